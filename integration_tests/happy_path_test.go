@@ -173,6 +173,39 @@ func (s *IntegrationTestSuite) TestHappyPath() {
 		err = s.deployERC20(paramsRes.BaseDenom, paramsRes.Erc20Name, paramsRes.Erc20Symbol, uint8(paramsRes.Erc20Decimals))
 		s.Require().NoError(err, "error deploying testgb as an ERC20")
 
+		var deployment types.ERC20DeployedEvent
+		s.Require().Eventuallyf(func() bool {
+			pendingRes, err := gbQueryClient.PendingERC20Deployments(context.Background(),
+				&types.PendingERC20DeploymentsRequest{},
+			)
+			if err != nil || len(pendingRes.Deployments) == 0 {
+				s.T().Logf("erc20 deployment not observed yet, waiting")
+				return false
+			}
+
+			deployment = pendingRes.Deployments[0]
+			return true
+		}, 180*time.Second, 10*time.Second, "unable to observe ERC20 deployment")
+
+		s.T().Logf("confirming the ERC20 deployment")
+		confirmMsg := types.NewMsgERC20DeployedConfirm(
+			deployment.CosmosDenom,
+			common.HexToAddress(deployment.TokenContract),
+			orch.keyInfo.GetAddress(),
+		)
+		s.Require().Eventuallyf(func() bool {
+			response, err := s.chain.sendMsgs(*clientCtx, confirmMsg)
+			if err != nil {
+				s.T().Logf("error: %s", err)
+				return false
+			}
+			if response.Code != 0 {
+				s.T().Log(response)
+				return false
+			}
+			return true
+		}, 105*time.Second, 10*time.Second, "unable to confirm ERC20 deployment")
+
 		s.Require().Eventuallyf(func() bool {
 			erc20Res, err := gbQueryClient.DenomToERC20(context.Background(),
 				&types.DenomToERC20Request{
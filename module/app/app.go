@@ -72,6 +72,12 @@ import (
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ica "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts"
+	icacontrollertypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
+	icahost "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host"
+	icahostkeeper "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/keeper"
+	icahosttypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
 	ibctransfer "github.com/cosmos/ibc-go/v5/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v5/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
@@ -84,6 +90,7 @@ import (
 	"github.com/gorilla/mux"
 	gravityparams "github.com/peggyjv/gravity-bridge/module/v2/app/params"
 	v2 "github.com/peggyjv/gravity-bridge/module/v2/app/upgrades/v2"
+	v3 "github.com/peggyjv/gravity-bridge/module/v2/app/upgrades/v3"
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity"
 	gravityclient "github.com/peggyjv/gravity-bridge/module/v2/x/gravity/client"
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/keeper"
@@ -107,6 +114,10 @@ const (
 	//
 	// NOTE: In the SDK, the default value is 255.
 	MaxAddrLen = 20
+
+	// InterchainAccountAddrLen is the length (in bytes) of the addresses of
+	// interchain accounts, which are derived as module account addresses.
+	InterchainAccountAddrLen = 32
 )
 
 var (
@@ -130,6 +141,12 @@ var (
 			upgradeclient.LegacyProposalHandler,
 			upgradeclient.LegacyCancelProposalHandler,
 			gravityclient.ProposalHandler,
+			gravityclient.EthereumBlocklistProposalHandler,
+			gravityclient.BridgeReenableProposalHandler,
+			gravityclient.DelayedSendToEthereumVetoProposalHandler,
+			gravityclient.HeldSendToCosmosReleaseProposalHandler,
+			gravityclient.EmergencySignerSetUpdateProposalHandler,
+			gravityclient.SendToEthereumPriorityProposalHandler,
 		}),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -138,6 +155,7 @@ var (
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		ibctransfer.AppModuleBasic{},
+		icaAppModuleBasic{},
 		vesting.AppModuleBasic{},
 		gravity.AppModuleBasic{},
 	)
@@ -145,14 +163,16 @@ var (
 	// module account permissions
 	// NOTE: We believe that this is giving various modules access to functions of the supply module? We will probably need to use this.
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		gravitytypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
+		authtypes.FeeCollectorName:         nil,
+		distrtypes.ModuleName:              nil,
+		minttypes.ModuleName:               {authtypes.Minter},
+		stakingtypes.BondedPoolName:        {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:     {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                {authtypes.Burner},
+		ibctransfertypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
+		icatypes.ModuleName:                nil,
+		gravitytypes.ModuleName:            {authtypes.Minter, authtypes.Burner},
+		gravitytypes.RelayerRewardPoolName: nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -206,11 +226,13 @@ type Gravity struct {
 	ibcKeeper        *ibckeeper.Keeper
 	evidenceKeeper   evidencekeeper.Keeper
 	transferKeeper   ibctransferkeeper.Keeper
+	icaHostKeeper    icahostkeeper.Keeper
 	gravityKeeper    keeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
+	ScopedICAHostKeeper  capabilitykeeper.ScopedKeeper
 
 	// Module Manager
 	mm *module.Manager
@@ -258,7 +280,7 @@ func NewGravityApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
-		gravitytypes.StoreKey,
+		icahosttypes.StoreKey, gravitytypes.StoreKey,
 	)
 	tKeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -284,6 +306,7 @@ func NewGravityApp(
 	)
 	scopedIBCKeeper := app.capabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.capabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedICAHostKeeper := app.capabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 
 	// Applications that wish to enforce statically created ScopedKeepers should
 	// call `Seal` after creating their scoped modules in the app via
@@ -383,8 +406,17 @@ func NewGravityApp(
 	transferModule := ibctransfer.NewAppModule(app.transferKeeper)
 	transferIBCModule := ibctransfer.NewIBCModule(app.transferKeeper)
 
+	app.icaHostKeeper = icahostkeeper.NewKeeper(
+		appCodec, keys[icahosttypes.StoreKey], app.GetSubspace(icahosttypes.SubModuleName),
+		app.ibcKeeper.ChannelKeeper, &app.ibcKeeper.PortKeeper,
+		app.accountKeeper, scopedICAHostKeeper, app.MsgServiceRouter(),
+	)
+	icaModule := ica.NewAppModule(nil, &app.icaHostKeeper)
+	icaHostIBCModule := icahost.NewIBCModule(app.icaHostKeeper)
+
 	ibcRouter := ibcporttypes.NewRouter()
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
+	ibcRouter.AddRoute(icahosttypes.SubModuleName, icaHostIBCModule)
 	app.ibcKeeper.SetRouter(ibcRouter)
 
 	evidenceKeeper := evidencekeeper.NewKeeper(
@@ -404,18 +436,22 @@ func NewGravityApp(
 		app.bankKeeper,
 		app.slashingKeeper,
 		app.distrKeeper,
-		sdk.DefaultPowerReduction,
 		app.ModuleAccountAddressesToNames([]string{}),
 		app.ModuleAccountAddressesToNames([]string{distrtypes.ModuleName}),
 	)
+	app.gravityKeeper.SetTransferKeeper(app.transferKeeper)
+	// deposits of the bond denom can be delegated with a deposit memo
+	app.gravityKeeper.RegisterDepositMemoHandler(gravitytypes.DepositMemoRouteStake, keeper.NewStakeDepositMemoHandler(app.stakingKeeper))
+	// ethereum events are observed by validator voting unless another oracle
+	// is set with app.gravityKeeper.SetOracle
 
 	govRouter := govv1beta1.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
-		AddRoute(paramsproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
+		AddRoute(paramsproposal.RouterKey, gravity.NewBridgeCriticalProposalHandler(app.gravityKeeper, gravity.NewParamChangeProposalHandler(app.gravityKeeper, params.NewParamChangeProposalHandler(app.paramsKeeper)))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.ibcKeeper.ClientKeeper)).
-		AddRoute(gravitytypes.RouterKey, gravity.NewCommunityPoolEthereumSpendProposalHandler(app.gravityKeeper))
+		AddRoute(gravitytypes.RouterKey, gravity.NewBridgeCriticalProposalHandler(app.gravityKeeper, gravity.NewCommunityPoolEthereumSpendProposalHandler(app.gravityKeeper)))
 
 	app.govKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -428,6 +464,7 @@ func NewGravityApp(
 		app.MsgServiceRouter(),
 		govtypes.DefaultConfig(),
 	)
+	app.gravityKeeper.SetGovKeeper(app.govKeeper)
 
 	app.setupUpgradeStoreLoaders()
 
@@ -498,9 +535,12 @@ func NewGravityApp(
 		ibc.NewAppModule(app.ibcKeeper),
 		params.NewAppModule(app.paramsKeeper),
 		transferModule,
+		icaModule,
 		gravity.NewAppModule(
 			app.gravityKeeper,
 			app.bankKeeper,
+			app.accountKeeper,
+			appCodec,
 		),
 	)
 
@@ -511,6 +551,7 @@ func NewGravityApp(
 		evidencetypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName,
 		// no-op modules
 		ibctransfertypes.ModuleName,
+		icatypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
 		govtypes.ModuleName,
@@ -525,6 +566,7 @@ func NewGravityApp(
 		// no-op modules
 		ibchost.ModuleName,
 		ibctransfertypes.ModuleName,
+		icatypes.ModuleName,
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
@@ -552,6 +594,7 @@ func NewGravityApp(
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
+		icatypes.ModuleName,
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
@@ -602,6 +645,7 @@ func NewGravityApp(
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedICAHostKeeper = scopedICAHostKeeper
 
 	return app
 }
@@ -624,6 +668,8 @@ func (app *Gravity) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) ab
 
 // EndBlocker application updates every end block
 func (app *Gravity) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	// bridge-critical proposals have to be tallied before gov ends their voting period
+	app.gravityKeeper.RecordBridgeProposalTallies(ctx)
 	return app.mm.EndBlock(ctx, req)
 }
 
@@ -787,6 +833,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govv1.ParamKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
+	paramsKeeper.Subspace(icahosttypes.SubModuleName)
 	paramsKeeper.Subspace(gravitytypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
 
@@ -797,10 +844,11 @@ func VerifyAddressFormat(bz []byte) error {
 	if len(bz) == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrUnknownAddress, "invalid address; cannot be empty")
 	}
-	if len(bz) != MaxAddrLen {
+	// interchain accounts of remote chains bridge through this chain
+	if len(bz) != MaxAddrLen && len(bz) != InterchainAccountAddrLen {
 		return sdkerrors.Wrapf(
 			sdkerrors.ErrUnknownAddress,
-			"invalid address length; got: %d, max: %d", len(bz), MaxAddrLen,
+			"invalid address length; got: %d, expected: %d or %d", len(bz), MaxAddrLen, InterchainAccountAddrLen,
 		)
 	}
 
@@ -808,11 +856,17 @@ func VerifyAddressFormat(bz []byte) error {
 }
 
 func (app *Gravity) setupUpgradeStoreLoaders() {
-	_, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(fmt.Sprintf("failed to read upgrade info from disk %s", err))
 	}
 
+	if upgradeInfo.Name == v3.UpgradeName && !app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{
+			Added: []string{icahosttypes.StoreKey},
+		}))
+	}
+
 	// if upgradeInfo.Name matches a plan name with a module being added, renamed, or deleted,
 	// create a storetypes.StoreUpgrades struct and
 	// app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
@@ -829,4 +883,13 @@ func (app *Gravity) setupUpgradeHandlers() {
 			app.bankKeeper,
 		),
 	)
+	app.upgradeKeeper.SetUpgradeHandler(
+		v3.UpgradeName,
+		v3.CreateUpgradeHandler(
+			app.mm,
+			app.configurator,
+			icacontrollertypes.Params{},
+			icahosttypes.NewParams(true, ICAHostAllowMessages),
+		),
+	)
 }
@@ -0,0 +1,34 @@
+package app
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ica "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts"
+	icahosttypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// ICAHostAllowMessages are the msgs the interchain accounts of remote chains
+// can execute, so that they can use the bridge as a service
+var ICAHostAllowMessages = []string{
+	sdk.MsgTypeURL(&gravitytypes.MsgSendToEthereum{}),
+	sdk.MsgTypeURL(&gravitytypes.MsgCancelSendToEthereum{}),
+	sdk.MsgTypeURL(&gravitytypes.MsgRequestBatchTx{}),
+}
+
+// icaAppModuleBasic is the interchain accounts module basic with a default
+// genesis whose host allows the ICAHostAllowMessages, rather than no msgs
+type icaAppModuleBasic struct {
+	ica.AppModuleBasic
+}
+
+// DefaultGenesis returns the default genesis of the interchain accounts module
+func (icaAppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	genesis := icatypes.DefaultGenesis()
+	genesis.HostGenesisState.Params = icahosttypes.NewParams(true, ICAHostAllowMessages)
+	return cdc.MustMarshalJSON(genesis)
+}
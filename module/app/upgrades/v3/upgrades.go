@@ -0,0 +1,37 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ica "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts"
+	icacontrollertypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/controller/types"
+	icahosttypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	controllerParams icacontrollertypes.Params,
+	hostParams icahosttypes.Params,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("v3 upgrade: entering handler")
+
+		// The interchain accounts module is added by this upgrade. It is
+		// initialized here with the msgs its host allows, rather than by
+		// InitGenesis with its default params which allow none.
+		icaModule, ok := mm.Modules[icatypes.ModuleName].(ica.AppModule)
+		if !ok {
+			panic("v3 upgrade: interchain accounts module not registered")
+		}
+		fromVM[icatypes.ModuleName] = icaModule.ConsensusVersion()
+
+		ctx.Logger().Info("v3 upgrade: initializing the interchain accounts host")
+		icaModule.InitModule(ctx, controllerParams, hostParams)
+
+		ctx.Logger().Info("v3 upgrade: running migrations and exiting handler")
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
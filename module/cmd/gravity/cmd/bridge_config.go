@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// ImportBridgeConfigCmd returns import-bridge-config cobra Command.
+func ImportBridgeConfigCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-bridge-config [config-file]",
+		Short: "Replace the gravity genesis state in genesis.json with an exported bridge configuration",
+		Long: `Replace the gravity genesis state in genesis.json with a bridge configuration exported from
+a running chain with the "query gravity bridge-config" command. The params, delegate keys, token mappings
+and latest signer set are then loaded by InitGenesis, which eases reproducing a chain's bridge state on a
+devnet or fork.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read bridge config: %w", err)
+			}
+
+			var gravityGenState gravitytypes.GenesisState
+			if err := cdc.UnmarshalJSON(bz, &gravityGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal bridge config: %w", err)
+			}
+
+			if err := gravityGenState.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid bridge config: %w", err)
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			gravityGenStateBz, err := cdc.MarshalJSON(&gravityGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal gravity genesis state: %w", err)
+			}
+
+			appState[gravitytypes.ModuleName] = gravityGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
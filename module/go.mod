@@ -3,10 +3,13 @@ module github.com/peggyjv/gravity-bridge/module/v2
 go 1.15
 
 require (
+	cosmossdk.io/errors v1.0.0-beta.7
+	github.com/armon/go-metrics v0.4.0
 	github.com/cosmos/cosmos-sdk v0.46.0
 	github.com/cosmos/ibc-go/v5 v5.0.0-beta1
 	github.com/ethereum/go-ethereum v1.10.17
 	github.com/gogo/protobuf v1.3.3
+	github.com/golang/protobuf v1.5.2
 	github.com/gorilla/mux v1.8.0
 	github.com/grpc-ecosystem/grpc-gateway v1.16.0
 	github.com/pkg/errors v0.9.1
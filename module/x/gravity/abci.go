@@ -21,21 +21,33 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	cleanupTimedOutContractCallTxs(ctx, k)
 	createSignerSetTxs(ctx, k)
 	createBatchTxs(ctx, k)
-	pruneSignerSetTxs(ctx, k)
+	k.PruneSignerSetTxs(ctx)
+	k.PruneBatchTxExecutionRecords(ctx)
+	k.PruneBridgeStats(ctx)
 }
 
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	outgoingTxSlashing(ctx, k)
+	k.PruneOrphanedEthereumSignatures(ctx)
 	eventVoteRecordPruneAndTally(ctx, k)
+	drainMintQueue(ctx, k)
+	k.ReleaseDelayedSendToEthereums(ctx)
 	updateObservedEthereumHeight(ctx, k)
+	createPolicyBatchTxs(ctx, k)
+	k.CheckCircuitBreaker(ctx)
+	k.EmitPoolTelemetry(ctx)
 }
 
 func createBatchTxs(ctx sdk.Context, k keeper.Keeper) {
 	// TODO: this needs some more work, is super naive
 	params := k.GetParams(ctx)
-	// bridge is currently disabled, do not create batch anymore
-	if !params.BridgeActive {
+	// bridge or its outbound direction is currently disabled, do not create batch anymore
+	if !params.BridgeActive || !params.OutboundEnabled {
+		return
+	}
+	// mirror chains only follow the bridge, they never create batches
+	if params.MirrorMode {
 		return
 	}
 	period := int64(params.BatchCreationPeriod)
@@ -55,7 +67,48 @@ func createBatchTxs(ctx sdk.Context, k keeper.Keeper) {
 		maxElement := int(params.BatchMaxElement)
 		for _, c := range contracts {
 			// NOTE: this doesn't emit events which would be helpful for client processes
-			k.BuildBatchTx(ctx, common.HexToAddress(c), maxElement)
+			if batch := k.BuildBatchTx(ctx, common.HexToAddress(c), maxElement); batch != nil {
+				k.RecordEndBlockerAction(ctx, types.EndBlockerActionBatchCreated, fmt.Sprintf(
+					"%s nonce %d: batch creation period", batch.TokenContract, batch.BatchNonce,
+				))
+			}
+		}
+	}
+}
+
+// createPolicyBatchTxs creates batches for the tokens whose unbatched txs
+// trigger a batch creation policy, without waiting for the batch creation
+// period or a batch request
+func createPolicyBatchTxs(ctx sdk.Context, k keeper.Keeper) {
+	params := k.GetParams(ctx)
+	if !params.BridgeActive || !params.OutboundEnabled || params.MirrorMode {
+		return
+	}
+
+	cm := map[string]bool{}
+	k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		cm[ste.Erc20Token.Contract] = true
+		return false
+	})
+
+	var contracts []string
+	for k := range cm {
+		contracts = append(contracts, k)
+	}
+	sort.Strings(contracts)
+
+	maxElement := int(params.BatchMaxElement)
+	for _, c := range contracts {
+		if batch, reason := k.BuildBatchTxOnPolicy(ctx, params, common.HexToAddress(c), maxElement); batch != nil {
+			k.RecordEndBlockerAction(ctx, types.EndBlockerActionBatchCreated, fmt.Sprintf(
+				"%s nonce %d: %s", batch.TokenContract, batch.BatchNonce, reason,
+			))
+			k.Logger(ctx).Info(
+				"batch created on batch creation policy",
+				"tokenContract", batch.TokenContract,
+				"batchNonce", batch.BatchNonce,
+				"reason", reason,
+			)
 		}
 	}
 }
@@ -66,10 +119,21 @@ func createSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
 	// 2. If there is at least one validator who started unbonding in current block. (we persist last unbonded block height in hooks.go)
 	//      This will make sure the unbonding validator has to provide an ethereum signature to a new signer set tx
 	//	    that excludes him before he completely Unbonds.  Otherwise he will be slashed
-	// 3. If power change between validators of Current signer set and latest signer set request is > 5%
+	// 3. If power change between validators of Current signer set and latest signer set request is above
+	//      the SignerSetPowerChangeThresholdBps param (5% by default)
+	// 4. If a validator rotated its ethereum key and the latest signer set request doesn't include it yet
+	// 5. If the latest signer set request is MaxBlocksBetweenSignerSets blocks old, when the param is set
+	//
+	// Mirror chains only follow the bridge, so no signer set txs are created while in mirror mode.
+	params := k.GetParams(ctx)
+	if params.MirrorMode {
+		return
+	}
+
 	latestSignerSetTx := k.GetLatestSignerSetTx(ctx)
 	if latestSignerSetTx == nil {
-		k.CreateSignerSetTx(ctx)
+		sstx := k.CreateSignerSetTx(ctx)
+		k.RecordEndBlockerAction(ctx, types.EndBlockerActionSignerSetCreated, fmt.Sprintf("nonce %d: no signer set yet", sstx.Nonce))
 		return
 	}
 
@@ -77,43 +141,48 @@ func createSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
 	blockHeight := uint64(ctx.BlockHeight())
 	powerDiff := types.EthereumSigners(k.CurrentSignerSet(ctx)).PowerDiff(latestSignerSetTx.Signers)
 
-	shouldCreate := (lastUnbondingHeight == blockHeight) || (powerDiff > 0.05)
+	pendingKeyRotation := hasUnsignedPendingEthereumAddress(ctx, k, latestSignerSetTx)
+
+	powerChanged := powerDiff > float64(params.SignerSetPowerChangeThresholdBps)/10_000
+	expired := params.MaxBlocksBetweenSignerSets != 0 && blockHeight >= latestSignerSetTx.Height+params.MaxBlocksBetweenSignerSets
+
+	shouldCreate := (lastUnbondingHeight == blockHeight) || powerChanged || pendingKeyRotation || expired
 	k.Logger(ctx).Info(
 		"considering signer set tx creation",
 		"blockHeight", blockHeight,
 		"lastUnbondingHeight", lastUnbondingHeight,
 		"latestSignerSetTx.Nonce", latestSignerSetTx.Nonce,
 		"powerDiff", powerDiff,
+		"pendingKeyRotation", pendingKeyRotation,
+		"expired", expired,
 		"shouldCreate", shouldCreate,
 	)
 
 	if shouldCreate {
-		k.CreateSignerSetTx(ctx)
+		sstx := k.CreateSignerSetTx(ctx)
+		k.RecordEndBlockerAction(ctx, types.EndBlockerActionSignerSetCreated, fmt.Sprintf(
+			"nonce %d: unbonding %t, power diff %f, pending key rotation %t, expired %t",
+			sstx.Nonce, lastUnbondingHeight == blockHeight, powerDiff, pendingKeyRotation, expired,
+		))
 	}
 }
 
-func pruneSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
-	params := k.GetParams(ctx)
-	// Validator set pruning
-	// prune all validator sets with a nonce less than the
-	// last observed nonce, they can't be submitted any longer
-	//
-	// Only prune valsets after the signed valsets window has passed
-	// so that slashing can occur the block before we remove them
-	lastObserved := k.GetLastObservedSignerSetTx(ctx)
-	currentBlock := uint64(ctx.BlockHeight())
-	tooEarly := currentBlock < params.SignedSignerSetTxsWindow
-	if lastObserved != nil && !tooEarly {
-		earliestToPrune := currentBlock - params.SignedSignerSetTxsWindow
-		for _, set := range k.GetSignerSetTxs(ctx) {
-			if set.Nonce < lastObserved.Nonce && set.Height < earliestToPrune {
-				// delete the signatures
-				k.DeleteEthereumSignatures(ctx, set)
-				// delete the outgoing signer set tx
-				k.DeleteOutgoingTx(ctx, set.GetStoreIndex())
-			}
-		}
+// hasUnsignedPendingEthereumAddress reports whether a pending ethereum address
+// of a bonded validator is missing from the signer set tx
+func hasUnsignedPendingEthereumAddress(ctx sdk.Context, k keeper.Keeper, signerSetTx *types.SignerSetTx) bool {
+	members := make(map[string]bool, len(signerSetTx.Signers))
+	for _, signer := range signerSetTx.Signers {
+		members[common.HexToAddress(signer.EthereumAddress).Hex()] = true
 	}
+
+	missing := false
+	k.IteratePendingValidatorEthereumAddresses(ctx, func(val sdk.ValAddress, ethAddr common.Address) bool {
+		validator := k.StakingKeeper.Validator(ctx, val)
+		missing = validator != nil && validator.IsBonded() && !members[ethAddr.Hex()]
+		return missing
+	})
+
+	return missing
 }
 
 // Iterate over all attestations currently being voted on in order of nonce and
@@ -121,6 +190,11 @@ func pruneSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
 // an attestation that has not passed the threshold
 // Also prune records that are older than the current nonce and no longer have any use
 func eventVoteRecordPruneAndTally(ctx sdk.Context, k keeper.Keeper) {
+	// the records of a replaced gravity contract must be gone before any record is tallied
+	if !k.CleanupPreviousGravityContract(ctx) {
+		return
+	}
+
 	params := k.GetParams(ctx)
 	// bridge is currently disabled, do not process attestations from Ethereum
 	if !params.BridgeActive {
@@ -169,12 +243,23 @@ func eventVoteRecordPruneAndTally(ctx sdk.Context, k keeper.Keeper) {
 			// If no attestation becomes observed, when we get to the next nonce, every attestation in
 			// it will be skipped. The same will happen for every nonce after that.
 			if nonce == lastNonce+1 {
-				k.TryEventVoteRecord(ctx, att)
+				k.TryEventVoteRecord(ctx, params, att)
 			}
 		}
 	}
 }
 
+// Release deposits held back by the per token mint rate limits, in order, at
+// the rate the limits allow
+func drainMintQueue(ctx sdk.Context, k keeper.Keeper) {
+	// bridge or its inbound direction is currently disabled, do not credit any deposits
+	if !k.IsInboundEnabled(ctx) {
+		return
+	}
+
+	k.DrainMintQueue(ctx)
+}
+
 // Periodically, every orchestrator will submit their latest observed Ethereum and Cosmos heights in
 // order to keep this information current regardless of the level of bridge activity.
 //
@@ -194,7 +279,7 @@ func updateObservedEthereumHeight(ctx sdk.Context, k keeper.Keeper) {
 	ethereumHeightPowers := make(map[uint64]sdk.Int)
 	cosmosHeightPowers := make(map[uint64]sdk.Int)
 	// we can use the same value as event vote records for this threshold
-	requiredPower := types.EventVoteRecordPowerThreshold(k.StakingKeeper.GetLastTotalPower(ctx))
+	requiredPower := types.EventVoteRecordPowerThreshold(k.StakingKeeper.GetLastTotalPower(ctx), k.GetEventVotePowerThreshold(ctx))
 
 	// populate the list
 	k.IterateEthereumHeightVotes(ctx, func(valAddres sdk.ValAddress, height types.LatestEthereumBlockHeight) bool {
@@ -261,18 +346,24 @@ func updateObservedEthereumHeight(ctx sdk.Context, k keeper.Keeper) {
 //    AND any deposit or withdraw has occurred to update the Ethereum block height.
 func cleanupTimedOutBatchTxs(ctx sdk.Context, k keeper.Keeper) {
 	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight
-	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
+	k.IterateTimedOutOutgoingTxs(ctx, types.BatchTxPrefixByte, ethereumHeight, func(otx types.OutgoingTx) bool {
 		btx, _ := otx.(*types.BatchTx)
 
-		if btx.Timeout < ethereumHeight {
-			k.CancelBatchTx(ctx, btx)
-		}
+		k.TimeOutBatchTx(ctx, btx)
+		types.EmitTypedEvent(ctx, &types.EventOutgoingTxTimedOut{
+			StoreIndex:     btx.GetStoreIndex(),
+			Timeout:        btx.Timeout,
+			EthereumHeight: ethereumHeight,
+		})
+		k.RecordEndBlockerAction(ctx, types.EndBlockerActionBatchTimedOut, fmt.Sprintf(
+			"%s nonce %d: timeout %d below ethereum height %d", btx.TokenContract, btx.BatchNonce, btx.Timeout, ethereumHeight,
+		))
 
 		return false
 	})
 }
 
-// cleanupTimedOutContractCallTxs deletes logic calls that have passed their expiration on Ethereum
+// cleanupTimedOutContractCallTxs refunds and deletes logic calls that have passed their expiration on Ethereum
 // keep in mind several things when modifying this function
 // A) unlike nonces timeouts are not monotonically increasing, meaning call 5 can have a later timeout than batch 6
 //    this means that we MUST only cleanup a single call at a time
@@ -283,12 +374,24 @@ func cleanupTimedOutBatchTxs(ctx sdk.Context, k keeper.Keeper) {
 //    AND any deposit or withdraw has occurred to update the Ethereum block height.
 func cleanupTimedOutContractCallTxs(ctx sdk.Context, k keeper.Keeper) {
 	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight
-	k.IterateOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+	k.IterateTimedOutOutgoingTxs(ctx, types.ContractCallTxPrefixByte, ethereumHeight, func(otx types.OutgoingTx) bool {
 		cctx, _ := otx.(*types.ContractCallTx)
-		if cctx.Timeout < ethereumHeight {
-			k.DeleteOutgoingTx(ctx, cctx.GetStoreIndex())
+
+		if !k.RefundContractCallTx(ctx, cctx) {
+			// the contract call keeps its escrow and is retried next block
+			return false
 		}
-		return true
+		k.DeleteOutgoingTx(ctx, cctx.GetStoreIndex())
+		types.EmitTypedEvent(ctx, &types.EventOutgoingTxTimedOut{
+			StoreIndex:     cctx.GetStoreIndex(),
+			Timeout:        cctx.Timeout,
+			EthereumHeight: ethereumHeight,
+		})
+		k.RecordEndBlockerAction(ctx, types.EndBlockerActionContractCallTimedOut, fmt.Sprintf(
+			"scope %X nonce %d: timeout %d below ethereum height %d", cctx.InvalidationScope, cctx.InvalidationNonce, cctx.Timeout, ethereumHeight,
+		))
+
+		return false
 	})
 }
 
@@ -315,9 +418,15 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 	}
 
 	bondedVals := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
-	valInfos := make([]valInfo, len(bondedVals))
+	valInfos := make([]valInfo, 0, len(bondedVals))
+
+	for _, val := range bondedVals {
+		// validators opted out of the bridge are not expected to sign, nor are
+		// those whose frozen keys can't be replaced yet
+		if k.IsValidatorOptedOut(ctx, val.GetOperator()) || k.IsOrchestratorFreezeCoolingDown(ctx, val.GetOperator()) {
+			continue
+		}
 
-	for i, val := range bondedVals {
 		consAddr, err := val.GetConsAddr()
 		if err != nil {
 			k.DisableBridge(ctx)
@@ -327,12 +436,13 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 		}
 
 		sigs, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
-		valInfos[i] = valInfo{val, exist, sigs, consAddr}
+		valInfos = append(valInfos, valInfo{val, exist, sigs, consAddr})
 	}
 
 	var unbondingValInfos []valInfo
 
 	blockTime := ctx.BlockTime().Add(k.StakingKeeper.GetParams(ctx).UnbondingTime)
+	powerReduction := k.StakingKeeper.PowerReduction(ctx)
 	blockHeight := ctx.BlockHeight()
 	unbondingValIterator := k.StakingKeeper.ValidatorQueueIterator(ctx, blockTime, blockHeight)
 	defer unbondingValIterator.Close()
@@ -348,6 +458,9 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 					fmt.Sprintf("outgoingTxSlashing: failed to bech32 decode validator address: %s", err))
 				return
 			}
+			if k.IsValidatorOptedOut(ctx, addr) || k.IsOrchestratorFreezeCoolingDown(ctx, addr) {
+				continue
+			}
 
 			validator, _ := k.StakingKeeper.GetValidator(ctx, addr)
 
@@ -371,8 +484,9 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 			// Don't slash validators who joined after outgoingtx is created
 			if valInfo.exist && valInfo.sigs.StartHeight < int64(otx.GetCosmosHeight()) {
 				if _, ok := signatures[valInfo.val.GetOperator().String()]; !ok {
+					k.IncrementMissedSignaturesByValidator(ctx, valInfo.val.GetOperator())
 					if !valInfo.val.IsJailed() {
-						power := valInfo.val.ConsensusPower(k.PowerReduction)
+						power := valInfo.val.ConsensusPower(powerReduction)
 						k.StakingKeeper.Slash(
 							ctx,
 							valInfo.cons,
@@ -381,16 +495,18 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 							params.SlashFractionBatch,
 						)
 						k.StakingKeeper.Jail(ctx, valInfo.cons)
-
-						ctx.EventManager().EmitEvent(
-							sdk.NewEvent(
-								slashingtypes.EventTypeSlash,
-								sdk.NewAttribute(slashingtypes.AttributeKeyAddress, valInfo.cons.String()),
-								sdk.NewAttribute(slashingtypes.AttributeKeyJailed, valInfo.cons.String()),
-								sdk.NewAttribute(slashingtypes.AttributeKeyReason, types.AttributeMissingBridgeBatchSig),
-								sdk.NewAttribute(slashingtypes.AttributeKeyPower, fmt.Sprintf("%d", power)),
-							),
-						)
+						keeper.TelemetryValidatorSlashed(types.AttributeMissingBridgeBatchSig)
+						k.RecordEndBlockerAction(ctx, types.EndBlockerActionValidatorSlashed, fmt.Sprintf(
+							"%s: missing signature for outgoing tx %X", valInfo.val.GetOperator(), otx.GetStoreIndex(),
+						))
+
+						types.EmitTypedEvent(ctx, &types.EventValidatorSlashed{
+							Validator:        valInfo.val.GetOperator().String(),
+							ConsensusAddress: valInfo.cons.String(),
+							Power:            power,
+							Reason:           types.AttributeMissingBridgeBatchSig,
+							StoreIndex:       otx.GetStoreIndex(),
+						})
 					}
 				}
 			}
@@ -407,7 +523,7 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 					if _, found := signatures[valInfo.val.GetOperator().String()]; !found {
 						if !valInfo.val.IsJailed() {
 							// TODO: Do we want to slash jailed validators?
-							power := valInfo.val.ConsensusPower(k.PowerReduction)
+							power := valInfo.val.ConsensusPower(powerReduction)
 							k.StakingKeeper.Slash(
 								ctx,
 								valInfo.cons,
@@ -416,16 +532,18 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 								params.SlashFractionSignerSetTx,
 							)
 							k.StakingKeeper.Jail(ctx, valInfo.cons)
-
-							ctx.EventManager().EmitEvent(
-								sdk.NewEvent(
-									slashingtypes.EventTypeSlash,
-									sdk.NewAttribute(slashingtypes.AttributeKeyAddress, valInfo.cons.String()),
-									sdk.NewAttribute(slashingtypes.AttributeKeyJailed, valInfo.cons.String()),
-									sdk.NewAttribute(slashingtypes.AttributeKeyReason, types.AttributeMissingBridgeBatchSig),
-									sdk.NewAttribute(slashingtypes.AttributeKeyPower, fmt.Sprintf("%d", power)),
-								),
-							)
+							keeper.TelemetryValidatorSlashed(types.AttributeMissingBridgeSignerSetSig)
+							k.RecordEndBlockerAction(ctx, types.EndBlockerActionValidatorSlashed, fmt.Sprintf(
+								"%s: unbonding without signing signer set %d", valInfo.val.GetOperator(), sstx.Nonce,
+							))
+
+							types.EmitTypedEvent(ctx, &types.EventValidatorSlashed{
+								Validator:        valInfo.val.GetOperator().String(),
+								ConsensusAddress: valInfo.cons.String(),
+								Power:            power,
+								Reason:           types.AttributeMissingBridgeSignerSetSig,
+								StoreIndex:       sstx.GetStoreIndex(),
+							})
 						}
 					}
 				}
@@ -26,8 +26,8 @@ func TestSignerSetTxCreationIfNotAvailable(t *testing.T) {
 
 	// BeginBlocker should set a new validator set if not available
 	gravity.BeginBlocker(ctx, gravityKeeper)
-	otx := gravityKeeper.GetOutgoingTx(ctx, types.MakeSignerSetTxKey(1))
-	require.NotNil(t, otx)
+	otx, err := gravityKeeper.GetOutgoingTx(ctx, types.MakeSignerSetTxKey(1))
+	require.NoError(t, err)
 	_, ok := otx.(*types.SignerSetTx)
 	require.True(t, ok)
 	require.True(t, len(gravityKeeper.GetSignerSetTxs(ctx)) == 1)
@@ -40,8 +40,8 @@ func TestPruneSignerSetTxs(t *testing.T) {
 
 	// BeginBlocker should set a new validator set if not available
 	gravity.BeginBlocker(ctx, gravityKeeper)
-	otx := gravityKeeper.GetOutgoingTx(ctx, types.MakeSignerSetTxKey(1))
-	require.NotNil(t, otx)
+	otx, err := gravityKeeper.GetOutgoingTx(ctx, types.MakeSignerSetTxKey(1))
+	require.NoError(t, err)
 	signerSetTx, ok := otx.(*types.SignerSetTx)
 	require.True(t, ok)
 	require.True(t, len(gravityKeeper.GetSignerSetTxs(ctx)) == 1)
@@ -76,8 +76,8 @@ func TestPruneSignerSetTxs(t *testing.T) {
 	newCtx := ctx.WithBlockHeight(int64(prunedHeight))
 	// Prune the valset
 	gravity.BeginBlocker(newCtx, gravityKeeper)
-	otx = gravityKeeper.GetOutgoingTx(newCtx, types.MakeSignerSetTxKey(1))
-	require.Nil(t, otx)
+	_, err = gravityKeeper.GetOutgoingTx(newCtx, types.MakeSignerSetTxKey(1))
+	require.ErrorIs(t, err, types.ErrOutgoingTxNotFound)
 	// Check that signatures are pruned as well
 	signatures = gravityKeeper.GetEthereumSignatures(newCtx, types.MakeSignerSetTxKey(1))
 	require.Equal(t, 0, len(signatures))
@@ -140,7 +140,7 @@ func TestSignerSetTxSlashing_SignerSetTxCreated_After_ValidatorBonded(t *testing
 		if i == 0 {
 			continue
 		}
-		pk.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{signerSet.Nonce, keeper.AccAddrs[i].String(), []byte("dummysig")}, val)
+		pk.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{signerSet.Nonce, keeper.AccAddrs[i].String(), []byte("dummysig"), types.SIGNATURE_SCHEME_ECDSA}, val)
 	}
 
 	gravity.EndBlocker(ctx, pk)
@@ -202,7 +202,7 @@ func TestSignerSetTxSlashing_UnbondingValidator_UnbondWindow_NotExpired(t *testi
 			// don't sign with first validator
 			continue
 		}
-		gravityKeeper.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{vs.Nonce, keeper.EthAddrs[i].Hex(), []byte("dummySig")}, val)
+		gravityKeeper.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{vs.Nonce, keeper.EthAddrs[i].Hex(), []byte("dummySig"), types.SIGNATURE_SCHEME_ECDSA}, val)
 	}
 	staking.EndBlocker(input.Context, input.StakingKeeper)
 
@@ -266,6 +266,11 @@ func TestBatchSlashing(t *testing.T) {
 	// ensure that the 2nd  validator is not jailed and slashed
 	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[1]).IsJailed())
 
+	// only the missing signature of the first validator is counted
+	require.Equal(t, uint64(1), gravityKeeper.GetMissedSignaturesByValidator(ctx, keeper.ValAddrs[0]))
+	require.Zero(t, gravityKeeper.GetMissedSignaturesByValidator(ctx, keeper.ValAddrs[1]))
+	require.Zero(t, gravityKeeper.GetMissedSignaturesByValidator(ctx, keeper.ValAddrs[2]))
+
 	// Ensure that the last slashed signer set tx nonce is set properly
 	require.Equal(t, input.GravityKeeper.GetLastSlashedOutgoingTxBlockHeight(ctx), batch.Height)
 }
@@ -283,10 +288,59 @@ func TestSignerSetTxEmission(t *testing.T) {
 
 	// BeginBlocker should set a new validator set
 	gravity.BeginBlocker(ctx, gravityKeeper)
-	require.NotNil(t, gravityKeeper.GetOutgoingTx(ctx, types.MakeSignerSetTxKey(2)))
+	_, err := gravityKeeper.GetOutgoingTx(ctx, types.MakeSignerSetTxKey(2))
+	require.NoError(t, err)
 	require.EqualValues(t, 2, len(gravityKeeper.GetSignerSetTxs(ctx)))
 }
 
+func TestSignerSetTxEmissionParams(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+
+	params := gravityKeeper.GetParams(ctx)
+	params.SignerSetPowerChangeThresholdBps = 1_000
+	params.MaxBlocksBetweenSignerSets = 10
+	gravityKeeper.SetParams(ctx, params)
+
+	// a 5% power change is below the threshold
+	sstx := gravityKeeper.CreateSignerSetTx(ctx)
+	delta := float64(types.EthereumSigners(sstx.Signers).TotalPower()) * 0.05
+	sstx.Signers[0].Power = uint64(float64(sstx.Signers[0].Power) - delta/2)
+	sstx.Signers[1].Power = uint64(float64(sstx.Signers[1].Power) + delta/2)
+	gravityKeeper.SetOutgoingTx(ctx, sstx)
+
+	gravity.BeginBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+9), gravityKeeper)
+	require.EqualValues(t, 1, len(gravityKeeper.GetSignerSetTxs(ctx)))
+
+	// but the latest signer set tx is replaced once it is old enough
+	gravity.BeginBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+10), gravityKeeper)
+	require.EqualValues(t, 2, len(gravityKeeper.GetSignerSetTxs(ctx)))
+}
+
+func TestMirrorModeSkipsOutgoingTxCreation(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+
+	params := gravityKeeper.GetParams(ctx)
+	params.MirrorMode = true
+	gravityKeeper.SetParams(ctx, params)
+
+	// BeginBlocker must not create a signer set tx while mirroring
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	_, err := gravityKeeper.GetOutgoingTx(ctx, types.MakeSignerSetTxKey(1))
+	require.ErrorIs(t, err, types.ErrOutgoingTxNotFound)
+	require.Empty(t, gravityKeeper.GetSignerSetTxs(ctx))
+
+	// sends to ethereum are rejected
+	_, err = keeper.NewMsgServerImpl(gravityKeeper).SendToEthereum(sdk.WrapSDKContext(ctx), &types.MsgSendToEthereum{
+		Sender:            keeper.AccAddrs[0].String(),
+		EthereumRecipient: keeper.EthAddrs[1].String(),
+		Amount:            sdk.NewInt64Coin("stake", 100),
+		BridgeFee:         sdk.NewInt64Coin("stake", 1),
+	})
+	require.ErrorIs(t, err, types.ErrMirrorMode)
+}
+
 func TestSignerSetTxSetting(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	gk := input.GravityKeeper
@@ -333,10 +387,10 @@ func TestBatchTxTimeout(t *testing.T) {
 	require.Equal(t, b2.Timeout, uint64(504))
 
 	// make sure the batches got stored in the first place
-	gotFirstBatch := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b1.TokenContract), b1.BatchNonce))
-	require.NotNil(t, gotFirstBatch)
-	gotSecondBatch := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b2.TokenContract), b2.BatchNonce))
-	require.NotNil(t, gotSecondBatch)
+	_, err := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b1.TokenContract), b1.BatchNonce))
+	require.NoError(t, err)
+	_, err = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b2.TokenContract), b2.BatchNonce))
+	require.NoError(t, err)
 
 	// when, way into the future
 	ctx = ctx.WithBlockTime(now).WithBlockHeight(9)
@@ -346,25 +400,111 @@ func TestBatchTxTimeout(t *testing.T) {
 	gravity.BeginBlocker(ctx, gravityKeeper)
 
 	// this had a timeout of zero should be deleted.
-	gotFirstBatch = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b1.TokenContract), b1.BatchNonce))
-	require.Nil(t, gotFirstBatch)
+	_, err = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b1.TokenContract), b1.BatchNonce))
+	require.ErrorIs(t, err, types.ErrOutgoingTxNotFound)
 	// make sure the end blocker does not delete these, as the block height has not officially
 	// been updated by a relay event
-	gotSecondBatch = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b2.TokenContract), b2.BatchNonce))
-	require.NotNil(t, gotSecondBatch)
-	gotThirdBatch := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b3.TokenContract), b3.BatchNonce))
-	require.NotNil(t, gotThirdBatch)
+	_, err = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b2.TokenContract), b2.BatchNonce))
+	require.NoError(t, err)
+	_, err = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b3.TokenContract), b3.BatchNonce))
+	require.NoError(t, err)
 
 	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 5000)
 	gravity.BeginBlocker(ctx, gravityKeeper)
 
 	// make sure the end blocker does delete these, as we've got a new Ethereum block height
-	gotFirstBatch = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b1.TokenContract), b1.BatchNonce))
-	require.Nil(t, gotFirstBatch)
-	gotSecondBatch = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b2.TokenContract), b2.BatchNonce))
-	require.Nil(t, gotSecondBatch)
-	gotThirdBatch = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b3.TokenContract), b3.BatchNonce))
-	require.NotNil(t, gotThirdBatch)
+	_, err = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b1.TokenContract), b1.BatchNonce))
+	require.ErrorIs(t, err, types.ErrOutgoingTxNotFound)
+	_, err = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b2.TokenContract), b2.BatchNonce))
+	require.ErrorIs(t, err, types.ErrOutgoingTxNotFound)
+	_, err = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b3.TokenContract), b3.BatchNonce))
+	require.NoError(t, err)
+}
+
+func TestBatchTxCreationOnFeeThreshold(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+	)
+
+	params := gravityKeeper.GetParams(ctx)
+	params.BatchFeeThresholds = []types.BatchFeeThreshold{{TokenContract: myTokenContractAddr.Hex(), Threshold: sdk.NewInt(10)}}
+	gravityKeeper.SetParams(ctx, params)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	// stay off the batch creation period so only the threshold can trigger a batch
+	ctx = ctx.WithBlockHeight(int64(params.BatchCreationPeriod) + 1)
+
+	// below the threshold nothing happens
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3)
+	gravity.EndBlocker(ctx, gravityKeeper)
+	_, err := gravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(myTokenContractAddr, 1))
+	require.ErrorIs(t, err, types.ErrOutgoingTxNotFound)
+
+	// crossing it creates a batch in the same block
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 5)
+	gravity.EndBlocker(ctx, gravityKeeper)
+	otx, err := gravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(myTokenContractAddr, 1))
+	require.NoError(t, err)
+	require.Len(t, otx.(*types.BatchTx).Transactions, 3)
+}
+
+func TestBatchTxCreationOnAgeAndPoolSize(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	var (
+		mySender, _ = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver  = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenA      = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		tokenB      = common.HexToAddress("0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4")
+		allVouchers = sdk.NewCoins(
+			types.NewERC20Token(99999, tokenA).GravityCoin(),
+			types.NewERC20Token(99999, tokenB).GravityCoin(),
+		)
+	)
+
+	params := gravityKeeper.GetParams(ctx)
+	params.BatchMaxTxAge = 20
+	params.BatchMaxPoolSize = 3
+	gravityKeeper.SetParams(ctx, params)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	// stay off the batch creation period so only the policies can trigger a batch
+	ctx = ctx.WithBlockHeight(int64(params.BatchCreationPeriod) + 1)
+	input.AddSendToEthTxsToPool(t, ctx, tokenA, mySender, myReceiver, 1)
+	input.AddSendToEthTxsToPool(t, ctx, tokenB, mySender, myReceiver, 1, 2)
+	gravity.EndBlocker(ctx, gravityKeeper)
+	_, err := gravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(tokenA, 1))
+	require.ErrorIs(t, err, types.ErrOutgoingTxNotFound)
+	_, err = gravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(tokenB, 1))
+	require.ErrorIs(t, err, types.ErrOutgoingTxNotFound)
+
+	// reaching the pool size creates a batch for that token only
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	input.AddSendToEthTxsToPool(t, ctx, tokenB, mySender, myReceiver, 3)
+	gravity.EndBlocker(ctx, gravityKeeper)
+	otx, err := gravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(tokenB, 1))
+	require.NoError(t, err)
+	require.Len(t, otx.(*types.BatchTx).Transactions, 3)
+	_, err = gravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(tokenA, 2))
+	require.ErrorIs(t, err, types.ErrOutgoingTxNotFound)
+
+	// reaching the age of the oldest tx creates a batch
+	ctx = ctx.WithBlockHeight(int64(params.BatchCreationPeriod) + 1 + int64(params.BatchMaxTxAge))
+	gravity.EndBlocker(ctx, gravityKeeper)
+	otx, err = gravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(tokenA, 2))
+	require.NoError(t, err)
+	require.Len(t, otx.(*types.BatchTx).Transactions, 1)
 }
 
 func TestUpdateObservedEthereumHeight(t *testing.T) {
@@ -418,6 +558,47 @@ func TestUpdateObservedEthereumHeight(t *testing.T) {
 	require.Equal(t, lastHeight.CosmosHeight, uint64(33))
 }
 
+// BenchmarkEndBlockerBatchPolicies measures the end blocker evaluating the
+// batch creation policies of many tokens with full pools that don't trigger
+// a batch, so that every iteration does the same work
+func BenchmarkEndBlockerBatchPolicies(b *testing.B) {
+	const (
+		tokenCount  = 20
+		txsPerToken = 50
+	)
+
+	input, ctx := keeper.SetupFiveValChain(b)
+	gravityKeeper := input.GravityKeeper
+	var (
+		mySender, _ = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver  = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		ids         = make([]uint64, txsPerToken)
+		thresholds  []types.BatchFeeThreshold
+	)
+	for i := range ids {
+		ids[i] = uint64(i + 1)
+	}
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+
+	for i := 0; i < tokenCount; i++ {
+		token := common.BigToAddress(sdk.NewInt(int64(i + 1)).BigInt())
+		require.NoError(b, fundAccount(ctx, input.BankKeeper, mySender, sdk.NewCoins(types.NewERC20Token(1_000_000, token).GravityCoin())))
+		input.AddSendToEthTxsToPool(b, ctx, token, mySender, myReceiver, ids...)
+		thresholds = append(thresholds, types.BatchFeeThreshold{TokenContract: token.Hex(), Threshold: sdk.NewInt(1_000_000)})
+	}
+
+	params := gravityKeeper.GetParams(ctx)
+	params.BatchFeeThresholds = thresholds
+	params.BatchMaxPoolSize = txsPerToken + 1
+	params.BatchMaxTxAge = 1_000_000
+	gravityKeeper.SetParams(ctx, params)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		gravity.EndBlocker(ctx, gravityKeeper)
+	}
+}
+
 func fundAccount(ctx sdk.Context, bankKeeper types.BankKeeper, addr sdk.AccAddress, amounts sdk.Coins) error {
 	if err := bankKeeper.MintCoins(ctx, types.ModuleName, amounts); err != nil {
 		return err
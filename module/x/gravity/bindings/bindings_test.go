@@ -0,0 +1,76 @@
+package bindings
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+var (
+	contractAddr, _ = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+	tokenContract   = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	gravityDenom    = types.GravityDenom(tokenContract)
+)
+
+func TestEncodeGravityMsg(t *testing.T) {
+	msgs, err := EncodeGravityMsg(contractAddr, []byte(`{"send_to_ethereum": {
+		"ethereum_recipient": "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+		"amount": {"denom": "`+gravityDenom+`", "amount": "100"},
+		"bridge_fee": {"denom": "`+gravityDenom+`", "amount": "1"}
+	}}`))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{types.NewMsgSendToEthereum(
+		contractAddr,
+		"0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+		sdk.NewInt64Coin(gravityDenom, 100),
+		sdk.NewInt64Coin(gravityDenom, 1),
+	)}, msgs)
+
+	msgs, err = EncodeGravityMsg(contractAddr, []byte(`{"cancel_send_to_ethereum": {"id": 7}}`))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{types.NewMsgCancelSendToEthereum(7, contractAddr)}, msgs)
+
+	_, err = EncodeGravityMsg(contractAddr, []byte(`{"request_batch_tx": {"denom": "`+gravityDenom+`"}, "cancel_send_to_ethereum": {"id": 7}}`))
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownRequest)
+
+	_, err = EncodeGravityMsg(contractAddr, []byte(`{"send_to_ethereum": {"amount": {"denom": "`+gravityDenom+`", "amount": "-1"}}}`))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+}
+
+func TestGravityQuerier(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	querier := NewGravityQuerier(input.GravityKeeper)
+
+	query := func(request string, res interface{}) {
+		bz, err := querier(ctx, json.RawMessage(request))
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(bz, res))
+	}
+
+	var version VersionResponse
+	query(`{"version": {}}`, &version)
+	require.Equal(t, Version, version.Version)
+
+	var erc20 DenomToERC20Response
+	query(`{"denom_to_erc20": {"denom": "`+gravityDenom+`"}}`, &erc20)
+	require.Equal(t, DenomToERC20Response{ERC20: tokenContract.Hex()}, erc20)
+
+	var denom ERC20ToDenomResponse
+	query(`{"erc20_to_denom": {"erc20": "`+tokenContract.Hex()+`"}}`, &denom)
+	require.Equal(t, ERC20ToDenomResponse{Denom: gravityDenom}, denom)
+
+	var fees BatchFeesResponse
+	query(`{"batch_fees": {"denom": "`+gravityDenom+`"}}`, &fees)
+	require.Equal(t, Coin{Denom: gravityDenom, Amount: "0"}, fees.Fees)
+
+	_, err := querier(ctx, json.RawMessage(`{}`))
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownRequest)
+}
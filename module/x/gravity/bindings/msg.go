@@ -0,0 +1,102 @@
+// Package bindings implements the gravity custom messages and queries of
+// CosmWasm contracts. The encoder and querier have the signatures of the
+// wasmd custom plugins, an app using wasmd registers them with:
+//
+//	wasmkeeper.WithMessageEncoders(&wasmkeeper.MessageEncoders{Custom: bindings.EncodeGravityMsg})
+//	wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{Custom: bindings.NewGravityQuerier(gravityKeeper)})
+//
+// Messages are encoded into the module's sdk.Msgs with the contract as their
+// sender, so contracts are subject to the same checks as accounts and can
+// only cancel their own sends to ethereum.
+package bindings
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// Version is the version of the binding schema, it changes whenever a message
+// or query of the schema changes in a backwards incompatible way
+const Version = "1"
+
+// Coin is the JSON representation of an sdk.Coin in contract messages
+type Coin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+func (c Coin) toSDK() (sdk.Coin, error) {
+	amount, ok := sdk.NewIntFromString(c.Amount)
+	if !ok {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", c.Amount)
+	}
+	coin := sdk.Coin{Denom: c.Denom, Amount: amount}
+	if err := coin.Validate(); err != nil {
+		return sdk.Coin{}, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	return coin, nil
+}
+
+// GravityMsg is the custom message of a contract, exactly one of its fields
+// must be set
+type GravityMsg struct {
+	SendToEthereum       *SendToEthereum       `json:"send_to_ethereum,omitempty"`
+	RequestBatchTx       *RequestBatchTx       `json:"request_batch_tx,omitempty"`
+	CancelSendToEthereum *CancelSendToEthereum `json:"cancel_send_to_ethereum,omitempty"`
+}
+
+// SendToEthereum sends coins of the contract to an ethereum address
+type SendToEthereum struct {
+	EthereumRecipient string `json:"ethereum_recipient"`
+	Amount            Coin   `json:"amount"`
+	BridgeFee         Coin   `json:"bridge_fee"`
+}
+
+// RequestBatchTx requests a batch of the unbatched sends of a denom
+type RequestBatchTx struct {
+	Denom string `json:"denom"`
+}
+
+// CancelSendToEthereum cancels an unbatched send to ethereum of the contract
+type CancelSendToEthereum struct {
+	ID uint64 `json:"id"`
+}
+
+// EncodeGravityMsg encodes the custom message of a contract into the gravity
+// message it describes, sent by the contract
+func EncodeGravityMsg(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+	var gravityMsg GravityMsg
+	if err := json.Unmarshal(msg, &gravityMsg); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var out sdk.Msg
+	switch {
+	case gravityMsg.SendToEthereum != nil && gravityMsg.RequestBatchTx == nil && gravityMsg.CancelSendToEthereum == nil:
+		send := gravityMsg.SendToEthereum
+		amount, err := send.Amount.toSDK()
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "amount")
+		}
+		bridgeFee, err := send.BridgeFee.toSDK()
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "bridge fee")
+		}
+		out = types.NewMsgSendToEthereum(sender, send.EthereumRecipient, amount, bridgeFee)
+	case gravityMsg.RequestBatchTx != nil && gravityMsg.SendToEthereum == nil && gravityMsg.CancelSendToEthereum == nil:
+		out = types.NewMsgRequestBatchTx(gravityMsg.RequestBatchTx.Denom, sender)
+	case gravityMsg.CancelSendToEthereum != nil && gravityMsg.SendToEthereum == nil && gravityMsg.RequestBatchTx == nil:
+		out = types.NewMsgCancelSendToEthereum(gravityMsg.CancelSendToEthereum.ID, sender)
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "gravity message must set exactly one message")
+	}
+
+	if err := out.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return []sdk.Msg{out}, nil
+}
@@ -0,0 +1,122 @@
+package bindings
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// GravityQuery is the custom query of a contract, exactly one of its fields
+// must be set
+type GravityQuery struct {
+	Version      *VersionQuery      `json:"version,omitempty"`
+	DenomToERC20 *DenomToERC20Query `json:"denom_to_erc20,omitempty"`
+	ERC20ToDenom *ERC20ToDenomQuery `json:"erc20_to_denom,omitempty"`
+	BatchFees    *BatchFeesQuery    `json:"batch_fees,omitempty"`
+}
+
+// VersionQuery returns the version of the binding schema
+type VersionQuery struct{}
+
+type VersionResponse struct {
+	Version string `json:"version"`
+}
+
+// DenomToERC20Query returns the ERC20 contract of a denom
+type DenomToERC20Query struct {
+	Denom string `json:"denom"`
+}
+
+type DenomToERC20Response struct {
+	ERC20            string `json:"erc20"`
+	CosmosOriginated bool   `json:"cosmos_originated"`
+}
+
+// ERC20ToDenomQuery returns the denom of an ERC20 contract
+type ERC20ToDenomQuery struct {
+	ERC20 string `json:"erc20"`
+}
+
+type ERC20ToDenomResponse struct {
+	Denom            string `json:"denom"`
+	CosmosOriginated bool   `json:"cosmos_originated"`
+}
+
+// BatchFeesQuery returns the token fees the next batch of a denom would pay
+// if requested now
+type BatchFeesQuery struct {
+	Denom string `json:"denom"`
+}
+
+type BatchFeesResponse struct {
+	Fees Coin `json:"fees"`
+}
+
+// NewGravityQuerier returns the querier of the custom queries of contracts
+func NewGravityQuerier(k keeper.Keeper) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query GravityQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+
+		var (
+			res interface{}
+			err error
+		)
+		switch {
+		case query.Version != nil:
+			res = VersionResponse{Version: Version}
+		case query.DenomToERC20 != nil:
+			res, err = denomToERC20(ctx, k, query.DenomToERC20)
+		case query.ERC20ToDenom != nil:
+			res, err = erc20ToDenom(ctx, k, query.ERC20ToDenom)
+		case query.BatchFees != nil:
+			res, err = batchFees(ctx, k, query.BatchFees)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown gravity query")
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+		return bz, nil
+	}
+}
+
+func denomToERC20(ctx sdk.Context, k keeper.Keeper, query *DenomToERC20Query) (DenomToERC20Response, error) {
+	cosmosOriginated, erc20, err := k.DenomToERC20Lookup(ctx, types.NormalizeDenom(query.Denom))
+	if err != nil {
+		return DenomToERC20Response{}, err
+	}
+	return DenomToERC20Response{ERC20: erc20.Hex(), CosmosOriginated: cosmosOriginated}, nil
+}
+
+func erc20ToDenom(ctx sdk.Context, k keeper.Keeper, query *ERC20ToDenomQuery) (ERC20ToDenomResponse, error) {
+	if err := types.ValidateEthAddress(query.ERC20); err != nil {
+		return ERC20ToDenomResponse{}, sdkerrors.Wrap(err, "erc20")
+	}
+	cosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(query.ERC20))
+	return ERC20ToDenomResponse{Denom: denom, CosmosOriginated: cosmosOriginated}, nil
+}
+
+func batchFees(ctx sdk.Context, k keeper.Keeper, query *BatchFeesQuery) (BatchFeesResponse, error) {
+	denom := types.NormalizeDenom(query.Denom)
+	_, tokenContract, err := k.DenomToERC20Lookup(ctx, denom)
+	if err != nil {
+		return BatchFeesResponse{}, err
+	}
+	fees := k.GetBatchFeesByTokenType(ctx, tokenContract, int(k.GetParams(ctx).BatchMaxElement))
+	return BatchFeesResponse{
+		Fees: Coin{Denom: denom, Amount: k.ERC20ToCosmosAmount(ctx, tokenContract, fees).String()},
+	}, nil
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -12,6 +13,21 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	flagTokenContract       = "token-contract"
+	flagMinTotalFee         = "min-total-fee"
+	flagTimeoutBeforeHeight = "timeout-before-height"
+	flagSignatureStatus     = "signature-status"
+	flagLimit               = "limit"
+	flagMaxElements         = "max-elements"
+	flagMinFee              = "min-fee"
+	flagSignatureScheme     = "signature-scheme"
+	flagGranter             = "granter"
+	flagGrantee             = "grantee"
+	flagBridgeFeeGranter    = "bridge-fee-granter"
+	flagExecuteAfterHeight  = "execute-after-height"
+)
+
 func GetQueryCmd() *cobra.Command {
 	gravityQueryCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -23,10 +39,19 @@ func GetQueryCmd() *cobra.Command {
 	gravityQueryCmd.AddCommand(
 		CmdBatchTx(),
 		CmdBatchTxConfirmations(),
+		CmdBatchTxConfirmationProgress(),
 		CmdBatchTxFees(),
+		CmdBatchFees(),
 		CmdBatchTxs(),
 		CmdContractCallTx(),
+		CmdSignerSetTxCheckpoint(),
+		CmdBatchTxCheckpoint(),
+		CmdContractCallTxCheckpoint(),
 		CmdContractCallTxConfirmations(),
+		CmdSignerSetTxCalldata(),
+		CmdBatchTxCalldata(),
+		CmdContractCallTxCalldata(),
+		CmdAgreedEthereumHeader(),
 		CmdContractCallTxs(),
 		CmdDenomToERC20Params(),
 		CmdERC20ToDenom(),
@@ -40,12 +65,39 @@ func GetQueryCmd() *cobra.Command {
 		CmdUnsignedContractCallTxs(),
 		CmdUnsignedSignerSetTxs(),
 		CmdDenomToERC20(),
+		CmdBatchedSendToEthereums(),
 		CmdUnbatchedSendToEthereums(),
 		CmdDelegateKeysByValidator(),
 		CmdDelegateKeysByEthereumSigner(),
 		CmdDelegateKeysByOrchestrator(),
 		CmdDelegateKeys(),
 		CmdLastObservedEthereumHeight(),
+		CmdProjectedEthereumHeight(),
+		CmdQueuedSendToCosmosEvents(),
+		CmdHeldSendToCosmosEvents(),
+		CmdExecutedBatchTxs(),
+		CmdExecutedContractCallTxs(),
+		CmdRefundedContractCallTxs(),
+		CmdSignerSetTxDelta(),
+		CmdBridgeFeeAllowances(),
+		CmdEthereumBlocklist(),
+		CmdModuleAccounts(),
+		CmdEndBlockerActions(),
+		CmdSignerSetTxsByHeightRange(),
+		CmdBridgeConfig(),
+		CmdReplayDiff(),
+		CmdPendingERC20Deployments(),
+		CmdERC20Conversion(),
+		CmdTokenPauses(),
+		CmdOrchestratorQueryIdentity(),
+		CmdDelayedSendToEthereums(),
+		CmdPendingEventVoteRecords(),
+		CmdEventByEthereumTxHash(),
+		CmdConflictingEthereumEvents(),
+		CmdBridgeStats(),
+		CmdBridgeValidatorLiveness(),
+		CmdBatchTxInclusionProof(),
+		CmdBridgeValidatorInfo(),
 	)
 
 	return gravityQueryCmd
@@ -106,6 +158,37 @@ func CmdSignerSetTx() *cobra.Command {
 	return cmd
 }
 
+func CmdSignerSetTxDelta() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-set-tx-delta [nonce]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "query the signers added, removed and changing power in a signer set compared to the previous one, by default the latest",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			var nonce uint64
+			if len(args) == 1 {
+				if nonce, err = parseNonce(args[0]); err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.SignerSetTxDelta(cmd.Context(), &types.SignerSetTxDeltaRequest{SignerSetNonce: nonce})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdBatchTx() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "batch-tx [contract-address] [nonce]",
@@ -226,7 +309,35 @@ func CmdBatchTxs() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.BatchTxs(cmd.Context(), &types.BatchTxsRequest{Pagination: pageReq})
+			req := &types.BatchTxsRequest{Pagination: pageReq}
+			if req.TokenContract, err = cmd.Flags().GetString(flagTokenContract); err != nil {
+				return err
+			}
+			if req.TokenContract != "" && !common.IsHexAddress(req.TokenContract) {
+				return fmt.Errorf("invalid token contract address: %s", req.TokenContract)
+			}
+			if req.MinTotalFee, err = cmd.Flags().GetString(flagMinTotalFee); err != nil {
+				return err
+			}
+			if req.TimeoutBeforeHeight, err = cmd.Flags().GetUint64(flagTimeoutBeforeHeight); err != nil {
+				return err
+			}
+
+			signatureStatus, err := cmd.Flags().GetString(flagSignatureStatus)
+			if err != nil {
+				return err
+			}
+			switch signatureStatus {
+			case "":
+			case "signed":
+				req.SignatureStatus = types.BATCH_TX_SIGNATURE_STATUS_SIGNED
+			case "unsigned":
+				req.SignatureStatus = types.BATCH_TX_SIGNATURE_STATUS_UNSIGNED
+			default:
+				return fmt.Errorf("invalid signature status %s, expected signed or unsigned", signatureStatus)
+			}
+
+			res, err := queryClient.BatchTxs(cmd.Context(), req)
 			if err != nil {
 				return err
 			}
@@ -235,6 +346,10 @@ func CmdBatchTxs() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagTokenContract, "", "only show batches of this token contract")
+	cmd.Flags().String(flagMinTotalFee, "", "only show batches with at least this total fee")
+	cmd.Flags().Uint64(flagTimeoutBeforeHeight, 0, "only show batches timing out before this ethereum height")
+	cmd.Flags().String(flagSignatureStatus, "", "only show batches that are signed or unsigned by the signer set vote threshold")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "batch-txs")
 	return cmd
@@ -339,6 +454,43 @@ func CmdBatchTxConfirmations() *cobra.Command {
 	return cmd
 }
 
+func CmdBatchTxConfirmationProgress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-tx-confirmation-progress [nonce] [contract-address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query the signed power for a given batch transaction identified by nonce and contract",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			nonce, err := parseNonce(args[0])
+			if err != nil {
+				return err
+			}
+
+			contractAddress, err := parseContractAddress(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BatchTxConfirmationProgress(cmd.Context(), &types.BatchTxConfirmationProgressRequest{
+				BatchNonce:    nonce,
+				TokenContract: contractAddress,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdContractCallTxConfirmations() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "contract-call-tx-ethereum-signatures [invalidation-scope] [invalidation-nonce]",
@@ -375,6 +527,130 @@ func CmdContractCallTxConfirmations() *cobra.Command {
 	return cmd
 }
 
+func CmdSignerSetTxCalldata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-set-tx-calldata [nonce]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the calldata of the gravity contract updateValset call for a signed signer set",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			nonce, err := parseNonce(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SignerSetTxCalldata(cmd.Context(), &types.SignerSetTxCalldataRequest{
+				SignerSetNonce: nonce,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBatchTxCalldata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-tx-calldata [nonce] [contract-address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query the calldata of the gravity contract submitBatch call for a signed batch",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			nonce, err := parseNonce(args[0])
+			if err != nil {
+				return err
+			}
+
+			contractAddress, err := parseContractAddress(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BatchTxCalldata(cmd.Context(), &types.BatchTxCalldataRequest{
+				BatchNonce:    nonce,
+				TokenContract: contractAddress,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdContractCallTxCalldata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-call-tx-calldata [invalidation-scope] [invalidation-nonce]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query the calldata of the gravity contract submitLogicCall call for a signed contract call",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			invalidationNonce, err := parseNonce(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractCallTxCalldata(cmd.Context(), &types.ContractCallTxCalldataRequest{
+				InvalidationScope: []byte(args[0]),
+				InvalidationNonce: invalidationNonce,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdAgreedEthereumHeader() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "agreed-ethereum-header",
+		Args:  cobra.NoArgs,
+		Short: "query the ethereum header agreed on at the latest checkpoint and the header votes of the validators",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AgreedEthereumHeader(cmd.Context(), &types.AgreedEthereumHeaderRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdUnsignedSignerSetTxs() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "pending-signer-set-tx-ethereum-signatures [validator-or-orchestrator-acc-address]",
@@ -499,22 +775,23 @@ func CmdLatestSignerSetTx() *cobra.Command {
 
 func CmdLastSubmittedEthereumEvent() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "last-submitted-ethereum-event [validator-or-orchestrator-acc-address]",
+		Use:   "last-submitted-ethereum-event [validator-or-orchestrator-address]",
 		Args:  cobra.ExactArgs(1),
-		Short: "query for the last event nonce that was submitted by a given validator",
+		Short: "query for the last event nonce and ethereum height vote submitted by a given validator",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
 			if err != nil {
 				return err
 			}
 
-			address, err := sdk.AccAddressFromBech32(args[0])
-			if err != nil {
-				return err
+			if _, err := sdk.ValAddressFromBech32(args[0]); err != nil {
+				if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+					return err
+				}
 			}
 
 			res, err := queryClient.LastSubmittedEthereumEvent(cmd.Context(), &types.LastSubmittedEthereumEventRequest{
-				Address: address.String(),
+				Address: args[0],
 			})
 
 			if err != nil {
@@ -554,6 +831,46 @@ func CmdBatchTxFees() *cobra.Command {
 	return cmd
 }
 
+func CmdBatchFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-fees [max-elements]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "query the fees of the unbatched txs of every token, with the fees of the next batch of max-elements txs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var maxElements uint64
+			if len(args) == 1 {
+				if maxElements, err = strconv.ParseUint(args[0], 10, 64); err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.BatchFees(cmd.Context(), &types.BatchFeesRequest{
+				MaxElements: maxElements,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "batch-fees")
+	return cmd
+}
+
 func CmdERC20ToDenom() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "erc20-to-denom [erc20]",
@@ -649,32 +966,25 @@ func CmdDenomToERC20() *cobra.Command {
 	return cmd
 }
 
-func CmdUnbatchedSendToEthereums() *cobra.Command {
+func CmdBatchedSendToEthereums() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "unbatched-send-to-ethereums [sender-address]",
-		Args:  cobra.MaximumNArgs(1),
-		Short: "query all unbatched send to ethereum messages",
+		Use:   "batched-send-to-ethereums [sender-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the send to ethereum messages of a sender in batches waiting to be relayed",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
 			if err != nil {
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
 			sender, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.UnbatchedSendToEthereums(cmd.Context(), &types.UnbatchedSendToEthereumsRequest{
+			res, err := queryClient.BatchedSendToEthereums(cmd.Context(), &types.BatchedSendToEthereumsRequest{
 				SenderAddress: sender.String(),
-				Pagination:    pageReq,
 			})
-
 			if err != nil {
 				return err
 			}
@@ -684,28 +994,33 @@ func CmdUnbatchedSendToEthereums() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
-	flags.AddPaginationFlagsToCmd(cmd, "unbatched-send-to-ethereums")
 	return cmd
 }
 
-func CmdDelegateKeysByValidator() *cobra.Command {
+func CmdUnbatchedSendToEthereums() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "delegate-keys-by-validator [validator-address]",
+		Use:   "unbatched-send-to-ethereums [sender-address]",
 		Args:  cobra.ExactArgs(1),
-		Short: "query which public keys/addresses a validator has delegated to",
+		Short: "query all unbatched send to ethereum messages",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
 			if err != nil {
 				return err
 			}
 
-			validatorAddress, err := sdk.ValAddressFromBech32(args[0])
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.DelegateKeysByValidator(cmd.Context(), &types.DelegateKeysByValidatorRequest{
-				ValidatorAddress: validatorAddress.String(),
+			sender, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UnbatchedSendToEthereums(cmd.Context(), &types.UnbatchedSendToEthereumsRequest{
+				SenderAddress: sender.String(),
+				Pagination:    pageReq,
 			})
 
 			if err != nil {
@@ -717,32 +1032,303 @@ func CmdDelegateKeysByValidator() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbatched-send-to-ethereums")
 	return cmd
 }
 
-func CmdDelegateKeysByEthereumSigner() *cobra.Command {
+func CmdQueuedSendToCosmosEvents() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "delegate-keys-by-ethereum-signer [ethereum-signer]",
-		Args:  cobra.ExactArgs(1),
-		Short: "query the valdiator and orchestartor keys for a given ethsigner",
+		Use:   "queued-send-to-cosmos-events [contract-address]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "query deposits waiting on a mint rate limit, optionally for a single token",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
 			if err != nil {
 				return err
 			}
 
-			if !common.IsHexAddress(args[0]) {
-				return fmt.Errorf("address is not an etheruem address")
-			}
-
-			res, err := queryClient.DelegateKeysByEthereumSigner(cmd.Context(), &types.DelegateKeysByEthereumSignerRequest{
-				EthereumSigner: args[0],
-			})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			var contractAddress string
+			if len(args) == 1 {
+				if contractAddress, err = parseContractAddress(args[0]); err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.QueuedSendToCosmosEvents(cmd.Context(), &types.QueuedSendToCosmosEventsRequest{
+				TokenContract: contractAddress,
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queued-send-to-cosmos-events")
+	return cmd
+}
+
+func CmdHeldSendToCosmosEvents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "held-send-to-cosmos-events [contract-address]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "query deposits held off the token allowlist, optionally for a single token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var contractAddress string
+			if len(args) == 1 {
+				if contractAddress, err = parseContractAddress(args[0]); err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.HeldSendToCosmosEvents(cmd.Context(), &types.HeldSendToCosmosEventsRequest{
+				TokenContract: contractAddress,
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "held-send-to-cosmos-events")
+	return cmd
+}
+
+func CmdExecutedBatchTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "executed-batch-txs [contract-address]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "query the archived records of executed batches, optionally for a single token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var contractAddress string
+			if len(args) == 1 {
+				if contractAddress, err = parseContractAddress(args[0]); err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.ExecutedBatchTxs(cmd.Context(), &types.ExecutedBatchTxsRequest{
+				TokenContract: contractAddress,
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "executed-batch-txs")
+	return cmd
+}
+
+func CmdExecutedContractCallTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "executed-contract-call-txs [contract-address]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "query the archived records of executed contract calls, optionally for a single logic contract",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var contractAddress string
+			if len(args) == 1 {
+				if contractAddress, err = parseContractAddress(args[0]); err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.ExecutedContractCallTxs(cmd.Context(), &types.ExecutedContractCallTxsRequest{
+				Address:    contractAddress,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "executed-contract-call-txs")
+	return cmd
+}
+
+func CmdRefundedContractCallTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refunded-contract-call-txs [refundee]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "query the archived records of expired or invalidated contract calls whose escrow was refunded, optionally to a single account address or module name",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var refundee string
+			if len(args) == 1 {
+				refundee = args[0]
+			}
+
+			res, err := queryClient.RefundedContractCallTxs(cmd.Context(), &types.RefundedContractCallTxsRequest{
+				Refundee:   refundee,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "refunded-contract-call-txs")
+	return cmd
+}
+
+func CmdBridgeFeeAllowances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-fee-allowances",
+		Args:  cobra.NoArgs,
+		Short: "query the bridge fee allowances, optionally of a granter or to a grantee",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.BridgeFeeAllowancesRequest{Pagination: pageReq}
+			if req.Granter, err = cmd.Flags().GetString(flagGranter); err != nil {
+				return err
+			}
+			if req.Grantee, err = cmd.Flags().GetString(flagGrantee); err != nil {
+				return err
+			}
+
+			res, err := queryClient.BridgeFeeAllowances(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bridge-fee-allowances")
+	cmd.Flags().String(flagGranter, "", "only show the allowances of this granter")
+	cmd.Flags().String(flagGrantee, "", "only show the allowances to this grantee")
+	return cmd
+}
+
+func CmdDelegateKeysByValidator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-keys-by-validator [validator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query which public keys/addresses a validator has delegated to",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			validatorAddress, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegateKeysByValidator(cmd.Context(), &types.DelegateKeysByValidatorRequest{
+				ValidatorAddress: validatorAddress.String(),
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdDelegateKeysByEthereumSigner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-keys-by-ethereum-signer [ethereum-signer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the valdiator and orchestartor keys for a given ethsigner",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("address is not an etheruem address")
+			}
+
+			res, err := queryClient.DelegateKeysByEthereumSigner(cmd.Context(), &types.DelegateKeysByEthereumSignerRequest{
+				EthereumSigner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
 		},
 	}
 
@@ -830,6 +1416,30 @@ func CmdLastObservedEthereumHeight() *cobra.Command {
 	return cmd
 }
 
+func CmdProjectedEthereumHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-ethereum-height",
+		Args:  cobra.NoArgs,
+		Short: "query the projected ethereum height and the timeout a batch created now would get",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProjectedEthereumHeight(cmd.Context(), &types.ProjectedEthereumHeightRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func newContextAndQueryClient(cmd *cobra.Command) (client.Context, types.QueryClient, error) {
 	clientCtx, err := client.GetClientQueryContext(cmd)
 	if err != nil {
@@ -860,3 +1470,651 @@ func parseNonce(s string) (uint64, error) {
 	}
 	return nonce, nil
 }
+
+func parseHeight(s string) (uint64, error) {
+	height, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("height %s not a valid uint, please input a valid height", s)
+	}
+	return height, nil
+}
+
+func CmdEthereumBlocklist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ethereum-blocklist",
+		Args:  cobra.NoArgs,
+		Short: "query the ethereum addresses blocklisted by governance",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := types.EthereumBlocklistRequest{}
+
+			res, err := queryClient.EthereumBlocklist(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdModuleAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-accounts",
+		Args:  cobra.NoArgs,
+		Short: "query the module accounts used by the bridge with their balances",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := types.ModuleAccountsRequest{}
+
+			res, err := queryClient.ModuleAccounts(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdEndBlockerActions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "end-blocker-actions",
+		Args:  cobra.NoArgs,
+		Short: "query the most recent decisions taken by the module while processing blocks, newest first",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetUint64(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			req := types.EndBlockerActionsRequest{Limit: limit}
+
+			res, err := queryClient.EndBlockerActions(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagLimit, 0, "maximum number of actions to return, all the recorded ones if zero")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSignerSetTxsByHeightRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-set-txs-by-height-range [start-height] [end-height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query the signer sets that took effect on ethereum between two ethereum block heights, inclusive; an end height of 0 is unbounded",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := parseHeight(args[0])
+			if err != nil {
+				return err
+			}
+
+			endHeight, err := parseHeight(args[1])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := types.SignerSetTxsByHeightRangeRequest{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				Pagination:  pageReq,
+			}
+
+			res, err := queryClient.SignerSetTxsByHeightRange(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "signer-set-txs-by-height-range")
+	return cmd
+}
+
+func CmdBridgeConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-config [output-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "export the bridge params, delegate keys, token mappings and latest signer set to a file as gravity genesis state",
+		Long: `Export the bridge params, delegate keys, token mappings and latest signer set to a file as gravity genesis state.
+The file can be loaded into the genesis of a test or fork environment with the import-bridge-config command.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BridgeConfig(cmd.Context(), &types.BridgeConfigRequest{})
+			if err != nil {
+				return err
+			}
+
+			bz, err := clientCtx.Codec.MarshalJSON(&res.Config)
+			if err != nil {
+				return err
+			}
+
+			return os.WriteFile(args[0], bz, 0o644)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdReplayDiff() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-diff",
+		Args:  cobra.NoArgs,
+		Short: "rebuild the derived bridge state from the log of observed events and diff it against the live state",
+		Long: `Rebuild the last observed event nonce and ethereum height, the last observed signer set and the bridged
+supplies by replaying the log of observed ethereum events, and print every field the live state disagrees on. An
+empty list means no corruption was detected.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ReplayDiff(cmd.Context(), &types.ReplayDiffRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdPendingERC20Deployments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-erc20-deployments",
+		Args:  cobra.NoArgs,
+		Short: "query the observed ERC20 deployments awaiting confirmation",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingERC20Deployments(cmd.Context(), &types.PendingERC20DeploymentsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdERC20Conversion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc20-conversion [erc20]",
+		Args:  cobra.ExactArgs(1),
+		Short: "given an erc20 contract address return the decimal conversion to its cosmos denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := parseContractAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ERC20Conversion(cmd.Context(), &types.ERC20ConversionRequest{
+				Erc20: contract,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdTokenPauses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-pauses",
+		Args:  cobra.NoArgs,
+		Short: "query the tokens whose sends to ethereum are paused on an attested ethereum anomaly",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenPauses(cmd.Context(), &types.TokenPausesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdOrchestratorQueryIdentity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orchestrator-query-identity [api-key]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the orchestrator that registered an API key for query prioritization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.OrchestratorQueryIdentityRequest{
+				KeyHash: types.HashOrchestratorQueryKey(args[0]),
+			}
+
+			res, err := queryClient.OrchestratorQueryIdentity(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSignerSetTxCheckpoint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-set-tx-checkpoint [nonce]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the checkpoint validators sign for a signer set transaction",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			nonce, err := parseNonce(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SignerSetTxCheckpoint(cmd.Context(), &types.SignerSetTxRequest{SignerSetNonce: nonce})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBatchTxCheckpoint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-tx-checkpoint [contract-address] [nonce]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query the checkpoint validators sign for an outgoing batch",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddress, err := parseContractAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			nonce, err := parseNonce(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BatchTxCheckpoint(cmd.Context(), &types.BatchTxRequest{
+				TokenContract: contractAddress,
+				BatchNonce:    nonce,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdContractCallTxCheckpoint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-call-tx-checkpoint [invalidation-scope] [invalidation-nonce]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query the checkpoint validators sign for an outgoing contract call",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			invalidationNonce, err := parseNonce(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractCallTxCheckpoint(cmd.Context(), &types.ContractCallTxRequest{
+				InvalidationScope: []byte(args[0]),
+				InvalidationNonce: invalidationNonce,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdDelayedSendToEthereums() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delayed-send-to-ethereums [sender-address]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "query the sends to ethereum held in the delayed send queue, optionally of a sender",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.DelayedSendToEthereumsRequest{Pagination: pageReq}
+			if len(args) == 1 {
+				sender, err := sdk.AccAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+				req.SenderAddress = sender.String()
+			}
+
+			res, err := queryClient.DelayedSendToEthereums(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delayed-send-to-ethereums")
+	return cmd
+}
+
+func CmdEventByEthereumTxHash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "event-by-ethereum-tx-hash [ethereum-tx-hash]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the ethereum events an ethereum transaction emitted, observed or still being voted on",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EventByEthereumTxHash(cmd.Context(), &types.EventByEthereumTxHashRequest{
+				EthereumTxHash: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdConflictingEthereumEvents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conflicting-ethereum-events",
+		Args:  cobra.NoArgs,
+		Short: "query the event nonces orchestrators voted on different ethereum events at",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ConflictingEthereumEvents(cmd.Context(), &types.ConflictingEthereumEventsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBridgeStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-stats [token-contract]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "query the volume, tx counts and average fees bridged over the last day and week, by default of every token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := types.BridgeStatsRequest{}
+			if len(args) == 1 {
+				req.TokenContract = args[0]
+			}
+
+			res, err := queryClient.BridgeStats(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdPendingEventVoteRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-event-vote-records [validator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the ethereum events a validator has not voted on yet",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			validatorAddress, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingEventVoteRecords(cmd.Context(), &types.PendingEventVoteRecordsRequest{
+				ValidatorAddress: validatorAddress.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBridgeValidatorLiveness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-validator-liveness",
+		Args:  cobra.NoArgs,
+		Short: "query how long ago each bonded validator last signed an outgoing tx and voted on an ethereum event",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BridgeValidatorLiveness(cmd.Context(), &types.BridgeValidatorLivenessRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBatchTxInclusionProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-tx-inclusion-proof [contract-address] [nonce] [send-to-ethereum-id]",
+		Args:  cobra.ExactArgs(3),
+		Short: "query a merkle proof that a send to ethereum is included in an outgoing batch",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddress, err := parseContractAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			nonce, err := parseNonce(args[1])
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BatchTxInclusionProof(cmd.Context(), &types.BatchTxInclusionProofRequest{
+				TokenContract:    contractAddress,
+				BatchNonce:       nonce,
+				SendToEthereumId: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBridgeValidatorInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-validator-info [validator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the delegate keys, liveness and missed outgoing tx signatures of a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			validatorAddress, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BridgeValidatorInfo(cmd.Context(), &types.BridgeValidatorInfoRequest{
+				ValidatorAddress: validatorAddress.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
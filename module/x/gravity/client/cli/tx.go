@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -32,6 +35,23 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		CmdCancelSendToEthereum(),
 		CmdRequestBatchTx(),
 		CmdSetDelegateKeys(),
+		CmdUpdateDelegateKeys(),
+		CmdRevokeOrchestratorKey(),
+		CmdERC20DeployedConfirm(),
+		CmdEthereumAnomalyReport(),
+		CmdRegisterOrchestratorQueryIdentity(),
+		CmdExecuteAtomic(),
+		CmdVetoDelayedSendToEthereum(),
+		CmdGrantBridgeFeeAllowance(),
+		CmdRevokeBridgeFeeAllowance(),
+		CmdSubmitBadEthereumSignatureEvidence(),
+		CmdSubmitEthereumTxConfirmation(),
+		CmdSubmitEthereumEvent(),
+		CmdSubmitAggregatedEthereumEvent(),
+		CmdSubmitEthereumHeightVote(),
+		CmdSubmitEthereumHeaderVote(),
+		CmdOptOutOfBridge(),
+		CmdFreezeOrchestrator(),
 	)
 
 	return gravityTxCmd
@@ -70,6 +90,12 @@ func CmdSendToEthereum() *cobra.Command {
 			}
 
 			msg := types.NewMsgSendToEthereum(from, common.HexToAddress(args[0]).Hex(), sendCoin, feeCoin)
+			if msg.FeeGranter, err = cmd.Flags().GetString(flagBridgeFeeGranter); err != nil {
+				return err
+			}
+			if msg.ExecuteAfterHeight, err = cmd.Flags().GetUint64(flagExecuteAfterHeight); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -79,6 +105,8 @@ func CmdSendToEthereum() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flagBridgeFeeGranter, "", "account paying the fee-coins out of the bridge fee allowance it granted the sender")
+	cmd.Flags().Uint64(flagExecuteAfterHeight, 0, "cosmos block height the send can only be batched after, it is escrowed right away")
 	return cmd
 }
 
@@ -121,6 +149,9 @@ func CmdRequestBatchTx() *cobra.Command {
 		Use:   "request-batch-tx [denom] [signer]",
 		Args:  cobra.ExactArgs(2),
 		Short: "Request batch transaction for denom by signer",
+		Long: `Request batch transaction for denom by signer. The batch holds at most --max-elements
+transactions, or the BatchMaxElement param if unset, and is only created if their total fee,
+in units of the ERC20 token, is at least --min-fee.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -133,7 +164,24 @@ func CmdRequestBatchTx() *cobra.Command {
 				return err
 			}
 
+			maxElements, err := cmd.Flags().GetUint64(flagMaxElements)
+			if err != nil {
+				return err
+			}
+
+			minFee := sdk.ZeroInt()
+			if minFeeStr, err := cmd.Flags().GetString(flagMinFee); err != nil {
+				return err
+			} else if minFeeStr != "" {
+				var ok bool
+				if minFee, ok = sdk.NewIntFromString(minFeeStr); !ok {
+					return fmt.Errorf("invalid min fee: %s", minFeeStr)
+				}
+			}
+
 			msg := types.NewMsgRequestBatchTx(denom, signer)
+			msg.MaxElements = maxElements
+			msg.MinFee = minFee
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -141,6 +189,8 @@ func CmdRequestBatchTx() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagMaxElements, 0, "maximum number of transactions in the batch, defaults to the BatchMaxElement param")
+	cmd.Flags().String(flagMinFee, "", "minimum total fee of the batch in units of the ERC20 token")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -152,7 +202,8 @@ func CmdSetDelegateKeys() *cobra.Command {
 		Short: "Set gravity delegate keys",
 		Long: `Set a validator's Ethereum and orchestrator addresses. The validator must
 sign over a binary Proto-encoded DelegateKeysSignMsg message. The message contains
-the validator's address and operator account current nonce.`,
+the validator's address and operator account current nonce. The validator signs outgoing
+txs with ECDSA unless another scheme supported by the chain is selected with --signature-scheme.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -179,7 +230,170 @@ the validator's address and operator account current nonce.`,
 				return err
 			}
 
+			schemeName, err := cmd.Flags().GetString(flagSignatureScheme)
+			if err != nil {
+				return err
+			}
+			scheme, ok := types.SignatureScheme_value[schemeName]
+			if !ok {
+				return fmt.Errorf("unknown signature scheme %s", schemeName)
+			}
+
 			msg := types.NewMsgDelegateKeys(valAddr, orcAddr, ethAddr, ethSig)
+			msg.SignatureScheme = types.SignatureScheme(scheme)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagSignatureScheme, types.SIGNATURE_SCHEME_ECDSA.String(), "scheme of the validator's signatures over outgoing txs")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUpdateDelegateKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-delegate-keys [validator-address] [orchestrator-address] [ethereum-address] [ethereum-signature]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Rotate gravity delegate keys",
+		Long: `Rotate a validator's Ethereum and orchestrator addresses. The new orchestrator
+takes effect immediately, while the new Ethereum address only takes effect once a signer
+set including it is observed on Ethereum. The signature is made the same way as for
+set-delegate-keys, with the new Ethereum key.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			orcAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			ethAddr, err := parseContractAddress(args[2])
+			if err != nil {
+				return err
+			}
+
+			ethSig, err := hexutil.Decode(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateDelegateKeys(valAddr, orcAddr, ethAddr, ethSig)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRevokeOrchestratorKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-orchestrator-key [validator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Revoke a validator's gravity orchestrator key",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeOrchestratorKey(valAddr)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdERC20DeployedConfirm() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc20-deployed-confirm [denom] [token-contract]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Confirm a pending ERC20 deployment as the representation of a cosmos originated denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			if !common.IsHexAddress(args[1]) {
+				return fmt.Errorf("must be a valid ethereum address got %s", args[1])
+			}
+
+			msg := types.NewMsgERC20DeployedConfirm(args[0], common.HexToAddress(args[1]), from)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdEthereumAnomalyReport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report-ethereum-anomaly [token-contract] [anomaly]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Report an ethereum-side anomaly of a token, pausing its sends to ethereum once attested",
+		Long: strings.TrimSpace(`Report an ethereum-side anomaly of a token as one of ETHEREUM_ANOMALY_TOKEN_PAUSED,
+ETHEREUM_ANOMALY_BRIDGE_BLACKLISTED or ETHEREUM_ANOMALY_RESOLVED. Once validators holding the event vote
+threshold of power report the same anomaly, sends of the token to ethereum are paused, or resumed when the
+anomaly is resolved.`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("must be a valid ethereum address got %s", args[0])
+			}
+
+			anomaly, ok := types.EthereumAnomaly_value[args[1]]
+			if !ok {
+				return fmt.Errorf("unknown ethereum anomaly %s", args[1])
+			}
+
+			msg := types.NewMsgEthereumAnomalyReport(common.HexToAddress(args[0]), types.EthereumAnomaly(anomaly), from)
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -278,3 +492,929 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+func CmdSubmitEthereumBlocklistProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ethereum-blocklist [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an Ethereum blocklist proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an Ethereum blocklist proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Sends to Ethereum with a blocklisted
+recipient are rejected, and deposits from a blocklisted Ethereum sender are credited to the
+community pool instead of their receiver.
+
+Example:
+$ %s tx gov submit-proposal ethereum-blocklist <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Ethereum Blocklist",
+	"description": "Block a sanctioned address",
+	"add_addresses": ["0x0000000000000000000000000000000000000001"],
+	"remove_addresses": [],
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseEthereumBlocklistProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewEthereumBlocklistProposal(proposal.Title, proposal.Description, proposal.AddAddresses, proposal.RemoveAddresses)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitBridgeReenableProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-reenable [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to re-enable the bridge",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to re-enable the bridge along with an initial deposit.
+The proposal details must be supplied via a JSON file. The bridge is turned back on after it
+was halted, either manually or by the circuit breaker.
+
+Example:
+$ %s tx gov submit-proposal bridge-reenable <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Re-enable the bridge",
+	"description": "The outflow that tripped the circuit breaker was legitimate",
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseBridgeReenableProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewBridgeReenableProposal(proposal.Title, proposal.Description)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdRegisterOrchestratorQueryIdentity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-query-identity [api-key]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Register an API key that gives the orchestrator prioritized access to rate limited query endpoints",
+		Long: strings.TrimSpace(`Register an API key for the orchestrator signing the transaction. Only the hash of the key
+is submitted on chain. Query endpoints that install the gravity rate limit middleware exempt requests carrying the
+key in the x-gravity-orchestrator-key header until the registration expires. Registering a new key replaces the
+previous one.`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			msg := types.NewMsgRegisterOrchestratorQueryIdentity(args[0], from)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdExecuteAtomic() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute-atomic [tx-json-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Execute the gravity messages of a generated tx all or nothing",
+		Long: strings.TrimSpace(fmt.Sprintf(`Execute the gravity messages of a tx generated with --%s in order, applying
+none of them if any fails. All messages must be signed by the sender of this transaction.
+
+Example:
+$ %s tx gravity cancel-send-to-ethereum 1 --from=<key> --%s > cancel.json
+$ %s tx gravity send-to-ethereum <receiver> 100<denom> 5<denom> --from=<key> --%s > send.json
+$ jq -s '.[0].body.messages += .[1].body.messages | .[0]' cancel.json send.json > tx.json
+$ %s tx gravity execute-atomic tx.json --from=<key>`,
+			flags.FlagGenerateOnly, version.AppName, flags.FlagGenerateOnly,
+			version.AppName, flags.FlagGenerateOnly, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgExecuteAtomic(theTx.GetMsgs(), from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdVetoDelayedSendToEthereum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "veto-delayed-send-to-ethereum [id]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Veto sends to ethereum held in the delayed send queue, as the security council",
+		Long: strings.TrimSpace(`Veto sends to ethereum held in the delayed send queue by id, returning their amount and
+fees to the senders. The transaction must be signed by the security council account set in the gravity params.
+Governance can veto them with a delayed-send-to-ethereum-veto proposal instead.`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			ids, err := parseDelayedSendToEthereumIDs(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVetoDelayedSendToEthereum(ids, from)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGrantBridgeFeeAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-bridge-fee-allowance [grantee] [spend-limit]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Let an account have the fees of its sends to Ethereum paid by the sender, up to a spend limit",
+		Long: strings.TrimSpace(`Grant an account a bridge fee allowance, so that the fees of its sends to Ethereum naming
+the sender with --bridge-fee-granter are paid by the sender, up to the spend limit. The grant replaces any
+allowance the sender already granted the account. Fees of cancelled sends are refunded to the sender.`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantBridgeFeeAllowance(from, grantee, spendLimit)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRevokeBridgeFeeAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-bridge-fee-allowance [grantee]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Revoke the bridge fee allowance granted to an account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeBridgeFeeAllowance(from, grantee)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSubmitBadEthereumSignatureEvidence() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-bad-ethereum-signature-evidence [outgoing-tx-json-file] [signature] [ethereum-signer]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit the ethereum signature of a validator over an outgoing tx the chain never created",
+		Long: strings.TrimSpace(`Submit the hex encoded ethereum signature of a validator over the checkpoint of an
+outgoing tx the chain never created, such as a fabricated batch or signer set. The outgoing tx is read as
+JSON from the file, with its @type. The validators signing with the ethereum signer are slashed and jailed.`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var subject types.OutgoingTx
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON(bz, &subject); err != nil {
+				return err
+			}
+
+			signature, err := hexutil.Decode(args[1])
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[2]) {
+				return fmt.Errorf("must be a valid ethereum address got %s", args[2])
+			}
+
+			msg, err := types.NewMsgSubmitBadEthereumSignatureEvidence(subject, signature, common.HexToAddress(args[2]), from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSubmitEthereumTxConfirmation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-ethereum-tx-confirmation [confirmation-json-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit the signature of a validator over an outgoing tx, as its orchestrator",
+		Long: strings.TrimSpace(`Submit the ethereum signature of a validator over the checkpoint of a signer set,
+batch or contract call tx. The confirmation is read as JSON from the file, with its @type, one of
+gravity.v1.SignerSetTxConfirmation, gravity.v1.BatchTxConfirmation or gravity.v1.ContractCallTxConfirmation.
+The transaction must be signed by the orchestrator of the validator.`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var confirmation types.EthereumTxConfirmation
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON(bz, &confirmation); err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitEthereumTxConfirmation(confirmation, from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSubmitEthereumEvent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-ethereum-event [event-json-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit the vote of a validator for an ethereum event, as its orchestrator",
+		Long: strings.TrimSpace(`Submit the vote of a validator for an event observed on ethereum. The event is read
+as JSON from the file, with its @type, such as gravity.v1.SendToCosmosEvent or gravity.v1.BatchExecutedEvent.
+The transaction must be signed by the orchestrator of the validator.`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			event, err := readEthereumEvent(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitEthereumEvent(event, from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSubmitAggregatedEthereumEvent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-aggregated-ethereum-event [event-json-file] [cosignatures-json-file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit the votes of several validators for an ethereum event at once",
+		Long: strings.TrimSpace(`Submit the vote of the signer's validator for an event observed on ethereum along with
+the votes of co-signing validators. The event is read as JSON from the first file, with its @type. The second
+file holds a JSON array of cosignatures, each with a validator_address and the base64 encoded signature of its
+ethereum key over the event's cosignature hash.`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			event, err := readEthereumEvent(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var rawCosignatures []json.RawMessage
+			if err := json.Unmarshal(bz, &rawCosignatures); err != nil {
+				return err
+			}
+			cosignatures := make([]types.EthereumEventCosignature, len(rawCosignatures))
+			for i, raw := range rawCosignatures {
+				if err := clientCtx.Codec.UnmarshalJSON(raw, &cosignatures[i]); err != nil {
+					return fmt.Errorf("cosignature %d: %w", i, err)
+				}
+			}
+
+			msg, err := types.NewMsgSubmitAggregatedEthereumEvent(event, from, cosignatures)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSubmitEthereumHeightVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-ethereum-height-vote [ethereum-height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit the latest ethereum height observed by a validator, as its orchestrator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			height, err := parseHeight(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEthereumHeightVote(height, from)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSubmitEthereumHeaderVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-ethereum-header-vote [ethereum-height] [block-hash] [parent-checkpoint-hash]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Vote for the ethereum header at the next checkpoint height, as the orchestrator of a validator",
+		Long: strings.TrimSpace(`Vote for the hash of the ethereum block at the next checkpoint height, along with the hash
+of the block at the previous checkpoint height, while ethereum header validation is enabled. Once validators
+holding the event vote threshold of power vote for the same header, ethereum events claiming a block beyond
+it are rejected.`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			height, err := parseHeight(args[0])
+			if err != nil {
+				return err
+			}
+
+			hash, err := hexutil.Decode(args[1])
+			if err != nil {
+				return err
+			}
+
+			parentHash, err := hexutil.Decode(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEthereumHeaderVote(types.EthereumHeader{
+				Height:               height,
+				Hash:                 hash,
+				ParentCheckpointHash: parentHash,
+			}, from)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// readEthereumEvent reads an ethereum event from a JSON file, with its @type
+func readEthereumEvent(clientCtx client.Context, path string) (types.EthereumEvent, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var event types.EthereumEvent
+	if err := clientCtx.Codec.UnmarshalInterfaceJSON(bz, &event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func CmdSubmitDelayedSendToEthereumVetoProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delayed-send-to-ethereum-veto [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to veto delayed sends to Ethereum",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to veto sends to Ethereum held in the delayed send queue along with an
+initial deposit. The proposal details must be supplied via a JSON file. The amount and fees of the vetoed sends
+are returned to their senders. The proposal fails if any of the sends was released before it passed.
+
+Example:
+$ %s tx gov submit-proposal delayed-send-to-ethereum-veto <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Veto delayed sends to Ethereum",
+	"description": "The sends were made with a compromised key",
+	"ids": [42, 43],
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseDelayedSendToEthereumVetoProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewDelayedSendToEthereumVetoProposal(proposal.Title, proposal.Description, proposal.Ids)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitHeldSendToCosmosReleaseProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "held-send-to-cosmos-release [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to release deposits held off the token allowlist",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to release deposits of a token that were held because the token is not on
+the bridge allowlist, along with an initial deposit. The proposal details must be supplied via a JSON file.
+Released deposits are credited to their receivers as if the token had been allowlisted.
+
+Example:
+$ %s tx gov submit-proposal held-send-to-cosmos-release <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Release held deposits",
+	"description": "Credit the deposits sent before the token was allowlisted",
+	"token_contract": "0xc783df8a850f42e7F7e57013759C285caa701eB6",
+	"event_nonces": [42, 43],
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseHeldSendToCosmosReleaseProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewHeldSendToCosmosReleaseProposal(proposal.Title, proposal.Description, proposal.TokenContract, proposal.EventNonces)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitEmergencySignerSetUpdateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emergency-signer-set-update [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to force a new signer set, excluding compromised ethereum keys",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to create a new signer set tx as soon as it passes, along with an initial deposit.
+The proposal details must be supplied via a JSON file. The listed ethereum addresses are excluded from this and
+every later signer set; their validators rejoin the signer set by rotating their ethereum keys.
+
+Example:
+$ %s tx gov submit-proposal emergency-signer-set-update <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Emergency signer set update",
+	"description": "Remove the leaked orchestrator key from the bridge",
+	"excluded_ethereum_addresses": ["0xc783df8a850f42e7F7e57013759C285caa701eB6"],
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseEmergencySignerSetUpdateProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewEmergencySignerSetUpdateProposal(proposal.Title, proposal.Description, proposal.ExcludedEthereumAddresses)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitSendToEthereumPriorityProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-to-ethereum-priority [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to prioritize sends to Ethereum in their batches",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to prioritize unbatched or delayed sends to Ethereum along with an initial
+deposit. The proposal details must be supplied via a JSON file. Prioritized sends go into the next batch of their
+token before the others, whatever their fee. The proposal fails if any of the sends was batched before it passed.
+
+Example:
+$ %s tx gov submit-proposal send-to-ethereum-priority <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Prioritize the liquidity rebalancing",
+	"description": "Move the protocol owned liquidity to ethereum ahead of the fee auction",
+	"ids": [42, 43],
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseSendToEthereumPriorityProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewSendToEthereumPriorityProposal(proposal.Title, proposal.Description, proposal.Ids)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// parseDelayedSendToEthereumIDs parses the ids of delayed sends to ethereum
+func parseDelayedSendToEthereumIDs(args []string) ([]uint64, error) {
+	ids := make([]uint64, len(args))
+	for i, arg := range args {
+		id, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid id %s: %w", arg, err)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+func CmdOptOutOfBridge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "opt-out-of-bridge [validator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Opt a validator out of the bridge duties",
+		Long: strings.TrimSpace(`Opt a validator out of the bridge duties. Its power is left out of the next
+signer sets and it is no longer slashed for missing signatures, but the orchestrator of its ethereum
+address is no longer paid relayer rewards. The transaction must be signed by the validator operator.`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOptOutOfBridge(valAddr)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdFreezeOrchestrator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-orchestrator [validator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Freeze the compromised delegate keys of a validator",
+		Long: strings.TrimSpace(`Freeze the orchestrator and ethereum address of a validator whose keys are compromised.
+The signatures and events of the keys are rejected at once, without unbonding the validator. New delegate
+keys can only be set once the orchestrator freeze cooldown has passed. The transaction must be signed by the
+validator operator.`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFreezeOrchestrator(valAddr)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -22,3 +22,99 @@ func ParseCommunityPoolEthereumSpendProposal(cdc codec.JSONCodec, proposalFile s
 
 	return proposal, nil
 }
+
+// ParseEthereumBlocklistProposal reads and parses an EthereumBlocklistProposalForCLI from a file.
+func ParseEthereumBlocklistProposal(cdc codec.JSONCodec, proposalFile string) (types.EthereumBlocklistProposalForCLI, error) {
+	proposal := types.EthereumBlocklistProposalForCLI{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseBridgeReenableProposal reads and parses a BridgeReenableProposalForCLI from a file.
+func ParseBridgeReenableProposal(cdc codec.JSONCodec, proposalFile string) (types.BridgeReenableProposalForCLI, error) {
+	proposal := types.BridgeReenableProposalForCLI{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseDelayedSendToEthereumVetoProposal reads and parses a DelayedSendToEthereumVetoProposalForCLI from a file.
+func ParseDelayedSendToEthereumVetoProposal(cdc codec.JSONCodec, proposalFile string) (types.DelayedSendToEthereumVetoProposalForCLI, error) {
+	proposal := types.DelayedSendToEthereumVetoProposalForCLI{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseHeldSendToCosmosReleaseProposal reads and parses a HeldSendToCosmosReleaseProposalForCLI from a file.
+func ParseHeldSendToCosmosReleaseProposal(cdc codec.JSONCodec, proposalFile string) (types.HeldSendToCosmosReleaseProposalForCLI, error) {
+	proposal := types.HeldSendToCosmosReleaseProposalForCLI{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseEmergencySignerSetUpdateProposal reads and parses an EmergencySignerSetUpdateProposalForCLI from a file.
+func ParseEmergencySignerSetUpdateProposal(cdc codec.JSONCodec, proposalFile string) (types.EmergencySignerSetUpdateProposalForCLI, error) {
+	proposal := types.EmergencySignerSetUpdateProposalForCLI{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseSendToEthereumPriorityProposal reads and parses a SendToEthereumPriorityProposalForCLI from a file.
+func ParseSendToEthereumPriorityProposal(cdc codec.JSONCodec, proposalFile string) (types.SendToEthereumPriorityProposalForCLI, error) {
+	proposal := types.SendToEthereumPriorityProposalForCLI{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -6,6 +6,18 @@ import (
 )
 
 // ProposalHandler is the community Ethereum spend proposal handler.
+// EthereumBlocklistProposalHandler is the Ethereum blocklist proposal handler.
+// BridgeReenableProposalHandler is the bridge re-enable proposal handler.
+// DelayedSendToEthereumVetoProposalHandler is the delayed send to Ethereum veto proposal handler.
+// HeldSendToCosmosReleaseProposalHandler is the held send to Cosmos release proposal handler.
+// EmergencySignerSetUpdateProposalHandler is the emergency signer set update proposal handler.
+// SendToEthereumPriorityProposalHandler is the send to Ethereum priority proposal handler.
 var (
-	ProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitCommunityPoolEthereumSpendProposal)
+	ProposalHandler                          = govclient.NewProposalHandler(cli.CmdSubmitCommunityPoolEthereumSpendProposal)
+	EthereumBlocklistProposalHandler         = govclient.NewProposalHandler(cli.CmdSubmitEthereumBlocklistProposal)
+	BridgeReenableProposalHandler            = govclient.NewProposalHandler(cli.CmdSubmitBridgeReenableProposal)
+	DelayedSendToEthereumVetoProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitDelayedSendToEthereumVetoProposal)
+	HeldSendToCosmosReleaseProposalHandler   = govclient.NewProposalHandler(cli.CmdSubmitHeldSendToCosmosReleaseProposal)
+	EmergencySignerSetUpdateProposalHandler  = govclient.NewProposalHandler(cli.CmdSubmitEmergencySignerSetUpdateProposal)
+	SendToEthereumPriorityProposalHandler    = govclient.NewProposalHandler(cli.CmdSubmitSendToEthereumPriorityProposal)
 )
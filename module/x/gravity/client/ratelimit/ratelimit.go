@@ -0,0 +1,252 @@
+// Package ratelimit provides a gRPC middleware that node operators can install
+// in front of their query endpoints to rate limit anonymous callers while
+// letting orchestrators through.
+//
+// Orchestrators register the hash of an API key on chain with
+// MsgRegisterOrchestratorQueryIdentity and send the key itself in the
+// x-gravity-orchestrator-key header. Requests carrying a key that is known to
+// be registered and not expired skip the limiter. Every other request takes a
+// token from the limiter of its peer address before the key, if any, is looked
+// up on chain, so unregistered keys cannot be used to flood the node with
+// identity queries.
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+const (
+	// HeaderOrchestratorKey is the metadata key orchestrators send their API key in
+	HeaderOrchestratorKey = "x-gravity-orchestrator-key"
+
+	// MaxCachedIdentities is the number of API keys an IdentityChecker
+	// remembers answers for
+	MaxCachedIdentities = 10000
+
+	// MaxLimitedCallers is the number of callers a Limiter keeps buckets for
+	MaxLimitedCallers = 10000
+)
+
+// IdentityChecker looks up whether an API key is registered by an
+// orchestrator, caching the answers so that the chain is not queried on every
+// request
+type IdentityChecker struct {
+	queryClient types.QueryClient
+	ttl         time.Duration
+	maxEntries  int
+
+	mu    sync.Mutex
+	cache map[string]cachedIdentity
+}
+
+type cachedIdentity struct {
+	registered bool
+	expiresAt  time.Time
+}
+
+// NewIdentityChecker returns an IdentityChecker querying the gravity module
+// through queryClient, caching the answers for ttl
+func NewIdentityChecker(queryClient types.QueryClient, ttl time.Duration) *IdentityChecker {
+	return &IdentityChecker{
+		queryClient: queryClient,
+		ttl:         ttl,
+		maxEntries:  MaxCachedIdentities,
+		cache:       make(map[string]cachedIdentity),
+	}
+}
+
+// Cached returns the cached answer for the API key, ok is false if there is no
+// answer or it expired. It never queries the chain.
+func (c *IdentityChecker) Cached(apiKey string, now time.Time) (registered bool, ok bool) {
+	keyHash := string(types.HashOrchestratorQueryKey(apiKey))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, found := c.cache[keyHash]
+	if !found || !now.Before(cached.expiresAt) {
+		return false, false
+	}
+	return cached.registered, true
+}
+
+// IsRegistered returns true if an orchestrator registered the API key and the
+// registration has not expired, querying the chain if there is no cached answer
+func (c *IdentityChecker) IsRegistered(ctx context.Context, apiKey string) bool {
+	now := time.Now()
+	if registered, ok := c.Cached(apiKey, now); ok {
+		return registered
+	}
+	keyHash := string(types.HashOrchestratorQueryKey(apiKey))
+
+	_, err := c.queryClient.OrchestratorQueryIdentity(ctx, &types.OrchestratorQueryIdentityRequest{KeyHash: []byte(keyHash)})
+	if err != nil && status.Code(err) != codes.NotFound {
+		// don't cache transient failures, the caller is limited for this request only
+		return false
+	}
+
+	c.mu.Lock()
+	if _, found := c.cache[keyHash]; !found && len(c.cache) >= c.maxEntries {
+		c.evict(now)
+	}
+	c.cache[keyHash] = cachedIdentity{registered: err == nil, expiresAt: now.Add(c.ttl)}
+	c.mu.Unlock()
+
+	return err == nil
+}
+
+// evict makes room in a full cache by dropping the expired answers, or an
+// arbitrary one if none expired. The caller must hold c.mu.
+func (c *IdentityChecker) evict(now time.Time) {
+	for keyHash, cached := range c.cache {
+		if !now.Before(cached.expiresAt) {
+			delete(c.cache, keyHash)
+		}
+	}
+	if len(c.cache) < c.maxEntries {
+		return
+	}
+	for keyHash := range c.cache {
+		delete(c.cache, keyHash)
+		return
+	}
+}
+
+// Limiter is a token bucket limiter keyed by caller
+type Limiter struct {
+	rate       float64
+	burst      float64
+	maxCallers int
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns a Limiter allowing each caller ratePerSecond requests per
+// second on average and bursts of up to burst requests
+func NewLimiter(ratePerSecond float64, burst int) *Limiter {
+	return &Limiter{
+		rate:       ratePerSecond,
+		burst:      float64(burst),
+		maxCallers: MaxLimitedCallers,
+		buckets:    make(map[string]*bucket),
+	}
+}
+
+// Allow takes a token from the bucket of the caller at the time now, returning
+// false if the bucket is empty
+func (l *Limiter) Allow(caller string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[caller]
+	if !ok {
+		if len(l.buckets) >= l.maxCallers {
+			l.evict(now)
+		}
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[caller] = b
+	}
+
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * l.rate
+		if b.tokens > l.burst {
+			b.tokens = l.burst
+		}
+		b.last = now
+	}
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// evict makes room for a new caller by dropping the buckets that refilled to
+// the burst, which behave exactly like new ones, or the least recently used one
+// if none did. The caller must hold l.mu.
+func (l *Limiter) evict(now time.Time) {
+	var (
+		oldest     string
+		oldestLast time.Time
+		found      bool
+	)
+	for caller, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, caller)
+			continue
+		}
+		if !found || b.last.Before(oldestLast) {
+			oldest, oldestLast, found = caller, b.last, true
+		}
+	}
+	if found && len(l.buckets) >= l.maxCallers {
+		delete(l.buckets, oldest)
+	}
+}
+
+// UnaryServerInterceptor returns an interceptor that lets requests carrying a
+// registered orchestrator API key through and rate limits all others by peer
+// address, failing them with codes.ResourceExhausted once limited. Keys
+// without a cached answer are only looked up on chain once the request got
+// past the limiter.
+func UnaryServerInterceptor(checker *IdentityChecker, limiter *Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		now := time.Now()
+		apiKey := orchestratorKey(ctx)
+		if apiKey != "" {
+			if registered, ok := checker.Cached(apiKey, now); ok && registered {
+				return handler(ctx, req)
+			}
+		}
+
+		if !limiter.Allow(callerAddress(ctx), now) {
+			return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s, register an orchestrator query identity for prioritized access", info.FullMethod)
+		}
+
+		if apiKey != "" {
+			// warm the cache so that the next requests with this key skip the limiter
+			checker.IsRegistered(ctx, apiKey)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func orchestratorKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(HeaderOrchestratorKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func callerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+		return host
+	}
+	return p.Addr.String()
+}
@@ -0,0 +1,114 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestLimiter(t *testing.T) {
+	limiter := NewLimiter(1, 2)
+	now := time.Now()
+
+	// the burst is allowed right away, then the bucket is empty
+	require.True(t, limiter.Allow("a", now))
+	require.True(t, limiter.Allow("a", now))
+	require.False(t, limiter.Allow("a", now))
+
+	// callers have their own buckets
+	require.True(t, limiter.Allow("b", now))
+
+	// the bucket refills at the rate, up to the burst
+	require.True(t, limiter.Allow("a", now.Add(time.Second)))
+	require.False(t, limiter.Allow("a", now.Add(time.Second)))
+	require.True(t, limiter.Allow("a", now.Add(time.Hour)))
+	require.True(t, limiter.Allow("a", now.Add(time.Hour)))
+	require.False(t, limiter.Allow("a", now.Add(time.Hour)))
+}
+
+func TestLimiterBounded(t *testing.T) {
+	limiter := NewLimiter(1, 2)
+	limiter.maxCallers = 2
+	now := time.Now()
+
+	require.True(t, limiter.Allow("a", now))
+	require.True(t, limiter.Allow("b", now.Add(time.Millisecond)))
+
+	// a new caller evicts the least recently used bucket
+	require.True(t, limiter.Allow("c", now.Add(2*time.Millisecond)))
+	require.Len(t, limiter.buckets, 2)
+	require.NotContains(t, limiter.buckets, "a")
+
+	// buckets that refilled are dropped first
+	require.True(t, limiter.Allow("d", now.Add(time.Hour)))
+	require.Len(t, limiter.buckets, 1)
+	require.Contains(t, limiter.buckets, "d")
+}
+
+type countingQueryClient struct {
+	types.QueryClient
+
+	registered map[string]bool
+	queries    int
+}
+
+func (c *countingQueryClient) OrchestratorQueryIdentity(ctx context.Context, in *types.OrchestratorQueryIdentityRequest, opts ...grpc.CallOption) (*types.OrchestratorQueryIdentityResponse, error) {
+	c.queries++
+	if !c.registered[string(in.KeyHash)] {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &types.OrchestratorQueryIdentityResponse{}, nil
+}
+
+func TestIdentityCheckerBounded(t *testing.T) {
+	client := &countingQueryClient{}
+	checker := NewIdentityChecker(client, time.Minute)
+	checker.maxEntries = 2
+
+	for _, key := range []string{"a", "b", "c", "d"} {
+		require.False(t, checker.IsRegistered(context.Background(), key))
+		require.LessOrEqual(t, len(checker.cache), 2)
+	}
+	require.Equal(t, 4, client.queries)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	client := &countingQueryClient{
+		registered: map[string]bool{string(types.HashOrchestratorQueryKey("orchestrator")): true},
+	}
+	interceptor := UnaryServerInterceptor(NewIdentityChecker(client, time.Minute), NewLimiter(0, 1))
+	info := &grpc.UnaryServerInfo{FullMethod: "/gravity.v1.Query/Params"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	call := func(peerIP, apiKey string) error {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(peerIP), Port: 1}})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(HeaderOrchestratorKey, apiKey))
+		_, err := interceptor(ctx, nil, info, handler)
+		return err
+	}
+
+	// an unregistered key takes a token before it is looked up, once the peer
+	// is limited the chain is no longer queried
+	require.NoError(t, call("10.0.0.1", "unregistered-1"))
+	require.Equal(t, 1, client.queries)
+	err := call("10.0.0.1", "unregistered-2")
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Equal(t, 1, client.queries)
+
+	// a registered key is looked up after its first request got past the
+	// limiter and skips the limiter from then on without further queries
+	require.NoError(t, call("10.0.0.2", "orchestrator"))
+	require.Equal(t, 2, client.queries)
+	require.NoError(t, call("10.0.0.2", "orchestrator"))
+	require.NoError(t, call("10.0.0.2", "orchestrator"))
+	require.Equal(t, 2, client.queries)
+}
@@ -99,6 +99,14 @@ func addDenomToERC20Relation(tv *testingVars) {
 	a := tv.input.GravityKeeper.GetEthereumEventVoteRecord(tv.ctx, myNonce, deployedEvent.Hash())
 	require.NotNil(tv.t, a)
 
+	// the deployment awaits confirmation before the relation is added
+	_, _, err = tv.input.GravityKeeper.DenomToERC20Lookup(tv.ctx, tv.denom)
+	require.Error(tv.t, err)
+
+	msgConfirm := types.NewMsgERC20DeployedConfirm(tv.denom, common.HexToAddress(tv.erc20), tv.myOrchestratorAddr)
+	_, err = tv.h(tv.ctx, msgConfirm)
+	require.NoError(tv.t, err)
+
 	// check if erc20<>denom relation added to db
 	isCosmosOriginated, gotERC20, err := tv.input.GravityKeeper.DenomToERC20Lookup(tv.ctx, tv.denom)
 	require.NoError(tv.t, err)
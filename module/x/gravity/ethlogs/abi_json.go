@@ -0,0 +1,91 @@
+package ethlogs
+
+// GravityEventsABIJSON is the subset of the Gravity.sol ABI describing the
+// events the module attests to. It must be kept in sync with the event
+// declarations in solidity/contracts/Gravity.sol.
+const GravityEventsABIJSON = `[
+	{
+		"anonymous": false,
+		"name": "TransactionBatchExecutedEvent",
+		"type": "event",
+		"inputs": [
+			{ "indexed": true,  "internalType": "uint256", "name": "_batchNonce", "type": "uint256" },
+			{ "indexed": true,  "internalType": "address", "name": "_token",      "type": "address" },
+			{ "indexed": false, "internalType": "uint256", "name": "_eventNonce", "type": "uint256" },
+			{ "indexed": false, "internalType": "address", "name": "_relayer",    "type": "address" }
+		]
+	},
+	{
+		"anonymous": false,
+		"name": "SendToCosmosEvent",
+		"type": "event",
+		"inputs": [
+			{ "indexed": true,  "internalType": "address", "name": "_tokenContract", "type": "address" },
+			{ "indexed": true,  "internalType": "address", "name": "_sender",        "type": "address" },
+			{ "indexed": true,  "internalType": "bytes32", "name": "_destination",   "type": "bytes32" },
+			{ "indexed": false, "internalType": "uint256", "name": "_amount",        "type": "uint256" },
+			{ "indexed": false, "internalType": "uint256", "name": "_eventNonce",    "type": "uint256" }
+		]
+	},
+	{
+		"anonymous": false,
+		"name": "SendToCosmosWithMemoEvent",
+		"type": "event",
+		"inputs": [
+			{ "indexed": true,  "internalType": "address", "name": "_tokenContract", "type": "address" },
+			{ "indexed": true,  "internalType": "address", "name": "_sender",        "type": "address" },
+			{ "indexed": true,  "internalType": "bytes32", "name": "_destination",   "type": "bytes32" },
+			{ "indexed": false, "internalType": "uint256", "name": "_amount",        "type": "uint256" },
+			{ "indexed": false, "internalType": "uint256", "name": "_eventNonce",    "type": "uint256" },
+			{ "indexed": false, "internalType": "bytes",   "name": "_memo",          "type": "bytes"   }
+		]
+	},
+	{
+		"anonymous": false,
+		"name": "SendEtherToCosmosEvent",
+		"type": "event",
+		"inputs": [
+			{ "indexed": true,  "internalType": "address", "name": "_sender",      "type": "address" },
+			{ "indexed": true,  "internalType": "bytes32", "name": "_destination", "type": "bytes32" },
+			{ "indexed": false, "internalType": "uint256", "name": "_amount",      "type": "uint256" },
+			{ "indexed": false, "internalType": "uint256", "name": "_eventNonce",  "type": "uint256" }
+		]
+	},
+	{
+		"anonymous": false,
+		"name": "ERC20DeployedEvent",
+		"type": "event",
+		"inputs": [
+			{ "indexed": false, "internalType": "string",  "name": "_cosmosDenom",   "type": "string"  },
+			{ "indexed": true,  "internalType": "address", "name": "_tokenContract", "type": "address" },
+			{ "indexed": false, "internalType": "string",  "name": "_name",          "type": "string"  },
+			{ "indexed": false, "internalType": "string",  "name": "_symbol",        "type": "string"  },
+			{ "indexed": false, "internalType": "uint8",   "name": "_decimals",      "type": "uint8"   },
+			{ "indexed": false, "internalType": "uint256", "name": "_eventNonce",    "type": "uint256" }
+		]
+	},
+	{
+		"anonymous": false,
+		"name": "ValsetUpdatedEvent",
+		"type": "event",
+		"inputs": [
+			{ "indexed": true,  "internalType": "uint256",   "name": "_newValsetNonce", "type": "uint256"   },
+			{ "indexed": false, "internalType": "uint256",   "name": "_eventNonce",     "type": "uint256"   },
+			{ "indexed": false, "internalType": "uint256",   "name": "_rewardAmount",   "type": "uint256"   },
+			{ "indexed": false, "internalType": "address",   "name": "_rewardToken",    "type": "address"   },
+			{ "indexed": false, "internalType": "address[]", "name": "_validators",     "type": "address[]" },
+			{ "indexed": false, "internalType": "uint256[]", "name": "_powers",         "type": "uint256[]" }
+		]
+	},
+	{
+		"anonymous": false,
+		"name": "LogicCallEvent",
+		"type": "event",
+		"inputs": [
+			{ "indexed": false, "internalType": "bytes32", "name": "_invalidationId",    "type": "bytes32" },
+			{ "indexed": false, "internalType": "uint256", "name": "_invalidationNonce", "type": "uint256" },
+			{ "indexed": false, "internalType": "bytes",   "name": "_returnData",        "type": "bytes"   },
+			{ "indexed": false, "internalType": "uint256", "name": "_eventNonce",        "type": "uint256" }
+		]
+	}
+]`
@@ -0,0 +1,333 @@
+// Package ethlogs decodes the logs emitted by the Gravity.sol contract into the
+// event types the gravity module attests to. Go based orchestrators should use
+// these helpers so the events they submit in MsgSubmitEthereumEvent carry
+// exactly the fields and semantics the keeper expects, including the hash of
+// the ethereum tx and the index of the log identifying each event.
+package ethlogs
+
+import (
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+const (
+	TransactionBatchExecutedEventName = "TransactionBatchExecutedEvent"
+	SendToCosmosEventName             = "SendToCosmosEvent"
+	SendToCosmosWithMemoEventName     = "SendToCosmosWithMemoEvent"
+	SendEtherToCosmosEventName        = "SendEtherToCosmosEvent"
+	ERC20DeployedEventName            = "ERC20DeployedEvent"
+	ValsetUpdatedEventName            = "ValsetUpdatedEvent"
+	LogicCallEventName                = "LogicCallEvent"
+)
+
+// GravityEventsABI is the parsed form of GravityEventsABIJSON
+var GravityEventsABI abi.ABI
+
+func init() {
+	var err error
+	GravityEventsABI, err = abi.JSON(strings.NewReader(GravityEventsABIJSON))
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "bad ABI definition in code"))
+	}
+}
+
+// EventID returns the topic hash identifying the named Gravity.sol event
+func EventID(name string) gethcommon.Hash {
+	return GravityEventsABI.Events[name].ID
+}
+
+// ParseEthereumEvent decodes any of the Gravity.sol events attested to by the
+// module, selecting the decoder from the first topic of the log
+func ParseEthereumEvent(log ethtypes.Log) (types.EthereumEvent, error) {
+	if len(log.Topics) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "log has no topics")
+	}
+
+	switch log.Topics[0] {
+	case EventID(SendToCosmosEventName):
+		return ParseSendToCosmosEvent(log)
+	case EventID(SendToCosmosWithMemoEventName):
+		return ParseSendToCosmosWithMemoEvent(log)
+	case EventID(SendEtherToCosmosEventName):
+		return ParseSendEtherToCosmosEvent(log)
+	case EventID(TransactionBatchExecutedEventName):
+		return ParseBatchExecutedEvent(log)
+	case EventID(ERC20DeployedEventName):
+		return ParseERC20DeployedEvent(log)
+	case EventID(LogicCallEventName):
+		return ParseContractCallExecutedEvent(log)
+	case EventID(ValsetUpdatedEventName):
+		return ParseSignerSetTxExecutedEvent(log)
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "unknown gravity event topic %s", log.Topics[0].Hex())
+	}
+}
+
+// ParseSendToCosmosEvent decodes a SendToCosmosEvent log. The cosmos receiver
+// is taken from the last 20 bytes of the bytes32 destination and encoded with
+// the bech32 account prefix configured in the sdk config. Gravity.sol emits the
+// amount its balance grew by, which is also reported as the received amount.
+func ParseSendToCosmosEvent(log ethtypes.Log) (*types.SendToCosmosEvent, error) {
+	fields, err := unpackLog(SendToCosmosEventName, log)
+	if err != nil {
+		return nil, err
+	}
+	return sendToCosmosEvent(fields, log)
+}
+
+// ParseSendToCosmosWithMemoEvent decodes a SendToCosmosWithMemoEvent log, a
+// SendToCosmosEvent carrying the memo the deposit is routed with once credited
+func ParseSendToCosmosWithMemoEvent(log ethtypes.Log) (*types.SendToCosmosEvent, error) {
+	fields, err := unpackLog(SendToCosmosWithMemoEventName, log)
+	if err != nil {
+		return nil, err
+	}
+	event, err := sendToCosmosEvent(fields, log)
+	if err != nil {
+		return nil, err
+	}
+	event.Memo = fields["_memo"].([]byte)
+	return event, nil
+}
+
+// sendToCosmosEvent builds a deposit from the fields the SendToCosmosEvent and
+// SendToCosmosWithMemoEvent logs share
+func sendToCosmosEvent(fields map[string]interface{}, log ethtypes.Log) (*types.SendToCosmosEvent, error) {
+
+	eventNonce, err := toUint64(fields["_eventNonce"], "event nonce")
+	if err != nil {
+		return nil, err
+	}
+	destination := fields["_destination"].([32]byte)
+	amount := sdk.NewIntFromBigInt(fields["_amount"].(*big.Int))
+
+	return &types.SendToCosmosEvent{
+		EventNonce:          eventNonce,
+		TokenContract:       fields["_tokenContract"].(gethcommon.Address).Hex(),
+		Amount:              amount,
+		EthereumSender:      fields["_sender"].(gethcommon.Address).Hex(),
+		CosmosReceiver:      sdk.AccAddress(destination[12:]).String(),
+		EthereumHeight:      log.BlockNumber,
+		ReceivedAmount:      amount,
+		EthereumTxHash:      log.TxHash.Bytes(),
+		EthereumBlockHash:   log.BlockHash.Bytes(),
+		EthereumLogIndex:    uint64(log.Index),
+		HasEthereumLogIndex: true,
+	}, nil
+}
+
+// ParseSendEtherToCosmosEvent decodes a SendEtherToCosmosEvent log, a deposit
+// of native ether the module credits as the native ether pseudo contract
+func ParseSendEtherToCosmosEvent(log ethtypes.Log) (*types.SendEtherToCosmosEvent, error) {
+	fields, err := unpackLog(SendEtherToCosmosEventName, log)
+	if err != nil {
+		return nil, err
+	}
+
+	eventNonce, err := toUint64(fields["_eventNonce"], "event nonce")
+	if err != nil {
+		return nil, err
+	}
+	destination := fields["_destination"].([32]byte)
+
+	return &types.SendEtherToCosmosEvent{
+		EventNonce:          eventNonce,
+		Amount:              sdk.NewIntFromBigInt(fields["_amount"].(*big.Int)),
+		EthereumSender:      fields["_sender"].(gethcommon.Address).Hex(),
+		CosmosReceiver:      sdk.AccAddress(destination[12:]).String(),
+		EthereumHeight:      log.BlockNumber,
+		EthereumTxHash:      log.TxHash.Bytes(),
+		EthereumBlockHash:   log.BlockHash.Bytes(),
+		EthereumLogIndex:    uint64(log.Index),
+		HasEthereumLogIndex: true,
+	}, nil
+}
+
+// ParseBatchExecutedEvent decodes a TransactionBatchExecutedEvent log, along
+// with the address that submitted the batch
+func ParseBatchExecutedEvent(log ethtypes.Log) (*types.BatchExecutedEvent, error) {
+	fields, err := unpackLog(TransactionBatchExecutedEventName, log)
+	if err != nil {
+		return nil, err
+	}
+
+	eventNonce, err := toUint64(fields["_eventNonce"], "event nonce")
+	if err != nil {
+		return nil, err
+	}
+	batchNonce, err := toUint64(fields["_batchNonce"], "batch nonce")
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BatchExecutedEvent{
+		TokenContract:       fields["_token"].(gethcommon.Address).Hex(),
+		EventNonce:          eventNonce,
+		EthereumHeight:      log.BlockNumber,
+		BatchNonce:          batchNonce,
+		Relayer:             fields["_relayer"].(gethcommon.Address).Hex(),
+		EthereumTxHash:      log.TxHash.Bytes(),
+		EthereumBlockHash:   log.BlockHash.Bytes(),
+		EthereumLogIndex:    uint64(log.Index),
+		HasEthereumLogIndex: true,
+	}, nil
+}
+
+// ParseERC20DeployedEvent decodes an ERC20DeployedEvent log
+func ParseERC20DeployedEvent(log ethtypes.Log) (*types.ERC20DeployedEvent, error) {
+	fields, err := unpackLog(ERC20DeployedEventName, log)
+	if err != nil {
+		return nil, err
+	}
+
+	eventNonce, err := toUint64(fields["_eventNonce"], "event nonce")
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ERC20DeployedEvent{
+		EventNonce:          eventNonce,
+		CosmosDenom:         fields["_cosmosDenom"].(string),
+		TokenContract:       fields["_tokenContract"].(gethcommon.Address).Hex(),
+		Erc20Name:           fields["_name"].(string),
+		Erc20Symbol:         fields["_symbol"].(string),
+		Erc20Decimals:       uint64(fields["_decimals"].(uint8)),
+		EthereumHeight:      log.BlockNumber,
+		EthereumTxHash:      log.TxHash.Bytes(),
+		EthereumBlockHash:   log.BlockHash.Bytes(),
+		EthereumLogIndex:    uint64(log.Index),
+		HasEthereumLogIndex: true,
+	}, nil
+}
+
+// ParseContractCallExecutedEvent decodes a LogicCallEvent log. Gravity.sol only
+// emits it for calls that succeeded, and the return data of the call is
+// attested to by its keccak256 hash.
+func ParseContractCallExecutedEvent(log ethtypes.Log) (*types.ContractCallExecutedEvent, error) {
+	fields, err := unpackLog(LogicCallEventName, log)
+	if err != nil {
+		return nil, err
+	}
+
+	eventNonce, err := toUint64(fields["_eventNonce"], "event nonce")
+	if err != nil {
+		return nil, err
+	}
+	invalidationNonce, err := toUint64(fields["_invalidationNonce"], "invalidation nonce")
+	if err != nil {
+		return nil, err
+	}
+	invalidationScope := fields["_invalidationId"].([32]byte)
+
+	return &types.ContractCallExecutedEvent{
+		EventNonce:          eventNonce,
+		InvalidationScope:   invalidationScope[:],
+		InvalidationNonce:   invalidationNonce,
+		EthereumHeight:      log.BlockNumber,
+		Success:             true,
+		ReturnDataHash:      crypto.Keccak256(fields["_returnData"].([]byte)),
+		EthereumTxHash:      log.TxHash.Bytes(),
+		EthereumBlockHash:   log.BlockHash.Bytes(),
+		EthereumLogIndex:    uint64(log.Index),
+		HasEthereumLogIndex: true,
+	}, nil
+}
+
+// ParseSignerSetTxExecutedEvent decodes a ValsetUpdatedEvent log. Members are
+// returned in the order they were emitted by the contract.
+func ParseSignerSetTxExecutedEvent(log ethtypes.Log) (*types.SignerSetTxExecutedEvent, error) {
+	fields, err := unpackLog(ValsetUpdatedEventName, log)
+	if err != nil {
+		return nil, err
+	}
+
+	eventNonce, err := toUint64(fields["_eventNonce"], "event nonce")
+	if err != nil {
+		return nil, err
+	}
+	signerSetNonce, err := toUint64(fields["_newValsetNonce"], "signer set nonce")
+	if err != nil {
+		return nil, err
+	}
+
+	validators := fields["_validators"].([]gethcommon.Address)
+	powers := fields["_powers"].([]*big.Int)
+	if len(validators) != len(powers) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "validators and powers have different length: %d != %d", len(validators), len(powers))
+	}
+
+	members := make([]*types.EthereumSigner, len(validators))
+	for i, validator := range validators {
+		power, err := toUint64(powers[i], "power")
+		if err != nil {
+			return nil, err
+		}
+		members[i] = &types.EthereumSigner{
+			Power:           power,
+			EthereumAddress: validator.Hex(),
+		}
+	}
+
+	return &types.SignerSetTxExecutedEvent{
+		EventNonce:          eventNonce,
+		SignerSetTxNonce:    signerSetNonce,
+		EthereumHeight:      log.BlockNumber,
+		Members:             members,
+		EthereumTxHash:      log.TxHash.Bytes(),
+		EthereumBlockHash:   log.BlockHash.Bytes(),
+		EthereumLogIndex:    uint64(log.Index),
+		HasEthereumLogIndex: true,
+	}, nil
+}
+
+// unpackLog decodes both the indexed and the non indexed arguments of the named
+// event into a single map keyed by argument name
+func unpackLog(name string, log ethtypes.Log) (map[string]interface{}, error) {
+	event, ok := GravityEventsABI.Events[name]
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "unknown gravity event %s", name)
+	}
+	if len(log.Topics) == 0 || log.Topics[0] != event.ID {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "log is not a %s", name)
+	}
+	if log.Removed {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "%s log was removed by a chain reorganization", name)
+	}
+
+	fields := make(map[string]interface{})
+	if err := event.Inputs.UnpackIntoMap(fields, log.Data); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "unpacking %s data: %s", name, err)
+	}
+
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(fields, indexed, log.Topics[1:]); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "unpacking %s topics: %s", name, err)
+	}
+
+	return fields, nil
+}
+
+func toUint64(v interface{}, field string) (uint64, error) {
+	n, ok := v.(*big.Int)
+	if !ok || n == nil {
+		return 0, sdkerrors.Wrapf(types.ErrInvalid, "%s is not a uint256", field)
+	}
+	if !n.IsUint64() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalid, "%s %s overflows uint64", field, n)
+	}
+	return n.Uint64(), nil
+}
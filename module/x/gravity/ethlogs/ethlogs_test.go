@@ -0,0 +1,261 @@
+package ethlogs
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// testTxHash is the hash of the ethereum tx emitting the test logs
+var testTxHash = gethcommon.HexToHash("0x8d6ad4c1e4d8b8b0b8e0c05c2b8e8e0a7f0c9a8ed2f6d8e4a0d3b0e1f2a3b4c5")
+
+// testBlockHash is the hash of the ethereum block holding the test logs
+var testBlockHash = gethcommon.HexToHash("0x3f4e2a1b0c9d8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f")
+
+func TestParseSendToCosmosEvent(t *testing.T) {
+	var (
+		token    = gethcommon.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		sender   = gethcommon.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		receiver = sdk.AccAddress(gethcommon.HexToAddress("0x0000000000000000000000000000000000000001").Bytes())
+	)
+
+	data, err := GravityEventsABI.Events[SendToCosmosEventName].Inputs.NonIndexed().Pack(big.NewInt(1000), big.NewInt(7))
+	require.NoError(t, err)
+
+	log := ethtypes.Log{
+		Topics: []gethcommon.Hash{
+			EventID(SendToCosmosEventName),
+			gethcommon.BytesToHash(token.Bytes()),
+			gethcommon.BytesToHash(sender.Bytes()),
+			gethcommon.BytesToHash(receiver.Bytes()),
+		},
+		Data:        data,
+		BlockNumber: 42,
+		TxHash:      testTxHash,
+		BlockHash:   testBlockHash,
+		Index:       3,
+	}
+
+	event, err := ParseEthereumEvent(log)
+	require.NoError(t, err)
+	require.Equal(t, &types.SendToCosmosEvent{
+		EventNonce:          7,
+		TokenContract:       token.Hex(),
+		Amount:              sdk.NewInt(1000),
+		EthereumSender:      sender.Hex(),
+		CosmosReceiver:      receiver.String(),
+		EthereumHeight:      42,
+		ReceivedAmount:      sdk.NewInt(1000),
+		EthereumTxHash:      testTxHash.Bytes(),
+		EthereumBlockHash:   testBlockHash.Bytes(),
+		EthereumLogIndex:    3,
+		HasEthereumLogIndex: true,
+	}, event)
+}
+
+func TestParseSendEtherToCosmosEvent(t *testing.T) {
+	var (
+		sender   = gethcommon.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		receiver = sdk.AccAddress(gethcommon.HexToAddress("0x0000000000000000000000000000000000000001").Bytes())
+	)
+
+	data, err := GravityEventsABI.Events[SendEtherToCosmosEventName].Inputs.NonIndexed().Pack(big.NewInt(1000), big.NewInt(7))
+	require.NoError(t, err)
+
+	log := ethtypes.Log{
+		Topics: []gethcommon.Hash{
+			EventID(SendEtherToCosmosEventName),
+			gethcommon.BytesToHash(sender.Bytes()),
+			gethcommon.BytesToHash(receiver.Bytes()),
+		},
+		Data:        data,
+		BlockNumber: 42,
+		TxHash:      testTxHash,
+		BlockHash:   testBlockHash,
+		Index:       3,
+	}
+
+	event, err := ParseEthereumEvent(log)
+	require.NoError(t, err)
+	require.Equal(t, &types.SendEtherToCosmosEvent{
+		EventNonce:          7,
+		Amount:              sdk.NewInt(1000),
+		EthereumSender:      sender.Hex(),
+		CosmosReceiver:      receiver.String(),
+		EthereumHeight:      42,
+		EthereumTxHash:      testTxHash.Bytes(),
+		EthereumBlockHash:   testBlockHash.Bytes(),
+		EthereumLogIndex:    3,
+		HasEthereumLogIndex: true,
+	}, event)
+
+	// it is credited as the native ether pseudo contract
+	require.Equal(t, types.NativeEtherContract.Hex(), event.(*types.SendEtherToCosmosEvent).SendToCosmosEvent().TokenContract)
+}
+
+func TestParseSendToCosmosWithMemoEvent(t *testing.T) {
+	var (
+		token    = gethcommon.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		sender   = gethcommon.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		receiver = sdk.AccAddress(gethcommon.HexToAddress("0x0000000000000000000000000000000000000001").Bytes())
+		memo     = []byte(`{"stake": {}}`)
+	)
+
+	data, err := GravityEventsABI.Events[SendToCosmosWithMemoEventName].Inputs.NonIndexed().Pack(big.NewInt(1000), big.NewInt(7), memo)
+	require.NoError(t, err)
+
+	log := ethtypes.Log{
+		Topics: []gethcommon.Hash{
+			EventID(SendToCosmosWithMemoEventName),
+			gethcommon.BytesToHash(token.Bytes()),
+			gethcommon.BytesToHash(sender.Bytes()),
+			gethcommon.BytesToHash(receiver.Bytes()),
+		},
+		Data:        data,
+		BlockNumber: 42,
+		TxHash:      testTxHash,
+		BlockHash:   testBlockHash,
+		Index:       3,
+	}
+
+	event, err := ParseEthereumEvent(log)
+	require.NoError(t, err)
+	require.Equal(t, &types.SendToCosmosEvent{
+		EventNonce:          7,
+		TokenContract:       token.Hex(),
+		Amount:              sdk.NewInt(1000),
+		EthereumSender:      sender.Hex(),
+		CosmosReceiver:      receiver.String(),
+		EthereumHeight:      42,
+		ReceivedAmount:      sdk.NewInt(1000),
+		Memo:                memo,
+		EthereumTxHash:      testTxHash.Bytes(),
+		EthereumBlockHash:   testBlockHash.Bytes(),
+		EthereumLogIndex:    3,
+		HasEthereumLogIndex: true,
+	}, event)
+	require.NoError(t, event.Validate())
+}
+
+func TestParseBatchExecutedEvent(t *testing.T) {
+	token := gethcommon.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	relayer := gethcommon.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+
+	data, err := GravityEventsABI.Events[TransactionBatchExecutedEventName].Inputs.NonIndexed().Pack(big.NewInt(3), relayer)
+	require.NoError(t, err)
+
+	log := ethtypes.Log{
+		Topics: []gethcommon.Hash{
+			EventID(TransactionBatchExecutedEventName),
+			gethcommon.BigToHash(big.NewInt(5)),
+			gethcommon.BytesToHash(token.Bytes()),
+		},
+		Data:        data,
+		BlockNumber: 10,
+		TxHash:      testTxHash,
+		BlockHash:   testBlockHash,
+		Index:       3,
+	}
+
+	event, err := ParseBatchExecutedEvent(log)
+	require.NoError(t, err)
+	require.Equal(t, &types.BatchExecutedEvent{
+		TokenContract:       token.Hex(),
+		EventNonce:          3,
+		EthereumHeight:      10,
+		BatchNonce:          5,
+		Relayer:             relayer.Hex(),
+		EthereumTxHash:      testTxHash.Bytes(),
+		EthereumBlockHash:   testBlockHash.Bytes(),
+		EthereumLogIndex:    3,
+		HasEthereumLogIndex: true,
+	}, event)
+}
+
+func TestParseContractCallExecutedEvent(t *testing.T) {
+	scope := [32]byte{0x1, 0x2}
+	returnData := []byte("return data")
+
+	data, err := GravityEventsABI.Events[LogicCallEventName].Inputs.NonIndexed().Pack(
+		scope, big.NewInt(6), returnData, big.NewInt(9),
+	)
+	require.NoError(t, err)
+
+	log := ethtypes.Log{
+		Topics:      []gethcommon.Hash{EventID(LogicCallEventName)},
+		Data:        data,
+		BlockNumber: 77,
+		TxHash:      testTxHash,
+		BlockHash:   testBlockHash,
+		Index:       3,
+	}
+
+	event, err := ParseEthereumEvent(log)
+	require.NoError(t, err)
+	require.Equal(t, &types.ContractCallExecutedEvent{
+		EventNonce:          9,
+		InvalidationScope:   scope[:],
+		InvalidationNonce:   6,
+		EthereumHeight:      77,
+		Success:             true,
+		ReturnDataHash:      crypto.Keccak256(returnData),
+		EthereumTxHash:      testTxHash.Bytes(),
+		EthereumBlockHash:   testBlockHash.Bytes(),
+		EthereumLogIndex:    3,
+		HasEthereumLogIndex: true,
+	}, event)
+	require.NoError(t, event.Validate())
+}
+
+func TestParseSignerSetTxExecutedEvent(t *testing.T) {
+	validators := []gethcommon.Address{
+		gethcommon.HexToAddress("0x0000000000000000000000000000000000000001"),
+		gethcommon.HexToAddress("0x0000000000000000000000000000000000000002"),
+	}
+	powers := []*big.Int{big.NewInt(200), big.NewInt(100)}
+
+	data, err := GravityEventsABI.Events[ValsetUpdatedEventName].Inputs.NonIndexed().Pack(
+		big.NewInt(4), big.NewInt(0), gethcommon.Address{}, validators, powers,
+	)
+	require.NoError(t, err)
+
+	log := ethtypes.Log{
+		Topics: []gethcommon.Hash{
+			EventID(ValsetUpdatedEventName),
+			gethcommon.BigToHash(big.NewInt(2)),
+		},
+		Data:        data,
+		BlockNumber: 99,
+		TxHash:      testTxHash,
+		BlockHash:   testBlockHash,
+		Index:       3,
+	}
+
+	event, err := ParseSignerSetTxExecutedEvent(log)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, event.EventNonce)
+	require.EqualValues(t, 2, event.SignerSetTxNonce)
+	require.EqualValues(t, 99, event.EthereumHeight)
+	require.Equal(t, testTxHash.Bytes(), []byte(event.EthereumTxHash))
+	require.EqualValues(t, 3, event.EthereumLogIndex)
+	require.True(t, event.HasEthereumLogIndex)
+	require.Equal(t, []*types.EthereumSigner{
+		{Power: 200, EthereumAddress: validators[0].Hex()},
+		{Power: 100, EthereumAddress: validators[1].Hex()},
+	}, event.Members)
+}
+
+func TestParseEthereumEventUnknownTopic(t *testing.T) {
+	_, err := ParseEthereumEvent(ethtypes.Log{Topics: []gethcommon.Hash{{0x1}}})
+	require.Error(t, err)
+
+	_, err = ParseEthereumEvent(ethtypes.Log{})
+	require.Error(t, err)
+}
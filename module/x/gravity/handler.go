@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/keeper"
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
@@ -45,6 +46,62 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.SubmitEthereumHeightVote(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgUpdateDelegateKeys:
+			res, err := msgServer.UpdateDelegateKeys(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRevokeOrchestratorKey:
+			res, err := msgServer.RevokeOrchestratorKey(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSubmitAggregatedEthereumEvent:
+			res, err := msgServer.SubmitAggregatedEthereumEvent(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgERC20DeployedConfirm:
+			res, err := msgServer.ERC20DeployedConfirm(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgEthereumAnomalyReport:
+			res, err := msgServer.SubmitEthereumAnomalyReport(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRegisterOrchestratorQueryIdentity:
+			res, err := msgServer.RegisterOrchestratorQueryIdentity(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgExecuteAtomic:
+			res, err := msgServer.ExecuteAtomic(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgVetoDelayedSendToEthereum:
+			res, err := msgServer.VetoDelayedSendToEthereum(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgGrantBridgeFeeAllowance:
+			res, err := msgServer.GrantBridgeFeeAllowance(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRevokeBridgeFeeAllowance:
+			res, err := msgServer.RevokeBridgeFeeAllowance(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSubmitBadEthereumSignatureEvidence:
+			res, err := msgServer.SubmitBadEthereumSignatureEvidence(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgOptOutOfBridge:
+			res, err := msgServer.OptOutOfBridge(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgEthereumHeaderVote:
+			res, err := msgServer.SubmitEthereumHeaderVote(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgFreezeOrchestrator:
+			res, err := msgServer.FreezeOrchestrator(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -56,8 +113,68 @@ func NewCommunityPoolEthereumSpendProposalHandler(k keeper.Keeper) govtypes.Hand
 		switch c := content.(type) {
 		case *types.CommunityPoolEthereumSpendProposal:
 			return k.HandleCommunityPoolEthereumSpendProposal(ctx, c)
+		case *types.EthereumBlocklistProposal:
+			return k.HandleEthereumBlocklistProposal(ctx, c)
+		case *types.BridgeReenableProposal:
+			return k.HandleBridgeReenableProposal(ctx, c)
+		case *types.DelayedSendToEthereumVetoProposal:
+			return k.HandleDelayedSendToEthereumVetoProposal(ctx, c)
+		case *types.HeldSendToCosmosReleaseProposal:
+			return k.HandleHeldSendToCosmosReleaseProposal(ctx, c)
+		case *types.EmergencySignerSetUpdateProposal:
+			return k.HandleEmergencySignerSetUpdateProposal(ctx, c)
+		case *types.SendToEthereumPriorityProposal:
+			return k.HandleSendToEthereumPriorityProposal(ctx, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gravity proposal content type: %T", c)
 		}
 	}
 }
+
+// NewBridgeCriticalProposalHandler wraps a gov proposal handler so that
+// bridge-critical proposals which passed the ordinary gov tally, but not the
+// elevated quorum or threshold in the gravity params, fail on execution.
+func NewBridgeCriticalProposalHandler(k keeper.Keeper, handler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if keeper.IsBridgeCriticalProposal(content) && k.IsBridgeProposalRejected(ctx, content) {
+			return sdkerrors.Wrapf(types.ErrBridgeProposalSupport, "%s proposal %q", content.ProposalType(), content.GetTitle())
+		}
+		return handler(ctx, content)
+	}
+}
+
+// NewParamChangeProposalHandler wraps the params proposal handler so that a
+// parameter change leaving the gravity params invalid is rejected as a whole.
+// The params proposal handler only validates each changed parameter on its
+// own, which misses parameters that are inconsistent together.
+func NewParamChangeProposalHandler(k keeper.Keeper, handler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if !isGravityParamChangeProposal(content) {
+			return handler(ctx, content)
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := handler(cacheCtx, content); err != nil {
+			return err
+		}
+		if err := k.GetParams(cacheCtx).Validate(); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidParams, "%s proposal %q: %s", content.ProposalType(), content.GetTitle(), err)
+		}
+
+		writeCache()
+		return nil
+	}
+}
+
+func isGravityParamChangeProposal(content govtypes.Content) bool {
+	pcp, ok := content.(*paramsproposal.ParameterChangeProposal)
+	if !ok {
+		return false
+	}
+	for _, change := range pcp.Changes {
+		if change.Subspace == types.DefaultParamspace {
+			return true
+		}
+	}
+	return false
+}
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
@@ -385,3 +387,23 @@ func TestMsgSetDelegateAddresses(t *testing.T) {
 	_, err = k.DelegateKeysByValidator(wctx, &types.DelegateKeysByValidatorRequest{ValidatorAddress: valAddress.String()})
 	require.NoError(t, err)
 }
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	handler := gravity.NewParamChangeProposalHandler(input.GravityKeeper, params.NewParamChangeProposalHandler(input.ParamsKeeper))
+
+	// valid on its own, but the target timeout is no longer above twice the block time
+	invalid := paramsproposal.NewParameterChangeProposal("eth block time", "typo", []paramsproposal.ParamChange{
+		{Subspace: types.DefaultParamspace, Key: string(types.ParamsStoreKeyAverageEthereumBlockTime), Value: `"40000"`},
+	})
+	err := handler(ctx, invalid)
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+	require.Equal(t, uint64(15000), input.GravityKeeper.GetParams(ctx).AverageEthereumBlockTime)
+
+	valid := paramsproposal.NewParameterChangeProposal("eth block time", "slower blocks", []paramsproposal.ParamChange{
+		{Subspace: types.DefaultParamspace, Key: string(types.ParamsStoreKeyAverageEthereumBlockTime), Value: `"20000"`},
+	})
+	require.NoError(t, handler(ctx, valid))
+	require.Equal(t, uint64(20000), input.GravityKeeper.GetParams(ctx).AverageEthereumBlockTime)
+}
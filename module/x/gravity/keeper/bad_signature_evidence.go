@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// SubmitBadEthereumSignatureEvidence slashes and jails the validators signing
+// with the ethereum signer if the signature is over the checkpoint of an
+// outgoing tx the chain never created. Such a signature could only be used to
+// relay a fabricated batch, signer set or contract call to the gravity
+// contract.
+func (k Keeper) SubmitBadEthereumSignatureEvidence(ctx sdk.Context, subject types.OutgoingTx, signature []byte, ethSigner common.Address) error {
+	checkpoint := subject.GetCheckpoint([]byte(k.getGravityID(ctx)))
+
+	if k.isPastEthereumSignatureCheckpoint(ctx, checkpoint) {
+		return sdkerrors.Wrap(types.ErrNotBadEthereumSignature, "the chain created the outgoing tx")
+	}
+	// the outgoing txs pending since before the checkpoints were recorded
+	if otx, err := k.GetOutgoingTx(ctx, subject.GetStoreIndex()); err == nil {
+		if bytes.Equal(otx.GetCheckpoint([]byte(k.getGravityID(ctx))), checkpoint) {
+			return sdkerrors.Wrap(types.ErrNotBadEthereumSignature, "the chain created the outgoing tx")
+		}
+	}
+	// the chain may have created the outgoing tx before the checkpoints were
+	// recorded, and no longer have it
+	scope, nonce := types.OutgoingTxNonce(subject)
+	if pastNonce, found := k.getPastOutgoingTxNonce(ctx, scope); found && nonce <= pastNonce {
+		return sdkerrors.Wrapf(types.ErrNotBadEthereumSignature, "nonce %d not above %d, the highest from before the checkpoints were recorded", nonce, pastNonce)
+	}
+
+	evidenceHash := crypto.Keccak256(checkpoint, signature)
+	if k.hasBadEthereumSignatureEvidence(ctx, evidenceHash) {
+		return sdkerrors.Wrap(types.ErrNotBadEthereumSignature, "evidence already submitted")
+	}
+
+	params := k.GetParams(ctx)
+	var slashed bool
+	for _, val := range k.getValidatorsByEthereumAddress(ctx, ethSigner) {
+		verifier, err := k.getSignatureVerifier(k.GetValidatorSignatureScheme(ctx, val))
+		if err != nil {
+			continue
+		}
+		if valid, err := verifier.VerifySignature(ctx, checkpoint, signature, ethSigner); err != nil || !valid {
+			continue
+		}
+		k.slashEthereumSigner(ctx, params, val, subject.GetStoreIndex(), types.AttributeBadBridgeSig)
+		slashed = true
+	}
+	if !slashed {
+		return sdkerrors.Wrapf(types.ErrNotBadEthereumSignature, "not a signature of the checkpoint by a validator signing with %s", ethSigner.Hex())
+	}
+
+	k.setBadEthereumSignatureEvidence(ctx, evidenceHash)
+	return nil
+}
+
+func (k Keeper) setPastEthereumSignatureCheckpoint(ctx sdk.Context, checkpoint []byte) {
+	ctx.KVStore(k.storeKey).Set(types.MakePastEthereumSignatureCheckpointKey(checkpoint), []byte{1})
+}
+
+func (k Keeper) isPastEthereumSignatureCheckpoint(ctx sdk.Context, checkpoint []byte) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MakePastEthereumSignatureCheckpointKey(checkpoint))
+}
+
+func (k Keeper) iteratePastEthereumSignatureCheckpoints(ctx sdk.Context, cb func(checkpoint []byte) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.PastEthereumSignatureCheckpointKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key()) {
+			break
+		}
+	}
+}
+
+func (k Keeper) setPastOutgoingTxNonce(ctx sdk.Context, scope []byte, nonce uint64) {
+	ctx.KVStore(k.storeKey).Set(types.MakePastOutgoingTxNonceKey(scope), sdk.Uint64ToBigEndian(nonce))
+}
+
+func (k Keeper) getPastOutgoingTxNonce(ctx sdk.Context, scope []byte) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakePastOutgoingTxNonceKey(scope))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+func (k Keeper) iteratePastOutgoingTxNonces(ctx sdk.Context, cb func(scope []byte, nonce uint64) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.PastOutgoingTxNonceKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key(), sdk.BigEndianToUint64(iter.Value())) {
+			break
+		}
+	}
+}
+
+func (k Keeper) setBadEthereumSignatureEvidence(ctx sdk.Context, evidenceHash []byte) {
+	ctx.KVStore(k.storeKey).Set(types.MakeBadEthereumSignatureEvidenceKey(evidenceHash), []byte{1})
+}
+
+func (k Keeper) hasBadEthereumSignatureEvidence(ctx sdk.Context, evidenceHash []byte) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MakeBadEthereumSignatureEvidenceKey(evidenceHash))
+}
+
+func (k Keeper) iterateBadEthereumSignatureEvidence(ctx sdk.Context, cb func(evidenceHash []byte) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.BadEthereumSignatureEvidenceKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key()) {
+			break
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestSubmitBadEthereumSignatureEvidence(t *testing.T) {
+	ethPrivKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		valAddr1     = sdk.ValAddress(AccAddrs[0])
+		ethAddr1     = crypto.PubkeyToAddress(ethPrivKey.PublicKey)
+		submitter, _ = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+	)
+
+	stakingKeeper := NewStakingKeeperMock(valAddr1)
+	gk.StakingKeeper = stakingKeeper
+	gk.setValidatorEthereumAddress(ctx, valAddr1, ethAddr1)
+	msgServer := NewMsgServerImpl(gk)
+
+	submit := func(subject types.OutgoingTx) error {
+		signature, err := types.NewEthereumSignature(subject.GetCheckpoint([]byte(gk.getGravityID(ctx))), ethPrivKey)
+		require.NoError(t, err)
+		msg, err := types.NewMsgSubmitBadEthereumSignatureEvidence(subject, signature, ethAddr1, submitter)
+		require.NoError(t, err)
+		_, err = msgServer.SubmitBadEthereumSignatureEvidence(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	// the signature of a signer set tx the chain created is not evidence, even
+	// once the tx is pruned
+	signerSetTx := gk.CreateSignerSetTx(ctx)
+	gk.DeleteOutgoingTx(ctx, signerSetTx.GetStoreIndex())
+	require.ErrorIs(t, submit(signerSetTx), types.ErrNotBadEthereumSignature)
+
+	// nor is a signature by a key no validator signs with
+	fabricated := types.NewSignerSetTx(signerSetTx.Nonce+1, signerSetTx.Height, signerSetTx.Signers)
+	otherPrivKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signature, err := types.NewEthereumSignature(fabricated.GetCheckpoint([]byte(gk.getGravityID(ctx))), otherPrivKey)
+	require.NoError(t, err)
+	msg, err := types.NewMsgSubmitBadEthereumSignatureEvidence(fabricated, signature, crypto.PubkeyToAddress(otherPrivKey.PublicKey), submitter)
+	require.NoError(t, err)
+	_, err = msgServer.SubmitBadEthereumSignatureEvidence(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrNotBadEthereumSignature)
+
+	// nor is the signature of a batch at or below the highest batch nonce
+	// from before the checkpoints were recorded, the chain may have created it
+	gk.setPastOutgoingTxNonce(ctx, []byte{types.BatchTxPrefixByte}, 5)
+	pastBatchTx := &types.BatchTx{BatchNonce: 5, TokenContract: EthAddrs[1].Hex()}
+	require.ErrorIs(t, submit(pastBatchTx), types.ErrNotBadEthereumSignature)
+
+	// the signature of a fabricated signer set tx slashes its validator once
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, submit(fabricated))
+
+	consAddr, err := stakingKeeper.BondedValidators[0].GetConsAddr()
+	require.NoError(t, err)
+	var slashed []*types.EventValidatorSlashed
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if typed, err := sdk.ParseTypedEvent(event); err == nil {
+			if e, ok := typed.(*types.EventValidatorSlashed); ok {
+				slashed = append(slashed, e)
+			}
+		}
+	}
+	require.Equal(t, []*types.EventValidatorSlashed{{
+		Validator:        valAddr1.String(),
+		ConsensusAddress: consAddr.String(),
+		Power:            stakingKeeper.BondedValidators[0].GetConsensusPower(sdk.DefaultPowerReduction),
+		Reason:           types.AttributeBadBridgeSig,
+		StoreIndex:       fabricated.GetStoreIndex(),
+	}}, slashed)
+
+	require.ErrorIs(t, submit(fabricated), types.ErrNotBadEthereumSignature)
+}
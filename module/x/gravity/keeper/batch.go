@@ -2,78 +2,135 @@ package keeper
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"strconv"
+	"sort"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
 )
 
+// outgoingTxSlashingBudget is the number of outgoing txs checked for missing
+// signatures per block, the rest is left to the following blocks
+const outgoingTxSlashingBudget = 50
+
 // BuildBatchTx starts the following process chain:
 // - find bridged denominator for given voucher type
 // - determine if a an unexecuted batch is already waiting for this token type, if so confirm the new batch would
 //   have a higher total fees. If not exit withtout creating a batch
-// - select available transactions from the outgoing transaction pool sorted by fee desc
+// - select available transactions from the outgoing transaction pool, priority txs first, then alternating
+//   between the txs paying a token fee and those paying the bridge fee denom, each sorted by fee priority desc,
+//   then id asc
 // - persist an outgoing batch object with an incrementing ID = nonce
 // - emit an event
 func (k Keeper) BuildBatchTx(ctx sdk.Context, contractAddress common.Address, maxElements int) *types.BatchTx {
+	return k.buildBatchTx(ctx, k.GetParams(ctx), contractAddress, maxElements)
+}
+
+// buildBatchTx builds a batch with the params already read by the caller, so
+// that the end blocker doesn't read them again for every token
+func (k Keeper) buildBatchTx(ctx sdk.Context, params types.Params, contractAddress common.Address, maxElements int) *types.BatchTx {
 	if maxElements == 0 {
 		return nil
 	}
-	// if there is a more profitable batch for this token type do not create a new batch
-	if lastBatch := k.getLastOutgoingBatchByTokenType(ctx, contractAddress); lastBatch != nil {
-		if lastBatch.GetFees().GTE(k.getBatchFeesByTokenType(ctx, contractAddress, maxElements)) {
+	// a mirror never creates outgoing txs of its own
+	if params.MirrorMode {
+		return nil
+	}
+	maxElements = batchSize(params, maxElements)
+	// the token could not be withdrawn on ethereum
+	if _, paused := k.GetTokenPause(ctx, contractAddress); paused {
+		return nil
+	}
+	selectedStes := k.selectBatchSendToEthereums(ctx, params, contractAddress, maxElements)
+	// do not create batches that would contain no transactions, even if they are requested
+	if len(selectedStes) == 0 {
+		return nil
+	}
+
+	fees := sdk.ZeroInt()
+	bridgeFees := sdk.NewCoins()
+	for _, ste := range selectedStes {
+		fees = fees.Add(ste.Erc20Fee.Amount)
+		bridgeFees = bridgeFees.Add(ste.GetBridgeFeeCoins()...)
+	}
+
+	// if there is a more profitable batch for this token type do not create a new batch, fees paid
+	// in the bridge fee denom can't be priced against the token so they have to be higher as well.
+	// Priority txs don't wait for a more profitable batch.
+	if lastBatch := k.getLastOutgoingBatchByTokenType(ctx, contractAddress); lastBatch != nil && !selectedStes[0].Priority {
+		if lastBatch.GetFees().GTE(fees) && lastBatch.BridgeFees.IsAllGTE(bridgeFees) {
 			return nil
 		}
 	}
 
-	var selectedStes []*types.SendToEthereum
-	k.iterateUnbatchedSendToEthereumsByContract(ctx, contractAddress, func(ste *types.SendToEthereum) bool {
-		selectedStes = append(selectedStes, ste)
+	for _, ste := range selectedStes {
 		k.deleteUnbatchedSendToEthereum(ctx, ste.Id, ste.Erc20Fee)
-		return len(selectedStes) == maxElements
-	})
+	}
 
-	// do not create batches that would contain no transactions, even if they are requested
-	if len(selectedStes) == 0 {
-		return nil
+	// the batch is relayed with a signature slot for every member of the
+	// latest signer set
+	var signatures int
+	if signerSet := k.GetLatestSignerSetTx(ctx); signerSet != nil {
+		signatures = len(signerSet.Signers)
 	}
 
 	batch := &types.BatchTx{
 		BatchNonce:    k.incrementLastOutgoingBatchNonce(ctx),
-		Timeout:       k.getTimeoutHeight(ctx),
+		Timeout:       k.getTimeoutHeight(ctx, params),
 		Transactions:  selectedStes,
 		TokenContract: contractAddress.Hex(),
 		Height:        uint64(ctx.BlockHeight()),
+		BridgeFees:    bridgeFees,
+		GasEstimate:   types.BatchTxGasEstimate(params, len(selectedStes), signatures),
 	}
 	k.SetOutgoingTx(ctx, batch)
 
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
-		types.EventTypeOutgoingBatch,
-		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-		sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
-		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
-		sdk.NewAttribute(types.AttributeKeyOutgoingBatchID, fmt.Sprint(batch.BatchNonce)),
-		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(batch.BatchNonce)),
-	))
+	types.EmitTypedEvent(ctx, &types.EventOutgoingBatch{
+		BridgeContract: k.getBridgeContractAddress(ctx),
+		BridgeChainId:  k.getBridgeChainID(ctx),
+		TokenContract:  batch.TokenContract,
+		BatchNonce:     batch.BatchNonce,
+		Timeout:        batch.Timeout,
+		TxCount:        uint64(len(batch.Transactions)),
+		GasEstimate:    batch.GasEstimate,
+	})
+
+	telemetryBatch(batch, "created")
+	k.AfterBatchCreated(ctx, *batch)
 
 	return batch
 }
 
+// batchSize returns the number of txs a batch of at most maxElements txs can
+// hold, batches too large to fit in an ethereum block could never be relayed
+func batchSize(params types.Params, maxElements int) int {
+	if params.MaxBatchTxSize != 0 && uint64(maxElements) > params.MaxBatchTxSize {
+		return int(params.MaxBatchTxSize)
+	}
+	return maxElements
+}
+
 // batchTxExecuted is run when the Cosmos chain detects that a batch has been executed on Ethereum
 // It deletes all the transactions in the batch, then cancels all earlier batches
-func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, nonce uint64) error {
-	otx := k.GetOutgoingTx(ctx, types.MakeBatchTxKey(tokenContract, nonce))
-	if otx == nil {
+func (k Keeper) batchTxExecuted(ctx sdk.Context, params types.Params, tokenContract common.Address, nonce uint64, relayer string) error {
+	otx, err := k.GetOutgoingTx(ctx, types.MakeBatchTxKey(tokenContract, nonce))
+	if errors.Is(err, types.ErrOutgoingTxNotFound) {
 		k.Logger(ctx).Error("Failed to clean batches",
 			"token contract", tokenContract.Hex(),
 			"nonce", nonce)
 		return nil
+	} else if err != nil {
+		return err
+	}
+	batchTx, ok := otx.(*types.BatchTx)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalid, "outgoing tx %X is not a batch tx", otx.GetStoreIndex())
 	}
-	batchTx, _ := otx.(*types.BatchTx)
 	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
 		// If the iterated batches nonce is lower than the one that was just executed, cancel it
 		btx, _ := otx.(*types.BatchTx)
@@ -83,8 +140,11 @@ func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, n
 		return false
 	})
 
-	// burn the amount for non cosmos originated asset
+	// keep a share of the fees for the relayer reward pool
 	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(batchTx.TokenContract))
+	reward := k.relayerRewardAmount(ctx, params, batchTx)
+
+	// burn the amount for non cosmos originated asset
 	if !isCosmosOriginated {
 		totalToBurn := sdk.NewInt(0)
 		for _, tx := range batchTx.Transactions {
@@ -94,30 +154,163 @@ func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, n
 			}
 			totalToBurn = totalToBurn.Add(tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount))
 		}
+		totalToBurn = k.ERC20ToCosmosAmount(ctx, common.HexToAddress(batchTx.TokenContract), totalToBurn).Sub(reward)
 		burnVouchers := sdk.NewCoins(sdk.NewCoin(denom, totalToBurn))
 		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnVouchers); err != nil {
 			return sdkerrors.Wrapf(err, "burn vouchers coins: %s", burnVouchers)
 		}
 	}
 
+	// the fees paid in the bridge fee denom have no erc20 fee paying the
+	// relayer on ethereum, so they are paid to the relayer along with its reward
+	rewards := sdk.NewCoins(sdk.NewCoin(denom, reward)).Add(batchTx.BridgeFees...)
+	if err := k.rewardRelayer(ctx, rewards, relayer); err != nil {
+		return err
+	}
+
+	if err := k.distributeChainFees(ctx, params, batchTx); err != nil {
+		return err
+	}
+
 	k.DeleteOutgoingTx(ctx, batchTx.GetStoreIndex())
+	telemetryBatch(batchTx, "executed")
+	k.AfterBatchExecuted(ctx, *batchTx)
 	return nil
 }
 
-// getBatchFeesByTokenType gets the fees the next batch of a given token type would
-// have if created. This info is both presented to relayers for the purpose of determining
-// when to request batches and also used by the batch creation process to decide not to create
-// a new batch
-func (k Keeper) getBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr common.Address, maxElements int) sdk.Int {
-	feeAmount := sdk.ZeroInt()
-	i := 0
-	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContractAddr, func(tx *types.SendToEthereum) bool {
-		feeAmount = feeAmount.Add(tx.Erc20Fee.Amount)
-		i++
-		return i == maxElements
+// selectBatchSendToEthereums returns the unbatched txs of a token the next
+// batch would hold, the maxElements ones with the highest priority. The
+// priority of a tx is its fee, raised by BatchTimeoutFeeBoost of it for each
+// time a batch holding the tx timed out, so that txs whose batches keep timing
+// out are not starved by newer txs with marginally higher fees. Txs flagged as
+// priority txs come before all the others whatever their fee. The fees paid in
+// the token and those paid in the bridge fee denom can't be priced against
+// each other, so the txs paying either are ordered by priority descending then
+// by id ascending on their own, and the batch takes from both in turn so that
+// neither starves. No more than MaxTxsPerSenderPerBatch txs of a sender are
+// selected, so that a sender can't fill every batch. The order only depends on
+// the txs of the pool, never on the order they were stored in, so every node
+// builds the same batch.
+func (k Keeper) selectBatchSendToEthereums(ctx sdk.Context, params types.Params, tokenContract common.Address, maxElements int) []*types.SendToEthereum {
+	boost := params.BatchTimeoutFeeBoost
+
+	type candidate struct {
+		ste      *types.SendToEthereum
+		priority sdk.Dec
+	}
+	var priorityTxs, erc20FeeTxs, bridgeFeeTxs []candidate
+	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContract, func(ste *types.SendToEthereum) bool {
+		fee := ste.Erc20Fee.Amount
+		if ste.BridgeFee != nil {
+			fee = ste.BridgeFee.Amount
+		}
+		c := candidate{ste, sdk.OneDec().Add(boost.MulInt64(int64(ste.BatchTimeouts))).MulInt(fee)}
+		switch {
+		case ste.Priority:
+			priorityTxs = append(priorityTxs, c)
+		case ste.BridgeFee != nil:
+			bridgeFeeTxs = append(bridgeFeeTxs, c)
+		default:
+			erc20FeeTxs = append(erc20FeeTxs, c)
+		}
+		return false
 	})
 
-	return feeAmount
+	// the pool is iterated by fee descending then id ascending, the explicit
+	// id tie-break keeps the order total should the index change
+	for _, candidates := range [][]candidate{priorityTxs, erc20FeeTxs, bridgeFeeTxs} {
+		candidates := candidates
+		sort.Slice(candidates, func(i, j int) bool {
+			if !candidates[i].priority.Equal(candidates[j].priority) {
+				return candidates[i].priority.GT(candidates[j].priority)
+			}
+			return candidates[i].ste.Id < candidates[j].ste.Id
+		})
+	}
+
+	candidates := priorityTxs
+	for i := 0; i < len(erc20FeeTxs) || i < len(bridgeFeeTxs); i++ {
+		if i < len(erc20FeeTxs) {
+			candidates = append(candidates, erc20FeeTxs[i])
+		}
+		if i < len(bridgeFeeTxs) {
+			candidates = append(candidates, bridgeFeeTxs[i])
+		}
+	}
+
+	// a sender's txs beyond MaxTxsPerSenderPerBatch are left in the pool for
+	// later batches, priority txs are let through but still counted
+	perSender := make(map[string]uint64)
+	var out []*types.SendToEthereum
+	for _, c := range candidates {
+		if maxElements > 0 && len(out) == maxElements {
+			break
+		}
+		if params.MaxTxsPerSenderPerBatch > 0 && !c.ste.Priority && perSender[c.ste.Sender] >= params.MaxTxsPerSenderPerBatch {
+			continue
+		}
+		perSender[c.ste.Sender]++
+		out = append(out, c.ste)
+	}
+	return out
+}
+
+// getBatchFeeThreshold returns the configured unbatched fee threshold for a token, if any
+func getBatchFeeThreshold(params types.Params, tokenContract common.Address) (sdk.Int, bool) {
+	for _, threshold := range params.BatchFeeThresholds {
+		if common.HexToAddress(threshold.TokenContract) == tokenContract {
+			return threshold.Threshold, true
+		}
+	}
+	return sdk.Int{}, false
+}
+
+// batchCreationPolicyTrigger returns why the batch creation policies require a
+// batch for the token, or an empty string if they don't. A batch is required
+// when the fees of the unbatched transactions that would go into it reach the
+// token's fee threshold, when its oldest unbatched transaction reaches
+// BatchMaxTxAge blocks, or when it has BatchMaxPoolSize unbatched transactions.
+func (k Keeper) batchCreationPolicyTrigger(ctx sdk.Context, params types.Params, tokenContract common.Address, maxElements int) string {
+	if threshold, ok := getBatchFeeThreshold(params, tokenContract); ok && k.batchFeesByTokenType(ctx, params, tokenContract, maxElements).GTE(threshold) {
+		return fmt.Sprintf("fee threshold %s reached", threshold)
+	}
+
+	if params.BatchMaxTxAge == 0 && params.BatchMaxPoolSize == 0 {
+		return ""
+	}
+
+	var poolSize uint64
+	oldest := uint64(ctx.BlockHeight())
+	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContract, func(ste *types.SendToEthereum) bool {
+		poolSize++
+		// txs created before their height was recorded have no known age
+		if ste.Height != 0 && ste.Height < oldest {
+			oldest = ste.Height
+		}
+		return false
+	})
+
+	if params.BatchMaxPoolSize != 0 && poolSize >= params.BatchMaxPoolSize {
+		return fmt.Sprintf("pool size %d reached", poolSize)
+	}
+	if age := uint64(ctx.BlockHeight()) - oldest; params.BatchMaxTxAge != 0 && age >= params.BatchMaxTxAge {
+		return fmt.Sprintf("unbatched tx age of %d blocks reached", age)
+	}
+	return ""
+}
+
+// BuildBatchTxOnPolicy builds a batch for the token if the batch creation
+// policies require one, and returns it along with the reason. The batch is
+// still subject to the rule that it must be more profitable than the last one
+// waiting for the token. The params are passed in so that the end blocker
+// reads them once for all tokens.
+func (k Keeper) BuildBatchTxOnPolicy(ctx sdk.Context, params types.Params, tokenContract common.Address, maxElements int) (*types.BatchTx, string) {
+	reason := k.batchCreationPolicyTrigger(ctx, params, tokenContract, maxElements)
+	if reason == "" {
+		return nil, ""
+	}
+
+	return k.buildBatchTx(ctx, params, tokenContract, maxElements), reason
 }
 
 // GetBatchFeesByTokenType gets the fees the next batch of a given token type would
@@ -125,17 +318,19 @@ func (k Keeper) getBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr commo
 // when to request batches and also used by the batch creation process to decide not to create
 // a new batch
 func (k Keeper) GetBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr common.Address, maxElements int) sdk.Int {
+	return k.batchFeesByTokenType(ctx, k.GetParams(ctx), tokenContractAddr, maxElements)
+}
+
+func (k Keeper) batchFeesByTokenType(ctx sdk.Context, params types.Params, tokenContractAddr common.Address, maxElements int) sdk.Int {
 	feeAmount := sdk.ZeroInt()
-	i := 0
-	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContractAddr, func(tx *types.SendToEthereum) bool {
+	for _, tx := range k.selectBatchSendToEthereums(ctx, params, tokenContractAddr, maxElements) {
 		feeAmount = feeAmount.Add(tx.Erc20Fee.Amount)
-		i++
-		return i == maxElements
-	})
+	}
 	return feeAmount
 }
 
-// CancelBatchTx releases all TX in the batch and deletes the batch
+// CancelBatchTx releases all TX in the batch and deletes the batch, the chain
+// fees of the txs stay escrowed with them until they execute or are cancelled
 func (k Keeper) CancelBatchTx(ctx sdk.Context, batch *types.BatchTx) {
 	// free transactions from batch and reindex them
 	for _, tx := range batch.Transactions {
@@ -145,16 +340,24 @@ func (k Keeper) CancelBatchTx(ctx sdk.Context, batch *types.BatchTx) {
 	// Delete batch since it is finished
 	k.DeleteOutgoingTx(ctx, batch.GetStoreIndex())
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeOutgoingBatchCanceled,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
-			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
-			sdk.NewAttribute(types.AttributeKeyOutgoingBatchID, fmt.Sprint(batch.BatchNonce)),
-			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(batch.BatchNonce)),
-		),
-	)
+	types.EmitTypedEvent(ctx, &types.EventOutgoingBatchCanceled{
+		BridgeContract: k.getBridgeContractAddress(ctx),
+		BridgeChainId:  k.getBridgeChainID(ctx),
+		TokenContract:  batch.TokenContract,
+		BatchNonce:     batch.BatchNonce,
+	})
+
+	telemetryBatch(batch, "cancelled")
+	k.AfterBatchCancelled(ctx, *batch)
+}
+
+// TimeOutBatchTx cancels a batch that timed out on ethereum, raising the
+// priority of its transactions in the next batches of the token
+func (k Keeper) TimeOutBatchTx(ctx sdk.Context, batch *types.BatchTx) {
+	for _, tx := range batch.Transactions {
+		tx.BatchTimeouts++
+	}
+	k.CancelBatchTx(ctx, batch)
 }
 
 // getLastOutgoingBatchByTokenType gets the latest outgoing tx batch by token type
@@ -186,26 +389,46 @@ func (k Keeper) GetLastSlashedOutgoingTxBlockHeight(ctx sdk.Context) uint64 {
 	}
 }
 
+// GetUnSlashedOutgoingTxs returns the outgoing txs created after the last
+// slashed height and before maxHeight, oldest first. The txs are read from the
+// index by height starting at the last slashed height, which is the cursor the
+// next block resumes from, and the iteration stops once
+// outgoingTxSlashingBudget txs (rounded up to a whole height, as a height is
+// never split) were read so the slashing pass in EndBlock stays bounded.
 func (k Keeper) GetUnSlashedOutgoingTxs(ctx sdk.Context, maxHeight uint64) (out []types.OutgoingTx) {
 	lastSlashed := k.GetLastSlashedOutgoingTxBlockHeight(ctx)
-	k.iterateOutgoingTxs(ctx, func(key []byte, otx types.OutgoingTx) bool {
-		if (otx.GetCosmosHeight() < maxHeight) && (otx.GetCosmosHeight() > lastSlashed) {
-			out = append(out, otx)
+	exhausted := false
+	k.iterateOutgoingTxsByHeight(ctx, lastSlashed+1, maxHeight, func(otx types.OutgoingTx) bool {
+		if len(out) >= outgoingTxSlashingBudget && otx.GetCosmosHeight() != out[len(out)-1].GetCosmosHeight() {
+			exhausted = true
+			return true
 		}
+		out = append(out, otx)
 		return false
 	})
+
+	if exhausted {
+		telemetry.IncrCounter(1, types.ModuleName, "iteration_budget_exhausted", "outgoing_tx_slashing")
+		k.Logger(ctx).Info("outgoing tx slashing budget exhausted, resuming next block",
+			"resume after height", out[len(out)-1].GetCosmosHeight())
+	}
+
 	return
 }
 
 func (k Keeper) incrementLastOutgoingBatchNonce(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte{types.LastOutgoingBatchNonceKey})
-	var id uint64 = 0
-	if bz != nil {
-		id = binary.BigEndian.Uint64(bz)
-	}
-	newId := id + 1
-	bz = sdk.Uint64ToBigEndian(newId)
-	store.Set([]byte{types.LastOutgoingBatchNonceKey}, bz)
+	newId := k.getLastOutgoingBatchNonce(ctx) + 1
+	k.setLastOutgoingBatchNonce(ctx, newId)
 	return newId
 }
+
+func (k Keeper) getLastOutgoingBatchNonce(ctx sdk.Context) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastOutgoingBatchNonceKey}); bz != nil {
+		return binary.BigEndian.Uint64(bz)
+	}
+	return 0
+}
+
+func (k Keeper) setLastOutgoingBatchNonce(ctx sdk.Context, nonce uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastOutgoingBatchNonceKey}, sdk.Uint64ToBigEndian(nonce))
+}
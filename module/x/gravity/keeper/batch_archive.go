@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// archiveExecutedBatchTx stores the record of an executed batch, unless the
+// executed batch retention is zero
+func (k Keeper) archiveExecutedBatchTx(ctx sdk.Context, params types.Params, batchTx *types.BatchTx, event *types.BatchExecutedEvent) {
+	if params.ExecutedBatchRetention == 0 {
+		return
+	}
+
+	totalAmount, totalFee := sdk.ZeroInt(), sdk.ZeroInt()
+	for _, tx := range batchTx.Transactions {
+		totalAmount = totalAmount.Add(tx.Erc20Token.Amount)
+		totalFee = totalFee.Add(tx.Erc20Fee.Amount)
+	}
+
+	k.setBatchTxExecutionRecord(ctx, types.BatchTxExecutionRecord{
+		BatchNonce:     batchTx.BatchNonce,
+		TokenContract:  batchTx.TokenContract,
+		TxCount:        uint64(len(batchTx.Transactions)),
+		TotalAmount:    totalAmount,
+		TotalFee:       totalFee,
+		BridgeFees:     batchTx.BridgeFees,
+		Relayer:        event.Relayer,
+		EthereumTxHash: event.EthereumTxHash,
+		EthereumHeight: event.EthereumHeight,
+		CreatedHeight:  batchTx.Height,
+		ExecutedHeight: uint64(ctx.BlockHeight()),
+	})
+}
+
+func (k Keeper) setBatchTxExecutionRecord(ctx sdk.Context, record types.BatchTxExecutionRecord) {
+	key := types.MakeExecutedBatchTxKey(record.ExecutedHeight, common.HexToAddress(record.TokenContract), record.BatchNonce)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&record))
+}
+
+// IterateBatchTxExecutionRecords iterates over the archived records of
+// executed batches in execution order
+func (k Keeper) IterateBatchTxExecutionRecords(ctx sdk.Context, cb func(types.BatchTxExecutionRecord) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ExecutedBatchTxKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record types.BatchTxExecutionRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// archiveExecutedContractCallTx stores the record of an executed contract
+// call, unless the executed batch retention is zero. The records of contract
+// calls are kept for the same retention as the ones of batches.
+func (k Keeper) archiveExecutedContractCallTx(ctx sdk.Context, params types.Params, contractCallTx *types.ContractCallTx, event *types.ContractCallExecutedEvent) {
+	if params.ExecutedBatchRetention == 0 {
+		return
+	}
+
+	// multi calls are recorded under the address of their first call
+	address := contractCallTx.Address
+	if len(contractCallTx.Calls) > 0 {
+		address = contractCallTx.Calls[0].Address
+	}
+
+	k.setContractCallTxExecutionRecord(ctx, types.ContractCallTxExecutionRecord{
+		InvalidationScope: contractCallTx.InvalidationScope,
+		InvalidationNonce: contractCallTx.InvalidationNonce,
+		Address:           address,
+		Success:           event.Success,
+		ReturnDataHash:    event.ReturnDataHash,
+		EthereumTxHash:    event.EthereumTxHash,
+		EthereumHeight:    event.EthereumHeight,
+		CreatedHeight:     contractCallTx.Height,
+		ExecutedHeight:    uint64(ctx.BlockHeight()),
+	})
+}
+
+func (k Keeper) setContractCallTxExecutionRecord(ctx sdk.Context, record types.ContractCallTxExecutionRecord) {
+	key := types.MakeExecutedContractCallTxKey(record.ExecutedHeight, record.InvalidationScope, record.InvalidationNonce)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&record))
+}
+
+// IterateContractCallTxExecutionRecords iterates over the archived records of
+// executed contract calls in execution order
+func (k Keeper) IterateContractCallTxExecutionRecords(ctx sdk.Context, cb func(types.ContractCallTxExecutionRecord) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ExecutedContractCallTxKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record types.ContractCallTxExecutionRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// PruneBatchTxExecutionRecords deletes the records of the batches and
+// contract calls executed more than ExecutedBatchRetention blocks ago, and of
+// the contract calls refunded more than ContractCallRefundRetention blocks ago
+func (k Keeper) PruneBatchTxExecutionRecords(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	k.pruneRecords(ctx, params.ExecutedBatchRetention, types.ExecutedBatchTxKey, types.ExecutedContractCallTxKey)
+	if params.ContractCallRefundRetention != 0 {
+		k.pruneRecords(ctx, params.ContractCallRefundRetention, types.RefundedContractCallTxKey)
+	}
+}
+
+// pruneRecords deletes the records under the key prefixes, keyed by height
+// first, of more than retention blocks ago
+func (k Keeper) pruneRecords(ctx sdk.Context, retention uint64, keyPrefixes ...byte) {
+	currentBlock := uint64(ctx.BlockHeight())
+	if currentBlock <= retention {
+		return
+	}
+
+	for _, keyPrefix := range keyPrefixes {
+		prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{keyPrefix})
+		iter := prefixStore.Iterator(nil, sdk.Uint64ToBigEndian(currentBlock-retention))
+
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+
+		for _, key := range keys {
+			prefixStore.Delete(key)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestBatchTxExecutionArchive(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		relayer             = common.HexToAddress("0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09")
+		txHash              = common.HexToHash("0x5e0a4d8ba2f9bde0b3a7d1c1e2c3b8a4f0e1d2c3b4a5968778695a4b3c2d1e0f")
+	)
+
+	params := gk.GetParams(ctx)
+	params.ExecutedBatchRetention = 10
+	gk.SetParams(ctx, params)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	MintVouchersFromAir(t, ctx, gk, mySender, types.NewERC20Token(99999, myTokenContractAddr))
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3)
+
+	batch := gk.BuildBatchTx(ctx, myTokenContractAddr, 100)
+	require.NotNil(t, batch)
+
+	require.NoError(t, gk.Handle(ctx, &types.BatchExecutedEvent{
+		TokenContract:  myTokenContractAddr.Hex(),
+		EventNonce:     1,
+		EthereumHeight: 500,
+		BatchNonce:     batch.BatchNonce,
+		Relayer:        relayer.Hex(),
+		EthereumTxHash: txHash.Bytes(),
+	}))
+	_, err := gk.GetOutgoingTx(ctx, batch.GetStoreIndex())
+	require.ErrorIs(t, err, types.ErrOutgoingTxNotFound)
+
+	res, err := gk.ExecutedBatchTxs(sdk.WrapSDKContext(ctx), &types.ExecutedBatchTxsRequest{TokenContract: myTokenContractAddr.Hex()})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+	record := res.Records[0]
+	require.Equal(t, batch.BatchNonce, record.BatchNonce)
+	require.Equal(t, uint64(2), record.TxCount)
+	require.Equal(t, sdk.NewInt(100+101), record.TotalAmount)
+	require.Equal(t, sdk.NewInt(5), record.TotalFee)
+	require.Equal(t, relayer.Hex(), record.Relayer)
+	require.Equal(t, txHash.Bytes(), []byte(record.EthereumTxHash))
+	require.Equal(t, uint64(500), record.EthereumHeight)
+	require.Equal(t, uint64(ctx.BlockHeight()), record.ExecutedHeight)
+
+	// other tokens are filtered out
+	res, err = gk.ExecutedBatchTxs(sdk.WrapSDKContext(ctx), &types.ExecutedBatchTxsRequest{TokenContract: myReceiver.Hex()})
+	require.NoError(t, err)
+	require.Empty(t, res.Records)
+
+	// the record is kept for the retention only
+	gk.PruneBatchTxExecutionRecords(ctx.WithBlockHeight(ctx.BlockHeight() + 10))
+	res, err = gk.ExecutedBatchTxs(sdk.WrapSDKContext(ctx), &types.ExecutedBatchTxsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+
+	gk.PruneBatchTxExecutionRecords(ctx.WithBlockHeight(ctx.BlockHeight() + 11))
+	res, err = gk.ExecutedBatchTxs(sdk.WrapSDKContext(ctx), &types.ExecutedBatchTxsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Records)
+}
+
+func TestContractCallTxExecutionArchive(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		scope          = []byte("test-scope")
+		logicContract  = common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+		otherContract  = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		txHash         = common.HexToHash("0x5e0a4d8ba2f9bde0b3a7d1c1e2c3b8a4f0e1d2c3b4a5968778695a4b3c2d1e0f")
+		returnDataHash = common.HexToHash("0x0f1e2d3c4b5a69788796a5b4c3d2e1f0a4b8c3e2c1d1a7b3e0bdf9a2b8d4a0e5")
+	)
+
+	params := gk.GetParams(ctx)
+	params.ExecutedBatchRetention = 10
+	gk.SetParams(ctx, params)
+
+	contractCallTx := &types.ContractCallTx{
+		InvalidationNonce: 1,
+		InvalidationScope: scope,
+		Address:           logicContract.Hex(),
+		Payload:           []byte("payload"),
+		Timeout:           1000,
+		Height:            uint64(ctx.BlockHeight()),
+	}
+	gk.SetOutgoingTx(ctx, contractCallTx)
+
+	require.NoError(t, gk.Handle(ctx, &types.ContractCallExecutedEvent{
+		EventNonce:        1,
+		InvalidationScope: scope,
+		InvalidationNonce: 1,
+		EthereumHeight:    500,
+		Success:           true,
+		ReturnDataHash:    returnDataHash.Bytes(),
+		EthereumTxHash:    txHash.Bytes(),
+	}))
+	_, err := gk.GetOutgoingTx(ctx, contractCallTx.GetStoreIndex())
+	require.ErrorIs(t, err, types.ErrOutgoingTxNotFound)
+
+	res, err := gk.ExecutedContractCallTxs(sdk.WrapSDKContext(ctx), &types.ExecutedContractCallTxsRequest{Address: logicContract.Hex()})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+	record := res.Records[0]
+	require.Equal(t, scope, []byte(record.InvalidationScope))
+	require.Equal(t, uint64(1), record.InvalidationNonce)
+	require.True(t, record.Success)
+	require.Equal(t, returnDataHash.Bytes(), []byte(record.ReturnDataHash))
+	require.Equal(t, txHash.Bytes(), []byte(record.EthereumTxHash))
+	require.Equal(t, uint64(500), record.EthereumHeight)
+	require.Equal(t, uint64(ctx.BlockHeight()), record.ExecutedHeight)
+
+	// the ethereum tx hash is emitted for explorers
+	var emitted bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "gravity.v1.EventContractCallTxExecuted" {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == "ethereum_tx_hash" {
+				require.Equal(t, `"`+txHash.Hex()+`"`, string(attr.Value))
+				emitted = true
+			}
+		}
+	}
+	require.True(t, emitted)
+
+	// other logic contracts are filtered out
+	res, err = gk.ExecutedContractCallTxs(sdk.WrapSDKContext(ctx), &types.ExecutedContractCallTxsRequest{Address: otherContract.Hex()})
+	require.NoError(t, err)
+	require.Empty(t, res.Records)
+
+	// the record is kept for the retention only
+	gk.PruneBatchTxExecutionRecords(ctx.WithBlockHeight(ctx.BlockHeight() + 10))
+	res, err = gk.ExecutedContractCallTxs(sdk.WrapSDKContext(ctx), &types.ExecutedContractCallTxsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+
+	gk.PruneBatchTxExecutionRecords(ctx.WithBlockHeight(ctx.BlockHeight() + 11))
+	res, err = gk.ExecutedContractCallTxs(sdk.WrapSDKContext(ctx), &types.ExecutedContractCallTxsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Records)
+}
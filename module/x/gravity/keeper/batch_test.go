@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -44,15 +45,16 @@ func TestBatches(t *testing.T) {
 	firstBatch := input.GravityKeeper.BuildBatchTx(ctx, myTokenContractAddr, 2)
 
 	// then batch is persisted
-	gotFirstBatch := input.GravityKeeper.GetOutgoingTx(ctx, firstBatch.GetStoreIndex())
+	gotFirstBatch, err := input.GravityKeeper.GetOutgoingTx(ctx, firstBatch.GetStoreIndex())
+	require.NoError(t, err)
 	require.NotNil(t, gotFirstBatch)
 
 	gfb := gotFirstBatch.(*types.BatchTx)
 	expFirstBatch := &types.BatchTx{
 		BatchNonce: 1,
 		Transactions: []*types.SendToEthereum{
-			types.NewSendToEthereumTx(2, myTokenContractAddr, mySender, myReceiver, 101, 3),
-			types.NewSendToEthereumTx(3, myTokenContractAddr, mySender, myReceiver, 102, 2),
+			newPoolSendToEthereumTx(2, myTokenContractAddr, mySender, myReceiver, 101, 3),
+			newPoolSendToEthereumTx(1, myTokenContractAddr, mySender, myReceiver, 100, 2),
 		},
 		TokenContract: myTokenContractAddr.Hex(),
 		Height:        1234567,
@@ -67,8 +69,8 @@ func TestBatches(t *testing.T) {
 		return false
 	})
 	expUnbatchedTx := []*types.SendToEthereum{
-		types.NewSendToEthereumTx(1, myTokenContractAddr, mySender, myReceiver, 100, 2),
-		types.NewSendToEthereumTx(4, myTokenContractAddr, mySender, myReceiver, 103, 1),
+		newPoolSendToEthereumTx(3, myTokenContractAddr, mySender, myReceiver, 102, 2),
+		newPoolSendToEthereumTx(4, myTokenContractAddr, mySender, myReceiver, 103, 1),
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
 
@@ -87,8 +89,8 @@ func TestBatches(t *testing.T) {
 	expSecondBatch := &types.BatchTx{
 		BatchNonce: 2,
 		Transactions: []*types.SendToEthereum{
-			types.NewSendToEthereumTx(6, myTokenContractAddr, mySender, myReceiver, 101, 5),
-			types.NewSendToEthereumTx(5, myTokenContractAddr, mySender, myReceiver, 100, 4),
+			newPoolSendToEthereumTx(6, myTokenContractAddr, mySender, myReceiver, 101, 5),
+			newPoolSendToEthereumTx(5, myTokenContractAddr, mySender, myReceiver, 100, 4),
 		},
 		TokenContract: myTokenContractAddr.Hex(),
 		Height:        1234567,
@@ -100,11 +102,11 @@ func TestBatches(t *testing.T) {
 	// =================================
 
 	// Execute the batch
-	input.GravityKeeper.batchTxExecuted(ctx, common.HexToAddress(secondBatch.TokenContract), secondBatch.BatchNonce)
+	input.GravityKeeper.batchTxExecuted(ctx, input.GravityKeeper.GetParams(ctx), common.HexToAddress(secondBatch.TokenContract), secondBatch.BatchNonce, "")
 
 	// check batch has been deleted
-	gotSecondBatch := input.GravityKeeper.GetOutgoingTx(ctx, secondBatch.GetStoreIndex())
-	require.Nil(t, gotSecondBatch)
+	_, err = input.GravityKeeper.GetOutgoingTx(ctx, secondBatch.GetStoreIndex())
+	require.ErrorIs(t, err, types.ErrOutgoingTxNotFound)
 
 	// check that txs from first batch have been freed
 	gotUnbatchedTx = nil
@@ -113,10 +115,10 @@ func TestBatches(t *testing.T) {
 		return false
 	})
 	expUnbatchedTx = []*types.SendToEthereum{
-		types.NewSendToEthereumTx(2, myTokenContractAddr, mySender, myReceiver, 101, 3),
-		types.NewSendToEthereumTx(3, myTokenContractAddr, mySender, myReceiver, 102, 2),
-		types.NewSendToEthereumTx(1, myTokenContractAddr, mySender, myReceiver, 100, 2),
-		types.NewSendToEthereumTx(4, myTokenContractAddr, mySender, myReceiver, 103, 1),
+		newPoolSendToEthereumTx(2, myTokenContractAddr, mySender, myReceiver, 101, 3),
+		newPoolSendToEthereumTx(1, myTokenContractAddr, mySender, myReceiver, 100, 2),
+		newPoolSendToEthereumTx(3, myTokenContractAddr, mySender, myReceiver, 102, 2),
+		newPoolSendToEthereumTx(4, myTokenContractAddr, mySender, myReceiver, 103, 1),
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
 }
@@ -163,7 +165,8 @@ func TestBatchesFullCoins(t *testing.T) {
 	firstBatch := input.GravityKeeper.BuildBatchTx(ctx, myTokenContractAddr, 2)
 
 	// then batch is persisted
-	gotFirstBatch := input.GravityKeeper.GetOutgoingTx(ctx, firstBatch.GetStoreIndex())
+	gotFirstBatch, err := input.GravityKeeper.GetOutgoingTx(ctx, firstBatch.GetStoreIndex())
+	require.NoError(t, err)
 	require.NotNil(t, gotFirstBatch)
 
 	expFirstBatch := &types.BatchTx{
@@ -175,6 +178,7 @@ func TestBatchesFullCoins(t *testing.T) {
 				Sender:            mySender.String(),
 				EthereumRecipient: myReceiver.Hex(),
 				Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
+				Height:            1234567,
 			},
 			{
 				Id:                3,
@@ -182,6 +186,7 @@ func TestBatchesFullCoins(t *testing.T) {
 				Sender:            mySender.String(),
 				EthereumRecipient: myReceiver.Hex(),
 				Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
+				Height:            1234567,
 			},
 		},
 		TokenContract: myTokenContractAddr.Hex(),
@@ -202,6 +207,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			Sender:            mySender.String(),
 			EthereumRecipient: myReceiver.Hex(),
 			Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr),
+			Height:            1234567,
 		},
 		{
 			Id:                4,
@@ -209,6 +215,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			Sender:            mySender.String(),
 			EthereumRecipient: myReceiver.Hex(),
 			Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr),
+			Height:            1234567,
 		},
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -240,6 +247,7 @@ func TestBatchesFullCoins(t *testing.T) {
 				Sender:            mySender.String(),
 				EthereumRecipient: myReceiver.Hex(),
 				Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr),
+				Height:            1234567,
 			},
 			{
 				Id:                4,
@@ -247,6 +255,7 @@ func TestBatchesFullCoins(t *testing.T) {
 				Sender:            mySender.String(),
 				EthereumRecipient: myReceiver.Hex(),
 				Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr),
+				Height:            1234567,
 			},
 		},
 		TokenContract: myTokenContractAddr.Hex(),
@@ -259,11 +268,11 @@ func TestBatchesFullCoins(t *testing.T) {
 	// =================================
 
 	// Execute the batch
-	input.GravityKeeper.batchTxExecuted(ctx, common.HexToAddress(secondBatch.TokenContract), secondBatch.BatchNonce)
+	input.GravityKeeper.batchTxExecuted(ctx, input.GravityKeeper.GetParams(ctx), common.HexToAddress(secondBatch.TokenContract), secondBatch.BatchNonce, "")
 
 	// check batch has been deleted
-	gotSecondBatch := input.GravityKeeper.GetOutgoingTx(ctx, secondBatch.GetStoreIndex())
-	require.Nil(t, gotSecondBatch)
+	_, err = input.GravityKeeper.GetOutgoingTx(ctx, secondBatch.GetStoreIndex())
+	require.ErrorIs(t, err, types.ErrOutgoingTxNotFound)
 
 	// check that txs from first batch have been freed
 	gotUnbatchedTx = nil
@@ -278,6 +287,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			Sender:            mySender.String(),
 			EthereumRecipient: myReceiver.Hex(),
 			Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
+			Height:            1234567,
 		},
 		{
 			Id:                3,
@@ -285,6 +295,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			Sender:            mySender.String(),
 			EthereumRecipient: myReceiver.Hex(),
 			Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
+			Height:            1234567,
 		},
 		{
 			Id:                6,
@@ -292,6 +303,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			Sender:            mySender.String(),
 			EthereumRecipient: myReceiver.Hex(),
 			Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(5)), myTokenContractAddr),
+			Height:            1234567,
 		},
 		{
 			Id:                5,
@@ -299,6 +311,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			Sender:            mySender.String(),
 			EthereumRecipient: myReceiver.Hex(),
 			Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(4)), myTokenContractAddr),
+			Height:            1234567,
 		},
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -380,3 +393,325 @@ func TestEmptyBatch(t *testing.T) {
 
 	require.Nil(t, batchTx)
 }
+
+func TestBatchTxTypedEvents(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	voucher := MintVouchersFromAir(t, ctx, gk, mySender, types.NewERC20Token(1000, myTokenContractAddr))
+	_, err := gk.createSendToEthereum(ctx, mySender, myReceiver, sdk.NewCoin(voucher.Denom, sdk.NewInt(100)), sdk.NewCoin(voucher.Denom, sdk.NewInt(1)))
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	batch := gk.BuildBatchTx(ctx, myTokenContractAddr, 10)
+	require.NotNil(t, batch)
+	gk.CancelBatchTx(ctx, batch)
+
+	var events []proto.Message
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		typed, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		events = append(events, typed)
+	}
+
+	require.Equal(t, []proto.Message{
+		&types.EventOutgoingBatch{
+			BridgeContract: gk.getBridgeContractAddress(ctx),
+			BridgeChainId:  gk.getBridgeChainID(ctx),
+			TokenContract:  myTokenContractAddr.Hex(),
+			BatchNonce:     batch.BatchNonce,
+			Timeout:        batch.Timeout,
+			TxCount:        1,
+		},
+		&types.EventOutgoingBatchCanceled{
+			BridgeContract: gk.getBridgeContractAddress(ctx),
+			BridgeChainId:  gk.getBridgeChainID(ctx),
+			TokenContract:  myTokenContractAddr.Hex(),
+			BatchNonce:     batch.BatchNonce,
+		},
+	}, events)
+}
+
+func TestBatchTxTimeoutPriority(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+	)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	params := gk.GetParams(ctx)
+	params.BatchTimeoutFeeBoost = sdk.NewDecWithPrec(2, 1)
+	gk.SetParams(ctx, params)
+
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 10)
+	batch := gk.BuildBatchTx(ctx, myTokenContractAddr, 1)
+	require.NotNil(t, batch)
+
+	// a newer tx pays a slightly higher fee than the one in the batch
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 11)
+
+	gk.TimeOutBatchTx(ctx, batch)
+	require.Equal(t, uint64(1), gk.getUnbatchedSendToEthereums(ctx)[1].BatchTimeouts)
+
+	// the timed out tx is boosted to a priority of 12 and goes first
+	next := gk.BuildBatchTx(ctx, myTokenContractAddr, 1)
+	require.NotNil(t, next)
+	require.Equal(t, uint64(1), next.Transactions[0].Id)
+	require.Equal(t, uint64(1), next.Transactions[0].BatchTimeouts)
+
+	// without the boost the pool is batched by fee alone
+	gk.CancelBatchTx(ctx, next)
+	params.BatchTimeoutFeeBoost = sdk.ZeroDec()
+	gk.SetParams(ctx, params)
+
+	require.Equal(t, uint64(2), gk.BuildBatchTx(ctx, myTokenContractAddr, 1).Transactions[0].Id)
+}
+
+func TestBatchTxMaxSize(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+	)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	params := gk.GetParams(ctx)
+	params.MaxBatchTxSize = 2
+	gk.SetParams(ctx, params)
+
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3, 1)
+
+	// the batch is capped at the max size and takes the highest fees
+	batch := gk.BuildBatchTx(ctx, myTokenContractAddr, 100)
+	require.NotNil(t, batch)
+	require.Len(t, batch.Transactions, 2)
+	require.Equal(t, uint64(2), batch.Transactions[0].Id)
+	require.Equal(t, uint64(1), batch.Transactions[1].Id)
+	require.Len(t, gk.getUnbatchedSendToEthereums(ctx), 1)
+}
+
+func TestBatchTxMaxTxsPerSender(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		whale, _            = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+	)
+
+	for _, sender := range []sdk.AccAddress{whale, mySender} {
+		input.AccountKeeper.NewAccountWithAddress(ctx, sender)
+		require.NoError(t, fundAccount(ctx, input.BankKeeper, sender, allVouchers))
+	}
+
+	params := gk.GetParams(ctx)
+	params.MaxTxsPerSenderPerBatch = 2
+	gk.SetParams(ctx, params)
+
+	// the whale outbids the other sender with every tx
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, whale, myReceiver, 5, 4, 3)
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 1)
+
+	batch := gk.BuildBatchTx(ctx, myTokenContractAddr, 100)
+	require.NotNil(t, batch)
+	require.Len(t, batch.Transactions, 3)
+	require.Equal(t, uint64(1), batch.Transactions[0].Id)
+	require.Equal(t, uint64(2), batch.Transactions[1].Id)
+	require.Equal(t, uint64(4), batch.Transactions[2].Id)
+
+	// the whale's third tx waits for the next batch
+	unbatched := gk.getUnbatchedSendToEthereums(ctx)
+	require.Len(t, unbatched, 1)
+	require.Equal(t, uint64(3), unbatched[0].Id)
+}
+
+func TestBatchTxDeterministicOrder(t *testing.T) {
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+
+	pool := []*types.SendToEthereum{
+		newPoolSendToEthereumTx(1, myTokenContractAddr, mySender, myReceiver, 100, 10),
+		newPoolSendToEthereumTx(2, myTokenContractAddr, mySender, myReceiver, 100, 12),
+		newPoolSendToEthereumTx(3, myTokenContractAddr, mySender, myReceiver, 100, 10),
+		newPoolSendToEthereumTx(4, myTokenContractAddr, mySender, myReceiver, 100, 12),
+		newPoolSendToEthereumTx(5, myTokenContractAddr, mySender, myReceiver, 100, 7),
+		newPoolSendToEthereumTx(6, myTokenContractAddr, mySender, myReceiver, 100, 10),
+	}
+	// a timed out tx boosted to the priority of the txs paying 12
+	pool[5].BatchTimeouts = 1
+	marshaler, _ := MakeTestMarshaler()
+
+	// every node builds the same batch, whatever order the pool was stored in
+	buildBatch := func(boost sdk.Dec, order ...int) *types.BatchTx {
+		input := CreateTestEnv(t)
+		gk := input.GravityKeeper
+		params := gk.GetParams(input.Context)
+		params.BatchTimeoutFeeBoost = boost
+		gk.SetParams(input.Context, params)
+		for _, i := range order {
+			gk.setUnbatchedSendToEthereum(input.Context, pool[i])
+		}
+		batch := gk.BuildBatchTx(input.Context, myTokenContractAddr, 4)
+		require.NotNil(t, batch)
+		return batch
+	}
+	batchIDs := func(batch *types.BatchTx) (ids []uint64) {
+		for _, tx := range batch.Transactions {
+			ids = append(ids, tx.Id)
+		}
+		return ids
+	}
+
+	// by fee descending, then id ascending
+	batch := buildBatch(sdk.ZeroDec(), 0, 1, 2, 3, 4, 5)
+	require.Equal(t, []uint64{2, 4, 1, 3}, batchIDs(batch))
+	require.Equal(t, marshaler.MustMarshal(batch), marshaler.MustMarshal(buildBatch(sdk.ZeroDec(), 5, 4, 3, 2, 1, 0)))
+	require.Equal(t, batch.GetCheckpoint([]byte("gravity")), buildBatch(sdk.ZeroDec(), 3, 0, 5, 1, 4, 2).GetCheckpoint([]byte("gravity")))
+
+	// by boosted priority descending, then id ascending
+	batch = buildBatch(sdk.NewDecWithPrec(2, 1), 0, 1, 2, 3, 4, 5)
+	require.Equal(t, []uint64{2, 4, 6, 1}, batchIDs(batch))
+	require.Equal(t, marshaler.MustMarshal(batch), marshaler.MustMarshal(buildBatch(sdk.NewDecWithPrec(2, 1), 5, 4, 3, 2, 1, 0)))
+}
+
+type batchRecordingHooks struct {
+	types.GravityHooks
+
+	pooled    []uint64
+	created   []uint64
+	cancelled []uint64
+}
+
+func (h *batchRecordingHooks) AfterSendToEthereumPooled(_ sdk.Context, ste types.SendToEthereum) {
+	h.pooled = append(h.pooled, ste.Id)
+}
+
+func (h *batchRecordingHooks) AfterBatchCreated(_ sdk.Context, batch types.BatchTx) {
+	h.created = append(h.created, batch.BatchNonce)
+}
+
+func (h *batchRecordingHooks) AfterBatchCancelled(_ sdk.Context, batch types.BatchTx) {
+	h.cancelled = append(h.cancelled, batch.BatchNonce)
+}
+
+func TestBatchLifecycleHooks(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+
+	hooks := &batchRecordingHooks{}
+	input.GravityKeeper.SetHooks(hooks)
+	gk := input.GravityKeeper
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+	)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3)
+	require.Equal(t, []uint64{1, 2}, hooks.pooled)
+
+	batch := gk.BuildBatchTx(ctx, myTokenContractAddr, 2)
+	require.NotNil(t, batch)
+	require.Equal(t, []uint64{batch.BatchNonce}, hooks.created)
+
+	// returning txs to the pool doesn't report them again
+	gk.TimeOutBatchTx(ctx, batch)
+	require.Equal(t, []uint64{batch.BatchNonce}, hooks.cancelled)
+	require.Len(t, hooks.pooled, 2)
+}
+
+// BenchmarkBuildBatchTx measures building a batch out of a large pool, on a
+// cache context so that every iteration starts from the same pool
+func BenchmarkBuildBatchTx(b *testing.B) {
+	input := CreateTestEnv(b)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		ids                 = make([]uint64, 500)
+	)
+	for i := range ids {
+		ids[i] = uint64(i + 1)
+	}
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(b, input.AddBalanceToBank(ctx, mySender, sdk.NewCoins(types.NewERC20Token(10_000_000, myTokenContractAddr).GravityCoin())))
+	input.AddSendToEthTxsToPool(b, ctx, myTokenContractAddr, mySender, myReceiver, ids...)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cacheCtx, _ := ctx.CacheContext()
+		require.NotNil(b, input.GravityKeeper.BuildBatchTx(cacheCtx, myTokenContractAddr, 100))
+	}
+}
+
+func TestBatchTxGasEstimate(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+	)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	params := gk.GetParams(ctx)
+	params.BatchGasBaseCost = 1000
+	params.BatchGasPerTransfer = 100
+	params.BatchGasPerSignature = 10
+	gk.SetParams(ctx, params)
+
+	// the latest signer set has three members
+	gk.SetOutgoingTx(ctx, types.NewSignerSetTx(gk.incrementLatestSignerSetTxNonce(ctx), uint64(ctx.BlockHeight()), types.EthereumSigners{
+		{Power: 1000, EthereumAddress: EthAddrs[0].Hex()},
+		{Power: 1000, EthereumAddress: EthAddrs[1].Hex()},
+		{Power: 1000, EthereumAddress: EthAddrs[2].Hex()},
+	}))
+
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3)
+
+	batch := gk.BuildBatchTx(ctx, myTokenContractAddr, 100)
+	require.NotNil(t, batch)
+	require.Equal(t, uint64(1000+2*100+3*10), batch.GasEstimate)
+}
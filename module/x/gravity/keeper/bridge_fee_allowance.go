@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// GetBridgeFeeAllowance returns the bridge fee allowance the granter granted
+// the grantee, if any
+func (k Keeper) GetBridgeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (types.BridgeFeeAllowance, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeBridgeFeeAllowanceKey(granter, grantee))
+	if bz == nil {
+		return types.BridgeFeeAllowance{}, false
+	}
+	var allowance types.BridgeFeeAllowance
+	k.cdc.MustUnmarshal(bz, &allowance)
+	return allowance, true
+}
+
+func (k Keeper) setBridgeFeeAllowance(ctx sdk.Context, allowance types.BridgeFeeAllowance) {
+	granter, _ := sdk.AccAddressFromBech32(allowance.Granter)
+	grantee, _ := sdk.AccAddressFromBech32(allowance.Grantee)
+	ctx.KVStore(k.storeKey).Set(types.MakeBridgeFeeAllowanceKey(granter, grantee), k.cdc.MustMarshal(&allowance))
+}
+
+func (k Keeper) deleteBridgeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeBridgeFeeAllowanceKey(granter, grantee))
+}
+
+// GrantBridgeFeeAllowance lets the granter pay the fees of the grantee's sends
+// to ethereum up to the spend limit, replacing any previous allowance
+func (k Keeper) GrantBridgeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, spendLimit sdk.Coins) {
+	k.setBridgeFeeAllowance(ctx, types.BridgeFeeAllowance{
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		SpendLimit: spendLimit,
+	})
+}
+
+// RevokeBridgeFeeAllowance removes the bridge fee allowance the granter
+// granted the grantee
+func (k Keeper) RevokeBridgeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
+	if _, found := k.GetBridgeFeeAllowance(ctx, granter, grantee); !found {
+		return sdkerrors.Wrapf(types.ErrBridgeFeeAllowanceNotFound, "granter %s, grantee %s", granter, grantee)
+	}
+	k.deleteBridgeFeeAllowance(ctx, granter, grantee)
+	return nil
+}
+
+// useBridgeFeeAllowance deducts a fee from the allowance the granter granted
+// the grantee, removing the allowance once it is spent
+func (k Keeper) useBridgeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coin) error {
+	allowance, found := k.GetBridgeFeeAllowance(ctx, granter, grantee)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrBridgeFeeAllowanceNotFound, "granter %s, grantee %s", granter, grantee)
+	}
+
+	remaining, hasNeg := allowance.SpendLimit.SafeSub(fee)
+	if hasNeg {
+		return sdkerrors.Wrapf(types.ErrBridgeFeeAllowanceExceeded, "%s over the spend limit of %s", fee, allowance.SpendLimit)
+	}
+
+	if remaining.IsZero() {
+		k.deleteBridgeFeeAllowance(ctx, granter, grantee)
+		return nil
+	}
+	allowance.SpendLimit = remaining
+	k.setBridgeFeeAllowance(ctx, allowance)
+	return nil
+}
+
+// IterateBridgeFeeAllowances iterates over the bridge fee allowances by
+// granter and grantee
+func (k Keeper) IterateBridgeFeeAllowances(ctx sdk.Context, cb func(types.BridgeFeeAllowance) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.BridgeFeeAllowanceKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var allowance types.BridgeFeeAllowance
+		k.cdc.MustUnmarshal(iter.Value(), &allowance)
+		if cb(allowance) {
+			break
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestBridgeFeeAllowance(t *testing.T) {
+	var (
+		input     = CreateTestEnv(t)
+		ctx       = input.Context
+		gk        = input.GravityKeeper
+		msgServer = NewMsgServerImpl(gk)
+
+		mySender, _   = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		granter, _    = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		myReceiver    = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	input.AccountKeeper.NewAccountWithAddress(ctx, granter)
+	voucher := MintVouchersFromAir(t, ctx, gk, mySender, types.NewERC20Token(1000, tokenContract))
+	MintVouchersFromAir(t, ctx, gk, granter, types.NewERC20Token(1000, tokenContract))
+
+	send := func(fee int64) (uint64, error) {
+		msg := types.NewMsgSendToEthereum(mySender, myReceiver.Hex(), sdk.NewCoin(voucher.Denom, sdk.NewInt(100)), sdk.NewCoin(voucher.Denom, sdk.NewInt(fee)))
+		msg.FeeGranter = granter.String()
+		res, err := msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			return 0, err
+		}
+		return res.Id, nil
+	}
+
+	// no fee is paid by a granter that granted no allowance
+	_, err := send(10)
+	require.ErrorIs(t, err, types.ErrBridgeFeeAllowanceNotFound)
+
+	_, err = msgServer.GrantBridgeFeeAllowance(sdk.WrapSDKContext(ctx), types.NewMsgGrantBridgeFeeAllowance(granter, mySender, sdk.NewCoins(sdk.NewCoin(voucher.Denom, sdk.NewInt(25)))))
+	require.NoError(t, err)
+
+	// the sender escrows the amount and the granter the fee
+	id, err := send(10)
+	require.NoError(t, err)
+	require.Equal(t, int64(900), input.BankKeeper.GetBalance(ctx, mySender, voucher.Denom).Amount.Int64())
+	require.Equal(t, int64(990), input.BankKeeper.GetBalance(ctx, granter, voucher.Denom).Amount.Int64())
+
+	allowance, found := gk.GetBridgeFeeAllowance(ctx, granter, mySender)
+	require.True(t, found)
+	require.Equal(t, int64(15), allowance.SpendLimit.AmountOf(voucher.Denom).Int64())
+
+	_, err = send(20)
+	require.ErrorIs(t, err, types.ErrBridgeFeeAllowanceExceeded)
+
+	// cancelling refunds the fee to the granter
+	_, err = msgServer.CancelSendToEthereum(sdk.WrapSDKContext(ctx), types.NewMsgCancelSendToEthereum(id, mySender))
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), input.BankKeeper.GetBalance(ctx, mySender, voucher.Denom).Amount.Int64())
+	require.Equal(t, int64(1000), input.BankKeeper.GetBalance(ctx, granter, voucher.Denom).Amount.Int64())
+
+	// the allowance is removed once spent
+	_, err = send(15)
+	require.NoError(t, err)
+	_, found = gk.GetBridgeFeeAllowance(ctx, granter, mySender)
+	require.False(t, found)
+
+	_, err = msgServer.RevokeBridgeFeeAllowance(sdk.WrapSDKContext(ctx), types.NewMsgRevokeBridgeFeeAllowance(granter, mySender))
+	require.ErrorIs(t, err, types.ErrBridgeFeeAllowanceNotFound)
+}
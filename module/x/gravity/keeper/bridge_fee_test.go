@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestBridgeFee(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos12luku6uxehhak02py4rcz65zu0swh7wj8a5enl")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		myTokenDenom        = "gravity0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		relayer             = common.HexToAddress("0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09")
+		relayerOrch, _      = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+	)
+
+	gk.setEthereumOrchestratorAddress(ctx, relayer, relayerOrch)
+
+	allCoins := sdk.NewCoins(sdk.NewCoin(myTokenDenom, sdk.NewInt(99999)), sdk.NewCoin("stake", sdk.NewInt(100)))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allCoins))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allCoins))
+
+	send := func(fee sdk.Coin) (uint64, error) {
+		return gk.createSendToEthereum(ctx, mySender, myReceiver, sdk.NewCoin(myTokenDenom, sdk.NewInt(100)), fee)
+	}
+
+	// fees in another denom are rejected until it is the bridge fee denom
+	_, err := send(sdk.NewCoin("stake", sdk.NewInt(5)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+
+	params := gk.GetParams(ctx)
+	params.BridgeFeeDenom = "stake"
+	gk.SetParams(ctx, params)
+
+	_, err = send(sdk.NewCoin(myTokenDenom, sdk.NewInt(2)))
+	require.NoError(t, err)
+	_, err = send(sdk.NewCoin("stake", sdk.NewInt(5)))
+	require.NoError(t, err)
+	canceled, err := send(sdk.NewCoin("stake", sdk.NewInt(7)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(88), input.BankKeeper.GetBalance(ctx, mySender, "stake").Amount)
+	checkInvariant(t, ctx, gk, true)
+
+	// the bridge fee is refunded on cancel
+	require.NoError(t, gk.cancelSendToEthereum(ctx, canceled, mySender.String()))
+	require.Equal(t, sdk.NewInt(95), input.BankKeeper.GetBalance(ctx, mySender, "stake").Amount)
+
+	batch := gk.BuildBatchTx(ctx, myTokenContractAddr, 100)
+	require.NotNil(t, batch)
+	require.Len(t, batch.Transactions, 2)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(5))), batch.BridgeFees)
+
+	// the bridge fee one has no erc20 fee
+	require.Equal(t, sdk.NewInt(2), batch.Transactions[0].Erc20Fee.Amount)
+	require.True(t, batch.Transactions[1].Erc20Fee.Amount.IsZero())
+
+	// the bridge fees are paid to the relayer of the batch
+	require.NoError(t, gk.batchTxExecuted(ctx, gk.GetParams(ctx), myTokenContractAddr, batch.BatchNonce, relayer.Hex()))
+	require.Equal(t, sdk.NewInt(5), input.BankKeeper.GetBalance(ctx, relayerOrch, "stake").Amount)
+	require.True(t, input.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName), "stake").IsZero())
+	checkInvariant(t, ctx, gk, true)
+
+	// the txs paying the bridge fee denom are taken in turn with those paying
+	// a token fee, however high the token fees
+	for _, fee := range []sdk.Coin{
+		sdk.NewCoin(myTokenDenom, sdk.NewInt(10)),
+		sdk.NewCoin(myTokenDenom, sdk.NewInt(20)),
+		sdk.NewCoin(myTokenDenom, sdk.NewInt(30)),
+		sdk.NewCoin("stake", sdk.NewInt(1)),
+	} {
+		_, err = send(fee)
+		require.NoError(t, err)
+	}
+	batch = gk.BuildBatchTx(ctx, myTokenContractAddr, 2)
+	require.NotNil(t, batch)
+	require.Len(t, batch.Transactions, 2)
+	require.Equal(t, sdk.NewInt(30), batch.Transactions[0].Erc20Fee.Amount)
+	require.Equal(t, sdk.NewCoin("stake", sdk.NewInt(1)), *batch.Transactions[1].BridgeFee)
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func (k Keeper) setValidatorOptedOut(ctx sdk.Context, val sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Set(types.MakeOptedOutValidatorKey(val), []byte{1})
+}
+
+// IsValidatorOptedOut reports whether a validator opted out of the bridge
+// duties
+func (k Keeper) IsValidatorOptedOut(ctx sdk.Context, val sdk.ValAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MakeOptedOutValidatorKey(val))
+}
+
+// IterateOptedOutValidators iterates over the validators opted out of the
+// bridge duties
+func (k Keeper) IterateOptedOutValidators(ctx sdk.Context, cb func(sdk.ValAddress) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.OptedOutValidatorKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(sdk.ValAddress(iter.Key())) {
+			break
+		}
+	}
+}
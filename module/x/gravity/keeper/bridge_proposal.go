@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"crypto/sha256"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// IsBridgeCriticalProposal reports whether a proposal must meet the elevated
+// bridge proposal quorum and threshold: gravity proposals and parameter
+// changes to the gravity subspace.
+func IsBridgeCriticalProposal(content govv1beta1.Content) bool {
+	if content.ProposalRoute() == types.RouterKey {
+		return true
+	}
+	if pcp, ok := content.(*paramsproposal.ParameterChangeProposal); ok {
+		for _, change := range pcp.Changes {
+			if change.Subspace == types.DefaultParamspace {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// bridgeProposalContentHash identifies a proposal by its content, since gov
+// proposal handlers do not receive the proposal id
+func bridgeProposalContentHash(content govv1beta1.Content) []byte {
+	msg, ok := content.(proto.Message)
+	if !ok {
+		panic("proposal content is not a proto message")
+	}
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
+
+// IsBridgeProposalRejected reports whether a bridge-critical proposal ending
+// this block fell short of the elevated quorum or threshold
+func (k Keeper) IsBridgeProposalRejected(ctx sdk.Context, content govv1beta1.Content) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MakeBridgeProposalRejectionKey(bridgeProposalContentHash(content)))
+}
+
+func (k Keeper) clearBridgeProposalRejections(ctx sdk.Context) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.BridgeProposalRejectionKey})
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
+// RecordBridgeProposalTallies tallies the bridge-critical proposals whose
+// voting period ends this block and marks those that pass the ordinary gov
+// tally but not the elevated bridge proposal quorum or threshold. It must run
+// before the gov EndBlocker, which deletes the votes while tallying.
+func (k Keeper) RecordBridgeProposalTallies(ctx sdk.Context) {
+	k.clearBridgeProposalRejections(ctx)
+
+	params := k.GetParams(ctx)
+	if k.govKeeper == nil || (params.BridgeProposalQuorum.IsZero() && params.BridgeProposalThreshold.IsZero()) {
+		return
+	}
+
+	k.govKeeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal govv1.Proposal) bool {
+		msgs, err := proposal.GetMsgs()
+		if err != nil {
+			return false
+		}
+
+		for _, msg := range msgs {
+			legacy, ok := msg.(*govv1.MsgExecLegacyContent)
+			if !ok {
+				continue
+			}
+			content, err := govv1.LegacyContentFromMessage(legacy)
+			if err != nil || !IsBridgeCriticalProposal(content) {
+				continue
+			}
+
+			// gov deletes votes as it tallies, so tally on a throwaway context
+			cacheCtx, _ := ctx.CacheContext()
+			passes, _, tally := k.govKeeper.Tally(cacheCtx, proposal)
+			if passes && !k.meetsBridgeProposalSupport(ctx, params, tally) {
+				ctx.KVStore(k.storeKey).Set(types.MakeBridgeProposalRejectionKey(bridgeProposalContentHash(content)), []byte{1})
+				k.Logger(ctx).Info("bridge proposal short of elevated quorum or threshold", "proposal id", proposal.Id)
+			}
+		}
+		return false
+	})
+}
+
+func (k Keeper) meetsBridgeProposalSupport(ctx sdk.Context, params types.Params, tally govv1.TallyResult) bool {
+	yes, abstain, no, veto := tallyCount(tally.YesCount), tallyCount(tally.AbstainCount), tallyCount(tally.NoCount), tallyCount(tally.NoWithVetoCount)
+
+	totalBonded := k.StakingKeeper.TotalBondedTokens(ctx)
+	if totalBonded.IsZero() {
+		return false
+	}
+	voted := yes.Add(abstain).Add(no).Add(veto)
+	if voted.Quo(sdk.NewDecFromInt(totalBonded)).LT(params.BridgeProposalQuorum) {
+		return false
+	}
+
+	nonAbstain := yes.Add(no).Add(veto)
+	if nonAbstain.IsZero() {
+		return false
+	}
+	return yes.Quo(nonAbstain).GTE(params.BridgeProposalThreshold)
+}
+
+func tallyCount(count string) sdk.Dec {
+	amount, ok := sdk.NewIntFromString(count)
+	if !ok {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(amount)
+}
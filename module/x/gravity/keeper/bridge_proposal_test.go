@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+type mockGovKeeper struct {
+	proposal govv1.Proposal
+	tally    govv1.TallyResult
+}
+
+func (m *mockGovKeeper) IterateActiveProposalsQueue(_ sdk.Context, _ time.Time, cb func(govv1.Proposal) bool) {
+	cb(m.proposal)
+}
+
+func (m *mockGovKeeper) Tally(_ sdk.Context, _ govv1.Proposal) (bool, bool, govv1.TallyResult) {
+	return true, false, m.tally
+}
+
+func TestRecordBridgeProposalTallies(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	params := gk.GetParams(ctx)
+	params.BridgeProposalQuorum = sdk.NewDecWithPrec(5, 1)
+	params.BridgeProposalThreshold = sdk.NewDecWithPrec(9, 1)
+	gk.SetParams(ctx, params)
+
+	content := types.NewEthereumBlocklistProposal("blocklist", "block an address", []string{EthAddrs[0].Hex()}, nil)
+	msg, err := govv1.NewLegacyContent(content, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	require.NoError(t, err)
+	proposal, err := govv1.NewProposal([]sdk.Msg{msg}, 1, "", ctx.BlockTime(), ctx.BlockTime())
+	require.NoError(t, err)
+
+	bonded := input.StakingKeeper.TotalBondedTokens(ctx)
+	govKeeper := &mockGovKeeper{proposal: proposal}
+	gk.SetGovKeeper(govKeeper)
+
+	// passes ordinary gov but not the elevated threshold
+	govKeeper.tally = govv1.TallyResult{
+		YesCount:        bonded.MulRaw(6).QuoRaw(10).String(),
+		AbstainCount:    "0",
+		NoCount:         bonded.QuoRaw(10).String(),
+		NoWithVetoCount: "0",
+	}
+	gk.RecordBridgeProposalTallies(ctx)
+	require.True(t, gk.IsBridgeProposalRejected(ctx, content))
+
+	// meets both the elevated quorum and threshold
+	govKeeper.tally.NoCount = "0"
+	gk.RecordBridgeProposalTallies(ctx)
+	require.False(t, gk.IsBridgeProposalRejected(ctx, content))
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/keeper/testutil"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestBridgeRoundTrip(t *testing.T) {
+	var (
+		tk            = testutil.NewTestKeeper(t, 10, 20, 30)
+		ctx           = tk.Context
+		gk            = tk.GravityKeeper
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom         = types.GravityDenom(tokenContract)
+		sender        = sdk.AccAddress(tk.Validators[0].Address)
+		ethReceiver   = tk.Validators[1].EthereumAddress
+	)
+
+	// deposit the tokens on cosmos
+	tk.ObserveEvent(t, testutil.NewSendToCosmosEvent(1, tokenContract, sdk.NewInt(1000), ethReceiver, sender, 10))
+	require.Equal(t, sdk.NewInt(1000), tk.BankKeeper.GetAllBalances(ctx, sender).AmountOf(denom))
+
+	// send part of them back to ethereum
+	msgServer := keeper.NewMsgServerImpl(gk)
+	_, err := msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), types.NewMsgSendToEthereum(sender, ethReceiver.Hex(), sdk.NewInt64Coin(denom, 600), sdk.NewInt64Coin(denom, 10)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(390), tk.BankKeeper.GetAllBalances(ctx, sender).AmountOf(denom))
+
+	batchTx := gk.BuildBatchTx(ctx, tokenContract, 10)
+	require.NotNil(t, batchTx)
+	require.Len(t, batchTx.Transactions, 1)
+
+	tk.ConfirmOutgoingTx(t, batchTx)
+	require.Len(t, gk.GetEthereumSignatures(ctx, batchTx.GetStoreIndex()), 3)
+
+	// the batch is removed once its execution is observed
+	tk.ObserveEvent(t, testutil.NewBatchExecutedEvent(2, batchTx, ethReceiver, 20))
+	require.Equal(t, uint64(2), gk.GetLastObservedEventNonce(ctx))
+	_, err = gk.GetOutgoingTx(ctx, batchTx.GetStoreIndex())
+	require.Error(t, err)
+
+	// the log of observed events replays to the live state
+	require.Equal(t, sdk.NewInt(390), gk.GetBridgedSupply(ctx, tokenContract))
+	res, err := gk.ReplayDiff(sdk.WrapSDKContext(ctx), &types.ReplayDiffRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Discrepancies)
+}
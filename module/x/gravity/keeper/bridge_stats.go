@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+const (
+	// bridgeStatsDayHours and bridgeStatsWeekHours are the number of hourly
+	// buckets, the current one included, the rolling windows sum
+	bridgeStatsDayHours  = 24
+	bridgeStatsWeekHours = 7 * 24
+)
+
+// bridgeStatsHour returns the hour of block time since the unix epoch
+func bridgeStatsHour(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockTime().Unix()) / 3600
+}
+
+func (k Keeper) getBridgeStatsBucket(ctx sdk.Context, hour uint64, tokenContract common.Address) types.BridgeStatsBucket {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeBridgeStatsKey(hour, tokenContract))
+	if bz == nil {
+		return types.BridgeStatsBucket{
+			TokenContract: tokenContract.Hex(),
+			Hour:          hour,
+			Inflow:        sdk.ZeroInt(),
+			Outflow:       sdk.ZeroInt(),
+			OutflowFees:   sdk.ZeroInt(),
+		}
+	}
+
+	var bucket types.BridgeStatsBucket
+	k.cdc.MustUnmarshal(bz, &bucket)
+	return bucket
+}
+
+func (k Keeper) setBridgeStatsBucket(ctx sdk.Context, bucket types.BridgeStatsBucket) {
+	key := types.MakeBridgeStatsKey(bucket.Hour, common.HexToAddress(bucket.TokenContract))
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&bucket))
+}
+
+// recordBridgeStatsInflow adds a deposit credited from ethereum to the stats
+// of the current hour
+func (k Keeper) recordBridgeStatsInflow(ctx sdk.Context, tokenContract common.Address, amount sdk.Int) {
+	bucket := k.getBridgeStatsBucket(ctx, bridgeStatsHour(ctx), tokenContract)
+	bucket.Inflow = bucket.Inflow.Add(amount)
+	bucket.InflowCount++
+	k.setBridgeStatsBucket(ctx, bucket)
+}
+
+// recordBridgeStatsOutflow adds a send to ethereum, and its fee in the token,
+// to the stats of the current hour
+func (k Keeper) recordBridgeStatsOutflow(ctx sdk.Context, tokenContract common.Address, amount, fee sdk.Int) {
+	bucket := k.getBridgeStatsBucket(ctx, bridgeStatsHour(ctx), tokenContract)
+	bucket.Outflow = bucket.Outflow.Add(amount)
+	bucket.OutflowCount++
+	bucket.OutflowFees = bucket.OutflowFees.Add(fee)
+	k.setBridgeStatsBucket(ctx, bucket)
+}
+
+// IterateBridgeStatsBuckets iterates over the hourly bridge stats of tokens
+// from an hour on, by hour then token contract
+func (k Keeper) IterateBridgeStatsBuckets(ctx sdk.Context, fromHour uint64, cb func(bucket types.BridgeStatsBucket) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.BridgeStatsKey}).Iterator(sdk.Uint64ToBigEndian(fromHour), nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var bucket types.BridgeStatsBucket
+		k.cdc.MustUnmarshal(iter.Value(), &bucket)
+		if cb(bucket) {
+			break
+		}
+	}
+}
+
+// GetBridgeStats returns the usage of the bridge by tokens over the last 24
+// hours and 7 days of block time, by token contract. Only the stats of the
+// token are returned if one is given.
+func (k Keeper) GetBridgeStats(ctx sdk.Context, tokenContract *common.Address) []types.BridgeTokenStats {
+	hour := bridgeStatsHour(ctx)
+	var fromHour uint64
+	if hour >= bridgeStatsWeekHours {
+		fromHour = hour - bridgeStatsWeekHours + 1
+	}
+
+	var contracts []common.Address
+	byContract := make(map[common.Address]*types.BridgeTokenStats)
+	k.IterateBridgeStatsBuckets(ctx, fromHour, func(bucket types.BridgeStatsBucket) bool {
+		contract := common.HexToAddress(bucket.TokenContract)
+		if tokenContract != nil && contract != *tokenContract {
+			return false
+		}
+
+		stats, ok := byContract[contract]
+		if !ok {
+			stats = &types.BridgeTokenStats{
+				TokenContract: contract.Hex(),
+				LastDay:       newBridgeStatsWindow(),
+				LastWeek:      newBridgeStatsWindow(),
+			}
+			byContract[contract] = stats
+			contracts = append(contracts, contract)
+		}
+
+		addBridgeStatsBucket(&stats.LastWeek, bucket)
+		if bucket.Hour+bridgeStatsDayHours > hour {
+			addBridgeStatsBucket(&stats.LastDay, bucket)
+		}
+		return false
+	})
+
+	// the buckets are iterated by hour, the stats are returned by token
+	sort.Slice(contracts, func(i, j int) bool {
+		return bytes.Compare(contracts[i].Bytes(), contracts[j].Bytes()) < 0
+	})
+
+	out := make([]types.BridgeTokenStats, 0, len(contracts))
+	for _, contract := range contracts {
+		stats := byContract[contract]
+		stats.LastDay.AverageFee = averageBridgeStatsFee(stats.LastDay)
+		stats.LastWeek.AverageFee = averageBridgeStatsFee(stats.LastWeek)
+		out = append(out, *stats)
+	}
+	return out
+}
+
+func newBridgeStatsWindow() types.BridgeStatsWindow {
+	return types.BridgeStatsWindow{
+		Inflow:     sdk.ZeroInt(),
+		Outflow:    sdk.ZeroInt(),
+		AverageFee: sdk.ZeroInt(),
+	}
+}
+
+// addBridgeStatsBucket adds the usage of an hour to a window, summing the fees
+// in the average fee until averageBridgeStatsFee is called
+func addBridgeStatsBucket(window *types.BridgeStatsWindow, bucket types.BridgeStatsBucket) {
+	window.Inflow = window.Inflow.Add(bucket.Inflow)
+	window.Outflow = window.Outflow.Add(bucket.Outflow)
+	window.InflowCount += bucket.InflowCount
+	window.OutflowCount += bucket.OutflowCount
+	window.AverageFee = window.AverageFee.Add(bucket.OutflowFees)
+}
+
+func averageBridgeStatsFee(window types.BridgeStatsWindow) sdk.Int {
+	if window.OutflowCount == 0 {
+		return sdk.ZeroInt()
+	}
+	return window.AverageFee.QuoRaw(int64(window.OutflowCount))
+}
+
+// PruneBridgeStats deletes the hourly bridge stats older than the last week
+func (k Keeper) PruneBridgeStats(ctx sdk.Context) {
+	hour := bridgeStatsHour(ctx)
+	if hour < bridgeStatsWeekHours {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.BridgeStatsKey})
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(hour-bridgeStatsWeekHours+1))
+
+	// collect first, the store can't be written while it is being iterated
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestBridgeStats(t *testing.T) {
+	input := CreateTestEnv(t)
+	gk := input.GravityKeeper
+	tokenA := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	tokenB := common.HexToAddress("0x0bfaC60d3A9Bd8C8b8D77aB6a7bf0C8b3ee5D9a1")
+	start := time.Unix(1_700_000_000, 0)
+
+	// two days ago, only in the week
+	ctx := input.Context.WithBlockTime(start)
+	gk.recordBridgeStatsOutflow(ctx, tokenA, sdk.NewInt(100), sdk.NewInt(10))
+
+	ctx = ctx.WithBlockTime(start.Add(48 * time.Hour))
+	gk.recordBridgeStatsInflow(ctx, tokenA, sdk.NewInt(500))
+	gk.recordBridgeStatsOutflow(ctx, tokenA, sdk.NewInt(200), sdk.NewInt(2))
+	gk.recordBridgeStatsInflow(ctx, tokenB, sdk.NewInt(7))
+
+	stats := gk.GetBridgeStats(ctx, nil)
+	require.Len(t, stats, 2)
+	require.Equal(t, tokenB.Hex(), stats[0].TokenContract)
+	require.Equal(t, tokenA.Hex(), stats[1].TokenContract)
+
+	a := stats[1]
+	require.Equal(t, sdk.NewInt(500), a.LastDay.Inflow)
+	require.Equal(t, sdk.NewInt(200), a.LastDay.Outflow)
+	require.Equal(t, uint64(1), a.LastDay.OutflowCount)
+	require.Equal(t, sdk.NewInt(2), a.LastDay.AverageFee)
+	require.Equal(t, sdk.NewInt(300), a.LastWeek.Outflow)
+	require.Equal(t, uint64(2), a.LastWeek.OutflowCount)
+	require.Equal(t, sdk.NewInt(6), a.LastWeek.AverageFee)
+
+	stats = gk.GetBridgeStats(ctx, &tokenB)
+	require.Len(t, stats, 1)
+	require.Equal(t, uint64(1), stats[0].LastWeek.InflowCount)
+
+	// a week after the first outflow it falls out of the week and is pruned
+	ctx = ctx.WithBlockTime(start.Add(7 * 24 * time.Hour))
+	gk.PruneBridgeStats(ctx)
+	var hours []uint64
+	gk.IterateBridgeStatsBuckets(ctx, 0, func(bucket types.BridgeStatsBucket) bool {
+		hours = append(hours, bucket.Hour)
+		return false
+	})
+	require.Len(t, hours, 2)
+	a = gk.GetBridgeStats(ctx, &tokenA)[0]
+	require.Equal(t, sdk.NewInt(200), a.LastWeek.Outflow)
+	require.Equal(t, sdk.ZeroInt(), a.LastDay.Outflow)
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// calldataSignedPowerPercent is the share of the power of the signer set the
+// signatures of a submitted outgoing tx must reach, as relayers require it
+// before submitting
+const calldataSignedPowerPercent = 66
+
+// getOutgoingTxCalldata returns the calldata of the gravity contract call
+// submitting an outgoing tx, signed by the last signer set observed on
+// ethereum, which is the one the contract checks signatures against
+func (k Keeper) getOutgoingTxCalldata(ctx sdk.Context, storeIndex []byte) (*types.OutgoingTxCalldataResponse, error) {
+	otx, err := k.GetOutgoingTx(ctx, storeIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	current := k.GetLastObservedSignerSetTx(ctx)
+	if current == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no signer set observed on ethereum")
+	}
+	if sstx, ok := otx.(*types.SignerSetTx); ok && sstx.Nonce <= current.Nonce {
+		return nil, status.Errorf(codes.FailedPrecondition, "signer set %d is not newer than the observed signer set %d", sstx.Nonce, current.Nonce)
+	}
+
+	// the signatures are stored by validator, whose ethereum address may have
+	// changed since, so they are matched to the signers they recover to
+	checkpoint := otx.GetCheckpoint([]byte(k.getGravityID(ctx)))
+	bySigner := make(map[common.Address][]byte)
+	k.iterateEthereumSignatures(ctx, storeIndex, func(_ sdk.ValAddress, sig types.EthereumSignature) bool {
+		if signer, err := types.EthereumSignatureSigner(checkpoint, sig.Signature); err == nil {
+			bySigner[signer] = sig.Signature
+		}
+		return false
+	})
+
+	current.Signers.Sort()
+	signatures := make([][]byte, len(current.Signers))
+	res := &types.OutgoingTxCalldataResponse{
+		CurrentSignerSetNonce: current.Nonce,
+		TotalPower:            current.Signers.TotalPower(),
+	}
+	for i, signer := range current.Signers {
+		if sig, ok := bySigner[common.HexToAddress(signer.EthereumAddress)]; ok {
+			signatures[i] = sig
+			res.SignedPower += signer.Power
+		}
+	}
+	if res.SignedPower*100 < res.TotalPower*calldataSignedPowerPercent {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientSignatures, "signed power %d of %d", res.SignedPower, res.TotalPower)
+	}
+
+	switch otx := otx.(type) {
+	case *types.SignerSetTx:
+		res.Calldata, err = otx.GetSubmitCalldata(*current, signatures)
+	case *types.BatchTx:
+		res.Calldata, err = otx.GetSubmitCalldata(*current, signatures)
+	case *types.ContractCallTx:
+		res.Calldata, err = otx.GetSubmitCalldata(*current, signatures)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "outgoing tx of type %T can't be submitted", otx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
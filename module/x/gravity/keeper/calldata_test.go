@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"crypto/ecdsa"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestGetOutgoingTxCalldata(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+
+	privKeys := make([]*ecdsa.PrivateKey, 3)
+	signers := make(types.EthereumSigners, 3)
+	for i := range privKeys {
+		privKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		privKeys[i] = privKey
+		signers[i] = &types.EthereumSigner{
+			EthereumAddress: crypto.PubkeyToAddress(privKey.PublicKey).Hex(),
+			Power:           uint64(1000 + i),
+		}
+	}
+
+	batch := &types.BatchTx{BatchNonce: 1, TokenContract: tokenContract.Hex(), Timeout: 100, Height: 1}
+	gk.SetOutgoingTx(ctx, batch)
+
+	// without a signer set on ethereum there is nothing to sign against
+	_, err := gk.getOutgoingTxCalldata(ctx, batch.GetStoreIndex())
+	require.Error(t, err)
+	gk.setLastObservedSignerSetTx(ctx, *types.NewSignerSetTx(1, 1, signers))
+
+	sign := func(i int) {
+		signature, err := types.NewEthereumSignature(batch.GetCheckpoint([]byte(gk.getGravityID(ctx))), privKeys[i])
+		require.NoError(t, err)
+		gk.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+			TokenContract:  tokenContract.Hex(),
+			BatchNonce:     batch.BatchNonce,
+			EthereumSigner: signers[i].EthereumAddress,
+			Signature:      signature,
+		}, ValAddrs[i])
+	}
+
+	sign(2)
+	_, err = gk.getOutgoingTxCalldata(ctx, batch.GetStoreIndex())
+	require.ErrorIs(t, err, types.ErrInsufficientSignatures)
+
+	sign(1)
+	res, err := gk.getOutgoingTxCalldata(ctx, batch.GetStoreIndex())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.CurrentSignerSetNonce)
+	require.Equal(t, uint64(1001+1002), res.SignedPower)
+
+	contractAbi, err := abi.JSON(strings.NewReader(types.GravitySubmitABIJSON))
+	require.NoError(t, err)
+	method := contractAbi.Methods["submitBatch"]
+	require.Equal(t, method.ID, res.Calldata[:4])
+
+	args, err := method.Inputs.Unpack(res.Calldata[4:])
+	require.NoError(t, err)
+	var sigs []struct {
+		V uint8
+		R [32]byte
+		S [32]byte
+	}
+	abi.ConvertType(args[1], &sigs)
+
+	// the signers are sorted by power, the one that did not sign is left zero
+	require.Len(t, sigs, 3)
+	require.Equal(t, uint8(0), sigs[2].V)
+	for _, sig := range sigs[:2] {
+		require.Contains(t, []uint8{27, 28}, sig.V)
+	}
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// distributeChainFees moves the chain fees escrowed for the txs of an executed
+// batch to the chain fee destination: the community pool, a burn, or the fee
+// collector, from which the distribution module pays them to stakers
+func (k Keeper) distributeChainFees(ctx sdk.Context, params types.Params, batchTx *types.BatchTx) error {
+	fees := sdk.NewCoins()
+	for _, tx := range batchTx.Transactions {
+		fees = fees.Add(tx.GetChainFeeCoins()...)
+	}
+	if fees.Empty() {
+		return nil
+	}
+
+	destination := params.ChainFeeDestination
+	switch destination {
+	case types.ChainFeeDestinationBurn:
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, fees); err != nil {
+			return sdkerrors.Wrapf(err, "burn chain fees: %s", fees)
+		}
+	case types.ChainFeeDestinationStakers:
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, fees); err != nil {
+			return sdkerrors.Wrapf(err, "send chain fees to stakers: %s", fees)
+		}
+	default:
+		if err := k.sendToCommunityPool(ctx, fees); err != nil {
+			return sdkerrors.Wrapf(err, "send chain fees to community pool: %s", fees)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeChainFee,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyBatchNonce, fmt.Sprint(batchTx.BatchNonce)),
+		sdk.NewAttribute(types.AttributeKeyChainFee, fees.String()),
+		sdk.NewAttribute(types.AttributeKeyChainFeeDestination, destination),
+	))
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestChainFee(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos12luku6uxehhak02py4rcz65zu0swh7wj8a5enl")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		myTokenDenom        = "gravity0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+
+	params := gk.GetParams(ctx)
+	params.ChainFeeBasisPoints = 100
+	params.ChainFeeDestination = types.ChainFeeDestinationCommunityPool
+	gk.SetParams(ctx, params)
+
+	allVouchers := sdk.NewCoins(sdk.NewCoin(myTokenDenom, sdk.NewInt(10000)))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	send := func() uint64 {
+		id, err := gk.createSendToEthereum(ctx, mySender, myReceiver, sdk.NewCoin(myTokenDenom, sdk.NewInt(1000)), sdk.NewCoin(myTokenDenom, sdk.NewInt(10)))
+		require.NoError(t, err)
+		return id
+	}
+
+	// 1% of the amount is deducted as the chain fee and escrowed with the tx
+	kept := send()
+	canceled := send()
+	require.Equal(t, sdk.NewInt(7980), input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom).Amount)
+	for _, ste := range gk.getUnbatchedSendToEthereums(ctx) {
+		require.Equal(t, sdk.NewInt(990), ste.Erc20Token.Amount)
+		require.Equal(t, sdk.NewCoin(myTokenDenom, sdk.NewInt(10)), *ste.ChainFee)
+	}
+	checkInvariant(t, ctx, gk, true)
+
+	// the chain fee is refunded on cancel
+	require.NoError(t, gk.cancelSendToEthereum(ctx, canceled, mySender.String()))
+	require.Equal(t, sdk.NewInt(8990), input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom).Amount)
+
+	// and stays escrowed with the tx when its batch is cancelled
+	batch := gk.BuildBatchTx(ctx, myTokenContractAddr, 100)
+	require.NotNil(t, batch)
+	gk.CancelBatchTx(ctx, batch)
+	checkInvariant(t, ctx, gk, true)
+
+	batch = gk.BuildBatchTx(ctx, myTokenContractAddr, 100)
+	require.NotNil(t, batch)
+	require.Equal(t, kept, batch.Transactions[0].Id)
+	require.True(t, input.DistKeeper.GetFeePool(ctx).CommunityPool.IsZero())
+
+	// it goes to the chain fee destination once the batch executes
+	require.NoError(t, gk.batchTxExecuted(ctx, gk.GetParams(ctx), myTokenContractAddr, batch.BatchNonce, ""))
+	require.Equal(t, sdk.NewInt(10), input.DistKeeper.GetFeePool(ctx).CommunityPool.AmountOf(myTokenDenom).TruncateInt())
+	require.True(t, input.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), myTokenDenom).IsZero())
+	checkInvariant(t, ctx, gk, true)
+
+	// nothing is left to send when the chain fee takes the whole amount
+	params.ChainFeeBasisPoints = types.ChainFeeBasisPointsMax
+	gk.SetParams(ctx, params)
+	_, err := gk.createSendToEthereum(ctx, mySender, myReceiver, sdk.NewCoin(myTokenDenom, sdk.NewInt(1000)), sdk.NewCoin(myTokenDenom, sdk.NewInt(10)))
+	require.Error(t, err)
+}
+
+func TestChainFeeDestinations(t *testing.T) {
+	for _, destination := range []string{types.ChainFeeDestinationBurn, types.ChainFeeDestinationStakers} {
+		t.Run(destination, func(t *testing.T) {
+			input := CreateTestEnv(t)
+			ctx := input.Context
+			gk := input.GravityKeeper
+
+			var (
+				mySender, _         = sdk.AccAddressFromBech32("cosmos12luku6uxehhak02py4rcz65zu0swh7wj8a5enl")
+				myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+				myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+				myTokenDenom        = "gravity0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+			)
+
+			params := gk.GetParams(ctx)
+			params.ChainFeeBasisPoints = 100
+			params.ChainFeeDestination = destination
+			gk.SetParams(ctx, params)
+
+			allVouchers := sdk.NewCoins(sdk.NewCoin(myTokenDenom, sdk.NewInt(10000)))
+			require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+			input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+			require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+			_, err := gk.createSendToEthereum(ctx, mySender, myReceiver, sdk.NewCoin(myTokenDenom, sdk.NewInt(1000)), sdk.NewCoin(myTokenDenom, sdk.NewInt(10)))
+			require.NoError(t, err)
+			batch := gk.BuildBatchTx(ctx, myTokenContractAddr, 100)
+			require.NotNil(t, batch)
+			require.NoError(t, gk.batchTxExecuted(ctx, gk.GetParams(ctx), myTokenContractAddr, batch.BatchNonce, ""))
+
+			feeCollected := input.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName), myTokenDenom).Amount
+			if destination == types.ChainFeeDestinationStakers {
+				require.Equal(t, sdk.NewInt(10), feeCollected)
+				require.Equal(t, sdk.NewInt(10000), input.BankKeeper.GetSupply(ctx, myTokenDenom).Amount.Add(sdk.NewInt(1000)))
+			} else {
+				require.True(t, feeCollected.IsZero())
+				require.Equal(t, sdk.NewInt(10000), input.BankKeeper.GetSupply(ctx, myTokenDenom).Amount.Add(sdk.NewInt(1000)).Add(sdk.NewInt(10)))
+			}
+			require.True(t, input.DistKeeper.GetFeePool(ctx).CommunityPool.IsZero())
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// bridgeFlowAverageWeight is the number of windows the trailing averages
+// roughly span: each window moves the average by 1/bridgeFlowAverageWeight of
+// the difference with its flow
+const bridgeFlowAverageWeight = 8
+
+// GetBridgeFlow returns the flow of a token in the current circuit breaker window
+func (k Keeper) GetBridgeFlow(ctx sdk.Context, tokenContract common.Address) types.BridgeFlow {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeBridgeFlowKey(tokenContract))
+	if bz == nil {
+		return types.BridgeFlow{
+			TokenContract:  tokenContract.Hex(),
+			Inflow:         sdk.ZeroInt(),
+			Outflow:        sdk.ZeroInt(),
+			AverageInflow:  sdk.ZeroInt(),
+			AverageOutflow: sdk.ZeroInt(),
+		}
+	}
+
+	var flow types.BridgeFlow
+	k.cdc.MustUnmarshal(bz, &flow)
+	return flow
+}
+
+func (k Keeper) setBridgeFlow(ctx sdk.Context, flow types.BridgeFlow) {
+	key := types.MakeBridgeFlowKey(common.HexToAddress(flow.TokenContract))
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&flow))
+}
+
+// IterateBridgeFlows iterates over the flows of all tokens
+func (k Keeper) IterateBridgeFlows(ctx sdk.Context, cb func(flow types.BridgeFlow) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.BridgeFlowKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var flow types.BridgeFlow
+		k.cdc.MustUnmarshal(iter.Value(), &flow)
+		if cb(flow) {
+			break
+		}
+	}
+}
+
+func (k Keeper) getBridgeFlows(ctx sdk.Context) (flows []types.BridgeFlow) {
+	k.IterateBridgeFlows(ctx, func(flow types.BridgeFlow) bool {
+		flows = append(flows, flow)
+		return false
+	})
+	return flows
+}
+
+// recordBridgeInflow adds a deposit credited from ethereum to the flow of its token
+func (k Keeper) recordBridgeInflow(ctx sdk.Context, tokenContract common.Address, amount sdk.Int) {
+	flow := k.GetBridgeFlow(ctx, tokenContract)
+	flow.Inflow = flow.Inflow.Add(amount)
+	k.setBridgeFlow(ctx, flow)
+}
+
+// recordBridgeOutflow adds a send to ethereum to the flow of its token
+func (k Keeper) recordBridgeOutflow(ctx sdk.Context, tokenContract common.Address, amount sdk.Int) {
+	flow := k.GetBridgeFlow(ctx, tokenContract)
+	flow.Outflow = flow.Outflow.Add(amount)
+	k.setBridgeFlow(ctx, flow)
+}
+
+// getOutflowCap returns the configured outflow cap for a token, if any
+func getOutflowCap(params types.Params, tokenContract common.Address) (sdk.Int, bool) {
+	for _, outflowCap := range params.CircuitBreakerOutflowCaps {
+		if common.HexToAddress(outflowCap.TokenContract) == tokenContract {
+			return outflowCap.Cap, true
+		}
+	}
+	return sdk.Int{}, false
+}
+
+// exceedsTrailingAverage reports whether the flow of the current window is
+// more than multiple times the trailing average. Tokens without history are
+// never reported, there is nothing to compare them with yet.
+func exceedsTrailingAverage(current, average sdk.Int, multiple sdk.Dec) bool {
+	if !multiple.IsPositive() || !average.IsPositive() {
+		return false
+	}
+	return sdk.NewDecFromInt(current).GT(multiple.MulInt(average))
+}
+
+// bridgeFlowAnomaly returns why the flow of a token should halt the bridge,
+// or an empty string if it shouldn't
+func (k Keeper) bridgeFlowAnomaly(ctx sdk.Context, params types.Params, flow types.BridgeFlow) string {
+	if outflowCap, ok := getOutflowCap(params, common.HexToAddress(flow.TokenContract)); ok && flow.Outflow.GT(outflowCap) {
+		return "outflow cap exceeded"
+	}
+	if exceedsTrailingAverage(flow.Inflow, flow.AverageInflow, params.CircuitBreakerMultiple) {
+		return "inflow deviates from trailing average"
+	}
+	if exceedsTrailingAverage(flow.Outflow, flow.AverageOutflow, params.CircuitBreakerMultiple) {
+		return "outflow deviates from trailing average"
+	}
+	return ""
+}
+
+// CheckCircuitBreaker halts the bridge when the flow of a token in the current
+// window exceeds its outflow cap or deviates too far from its trailing average.
+// At the end of each window the trailing averages are updated and the flows
+// of the window reset.
+func (k Keeper) CheckCircuitBreaker(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	flows := k.getBridgeFlows(ctx)
+
+	if params.BridgeActive {
+		for _, flow := range flows {
+			if reason := k.bridgeFlowAnomaly(ctx, params, flow); reason != "" {
+				k.DisableBridge(ctx)
+				k.Logger(ctx).Error("circuit breaker halted the bridge", "token", flow.TokenContract, "reason", reason)
+				k.RecordEndBlockerAction(ctx, types.EndBlockerActionBridgeHalted, fmt.Sprintf("%s: %s", flow.TokenContract, reason))
+
+				ctx.EventManager().EmitEvent(sdk.NewEvent(
+					types.EventTypeBridgeHalted,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeKeyContract, flow.TokenContract),
+					sdk.NewAttribute(types.AttributeKeyHaltReason, reason),
+				))
+				break
+			}
+		}
+	}
+
+	if ctx.BlockHeight()%int64(params.CircuitBreakerWindow) != 0 {
+		return
+	}
+
+	for _, flow := range flows {
+		flow.AverageInflow = flow.AverageInflow.Add(flow.Inflow.Sub(flow.AverageInflow).QuoRaw(bridgeFlowAverageWeight))
+		flow.AverageOutflow = flow.AverageOutflow.Add(flow.Outflow.Sub(flow.AverageOutflow).QuoRaw(bridgeFlowAverageWeight))
+		flow.Inflow = sdk.ZeroInt()
+		flow.Outflow = sdk.ZeroInt()
+		k.setBridgeFlow(ctx, flow)
+	}
+}
+
+// HandleBridgeReenableProposal turns the bridge back on. The flows of the
+// current window are cleared so the circuit breaker doesn't trip again on the
+// same anomaly.
+func (k Keeper) HandleBridgeReenableProposal(ctx sdk.Context, p *types.BridgeReenableProposal) error {
+	for _, flow := range k.getBridgeFlows(ctx) {
+		flow.Inflow = sdk.ZeroInt()
+		flow.Outflow = sdk.ZeroInt()
+		k.setBridgeFlow(ctx, flow)
+	}
+
+	params := k.GetParams(ctx)
+	params.BridgeActive = true
+	k.SetParams(ctx, params)
+
+	k.Logger(ctx).Info("bridge re-enabled by governance", "title", p.Title)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBridgeReenabled,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+	))
+
+	return nil
+}
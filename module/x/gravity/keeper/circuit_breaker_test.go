@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestCircuitBreakerOutflowCap(t *testing.T) {
+	input := CreateTestEnv(t)
+	gk := input.GravityKeeper
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+
+	params := gk.GetParams(input.Context)
+	params.CircuitBreakerOutflowCaps = []types.OutflowCap{{TokenContract: tokenContract.Hex(), Cap: sdk.NewInt(100)}}
+	gk.SetParams(input.Context, params)
+
+	// stay off the window boundary so the flows are not reset
+	ctx := input.Context.WithBlockHeight(int64(params.CircuitBreakerWindow) + 1)
+
+	gk.recordBridgeOutflow(ctx, tokenContract, sdk.NewInt(100))
+	gk.CheckCircuitBreaker(ctx)
+	require.True(t, gk.GetParams(ctx).BridgeActive)
+
+	gk.recordBridgeOutflow(ctx, tokenContract, sdk.NewInt(1))
+	gk.CheckCircuitBreaker(ctx)
+	require.False(t, gk.GetParams(ctx).BridgeActive)
+
+	require.NoError(t, gk.HandleBridgeReenableProposal(ctx, types.NewBridgeReenableProposal("title", "description")))
+	require.True(t, gk.GetParams(ctx).BridgeActive)
+	require.True(t, gk.GetBridgeFlow(ctx, tokenContract).Outflow.IsZero())
+
+	gk.CheckCircuitBreaker(ctx)
+	require.True(t, gk.GetParams(ctx).BridgeActive)
+}
+
+func TestCircuitBreakerTrailingAverage(t *testing.T) {
+	input := CreateTestEnv(t)
+	gk := input.GravityKeeper
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+
+	params := gk.GetParams(input.Context)
+	params.CircuitBreakerMultiple = sdk.NewDec(3)
+	gk.SetParams(input.Context, params)
+
+	window := int64(params.CircuitBreakerWindow)
+	ctx := input.Context.WithBlockHeight(window)
+
+	// without history nothing can deviate
+	gk.recordBridgeInflow(ctx, tokenContract, sdk.NewInt(800))
+	gk.CheckCircuitBreaker(ctx)
+	require.True(t, gk.GetParams(ctx).BridgeActive)
+
+	// the window ended, the average moved an eighth of the way
+	flow := gk.GetBridgeFlow(ctx, tokenContract)
+	require.Equal(t, sdk.NewInt(100), flow.AverageInflow)
+	require.True(t, flow.Inflow.IsZero())
+
+	ctx = ctx.WithBlockHeight(window + 1)
+
+	gk.recordBridgeInflow(ctx, tokenContract, sdk.NewInt(300))
+	gk.CheckCircuitBreaker(ctx)
+	require.True(t, gk.GetParams(ctx).BridgeActive)
+
+	gk.recordBridgeInflow(ctx, tokenContract, sdk.NewInt(1))
+	gk.CheckCircuitBreaker(ctx)
+	require.False(t, gk.GetParams(ctx).BridgeActive)
+}
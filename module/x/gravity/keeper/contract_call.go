@@ -4,29 +4,160 @@ import (
 	"bytes"
 	"encoding/hex"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
 )
 
-func (k Keeper) contractCallExecuted(ctx sdk.Context, invalidationScope []byte, invalidationNonce uint64) {
-	otx := k.GetOutgoingTx(ctx, types.MakeContractCallTxKey(invalidationScope, invalidationNonce))
-	if otx == nil {
+// contractCallExecuted deletes the executed contract call and refunds the
+// earlier nonces of its scope. It returns the executed contract call, or nil if
+// it is not in the store, and fails if its escrowed vouchers can't be burned.
+func (k Keeper) contractCallExecuted(ctx sdk.Context, invalidationScope []byte, invalidationNonce uint64) (*types.ContractCallTx, error) {
+	otx, err := k.GetOutgoingTx(ctx, types.MakeContractCallTxKey(invalidationScope, invalidationNonce))
+	if err != nil {
 		k.Logger(ctx).Error("Failed to clean contract calls",
 			"invalidation scope", hex.EncodeToString(invalidationScope),
-			"invalidation nonce", invalidationNonce)
-		return
+			"invalidation nonce", invalidationNonce,
+			"cause", err.Error())
+		return nil, nil
 	}
 
-	completedCallTx, _ := otx.(*types.ContractCallTx)
+	completedCallTx, ok := otx.(*types.ContractCallTx)
+	if !ok {
+		k.Logger(ctx).Error("Failed to clean contract calls, outgoing tx is not a contract call",
+			"invalidation scope", hex.EncodeToString(invalidationScope),
+			"invalidation nonce", invalidationNonce)
+		return nil, nil
+	}
 	k.IterateOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
 		// If the iterated contract call's nonce is lower than the one that was just executed, delete it
 		cctx, _ := otx.(*types.ContractCallTx)
 		if (cctx.InvalidationNonce < completedCallTx.InvalidationNonce) &&
 			bytes.Equal(cctx.InvalidationScope, completedCallTx.InvalidationScope) {
-			k.DeleteOutgoingTx(ctx, cctx.GetStoreIndex())
+			if k.RefundContractCallTx(ctx, cctx) {
+				k.DeleteOutgoingTx(ctx, cctx.GetStoreIndex())
+			}
 		}
 		return false
 	})
 
+	// the escrowed tokens left the bridge, burn the vouchers of ethereum
+	// originated ones as executed batches do
+	if completedCallTx.Refundee != "" {
+		if _, burn := k.contractCallTxCoins(ctx, completedCallTx); !burn.IsZero() {
+			if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burn); err != nil {
+				return nil, sdkerrors.Wrapf(err, "burn escrowed contract call vouchers: %s", burn)
+			}
+		}
+	}
+
 	k.DeleteOutgoingTx(ctx, completedCallTx.GetStoreIndex())
+	return completedCallTx, nil
+}
+
+// contractCallTxCoins returns the coins the tokens and fees of a contract call
+// are escrowed as, and the part of them that are vouchers of ethereum
+// originated tokens
+func (k Keeper) contractCallTxCoins(ctx sdk.Context, contractCallTx *types.ContractCallTx) (coins sdk.Coins, vouchers sdk.Coins) {
+	for _, tokens := range [][]types.ERC20Token{contractCallTx.Tokens, contractCallTx.Fees} {
+		for _, token := range tokens {
+			tokenContract := common.HexToAddress(token.Contract)
+			isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, tokenContract)
+			coin := sdk.NewCoin(denom, k.ERC20ToCosmosAmount(ctx, tokenContract, token.Amount))
+			coins = coins.Add(coin)
+			if !isCosmosOriginated {
+				vouchers = vouchers.Add(coin)
+			}
+		}
+	}
+	return coins, vouchers
+}
+
+// escrowContractCallTx moves the tokens and fees of a new contract call from
+// its refundee, an account address or else a module name, to the gravity
+// module account
+func (k Keeper) escrowContractCallTx(ctx sdk.Context, contractCallTx *types.ContractCallTx) error {
+	if contractCallTx.Refundee == "" {
+		return nil
+	}
+	coins, _ := k.contractCallTxCoins(ctx, contractCallTx)
+	if coins.IsZero() {
+		return nil
+	}
+	if addr, err := sdk.AccAddressFromBech32(contractCallTx.Refundee); err == nil {
+		return k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, coins)
+	}
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, contractCallTx.Refundee, types.ModuleName, coins)
+}
+
+// RefundContractCallTx returns the escrowed tokens and fees of a contract call
+// that timed out or was invalidated to its refundee, and archives the record of
+// the refund. It does not delete the contract call. A failed refund is logged
+// and reported as false so that the caller keeps the contract call, and its
+// escrow, to retry later instead of halting the chain.
+func (k Keeper) RefundContractCallTx(ctx sdk.Context, contractCallTx *types.ContractCallTx) (refunded bool) {
+	if contractCallTx.Refundee == "" {
+		return true
+	}
+	coins, _ := k.contractCallTxCoins(ctx, contractCallTx)
+	if !coins.IsZero() {
+		var err error
+		if addr, addrErr := sdk.AccAddressFromBech32(contractCallTx.Refundee); addrErr == nil {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+		} else {
+			err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, contractCallTx.Refundee, coins)
+		}
+		if err != nil {
+			k.Logger(ctx).Error("Failed to refund contract call escrow",
+				"invalidation scope", hex.EncodeToString(contractCallTx.InvalidationScope),
+				"invalidation nonce", contractCallTx.InvalidationNonce,
+				"refundee", contractCallTx.Refundee,
+				"escrow", coins.String(),
+				"cause", err.Error())
+			return false
+		}
+	}
+
+	k.setContractCallTxRefundRecord(ctx, types.ContractCallTxRefundRecord{
+		InvalidationScope: contractCallTx.InvalidationScope,
+		InvalidationNonce: contractCallTx.InvalidationNonce,
+		Refundee:          contractCallTx.Refundee,
+		Refunded:          coins,
+		CreatedHeight:     contractCallTx.Height,
+		RefundedHeight:    uint64(ctx.BlockHeight()),
+	})
+
+	types.EmitTypedEvent(ctx, &types.EventContractCallTxRefunded{
+		BridgeContract:    k.getBridgeContractAddress(ctx),
+		BridgeChainId:     k.getBridgeChainID(ctx),
+		InvalidationScope: hexutil.Encode(contractCallTx.InvalidationScope),
+		InvalidationNonce: contractCallTx.InvalidationNonce,
+		Refundee:          contractCallTx.Refundee,
+		Refunded:          coins.String(),
+	})
+	return true
+}
+
+func (k Keeper) setContractCallTxRefundRecord(ctx sdk.Context, record types.ContractCallTxRefundRecord) {
+	key := types.MakeRefundedContractCallTxKey(record.RefundedHeight, record.InvalidationScope, record.InvalidationNonce)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&record))
+}
+
+// IterateContractCallTxRefundRecords iterates over the archived records of
+// refunded contract calls in refund order
+func (k Keeper) IterateContractCallTxRefundRecords(ctx sdk.Context, cb func(types.ContractCallTxRefundRecord) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.RefundedContractCallTxKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record types.ContractCallTxRefundRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
 }
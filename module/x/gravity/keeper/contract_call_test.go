@@ -5,8 +5,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestContractCallTxExecuted(t *testing.T) {
@@ -42,6 +44,7 @@ func TestContractCallTxExecuted(t *testing.T) {
 		payload,
 		erc20Tokens,
 		erc20Tokens,
+		"",
 	)
 
 	input.GravityKeeper.CreateContractCallTx(
@@ -52,9 +55,12 @@ func TestContractCallTxExecuted(t *testing.T) {
 		payload,
 		erc20Tokens,
 		erc20Tokens,
+		"",
 	)
 
-	cctx1 := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, nonce1)).(*types.ContractCallTx)
+	otx1, err := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, nonce1))
+	assert.NoError(t, err)
+	cctx1 := otx1.(*types.ContractCallTx)
 	assert.Equal(t, cctx1.InvalidationScope, scope)
 	assert.Equal(t, cctx1.InvalidationNonce, nonce1)
 	assert.Equal(t, cctx1.Address, contract.Hex())
@@ -62,7 +68,9 @@ func TestContractCallTxExecuted(t *testing.T) {
 	assert.Equal(t, cctx1.Tokens, erc20Tokens)
 	assert.Equal(t, cctx1.Fees, erc20Tokens)
 
-	cctx2 := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, nonce2)).(*types.ContractCallTx)
+	otx2, err := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, nonce2))
+	assert.NoError(t, err)
+	cctx2 := otx2.(*types.ContractCallTx)
 	assert.Equal(t, cctx2.InvalidationScope, scope)
 	assert.Equal(t, cctx2.InvalidationNonce, nonce2)
 	assert.Equal(t, cctx2.Address, contract.Hex())
@@ -70,11 +78,236 @@ func TestContractCallTxExecuted(t *testing.T) {
 	assert.Equal(t, cctx2.Tokens, erc20Tokens)
 	assert.Equal(t, cctx2.Fees, erc20Tokens)
 
-	input.GravityKeeper.contractCallExecuted(ctx, scope, nonce2)
+	_, err = input.GravityKeeper.contractCallExecuted(ctx, scope, nonce2)
+	assert.NoError(t, err)
 
-	otx1 := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, nonce1))
-	otx2 := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, nonce2))
+	_, err = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, nonce1))
+	assert.ErrorIs(t, err, types.ErrOutgoingTxNotFound)
+	_, err = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, nonce2))
+	assert.ErrorIs(t, err, types.ErrOutgoingTxNotFound)
+}
+
+type contractCallRecordingHooks struct {
+	types.GravityHooks
+
+	executed       *types.ContractCallTx
+	success        bool
+	returnDataHash []byte
+}
+
+func (h *contractCallRecordingHooks) AfterContractCallExecutedEvent(sdk.Context, types.ContractCallExecutedEvent) {
+}
+
+func (h *contractCallRecordingHooks) AfterContractCallExecuted(_ sdk.Context, tx types.ContractCallTx, success bool, returnDataHash []byte) {
+	h.executed = &tx
+	h.success = success
+	h.returnDataHash = returnDataHash
+}
+
+func TestAfterContractCallExecutedHook(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	hooks := &contractCallRecordingHooks{}
+	input.GravityKeeper.SetHooks(hooks)
+
+	scope := []byte("test-scope")
+	contract := common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	returnDataHash := crypto.Keccak256([]byte("return data"))
+
+	input.GravityKeeper.CreateContractCallTx(ctx, 1, scope, contract, []byte("payload"), nil, nil, "")
+
+	err := input.GravityKeeper.Handle(ctx, &types.ContractCallExecutedEvent{
+		EventNonce:        1,
+		InvalidationScope: scope,
+		InvalidationNonce: 1,
+		EthereumHeight:    1000,
+		Success:           true,
+		ReturnDataHash:    returnDataHash,
+	})
+	assert.NoError(t, err)
+
+	if assert.NotNil(t, hooks.executed) {
+		assert.Equal(t, contract.Hex(), hooks.executed.Address)
+		assert.Equal(t, []byte("payload"), hooks.executed.Payload)
+	}
+	assert.True(t, hooks.success)
+	assert.Equal(t, returnDataHash, hooks.returnDataHash)
+}
+
+func TestContractCallTxMaxPayload(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	scope := []byte("test-scope")
+	contract := common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+
+	params := gk.GetParams(ctx)
+	params.MaxContractCallPayloadBytes = 7
+	gk.SetParams(ctx, params)
+
+	_, err := gk.CreateContractCallTx(ctx, 1, scope, contract, []byte("payload!"), nil, nil, "")
+	assert.ErrorIs(t, err, types.ErrInvalid)
+	_, err = gk.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, 1))
+	assert.ErrorIs(t, err, types.ErrOutgoingTxNotFound)
+
+	_, err = gk.CreateContractCallTx(ctx, 1, scope, contract, []byte("payload"), nil, nil, "")
+	assert.NoError(t, err)
+	_, err = gk.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, 1))
+	assert.NoError(t, err)
+}
+
+func TestMultiContractCallTx(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	scope := []byte("test-scope")
+	calls := []types.ContractCall{
+		{Address: "0x2a24af0501a534fca004ee1bd667b783f205a546", Payload: []byte("withdraw"), Value: sdk.ZeroInt()},
+		{Address: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", Payload: []byte("deposit"), Value: sdk.NewInt(10)},
+	}
+
+	params := gk.GetParams(ctx)
+	params.MaxContractCallPayloadBytes = 14
+	gk.SetParams(ctx, params)
+
+	// the payloads count together against the maximum
+	_, err := gk.CreateMultiContractCallTx(ctx, 1, scope, calls, nil, nil, "")
+	assert.ErrorIs(t, err, types.ErrInvalid)
+
+	params.MaxContractCallPayloadBytes = 15
+	gk.SetParams(ctx, params)
+
+	_, err = gk.CreateMultiContractCallTx(ctx, 1, scope, nil, nil, nil, "")
+	assert.ErrorIs(t, err, types.ErrInvalid)
+
+	_, err = gk.CreateMultiContractCallTx(ctx, 1, scope, calls, nil, nil, "")
+	assert.NoError(t, err)
+
+	otx, err := gk.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, 1))
+	assert.NoError(t, err)
+	cctx := otx.(*types.ContractCallTx)
+	assert.Equal(t, calls, cctx.Calls)
+	assert.Empty(t, cctx.Address)
+
+	// a multi call is not signed as a single call to its first contract
+	single := *cctx
+	single.Calls = nil
+	single.Address = calls[0].Address
+	single.Payload = calls[0].Payload
+	assert.NotEqual(t, single.GetCheckpoint([]byte("foo")), cctx.GetCheckpoint([]byte("foo")))
+}
+
+func TestContractCallTxRefund(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	// refunds are recorded even when executed batches are not
+	params := gk.GetParams(ctx)
+	params.ExecutedBatchRetention = 0
+	params.ContractCallRefundRetention = 100
+	gk.SetParams(ctx, params)
+
+	scope := []byte("test-scope")
+	contract := common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	refundee, _ := sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, refundee)
+	voucher := MintVouchersFromAir(t, ctx, gk, refundee, types.NewERC20Token(1000, tokenContract))
+	tokens := []types.ERC20Token{types.NewERC20Token(100, tokenContract)}
+	fees := []types.ERC20Token{types.NewERC20Token(10, tokenContract)}
+
+	// the tokens and fees are escrowed from the refundee
+	for _, nonce := range []uint64{1, 2} {
+		_, err := gk.CreateContractCallTx(ctx, nonce, scope, contract, []byte("payload"), tokens, fees, refundee.String())
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int64(780), input.BankKeeper.GetBalance(ctx, refundee, voucher.Denom).Amount.Int64())
+	checkInvariant(t, ctx, gk, true)
+
+	_, err := gk.CreateContractCallTx(ctx, 3, scope, contract, []byte("payload"), []types.ERC20Token{types.NewERC20Token(1000, tokenContract)}, nil, refundee.String())
+	assert.Error(t, err)
+
+	// executing the second call refunds the first one it invalidates and
+	// burns the vouchers of the second
+	err = gk.Handle(ctx, &types.ContractCallExecutedEvent{
+		EventNonce:        1,
+		InvalidationScope: scope,
+		InvalidationNonce: 2,
+		EthereumHeight:    1000,
+		Success:           true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(890), input.BankKeeper.GetBalance(ctx, refundee, voucher.Denom).Amount.Int64())
+	assert.Equal(t, int64(890), input.BankKeeper.GetSupply(ctx, voucher.Denom).Amount.Int64())
+	checkInvariant(t, ctx, gk, true)
+
+	res, err := gk.RefundedContractCallTxs(sdk.WrapSDKContext(ctx), &types.RefundedContractCallTxsRequest{Refundee: refundee.String()})
+	assert.NoError(t, err)
+	if assert.Len(t, res.Records, 1) {
+		assert.Equal(t, uint64(1), res.Records[0].InvalidationNonce)
+		assert.Equal(t, sdk.NewCoins(sdk.NewCoin(voucher.Denom, sdk.NewInt(110))), res.Records[0].Refunded)
+	}
+}
+
+func TestContractCallTxRefundFailure(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	scope := []byte("test-scope")
+	contract := common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	refundee, _ := sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+	other, _ := sdk.AccAddressFromBech32("cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du")
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, refundee)
+	voucher := MintVouchersFromAir(t, ctx, gk, refundee, types.NewERC20Token(1000, tokenContract))
+	tokens := []types.ERC20Token{types.NewERC20Token(100, tokenContract)}
+	fees := []types.ERC20Token{types.NewERC20Token(10, tokenContract)}
+	for _, nonce := range []uint64{1, 2} {
+		_, err := gk.CreateContractCallTx(ctx, nonce, scope, contract, []byte("payload"), tokens, fees, refundee.String())
+		require.NoError(t, err)
+	}
+
+	otx, err := gk.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, 1))
+	require.NoError(t, err)
+
+	// drain the escrow so that the refund fails
+	drained := sdk.NewCoins(sdk.NewCoin(voucher.Denom, sdk.NewInt(220)))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, other, drained))
+
+	// the failed refund does not halt the chain and is not recorded
+	require.NotPanics(t, func() {
+		assert.False(t, gk.RefundContractCallTx(ctx, otx.(*types.ContractCallTx)))
+	})
+	assert.Equal(t, int64(780), input.BankKeeper.GetBalance(ctx, refundee, voucher.Denom).Amount.Int64())
+	res, err := gk.RefundedContractCallTxs(sdk.WrapSDKContext(ctx), &types.RefundedContractCallTxsRequest{Refundee: refundee.String()})
+	require.NoError(t, err)
+	assert.Empty(t, res.Records)
+
+	// nor does the failed burn of the executed call's vouchers, which fails
+	// the event instead
+	executed := &types.ContractCallExecutedEvent{
+		EventNonce:        1,
+		InvalidationScope: scope,
+		InvalidationNonce: 2,
+		EthereumHeight:    1000,
+		Success:           true,
+	}
+	require.NotPanics(t, func() {
+		assert.Error(t, gk.Handle(ctx, executed))
+	})
 
-	assert.Nil(t, otx1)
-	assert.Nil(t, otx2)
+	// once the escrow is restored the invalidated call is refunded
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(ctx, other, types.ModuleName, drained))
+	checkInvariant(t, ctx, gk, true)
+	require.NoError(t, gk.Handle(ctx, executed))
+	assert.Equal(t, int64(890), input.BankKeeper.GetBalance(ctx, refundee, voucher.Denom).Amount.Int64())
+	_, err = gk.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, 1))
+	assert.Error(t, err)
+	checkInvariant(t, ctx, gk, true)
 }
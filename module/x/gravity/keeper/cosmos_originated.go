@@ -1,10 +1,9 @@
 package keeper
 
 import (
-	"fmt"
-
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
@@ -42,14 +41,18 @@ func (k Keeper) setCosmosOriginatedDenomToERC20(ctx sdk.Context, denom string, t
 // This will return an error if it cant parse the denom as a gravity denom, and then also can't find the denom
 // in an index of ERC20 contracts deployed on Ethereum to serve as synthetic Cosmos assets.
 func (k Keeper) DenomToERC20Lookup(ctx sdk.Context, denom string) (bool, common.Address, error) {
+	// Native ether vouchers are sent back as the native ether pseudo contract
+	if denom == k.getNativeEtherDenom(ctx) {
+		return false, types.NativeEtherContract, nil
+	}
+
 	// First try parsing the ERC20 out of the denom
 	tc1, err := types.GravityDenomToERC20(denom)
 	if err != nil {
 		// Look up ERC20 contract in index and error if it's not in there.
 		tc2, exists := k.getCosmosOriginatedERC20(ctx, denom)
 		if !exists {
-			return false, common.Address{},
-				fmt.Errorf("denom not a gravity voucher coin: %s, and also not in cosmos-originated ERC20 index", err)
+			return false, common.Address{}, sdkerrors.Wrapf(types.ErrUnknownToken, "%s: %s", denom, err)
 		}
 		// This is a cosmos-originated asset
 		return true, tc2, nil
@@ -63,6 +66,11 @@ func (k Keeper) DenomToERC20Lookup(ctx sdk.Context, denom string) (bool, common.
 // Using this information, you can see if an ERC20 address represents an asset is native to Cosmos or Ethereum,
 // and get its corresponding denom
 func (k Keeper) ERC20ToDenomLookup(ctx sdk.Context, tokenContract common.Address) (bool, string) {
+	// Native ether has no ERC20 contract and its own denom
+	if tokenContract == types.NativeEtherContract {
+		return false, k.getNativeEtherDenom(ctx)
+	}
+
 	// First try looking up tokenContract in index
 	dn1, exists := k.getCosmosOriginatedDenom(ctx, tokenContract)
 	if exists {
@@ -82,7 +90,7 @@ func (k Keeper) iterateERC20ToDenom(ctx sdk.Context, cb func([]byte, *types.ERC2
 
 	for ; iter.Valid(); iter.Next() {
 		erc20ToDenom := types.ERC20ToDenom{
-			Erc20: string(iter.Key()),
+			Erc20: common.BytesToAddress(iter.Key()).Hex(),
 			Denom: string(iter.Value()),
 		}
 		// cb returns true to stop early
@@ -91,3 +99,127 @@ func (k Keeper) iterateERC20ToDenom(ctx sdk.Context, cb func([]byte, *types.ERC2
 		}
 	}
 }
+
+// setPendingERC20Deployment records an observed ERC20 deployment until it is
+// confirmed with MsgERC20DeployedConfirm
+func (k Keeper) setPendingERC20Deployment(ctx sdk.Context, event types.ERC20DeployedEvent) {
+	ctx.KVStore(k.storeKey).Set(
+		types.MakePendingERC20DeploymentKey(common.HexToAddress(event.TokenContract), event.CosmosDenom),
+		k.cdc.MustMarshal(&event),
+	)
+}
+
+// getPendingERC20Deployment returns the observed deployment of the token
+// contract for the denom, if it awaits confirmation
+func (k Keeper) getPendingERC20Deployment(ctx sdk.Context, tokenContract common.Address, denom string) (*types.ERC20DeployedEvent, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakePendingERC20DeploymentKey(tokenContract, denom))
+	if bz == nil {
+		return nil, false
+	}
+
+	var event types.ERC20DeployedEvent
+	k.cdc.MustUnmarshal(bz, &event)
+	return &event, true
+}
+
+// IteratePendingERC20Deployments iterates over the observed ERC20 deployments
+// awaiting confirmation
+func (k Keeper) IteratePendingERC20Deployments(ctx sdk.Context, cb func(types.ERC20DeployedEvent) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.PendingERC20DeploymentKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var event types.ERC20DeployedEvent
+		k.cdc.MustUnmarshal(iter.Value(), &event)
+		if cb(event) {
+			break
+		}
+	}
+}
+
+// deletePendingERC20Deployments removes every pending deployment for the denom
+func (k Keeper) deletePendingERC20Deployments(ctx sdk.Context, denom string) {
+	var keys [][]byte
+	k.IteratePendingERC20Deployments(ctx, func(event types.ERC20DeployedEvent) bool {
+		if event.CosmosDenom == denom {
+			keys = append(keys, types.MakePendingERC20DeploymentKey(common.HexToAddress(event.TokenContract), event.CosmosDenom))
+		}
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// confirmERC20Deployment adopts a pending deployment as the representation of
+// the denom. The ERC20 is checked against the denom metadata again, as it may
+// have changed since the deployment was observed, and amounts are scaled when
+// the ERC20 has more decimals than the denom.
+func (k Keeper) confirmERC20Deployment(ctx sdk.Context, denom string, tokenContract common.Address) error {
+	deployment, ok := k.getPendingERC20Deployment(ctx, tokenContract, denom)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidERC20Event, "no pending deployment of %s for denom %s", tokenContract.Hex(), denom)
+	}
+	if err := k.verifyERC20DeployedEvent(ctx, deployment); err != nil {
+		return err
+	}
+
+	k.setCosmosOriginatedDenomToERC20(ctx, denom, tokenContract)
+	k.deletePendingERC20Deployments(ctx, denom)
+
+	if md, ok := k.bankKeeper.GetDenomMetaData(ctx, denom); ok && md.Base != "" {
+		if exponent := displayExponent(md); uint64(exponent) != deployment.Erc20Decimals {
+			k.setERC20Conversion(ctx, types.NewERC20Conversion(tokenContract, uint32(deployment.Erc20Decimals), exponent))
+		}
+	}
+
+	return nil
+}
+
+// setERC20Conversion records the decimal conversion of an ERC20
+func (k Keeper) setERC20Conversion(ctx sdk.Context, conversion types.ERC20Conversion) {
+	ctx.KVStore(k.storeKey).Set(
+		types.MakeERC20ConversionKey(common.HexToAddress(conversion.TokenContract)),
+		k.cdc.MustMarshal(&conversion),
+	)
+}
+
+// GetERC20Conversion returns the decimal conversion of an ERC20, which keeps
+// identical precision on both sides unless one was recorded
+func (k Keeper) GetERC20Conversion(ctx sdk.Context, tokenContract common.Address) types.ERC20Conversion {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeERC20ConversionKey(tokenContract))
+	if bz == nil {
+		return types.NewERC20Conversion(tokenContract, 0, 0)
+	}
+
+	var conversion types.ERC20Conversion
+	k.cdc.MustUnmarshal(bz, &conversion)
+	return conversion
+}
+
+// IterateERC20Conversions iterates over the recorded decimal conversions
+func (k Keeper) IterateERC20Conversions(ctx sdk.Context, cb func(types.ERC20Conversion) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ERC20ConversionKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var conversion types.ERC20Conversion
+		k.cdc.MustUnmarshal(iter.Value(), &conversion)
+		if cb(conversion) {
+			break
+		}
+	}
+}
+
+// ERC20ToCosmosAmount converts an amount of an ERC20 to its denom, truncating
+// the units below the precision of the denom
+func (k Keeper) ERC20ToCosmosAmount(ctx sdk.Context, tokenContract common.Address, amount sdk.Int) sdk.Int {
+	return k.GetERC20Conversion(ctx, tokenContract).ToCosmos(amount)
+}
+
+// CosmosToERC20Amount converts an amount of a denom to its ERC20
+func (k Keeper) CosmosToERC20Amount(ctx sdk.Context, tokenContract common.Address, amount sdk.Int) (sdk.Int, error) {
+	return k.GetERC20Conversion(ctx, tokenContract).ToERC20(amount)
+}
@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// getDelayedWithdrawalThreshold returns the configured delayed withdrawal
+// threshold for a token, if any
+func (k Keeper) getDelayedWithdrawalThreshold(ctx sdk.Context, tokenContract common.Address) (sdk.Int, bool) {
+	for _, threshold := range k.GetParams(ctx).DelayedWithdrawalThresholds {
+		if common.HexToAddress(threshold.TokenContract) == tokenContract {
+			return threshold.Threshold, true
+		}
+	}
+	return sdk.Int{}, false
+}
+
+// delaySendToEthereum holds a send to ethereum in the delayed send queue
+// until the end of the delayed withdrawal period
+func (k Keeper) delaySendToEthereum(ctx sdk.Context, ste *types.SendToEthereum) {
+	delayed := types.DelayedSendToEthereum{
+		SendToEthereum: *ste,
+		ReleaseHeight:  uint64(ctx.BlockHeight()) + k.GetParams(ctx).DelayedWithdrawalPeriod,
+	}
+	k.setDelayedSendToEthereum(ctx, delayed)
+
+	types.EmitTypedEvent(ctx, &types.EventSendToEthereumDelayed{
+		Id:            ste.Id,
+		Sender:        ste.Sender,
+		TokenContract: ste.Erc20Token.Contract,
+		Amount:        ste.Erc20Token.Amount.String(),
+		ReleaseHeight: delayed.ReleaseHeight,
+	})
+}
+
+func (k Keeper) setDelayedSendToEthereum(ctx sdk.Context, delayed types.DelayedSendToEthereum) {
+	ctx.KVStore(k.storeKey).Set(
+		types.MakeDelayedSendToEthereumKey(delayed.ReleaseHeight, delayed.SendToEthereum.Id),
+		k.cdc.MustMarshal(&delayed),
+	)
+}
+
+// IterateDelayedSendToEthereums iterates over the delayed sends to ethereum by
+// release height
+func (k Keeper) IterateDelayedSendToEthereums(ctx sdk.Context, cb func(types.DelayedSendToEthereum) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.DelayedSendToEthereumKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var delayed types.DelayedSendToEthereum
+		k.cdc.MustUnmarshal(iter.Value(), &delayed)
+		if cb(delayed) {
+			break
+		}
+	}
+}
+
+// GetDelayedSendToEthereum returns the delayed send to ethereum with the id,
+// if it is still held in the delayed send queue
+func (k Keeper) GetDelayedSendToEthereum(ctx sdk.Context, id uint64) (types.DelayedSendToEthereum, bool) {
+	var (
+		out   types.DelayedSendToEthereum
+		found bool
+	)
+	k.IterateDelayedSendToEthereums(ctx, func(delayed types.DelayedSendToEthereum) bool {
+		if delayed.SendToEthereum.Id == id {
+			out, found = delayed, true
+			return true
+		}
+		return false
+	})
+	return out, found
+}
+
+// ReleaseDelayedSendToEthereums moves the delayed sends to ethereum that
+// reached their release height to the pool of unbatched txs
+func (k Keeper) ReleaseDelayedSendToEthereums(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.DelayedSendToEthereumKey})
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())+1))
+
+	var released []types.DelayedSendToEthereum
+	for ; iter.Valid(); iter.Next() {
+		var delayed types.DelayedSendToEthereum
+		k.cdc.MustUnmarshal(iter.Value(), &delayed)
+		released = append(released, delayed)
+	}
+	iter.Close()
+
+	for _, delayed := range released {
+		ste := delayed.SendToEthereum
+		ctx.KVStore(k.storeKey).Delete(types.MakeDelayedSendToEthereumKey(delayed.ReleaseHeight, ste.Id))
+		k.setUnbatchedSendToEthereum(ctx, &ste)
+		k.AfterSendToEthereumPooled(ctx, ste)
+
+		types.EmitTypedEvent(ctx, &types.EventDelayedSendToEthereumReleased{Id: ste.Id})
+	}
+}
+
+// VetoDelayedSendToEthereums removes sends to ethereum from the delayed send
+// queue before their release and returns their amount and fees to the
+// senders. It fails if any of them isn't delayed anymore.
+func (k Keeper) VetoDelayedSendToEthereums(ctx sdk.Context, ids []uint64, vetoedBy string) error {
+	for _, id := range ids {
+		delayed, found := k.GetDelayedSendToEthereum(ctx, id)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrSendToEthereumNotFound, "id %d not in the delayed send queue", id)
+		}
+
+		if err := k.refundSendToEthereum(ctx, delayed.SendToEthereum); err != nil {
+			return sdkerrors.Wrapf(err, "refunding send to ethereum %d", id)
+		}
+		ctx.KVStore(k.storeKey).Delete(types.MakeDelayedSendToEthereumKey(delayed.ReleaseHeight, id))
+
+		k.Logger(ctx).Info("delayed send to ethereum vetoed", "id", id, "sender", delayed.SendToEthereum.Sender, "vetoed by", vetoedBy)
+
+		types.EmitTypedEvent(ctx, &types.EventDelayedSendToEthereumVetoed{
+			Id:       id,
+			VetoedBy: vetoedBy,
+		})
+	}
+	return nil
+}
+
+// refundSendToEthereum returns the escrowed amount and fees of a send to
+// ethereum to its sender, or to its module for sender module accounts. The
+// chain fee is refunded too, as the send never executed. Community pool
+// spends are credited back to the community pool, as its coins are held by
+// the distribution module account. Fees paid by a fee granter are refunded
+// to the granter.
+func (k Keeper) refundSendToEthereum(ctx sdk.Context, ste types.SendToEthereum) error {
+	sender, err := sdk.AccAddressFromBech32(ste.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+
+	tokenContract := common.HexToAddress(ste.Erc20Token.Contract)
+	_, denom := k.ERC20ToDenomLookup(ctx, tokenContract)
+
+	if ste.FeeGranter != "" {
+		feeGranter, err := sdk.AccAddressFromBech32(ste.FeeGranter)
+		if err != nil {
+			return sdkerrors.Wrap(err, "fee granter")
+		}
+		fee := sdk.NewCoins(sdk.NewCoin(denom, k.ERC20ToCosmosAmount(ctx, tokenContract, ste.Erc20Fee.Amount))).Add(ste.GetBridgeFeeCoins()...)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, feeGranter, fee); err != nil {
+			return err
+		}
+		ste.Erc20Fee.Amount = sdk.ZeroInt()
+		ste.BridgeFee = nil
+	}
+	amount := k.ERC20ToCosmosAmount(ctx, tokenContract, ste.Erc20Token.Amount.Add(ste.Erc20Fee.Amount))
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount)).Add(ste.GetBridgeFeeCoins()...).Add(ste.GetChainFeeCoins()...)
+
+	if sender.Equals(authtypes.NewModuleAddress(distributiontypes.ModuleName)) {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distributiontypes.ModuleName, coins); err != nil {
+			return err
+		}
+		feePool := k.DistributionKeeper.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(coins...)...)
+		k.DistributionKeeper.SetFeePool(ctx, feePool)
+		return nil
+	}
+
+	if senderModule, ok := k.SenderModuleAccounts[sender.String()]; ok {
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, senderModule, coins)
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins)
+}
+
+// HandleDelayedSendToEthereumVetoProposal vetoes the delayed sends to
+// ethereum of the proposal, on behalf of the gov module account
+func (k Keeper) HandleDelayedSendToEthereumVetoProposal(ctx sdk.Context, p *types.DelayedSendToEthereumVetoProposal) error {
+	return k.VetoDelayedSendToEthereums(ctx, p.Ids, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+}
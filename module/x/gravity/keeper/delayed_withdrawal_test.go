@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestDelayedSendToEthereum(t *testing.T) {
+	var (
+		input = CreateTestEnv(t)
+		ctx   = input.Context
+		gk    = input.GravityKeeper
+
+		mySender, _   = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		council, _    = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		myReceiver    = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+
+	params := gk.GetParams(ctx)
+	params.DelayedWithdrawalThresholds = []types.DelayedWithdrawalThreshold{{
+		TokenContract: tokenContract.Hex(),
+		Threshold:     sdk.NewInt(100),
+	}}
+	params.DelayedWithdrawalPeriod = 10
+	gk.SetParams(ctx, params)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	voucher := MintVouchersFromAir(t, ctx, gk, mySender, types.NewERC20Token(1000, tokenContract))
+	send := func(amount int64) uint64 {
+		id, err := gk.createSendToEthereum(ctx, mySender, myReceiver.Hex(), sdk.NewCoin(voucher.Denom, sdk.NewInt(amount)), sdk.NewCoin(voucher.Denom, sdk.NewInt(1)))
+		require.NoError(t, err)
+		return id
+	}
+
+	// sends up to the threshold enter the pool right away
+	send(100)
+	require.Len(t, gk.getUnbatchedSendToEthereums(ctx), 1)
+
+	released := send(200)
+	vetoed := send(300)
+	require.Len(t, gk.getUnbatchedSendToEthereums(ctx), 1)
+
+	res, err := gk.DelayedSendToEthereums(sdk.WrapSDKContext(ctx), &types.DelayedSendToEthereumsRequest{SenderAddress: mySender.String()})
+	require.NoError(t, err)
+	require.Len(t, res.DelayedSendToEthereums, 2)
+	require.Equal(t, uint64(ctx.BlockHeight())+10, res.DelayedSendToEthereums[0].ReleaseHeight)
+
+	// only the security council may veto
+	msgServer := NewMsgServerImpl(gk)
+	_, err = msgServer.VetoDelayedSendToEthereum(sdk.WrapSDKContext(ctx), types.NewMsgVetoDelayedSendToEthereum([]uint64{vetoed}, council))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	params.SecurityCouncil = council.String()
+	gk.SetParams(ctx, params)
+
+	balance := input.BankKeeper.GetBalance(ctx, mySender, voucher.Denom)
+	_, err = msgServer.VetoDelayedSendToEthereum(sdk.WrapSDKContext(ctx), types.NewMsgVetoDelayedSendToEthereum([]uint64{vetoed}, council))
+	require.NoError(t, err)
+	require.Equal(t, balance.Amount.AddRaw(301), input.BankKeeper.GetBalance(ctx, mySender, voucher.Denom).Amount)
+	_, found := gk.GetDelayedSendToEthereum(ctx, vetoed)
+	require.False(t, found)
+
+	// the delayed send is released at the end of the period
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 9)
+	gk.ReleaseDelayedSendToEthereums(ctx)
+	require.Len(t, gk.getUnbatchedSendToEthereums(ctx), 1)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	gk.ReleaseDelayedSendToEthereums(ctx)
+	require.Len(t, gk.getUnbatchedSendToEthereums(ctx), 2)
+	_, found = gk.GetDelayedSendToEthereum(ctx, released)
+	require.False(t, found)
+
+	// released sends can't be vetoed anymore
+	err = gk.VetoDelayedSendToEthereums(ctx, []uint64{released}, council.String())
+	require.ErrorIs(t, err, types.ErrSendToEthereumNotFound)
+}
@@ -0,0 +1,261 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// UpdateDelegateKeys rotates the orchestrator and ethereum keys of a validator
+// that already has delegate keys. The orchestrator takes effect immediately,
+// while a new ethereum address is held as pending until a signer set that
+// includes it is observed on Ethereum, so signatures over in-flight txs made
+// with the current key remain valid.
+func (k msgServer) UpdateDelegateKeys(c context.Context, msg *types.MsgUpdateDelegateKeys) (*types.MsgUpdateDelegateKeysResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	orchAddr, err := sdk.AccAddressFromBech32(msg.OrchestratorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ethAddr := common.HexToAddress(msg.EthereumAddress)
+
+	// ensure that the validator exists
+	if k.Keeper.StakingKeeper.Validator(ctx, valAddr) == nil {
+		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, valAddr.String())
+	}
+
+	currentEthAddr := k.GetValidatorEthereumAddress(ctx, valAddr)
+	if currentEthAddr == (common.Address{}) {
+		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "no delegate keys set for validator %s", valAddr)
+	}
+
+	// check if the Ethereum address is not used by another validator
+	for _, val := range k.getValidatorsByEthereumAddress(ctx, ethAddr) {
+		if !val.Equals(valAddr) {
+			return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "ethereum address %s in use", ethAddr)
+		}
+	}
+	if k.IsEthereumSignerExcluded(ctx, ethAddr) {
+		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "ethereum address %s excluded from signer sets", ethAddr)
+	}
+
+	// check if the orchestrator address is not used by another validator
+	if val := k.GetOrchestratorValidatorAddress(ctx, orchAddr); val != nil && !val.Equals(valAddr) {
+		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "orchestrator address %s in use", orchAddr)
+	}
+
+	if err = k.verifyDelegateKeysSignature(ctx, valAddr, ethAddr, msg.EthSignature); err != nil {
+		return nil, err
+	}
+
+	// replace the orchestrator, cleaning the indexes of the old one
+	if oldOrchAddr := k.GetEthereumOrchestratorAddress(ctx, currentEthAddr); oldOrchAddr != nil {
+		k.deleteOrchestratorValidatorAddress(ctx, oldOrchAddr)
+	}
+	k.SetOrchestratorValidatorAddress(ctx, valAddr, orchAddr)
+	k.setEthereumOrchestratorAddress(ctx, currentEthAddr, orchAddr)
+
+	if ethAddr == currentEthAddr {
+		k.deletePendingValidatorEthereumAddress(ctx, valAddr)
+	} else {
+		k.setPendingValidatorEthereumAddress(ctx, valAddr, ethAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeySetOrchestratorAddr, orchAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySetEthereumAddr, ethAddr.Hex()),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, valAddr.String()),
+		),
+	)
+
+	return &types.MsgUpdateDelegateKeysResponse{}, nil
+}
+
+// RevokeOrchestratorKey removes the orchestrator of a validator. The validator
+// keeps its ethereum address and can still sign with its operator account.
+func (k msgServer) RevokeOrchestratorKey(c context.Context, msg *types.MsgRevokeOrchestratorKey) (*types.MsgRevokeOrchestratorKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ethAddr := k.GetValidatorEthereumAddress(ctx, valAddr)
+	orchAddr := k.GetEthereumOrchestratorAddress(ctx, ethAddr)
+	if ethAddr == (common.Address{}) || orchAddr == nil {
+		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "no orchestrator set for validator %s", valAddr)
+	}
+
+	k.deleteOrchestratorValidatorAddress(ctx, orchAddr)
+	k.deleteEthereumOrchestratorAddress(ctx, ethAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, valAddr.String()),
+		),
+	)
+
+	return &types.MsgRevokeOrchestratorKeyResponse{}, nil
+}
+
+// verifyDelegateKeysSignature checks that the ethereum address signed over the
+// validator address and the current nonce of the validator account
+func (k Keeper) verifyDelegateKeysSignature(ctx sdk.Context, valAddr sdk.ValAddress, ethAddr common.Address, ethSig []byte) error {
+	valAccAddr := sdk.AccAddress(valAddr)
+	valAccSeq, err := k.accountKeeper.GetSequence(ctx, valAccAddr)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrDelegateKeys, "failed to get sequence for validator account %s", valAccAddr)
+	}
+
+	var nonce uint64
+	if valAccSeq > 0 {
+		nonce = valAccSeq - 1
+	}
+
+	signMsgBz := k.cdc.MustMarshal(&types.DelegateKeysSignMsg{
+		ValidatorAddress: valAddr.String(),
+		// We decrement since we process the message after the ante-handler which
+		// increments the nonce.
+		Nonce: nonce,
+	})
+
+	hash := crypto.Keccak256Hash(signMsgBz).Bytes()
+
+	if err = types.ValidateEthereumSignature(hash, ethSig, ethAddr); err != nil {
+		return sdkerrors.Wrapf(
+			types.ErrDelegateKeys,
+			"failed to validate delegate keys signature for Ethereum address %X; %s ;%d",
+			ethAddr, err, nonce,
+		)
+	}
+
+	return nil
+}
+
+// applyPendingEthereumAddresses switches validators to their pending ethereum
+// address once an observed signer set includes it
+func (k Keeper) applyPendingEthereumAddresses(ctx sdk.Context, members types.EthereumSigners) {
+	observed := make(map[common.Address]bool, len(members))
+	for _, member := range members {
+		observed[common.HexToAddress(member.EthereumAddress)] = true
+	}
+
+	// collect first, the store can't be written while it is being iterated
+	var rotated []sdk.ValAddress
+	k.IteratePendingValidatorEthereumAddresses(ctx, func(val sdk.ValAddress, ethAddr common.Address) bool {
+		if observed[ethAddr] {
+			rotated = append(rotated, val)
+		}
+		return false
+	})
+
+	for _, val := range rotated {
+		ethAddr, _ := k.GetPendingValidatorEthereumAddress(ctx, val)
+		oldEthAddr := k.GetValidatorEthereumAddress(ctx, val)
+		orchAddr := k.GetEthereumOrchestratorAddress(ctx, oldEthAddr)
+
+		k.deleteEthereumOrchestratorAddress(ctx, oldEthAddr)
+		k.setValidatorEthereumAddress(ctx, val, ethAddr)
+		if orchAddr != nil {
+			k.setEthereumOrchestratorAddress(ctx, ethAddr, orchAddr)
+		}
+		k.deletePendingValidatorEthereumAddress(ctx, val)
+
+		k.Logger(ctx).Info(
+			"ethereum address rotated",
+			"validator", val.String(),
+			"old", oldEthAddr.Hex(),
+			"new", ethAddr.Hex(),
+		)
+	}
+}
+
+// deleteOrchestratorValidatorAddress removes the orchestrator -> validator mapping
+func (k Keeper) deleteOrchestratorValidatorAddress(ctx sdk.Context, orchAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeOrchestratorValidatorAddressKey(orchAddr))
+}
+
+// deleteEthereumOrchestratorAddress removes the eth -> orchestrator mapping
+func (k Keeper) deleteEthereumOrchestratorAddress(ctx sdk.Context, ethAddr common.Address) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeEthereumOrchestratorAddressKey(ethAddr))
+}
+
+// setPendingValidatorEthereumAddress sets the ethereum address that will replace
+// the validator's current one
+func (k Keeper) setPendingValidatorEthereumAddress(ctx sdk.Context, valAddr sdk.ValAddress, ethAddr common.Address) {
+	store := ctx.KVStore(k.storeKey)
+
+	previous, hadPrevious := k.GetPendingValidatorEthereumAddress(ctx, valAddr)
+	store.Set(types.MakePendingValidatorEthereumAddressKey(valAddr), ethAddr.Bytes())
+	store.Set(types.MakeEthereumAddressValidatorKey(ethAddr, valAddr), []byte{0x1})
+	if hadPrevious && previous != ethAddr {
+		k.unindexEthereumAddressValidator(ctx, previous, valAddr)
+	}
+}
+
+// GetPendingValidatorEthereumAddress returns the ethereum address waiting to
+// replace the validator's current one, or false if there is none
+func (k Keeper) GetPendingValidatorEthereumAddress(ctx sdk.Context, valAddr sdk.ValAddress) (common.Address, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakePendingValidatorEthereumAddressKey(valAddr))
+	if bz == nil {
+		return common.Address{}, false
+	}
+
+	return common.BytesToAddress(bz), true
+}
+
+func (k Keeper) deletePendingValidatorEthereumAddress(ctx sdk.Context, valAddr sdk.ValAddress) {
+	previous, hadPrevious := k.GetPendingValidatorEthereumAddress(ctx, valAddr)
+	ctx.KVStore(k.storeKey).Delete(types.MakePendingValidatorEthereumAddressKey(valAddr))
+	if hadPrevious {
+		k.unindexEthereumAddressValidator(ctx, previous, valAddr)
+	}
+}
+
+// IteratePendingValidatorEthereumAddresses iterates over the pending ethereum
+// addresses of all validators
+func (k Keeper) IteratePendingValidatorEthereumAddresses(ctx sdk.Context, cb func(val sdk.ValAddress, ethAddr common.Address) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.PendingValidatorEthereumAddressKey})
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(sdk.ValAddress(iter.Key()), common.BytesToAddress(iter.Value())) {
+			break
+		}
+	}
+}
+
+// iterateValidatorEthereumAddresses iterates over the current ethereum
+// addresses of all validators
+func (k Keeper) iterateValidatorEthereumAddresses(ctx sdk.Context, cb func(val sdk.ValAddress, ethAddr common.Address) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ValidatorEthereumAddressKey})
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(sdk.ValAddress(iter.Key()), common.BytesToAddress(iter.Value())) {
+			break
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// RegisterDepositMemoHandler registers the handler of the deposit memos with
+// the route, it panics if the route already has a handler
+func (k *Keeper) RegisterDepositMemoHandler(route string, handler types.DepositMemoHandler) *Keeper {
+	if _, ok := k.memoHandlers[route]; ok {
+		panic(fmt.Sprintf("cannot register deposit memo handler %s twice", route))
+	}
+
+	k.memoHandlers[route] = handler
+
+	return k
+}
+
+// handleDepositMemo passes a credited deposit to the handler its memo routes
+// to. Like a failed IBC forward, an unknown route or a failing handler is not
+// an error for the deposit: the coins simply stay with the receiver.
+func (k Keeper) handleDepositMemo(ctx sdk.Context, event *types.SendToCosmosEvent, receiver sdk.AccAddress, coins sdk.Coins) {
+	if len(event.Memo) == 0 {
+		return
+	}
+
+	logger := k.Logger(ctx).With("nonce", fmt.Sprint(event.EventNonce))
+	route, payload, ok := types.ParseDepositMemo(event.Memo)
+	if !ok {
+		logger.Info("unrecognized deposit memo, deposit left with receiver")
+		return
+	}
+	handler, ok := k.memoHandlers[route]
+	if !ok {
+		logger.Info("no handler for deposit memo, deposit left with receiver", "route", route)
+		return
+	}
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := handler.HandleDepositMemo(cacheCtx, *event, receiver, coins, payload); err != nil {
+		logger.Error("deposit memo handler failed, deposit left with receiver", "route", route, "cause", err.Error())
+		return
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBridgeDepositMemoHandled,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.EventNonce)),
+			sdk.NewAttribute(types.AttributeKeyMemoRoute, route),
+		),
+	)
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+var _ types.DepositMemoHandler = StakeDepositMemoHandler{}
+
+// StakeDepositMemoHandler delegates deposits of the bond denom to the
+// validator named in their memo, for chains whose staking token has an ERC20
+// twin. It is registered with the DepositMemoRouteStake route.
+type StakeDepositMemoHandler struct {
+	stakingKeeper types.DelegationKeeper
+}
+
+// NewStakeDepositMemoHandler returns a deposit memo handler delegating with
+// the staking keeper
+func NewStakeDepositMemoHandler(stakingKeeper types.DelegationKeeper) StakeDepositMemoHandler {
+	return StakeDepositMemoHandler{stakingKeeper: stakingKeeper}
+}
+
+// HandleDepositMemo delegates the deposit from its receiver. Deposits of any
+// other denom than the bond denom are rejected, so that they stay with the
+// receiver like the deposits whose delegation fails.
+func (h StakeDepositMemoHandler) HandleDepositMemo(ctx sdk.Context, event types.SendToCosmosEvent, receiver sdk.AccAddress, coins sdk.Coins, payload json.RawMessage) error {
+	var memo types.StakeDepositMemo
+	if err := json.Unmarshal(payload, &memo); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	valAddr, err := sdk.ValAddressFromBech32(memo.Validator)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, memo.Validator)
+	}
+
+	bondDenom := h.stakingKeeper.BondDenom(ctx)
+	if len(coins) != 1 || coins[0].Denom != bondDenom {
+		return sdkerrors.Wrapf(types.ErrInvalid, "deposit of %s is not of the bond denom %s", coins, bondDenom)
+	}
+
+	validator, found := h.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, memo.Validator)
+	}
+
+	if _, err := h.stakingKeeper.Delegate(ctx, receiver, coins[0].Amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBridgeDepositStaked,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.EventNonce)),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		),
+	)
+
+	return nil
+}
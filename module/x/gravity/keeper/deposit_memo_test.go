@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+type recordingMemoHandler struct {
+	receiver sdk.AccAddress
+	coins    sdk.Coins
+	payload  json.RawMessage
+	err      error
+}
+
+func (h *recordingMemoHandler) HandleDepositMemo(_ sdk.Context, _ types.SendToCosmosEvent, receiver sdk.AccAddress, coins sdk.Coins, payload json.RawMessage) error {
+	h.receiver, h.coins, h.payload = receiver, coins, payload
+	return h.err
+}
+
+func TestSendToCosmosDepositMemo(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	swap := &recordingMemoHandler{}
+	failing := &recordingMemoHandler{err: errors.New("slippage")}
+	gk.RegisterDepositMemoHandler("swap", swap)
+	gk.RegisterDepositMemoHandler("stake", failing)
+	require.Panics(t, func() { gk.RegisterDepositMemoHandler("swap", swap) })
+
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	denom := types.GravityDenom(tokenContract)
+	deposit := func(nonce uint64, memo string) {
+		event := &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  tokenContract.Hex(),
+			Amount:         sdk.NewInt(10),
+			EthereumSender: EthAddrs[0].Hex(),
+			CosmosReceiver: AccAddrs[0].String(),
+			EthereumHeight: 10,
+			Memo:           []byte(memo),
+		}
+		require.NoError(t, event.Validate())
+		require.NoError(t, gk.Handle(ctx, event))
+	}
+
+	deposit(1, `{"swap": {"denom": "uatom"}}`)
+	require.Equal(t, AccAddrs[0], swap.receiver)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)), swap.coins)
+	require.JSONEq(t, `{"denom": "uatom"}`, string(swap.payload))
+
+	// unknown routes, malformed memos and failing handlers leave the deposit with the receiver
+	deposit(2, `{"unknown": {}}`)
+	deposit(3, `not json`)
+	deposit(4, `{"stake": {}}`)
+	require.NotNil(t, failing.coins)
+	require.Equal(t, sdk.NewInt(40), input.BankKeeper.GetBalance(ctx, AccAddrs[0], denom).Amount)
+}
+
+func TestSendToCosmosStakeDepositMemo(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	gk.RegisterDepositMemoHandler(types.DepositMemoRouteStake, NewStakeDepositMemoHandler(input.StakingKeeper))
+
+	var (
+		receiver, _   = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		stakeContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		otherContract = common.HexToAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+		bondDenom     = input.StakingKeeper.BondDenom(ctx)
+	)
+
+	// the bond denom has an ERC20 twin, deposits of it unlock coins held by the module
+	gk.setCosmosOriginatedDenomToERC20(ctx, bondDenom, stakeContract)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))))
+
+	deposit := func(nonce uint64, tokenContract common.Address, validator string) {
+		event := &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  tokenContract.Hex(),
+			Amount:         sdk.NewInt(10),
+			EthereumSender: EthAddrs[0].Hex(),
+			CosmosReceiver: receiver.String(),
+			EthereumHeight: 10,
+			Memo:           []byte(`{"stake": {"validator": "` + validator + `"}}`),
+		}
+		require.NoError(t, event.Validate())
+		require.NoError(t, gk.Handle(ctx, event))
+	}
+
+	deposit(1, stakeContract, ValAddrs[0].String())
+	delegation, found := input.StakingKeeper.GetDelegation(ctx, receiver, ValAddrs[0])
+	require.True(t, found)
+	validator, _ := input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+	require.Equal(t, sdk.NewInt(10), validator.TokensFromShares(delegation.Shares).TruncateInt())
+	require.True(t, input.BankKeeper.GetBalance(ctx, receiver, bondDenom).IsZero())
+
+	// failed delegations and deposits of other tokens are left with the receiver
+	deposit(2, stakeContract, sdk.ValAddress(receiver).String())
+	require.Equal(t, sdk.NewInt(10), input.BankKeeper.GetBalance(ctx, receiver, bondDenom).Amount)
+
+	deposit(3, otherContract, ValAddrs[0].String())
+	require.Equal(t, sdk.NewInt(10), input.BankKeeper.GetBalance(ctx, receiver, types.GravityDenom(otherContract)).Amount)
+}
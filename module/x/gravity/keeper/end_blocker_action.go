@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// RecordEndBlockerAction stores a decision taken while processing the block in
+// a ring buffer of the last EndBlockerActionHistorySize actions
+func (k Keeper) RecordEndBlockerAction(ctx sdk.Context, action, reason string) {
+	store := ctx.KVStore(k.storeKey)
+	seq := k.getEndBlockerActionSequence(ctx)
+
+	store.Set(
+		types.MakeEndBlockerActionKey(seq%types.EndBlockerActionHistorySize),
+		k.cdc.MustMarshal(&types.EndBlockerAction{
+			Height: ctx.BlockHeight(),
+			Action: action,
+			Reason: reason,
+		}),
+	)
+	store.Set([]byte{types.EndBlockerActionSequenceKey}, sdk.Uint64ToBigEndian(seq+1))
+}
+
+// GetEndBlockerActions returns up to limit of the recorded end blocker
+// actions, newest first. A zero limit returns all of them.
+func (k Keeper) GetEndBlockerActions(ctx sdk.Context, limit uint64) (actions []*types.EndBlockerAction) {
+	store := ctx.KVStore(k.storeKey)
+	seq := k.getEndBlockerActionSequence(ctx)
+
+	count := seq
+	if count > types.EndBlockerActionHistorySize {
+		count = types.EndBlockerActionHistorySize
+	}
+	if limit != 0 && limit < count {
+		count = limit
+	}
+
+	for i := uint64(1); i <= count; i++ {
+		var action types.EndBlockerAction
+		k.cdc.MustUnmarshal(store.Get(types.MakeEndBlockerActionKey((seq-i)%types.EndBlockerActionHistorySize)), &action)
+		actions = append(actions, &action)
+	}
+
+	return actions
+}
+
+func (k Keeper) getEndBlockerActionSequence(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.EndBlockerActionSequenceKey})
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestEndBlockerActions(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	require.Empty(t, gk.GetEndBlockerActions(ctx, 0))
+
+	total := types.EndBlockerActionHistorySize + 10
+	for i := 0; i < total; i++ {
+		gk.RecordEndBlockerAction(ctx.WithBlockHeight(int64(i)), types.EndBlockerActionBatchCreated, fmt.Sprint(i))
+	}
+
+	// only the most recent actions are kept, newest first
+	actions := gk.GetEndBlockerActions(ctx, 0)
+	require.Len(t, actions, types.EndBlockerActionHistorySize)
+	require.Equal(t, int64(total-1), actions[0].Height)
+	require.Equal(t, fmt.Sprint(total-1), actions[0].Reason)
+	require.Equal(t, int64(10), actions[len(actions)-1].Height)
+
+	actions = gk.GetEndBlockerActions(ctx, 2)
+	require.Len(t, actions, 2)
+	require.Equal(t, int64(total-2), actions[1].Height)
+}
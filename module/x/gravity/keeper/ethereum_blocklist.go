@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+/////////////////////////
+// ETHEREUM BLOCKLIST //
+/////////////////////////
+
+func (k Keeper) setEthereumAddressBlocklisted(ctx sdk.Context, addr common.Address) {
+	ctx.KVStore(k.storeKey).Set(types.MakeEthereumBlocklistKey(addr), []byte{1})
+}
+
+func (k Keeper) deleteEthereumAddressBlocklisted(ctx sdk.Context, addr common.Address) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeEthereumBlocklistKey(addr))
+}
+
+// IsEthereumAddressBlocklisted reports whether governance has blocklisted an ethereum address
+func (k Keeper) IsEthereumAddressBlocklisted(ctx sdk.Context, addr common.Address) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MakeEthereumBlocklistKey(addr))
+}
+
+// IterateEthereumBlocklist iterates over all blocklisted ethereum addresses
+func (k Keeper) IterateEthereumBlocklist(ctx sdk.Context, cb func(common.Address) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumBlocklistKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(common.BytesToAddress(iter.Key())) {
+			break
+		}
+	}
+}
+
+// HandleEthereumBlocklistProposal adds and removes addresses from the ethereum blocklist
+func (k Keeper) HandleEthereumBlocklistProposal(ctx sdk.Context, p *types.EthereumBlocklistProposal) error {
+	for _, addr := range p.AddAddresses {
+		k.setEthereumAddressBlocklisted(ctx, common.HexToAddress(addr))
+	}
+	for _, addr := range p.RemoveAddresses {
+		k.deleteEthereumAddressBlocklisted(ctx, common.HexToAddress(addr))
+	}
+
+	k.Logger(ctx).Info("ethereum blocklist updated", "added", len(p.AddAddresses), "removed", len(p.RemoveAddresses))
+
+	return nil
+}
+
+// sendToCommunityPool credits coins of the module account to the community
+// pool, such as a deposit from a blocklisted sender or chain fees
+func (k Keeper) sendToCommunityPool(ctx sdk.Context, coins sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distributiontypes.ModuleName, coins); err != nil {
+		return err
+	}
+
+	feePool := k.DistributionKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(coins...)...)
+	k.DistributionKeeper.SetFeePool(ctx, feePool)
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestEthereumBlocklist(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		blocked       = EthAddrs[0]
+		receiver      = AccAddrs[0]
+		denom         = types.GravityDenom(tokenContract)
+	)
+
+	proposal := types.NewEthereumBlocklistProposal("blocklist", "block an address", []string{blocked.Hex()}, nil)
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, gk.HandleEthereumBlocklistProposal(ctx, proposal))
+	require.True(t, gk.IsEthereumAddressBlocklisted(ctx, blocked))
+
+	// sends to a blocklisted recipient are rejected
+	_, err := gk.createSendToEthereum(ctx, receiver, blocked.Hex(), sdk.NewInt64Coin(denom, 10), sdk.NewInt64Coin(denom, 1))
+	require.ErrorIs(t, err, types.ErrEthereumAddressBlocklisted)
+
+	// deposits from a blocklisted sender go to the community pool
+	require.NoError(t, gk.Handle(ctx, &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  tokenContract.Hex(),
+		Amount:         sdk.NewInt(50),
+		EthereumSender: blocked.Hex(),
+		CosmosReceiver: receiver.String(),
+		EthereumHeight: 10,
+	}))
+	require.True(t, input.BankKeeper.GetBalance(ctx, receiver, denom).IsZero())
+	require.Equal(t, sdk.NewInt(50), input.DistKeeper.GetFeePool(ctx).CommunityPool.AmountOf(denom).TruncateInt())
+
+	// removing the address restores normal deposits
+	proposal = types.NewEthereumBlocklistProposal("unblock", "unblock an address", nil, []string{blocked.Hex()})
+	require.NoError(t, gk.HandleEthereumBlocklistProposal(ctx, proposal))
+	require.False(t, gk.IsEthereumAddressBlocklisted(ctx, blocked))
+}
@@ -8,6 +8,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
 )
@@ -24,40 +25,55 @@ func (k Keeper) DetectMaliciousSupply(ctx sdk.Context, denom string, amount sdk.
 
 // Handle is the entry point for EthereumEvent processing
 func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
+	return k.handle(ctx, k.GetParams(ctx), eve)
+}
+
+// handle processes an event with the params already read by the caller
+func (k Keeper) handle(ctx sdk.Context, params types.Params, eve types.EthereumEvent) (err error) {
 	switch event := eve.(type) {
 	case *types.SendToCosmosEvent:
-		// Check if coin is Cosmos-originated asset and get denom
-		isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(event.TokenContract))
-		addr, _ := sdk.AccAddressFromBech32(event.CosmosReceiver)
-		coins := sdk.Coins{sdk.NewCoin(denom, event.Amount)}
-
-		if !isCosmosOriginated {
-			if err := k.DetectMaliciousSupply(ctx, denom, event.Amount); err != nil {
-				return err
-			}
-
-			// if it is not cosmos originated, mint the coins (aka vouchers)
-			if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
-				return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
-			}
+		// deposits of fee on transfer tokens that don't report what the gravity
+		// contract received, and of tokens off the allowlist, are held until
+		// governance releases them
+		event, ok := creditedSendToCosmos(params, event)
+		if !ok || !isTokenAllowlisted(params, common.HexToAddress(event.TokenContract)) {
+			k.holdSendToCosmos(ctx, event)
+			return nil
 		}
 
-		if recipientModule, ok := k.ReceiverModuleAccounts[event.CosmosReceiver]; ok {
-			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipientModule, coins); err != nil {
-				return err
-			}
-		} else {
-			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
-				return err
-			}
+		// deposits over the mint rate limit of their token wait in the queue
+		if k.shouldQueueSendToCosmos(ctx, params, event) {
+			k.enqueueSendToCosmos(ctx, event)
+			return nil
 		}
-		k.AfterSendToCosmosEvent(ctx, *event)
-		return nil
+
+		return k.sendToCosmos(ctx, params, event)
+
+	case *types.SendEtherToCosmosEvent:
+		// native ether is deposited as its pseudo contract, so that it is
+		// allowlisted, rate limited and held as any ERC20 deposit
+		return k.handle(ctx, params, event.SendToCosmosEvent())
 
 	case *types.BatchExecutedEvent:
-		if err := k.batchTxExecuted(ctx, common.HexToAddress(event.TokenContract), event.BatchNonce); err != nil {
+		tokenContract := common.HexToAddress(event.TokenContract)
+		// batchTxExecuted surfaces the error of a batch that can't be read
+		otx, _ := k.GetOutgoingTx(ctx, types.MakeBatchTxKey(tokenContract, event.BatchNonce))
+		if err := k.batchTxExecuted(ctx, params, tokenContract, event.BatchNonce, event.Relayer); err != nil {
 			return err
 		}
+		// the executed batch is deleted, a compact record of it is archived
+		if batchTx, ok := otx.(*types.BatchTx); ok {
+			k.archiveExecutedBatchTx(ctx, params, batchTx, event)
+		}
+		types.EmitTypedEvent(ctx, &types.EventBatchTxExecuted{
+			BridgeContract: k.getBridgeContractAddress(ctx),
+			BridgeChainId:  k.getBridgeChainID(ctx),
+			TokenContract:  tokenContract.Hex(),
+			BatchNonce:     event.BatchNonce,
+			Relayer:        event.Relayer,
+			EthereumTxHash: hexutil.Encode(event.EthereumTxHash),
+			EthereumHeight: event.EthereumHeight,
+		})
 		k.AfterBatchExecutedEvent(ctx, *event)
 		return nil
 
@@ -74,13 +90,35 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 			return nil
 		}
 
-		// add to denom-erc20 mapping
-		k.setCosmosOriginatedDenomToERC20(ctx, event.CosmosDenom, common.HexToAddress(event.TokenContract))
+		// the denom-erc20 mapping is only added once the deployment is confirmed
+		k.setPendingERC20Deployment(ctx, *event)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeERC20DeploymentPending,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyCosmosDenom, event.CosmosDenom),
+			sdk.NewAttribute(types.AttributeKeyTokenContract, event.TokenContract),
+		))
 		k.AfterERC20DeployedEvent(ctx, *event)
 		return nil
 
 	case *types.ContractCallExecutedEvent:
-		k.contractCallExecuted(ctx, event.InvalidationScope.Bytes(), event.InvalidationNonce)
+		completedCallTx, err := k.contractCallExecuted(ctx, event.InvalidationScope.Bytes(), event.InvalidationNonce)
+		if err != nil {
+			return err
+		}
+		if completedCallTx != nil {
+			k.archiveExecutedContractCallTx(ctx, params, completedCallTx, event)
+			k.AfterContractCallExecuted(ctx, *completedCallTx, event.Success, event.ReturnDataHash)
+		}
+		types.EmitTypedEvent(ctx, &types.EventContractCallTxExecuted{
+			BridgeContract:    k.getBridgeContractAddress(ctx),
+			BridgeChainId:     k.getBridgeChainID(ctx),
+			InvalidationScope: hexutil.Encode(event.InvalidationScope),
+			InvalidationNonce: event.InvalidationNonce,
+			Success:           event.Success,
+			EthereumTxHash:    hexutil.Encode(event.EthereumTxHash),
+			EthereumHeight:    event.EthereumHeight,
+		})
 		k.AfterContractCallExecutedEvent(ctx, *event)
 		return nil
 
@@ -88,10 +126,16 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 		// TODO here we should check the contents of the validator set against
 		// the store, if they differ we should take some action to indicate to the
 		// user that bridge highjacking has occurred
-		k.setLastObservedSignerSetTx(ctx, types.SignerSetTx{
+		signerSet := types.SignerSetTx{
 			Nonce:   event.SignerSetTxNonce,
 			Signers: event.Members,
+		}
+		k.setLastObservedSignerSetTx(ctx, signerSet)
+		k.setObservedSignerSetTx(ctx, types.ObservedSignerSetTx{
+			SignerSet:      signerSet,
+			EthereumHeight: event.EthereumHeight,
 		})
+		k.applyPendingEthereumAddresses(ctx, event.Members)
 		k.AfterSignerSetExecutedEvent(ctx, *event)
 		return nil
 
@@ -100,6 +144,69 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 	}
 }
 
+// sendToCosmos credits the receiver of a deposit, minting vouchers for
+// Ethereum originated tokens
+func (k Keeper) sendToCosmos(ctx sdk.Context, params types.Params, event *types.SendToCosmosEvent) error {
+	// Check if coin is Cosmos-originated asset and get denom
+	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(event.TokenContract))
+	addr, _ := sdk.AccAddressFromBech32(event.CosmosReceiver)
+	amount := k.ERC20ToCosmosAmount(ctx, common.HexToAddress(event.TokenContract), event.Amount)
+	coins := sdk.Coins{sdk.NewCoin(denom, amount)}
+	blocklisted := k.IsEthereumAddressBlocklisted(ctx, common.HexToAddress(event.EthereumSender))
+
+	if !amount.IsPositive() {
+		// the deposit is below the precision of the denom and stays locked on ethereum
+		k.Logger(ctx).Info("deposit below denom precision not credited", "amount", event.Amount, "token", event.TokenContract, "nonce", event.EventNonce)
+		return nil
+	}
+
+	// only the deposits credited count against the rate limits and stats
+	k.recordMint(ctx, params, event)
+	k.recordBridgeInflow(ctx, common.HexToAddress(event.TokenContract), event.Amount)
+	k.recordBridgeStatsInflow(ctx, common.HexToAddress(event.TokenContract), event.Amount)
+
+	if !isCosmosOriginated {
+		if err := k.DetectMaliciousSupply(ctx, denom, amount); err != nil {
+			return err
+		}
+
+		// if it is not cosmos originated, mint the coins (aka vouchers)
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
+		}
+	}
+
+	// deposits from blocklisted senders go to the community pool rather than the receiver
+	if blocklisted {
+		if err := k.sendToCommunityPool(ctx, coins); err != nil {
+			return err
+		}
+		k.Logger(ctx).Info("deposit from blocklisted sender sent to the community pool", "sender", event.EthereumSender, "nonce", event.EventNonce)
+		return nil
+	}
+
+	if recipientModule, ok := k.ReceiverModuleAccounts[event.CosmosReceiver]; ok {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipientModule, coins); err != nil {
+			return err
+		}
+	} else {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+			return err
+		}
+		if event.IbcForward != "" && len(event.Memo) != 0 {
+			// the deposit can't be both forwarded and routed to a memo handler
+			k.Logger(ctx).Info("deposit with both an ibc forward and a memo left with receiver", "nonce", event.EventNonce)
+		} else {
+			k.forwardSendToCosmos(ctx, event, addr, coins[0])
+			k.handleDepositMemo(ctx, event, addr, coins)
+		}
+	}
+
+	k.AfterSendToCosmosEvent(ctx, *event)
+
+	return nil
+}
+
 func (k Keeper) verifyERC20DeployedEvent(ctx sdk.Context, event *types.ERC20DeployedEvent) error {
 	if existingERC20, exists := k.getCosmosOriginatedERC20(ctx, event.CosmosDenom); exists {
 		return sdkerrors.Wrapf(
@@ -108,6 +215,13 @@ func (k Keeper) verifyERC20DeployedEvent(ctx sdk.Context, event *types.ERC20Depl
 		)
 	}
 
+	if existingDenom, exists := k.getCosmosOriginatedDenom(ctx, common.HexToAddress(event.TokenContract)); exists {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidERC20Event,
+			"ERC20 token %s already represents denom %s", event.TokenContract, existingDenom,
+		)
+	}
+
 	// We expect that all Cosmos-based tokens have metadata defined. In the case
 	// a token does not have metadata defined, e.g. an IBC token, we successfully
 	// handle the token under the following conditions:
@@ -187,15 +301,12 @@ func verifyERC20Token(metadata banktypes.Metadata, event *types.ERC20DeployedEve
 	// result in there being no decimal places in the token's ERC20 on Ethereum.
 	// For example, if this happened with ATOM, 1 ATOM would appear on Ethereum
 	// as 1 million ATOM, having 6 extra places before the decimal point.
-	var decimals uint32
-	for _, denomUnit := range metadata.DenomUnits {
-		if denomUnit.Denom == metadata.Display {
-			decimals = denomUnit.Exponent
-			break
-		}
-	}
-
-	if uint64(decimals) != event.Erc20Decimals {
+	//
+	// An ERC20 with more decimals than the denom is accepted as well, amounts
+	// are then scaled by the ERC20Conversion recorded once the deployment is
+	// confirmed.
+	decimals := displayExponent(metadata)
+	if event.Erc20Decimals < uint64(decimals) || event.Erc20Decimals-uint64(decimals) > types.MaxERC20ConversionDecimals {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidERC20Event,
 			"ERC20 decimals %d does not match denom decimals %d", event.Erc20Decimals, decimals,
@@ -204,3 +315,14 @@ func verifyERC20Token(metadata banktypes.Metadata, event *types.ERC20DeployedEve
 
 	return nil
 }
+
+// displayExponent returns the exponent of the display unit of a denom, or zero
+// if the display unit is not found
+func displayExponent(metadata banktypes.Metadata) uint32 {
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit.Denom == metadata.Display {
+			return denomUnit.Exponent
+		}
+	}
+	return 0
+}
@@ -5,7 +5,10 @@ import (
 	"testing"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
 )
 
 func TestDetectMaliciousSupply(t *testing.T) {
@@ -19,3 +22,43 @@ func TestDetectMaliciousSupply(t *testing.T) {
 	err := input.GravityKeeper.DetectMaliciousSupply(input.Context, "stake", bigCoinAmount)
 	require.Error(t, err, "didn't error out on too much added supply")
 }
+
+func TestNativeEtherDeposit(t *testing.T) {
+	var (
+		input      = CreateTestEnv(t)
+		ctx        = input.Context
+		gk         = input.GravityKeeper
+		receiver   = AccAddrs[0]
+		myReceiver = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		denom      = gk.GetParams(ctx).NativeEtherDenom
+	)
+
+	require.NoError(t, gk.Handle(ctx, &types.SendEtherToCosmosEvent{
+		EventNonce:     1,
+		Amount:         sdktypes.NewInt(1000),
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: receiver.String(),
+		EthereumHeight: 10,
+	}))
+	require.Equal(t, "gravity/eth", denom)
+	require.Equal(t, int64(1000), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+
+	isCosmosOriginated, tokenContract, err := gk.DenomToERC20Lookup(ctx, denom)
+	require.NoError(t, err)
+	require.False(t, isCosmosOriginated)
+	require.Equal(t, types.NativeEtherContract, tokenContract)
+
+	// the vouchers sent back to ethereum are escrowed and pooled as the pseudo contract
+	_, err = gk.createSendToEthereum(ctx, receiver, myReceiver.Hex(), sdktypes.NewCoin(denom, sdktypes.NewInt(900)), sdktypes.NewCoin(denom, sdktypes.NewInt(100)))
+	require.NoError(t, err)
+	require.True(t, input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.IsZero())
+
+	var sends []*types.SendToEthereum
+	gk.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		sends = append(sends, ste)
+		return false
+	})
+	require.Len(t, sends, 1)
+	require.Equal(t, types.NativeEtherContract.Hex(), sends[0].Erc20Token.Contract)
+	require.Equal(t, int64(900), sends[0].Erc20Token.Amount.Int64())
+}
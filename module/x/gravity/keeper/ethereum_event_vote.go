@@ -1,13 +1,15 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
-	"strconv"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
 )
@@ -24,26 +26,49 @@ func (k Keeper) recordEventVote(
 	lastEventNonce := k.getLastEventNonceByValidator(ctx, val)
 	expectedNonce := lastEventNonce + 1
 	if event.GetEventNonce() != expectedNonce {
-		return nil, sdkerrors.Wrapf(types.ErrInvalid,
-			"non contiguous event nonce expected %v observed %v for validator %v",
+		return nil, sdkerrors.Wrapf(types.ErrNonContiguousEventNonce,
+			"expected %v observed %v for validator %v",
 			expectedNonce,
 			event.GetEventNonce(),
 			val,
 		)
 	}
 
+	if err := k.checkEthereumEventNotObserved(ctx, event); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkEthereumEventBlock(ctx, event); err != nil {
+		return nil, err
+	}
+
 	// Tries to get an EthereumEventVoteRecord with the same eventNonce and event as the event that was submitted.
 	eventVoteRecord := k.GetEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash())
 
-	// If it does not exist, create a new one.
+	// If it does not exist, create a new one. Events at the same nonce are
+	// never merged, they are voted on in their own vote records.
 	if eventVoteRecord == nil {
+		if k.hasEthereumEventVoteRecordAtNonce(ctx, event.GetEventNonce()) {
+			k.Logger(ctx).Error("conflicting ethereum event",
+				"nonce", fmt.Sprint(event.GetEventNonce()),
+				"hash", event.Hash().String(),
+				"validator", val.String(),
+			)
+			types.EmitTypedEvent(ctx, &types.EventConflictingEthereumEvent{
+				EventNonce: event.GetEventNonce(),
+				EventHash:  event.Hash(),
+				Validator:  val.String(),
+			})
+		}
+
 		any, err := types.PackEvent(event)
 		if err != nil {
 			return nil, err
 		}
 		eventVoteRecord = &types.EthereumEventVoteRecord{
-			Accepted: false,
-			Event:    any,
+			Accepted:       false,
+			Event:          any,
+			EthereumHeight: event.GetEthereumHeight(),
 		}
 	}
 
@@ -52,15 +77,16 @@ func (k Keeper) recordEventVote(
 
 	k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), eventVoteRecord)
 	k.setLastEventNonceByValidator(ctx, val, event.GetEventNonce())
+	k.setLastEventVoteHeightByValidator(ctx, val, uint64(ctx.BlockHeight()))
 
 	return eventVoteRecord, nil
 }
 
-// TryEventVoteRecord checks if an event vote record has enough votes to be applied to the consensus state
-// and has not already been marked Observed, then calls processEthereumEvent to actually apply it to the state,
-// and then marks it Observed and emits an event.
-func (k Keeper) TryEventVoteRecord(ctx sdk.Context, eventVoteRecord *types.EthereumEventVoteRecord) {
-	// If the event vote record has not yet been Observed, sum up the votes and see if it is ready to apply to the state.
+// TryEventVoteRecord checks if the event of an event vote record is buried under the confirmation depth and
+// the oracle considers it observed and it has not already been marked Observed, then calls processEthereumEvent
+// to actually apply it to the state, and then marks it Observed and emits an event.
+func (k Keeper) TryEventVoteRecord(ctx sdk.Context, params types.Params, eventVoteRecord *types.EthereumEventVoteRecord) {
+	// If the event vote record has not yet been Observed, ask the oracle whether it is ready to apply to the state.
 	// This conditional stops the event vote record from accidentally being applied twice.
 	if !eventVoteRecord.Accepted {
 		var event types.EthereumEvent
@@ -70,49 +96,43 @@ func (k Keeper) TryEventVoteRecord(ctx sdk.Context, eventVoteRecord *types.Ether
 			return
 		}
 
-		// Sum the current powers of all validators who have voted and see if it passes the current threshold
-		// TODO: The different integer types and math here needs a careful review
-		requiredPower := types.EventVoteRecordPowerThreshold(k.StakingKeeper.GetLastTotalPower(ctx))
-		eventVotePower := sdk.NewInt(0)
-		for _, validator := range eventVoteRecord.Votes {
-			val, _ := sdk.ValAddressFromBech32(validator)
-
-			validatorPower := k.StakingKeeper.GetLastValidatorPower(ctx, val)
-			// Add it to the attestation power's sum
-			eventVotePower = eventVotePower.Add(sdk.NewInt(validatorPower))
-			// If the power of all the validators that have voted on the attestation is higher or equal to the threshold,
-			// process the attestation, set Observed to true, and break
-			if eventVotePower.GTE(requiredPower) {
-				lastEventNonce := k.GetLastObservedEventNonce(ctx)
-				// this check is performed at the next level up so this should never happen
-				// outside of programmer error.
-				if event.GetEventNonce() != lastEventNonce+1 {
-					k.DisableBridge(ctx)
-					k.Logger(ctx).Error(
-						"TryEventVoteRecord: attempting to apply events to state out of order")
-					return
-				}
-				k.setLastObservedEventNonce(ctx, event.GetEventNonce())
-				k.SetLastObservedEthereumBlockHeight(ctx, event.GetEthereumHeight())
-
-				eventVoteRecord.Accepted = true
-				k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), eventVoteRecord)
-
-				k.processEthereumEvent(ctx, event)
-				ctx.EventManager().EmitEvent(sdk.NewEvent(
-					types.EventTypeObservation,
-					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-					sdk.NewAttribute(types.AttributeKeyEthereumEventType, fmt.Sprintf("%T", event)),
-					sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
-					sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
-					sdk.NewAttribute(types.AttributeKeyEthereumEventVoteRecordID,
-						string(types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()))),
-					sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.GetEventNonce())),
-				))
-
-				break
-			}
+		if !k.isEventConfirmed(ctx, params, event, eventVoteRecord) {
+			return
+		}
+
+		if !k.getOracle().IsObserved(ctx, event, eventVoteRecord) {
+			return
+		}
+
+		lastEventNonce := k.GetLastObservedEventNonce(ctx)
+		// this check is performed at the next level up so this should never happen
+		// outside of programmer error.
+		if event.GetEventNonce() != lastEventNonce+1 {
+			k.DisableBridge(ctx)
+			k.Logger(ctx).Error(
+				"TryEventVoteRecord: attempting to apply events to state out of order")
+			return
 		}
+		k.setLastObservedEventNonce(ctx, event.GetEventNonce())
+		k.SetLastObservedEthereumBlockHeight(ctx, event.GetEthereumHeight())
+
+		eventVoteRecord.Accepted = true
+		k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), eventVoteRecord)
+		k.setEthereumTxHashEventVoteRecord(ctx, event, eventVoteRecord)
+		k.logObservedEthereumEvent(ctx, event)
+
+		k.processEthereumEvent(ctx, params, event)
+		types.EmitTypedEvent(ctx, &types.EventEthereumEventObserved{
+			EventType:      proto.MessageName(event),
+			BridgeContract: k.getBridgeContractAddress(ctx),
+			BridgeChainId:  k.getBridgeChainID(ctx),
+			EventNonce:     event.GetEventNonce(),
+			EventHash:      event.Hash(),
+		})
+		if signerSetEvent, ok := event.(*types.SignerSetTxExecutedEvent); ok {
+			k.AfterSignerSetObserved(ctx, *signerSetEvent)
+		}
+		k.AfterEventObserved(ctx, event)
 	} else {
 		// We disable the bridge here because this should never happen
 		k.DisableBridge(ctx)
@@ -122,11 +142,31 @@ func (k Keeper) TryEventVoteRecord(ctx sdk.Context, eventVoteRecord *types.Ether
 	}
 }
 
+// isEventConfirmed checks that the event of an event vote record is buried
+// under EthereumEventConfirmationDepth ethereum blocks, i.e. that the median
+// ethereum height voted by validators within the TimeoutHeightVoteWindow
+// exceeds the height the event was claimed at by more than the depth. Events
+// are never confirmed without recent height votes when the depth is set.
+func (k Keeper) isEventConfirmed(ctx sdk.Context, params types.Params, event types.EthereumEvent, eventVoteRecord *types.EthereumEventVoteRecord) bool {
+	if params.EthereumEventConfirmationDepth == 0 {
+		return true
+	}
+
+	// records stored before their height was recorded hold it in the event
+	claimHeight := eventVoteRecord.EthereumHeight
+	if claimHeight == 0 {
+		claimHeight = event.GetEthereumHeight()
+	}
+
+	medianHeight, ok := k.medianEthereumHeightVote(ctx, params.TimeoutHeightVoteWindow)
+	return ok && medianHeight > claimHeight+params.EthereumEventConfirmationDepth
+}
+
 // processEthereumEvent actually applies the attestation to the consensus state
-func (k Keeper) processEthereumEvent(ctx sdk.Context, event types.EthereumEvent) {
+func (k Keeper) processEthereumEvent(ctx sdk.Context, params types.Params, event types.EthereumEvent) {
 	// then execute in a new Tx so that we can store state on failure
 	xCtx, commit := ctx.CacheContext()
-	if err := k.Handle(xCtx, event); err != nil { // execute with a transient storage
+	if err := k.handle(xCtx, params, event); err != nil { // execute with a transient storage
 		// If the attestation fails, something has gone wrong and we can't recover it. Disable the bridge,
 		// log the error and move on
 		// The attestation will still be marked "Observed", and validators can still be slashed for not
@@ -142,6 +182,7 @@ func (k Keeper) processEthereumEvent(ctx sdk.Context, event types.EthereumEvent)
 	} else {
 		ctx.EventManager().EmitEvents(xCtx.EventManager().Events()) // copy events to original context
 		commit()                                                    // persist transient storage
+		telemetryEventObserved(event)
 	}
 }
 
@@ -206,6 +247,131 @@ func (k Keeper) iterateEthereumEventVoteRecords(ctx sdk.Context, cb func([]byte,
 	}
 }
 
+// setEthereumTxHashEventVoteRecord indexes an observed event vote record by
+// the hash of the ethereum tx that emitted its event, if the event has one.
+// The index outlives the vote record, which is pruned after observation.
+func (k Keeper) setEthereumTxHashEventVoteRecord(ctx sdk.Context, event types.EthereumEvent, eventVoteRecord *types.EthereumEventVoteRecord) {
+	if len(event.GetEthereumTxHash()) == 0 {
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.MakeEthereumTxHashEventKey(event.GetEthereumTxHash(), event.GetEventNonce()), k.cdc.MustMarshal(eventVoteRecord))
+}
+
+// iterateEthereumTxHashEventVoteRecords iterates through the observed event
+// vote records indexed by ethereum tx hash, or only those of a tx hash if one
+// is given
+func (k Keeper) iterateEthereumTxHashEventVoteRecords(ctx sdk.Context, txHash []byte, cb func(*types.EthereumEventVoteRecord) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.EthereumTxHashEventKey}, txHash...))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		eventVoteRecord := &types.EthereumEventVoteRecord{}
+		k.cdc.MustUnmarshal(iter.Value(), eventVoteRecord)
+		if cb(eventVoteRecord) {
+			return
+		}
+	}
+}
+
+// GetEthereumEventVoteRecordsByTxHash returns the vote records of the events
+// an ethereum tx emitted, observed or still being voted on, by event nonce.
+// The vote records still in state are returned over their indexed copy, as
+// they hold the votes cast since the event was observed.
+func (k Keeper) GetEthereumEventVoteRecordsByTxHash(ctx sdk.Context, txHash []byte) []*types.EthereumEventVoteRecord {
+	type match struct {
+		nonce  uint64
+		record *types.EthereumEventVoteRecord
+	}
+	var matches []match
+	seen := make(map[string]bool)
+	k.iterateEthereumEventVoteRecords(ctx, func(_ []byte, eventVoteRecord *types.EthereumEventVoteRecord) bool {
+		event, err := types.UnpackEvent(eventVoteRecord.Event)
+		if err == nil && bytes.Equal(event.GetEthereumTxHash(), txHash) {
+			matches = append(matches, match{event.GetEventNonce(), eventVoteRecord})
+			seen[string(event.Hash())] = true
+		}
+		return false
+	})
+	k.iterateEthereumTxHashEventVoteRecords(ctx, txHash, func(eventVoteRecord *types.EthereumEventVoteRecord) bool {
+		event, err := types.UnpackEvent(eventVoteRecord.Event)
+		if err == nil && !seen[string(event.Hash())] {
+			matches = append(matches, match{event.GetEventNonce(), eventVoteRecord})
+		}
+		return false
+	})
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].nonce < matches[j].nonce
+	})
+	out := make([]*types.EthereumEventVoteRecord, len(matches))
+	for i, m := range matches {
+		out[i] = m.record
+	}
+	return out
+}
+
+// checkEthereumEventNotObserved rejects an event emitted by the same log of
+// the same ethereum tx as an event observed at another nonce, so that a log is
+// never credited twice. Events without a tx hash or a log index can't be
+// checked, as a missing log index can't be told apart from the first log of a
+// block.
+func (k Keeper) checkEthereumEventNotObserved(ctx sdk.Context, event types.EthereumEvent) error {
+	if len(event.GetEthereumTxHash()) == 0 || !event.GetHasEthereumLogIndex() {
+		return nil
+	}
+
+	var err error
+	k.iterateEthereumTxHashEventVoteRecords(ctx, event.GetEthereumTxHash(), func(eventVoteRecord *types.EthereumEventVoteRecord) bool {
+		observed, unpackErr := types.UnpackEvent(eventVoteRecord.Event)
+		if unpackErr != nil {
+			return false
+		}
+		if observed.GetEventNonce() != event.GetEventNonce() && observed.GetHasEthereumLogIndex() && observed.GetEthereumLogIndex() == event.GetEthereumLogIndex() {
+			err = sdkerrors.Wrapf(types.ErrDuplicateEthereumEvent,
+				"tx hash %s log index %d observed at nonce %d",
+				event.GetEthereumTxHash(),
+				event.GetEthereumLogIndex(),
+				observed.GetEventNonce(),
+			)
+			return true
+		}
+		return false
+	})
+	return err
+}
+
+// hasEthereumEventVoteRecordAtNonce reports whether an event was voted on at
+// the nonce
+func (k Keeper) hasEthereumEventVoteRecordAtNonce(ctx sdk.Context, eventNonce uint64) bool {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeEthereumEventVoteRecordKey(eventNonce, nil)).Iterator(nil, nil)
+	defer iter.Close()
+	return iter.Valid()
+}
+
+// GetConflictingEthereumEvents returns the vote records of the nonces more
+// than one event was voted on at, by nonce
+func (k Keeper) GetConflictingEthereumEvents(ctx sdk.Context) []types.ConflictingEthereumEvents {
+	var out []types.ConflictingEthereumEvents
+	var current types.ConflictingEthereumEvents
+	flush := func() {
+		if len(current.EventVoteRecords) > 1 {
+			out = append(out, current)
+		}
+	}
+	// vote records are keyed by event nonce, so those of a nonce are adjacent
+	k.iterateEthereumEventVoteRecords(ctx, func(key []byte, eventVoteRecord *types.EthereumEventVoteRecord) bool {
+		nonce := binary.BigEndian.Uint64(key[:8])
+		if nonce != current.EventNonce {
+			flush()
+			current = types.ConflictingEthereumEvents{EventNonce: nonce}
+		}
+		current.EventVoteRecords = append(current.EventVoteRecords, eventVoteRecord)
+		return false
+	})
+	flush()
+	return out
+}
+
 // GetLastObservedEventNonce returns the latest observed event nonce
 func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
@@ -285,3 +451,9 @@ func (k Keeper) setLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValA
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.MakeLastEventNonceByValidatorKey(validator), sdk.Uint64ToBigEndian(nonce))
 }
+
+// iterateLastEventNonceByValidator iterates over the validators with a stored
+// last event nonce
+func (k Keeper) iterateLastEventNonceByValidator(ctx sdk.Context, cb func(validator sdk.ValAddress, nonce uint64) (stop bool)) {
+	k.iterateValidatorUint64s(ctx, types.LastEventNonceByValidatorKey, cb)
+}
@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// GetAgreedEthereumHeader returns the ethereum header of the bridge chain
+// agreed on at the latest checkpoint, if any
+func (k Keeper) GetAgreedEthereumHeader(ctx sdk.Context) *types.EthereumHeader {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeAgreedEthereumHeaderKey(k.getBridgeChainID(ctx)))
+	if bz == nil {
+		return nil
+	}
+
+	var header types.EthereumHeader
+	k.cdc.MustUnmarshal(bz, &header)
+	return &header
+}
+
+func (k Keeper) setAgreedEthereumHeader(ctx sdk.Context, header types.EthereumHeader) {
+	ctx.KVStore(k.storeKey).Set(types.MakeAgreedEthereumHeaderKey(k.getBridgeChainID(ctx)), k.cdc.MustMarshal(&header))
+	k.setAgreedEthereumHeaderByHeight(ctx, header)
+}
+
+func (k Keeper) setAgreedEthereumHeaderByHeight(ctx sdk.Context, header types.EthereumHeader) {
+	ctx.KVStore(k.storeKey).Set(types.MakeAgreedEthereumHeaderByHeightKey(k.getBridgeChainID(ctx), header.Height), k.cdc.MustMarshal(&header))
+}
+
+// getAgreedEthereumHeaderCovering returns the agreed header whose block hashes
+// hold the hash of the block at the height, if any
+func (k Keeper) getAgreedEthereumHeaderCovering(ctx sdk.Context, height uint64) (*types.EthereumHeader, tmbytes.HexBytes) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.AgreedEthereumHeaderByHeightKey}, sdk.Uint64ToBigEndian(k.getBridgeChainID(ctx))...)).Iterator(sdk.Uint64ToBigEndian(height), nil)
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, nil
+	}
+
+	var header types.EthereumHeader
+	k.cdc.MustUnmarshal(iter.Value(), &header)
+	blockHash, ok := header.BlockHash(height)
+	if !ok {
+		return nil, nil
+	}
+	return &header, blockHash
+}
+
+// IterateAgreedEthereumHeaders iterates over the agreed ethereum headers kept
+// to check events against, in height order
+func (k Keeper) IterateAgreedEthereumHeaders(ctx sdk.Context, cb func(types.EthereumHeader) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.AgreedEthereumHeaderByHeightKey}, sdk.Uint64ToBigEndian(k.getBridgeChainID(ctx))...)).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var header types.EthereumHeader
+		k.cdc.MustUnmarshal(iter.Value(), &header)
+		if cb(header) {
+			break
+		}
+	}
+}
+
+// pruneAgreedEthereumHeaders deletes the agreed headers below the height of
+// the last observed event, as the events still to be observed are at or
+// beyond it. The latest agreed header is always kept.
+func (k Keeper) pruneAgreedEthereumHeaders(ctx sdk.Context) {
+	lastObservedHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight
+	agreed := k.GetAgreedEthereumHeader(ctx)
+	if agreed == nil {
+		return
+	}
+
+	var keys [][]byte
+	k.IterateAgreedEthereumHeaders(ctx, func(header types.EthereumHeader) bool {
+		if header.Height >= lastObservedHeight || header.Height >= agreed.Height {
+			return true
+		}
+		keys = append(keys, types.MakeAgreedEthereumHeaderByHeightKey(k.getBridgeChainID(ctx), header.Height))
+		return false
+	})
+	for _, key := range keys {
+		ctx.KVStore(k.storeKey).Delete(key)
+	}
+}
+
+func (k Keeper) setEthereumHeaderVote(ctx sdk.Context, vote types.EthereumHeaderVote) {
+	validator, _ := sdk.ValAddressFromBech32(vote.ValidatorAddress)
+	ctx.KVStore(k.storeKey).Set(types.MakeEthereumHeaderVoteKey(k.getBridgeChainID(ctx), validator), k.cdc.MustMarshal(&vote))
+}
+
+// IterateEthereumHeaderVotes iterates over the latest ethereum header of the
+// bridge chain voted by each validator
+func (k Keeper) IterateEthereumHeaderVotes(ctx sdk.Context, cb func(types.EthereumHeaderVote) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.EthereumHeaderVoteKey}, sdk.Uint64ToBigEndian(k.getBridgeChainID(ctx))...)).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var vote types.EthereumHeaderVote
+		k.cdc.MustUnmarshal(iter.Value(), &vote)
+		if cb(vote) {
+			break
+		}
+	}
+}
+
+// VoteEthereumHeader records the ethereum header a validator voted for at the
+// next checkpoint, along with the hashes of the blocks since the previous
+// checkpoint. The first agreed header anchors the chain, every later one must
+// be at the next checkpoint height and link to the agreed header by its parent
+// checkpoint hash. Once validators holding the event vote threshold of power
+// vote for the same header it becomes the agreed header.
+func (k Keeper) VoteEthereumHeader(ctx sdk.Context, validator sdk.ValAddress, header types.EthereumHeader) error {
+	params := k.GetParams(ctx)
+	if !params.EthereumHeaderValidationEnabled {
+		return sdkerrors.Wrap(types.ErrInvalidEthereumHeader, "ethereum header validation is disabled")
+	}
+	if header.Height%params.EthereumHeaderCheckpointInterval != 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidEthereumHeader, "height %d is not a checkpoint, checkpoints are every %d blocks", header.Height, params.EthereumHeaderCheckpointInterval)
+	}
+	if uint64(len(header.BlockHashes)) != params.EthereumHeaderCheckpointInterval {
+		return sdkerrors.Wrapf(types.ErrInvalidEthereumHeader, "expected the hashes of the %d blocks since the previous checkpoint, got %d", params.EthereumHeaderCheckpointInterval, len(header.BlockHashes))
+	}
+	if agreed := k.GetAgreedEthereumHeader(ctx); agreed != nil {
+		if next := agreed.Height + params.EthereumHeaderCheckpointInterval; header.Height != next {
+			return sdkerrors.Wrapf(types.ErrInvalidEthereumHeader, "expected the checkpoint at height %d, got %d", next, header.Height)
+		}
+		if !bytes.Equal(header.ParentCheckpointHash, agreed.Hash) {
+			return sdkerrors.Wrapf(types.ErrInvalidEthereumHeader, "parent checkpoint hash %s is not the agreed header %s", header.ParentCheckpointHash, agreed.Hash)
+		}
+	}
+
+	k.setEthereumHeaderVote(ctx, types.EthereumHeaderVote{
+		ValidatorAddress: validator.String(),
+		Header:           header,
+	})
+
+	votedPower := sdk.ZeroInt()
+	k.IterateEthereumHeaderVotes(ctx, func(vote types.EthereumHeaderVote) bool {
+		if vote.Header.Equal(header) {
+			val, _ := sdk.ValAddressFromBech32(vote.ValidatorAddress)
+			votedPower = votedPower.Add(sdk.NewInt(k.StakingKeeper.GetLastValidatorPower(ctx, val)))
+		}
+		return false
+	})
+	if votedPower.LT(types.EventVoteRecordPowerThreshold(k.StakingKeeper.GetLastTotalPower(ctx), k.GetEventVotePowerThreshold(ctx))) {
+		return nil
+	}
+
+	k.setAgreedEthereumHeader(ctx, header)
+	k.pruneAgreedEthereumHeaders(ctx)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEthereumHeaderAgreed,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyEthereumHeight, fmt.Sprint(header.Height)),
+		sdk.NewAttribute(types.AttributeKeyEthereumBlockHash, header.Hash.String()),
+	))
+	return nil
+}
+
+// checkEthereumEventBlock rejects, while ethereum header validation is
+// enabled, an event whose block is not an ancestor of the agreed header: an
+// event claiming a block beyond the agreed header, or a block whose hash is
+// not the agreed one at its height. Events are not checked until a header is
+// agreed on, nor are events below the blocks of the first agreed header.
+func (k Keeper) checkEthereumEventBlock(ctx sdk.Context, event types.EthereumEvent) error {
+	if !k.GetParams(ctx).EthereumHeaderValidationEnabled {
+		return nil
+	}
+	agreed := k.GetAgreedEthereumHeader(ctx)
+	if agreed == nil {
+		return nil
+	}
+	if event.GetEthereumHeight() > agreed.Height {
+		return sdkerrors.Wrapf(types.ErrEventBeyondAgreedHeader, "event at height %d, agreed header at height %d", event.GetEthereumHeight(), agreed.Height)
+	}
+	header, blockHash := k.getAgreedEthereumHeaderCovering(ctx, event.GetEthereumHeight())
+	if header == nil {
+		return nil
+	}
+	if !bytes.Equal(event.GetEthereumBlockHash(), blockHash) {
+		return sdkerrors.Wrapf(types.ErrEventBeyondAgreedHeader, "event in block %s at height %d, agreed block %s in the checkpoint at height %d",
+			event.GetEthereumBlockHash(), event.GetEthereumHeight(), blockHash, header.Height)
+	}
+	return nil
+}
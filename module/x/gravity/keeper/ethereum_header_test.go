@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestVoteEthereumHeader(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+	gk.StakingKeeper = NewStakingKeeperMock(ValAddrs[0], ValAddrs[1], ValAddrs[2])
+
+	blockHash := func(height uint64) tmbytes.HexBytes {
+		return crypto.Keccak256(sdk.Uint64ToBigEndian(height))
+	}
+	header := func(height uint64, parent []byte) types.EthereumHeader {
+		var blockHashes []tmbytes.HexBytes
+		for h := height - 99; h <= height; h++ {
+			blockHashes = append(blockHashes, blockHash(h))
+		}
+		return types.EthereumHeader{
+			Height:               height,
+			Hash:                 blockHash(height),
+			ParentCheckpointHash: parent,
+			BlockHashes:          blockHashes,
+		}
+	}
+	anchor := header(1000, blockHash(900))
+
+	// votes are rejected while the validation is disabled
+	require.ErrorIs(t, gk.VoteEthereumHeader(ctx, ValAddrs[0], anchor), types.ErrInvalidEthereumHeader)
+
+	params := gk.GetParams(ctx)
+	params.EthereumHeaderValidationEnabled = true
+	gk.SetParams(ctx, params)
+
+	require.ErrorIs(t, gk.VoteEthereumHeader(ctx, ValAddrs[0], header(1050, anchor.Hash)), types.ErrInvalidEthereumHeader)
+
+	// the hashes of all the blocks since the previous checkpoint are required
+	partial := anchor
+	partial.BlockHashes = anchor.BlockHashes[50:]
+	require.ErrorIs(t, gk.VoteEthereumHeader(ctx, ValAddrs[0], partial), types.ErrInvalidEthereumHeader)
+
+	// events are not checked until a header is agreed on
+	event := &types.SendToCosmosEvent{EventNonce: 1, EthereumHeight: 1001}
+	require.NoError(t, gk.checkEthereumEventBlock(ctx, event))
+
+	// a single vote is below the threshold
+	require.NoError(t, gk.VoteEthereumHeader(ctx, ValAddrs[0], anchor))
+	require.Nil(t, gk.GetAgreedEthereumHeader(ctx))
+	require.NoError(t, gk.VoteEthereumHeader(ctx, ValAddrs[1], anchor))
+	require.Equal(t, &anchor, gk.GetAgreedEthereumHeader(ctx))
+
+	// events beyond the agreed header are rejected
+	require.ErrorIs(t, gk.checkEthereumEventBlock(ctx, event), types.ErrEventBeyondAgreedHeader)
+
+	// as are events in blocks off the agreed chain, or without a block hash
+	event.EthereumHeight = 950
+	require.ErrorIs(t, gk.checkEthereumEventBlock(ctx, event), types.ErrEventBeyondAgreedHeader)
+	event.EthereumBlockHash = blockHash(951)
+	require.ErrorIs(t, gk.checkEthereumEventBlock(ctx, event), types.ErrEventBeyondAgreedHeader)
+	event.EthereumBlockHash = blockHash(950)
+	require.NoError(t, gk.checkEthereumEventBlock(ctx, event))
+	event.EthereumHeight, event.EthereumBlockHash = 1000, anchor.Hash
+	require.NoError(t, gk.checkEthereumEventBlock(ctx, event))
+
+	// events below the blocks of the first agreed header are not checked
+	event.EthereumHeight, event.EthereumBlockHash = 900, nil
+	require.NoError(t, gk.checkEthereumEventBlock(ctx, event))
+
+	// the next header must be at the next checkpoint and link to the agreed one
+	require.ErrorIs(t, gk.VoteEthereumHeader(ctx, ValAddrs[0], header(1200, anchor.Hash)), types.ErrInvalidEthereumHeader)
+	require.ErrorIs(t, gk.VoteEthereumHeader(ctx, ValAddrs[0], header(1100, anchor.ParentCheckpointHash)), types.ErrInvalidEthereumHeader)
+
+	next := header(1100, anchor.Hash)
+	require.NoError(t, gk.VoteEthereumHeader(ctx, ValAddrs[1], next))
+	require.NoError(t, gk.VoteEthereumHeader(ctx, ValAddrs[2], next))
+	require.Equal(t, &next, gk.GetAgreedEthereumHeader(ctx))
+
+	// the events in the blocks of earlier agreed headers are still checked
+	// until an event beyond them is observed
+	event.EthereumHeight, event.EthereumBlockHash = 950, blockHash(950)
+	require.NoError(t, gk.checkEthereumEventBlock(ctx, event))
+	event.EthereumBlockHash = blockHash(1050)
+	require.ErrorIs(t, gk.checkEthereumEventBlock(ctx, event), types.ErrEventBeyondAgreedHeader)
+
+	gk.SetLastObservedEthereumBlockHeight(ctx, 1050)
+	third := header(1200, next.Hash)
+	require.NoError(t, gk.VoteEthereumHeader(ctx, ValAddrs[0], third))
+	require.NoError(t, gk.VoteEthereumHeader(ctx, ValAddrs[1], third))
+	var heights []uint64
+	gk.IterateAgreedEthereumHeaders(ctx, func(header types.EthereumHeader) bool {
+		heights = append(heights, header.Height)
+		return false
+	})
+	require.Equal(t, []uint64{1100, 1200}, heights)
+
+	res, err := gk.AgreedEthereumHeader(sdk.WrapSDKContext(ctx), &types.AgreedEthereumHeaderRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &third, res.Header)
+	require.Len(t, res.Votes, 3)
+
+	// the headers and votes are kept per bridge chain
+	params.BridgeChainId++
+	gk.SetParams(ctx, params)
+	require.Nil(t, gk.GetAgreedEthereumHeader(ctx))
+	require.NoError(t, gk.checkEthereumEventBlock(ctx, event))
+}
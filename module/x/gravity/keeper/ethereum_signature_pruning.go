@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// validatorAddressLengths are the lengths of the validator address a
+// signature key can end with, as the key doesn't length prefix it
+var validatorAddressLengths = []int{20, 32}
+
+// PruneOrphanedEthereumSignatures deletes the ethereum signatures whose
+// outgoing tx no longer exists, in case a path deleting an outgoing tx left
+// its signatures behind, so that the signatures in state are those of the
+// outgoing txs still in flight.
+func (k Keeper) PruneOrphanedEthereumSignatures(ctx sdk.Context) {
+	// collect first, the store can't be written while it is being iterated
+	var orphaned [][]byte
+	k.iterateOrphanedEthereumSignatures(ctx, func(key []byte) bool {
+		orphaned = append(orphaned, key)
+		return false
+	})
+	if len(orphaned) == 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumSignatureKey})
+	for _, key := range orphaned {
+		store.Delete(key)
+	}
+	k.RecordEndBlockerAction(ctx, types.EndBlockerActionEthereumSignaturesPruned, fmt.Sprintf(
+		"%d signatures of outgoing txs no longer in state", len(orphaned),
+	))
+}
+
+// iterateOrphanedEthereumSignatures iterates over the keys, without the
+// signature key prefix, of the ethereum signatures whose outgoing tx no longer
+// exists
+func (k Keeper) iterateOrphanedEthereumSignatures(ctx sdk.Context, cb func(key []byte) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := prefix.NewStore(store, []byte{types.EthereumSignatureKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		orphaned := true
+		// the key is the store index of the outgoing tx followed by the validator address
+		for _, addrLen := range validatorAddressLengths {
+			if len(key) > addrLen && store.Has(types.MakeOutgoingTxKey(key[:len(key)-addrLen])) {
+				orphaned = false
+				break
+			}
+		}
+		if orphaned && cb(key) {
+			break
+		}
+	}
+}
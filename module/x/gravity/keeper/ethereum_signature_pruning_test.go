@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestPruneOrphanedEthereumSignatures(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+
+	batch := &types.BatchTx{BatchNonce: 1, TokenContract: tokenContract.Hex(), Height: 1}
+	gk.SetOutgoingTx(ctx, batch)
+	for _, nonce := range []uint64{1, 2} {
+		for i, val := range ValAddrs {
+			gk.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+				TokenContract:  tokenContract.Hex(),
+				BatchNonce:     nonce,
+				EthereumSigner: EthAddrs[i].Hex(),
+				Signature:      []byte("signature"),
+			}, val)
+		}
+	}
+
+	// the signatures of batch 2, which is not in state, are orphaned
+	_, broken := OrphanedEthereumSignaturesInvariant(gk)(ctx)
+	require.True(t, broken)
+
+	gk.PruneOrphanedEthereumSignatures(ctx)
+	_, broken = OrphanedEthereumSignaturesInvariant(gk)(ctx)
+	require.False(t, broken)
+	require.Len(t, gk.GetEthereumSignatures(ctx, batch.GetStoreIndex()), len(ValAddrs))
+	require.Empty(t, gk.GetEthereumSignatures(ctx, types.MakeBatchTxKey(tokenContract, 2)))
+}
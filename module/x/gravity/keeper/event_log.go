@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// logObservedEthereumEvent appends an event about to be applied to the state
+// to the log of observed events, along with the batch or contract call it
+// executes, and adds what it moves in or out of the gravity contract to the
+// bridged supplies. It must run before the event is handled, which deletes
+// the executed outgoing tx.
+func (k Keeper) logObservedEthereumEvent(ctx sdk.Context, event types.EthereumEvent) {
+	any, err := types.PackEvent(event)
+	if err != nil {
+		panic(err)
+	}
+	entry := types.ObservedEthereumEvent{Event: any}
+
+	switch event := event.(type) {
+	case *types.SendToCosmosEvent:
+		k.addBridgedSupply(ctx, common.HexToAddress(event.TokenContract), event.Amount)
+
+	case *types.SendEtherToCosmosEvent:
+		k.addBridgedSupply(ctx, types.NativeEtherContract, event.Amount)
+
+	case *types.BatchExecutedEvent:
+		otx, err := k.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(event.TokenContract), event.BatchNonce))
+		if batchTx, ok := otx.(*types.BatchTx); err == nil && ok {
+			entry.ExecutedBatchTx = batchTx
+			for _, tx := range batchTx.Transactions {
+				k.addBridgedSupply(ctx, common.HexToAddress(batchTx.TokenContract), tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount).Neg())
+			}
+		}
+
+	case *types.ContractCallExecutedEvent:
+		otx, err := k.GetOutgoingTx(ctx, types.MakeContractCallTxKey(event.InvalidationScope, event.InvalidationNonce))
+		if contractCallTx, ok := otx.(*types.ContractCallTx); err == nil && ok {
+			entry.ExecutedContractCallTx = contractCallTx
+			for _, token := range append(append([]types.ERC20Token{}, contractCallTx.Tokens...), contractCallTx.Fees...) {
+				k.addBridgedSupply(ctx, common.HexToAddress(token.Contract), token.Amount.Neg())
+			}
+		}
+	}
+
+	k.setObservedEthereumEvent(ctx, entry, event.GetEventNonce())
+}
+
+func (k Keeper) setObservedEthereumEvent(ctx sdk.Context, entry types.ObservedEthereumEvent, eventNonce uint64) {
+	ctx.KVStore(k.storeKey).Set(types.MakeObservedEthereumEventKey(eventNonce), k.cdc.MustMarshal(&entry))
+}
+
+// IterateObservedEthereumEvents iterates over the log of observed ethereum
+// events by event nonce
+func (k Keeper) IterateObservedEthereumEvents(ctx sdk.Context, cb func(entry types.ObservedEthereumEvent) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ObservedEthereumEventKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var entry types.ObservedEthereumEvent
+		k.cdc.MustUnmarshal(iter.Value(), &entry)
+		if cb(entry) {
+			break
+		}
+	}
+}
+
+// GetBridgedSupply returns the net amount of an ERC20 bridged in since the
+// event log started: the deposits observed less the transfers and fees of
+// the batches and contract calls executed
+func (k Keeper) GetBridgedSupply(ctx sdk.Context, tokenContract common.Address) sdk.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeBridgedSupplyKey(tokenContract))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var supply sdk.Int
+	if err := supply.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return supply
+}
+
+func (k Keeper) setBridgedSupply(ctx sdk.Context, tokenContract common.Address, supply sdk.Int) {
+	bz, err := supply.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.MakeBridgedSupplyKey(tokenContract), bz)
+}
+
+func (k Keeper) addBridgedSupply(ctx sdk.Context, tokenContract common.Address, amount sdk.Int) {
+	k.setBridgedSupply(ctx, tokenContract, k.GetBridgedSupply(ctx, tokenContract).Add(amount))
+}
+
+// IterateBridgedSupplies iterates over the bridged supplies by token contract
+func (k Keeper) IterateBridgedSupplies(ctx sdk.Context, cb func(supply types.ERC20Token) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.BridgedSupplyKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		if cb(types.ERC20Token{Contract: common.BytesToAddress(iter.Key()).Hex(), Amount: amount}) {
+			break
+		}
+	}
+}
+
+// resetObservedEthereumEvents deletes the log of observed ethereum events and
+// the bridged supplies derived from it
+func (k Keeper) resetObservedEthereumEvents(ctx sdk.Context) {
+	for _, keyPrefix := range []byte{types.ObservedEthereumEventKey, types.BridgedSupplyKey} {
+		prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{keyPrefix})
+		iter := prefixStore.Iterator(nil, nil)
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+		for _, key := range keys {
+			prefixStore.Delete(key)
+		}
+	}
+}
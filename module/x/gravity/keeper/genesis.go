@@ -13,12 +13,113 @@ import (
 // InitGenesis starts a chain from a genesis state
 func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.SetParams(ctx, *data.Params)
+	if err := k.ValidatePowerReduction(ctx, data.Params.PowerReduction); err != nil {
+		panic(err)
+	}
 
 	// reset pool transactions in state
 	for _, tx := range data.UnbatchedSendToEthereumTxs {
 		k.setUnbatchedSendToEthereum(ctx, tx)
 	}
 
+	// reset deposits waiting on a mint rate limit
+	for _, event := range data.QueuedSendToCosmosEvents {
+		k.setQueuedSendToCosmos(ctx, event)
+	}
+
+	// reset deposits held off the token allowlist
+	for _, event := range data.HeldSendToCosmosEvents {
+		k.setHeldSendToCosmos(ctx, event)
+	}
+
+	// reset the archive of executed batches
+	for _, record := range data.ExecutedBatchTxs {
+		k.setBatchTxExecutionRecord(ctx, record)
+	}
+
+	// reset the archive of executed contract calls
+	for _, record := range data.ExecutedContractCallTxs {
+		k.setContractCallTxExecutionRecord(ctx, record)
+	}
+
+	// reset the archive of refunded contract calls
+	for _, record := range data.RefundedContractCallTxs {
+		k.setContractCallTxRefundRecord(ctx, record)
+	}
+
+	// reset the signer set deltas
+	for _, delta := range data.SignerSetTxDeltas {
+		k.setSignerSetTxDelta(ctx, delta)
+	}
+
+	// reset the bridge fee allowances
+	for _, allowance := range data.BridgeFeeAllowances {
+		k.setBridgeFeeAllowance(ctx, allowance)
+	}
+
+	// reset the checkpoints of past outgoing txs and the bad signature evidence
+	for _, checkpoint := range data.PastEthereumSignatureCheckpoints {
+		k.setPastEthereumSignatureCheckpoint(ctx, checkpoint)
+	}
+	for _, evidenceHash := range data.BadEthereumSignatureEvidence {
+		k.setBadEthereumSignatureEvidence(ctx, evidenceHash)
+	}
+	for _, pastNonce := range data.PastOutgoingTxNonces {
+		k.setPastOutgoingTxNonce(ctx, pastNonce.Scope, pastNonce.Nonce)
+	}
+
+	// reset the ethereum blocklist
+	for _, addr := range data.EthereumBlocklist {
+		k.setEthereumAddressBlocklisted(ctx, common.HexToAddress(addr))
+	}
+
+	// reset the ethereum addresses excluded from signer sets
+	for _, addr := range data.ExcludedEthereumSigners {
+		k.setEthereumSignerExcluded(ctx, common.HexToAddress(addr))
+	}
+
+	// reset the validators opted out of the bridge
+	for _, addr := range data.OptedOutValidators {
+		val, err := sdk.ValAddressFromBech32(addr)
+		if err != nil {
+			panic(err)
+		}
+		k.setValidatorOptedOut(ctx, val)
+	}
+
+	// reset the hourly bridge usage stats
+	for _, bucket := range data.BridgeStatsBuckets {
+		k.setBridgeStatsBucket(ctx, bucket)
+	}
+
+	// reset the agreed ethereum header and the header votes
+	if data.AgreedEthereumHeader != nil {
+		k.setAgreedEthereumHeader(ctx, *data.AgreedEthereumHeader)
+	}
+	for _, header := range data.AgreedEthereumHeaders {
+		k.setAgreedEthereumHeaderByHeight(ctx, header)
+	}
+	for _, vote := range data.EthereumHeaderVotes {
+		k.setEthereumHeaderVote(ctx, vote)
+	}
+
+	// reset the log of observed events and the bridged supplies
+	for _, entry := range data.ObservedEthereumEvents {
+		event, err := types.UnpackEvent(entry.Event)
+		if err != nil {
+			panic(err)
+		}
+		k.setObservedEthereumEvent(ctx, entry, event.GetEventNonce())
+	}
+	for _, supply := range data.BridgedSupplies {
+		k.setBridgedSupply(ctx, common.HexToAddress(supply.Contract), supply.Amount)
+	}
+
+	// reset the frozen delegate keys
+	for _, freeze := range data.OrchestratorFreezes {
+		k.setOrchestratorFreeze(ctx, freeze)
+	}
+
 	// reset ethereum event vote records in state
 	for _, evr := range data.EthereumEventVoteRecords {
 		event, err := types.UnpackEvent(evr.Event)
@@ -46,7 +147,43 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		}
 	}
 
+	// reset the last event nonces of validators, which also cover the
+	// validators whose votes were pruned with their observed events
+	for _, entry := range data.LastEventNoncesByValidator {
+		val, _ := sdk.ValAddressFromBech32(entry.ValidatorAddress)
+		k.setLastEventNonceByValidator(ctx, val, entry.EventNonce)
+	}
+
+	// reset the ethereum height votes and the last observed ethereum height
+	for _, vote := range data.EthereumHeightVotes {
+		val, _ := sdk.ValAddressFromBech32(vote.ValidatorAddress)
+		k.setEthereumHeightVote(ctx, val, vote.Height)
+	}
+	k.SetLastObservedEthereumBlockHeightWithCosmos(ctx, data.LastObservedEthereumHeight.EthereumHeight, data.LastObservedEthereumHeight.CosmosHeight)
+
+	// reset the liveness heights of validators
+	for _, entry := range data.ValidatorLivenessHeights {
+		val, _ := sdk.ValAddressFromBech32(entry.ValidatorAddress)
+		if entry.LastSignatureHeight != 0 {
+			k.setLastSignatureHeightByValidator(ctx, val, entry.LastSignatureHeight)
+		}
+		if entry.LastEventVoteHeight != 0 {
+			k.setLastEventVoteHeightByValidator(ctx, val, entry.LastEventVoteHeight)
+		}
+		k.setMissedSignaturesByValidator(ctx, val, entry.MissedSignatures)
+	}
+
+	// reset the index of observed events by ethereum tx hash
+	for _, evr := range data.EthereumTxHashEventVoteRecords {
+		event, err := types.UnpackEvent(evr.Event)
+		if err != nil {
+			panic(fmt.Sprintf("couldn't cast to event: %s", err))
+		}
+		k.setEthereumTxHashEventVoteRecord(ctx, event, evr)
+	}
+
 	// reset delegate keys in state
+	ethereumSigners := make(map[common.Address]sdk.ValAddress)
 	for _, keys := range data.DelegateKeys {
 		if err := keys.ValidateBasic(); err != nil {
 			panic(fmt.Sprintf("Invalid delegate key in Genesis: %s", err))
@@ -61,6 +198,67 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		// set the ethereum address
 		k.setValidatorEthereumAddress(ctx, val, common.HexToAddress(keys.EthereumAddress))
 		k.setEthereumOrchestratorAddress(ctx, eth, orch)
+		ethereumSigners[eth] = val
+	}
+
+	// reset the signature schemes of validators, which their signatures below
+	// are verified with
+	for _, entry := range data.ValidatorSignatureSchemes {
+		val, _ := sdk.ValAddressFromBech32(entry.ValidatorAddress)
+		if _, err := k.getSignatureVerifier(entry.Scheme); err != nil {
+			panic(fmt.Sprintf("invalid signature scheme in genesis: %s", err))
+		}
+		k.setValidatorSignatureScheme(ctx, val, entry.Scheme)
+	}
+
+	// reset the ethereum addresses of validators without an orchestrator
+	for _, entry := range data.OrchestratorlessEthereumAddresses {
+		val, _ := sdk.ValAddressFromBech32(entry.ValidatorAddress)
+		eth := common.HexToAddress(entry.EthereumAddress)
+
+		k.setValidatorEthereumAddress(ctx, val, eth)
+		ethereumSigners[eth] = val
+	}
+
+	// reset the circuit breaker flows
+	for _, flow := range data.BridgeFlows {
+		k.setBridgeFlow(ctx, *flow)
+	}
+
+	// reset the history of signer sets observed on ethereum
+	for _, observed := range data.ObservedSignerSetTxs {
+		k.setObservedSignerSetTx(ctx, *observed)
+	}
+
+	// reset the erc20 deployments awaiting confirmation
+	for _, deployment := range data.PendingErc20Deployments {
+		k.setPendingERC20Deployment(ctx, deployment)
+	}
+
+	// reset the decimal conversions of the erc20s
+	for _, conversion := range data.Erc20Conversions {
+		k.setERC20Conversion(ctx, conversion)
+	}
+
+	// reset the tokens whose sends to ethereum are paused
+	for _, pause := range data.TokenPauses {
+		k.setTokenPause(ctx, pause)
+	}
+
+	// reset the orchestrator query identities
+	for _, identity := range data.OrchestratorQueryIdentities {
+		k.setOrchestratorQueryIdentity(ctx, identity)
+	}
+
+	// reset the sends to ethereum held in the delayed send queue
+	for _, delayed := range data.DelayedSendToEthereums {
+		k.setDelayedSendToEthereum(ctx, delayed)
+	}
+
+	// reset the ethereum addresses waiting on a signer set update
+	for _, entry := range data.PendingEthereumAddresses {
+		val, _ := sdk.ValAddressFromBech32(entry.ValidatorAddress)
+		k.setPendingValidatorEthereumAddress(ctx, val, common.HexToAddress(entry.EthereumAddress))
 	}
 
 	// populate state with cosmos originated denom-erc20 mapping
@@ -68,25 +266,101 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.setCosmosOriginatedDenomToERC20(ctx, item.Denom, common.HexToAddress(item.Erc20))
 	}
 
-	// reset outgoing txs in state
+	// reset outgoing txs in state, the nonce counters can't be lower than
+	// the nonces of the imported txs
+	lastBatchNonce, latestSignerSetNonce := data.LastOutgoingBatchNonce, data.LatestSignerSetTxNonce
 	for _, ota := range data.OutgoingTxs {
 		otx, err := types.UnpackOutgoingTx(ota)
 		if err != nil {
 			panic(fmt.Sprintf("invalid outgoing tx any in genesis file: %s", err))
 		}
 		k.SetOutgoingTx(ctx, otx)
+
+		switch tx := otx.(type) {
+		case *types.BatchTx:
+			if tx.BatchNonce > lastBatchNonce {
+				lastBatchNonce = tx.BatchNonce
+			}
+		case *types.SignerSetTx:
+			if tx.Nonce > latestSignerSetNonce {
+				latestSignerSetNonce = tx.Nonce
+			}
+		}
+	}
+	k.setLastOutgoingBatchNonce(ctx, lastBatchNonce)
+	k.setLatestSignerSetTxNonce(ctx, latestSignerSetNonce)
+
+	// reset the send to ethereum id counter, which can't be lower than the
+	// ids of the imported unbatched sends
+	lastSendToEthereumID := data.LastSendToEthereumId
+	for _, tx := range data.UnbatchedSendToEthereumTxs {
+		if tx.Id > lastSendToEthereumID {
+			lastSendToEthereumID = tx.Id
+		}
 	}
+	k.setLastSendToEthereumID(ctx, lastSendToEthereumID)
 
 	// reset signatures in state
+	gravityID := []byte(k.getGravityID(ctx))
 	for _, confa := range data.Confirmations {
 		conf, err := types.UnpackConfirmation(confa)
 		if err != nil {
 			panic(fmt.Sprintf("invalid etheruem signature in genesis: %s", err))
 		}
-		// TODO: not currently an easy way to get the validator address from the
-		// etherum address here. once we implement the third index for keys
-		// this will be easy.
-		k.SetEthereumSignature(ctx, conf, sdk.ValAddress{})
+
+		// resolve the validator through the delegate keys set above
+		val, ok := ethereumSigners[conf.GetSigner()]
+		if !ok {
+			panic(fmt.Sprintf("no delegate keys for ethereum signer %s in genesis", conf.GetSigner().Hex()))
+		}
+
+		otx, err := k.GetOutgoingTx(ctx, conf.GetStoreIndex())
+		if err != nil {
+			panic(fmt.Sprintf("no outgoing tx for ethereum signature %x in genesis: %s", conf.GetStoreIndex(), err))
+		}
+		valid, err := k.verifyEthereumTxConfirmation(ctx, val, conf, otx.GetCheckpoint(gravityID), conf.GetSigner())
+		if err != nil {
+			panic(fmt.Sprintf("invalid ethereum signature in genesis: %s", err))
+		}
+		if !valid {
+			panic(fmt.Sprintf("ethereum signature of %s in genesis doesn't sign %x", conf.GetSigner().Hex(), conf.GetStoreIndex()))
+		}
+
+		k.SetEthereumSignature(ctx, conf, val)
+	}
+}
+
+// ExportBridgeConfig exports the bridge configuration, i.e. the params, the
+// delegate keys, the token mappings and the latest signer set, as a genesis
+// state that can bootstrap a test or fork environment through InitGenesis.
+func ExportBridgeConfig(ctx sdk.Context, k Keeper) types.GenesisState {
+	var (
+		p             = k.GetParams(ctx)
+		delegates     = k.getDelegateKeys(ctx)
+		erc20ToDenoms []*types.ERC20ToDenom
+		outgoingTxs   []*cdctypes.Any
+	)
+
+	k.iterateERC20ToDenom(ctx, func(key []byte, erc20ToDenom *types.ERC20ToDenom) bool {
+		erc20ToDenoms = append(erc20ToDenoms, erc20ToDenom)
+		return false
+	})
+
+	if signerSet := k.GetLatestSignerSetTx(ctx); signerSet != nil {
+		ota, _ := types.PackOutgoingTx(signerSet)
+		outgoingTxs = append(outgoingTxs, ota)
+	}
+
+	// as in ExportGenesis, the signatures are not needed to import the keys
+	for _, delegate := range delegates {
+		delegate.EthSignature = []byte("unused")
+	}
+
+	return types.GenesisState{
+		Params:        &p,
+		OutgoingTxs:   outgoingTxs,
+		DelegateKeys:  delegates,
+		Erc20ToDenoms: erc20ToDenoms,
 	}
 }
 
@@ -103,8 +377,266 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		lastobserved             = k.GetLastObservedEventNonce(ctx)
 		erc20ToDenoms            []*types.ERC20ToDenom
 		unbatchedTransfers       = k.getUnbatchedSendToEthereums(ctx)
+		queuedDeposits           []*types.SendToCosmosEvent
+		heldDeposits             []*types.SendToCosmosEvent
+		executedBatchTxs         []types.BatchTxExecutionRecord
+		executedContractCallTxs  []types.ContractCallTxExecutionRecord
+		refundedContractCallTxs  []types.ContractCallTxRefundRecord
+		signerSetTxDeltas        []types.SignerSetTxDelta
+		bridgeFeeAllowances      []types.BridgeFeeAllowance
+		pastCheckpoints          [][]byte
+		pastOutgoingTxNonces     []types.PastOutgoingTxNonce
+		badSignatureEvidence     [][]byte
+		signatureSchemes         []types.ValidatorSignatureScheme
+		ethereumBlocklist        []string
+		excludedEthereumSigners  []string
+		optedOutValidators       []string
+		bridgeStatsBuckets       []types.BridgeStatsBucket
+		ethereumHeaderVotes      []types.EthereumHeaderVote
+		agreedEthereumHeaders    []types.EthereumHeader
+		orchestratorFreezes      []types.OrchestratorFreeze
+		observedEvents           []types.ObservedEthereumEvent
+		bridgedSupplies          []types.ERC20Token
+		pendingEthAddrs          []*types.ValidatorEthereumAddress
+		orchestratorlessEthAddrs []*types.ValidatorEthereumAddress
+		bridgeFlows              []*types.BridgeFlow
+		observedSignerSetTxs     []*types.ObservedSignerSetTx
+		pendingERC20Deployments  []types.ERC20DeployedEvent
+		erc20Conversions         []types.ERC20Conversion
+		tokenPauses              []types.TokenPause
+		queryIdentities          []types.OrchestratorQueryIdentity
+		delayedTransfers         []types.DelayedSendToEthereum
+		heightVotes              []types.ValidatorEthereumHeightVote
+		lastEventNonces          []types.ValidatorEventNonce
+		livenessHeights          []types.ValidatorLivenessHeights
+		livenessIndexes          = make(map[string]int)
+		txHashEventVoteRecords   []*types.EthereumEventVoteRecord
 	)
 
+	// export the index of observed events by ethereum tx hash
+	k.iterateEthereumTxHashEventVoteRecords(ctx, nil, func(evr *types.EthereumEventVoteRecord) bool {
+		txHashEventVoteRecords = append(txHashEventVoteRecords, evr)
+		return false
+	})
+
+	// export the ethereum height votes of validators
+	k.IterateEthereumHeightVotes(ctx, func(val sdk.ValAddress, height types.LatestEthereumBlockHeight) bool {
+		heightVotes = append(heightVotes, types.ValidatorEthereumHeightVote{
+			ValidatorAddress: val.String(),
+			Height:           height,
+		})
+		return false
+	})
+
+	// export the last event nonces of validators
+	k.iterateLastEventNonceByValidator(ctx, func(val sdk.ValAddress, nonce uint64) bool {
+		lastEventNonces = append(lastEventNonces, types.ValidatorEventNonce{
+			ValidatorAddress: val.String(),
+			EventNonce:       nonce,
+		})
+		return false
+	})
+
+	// export the liveness heights of validators
+	livenessEntry := func(val sdk.ValAddress) *types.ValidatorLivenessHeights {
+		i, ok := livenessIndexes[val.String()]
+		if !ok {
+			i = len(livenessHeights)
+			livenessIndexes[val.String()] = i
+			livenessHeights = append(livenessHeights, types.ValidatorLivenessHeights{ValidatorAddress: val.String()})
+		}
+		return &livenessHeights[i]
+	}
+	k.iterateLastSignatureHeightByValidator(ctx, func(val sdk.ValAddress, height uint64) bool {
+		livenessEntry(val).LastSignatureHeight = height
+		return false
+	})
+	k.iterateLastEventVoteHeightByValidator(ctx, func(val sdk.ValAddress, height uint64) bool {
+		livenessEntry(val).LastEventVoteHeight = height
+		return false
+	})
+	k.iterateMissedSignaturesByValidator(ctx, func(val sdk.ValAddress, missed uint64) bool {
+		livenessEntry(val).MissedSignatures = missed
+		return false
+	})
+
+	// export the sends to ethereum held in the delayed send queue
+	k.IterateDelayedSendToEthereums(ctx, func(delayed types.DelayedSendToEthereum) bool {
+		delayedTransfers = append(delayedTransfers, delayed)
+		return false
+	})
+
+	// export the orchestrator query identities
+	k.IterateOrchestratorQueryIdentities(ctx, func(identity types.OrchestratorQueryIdentity) bool {
+		queryIdentities = append(queryIdentities, identity)
+		return false
+	})
+
+	// export the tokens whose sends to ethereum are paused
+	k.IterateTokenPauses(ctx, func(pause types.TokenPause) bool {
+		tokenPauses = append(tokenPauses, pause)
+		return false
+	})
+
+	// export the decimal conversions of the erc20s
+	k.IterateERC20Conversions(ctx, func(conversion types.ERC20Conversion) bool {
+		erc20Conversions = append(erc20Conversions, conversion)
+		return false
+	})
+
+	// export the erc20 deployments awaiting confirmation
+	k.IteratePendingERC20Deployments(ctx, func(deployment types.ERC20DeployedEvent) bool {
+		pendingERC20Deployments = append(pendingERC20Deployments, deployment)
+		return false
+	})
+
+	// export the history of signer sets observed on ethereum
+	k.IterateObservedSignerSetTxs(ctx, func(observed types.ObservedSignerSetTx) bool {
+		observedSignerSetTxs = append(observedSignerSetTxs, &observed)
+		return false
+	})
+
+	// export the circuit breaker flows
+	k.IterateBridgeFlows(ctx, func(flow types.BridgeFlow) bool {
+		bridgeFlows = append(bridgeFlows, &flow)
+		return false
+	})
+
+	// export the ethereum addresses waiting on a signer set update
+	k.IteratePendingValidatorEthereumAddresses(ctx, func(val sdk.ValAddress, ethAddr common.Address) bool {
+		pendingEthAddrs = append(pendingEthAddrs, &types.ValidatorEthereumAddress{
+			ValidatorAddress: val.String(),
+			EthereumAddress:  ethAddr.Hex(),
+		})
+		return false
+	})
+
+	// export the ethereum addresses of validators without an orchestrator
+	k.iterateValidatorEthereumAddresses(ctx, func(val sdk.ValAddress, ethAddr common.Address) bool {
+		if k.GetEthereumOrchestratorAddress(ctx, ethAddr) == nil {
+			orchestratorlessEthAddrs = append(orchestratorlessEthAddrs, &types.ValidatorEthereumAddress{
+				ValidatorAddress: val.String(),
+				EthereumAddress:  ethAddr.Hex(),
+			})
+		}
+		return false
+	})
+
+	// export the ethereum blocklist
+	k.IterateEthereumBlocklist(ctx, func(addr common.Address) bool {
+		ethereumBlocklist = append(ethereumBlocklist, addr.Hex())
+		return false
+	})
+
+	// export the ethereum addresses excluded from signer sets
+	k.IterateExcludedEthereumSigners(ctx, func(addr common.Address) bool {
+		excludedEthereumSigners = append(excludedEthereumSigners, addr.Hex())
+		return false
+	})
+
+	// export the validators opted out of the bridge
+	k.IterateOptedOutValidators(ctx, func(val sdk.ValAddress) bool {
+		optedOutValidators = append(optedOutValidators, val.String())
+		return false
+	})
+
+	// export the hourly bridge usage stats
+	k.IterateBridgeStatsBuckets(ctx, 0, func(bucket types.BridgeStatsBucket) bool {
+		bridgeStatsBuckets = append(bridgeStatsBuckets, bucket)
+		return false
+	})
+
+	// export the ethereum header votes and the agreed headers
+	k.IterateEthereumHeaderVotes(ctx, func(vote types.EthereumHeaderVote) bool {
+		ethereumHeaderVotes = append(ethereumHeaderVotes, vote)
+		return false
+	})
+	k.IterateAgreedEthereumHeaders(ctx, func(header types.EthereumHeader) bool {
+		agreedEthereumHeaders = append(agreedEthereumHeaders, header)
+		return false
+	})
+
+	// export the log of observed events and the bridged supplies
+	k.IterateObservedEthereumEvents(ctx, func(entry types.ObservedEthereumEvent) bool {
+		observedEvents = append(observedEvents, entry)
+		return false
+	})
+	k.IterateBridgedSupplies(ctx, func(supply types.ERC20Token) bool {
+		bridgedSupplies = append(bridgedSupplies, supply)
+		return false
+	})
+
+	// export the frozen delegate keys
+	k.IterateOrchestratorFreezes(ctx, func(freeze types.OrchestratorFreeze) bool {
+		orchestratorFreezes = append(orchestratorFreezes, freeze)
+		return false
+	})
+
+	// export deposits waiting on a mint rate limit
+	k.IterateQueuedSendToCosmos(ctx, func(event *types.SendToCosmosEvent) bool {
+		queuedDeposits = append(queuedDeposits, event)
+		return false
+	})
+
+	// export deposits held off the token allowlist
+	k.IterateHeldSendToCosmos(ctx, func(event *types.SendToCosmosEvent) bool {
+		heldDeposits = append(heldDeposits, event)
+		return false
+	})
+
+	// export the archive of executed batches
+	k.IterateBatchTxExecutionRecords(ctx, func(record types.BatchTxExecutionRecord) bool {
+		executedBatchTxs = append(executedBatchTxs, record)
+		return false
+	})
+
+	// export the archive of executed contract calls
+	k.IterateContractCallTxExecutionRecords(ctx, func(record types.ContractCallTxExecutionRecord) bool {
+		executedContractCallTxs = append(executedContractCallTxs, record)
+		return false
+	})
+
+	// export the archive of refunded contract calls
+	k.IterateContractCallTxRefundRecords(ctx, func(record types.ContractCallTxRefundRecord) bool {
+		refundedContractCallTxs = append(refundedContractCallTxs, record)
+		return false
+	})
+
+	// export the signer set deltas
+	k.IterateSignerSetTxDeltas(ctx, func(delta types.SignerSetTxDelta) bool {
+		signerSetTxDeltas = append(signerSetTxDeltas, delta)
+		return false
+	})
+
+	// export the bridge fee allowances
+	k.IterateBridgeFeeAllowances(ctx, func(allowance types.BridgeFeeAllowance) bool {
+		bridgeFeeAllowances = append(bridgeFeeAllowances, allowance)
+		return false
+	})
+
+	// export the checkpoints of past outgoing txs and the bad signature evidence
+	k.iteratePastEthereumSignatureCheckpoints(ctx, func(checkpoint []byte) bool {
+		pastCheckpoints = append(pastCheckpoints, checkpoint)
+		return false
+	})
+	k.iterateBadEthereumSignatureEvidence(ctx, func(evidenceHash []byte) bool {
+		badSignatureEvidence = append(badSignatureEvidence, evidenceHash)
+		return false
+	})
+	k.iteratePastOutgoingTxNonces(ctx, func(scope []byte, nonce uint64) bool {
+		pastOutgoingTxNonces = append(pastOutgoingTxNonces, types.PastOutgoingTxNonce{Scope: scope, Nonce: nonce})
+		return false
+	})
+
+	// export the signature schemes of validators
+	k.iterateValidatorSignatureSchemes(ctx, func(val sdk.ValAddress, scheme types.SignatureScheme) bool {
+		signatureSchemes = append(signatureSchemes, types.ValidatorSignatureScheme{
+			ValidatorAddress: val.String(),
+			Scheme:           scheme,
+		})
+		return false
+	})
+
 	// export ethereumEventVoteRecords from state
 	for _, atts := range attmap {
 		// TODO: set height = 0?
@@ -117,13 +649,24 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		return false
 	})
 
+	// the signatures of validators left without an ethereum address, as
+	// frozen ones are, could not be verified on import and are not exported
+	exportedSigner := func(val sdk.ValAddress) (string, bool) {
+		ethAddr := k.GetValidatorEthereumAddress(ctx, val)
+		return ethAddr.Hex(), ethAddr != (common.Address{})
+	}
+
 	// export signer set txs and sigs
 	k.IterateOutgoingTxsByType(ctx, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
 		ota, _ := types.PackOutgoingTx(otx)
 		outgoingTxs = append(outgoingTxs, ota)
 		sstx, _ := otx.(*types.SignerSetTx)
-		k.iterateEthereumSignatures(ctx, sstx.GetStoreIndex(), func(val sdk.ValAddress, sig []byte) bool {
-			siga, _ := types.PackConfirmation(&types.SignerSetTxConfirmation{sstx.Nonce, k.GetValidatorEthereumAddress(ctx, val).Hex(), sig})
+		k.iterateEthereumSignatures(ctx, sstx.GetStoreIndex(), func(val sdk.ValAddress, sig types.EthereumSignature) bool {
+			signer, ok := exportedSigner(val)
+			if !ok {
+				return false
+			}
+			siga, _ := types.PackConfirmation(&types.SignerSetTxConfirmation{sstx.Nonce, signer, sig.Signature, sig.Scheme})
 			ethereumTxConfirmations = append(ethereumTxConfirmations, siga)
 			return false
 		})
@@ -135,8 +678,12 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		ota, _ := types.PackOutgoingTx(otx)
 		outgoingTxs = append(outgoingTxs, ota)
 		btx, _ := otx.(*types.BatchTx)
-		k.iterateEthereumSignatures(ctx, btx.GetStoreIndex(), func(val sdk.ValAddress, sig []byte) bool {
-			siga, _ := types.PackConfirmation(&types.BatchTxConfirmation{btx.TokenContract, btx.BatchNonce, k.GetValidatorEthereumAddress(ctx, val).Hex(), sig})
+		k.iterateEthereumSignatures(ctx, btx.GetStoreIndex(), func(val sdk.ValAddress, sig types.EthereumSignature) bool {
+			signer, ok := exportedSigner(val)
+			if !ok {
+				return false
+			}
+			siga, _ := types.PackConfirmation(&types.BatchTxConfirmation{btx.TokenContract, btx.BatchNonce, signer, sig.Signature, sig.Scheme})
 			ethereumTxConfirmations = append(ethereumTxConfirmations, siga)
 			return false
 		})
@@ -148,8 +695,12 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		ota, _ := types.PackOutgoingTx(otx)
 		outgoingTxs = append(outgoingTxs, ota)
 		btx, _ := otx.(*types.ContractCallTx)
-		k.iterateEthereumSignatures(ctx, btx.GetStoreIndex(), func(val sdk.ValAddress, sig []byte) bool {
-			siga, _ := types.PackConfirmation(&types.ContractCallTxConfirmation{btx.InvalidationScope, btx.InvalidationNonce, k.GetValidatorEthereumAddress(ctx, val).Hex(), sig})
+		k.iterateEthereumSignatures(ctx, btx.GetStoreIndex(), func(val sdk.ValAddress, sig types.EthereumSignature) bool {
+			signer, ok := exportedSigner(val)
+			if !ok {
+				return false
+			}
+			siga, _ := types.PackConfirmation(&types.ContractCallTxConfirmation{btx.InvalidationScope, btx.InvalidationNonce, signer, sig.Signature, sig.Scheme})
 			ethereumTxConfirmations = append(ethereumTxConfirmations, siga)
 			return false
 		})
@@ -162,13 +713,51 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	}
 
 	return types.GenesisState{
-		Params:                     &p,
-		LastObservedEventNonce:     lastobserved,
-		OutgoingTxs:                outgoingTxs,
-		Confirmations:              ethereumTxConfirmations,
-		EthereumEventVoteRecords:   ethereumEventVoteRecords,
-		DelegateKeys:               delegates,
-		Erc20ToDenoms:              erc20ToDenoms,
-		UnbatchedSendToEthereumTxs: unbatchedTransfers,
+		Params:                            &p,
+		LastObservedEventNonce:            lastobserved,
+		OutgoingTxs:                       outgoingTxs,
+		Confirmations:                     ethereumTxConfirmations,
+		EthereumEventVoteRecords:          ethereumEventVoteRecords,
+		DelegateKeys:                      delegates,
+		Erc20ToDenoms:                     erc20ToDenoms,
+		UnbatchedSendToEthereumTxs:        unbatchedTransfers,
+		QueuedSendToCosmosEvents:          queuedDeposits,
+		EthereumBlocklist:                 ethereumBlocklist,
+		PendingEthereumAddresses:          pendingEthAddrs,
+		OrchestratorlessEthereumAddresses: orchestratorlessEthAddrs,
+		BridgeFlows:                       bridgeFlows,
+		ObservedSignerSetTxs:              observedSignerSetTxs,
+		PendingErc20Deployments:           pendingERC20Deployments,
+		Erc20Conversions:                  erc20Conversions,
+		TokenPauses:                       tokenPauses,
+		OrchestratorQueryIdentities:       queryIdentities,
+		DelayedSendToEthereums:            delayedTransfers,
+		EthereumHeightVotes:               heightVotes,
+		LastEventNoncesByValidator:        lastEventNonces,
+		LastObservedEthereumHeight:        k.GetLastObservedEthereumBlockHeight(ctx),
+		LastSendToEthereumId:              k.getLastSendToEthereumID(ctx),
+		LastOutgoingBatchNonce:            k.getLastOutgoingBatchNonce(ctx),
+		LatestSignerSetTxNonce:            k.GetLatestSignerSetTxNonce(ctx),
+		ValidatorLivenessHeights:          livenessHeights,
+		EthereumTxHashEventVoteRecords:    txHashEventVoteRecords,
+		HeldSendToCosmosEvents:            heldDeposits,
+		ExecutedBatchTxs:                  executedBatchTxs,
+		ValidatorSignatureSchemes:         signatureSchemes,
+		ExcludedEthereumSigners:           excludedEthereumSigners,
+		ExecutedContractCallTxs:           executedContractCallTxs,
+		BridgeFeeAllowances:               bridgeFeeAllowances,
+		PastEthereumSignatureCheckpoints:  pastCheckpoints,
+		BadEthereumSignatureEvidence:      badSignatureEvidence,
+		RefundedContractCallTxs:           refundedContractCallTxs,
+		SignerSetTxDeltas:                 signerSetTxDeltas,
+		OptedOutValidators:                optedOutValidators,
+		BridgeStatsBuckets:                bridgeStatsBuckets,
+		AgreedEthereumHeader:              k.GetAgreedEthereumHeader(ctx),
+		EthereumHeaderVotes:               ethereumHeaderVotes,
+		AgreedEthereumHeaders:             agreedEthereumHeaders,
+		OrchestratorFreezes:               orchestratorFreezes,
+		PastOutgoingTxNonces:              pastOutgoingTxNonces,
+		ObservedEthereumEvents:            observedEvents,
+		BridgedSupplies:                   bridgedSupplies,
 	}
 }
@@ -5,7 +5,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
 )
 
 // for the moment this is only testing delegate keys being set, but it would be good to make
@@ -34,3 +38,119 @@ func TestExportAndImport(t *testing.T) {
 	assert.Equal(t, newKeeper.GetEthereumOrchestratorAddress(newCtx, ethAddr), orchAddr)
 	assert.Equal(t, newKeeper.GetOrchestratorValidatorAddress(newCtx, orchAddr), valAddr)
 }
+
+func TestExportBridgeConfigAndImport(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	keeper := env.GravityKeeper
+
+	valAddr, _ := sdk.ValAddressFromBech32("cosmosvaloper13yfm8as7y0mzsxqkfmk5jvgm45aez0u24jk95z")
+	orchAddr, _ := sdk.AccAddressFromBech32("cosmos1h706wwrghfpydyh735aet8aluhf95dqj0psgyf")
+	ethAddr := common.BytesToAddress([]byte("0xFDb0aaBD40774BBF3068Bf29E8b0a6C88BE26F83"))
+	erc20 := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+
+	keeper.setValidatorEthereumAddress(ctx, valAddr, ethAddr)
+	keeper.setEthereumOrchestratorAddress(ctx, ethAddr, orchAddr)
+	keeper.SetOrchestratorValidatorAddress(ctx, valAddr, orchAddr)
+	keeper.setCosmosOriginatedDenomToERC20(ctx, "uatom", erc20)
+	signerSet := keeper.CreateSignerSetTx(ctx)
+
+	config := ExportBridgeConfig(ctx, keeper)
+	assert.NoError(t, config.ValidateBasic())
+
+	newEnv := CreateTestEnv(t)
+	newCtx := newEnv.Context
+	newKeeper := newEnv.GravityKeeper
+
+	InitGenesis(newCtx, newKeeper, config)
+
+	assert.Equal(t, newKeeper.GetParams(newCtx), keeper.GetParams(ctx))
+	assert.Equal(t, newKeeper.GetOrchestratorValidatorAddress(newCtx, orchAddr), valAddr)
+	isCosmosOriginated, denom := newKeeper.ERC20ToDenomLookup(newCtx, erc20)
+	assert.True(t, isCosmosOriginated)
+	assert.Equal(t, "uatom", denom)
+	otx, err := newKeeper.GetOutgoingTx(newCtx, signerSet.GetStoreIndex())
+	assert.NoError(t, err)
+	assert.Equal(t, signerSet, otx)
+}
+
+func TestExportAndImportBridgeProgress(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context.WithBlockHeight(100)
+	keeper := env.GravityKeeper
+
+	valAddr, _ := sdk.ValAddressFromBech32("cosmosvaloper13yfm8as7y0mzsxqkfmk5jvgm45aez0u24jk95z")
+
+	keeper.SetEthereumHeightVote(ctx, valAddr, 1234)
+	keeper.SetLastObservedEthereumBlockHeight(ctx, 1200)
+	keeper.setLastObservedEventNonce(ctx, 10)
+	keeper.setLastEventNonceByValidator(ctx, valAddr, 10)
+	keeper.setLastSignatureHeightByValidator(ctx, valAddr, 90)
+	keeper.setLastEventVoteHeightByValidator(ctx, valAddr, 95)
+	keeper.setLastSendToEthereumID(ctx, 7)
+	keeper.setLastOutgoingBatchNonce(ctx, 3)
+	keeper.setLatestSignerSetTxNonce(ctx, 2)
+
+	exportedGenesis := ExportGenesis(ctx, keeper)
+	assert.NoError(t, exportedGenesis.ValidateBasic())
+
+	newEnv := CreateTestEnv(t)
+	newCtx := newEnv.Context.WithBlockHeight(101)
+	newKeeper := newEnv.GravityKeeper
+
+	InitGenesis(newCtx, newKeeper, exportedGenesis)
+
+	assert.Equal(t, types.LatestEthereumBlockHeight{EthereumHeight: 1234, CosmosHeight: 100}, newKeeper.GetEthereumHeightVote(newCtx, valAddr))
+	assert.Equal(t, types.LatestEthereumBlockHeight{EthereumHeight: 1200, CosmosHeight: 100}, newKeeper.GetLastObservedEthereumBlockHeight(newCtx))
+	// without the exported entry the nonce would fall back to the last observed nonce minus one
+	assert.Equal(t, uint64(10), newKeeper.getLastEventNonceByValidator(newCtx, valAddr))
+	assert.Equal(t, uint64(90), newKeeper.GetLastSignatureHeightByValidator(newCtx, valAddr))
+	assert.Equal(t, uint64(95), newKeeper.GetLastEventVoteHeightByValidator(newCtx, valAddr))
+	assert.Equal(t, uint64(8), newKeeper.incrementLastSendToEthereumIDKey(newCtx))
+	assert.Equal(t, uint64(4), newKeeper.incrementLastOutgoingBatchNonce(newCtx))
+	assert.Equal(t, uint64(2), newKeeper.GetLatestSignerSetTxNonce(newCtx))
+}
+
+func TestExportAndImportWithFrozenValidator(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	keeper := env.GravityKeeper
+
+	signerSetTx := &types.SignerSetTx{Nonce: 1, Height: 1}
+	keeper.SetOutgoingTx(ctx, signerSetTx)
+	checkpoint := signerSetTx.GetCheckpoint([]byte(keeper.getGravityID(ctx)))
+	for i := range []int{0, 1} {
+		ethPrivKey, err := ethCrypto.GenerateKey()
+		require.NoError(t, err)
+		ethAddr := ethCrypto.PubkeyToAddress(ethPrivKey.PublicKey)
+		keeper.setValidatorEthereumAddress(ctx, ValAddrs[i], ethAddr)
+		keeper.setEthereumOrchestratorAddress(ctx, ethAddr, AccAddrs[i])
+		keeper.SetOrchestratorValidatorAddress(ctx, ValAddrs[i], AccAddrs[i])
+
+		signature, err := types.NewEthereumSignature(checkpoint, ethPrivKey)
+		require.NoError(t, err)
+		keeper.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{
+			SignerSetNonce: signerSetTx.Nonce,
+			EthereumSigner: ethAddr.Hex(),
+			Signature:      signature,
+		}, ValAddrs[i])
+	}
+
+	// the frozen validator keeps its signature but has no ethereum address
+	// left to verify it on import
+	_, err := keeper.FreezeOrchestrator(ctx, ValAddrs[1])
+	require.NoError(t, err)
+
+	exportedGenesis := ExportGenesis(ctx, keeper)
+	assert.Len(t, exportedGenesis.Confirmations, 1)
+
+	newEnv := CreateTestEnv(t)
+	newCtx := newEnv.Context
+	newKeeper := newEnv.GravityKeeper
+	require.NotPanics(t, func() { InitGenesis(newCtx, newKeeper, exportedGenesis) })
+
+	assert.NotNil(t, newKeeper.getEthereumSignature(newCtx, signerSetTx.GetStoreIndex(), ValAddrs[0]))
+	assert.Nil(t, newKeeper.getEthereumSignature(newCtx, signerSetTx.GetStoreIndex(), ValAddrs[1]))
+	_, frozen := newKeeper.GetOrchestratorFreeze(newCtx, ValAddrs[1])
+	assert.True(t, frozen)
+}
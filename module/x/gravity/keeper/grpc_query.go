@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"sort"
 
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -11,8 +14,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/replay"
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -51,9 +57,11 @@ func (k Keeper) SignerSetTx(c context.Context, req *types.SignerSetTxRequest) (*
 	ctx := sdk.UnwrapSDKContext(c)
 
 	key := types.MakeSignerSetTxKey(req.SignerSetNonce)
-	otx := k.GetOutgoingTx(ctx, key)
-	if otx == nil {
+	otx, err := k.GetOutgoingTx(ctx, key)
+	if errors.Is(err, types.ErrOutgoingTxNotFound) {
 		return &types.SignerSetTxResponse{}, nil
+	} else if err != nil {
+		return nil, err
 	}
 
 	ss, ok := otx.(*types.SignerSetTx)
@@ -64,6 +72,21 @@ func (k Keeper) SignerSetTx(c context.Context, req *types.SignerSetTxRequest) (*
 	return &types.SignerSetTxResponse{SignerSet: ss}, nil
 }
 
+func (k Keeper) SignerSetTxDelta(c context.Context, req *types.SignerSetTxDeltaRequest) (*types.SignerSetTxDeltaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	nonce := req.SignerSetNonce
+	if nonce == 0 {
+		nonce = k.GetLatestSignerSetTxNonce(ctx)
+	}
+
+	delta, found := k.GetSignerSetTxDelta(ctx, nonce)
+	if !found {
+		return &types.SignerSetTxDeltaResponse{}, nil
+	}
+	return &types.SignerSetTxDeltaResponse{Delta: &delta}, nil
+}
+
 func (k Keeper) BatchTx(c context.Context, req *types.BatchTxRequest) (*types.BatchTxResponse, error) {
 	if !common.IsHexAddress(req.TokenContract) {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
@@ -72,9 +95,11 @@ func (k Keeper) BatchTx(c context.Context, req *types.BatchTxRequest) (*types.Ba
 	res := &types.BatchTxResponse{}
 
 	key := types.MakeBatchTxKey(common.HexToAddress(req.TokenContract), req.BatchNonce)
-	otx := k.GetOutgoingTx(sdk.UnwrapSDKContext(c), key)
-	if otx == nil {
+	otx, err := k.GetOutgoingTx(sdk.UnwrapSDKContext(c), key)
+	if errors.Is(err, types.ErrOutgoingTxNotFound) {
 		return nil, status.Errorf(codes.InvalidArgument, "no batch tx found for %d %s", req.BatchNonce, req.TokenContract)
+	} else if err != nil {
+		return nil, err
 	}
 	batch, ok := otx.(*types.BatchTx)
 	if !ok {
@@ -87,9 +112,11 @@ func (k Keeper) BatchTx(c context.Context, req *types.BatchTxRequest) (*types.Ba
 
 func (k Keeper) ContractCallTx(c context.Context, req *types.ContractCallTxRequest) (*types.ContractCallTxResponse, error) {
 	key := types.MakeContractCallTxKey(req.InvalidationScope, req.InvalidationNonce)
-	otx := k.GetOutgoingTx(sdk.UnwrapSDKContext(c), key)
-	if otx == nil {
+	otx, err := k.GetOutgoingTx(sdk.UnwrapSDKContext(c), key)
+	if errors.Is(err, types.ErrOutgoingTxNotFound) {
 		return nil, status.Errorf(codes.InvalidArgument, "no contract call found for %d %s", req.InvalidationNonce, req.InvalidationScope)
+	} else if err != nil {
+		return nil, err
 	}
 
 	cctx, ok := otx.(*types.ContractCallTx)
@@ -100,9 +127,44 @@ func (k Keeper) ContractCallTx(c context.Context, req *types.ContractCallTxReque
 	return &types.ContractCallTxResponse{LogicCall: cctx}, nil
 }
 
+func (k Keeper) SignerSetTxCheckpoint(c context.Context, req *types.SignerSetTxRequest) (*types.OutgoingTxCheckpointResponse, error) {
+	return k.outgoingTxCheckpoint(sdk.UnwrapSDKContext(c), types.MakeSignerSetTxKey(req.SignerSetNonce))
+}
+
+func (k Keeper) BatchTxCheckpoint(c context.Context, req *types.BatchTxRequest) (*types.OutgoingTxCheckpointResponse, error) {
+	if !common.IsHexAddress(req.TokenContract) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+	}
+
+	return k.outgoingTxCheckpoint(sdk.UnwrapSDKContext(c), types.MakeBatchTxKey(common.HexToAddress(req.TokenContract), req.BatchNonce))
+}
+
+func (k Keeper) ContractCallTxCheckpoint(c context.Context, req *types.ContractCallTxRequest) (*types.OutgoingTxCheckpointResponse, error) {
+	return k.outgoingTxCheckpoint(sdk.UnwrapSDKContext(c), types.MakeContractCallTxKey(req.InvalidationScope, req.InvalidationNonce))
+}
+
+// outgoingTxCheckpoint returns the checkpoint of the outgoing tx stored under
+// storeIndex and the digest validators sign for it
+func (k Keeper) outgoingTxCheckpoint(ctx sdk.Context, storeIndex []byte) (*types.OutgoingTxCheckpointResponse, error) {
+	otx, err := k.GetOutgoingTx(ctx, storeIndex)
+	if errors.Is(err, types.ErrOutgoingTxNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no outgoing tx found for %X", storeIndex)
+	} else if err != nil {
+		return nil, err
+	}
+
+	gravityID := k.getGravityID(ctx)
+	checkpoint := otx.GetCheckpoint([]byte(gravityID))
+	return &types.OutgoingTxCheckpointResponse{
+		Checkpoint: checkpoint,
+		SignedHash: types.EthereumSignedHash(checkpoint),
+		GravityId:  gravityID,
+	}, nil
+}
+
 func (k Keeper) SignerSetTxs(c context.Context, req *types.SignerSetTxsRequest) (*types.SignerSetTxsResponse, error) {
 	var signers []*types.SignerSetTx
-	pageRes, err := k.PaginateOutgoingTxsByType(sdk.UnwrapSDKContext(c), req.Pagination, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) (hit bool) {
+	pageRes, err := k.PaginateOutgoingTxsByType(sdk.UnwrapSDKContext(c), req.Pagination, types.SignerSetTxPrefixByte, nil, func(_ []byte, otx types.OutgoingTx) (hit bool) {
 		signer, ok := otx.(*types.SignerSetTx)
 		if !ok {
 			panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to signer set for %s", otx))
@@ -118,9 +180,90 @@ func (k Keeper) SignerSetTxs(c context.Context, req *types.SignerSetTxsRequest)
 	return &types.SignerSetTxsResponse{SignerSets: signers, Pagination: pageRes}, nil
 }
 
+func (k Keeper) SignerSetTxsByHeightRange(c context.Context, req *types.SignerSetTxsByHeightRangeRequest) (*types.SignerSetTxsByHeightRangeResponse, error) {
+	if req.EndHeight != 0 && req.StartHeight > req.EndHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "start height %d is after end height %d", req.StartHeight, req.EndHeight)
+	}
+
+	var signerSets []*types.ObservedSignerSetTx
+	pageRes, err := k.PaginateObservedSignerSetTxsByHeightRange(sdk.UnwrapSDKContext(c), req.Pagination, req.StartHeight, req.EndHeight, func(observed types.ObservedSignerSetTx) {
+		signerSets = append(signerSets, &observed)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SignerSetTxsByHeightRangeResponse{SignerSets: signerSets, Pagination: pageRes}, nil
+}
+
+func (k Keeper) BridgeConfig(c context.Context, req *types.BridgeConfigRequest) (*types.BridgeConfigResponse, error) {
+	return &types.BridgeConfigResponse{Config: ExportBridgeConfig(sdk.UnwrapSDKContext(c), k)}, nil
+}
+
+func (k Keeper) ReplayDiff(c context.Context, req *types.ReplayDiffRequest) (*types.ReplayDiffResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rebuilt, err := replay.Rebuild(ctx, k)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "rebuilding bridge state: %s", err)
+	}
+
+	return &types.ReplayDiffResponse{Discrepancies: replay.Diff(rebuilt, k.liveReplayState(ctx))}, nil
+}
+
+func (k Keeper) PendingERC20Deployments(c context.Context, req *types.PendingERC20DeploymentsRequest) (*types.PendingERC20DeploymentsResponse, error) {
+	var deployments []types.ERC20DeployedEvent
+	k.IteratePendingERC20Deployments(sdk.UnwrapSDKContext(c), func(event types.ERC20DeployedEvent) bool {
+		deployments = append(deployments, event)
+		return false
+	})
+
+	return &types.PendingERC20DeploymentsResponse{Deployments: deployments}, nil
+}
+
+func (k Keeper) TokenPauses(c context.Context, req *types.TokenPausesRequest) (*types.TokenPausesResponse, error) {
+	var pauses []types.TokenPause
+	k.IterateTokenPauses(sdk.UnwrapSDKContext(c), func(pause types.TokenPause) bool {
+		pauses = append(pauses, pause)
+		return false
+	})
+
+	return &types.TokenPausesResponse{Pauses: pauses}, nil
+}
+
+func (k Keeper) OrchestratorQueryIdentity(c context.Context, req *types.OrchestratorQueryIdentityRequest) (*types.OrchestratorQueryIdentityResponse, error) {
+	identity, found := k.GetOrchestratorQueryIdentity(sdk.UnwrapSDKContext(c), req.KeyHash)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no orchestrator query identity registered for key hash %X", req.KeyHash)
+	}
+
+	return &types.OrchestratorQueryIdentityResponse{Identity: identity}, nil
+}
+
+// liveReplayState reads the bridge state replay.Rebuild derives from the store
+func (k Keeper) liveReplayState(ctx sdk.Context) replay.State {
+	state := replay.NewState()
+	state.LastObservedEventNonce = k.GetLastObservedEventNonce(ctx)
+	state.LastObservedEthereumHeight = k.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight
+	state.LastObservedSignerSet = k.GetLastObservedSignerSetTx(ctx)
+
+	k.IterateBridgedSupplies(ctx, func(supply types.ERC20Token) bool {
+		state.BridgedSupplies[common.HexToAddress(supply.Contract)] = supply.Amount
+		return false
+	})
+
+	return state
+}
+
 func (k Keeper) BatchTxs(c context.Context, req *types.BatchTxsRequest) (*types.BatchTxsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	filter, err := k.batchTxsFilter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
 	var batches []*types.BatchTx
-	pageRes, err := k.PaginateOutgoingTxsByType(sdk.UnwrapSDKContext(c), req.Pagination, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) (hit bool) {
+	pageRes, err := k.PaginateOutgoingTxsByType(ctx, req.Pagination, types.BatchTxPrefixByte, filter, func(_ []byte, otx types.OutgoingTx) (hit bool) {
 		batch, ok := otx.(*types.BatchTx)
 		if !ok {
 			panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to batch tx for %s", otx))
@@ -135,9 +278,65 @@ func (k Keeper) BatchTxs(c context.Context, req *types.BatchTxsRequest) (*types.
 	return &types.BatchTxsResponse{Batches: batches, Pagination: pageRes}, nil
 }
 
+// batchTxsFilter builds the outgoing tx filter for the optional BatchTxs request filters
+func (k Keeper) batchTxsFilter(ctx sdk.Context, req *types.BatchTxsRequest) (func(types.OutgoingTx) bool, error) {
+	var tokenContract common.Address
+	if req.TokenContract != "" {
+		if !common.IsHexAddress(req.TokenContract) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+		}
+		tokenContract = common.HexToAddress(req.TokenContract)
+	}
+
+	minTotalFee := sdk.ZeroInt()
+	if req.MinTotalFee != "" {
+		var ok bool
+		if minTotalFee, ok = sdk.NewIntFromString(req.MinTotalFee); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid min total fee %s", req.MinTotalFee)
+		}
+	}
+
+	var signerSet *types.SignerSetTx
+	if req.SignatureStatus != types.BATCH_TX_SIGNATURE_STATUS_UNSPECIFIED {
+		if signerSet = k.getConfirmationSignerSet(ctx); signerSet == nil {
+			return nil, status.Errorf(codes.NotFound, "no signer set found")
+		}
+	}
+
+	return func(otx types.OutgoingTx) bool {
+		batch, ok := otx.(*types.BatchTx)
+		if !ok {
+			return false
+		}
+		if req.TokenContract != "" && common.HexToAddress(batch.TokenContract) != tokenContract {
+			return false
+		}
+		if req.TimeoutBeforeHeight != 0 && batch.Timeout >= req.TimeoutBeforeHeight {
+			return false
+		}
+		if minTotalFee.IsPositive() {
+			totalFee := sdk.ZeroInt()
+			for _, tx := range batch.Transactions {
+				totalFee = totalFee.Add(tx.Erc20Fee.Amount)
+			}
+			if totalFee.LT(minTotalFee) {
+				return false
+			}
+		}
+		if signerSet != nil {
+			progress := k.confirmationProgress(ctx, batch.GetStoreIndex(), signerSet)
+			signed := progress.SignedPower >= progress.ThresholdPower
+			if signed != (req.SignatureStatus == types.BATCH_TX_SIGNATURE_STATUS_SIGNED) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
 func (k Keeper) ContractCallTxs(c context.Context, req *types.ContractCallTxsRequest) (*types.ContractCallTxsResponse, error) {
 	var calls []*types.ContractCallTx
-	pageRes, err := k.PaginateOutgoingTxsByType(sdk.UnwrapSDKContext(c), req.Pagination, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) (hit bool) {
+	pageRes, err := k.PaginateOutgoingTxsByType(sdk.UnwrapSDKContext(c), req.Pagination, types.ContractCallTxPrefixByte, nil, func(_ []byte, otx types.OutgoingTx) (hit bool) {
 		call, ok := otx.(*types.ContractCallTx)
 		if !ok {
 			panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to contract call for %s", otx))
@@ -157,11 +356,12 @@ func (k Keeper) SignerSetTxConfirmations(c context.Context, req *types.SignerSet
 	key := types.MakeSignerSetTxKey(req.SignerSetNonce)
 
 	var out []*types.SignerSetTxConfirmation
-	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig []byte) bool {
+	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig types.EthereumSignature) bool {
 		out = append(out, &types.SignerSetTxConfirmation{
 			SignerSetNonce: req.SignerSetNonce,
 			EthereumSigner: k.GetValidatorEthereumAddress(ctx, val).Hex(),
-			Signature:      sig,
+			Signature:      sig.Signature,
+			Scheme:         sig.Scheme,
 		})
 		return false
 	})
@@ -174,35 +374,119 @@ func (k Keeper) BatchTxConfirmations(c context.Context, req *types.BatchTxConfir
 	key := types.MakeBatchTxKey(common.HexToAddress(req.TokenContract), req.BatchNonce)
 
 	var out []*types.BatchTxConfirmation
-	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig []byte) bool {
+	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig types.EthereumSignature) bool {
 		out = append(out, &types.BatchTxConfirmation{
 			TokenContract:  req.TokenContract,
 			BatchNonce:     req.BatchNonce,
 			EthereumSigner: k.GetValidatorEthereumAddress(ctx, val).Hex(),
-			Signature:      sig,
+			Signature:      sig.Signature,
+			Scheme:         sig.Scheme,
 		})
 		return false
 	})
 	return &types.BatchTxConfirmationsResponse{Signatures: out}, nil
 }
 
+func (k Keeper) BatchTxConfirmationProgress(c context.Context, req *types.BatchTxConfirmationProgressRequest) (*types.BatchTxConfirmationProgressResponse, error) {
+	if !common.IsHexAddress(req.TokenContract) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	key := types.MakeBatchTxKey(common.HexToAddress(req.TokenContract), req.BatchNonce)
+	if _, err := k.GetOutgoingTx(ctx, key); errors.Is(err, types.ErrOutgoingTxNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no batch tx found for %d %s", req.BatchNonce, req.TokenContract)
+	} else if err != nil {
+		return nil, err
+	}
+
+	signerSet := k.getConfirmationSignerSet(ctx)
+	if signerSet == nil {
+		return nil, status.Errorf(codes.NotFound, "no signer set found")
+	}
+
+	return k.confirmationProgress(ctx, key, signerSet), nil
+}
+
+// getConfirmationSignerSet returns the signer set that confirmations are
+// checked against: the bridge contract checks signatures against the last
+// signer set it has seen, which is the last observed one on this side
+func (k Keeper) getConfirmationSignerSet(ctx sdk.Context) *types.SignerSetTx {
+	signerSet := k.GetLastObservedSignerSetTx(ctx)
+	if signerSet == nil {
+		signerSet = k.GetLatestSignerSetTx(ctx)
+	}
+	return signerSet
+}
+
+// confirmationProgress reports which members of a signer set have signed an outgoing tx
+func (k Keeper) confirmationProgress(ctx sdk.Context, storeIndex []byte, signerSet *types.SignerSetTx) *types.BatchTxConfirmationProgressResponse {
+	signedBy := make(map[string]bool)
+	k.iterateEthereumSignatures(ctx, storeIndex, func(val sdk.ValAddress, _ types.EthereumSignature) bool {
+		signedBy[k.GetValidatorEthereumAddress(ctx, val).Hex()] = true
+		return false
+	})
+
+	res := &types.BatchTxConfirmationProgressResponse{SignerSetNonce: signerSet.Nonce}
+	for _, signer := range signerSet.Signers {
+		res.TotalPower += signer.Power
+		if signedBy[common.HexToAddress(signer.EthereumAddress).Hex()] {
+			res.SignedPower += signer.Power
+			res.Signed = append(res.Signed, signer)
+		} else {
+			res.Unsigned = append(res.Unsigned, signer)
+		}
+	}
+	// the bridge contract checks signatures against its own fixed threshold,
+	// which doesn't follow the event vote power threshold param
+	res.ThresholdPower = types.EventVoteRecordPowerThreshold(sdk.NewIntFromUint64(res.TotalPower), sdk.NewDecWithPrec(66, 2)).Uint64()
+
+	return res
+}
+
 func (k Keeper) ContractCallTxConfirmations(c context.Context, req *types.ContractCallTxConfirmationsRequest) (*types.ContractCallTxConfirmationsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	key := types.MakeContractCallTxKey(req.InvalidationScope, req.InvalidationNonce)
 
 	var out []*types.ContractCallTxConfirmation
-	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig []byte) bool {
+	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig types.EthereumSignature) bool {
 		out = append(out, &types.ContractCallTxConfirmation{
 			InvalidationScope: req.InvalidationScope,
 			InvalidationNonce: req.InvalidationNonce,
 			EthereumSigner:    k.GetValidatorEthereumAddress(ctx, val).Hex(),
-			Signature:         sig,
+			Signature:         sig.Signature,
+			Scheme:            sig.Scheme,
 		})
 		return false
 	})
 	return &types.ContractCallTxConfirmationsResponse{Signatures: out}, nil
 }
 
+func (k Keeper) SignerSetTxCalldata(c context.Context, req *types.SignerSetTxCalldataRequest) (*types.OutgoingTxCalldataResponse, error) {
+	return k.getOutgoingTxCalldata(sdk.UnwrapSDKContext(c), types.MakeSignerSetTxKey(req.SignerSetNonce))
+}
+
+func (k Keeper) BatchTxCalldata(c context.Context, req *types.BatchTxCalldataRequest) (*types.OutgoingTxCalldataResponse, error) {
+	if !common.IsHexAddress(req.TokenContract) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+	}
+	return k.getOutgoingTxCalldata(sdk.UnwrapSDKContext(c), types.MakeBatchTxKey(common.HexToAddress(req.TokenContract), req.BatchNonce))
+}
+
+func (k Keeper) ContractCallTxCalldata(c context.Context, req *types.ContractCallTxCalldataRequest) (*types.OutgoingTxCalldataResponse, error) {
+	return k.getOutgoingTxCalldata(sdk.UnwrapSDKContext(c), types.MakeContractCallTxKey(req.InvalidationScope, req.InvalidationNonce))
+}
+
+func (k Keeper) AgreedEthereumHeader(c context.Context, req *types.AgreedEthereumHeaderRequest) (*types.AgreedEthereumHeaderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.AgreedEthereumHeaderResponse{Header: k.GetAgreedEthereumHeader(ctx)}
+	k.IterateEthereumHeaderVotes(ctx, func(vote types.EthereumHeaderVote) bool {
+		res.Votes = append(res.Votes, vote)
+		return false
+	})
+	return res, nil
+}
+
 func (k Keeper) UnsignedSignerSetTxs(c context.Context, req *types.UnsignedSignerSetTxsRequest) (*types.UnsignedSignerSetTxsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	val, err := k.getSignerValidator(ctx, req.Address)
@@ -268,13 +552,19 @@ func (k Keeper) UnsignedContractCallTxs(c context.Context, req *types.UnsignedCo
 
 func (k Keeper) LastSubmittedEthereumEvent(c context.Context, req *types.LastSubmittedEthereumEventRequest) (*types.LastSubmittedEthereumEventResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	valAddr, err := k.getSignerValidator(ctx, req.Address)
+	valAddr, err := sdk.ValAddressFromBech32(req.Address)
 	if err != nil {
-		return nil, err
+		if valAddr, err = k.getSignerValidator(ctx, req.Address); err != nil {
+			return nil, err
+		}
 	}
 
 	res := &types.LastSubmittedEthereumEventResponse{
-		EventNonce: k.getLastEventNonceByValidator(ctx, valAddr),
+		EventNonce:                 k.getLastEventNonceByValidator(ctx, valAddr),
+		EthereumHeightVote:         k.GetEthereumHeightVote(ctx, valAddr),
+		LastEventVoteHeight:        k.GetLastEventVoteHeightByValidator(ctx, valAddr),
+		LastObservedEventNonce:     k.GetLastObservedEventNonce(ctx),
+		LastObservedEthereumHeight: k.GetLastObservedEthereumBlockHeight(ctx),
 	}
 	return res, nil
 }
@@ -289,15 +579,113 @@ func (k Keeper) BatchTxFees(c context.Context, req *types.BatchTxFeesRequest) (*
 	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
 		btx, _ := otx.(*types.BatchTx)
 		for _, tx := range btx.Transactions {
-			_, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(tx.Erc20Fee.Contract))
-			res.Fees = append(res.Fees, sdk.NewCoin(denom, tx.Erc20Fee.Amount))
+			contract := common.HexToAddress(tx.Erc20Fee.Contract)
+			_, denom := k.ERC20ToDenomLookup(ctx, contract)
+			res.Fees = append(res.Fees, sdk.NewCoin(denom, k.ERC20ToCosmosAmount(ctx, contract, tx.Erc20Fee.Amount)))
 		}
+		res.Fees = append(res.Fees, btx.BridgeFees...)
 		return false
 	})
 
 	return res, nil
 }
 
+func (k Keeper) BatchFees(c context.Context, req *types.BatchFeesRequest) (*types.BatchFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	maxElements := int(req.MaxElements)
+	if maxElements == 0 {
+		maxElements = int(params.BatchMaxElement)
+	}
+
+	fees := make(map[common.Address]*types.TokenBatchFees)
+	oldest := make(map[common.Address]uint64)
+	k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		contract := common.HexToAddress(ste.Erc20Token.Contract)
+		tokenFees, ok := fees[contract]
+		if !ok {
+			tokenFees = &types.TokenBatchFees{
+				TokenContract: contract.Hex(),
+				TotalFee:      sdk.ZeroInt(),
+				TopFee:        sdk.ZeroInt(),
+			}
+			fees[contract] = tokenFees
+		}
+		tokenFees.TotalFee = tokenFees.TotalFee.Add(ste.Erc20Fee.Amount)
+		tokenFees.TxCount++
+		// txs created before their height was recorded have no known age
+		if height, ok := oldest[contract]; ste.Height != 0 && (!ok || ste.Height < height) {
+			oldest[contract] = ste.Height
+		}
+		return false
+	})
+
+	contracts := make([]common.Address, 0, len(fees))
+	for contract := range fees {
+		contracts = append(contracts, contract)
+	}
+	sort.Slice(contracts, func(i, j int) bool {
+		return bytes.Compare(contracts[i].Bytes(), contracts[j].Bytes()) < 0
+	})
+
+	page, pageRes, err := paginateTokenContracts(contracts, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res := &types.BatchFeesResponse{Pagination: pageRes}
+	for _, contract := range page {
+		tokenFees := fees[contract]
+		tokenFees.TopFee = k.batchFeesByTokenType(ctx, params, contract, maxElements)
+		if height, ok := oldest[contract]; ok {
+			tokenFees.OldestTxAge = uint64(ctx.BlockHeight()) - height
+		}
+		res.Tokens = append(res.Tokens, *tokenFees)
+	}
+
+	return res, nil
+}
+
+// paginateTokenContracts returns the page of the sorted token contracts
+// selected by a page request, by key or by offset as the store pagination
+// does. The key of a page is the address of its first token contract.
+func paginateTokenContracts(contracts []common.Address, pageReq *query.PageRequest) ([]common.Address, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, nil, errors.New("invalid request, either offset or key is expected, got both")
+	}
+
+	start := len(contracts)
+	if len(pageReq.Key) != 0 {
+		start = sort.Search(len(contracts), func(i int) bool {
+			return bytes.Compare(contracts[i].Bytes(), pageReq.Key) >= 0
+		})
+	} else if pageReq.Offset < uint64(len(contracts)) {
+		start = int(pageReq.Offset)
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	end := len(contracts)
+	if limit < uint64(end-start) {
+		end = start + int(limit)
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < len(contracts) {
+		pageRes.NextKey = contracts[end].Bytes()
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = uint64(len(contracts))
+	}
+	return contracts[start:end], pageRes, nil
+}
+
 func (k Keeper) ERC20ToDenom(c context.Context, req *types.ERC20ToDenomRequest) (*types.ERC20ToDenomResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	cosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(req.Erc20))
@@ -308,6 +696,22 @@ func (k Keeper) ERC20ToDenom(c context.Context, req *types.ERC20ToDenomRequest)
 	return res, nil
 }
 
+func (k Keeper) ERC20Conversion(c context.Context, req *types.ERC20ConversionRequest) (*types.ERC20ConversionResponse, error) {
+	if !common.IsHexAddress(req.Erc20) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid erc20 address %s", req.Erc20)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	tokenContract := common.HexToAddress(req.Erc20)
+	conversion := k.GetERC20Conversion(ctx, tokenContract)
+	_, denom := k.ERC20ToDenomLookup(ctx, tokenContract)
+	return &types.ERC20ConversionResponse{
+		Conversion: conversion,
+		Denom:      denom,
+		Factor:     conversion.Factor(),
+	}, nil
+}
+
 func (k Keeper) DenomToERC20Params(c context.Context, req *types.DenomToERC20ParamsRequest) (*types.DenomToERC20ParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	if existingERC20, exists := k.getCosmosOriginatedERC20(ctx, req.Denom); exists {
@@ -480,3 +884,423 @@ func (k Keeper) LastObservedEthereumHeight(c context.Context, req *types.LastObs
 
 	return res, nil
 }
+
+func (k Keeper) ProjectedEthereumHeight(c context.Context, req *types.ProjectedEthereumHeightRequest) (*types.ProjectedEthereumHeightResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	res := &types.ProjectedEthereumHeightResponse{
+		LastObservedEthereumHeight: k.GetLastObservedEthereumBlockHeight(ctx),
+		ProjectedEthereumHeight:    k.projectEthereumHeight(ctx, params),
+		TimeoutHeight:              k.getTimeoutHeight(ctx, params),
+	}
+	if medianHeight, ok := k.medianEthereumHeightVote(ctx, params.TimeoutHeightVoteWindow); ok {
+		res.MedianEthereumHeightVote = medianHeight
+	}
+
+	return res, nil
+}
+
+func (k Keeper) QueuedSendToCosmosEvents(c context.Context, req *types.QueuedSendToCosmosEventsRequest) (*types.QueuedSendToCosmosEventsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueuedSendToCosmosEventsResponse{}
+
+	prefixKey := []byte{types.QueuedSendToCosmosKey}
+	if req.TokenContract != "" {
+		if !common.IsHexAddress(req.TokenContract) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+		}
+		prefixKey = append(prefixKey, common.HexToAddress(req.TokenContract).Bytes()...)
+	}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key []byte, value []byte) error {
+		var event types.SendToCosmosEvent
+		k.cdc.MustUnmarshal(value, &event)
+		res.Events = append(res.Events, &event)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) HeldSendToCosmosEvents(c context.Context, req *types.HeldSendToCosmosEventsRequest) (*types.HeldSendToCosmosEventsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.HeldSendToCosmosEventsResponse{}
+
+	prefixKey := []byte{types.HeldSendToCosmosKey}
+	if req.TokenContract != "" {
+		if !common.IsHexAddress(req.TokenContract) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+		}
+		prefixKey = append(prefixKey, common.HexToAddress(req.TokenContract).Bytes()...)
+	}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key []byte, value []byte) error {
+		var event types.SendToCosmosEvent
+		k.cdc.MustUnmarshal(value, &event)
+		res.Events = append(res.Events, &event)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) ExecutedBatchTxs(c context.Context, req *types.ExecutedBatchTxsRequest) (*types.ExecutedBatchTxsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.ExecutedBatchTxsResponse{}
+
+	var tokenContract string
+	if req.TokenContract != "" {
+		if !common.IsHexAddress(req.TokenContract) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+		}
+		tokenContract = common.HexToAddress(req.TokenContract).Hex()
+	}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ExecutedBatchTxKey})
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var record types.BatchTxExecutionRecord
+		k.cdc.MustUnmarshal(value, &record)
+		if tokenContract != "" && common.HexToAddress(record.TokenContract).Hex() != tokenContract {
+			return false, nil
+		}
+		if accumulate {
+			res.Records = append(res.Records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) ExecutedContractCallTxs(c context.Context, req *types.ExecutedContractCallTxsRequest) (*types.ExecutedContractCallTxsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.ExecutedContractCallTxsResponse{}
+
+	var address string
+	if req.Address != "" {
+		if !common.IsHexAddress(req.Address) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.Address)
+		}
+		address = common.HexToAddress(req.Address).Hex()
+	}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ExecutedContractCallTxKey})
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var record types.ContractCallTxExecutionRecord
+		k.cdc.MustUnmarshal(value, &record)
+		if address != "" && common.HexToAddress(record.Address).Hex() != address {
+			return false, nil
+		}
+		if accumulate {
+			res.Records = append(res.Records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) RefundedContractCallTxs(c context.Context, req *types.RefundedContractCallTxsRequest) (*types.RefundedContractCallTxsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.RefundedContractCallTxsResponse{}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.RefundedContractCallTxKey})
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var record types.ContractCallTxRefundRecord
+		k.cdc.MustUnmarshal(value, &record)
+		if req.Refundee != "" && record.Refundee != req.Refundee {
+			return false, nil
+		}
+		if accumulate {
+			res.Records = append(res.Records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) BridgeFeeAllowances(c context.Context, req *types.BridgeFeeAllowancesRequest) (*types.BridgeFeeAllowancesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.BridgeFeeAllowancesResponse{}
+
+	for _, addr := range []string{req.Granter, req.Grantee} {
+		if addr == "" {
+			continue
+		}
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address %s", addr)
+		}
+	}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.BridgeFeeAllowanceKey})
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var allowance types.BridgeFeeAllowance
+		k.cdc.MustUnmarshal(value, &allowance)
+		if (req.Granter != "" && allowance.Granter != req.Granter) || (req.Grantee != "" && allowance.Grantee != req.Grantee) {
+			return false, nil
+		}
+		if accumulate {
+			res.Allowances = append(res.Allowances, allowance)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) EthereumBlocklist(c context.Context, req *types.EthereumBlocklistRequest) (*types.EthereumBlocklistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.EthereumBlocklistResponse{}
+
+	k.IterateEthereumBlocklist(ctx, func(addr common.Address) bool {
+		res.Addresses = append(res.Addresses, addr.Hex())
+		return false
+	})
+
+	return res, nil
+}
+
+func (k Keeper) ModuleAccounts(c context.Context, req *types.ModuleAccountsRequest) (*types.ModuleAccountsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.ModuleAccountsResponse{}
+
+	account := func(name, purpose string) *types.BridgeModuleAccount {
+		addr := authtypes.NewModuleAddress(name)
+		return &types.BridgeModuleAccount{
+			Name:     name,
+			Address:  addr.String(),
+			Purpose:  purpose,
+			Balances: k.bankKeeper.GetAllBalances(ctx, addr),
+		}
+	}
+
+	res.Accounts = append(res.Accounts, account(
+		types.ModuleName,
+		"bridge escrow: locks cosmos originated tokens sent to ethereum and mints and burns gravity vouchers",
+	))
+	res.Accounts = append(res.Accounts, account(
+		types.RelayerRewardPoolName,
+		"relayer rewards: holds the share of batch fees paid to relayers",
+	))
+	for _, name := range sortedModuleAccountNames(k.SenderModuleAccounts) {
+		res.Accounts = append(res.Accounts, account(name, "module account allowed to send to ethereum"))
+	}
+	for _, name := range sortedModuleAccountNames(k.ReceiverModuleAccounts) {
+		res.Accounts = append(res.Accounts, account(name, "module account allowed to receive deposits from ethereum"))
+	}
+
+	return res, nil
+}
+
+// EndBlockerActions returns the most recent decisions taken by the module
+// while processing blocks, newest first
+func (k Keeper) EndBlockerActions(c context.Context, req *types.EndBlockerActionsRequest) (*types.EndBlockerActionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.EndBlockerActionsResponse{Actions: k.GetEndBlockerActions(ctx, req.Limit)}, nil
+}
+
+// sortedModuleAccountNames returns the names of an address to name module account mapping in order
+func sortedModuleAccountNames(accounts map[string]string) []string {
+	names := make([]string, 0, len(accounts))
+	for _, name := range accounts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (k Keeper) DelayedSendToEthereums(c context.Context, req *types.DelayedSendToEthereumsRequest) (*types.DelayedSendToEthereumsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.DelayedSendToEthereumsResponse{}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.DelayedSendToEthereumKey})
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var delayed types.DelayedSendToEthereum
+		k.cdc.MustUnmarshal(value, &delayed)
+		if req.SenderAddress != "" && delayed.SendToEthereum.Sender != req.SenderAddress {
+			return false, nil
+		}
+		if accumulate {
+			res.DelayedSendToEthereums = append(res.DelayedSendToEthereums, delayed)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) EventByEthereumTxHash(c context.Context, req *types.EventByEthereumTxHashRequest) (*types.EventByEthereumTxHashResponse, error) {
+	txHash := common.FromHex(req.EthereumTxHash)
+	if len(txHash) != common.HashLength {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ethereum tx hash %s", req.EthereumTxHash)
+	}
+
+	return &types.EventByEthereumTxHashResponse{
+		EventVoteRecords: k.GetEthereumEventVoteRecordsByTxHash(sdk.UnwrapSDKContext(c), txHash),
+	}, nil
+}
+
+func (k Keeper) ConflictingEthereumEvents(c context.Context, req *types.ConflictingEthereumEventsRequest) (*types.ConflictingEthereumEventsResponse, error) {
+	return &types.ConflictingEthereumEventsResponse{
+		Conflicts: k.GetConflictingEthereumEvents(sdk.UnwrapSDKContext(c)),
+	}, nil
+}
+
+func (k Keeper) BridgeStats(c context.Context, req *types.BridgeStatsRequest) (*types.BridgeStatsResponse, error) {
+	var tokenContract *common.Address
+	if req.TokenContract != "" {
+		if !common.IsHexAddress(req.TokenContract) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+		}
+		contract := common.HexToAddress(req.TokenContract)
+		tokenContract = &contract
+	}
+
+	return &types.BridgeStatsResponse{
+		Stats: k.GetBridgeStats(sdk.UnwrapSDKContext(c), tokenContract),
+	}, nil
+}
+
+func (k Keeper) PendingEventVoteRecords(c context.Context, req *types.PendingEventVoteRecordsRequest) (*types.PendingEventVoteRecordsResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address %s", req.ValidatorAddress)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.PendingEventVoteRecordsResponse{
+		LastEventNonce:         k.getLastEventNonceByValidator(ctx, valAddr),
+		LastObservedEventNonce: k.GetLastObservedEventNonce(ctx),
+	}
+
+	// vote records are keyed by event nonce, so the iteration can start above the validator's last vote
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventVoteRecordKey})
+	iter := store.Iterator(sdk.Uint64ToBigEndian(res.LastEventNonce+1), nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		record := &types.EthereumEventVoteRecord{}
+		k.cdc.MustUnmarshal(iter.Value(), record)
+		voted := false
+		for _, vote := range record.Votes {
+			if vote == valAddr.String() {
+				voted = true
+				break
+			}
+		}
+		if !voted {
+			res.EventVoteRecords = append(res.EventVoteRecords, record)
+		}
+	}
+
+	return res, nil
+}
+
+func (k Keeper) BridgeValidatorLiveness(c context.Context, req *types.BridgeValidatorLivenessRequest) (*types.BridgeValidatorLivenessResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.BridgeValidatorLivenessResponse{}
+	for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		res.Validators = append(res.Validators, k.getBridgeValidatorLiveness(ctx, validator.GetOperator()))
+	}
+	return res, nil
+}
+
+func (k Keeper) BatchTxInclusionProof(c context.Context, req *types.BatchTxInclusionProofRequest) (*types.BatchTxInclusionProofResponse, error) {
+	if !common.IsHexAddress(req.TokenContract) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	storeIndex := types.MakeBatchTxKey(common.HexToAddress(req.TokenContract), req.BatchNonce)
+	otx, err := k.GetOutgoingTx(ctx, storeIndex)
+	if err != nil && !errors.Is(err, types.ErrOutgoingTxNotFound) {
+		return nil, err
+	}
+	batch, ok := otx.(*types.BatchTx)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no batch tx found for %d %s", req.BatchNonce, req.TokenContract)
+	}
+
+	index := -1
+	leaves := make([][]byte, len(batch.Transactions))
+	for i, tx := range batch.Transactions {
+		leaves[i] = k.cdc.MustMarshal(tx)
+		if tx.Id == req.SendToEthereumId {
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "send to ethereum %d not in batch tx %d %s", req.SendToEthereumId, req.BatchNonce, req.TokenContract)
+	}
+
+	root, proofs := merkle.ProofsFromByteSlices(leaves)
+	return &types.BatchTxInclusionProofResponse{
+		SendToEthereum: batch.Transactions[index],
+		Root:           root,
+		Proof:          proofs[index].ToProto(),
+		Checkpoint:     batch.GetCheckpoint([]byte(k.getGravityID(ctx))),
+		StoreKey:       types.MakeOutgoingTxKey(storeIndex),
+	}, nil
+}
+
+func (k Keeper) BridgeValidatorInfo(c context.Context, req *types.BridgeValidatorInfoRequest) (*types.BridgeValidatorInfoResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address %s", req.ValidatorAddress)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator := k.StakingKeeper.Validator(ctx, valAddr)
+	if validator == nil {
+		return nil, status.Errorf(codes.NotFound, "no validator found for %s", valAddr)
+	}
+
+	info := types.BridgeValidatorInfo{
+		ValidatorAddress: valAddr.String(),
+		SignatureScheme:  k.GetValidatorSignatureScheme(ctx, valAddr),
+		Bonded:           validator.IsBonded(),
+		Liveness:         k.getBridgeValidatorLiveness(ctx, valAddr),
+		OptedOut:         k.IsValidatorOptedOut(ctx, valAddr),
+	}
+	if ethAddr := k.GetValidatorEthereumAddress(ctx, valAddr); ethAddr != (common.Address{}) {
+		info.EthereumAddress = ethAddr.Hex()
+		info.OrchestratorAddress = k.GetEthereumOrchestratorAddress(ctx, ethAddr).String()
+	}
+	if freeze, ok := k.GetOrchestratorFreeze(ctx, valAddr); ok {
+		info.Freeze = &freeze
+	}
+
+	return &types.BridgeValidatorInfoResponse{Validator: info}, nil
+}
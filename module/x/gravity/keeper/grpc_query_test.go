@@ -4,9 +4,16 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/libs/bytes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestKeeper_Params(t *testing.T) {
@@ -104,6 +111,92 @@ func TestKeeper_BatchTx(t *testing.T) {
 	})
 }
 
+func TestKeeper_BatchTxCheckpoint(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+
+	const tokenContract = "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4"
+
+	batch := &types.BatchTx{
+		BatchNonce:    55,
+		Timeout:       1000,
+		TokenContract: tokenContract,
+		Height:        100,
+	}
+	gk.SetOutgoingTx(ctx, batch)
+
+	res, err := gk.BatchTxCheckpoint(sdk.WrapSDKContext(ctx), &types.BatchTxRequest{
+		BatchNonce:    batch.BatchNonce,
+		TokenContract: tokenContract,
+	})
+	require.NoError(t, err)
+	require.Equal(t, gk.getGravityID(ctx), res.GravityId)
+	require.Equal(t, batch.GetCheckpoint([]byte(res.GravityId)), res.Checkpoint)
+
+	// a validator signature over the checkpoint recovers from the signed hash
+	privKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	signature, err := types.NewEthereumSignature(res.Checkpoint, privKey)
+	require.NoError(t, err)
+	pubKey, err := ethCrypto.SigToPub(res.SignedHash, signature)
+	require.NoError(t, err)
+	require.Equal(t, ethCrypto.PubkeyToAddress(privKey.PublicKey), ethCrypto.PubkeyToAddress(*pubKey))
+
+	_, err = gk.SignerSetTxCheckpoint(sdk.WrapSDKContext(ctx), &types.SignerSetTxRequest{SignerSetNonce: 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestKeeper_BatchTxConfirmationProgress(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	const (
+		batchNonce    = 55
+		tokenContract = "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4"
+	)
+
+	{ // setup
+		gk.CreateSignerSetTx(ctx)
+		gk.SetOutgoingTx(ctx, &types.BatchTx{
+			BatchNonce:    batchNonce,
+			Timeout:       1000,
+			TokenContract: tokenContract,
+			Height:        100,
+		})
+		for _, val := range ValAddrs[:3] {
+			gk.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+				TokenContract: tokenContract,
+				BatchNonce:    batchNonce,
+				Signature:     []byte("signature"),
+			}, val)
+		}
+	}
+	{ // validate
+		req := &types.BatchTxConfirmationProgressRequest{
+			BatchNonce:    batchNonce,
+			TokenContract: tokenContract,
+		}
+
+		res, err := gk.BatchTxConfirmationProgress(sdk.WrapSDKContext(ctx), req)
+		require.NoError(t, err)
+		require.Len(t, res.Signed, 3)
+		require.Len(t, res.Unsigned, 2)
+		require.Less(t, res.SignedPower, res.ThresholdPower)
+
+		gk.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+			TokenContract: tokenContract,
+			BatchNonce:    batchNonce,
+			Signature:     []byte("signature"),
+		}, ValAddrs[3])
+
+		res, err = gk.BatchTxConfirmationProgress(sdk.WrapSDKContext(ctx), req)
+		require.NoError(t, err)
+		require.Len(t, res.Signed, 4)
+		require.Greater(t, res.SignedPower, res.ThresholdPower)
+	}
+}
+
 func TestKeeper_ContractCallTx(t *testing.T) {
 	t.Run("read after there's something in state", func(t *testing.T) {
 		env := CreateTestEnv(t)
@@ -155,6 +248,67 @@ func TestKeeper_SignerSetTxs(t *testing.T) {
 	})
 }
 
+func TestKeeper_SignerSetTxsByHeightRange(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+
+	{ // setup
+		for nonce, height := range []uint64{100, 200, 300} {
+			require.NoError(t, gk.Handle(ctx, &types.SignerSetTxExecutedEvent{
+				EventNonce:       uint64(nonce + 1),
+				SignerSetTxNonce: uint64(nonce + 1),
+				EthereumHeight:   height,
+			}))
+		}
+	}
+	{ // validate
+		// the signer set active at the start height is included
+		res, err := gk.SignerSetTxsByHeightRange(sdk.WrapSDKContext(ctx), &types.SignerSetTxsByHeightRangeRequest{StartHeight: 150, EndHeight: 250})
+		require.NoError(t, err)
+		require.Len(t, res.SignerSets, 2)
+		require.Equal(t, uint64(1), res.SignerSets[0].SignerSet.Nonce)
+		require.Equal(t, uint64(100), res.SignerSets[0].EthereumHeight)
+		require.Equal(t, uint64(2), res.SignerSets[1].SignerSet.Nonce)
+
+		res, err = gk.SignerSetTxsByHeightRange(sdk.WrapSDKContext(ctx), &types.SignerSetTxsByHeightRangeRequest{StartHeight: 200, EndHeight: 300})
+		require.NoError(t, err)
+		require.Len(t, res.SignerSets, 2)
+		require.Equal(t, uint64(2), res.SignerSets[0].SignerSet.Nonce)
+		require.Equal(t, uint64(3), res.SignerSets[1].SignerSet.Nonce)
+
+		res, err = gk.SignerSetTxsByHeightRange(sdk.WrapSDKContext(ctx), &types.SignerSetTxsByHeightRangeRequest{StartHeight: 50})
+		require.NoError(t, err)
+		require.Len(t, res.SignerSets, 3)
+
+		res, err = gk.SignerSetTxsByHeightRange(sdk.WrapSDKContext(ctx), &types.SignerSetTxsByHeightRangeRequest{StartHeight: 1000})
+		require.NoError(t, err)
+		require.Len(t, res.SignerSets, 1)
+		require.Equal(t, uint64(3), res.SignerSets[0].SignerSet.Nonce)
+
+		// pages by key
+		var nonces []uint64
+		pageReq := &query.PageRequest{Limit: 1, CountTotal: true}
+		for {
+			res, err = gk.SignerSetTxsByHeightRange(sdk.WrapSDKContext(ctx), &types.SignerSetTxsByHeightRangeRequest{StartHeight: 150, Pagination: pageReq})
+			require.NoError(t, err)
+			require.Len(t, res.SignerSets, 1)
+			nonces = append(nonces, res.SignerSets[0].SignerSet.Nonce)
+			if pageReq.Key == nil {
+				require.Equal(t, uint64(3), res.Pagination.Total)
+			}
+			if res.Pagination.NextKey == nil {
+				break
+			}
+			pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+		}
+		require.Equal(t, []uint64{1, 2, 3}, nonces)
+
+		_, err = gk.SignerSetTxsByHeightRange(sdk.WrapSDKContext(ctx), &types.SignerSetTxsByHeightRangeRequest{StartHeight: 300, EndHeight: 100})
+		require.Error(t, err)
+	}
+}
+
 func TestKeeper_BatchTxs(t *testing.T) {
 	t.Run("read after there's something in state", func(t *testing.T) {
 		env := CreateTestEnv(t)
@@ -185,6 +339,49 @@ func TestKeeper_BatchTxs(t *testing.T) {
 			require.Len(t, got.Batches, 2)
 		}
 	})
+
+	t.Run("filtered", func(t *testing.T) {
+		env := CreateTestEnv(t)
+		ctx := env.Context
+		gk := env.GravityKeeper
+
+		var (
+			tokenA = "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4"
+			tokenB = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		)
+		withFee := func(token string, fee int64) []*types.SendToEthereum {
+			return []*types.SendToEthereum{{
+				Id:                1,
+				Sender:            AccAddrs[0].String(),
+				EthereumRecipient: EthAddrs[0].Hex(),
+				Erc20Token:        types.NewERC20Token(100, common.HexToAddress(token)),
+				Erc20Fee:          types.NewERC20Token(uint64(fee), common.HexToAddress(token)),
+			}}
+		}
+
+		{ // setup
+			gk.SetOutgoingTx(ctx, &types.BatchTx{BatchNonce: 1, Timeout: 100, Transactions: withFee(tokenA, 5), TokenContract: tokenA, Height: 1})
+			gk.SetOutgoingTx(ctx, &types.BatchTx{BatchNonce: 2, Timeout: 200, Transactions: withFee(tokenA, 50), TokenContract: tokenA, Height: 2})
+			gk.SetOutgoingTx(ctx, &types.BatchTx{BatchNonce: 3, Timeout: 300, Transactions: withFee(tokenB, 500), TokenContract: tokenB, Height: 3})
+		}
+		{ // validate
+			got, err := gk.BatchTxs(sdk.WrapSDKContext(ctx), &types.BatchTxsRequest{TokenContract: tokenA})
+			require.NoError(t, err)
+			require.Len(t, got.Batches, 2)
+
+			got, err = gk.BatchTxs(sdk.WrapSDKContext(ctx), &types.BatchTxsRequest{MinTotalFee: "50"})
+			require.NoError(t, err)
+			require.Len(t, got.Batches, 2)
+
+			got, err = gk.BatchTxs(sdk.WrapSDKContext(ctx), &types.BatchTxsRequest{TimeoutBeforeHeight: 250, MinTotalFee: "10"})
+			require.NoError(t, err)
+			require.Len(t, got.Batches, 1)
+			require.Equal(t, uint64(2), got.Batches[0].BatchNonce)
+
+			_, err = gk.BatchTxs(sdk.WrapSDKContext(ctx), &types.BatchTxsRequest{MinTotalFee: "lots"})
+			require.Error(t, err)
+		}
+	})
 }
 
 func TestKeeper_ContractCallTxs(t *testing.T) {
@@ -234,3 +431,350 @@ func TestKeeper_ContractCallTxs(t *testing.T) {
 // DelegateKeysByValidator(context.Context, *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error)
 // DelegateKeysByEthereumSigner(context.Context, *DelegateKeysByEthereumSignerRequest) (*DelegateKeysByEthereumSignerResponse, error)
 // DelegateKeysByOrchestrator(context.Context, *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error)
+
+func TestKeeper_ModuleAccounts(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := sdk.WrapSDKContext(env.Context)
+	gk := env.GravityKeeper
+
+	res, err := gk.ModuleAccounts(ctx, &types.ModuleAccountsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 2+len(gk.SenderModuleAccounts)+len(gk.ReceiverModuleAccounts))
+	require.Equal(t, types.ModuleName, res.Accounts[0].Name)
+	require.Equal(t, authtypes.NewModuleAddress(types.ModuleName).String(), res.Accounts[0].Address)
+	require.Equal(t, types.RelayerRewardPoolName, res.Accounts[1].Name)
+}
+
+func TestKeeper_ReplayDiff(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+
+	replayDiff := func() []types.ReplayDiscrepancy {
+		res, err := gk.ReplayDiff(sdk.WrapSDKContext(ctx), &types.ReplayDiffRequest{})
+		require.NoError(t, err)
+		return res.Discrepancies
+	}
+	require.Empty(t, replayDiff())
+
+	{ // a logged event ahead of the live nonce
+		gk.logObservedEthereumEvent(ctx, &types.SendToCosmosEvent{
+			EventNonce:     1,
+			TokenContract:  tokenContract.Hex(),
+			Amount:         sdk.NewInt(100),
+			EthereumSender: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+			CosmosReceiver: "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
+			EthereumHeight: 42,
+		})
+		require.Equal(t, sdk.NewInt(100), gk.GetBridgedSupply(ctx, tokenContract))
+
+		discrepancies := replayDiff()
+		require.Len(t, discrepancies, 2)
+		require.Equal(t, types.ReplayDiscrepancy{Field: "last_observed_event_nonce", Rebuilt: "1", Live: "0"}, discrepancies[0])
+		require.Equal(t, "last_observed_ethereum_height", discrepancies[1].Field)
+
+		gk.setLastObservedEventNonce(ctx, 1)
+		gk.SetLastObservedEthereumBlockHeight(ctx, 42)
+		require.Empty(t, replayDiff())
+	}
+
+	{ // a corrupted bridged supply
+		gk.setBridgedSupply(ctx, tokenContract, sdk.NewInt(90))
+
+		require.Equal(t, []types.ReplayDiscrepancy{{
+			Field:   "bridged_supplies." + tokenContract.Hex(),
+			Rebuilt: "100",
+			Live:    "90",
+		}}, replayDiff())
+	}
+}
+
+func TestKeeper_PendingEventVoteRecords(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+
+	newEvent := func(nonce uint64) types.EthereumEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+			Amount:         sdk.NewInt(100),
+			EthereumSender: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+			CosmosReceiver: "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
+			EthereumHeight: 42,
+		}
+	}
+
+	for _, nonce := range []uint64{1, 2} {
+		_, err := gk.recordEventVote(ctx, newEvent(nonce), ValAddrs[1])
+		require.NoError(t, err)
+	}
+	_, err := gk.recordEventVote(ctx, newEvent(1), ValAddrs[0])
+	require.NoError(t, err)
+
+	res, err := gk.PendingEventVoteRecords(sdk.WrapSDKContext(ctx), &types.PendingEventVoteRecordsRequest{ValidatorAddress: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.LastEventNonce)
+	require.Len(t, res.EventVoteRecords, 1)
+	event, err := types.UnpackEvent(res.EventVoteRecords[0].Event)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), event.GetEventNonce())
+
+	res, err = gk.PendingEventVoteRecords(sdk.WrapSDKContext(ctx), &types.PendingEventVoteRecordsRequest{ValidatorAddress: ValAddrs[1].String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.LastEventNonce)
+	require.Empty(t, res.EventVoteRecords)
+
+	_, err = gk.PendingEventVoteRecords(sdk.WrapSDKContext(ctx), &types.PendingEventVoteRecordsRequest{ValidatorAddress: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestKeeper_EventByEthereumTxHash(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+	gk.SetOracle(oracleFunc(func(_ sdk.Context, event types.EthereumEvent, _ *types.EthereumEventVoteRecord) bool {
+		return event.GetEventNonce() == 1
+	}))
+
+	depositTx := common.HexToHash("0x5e0a4d8ba2f9bde0b3a7d1c1e2c3b8a4f0e1d2c3b4a5968778695a4b3c2d1e0f")
+	otherTx := common.HexToHash("0x0f1e2d3c4b5a6978695a4b3c2d1e0f4a8b3c2e1c1d7a3b0edbf9a2b8d4a0e5")
+	newEvent := func(nonce uint64, txHash common.Hash) types.EthereumEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  EthAddrs[0].Hex(),
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[1].Hex(),
+			CosmosReceiver: AccAddrs[0].String(),
+			EthereumHeight: 42,
+			EthereumTxHash: txHash.Bytes(),
+		}
+	}
+
+	var records []*types.EthereumEventVoteRecord
+	for nonce, txHash := range []common.Hash{depositTx, depositTx, otherTx} {
+		record, err := gk.recordEventVote(ctx, newEvent(uint64(nonce+1), txHash), ValAddrs[0])
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+
+	// the first event is observed then its vote record pruned, the index keeps it
+	gk.TryEventVoteRecord(ctx, gk.GetParams(ctx), records[0])
+	require.True(t, records[0].Accepted)
+	gk.DeleteEthereumEventVoteRecord(ctx, records[0])
+
+	res, err := gk.EventByEthereumTxHash(sdk.WrapSDKContext(ctx), &types.EventByEthereumTxHashRequest{EthereumTxHash: depositTx.Hex()})
+	require.NoError(t, err)
+	require.Len(t, res.EventVoteRecords, 2)
+	for i, record := range res.EventVoteRecords {
+		event, err := types.UnpackEvent(record.Event)
+		require.NoError(t, err)
+		require.Equal(t, uint64(i+1), event.GetEventNonce())
+		require.Equal(t, i == 0, record.Accepted)
+	}
+
+	res, err = gk.EventByEthereumTxHash(sdk.WrapSDKContext(ctx), &types.EventByEthereumTxHashRequest{EthereumTxHash: common.Hash{}.Hex()})
+	require.NoError(t, err)
+	require.Empty(t, res.EventVoteRecords)
+
+	_, err = gk.EventByEthereumTxHash(sdk.WrapSDKContext(ctx), &types.EventByEthereumTxHashRequest{EthereumTxHash: "0x1234"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestKeeper_ConflictingEthereumEvents(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+	gk.SetOracle(oracleFunc(func(_ sdk.Context, event types.EthereumEvent, _ *types.EthereumEventVoteRecord) bool {
+		return event.GetEventNonce() == 1
+	}))
+
+	depositTx := common.HexToHash("0x5e0a4d8ba2f9bde0b3a7d1c1e2c3b8a4f0e1d2c3b4a5968778695a4b3c2d1e0f")
+	forkedTx := common.HexToHash("0x0f1e2d3c4b5a6978695a4b3c2d1e0f4a8b3c2e1c1d7a3b0edbf9a2b8d4a0e5")
+	newEvent := func(nonce uint64, txHash common.Hash, logIndex uint64) types.EthereumEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:          nonce,
+			TokenContract:       EthAddrs[0].Hex(),
+			Amount:              sdk.NewInt(100),
+			EthereumSender:      EthAddrs[1].Hex(),
+			CosmosReceiver:      AccAddrs[0].String(),
+			EthereumHeight:      42,
+			EthereumTxHash:      txHash.Bytes(),
+			EthereumLogIndex:    logIndex,
+			HasEthereumLogIndex: true,
+		}
+	}
+
+	// the validators disagree on the event at nonce 1, the votes aren't merged
+	record, err := gk.recordEventVote(ctx, newEvent(1, depositTx, 0), ValAddrs[0])
+	require.NoError(t, err)
+	_, err = gk.recordEventVote(ctx, newEvent(1, forkedTx, 0), ValAddrs[1])
+	require.NoError(t, err)
+	require.Len(t, record.Votes, 1)
+
+	gk.TryEventVoteRecord(ctx, gk.GetParams(ctx), record)
+	require.True(t, record.Accepted)
+
+	// the log of an observed event can't be claimed again at another nonce
+	_, err = gk.recordEventVote(ctx, newEvent(2, depositTx, 0), ValAddrs[0])
+	require.ErrorIs(t, err, types.ErrDuplicateEthereumEvent)
+	_, err = gk.recordEventVote(ctx, newEvent(2, depositTx, 1), ValAddrs[0])
+	require.NoError(t, err)
+
+	// an event without a log index can't be told apart from the first log
+	noLogIndex := newEvent(3, depositTx, 0).(*types.SendToCosmosEvent)
+	noLogIndex.HasEthereumLogIndex = false
+	_, err = gk.recordEventVote(ctx, noLogIndex, ValAddrs[0])
+	require.NoError(t, err)
+
+	res, err := gk.ConflictingEthereumEvents(sdk.WrapSDKContext(ctx), &types.ConflictingEthereumEventsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Conflicts, 1)
+	require.Equal(t, uint64(1), res.Conflicts[0].EventNonce)
+	require.Len(t, res.Conflicts[0].EventVoteRecords, 2)
+}
+
+func TestKeeper_BatchTxInclusionProof(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+
+	token := common.HexToAddress("0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4")
+	var txs []*types.SendToEthereum
+	for id := uint64(1); id <= 3; id++ {
+		txs = append(txs, &types.SendToEthereum{
+			Id:                id,
+			Sender:            AccAddrs[0].String(),
+			EthereumRecipient: EthAddrs[0].Hex(),
+			Erc20Token:        types.NewERC20Token(100, token),
+			Erc20Fee:          types.NewERC20Token(id, token),
+		})
+	}
+	gk.SetOutgoingTx(ctx, &types.BatchTx{
+		BatchNonce:    7,
+		Timeout:       1000,
+		Transactions:  txs,
+		TokenContract: token.Hex(),
+		Height:        1000,
+	})
+
+	res, err := gk.BatchTxInclusionProof(sdk.WrapSDKContext(ctx), &types.BatchTxInclusionProofRequest{
+		TokenContract:    token.Hex(),
+		BatchNonce:       7,
+		SendToEthereumId: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, txs[1], res.SendToEthereum)
+
+	proof, err := merkle.ProofFromProto(res.Proof)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), proof.Index)
+	require.NoError(t, proof.Verify(res.Root, env.Marshaler.MustMarshal(res.SendToEthereum)))
+
+	_, err = gk.BatchTxInclusionProof(sdk.WrapSDKContext(ctx), &types.BatchTxInclusionProofRequest{
+		TokenContract:    token.Hex(),
+		BatchNonce:       7,
+		SendToEthereumId: 4,
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestKeeper_ProjectedEthereumHeight(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	params := gk.GetParams(ctx)
+
+	// nothing can be projected before an ethereum height is observed
+	res, err := gk.ProjectedEthereumHeight(sdk.WrapSDKContext(ctx), &types.ProjectedEthereumHeightRequest{})
+	require.NoError(t, err)
+	require.Zero(t, res.ProjectedEthereumHeight)
+	require.Zero(t, res.TimeoutHeight)
+
+	gk.SetLastObservedEthereumBlockHeightWithCosmos(ctx, 1000, uint64(ctx.BlockHeight()))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 30)
+
+	projected := 1000 + 30*params.AverageBlockTime/params.AverageEthereumBlockTime
+	res, err = gk.ProjectedEthereumHeight(sdk.WrapSDKContext(ctx), &types.ProjectedEthereumHeightRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), res.LastObservedEthereumHeight.EthereumHeight)
+	require.Equal(t, projected, res.ProjectedEthereumHeight)
+	require.Zero(t, res.MedianEthereumHeightVote)
+	require.Equal(t, projected+params.TargetEthTxTimeout/params.AverageEthereumBlockTime, res.TimeoutHeight)
+
+	// with a margin, the timeout follows the median of the height votes
+	params.TimeoutEthereumBlockMargin = 3600
+	gk.SetParams(ctx, params)
+	for i, height := range []uint64{1000, 3000, 2000, 5000, 4000} {
+		gk.SetEthereumHeightVote(ctx, ValAddrs[i], height)
+	}
+	res, err = gk.ProjectedEthereumHeight(sdk.WrapSDKContext(ctx), &types.ProjectedEthereumHeightRequest{})
+	require.NoError(t, err)
+	require.Equal(t, projected, res.ProjectedEthereumHeight)
+	require.Equal(t, uint64(3000), res.MedianEthereumHeightVote)
+	require.Equal(t, uint64(3000+3600), res.TimeoutHeight)
+	require.Equal(t, gk.getTimeoutHeight(ctx, params), res.TimeoutHeight)
+}
+
+func TestKeeper_BatchFees(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+
+	var (
+		mySender, _ = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver  = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenA      = common.HexToAddress("0x1111111111111111111111111111111111111111")
+		tokenB      = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	)
+
+	env.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	for _, token := range []common.Address{tokenA, tokenB} {
+		MintVouchersFromAir(t, ctx, gk, mySender, types.NewERC20Token(99999, token))
+	}
+	env.AddSendToEthTxsToPool(t, ctx, tokenA, mySender, myReceiver, 2, 3, 5)
+	env.AddSendToEthTxsToPool(t, ctx, tokenB, mySender, myReceiver, 4)
+
+	queryCtx := sdk.WrapSDKContext(ctx.WithBlockHeight(ctx.BlockHeight() + 5))
+	res, err := gk.BatchFees(queryCtx, &types.BatchFeesRequest{MaxElements: 2})
+	require.NoError(t, err)
+	require.Equal(t, []types.TokenBatchFees{
+		{TokenContract: tokenA.Hex(), TotalFee: sdk.NewInt(10), TopFee: sdk.NewInt(8), TxCount: 3, OldestTxAge: 5},
+		{TokenContract: tokenB.Hex(), TotalFee: sdk.NewInt(4), TopFee: sdk.NewInt(4), TxCount: 1, OldestTxAge: 5},
+	}, res.Tokens)
+	require.Empty(t, res.Pagination.NextKey)
+
+	// tokens are paginated by contract address
+	res, err = gk.BatchFees(queryCtx, &types.BatchFeesRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Tokens, 1)
+	require.Equal(t, tokenA.Hex(), res.Tokens[0].TokenContract)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+	require.Equal(t, tokenB.Bytes(), res.Pagination.NextKey)
+
+	res, err = gk.BatchFees(queryCtx, &types.BatchFeesRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, res.Tokens, 1)
+	require.Equal(t, tokenB.Hex(), res.Tokens[0].TokenContract)
+	require.Equal(t, sdk.NewInt(4), res.Tokens[0].TopFee)
+	require.Empty(t, res.Pagination.NextKey)
+}
+
+func TestKeeper_LastSubmittedEthereumEvent(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	gk.setLastEventNonceByValidator(ctx, ValAddrs[0], 7)
+	gk.SetEthereumHeightVote(ctx, ValAddrs[0], 1200)
+	gk.SetLastObservedEthereumBlockHeightWithCosmos(ctx, 1000, uint64(ctx.BlockHeight()))
+
+	// the validator is given by its operator or account address
+	for _, address := range []string{ValAddrs[0].String(), AccAddrs[0].String()} {
+		res, err := gk.LastSubmittedEthereumEvent(sdk.WrapSDKContext(ctx), &types.LastSubmittedEthereumEventRequest{Address: address})
+		require.NoError(t, err)
+		require.Equal(t, uint64(7), res.EventNonce)
+		require.Equal(t, uint64(1200), res.EthereumHeightVote.EthereumHeight)
+		require.Equal(t, uint64(1000), res.LastObservedEthereumHeight.EthereumHeight)
+		require.Equal(t, gk.GetLastObservedEventNonce(ctx), res.LastObservedEventNonce)
+	}
+}
@@ -58,6 +58,14 @@ func (k Keeper) AfterContractCallExecutedEvent(ctx sdk.Context, event types.Cont
 	}
 }
 
+// AfterContractCallExecuted passes the executed contract call, as it was
+// created, along with its outcome on Ethereum to the module that requested it
+func (k Keeper) AfterContractCallExecuted(ctx sdk.Context, tx types.ContractCallTx, success bool, returnDataHash []byte) {
+	if k.hooks != nil {
+		k.hooks.AfterContractCallExecuted(ctx, tx, success, returnDataHash)
+	}
+}
+
 func (k Keeper) AfterERC20DeployedEvent(ctx sdk.Context, event types.ERC20DeployedEvent) {
 	if k.hooks != nil {
 		k.hooks.AfterERC20DeployedEvent(ctx, event)
@@ -82,6 +90,62 @@ func (k Keeper) AfterSendToCosmosEvent(ctx sdk.Context, event types.SendToCosmos
 	}
 }
 
+// AfterEthereumSignatureReceived reports each new signature for an outgoing tx
+// with the power of the confirmation signer set that has signed it so far
+func (k Keeper) AfterEthereumSignatureReceived(ctx sdk.Context, storeIndex []byte, validator sdk.ValAddress, cumulativePower uint64) {
+	if k.hooks != nil {
+		k.hooks.AfterEthereumSignatureReceived(ctx, storeIndex, validator, cumulativePower)
+	}
+}
+
+// AfterBatchCreated reports each new batch with the txs it took from the pool
+func (k Keeper) AfterBatchCreated(ctx sdk.Context, batch types.BatchTx) {
+	if k.hooks != nil {
+		k.hooks.AfterBatchCreated(ctx, batch)
+	}
+}
+
+// AfterBatchExecuted reports a batch once its execution on ethereum has been
+// observed and its vouchers and fees have been settled
+func (k Keeper) AfterBatchExecuted(ctx sdk.Context, batch types.BatchTx) {
+	if k.hooks != nil {
+		k.hooks.AfterBatchExecuted(ctx, batch)
+	}
+}
+
+// AfterBatchCancelled reports a batch whose txs were returned to the pool,
+// either because it timed out or a later batch was executed
+func (k Keeper) AfterBatchCancelled(ctx sdk.Context, batch types.BatchTx) {
+	if k.hooks != nil {
+		k.hooks.AfterBatchCancelled(ctx, batch)
+	}
+}
+
+// AfterSendToEthereumPooled reports each send to ethereum entering the pool
+// of unbatched txs for the first time
+func (k Keeper) AfterSendToEthereumPooled(ctx sdk.Context, ste types.SendToEthereum) {
+	if k.hooks != nil {
+		k.hooks.AfterSendToEthereumPooled(ctx, ste)
+	}
+}
+
+// AfterSignerSetObserved reports a signer set update once its execution on
+// ethereum crosses the observation threshold, whether or not it could be
+// applied
+func (k Keeper) AfterSignerSetObserved(ctx sdk.Context, event types.SignerSetTxExecutedEvent) {
+	if k.hooks != nil {
+		k.hooks.AfterSignerSetObserved(ctx, event)
+	}
+}
+
+// AfterEventObserved reports every ethereum event once it crosses the
+// observation threshold, whether or not it could be applied
+func (k Keeper) AfterEventObserved(ctx sdk.Context, event types.EthereumEvent) {
+	if k.hooks != nil {
+		k.hooks.AfterEventObserved(ctx, event)
+	}
+}
+
 func (k *Keeper) SetHooks(sh types.GravityHooks) *Keeper {
 	if k.hooks != nil {
 		panic("cannot set gravity hooks twice")
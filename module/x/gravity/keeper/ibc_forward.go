@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// forwardSendToCosmos dispatches an ICS-20 transfer of a credited deposit from
+// its receiver to the destination encoded in the event's IBC forward. A failed
+// forward is not an error for the deposit: the funds simply stay with the
+// receiver on this chain.
+func (k Keeper) forwardSendToCosmos(ctx sdk.Context, event *types.SendToCosmosEvent, receiver sdk.AccAddress, coin sdk.Coin) {
+	if event.IbcForward == "" {
+		return
+	}
+
+	logger := k.Logger(ctx).With("nonce", fmt.Sprint(event.EventNonce), "ibc forward", event.IbcForward)
+	if k.transferKeeper == nil {
+		logger.Info("ibc forwarding is not enabled, deposit left with receiver")
+		return
+	}
+
+	channel, destination, err := types.ParseIBCForward(event.IbcForward)
+	if err != nil {
+		logger.Error("invalid ibc forward, deposit left with receiver", "cause", err.Error())
+		return
+	}
+
+	timeout := uint64(ctx.BlockTime().Add(types.IBCForwardTimeout).UnixNano())
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.transferKeeper.SendTransfer(
+		cacheCtx,
+		transfertypes.PortID,
+		channel,
+		coin,
+		receiver,
+		destination,
+		clienttypes.ZeroHeight(),
+		timeout,
+	); err != nil {
+		logger.Error("ibc forward failed, deposit left with receiver", "cause", err.Error())
+		return
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBridgeDepositForwarded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.EventNonce)),
+			sdk.NewAttribute(types.AttributeKeyIBCForward, event.IbcForward),
+		),
+	)
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+type mockTransferKeeper struct {
+	channel  string
+	token    sdk.Coin
+	sender   sdk.AccAddress
+	receiver string
+}
+
+func (m *mockTransferKeeper) SendTransfer(_ sdk.Context, _, sourceChannel string, token sdk.Coin, sender sdk.AccAddress, receiver string, _ clienttypes.Height, _ uint64) error {
+	m.channel, m.token, m.sender, m.receiver = sourceChannel, token, sender, receiver
+	return nil
+}
+
+func TestSendToCosmosIBCForward(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	transferKeeper := &mockTransferKeeper{}
+	gk.SetTransferKeeper(transferKeeper)
+
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  tokenContract.Hex(),
+		Amount:         sdk.NewInt(12),
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: AccAddrs[0].String(),
+		EthereumHeight: 10,
+		IbcForward:     "channel-3:osmo1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq",
+	}
+	require.NoError(t, event.Validate())
+	require.NoError(t, gk.Handle(ctx, event))
+
+	require.Equal(t, "channel-3", transferKeeper.channel)
+	require.Equal(t, "osmo1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", transferKeeper.receiver)
+	require.Equal(t, AccAddrs[0], transferKeeper.sender)
+	require.Equal(t, sdk.NewInt64Coin(types.GravityDenom(tokenContract), 12), transferKeeper.token)
+}
+
+func TestSendToCosmosMalformedIBCForward(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	transferKeeper := &mockTransferKeeper{}
+	gk.SetTransferKeeper(transferKeeper)
+
+	// the malformed forward doesn't make the event invalid, the deposit is
+	// credited to the receiver without being forwarded
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  tokenContract.Hex(),
+		Amount:         sdk.NewInt(12),
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: AccAddrs[0].String(),
+		EthereumHeight: 10,
+		IbcForward:     "not a forward",
+	}
+	require.NoError(t, event.Validate())
+	require.NoError(t, gk.Handle(ctx, event))
+
+	require.Empty(t, transferKeeper.channel)
+	require.Equal(t, int64(12), input.BankKeeper.GetBalance(ctx, AccAddrs[0], types.GravityDenom(tokenContract)).Amount.Int64())
+}
+
+func TestSendToCosmosIBCForwardWithMemo(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	transferKeeper := &mockTransferKeeper{}
+	gk.SetTransferKeeper(transferKeeper)
+
+	// a deposit with both a forward and a memo is credited to the receiver
+	// without being forwarded or routed to a memo handler
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  tokenContract.Hex(),
+		Amount:         sdk.NewInt(12),
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: AccAddrs[0].String(),
+		EthereumHeight: 10,
+		IbcForward:     "channel-3:osmo1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq",
+		Memo:           []byte(`{"stake": {}}`),
+	}
+	require.NoError(t, event.Validate())
+	require.NoError(t, gk.Handle(ctx, event))
+
+	require.Empty(t, transferKeeper.channel)
+	require.Equal(t, int64(12), input.BankKeeper.GetBalance(ctx, AccAddrs[0], types.GravityDenom(tokenContract)).Amount.Int64())
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	accType "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -12,15 +14,17 @@ import (
 // TODO: (see the sdk docs for more info https://docs.cosmos.network/master/building-modules/invariants.html)
 func AllInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return ModuleBalanceInvariant(k)(ctx)
-
-		// Example additional invariants
-		//  res, stop := FutureInvariant(k)(ctx)
-		//	if stop {
-		//		return res, stop
-		//	}
-		//
-		//	return AnotherFutureInvariant(k)(ctx)
+		res, stop := ModuleBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = CosmosOriginatedSolvencyInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return OrphanedEthereumSignaturesInvariant(k)(ctx)
 	}
 }
 
@@ -37,6 +41,7 @@ func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
 		}
 		expectedBals = sumUnconfirmedBatchModuleBalances(ctx, k, expectedBals)
 		expectedBals = sumUnbatchedSendToEthereumsModuleBalances(ctx, k, expectedBals)
+		expectedBals = sumPendingContractCallModuleBalances(ctx, k, expectedBals)
 
 		// Compare actual vs expected balances
 		for _, actual := range actualBals {
@@ -63,6 +68,65 @@ func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// CosmosOriginatedSolvencyInvariant checks that the module account holds at least the amounts of cosmos-originated
+// assets owed to unbatched transactions and unobserved batches. The module also escrows the cosmos-originated assets
+// already bridged to Ethereum, which we have no index of, so only a lower bound can be asserted here. A refund or
+// cancellation paying out more than was escrowed breaks it.
+func CosmosOriginatedSolvencyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		modAcc := accType.NewModuleAddress(types.ModuleName)
+		actualBals := k.bankKeeper.GetAllBalances(ctx, modAcc)
+		expectedBals := make(map[string]*sdk.Int)
+		expectedBals = sumUnconfirmedBatchModuleBalances(ctx, k, expectedBals)
+		expectedBals = sumUnbatchedSendToEthereumsModuleBalances(ctx, k, expectedBals)
+		expectedBals = sumPendingContractCallModuleBalances(ctx, k, expectedBals)
+
+		// iterate the denoms in a deterministic order so every node reports the same violation
+		denoms := make([]string, 0, len(expectedBals))
+		for denom := range expectedBals {
+			denoms = append(denoms, denom)
+		}
+		sort.Strings(denoms)
+
+		for _, denom := range denoms {
+			if !k.isCosmosOriginatedDenom(ctx, denom) {
+				// eth-originated balances are checked for equality by ModuleBalanceInvariant
+				continue
+			}
+
+			expected := *expectedBals[denom]
+			actual := actualBals.AmountOf(denom)
+			if actual.LT(expected) {
+				return fmt.Sprint("Insolvent balance of cosmos-originated ", denom, ": actual balance ", actual, " < owed balance ", expected), true
+			}
+		}
+		return "", false
+	}
+}
+
+// OrphanedEthereumSignaturesInvariant checks that every ethereum signature in state is the signature of an
+// outgoing tx still in state. The end blocker prunes the orphaned signatures, so one found means a path deleting an
+// outgoing tx leaves its signatures behind.
+func OrphanedEthereumSignaturesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var orphaned [][]byte
+		k.iterateOrphanedEthereumSignatures(ctx, func(key []byte) bool {
+			orphaned = append(orphaned, key)
+			return false
+		})
+		if len(orphaned) > 0 {
+			return fmt.Sprintf("%d orphaned ethereum signatures, the first keyed %X", len(orphaned), orphaned[0]), true
+		}
+		return "", false
+	}
+}
+
+// isCosmosOriginatedDenom returns true if the denom is a cosmos-originated asset with an erc20 representation
+func (k Keeper) isCosmosOriginatedDenom(ctx sdk.Context, denom string) bool {
+	cosmosOriginated, _, err := k.DenomToERC20Lookup(ctx, denom)
+	return err == nil && cosmosOriginated
+}
+
 // sumUnconfirmedBatchModuleBalances calculate the value the module should have stored due to unconfirmed batches
 func sumUnconfirmedBatchModuleBalances(ctx sdk.Context, k Keeper, expectedBals map[string]*sdk.Int) map[string]*sdk.Int {
 	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
@@ -71,9 +135,11 @@ func sumUnconfirmedBatchModuleBalances(ctx sdk.Context, k Keeper, expectedBals m
 		batch, _ := otx.(*types.BatchTx)
 		for _, tx := range batch.Transactions {
 			batchTotal = batchTotal.Add(tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount))
+			addBridgeFeeModuleBalances(expectedBals, tx.GetChainFeeCoins())
 		}
-		contract := batch.TokenContract
-		_, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(contract))
+		contract := common.HexToAddress(batch.TokenContract)
+		_, denom := k.ERC20ToDenomLookup(ctx, contract)
+		batchTotal = k.ERC20ToCosmosAmount(ctx, contract, batchTotal)
 		// Add the batch total to the contract counter
 		_, ok := expectedBals[denom]
 		if !ok {
@@ -82,6 +148,7 @@ func sumUnconfirmedBatchModuleBalances(ctx sdk.Context, k Keeper, expectedBals m
 		}
 
 		*expectedBals[denom] = expectedBals[denom].Add(batchTotal)
+		addBridgeFeeModuleBalances(expectedBals, batch.BridgeFees)
 
 		return false // continue iterating
 	})
@@ -89,24 +156,63 @@ func sumUnconfirmedBatchModuleBalances(ctx sdk.Context, k Keeper, expectedBals m
 	return expectedBals
 }
 
-// sumUnbatchedSendToEthereumsModuleBalances calculates the value the module should have stored due to unbatched txs
+// sumUnbatchedSendToEthereumsModuleBalances calculates the value the module should have stored due to unbatched
+// txs, including the ones held in the delayed send queue
 func sumUnbatchedSendToEthereumsModuleBalances(ctx sdk.Context, k Keeper, expectedBals map[string]*sdk.Int) map[string]*sdk.Int {
 	// It is also given the balance of all unbatched txs in the pool
 	k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
-		contract := ste.Erc20Token.Contract
-		_, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(contract))
+		addSendToEthereumModuleBalances(ctx, k, expectedBals, *ste)
+		return false // continue iterating
+	})
+	k.IterateDelayedSendToEthereums(ctx, func(delayed types.DelayedSendToEthereum) bool {
+		addSendToEthereumModuleBalances(ctx, k, expectedBals, delayed.SendToEthereum)
+		return false // continue iterating
+	})
 
-		// Collect the send amount + fee amount for each tx
-		txTotal := ste.Erc20Token.Amount.Add(ste.Erc20Fee.Amount)
-		_, ok := expectedBals[denom]
-		if !ok {
-			zero := sdk.ZeroInt()
-			expectedBals[denom] = &zero
-		}
-		*expectedBals[denom] = expectedBals[denom].Add(txTotal)
+	return expectedBals
+}
 
+// sumPendingContractCallModuleBalances calculates the value the module should have stored due to the tokens and
+// fees escrowed for pending contract calls with a refundee
+func sumPendingContractCallModuleBalances(ctx sdk.Context, k Keeper, expectedBals map[string]*sdk.Int) map[string]*sdk.Int {
+	k.IterateOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
+		cctx, _ := otx.(*types.ContractCallTx)
+		if cctx.Refundee == "" {
+			return false // nothing escrowed, continue iterating
+		}
+		coins, _ := k.contractCallTxCoins(ctx, cctx)
+		addBridgeFeeModuleBalances(expectedBals, coins)
 		return false // continue iterating
 	})
 
 	return expectedBals
 }
+
+// addSendToEthereumModuleBalances adds the send amount and fees of a send to ethereum to the expected balances
+func addSendToEthereumModuleBalances(ctx sdk.Context, k Keeper, expectedBals map[string]*sdk.Int, ste types.SendToEthereum) {
+	contract := common.HexToAddress(ste.Erc20Token.Contract)
+	_, denom := k.ERC20ToDenomLookup(ctx, contract)
+
+	// Collect the send amount + fee amount for each tx
+	txTotal := k.ERC20ToCosmosAmount(ctx, contract, ste.Erc20Token.Amount.Add(ste.Erc20Fee.Amount))
+	_, ok := expectedBals[denom]
+	if !ok {
+		zero := sdk.ZeroInt()
+		expectedBals[denom] = &zero
+	}
+	*expectedBals[denom] = expectedBals[denom].Add(txTotal)
+	addBridgeFeeModuleBalances(expectedBals, ste.GetBridgeFeeCoins())
+	addBridgeFeeModuleBalances(expectedBals, ste.GetChainFeeCoins())
+}
+
+// addBridgeFeeModuleBalances adds the fees escrowed apart from the token amount, in the bridge fee denom or
+// as chain fees, or any other escrowed coins, to the expected balances
+func addBridgeFeeModuleBalances(expectedBals map[string]*sdk.Int, fees sdk.Coins) {
+	for _, fee := range fees {
+		if _, ok := expectedBals[fee.Denom]; !ok {
+			zero := sdk.ZeroInt()
+			expectedBals[fee.Denom] = &zero
+		}
+		*expectedBals[fee.Denom] = expectedBals[fee.Denom].Add(fee.Amount)
+	}
+}
@@ -64,7 +64,7 @@ func TestModuleBalanceUnbatchedTxs(t *testing.T) {
 	checkInvariant(t, ctx, input.GravityKeeper, true)
 
 	// Execute batch and check
-	input.GravityKeeper.batchTxExecuted(ctx, myTokenContractAddr, batch.BatchNonce)
+	input.GravityKeeper.batchTxExecuted(ctx, input.GravityKeeper.GetParams(ctx), myTokenContractAddr, batch.BatchNonce, "")
 	checkInvariant(t, ctx, input.GravityKeeper, true)
 
 	// Ensure an error is returned for a mismatched balance
@@ -72,6 +72,64 @@ func TestModuleBalanceUnbatchedTxs(t *testing.T) {
 	checkImbalancedModule(t, ctx, input.GravityKeeper, input.BankKeeper, mySender, sdk.NewCoins(oneVoucher))
 }
 
+// Tests that the gravity module stays solvent for cosmos-originated assets across sends, cancellation and batching
+func TestCosmosOriginatedSolvency(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos12luku6uxehhak02py4rcz65zu0swh7wj8a5enl")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		myTokenDenom        = "stake"
+	)
+
+	gk.setCosmosOriginatedDenomToERC20(ctx, myTokenDenom, myTokenContractAddr)
+	require.NoError(t, input.AddBalanceToBank(ctx, mySender, sdk.NewCoins(sdk.NewCoin(myTokenDenom, sdk.NewInt(10000)))))
+
+	checkSolvencyInvariant(t, ctx, gk, true)
+
+	for i := 0; i < 4; i++ {
+		_, err := gk.createSendToEthereum(
+			ctx,
+			mySender,
+			myReceiver,
+			sdk.NewCoin(myTokenDenom, sdk.NewInt(int64(i+100))),
+			sdk.NewCoin(myTokenDenom, sdk.NewInt(2)))
+		require.NoError(t, err)
+	}
+	checkSolvencyInvariant(t, ctx, gk, true)
+
+	require.NoError(t, gk.cancelSendToEthereum(ctx, 1, mySender.String()))
+	checkSolvencyInvariant(t, ctx, gk, true)
+
+	batch := gk.BuildBatchTx(ctx, myTokenContractAddr, 100)
+	require.NotNil(t, batch)
+	checkSolvencyInvariant(t, ctx, gk, true)
+
+	// paying out escrowed funds leaves the module short of what the batch owes
+	drained := sdk.NewCoins(sdk.NewCoin(myTokenDenom, sdk.NewInt(1)))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, drained))
+	checkSolvencyInvariant(t, ctx, gk, false)
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(ctx, mySender, types.ModuleName, drained))
+
+	// once executed the escrowed funds back the tokens on ethereum and are no longer checked
+	gk.batchTxExecuted(ctx, gk.GetParams(ctx), myTokenContractAddr, batch.BatchNonce, "")
+	checkSolvencyInvariant(t, ctx, gk, true)
+	input.AssertInvariants()
+}
+
+func checkSolvencyInvariant(t *testing.T, ctx sdk.Context, k Keeper, succeed bool) {
+	res, broken := CosmosOriginatedSolvencyInvariant(k)(ctx)
+	if succeed {
+		require.False(t, broken, res)
+	} else {
+		require.True(t, broken, "Invariant should have been broken")
+		require.NotEmpty(t, res, "Invariant should have returned a message")
+	}
+}
+
 func checkInvariant(t *testing.T, ctx sdk.Context, k Keeper, succeed bool) {
 	res, ok := ModuleBalanceInvariant(k)(ctx)
 	if succeed {
@@ -13,7 +13,9 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -34,8 +36,12 @@ type Keeper struct {
 	bankKeeper             types.BankKeeper
 	SlashingKeeper         types.SlashingKeeper
 	DistributionKeeper     types.DistributionKeeper
-	PowerReduction         sdk.Int
 	hooks                  types.GravityHooks
+	transferKeeper         types.TransferKeeper
+	govKeeper              types.GovKeeper
+	oracle                 types.Oracle
+	memoHandlers           map[string]types.DepositMemoHandler
+	signatureVerifiers     map[types.SignatureScheme]types.SignatureVerifier
 	ReceiverModuleAccounts map[string]string
 	SenderModuleAccounts   map[string]string
 }
@@ -50,7 +56,6 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	slashingKeeper types.SlashingKeeper,
 	distributionKeeper types.DistributionKeeper,
-	powerReduction sdk.Int,
 	receiverModuleAccounts map[string]string,
 	senderModuleAccounts map[string]string,
 ) Keeper {
@@ -68,7 +73,8 @@ func NewKeeper(
 		bankKeeper:             bankKeeper,
 		SlashingKeeper:         slashingKeeper,
 		DistributionKeeper:     distributionKeeper,
-		PowerReduction:         powerReduction,
+		memoHandlers:           make(map[string]types.DepositMemoHandler),
+		signatureVerifiers:     defaultSignatureVerifiers(),
 		ReceiverModuleAccounts: receiverModuleAccounts,
 		SenderModuleAccounts:   senderModuleAccounts,
 	}
@@ -76,6 +82,48 @@ func NewKeeper(
 	return k
 }
 
+// SetTransferKeeper sets the ICS-20 transfer keeper used to forward deposits over IBC
+func (k *Keeper) SetTransferKeeper(tk types.TransferKeeper) *Keeper {
+	if k.transferKeeper != nil {
+		panic("cannot set gravity transfer keeper twice")
+	}
+
+	k.transferKeeper = tk
+
+	return k
+}
+
+// SetGovKeeper sets the gov keeper used to tally bridge-critical proposals
+func (k *Keeper) SetGovKeeper(gk types.GovKeeper) *Keeper {
+	if k.govKeeper != nil {
+		panic("cannot set gravity gov keeper twice")
+	}
+
+	k.govKeeper = gk
+
+	return k
+}
+
+// SetOracle replaces the oracle deciding when ethereum events are observed,
+// which defaults to the validator voting oracle
+func (k *Keeper) SetOracle(oracle types.Oracle) *Keeper {
+	if k.oracle != nil {
+		panic("cannot set gravity oracle twice")
+	}
+
+	k.oracle = oracle
+
+	return k
+}
+
+// getOracle returns the oracle deciding when ethereum events are observed
+func (k Keeper) getOracle() types.Oracle {
+	if k.oracle == nil {
+		return types.NewVotingOracle(k.StakingKeeper, k.GetEventVotePowerThreshold)
+	}
+	return k.oracle
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
@@ -86,12 +134,15 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 // incrementLatestSignerSetTxNonce sets the latest valset nonce
 func (k Keeper) incrementLatestSignerSetTxNonce(ctx sdk.Context) uint64 {
-	current := k.GetLatestSignerSetTxNonce(ctx)
-	next := current + 1
-	ctx.KVStore(k.storeKey).Set([]byte{types.LatestSignerSetTxNonceKey}, sdk.Uint64ToBigEndian(next))
+	next := k.GetLatestSignerSetTxNonce(ctx) + 1
+	k.setLatestSignerSetTxNonce(ctx, next)
 	return next
 }
 
+func (k Keeper) setLatestSignerSetTxNonce(ctx sdk.Context, nonce uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LatestSignerSetTxNonceKey}, sdk.Uint64ToBigEndian(nonce))
+}
+
 // GetLatestSignerSetTxNonce returns the latest valset nonce
 func (k Keeper) GetLatestSignerSetTxNonce(ctx sdk.Context) uint64 {
 	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LatestSignerSetTxNonceKey}); bz != nil {
@@ -103,7 +154,10 @@ func (k Keeper) GetLatestSignerSetTxNonce(ctx sdk.Context) uint64 {
 // GetLatestSignerSetTx returns the latest validator set in state
 func (k Keeper) GetLatestSignerSetTx(ctx sdk.Context) *types.SignerSetTx {
 	key := types.MakeSignerSetTxKey(k.GetLatestSignerSetTxNonce(ctx))
-	otx := k.GetOutgoingTx(ctx, key)
+	otx, err := k.GetOutgoingTx(ctx, key)
+	if err != nil {
+		return nil
+	}
 	out, _ := otx.(*types.SignerSetTx)
 	return out
 }
@@ -132,35 +186,46 @@ func (k Keeper) GetLastUnbondingBlockHeight(ctx sdk.Context) uint64 {
 
 // getEthereumSignature returns a valset confirmation by a nonce and validator address
 func (k Keeper) getEthereumSignature(ctx sdk.Context, storeIndex []byte, validator sdk.ValAddress) []byte {
-	return ctx.KVStore(k.storeKey).Get(types.MakeEthereumSignatureKey(storeIndex, validator))
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeEthereumSignatureKey(storeIndex, validator))
+	if bz == nil {
+		return nil
+	}
+	var sig types.EthereumSignature
+	k.cdc.MustUnmarshal(bz, &sig)
+	return sig.Signature
 }
 
-// SetEthereumSignature sets a valset confirmation
+// SetEthereumSignature sets a valset confirmation, tagged with its scheme
 func (k Keeper) SetEthereumSignature(ctx sdk.Context, sig types.EthereumTxConfirmation, val sdk.ValAddress) []byte {
 	key := types.MakeEthereumSignatureKey(sig.GetStoreIndex(), val)
-	ctx.KVStore(k.storeKey).Set(key, sig.GetSignature())
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&types.EthereumSignature{
+		Scheme:    sig.GetScheme(),
+		Signature: sig.GetSignature(),
+	}))
 	return key
 }
 
 // GetEthereumSignatures returns all etherum signatures for a given outgoing tx by store index
 func (k Keeper) GetEthereumSignatures(ctx sdk.Context, storeIndex []byte) map[string][]byte {
 	var signatures = make(map[string][]byte)
-	k.iterateEthereumSignatures(ctx, storeIndex, func(val sdk.ValAddress, h []byte) bool {
-		signatures[val.String()] = h
+	k.iterateEthereumSignatures(ctx, storeIndex, func(val sdk.ValAddress, sig types.EthereumSignature) bool {
+		signatures[val.String()] = sig.Signature
 		return false
 	})
 	return signatures
 }
 
 // iterateEthereumSignatures iterates through all valset confirms by nonce in ASC order
-func (k Keeper) iterateEthereumSignatures(ctx sdk.Context, storeIndex []byte, cb func(sdk.ValAddress, []byte) bool) {
+func (k Keeper) iterateEthereumSignatures(ctx sdk.Context, storeIndex []byte, cb func(sdk.ValAddress, types.EthereumSignature) bool) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.EthereumSignatureKey}, storeIndex...))
 	iter := prefixStore.Iterator(nil, nil)
 	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
+		var sig types.EthereumSignature
+		k.cdc.MustUnmarshal(iter.Value(), &sig)
 		// cb returns true to stop early
-		if cb(iter.Key(), iter.Value()) {
+		if cb(iter.Key(), sig) {
 			break
 		}
 	}
@@ -196,7 +261,19 @@ func (k Keeper) setValidatorEthereumAddress(ctx sdk.Context, valAddr sdk.ValAddr
 	store := ctx.KVStore(k.storeKey)
 	key := types.MakeValidatorEthereumAddressKey(valAddr)
 
+	previous := k.GetValidatorEthereumAddress(ctx, valAddr)
 	store.Set(key, ethAddr.Bytes())
+	store.Set(types.MakeEthereumAddressValidatorKey(ethAddr, valAddr), []byte{0x1})
+	if previous != ethAddr {
+		k.unindexEthereumAddressValidator(ctx, previous, valAddr)
+	}
+}
+
+// deleteValidatorEthereumAddress removes the ethereum address of a validator
+func (k Keeper) deleteValidatorEthereumAddress(ctx sdk.Context, valAddr sdk.ValAddress) {
+	previous := k.GetValidatorEthereumAddress(ctx, valAddr)
+	ctx.KVStore(k.storeKey).Delete(types.MakeValidatorEthereumAddressKey(valAddr))
+	k.unindexEthereumAddressValidator(ctx, previous, valAddr)
 }
 
 // GetValidatorEthereumAddress returns the eth address for a given gravity validator.
@@ -207,20 +284,32 @@ func (k Keeper) GetValidatorEthereumAddress(ctx sdk.Context, valAddr sdk.ValAddr
 	return common.BytesToAddress(store.Get(key))
 }
 
+// getValidatorsByEthereumAddress returns the validators using the ethereum
+// address, either as their current or as their pending one
 func (k Keeper) getValidatorsByEthereumAddress(ctx sdk.Context, ethAddr common.Address) (vals []sdk.ValAddress) {
-	iter := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeEthereumAddressValidatorKey(ethAddr, nil)).Iterator(nil, nil)
+	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
-		if common.BytesToAddress(iter.Value()) == ethAddr {
-			valBs := bytes.TrimPrefix(iter.Key(), []byte{types.ValidatorEthereumAddressKey})
-			val := sdk.ValAddress(valBs)
-			vals = append(vals, val)
-		}
+		vals = append(vals, sdk.ValAddress(iter.Key()))
 	}
 
 	return
 }
 
+// unindexEthereumAddressValidator removes the validator from the index of the
+// ethereum address unless it is still the validator's current or pending one
+func (k Keeper) unindexEthereumAddressValidator(ctx sdk.Context, ethAddr common.Address, valAddr sdk.ValAddress) {
+	if k.GetValidatorEthereumAddress(ctx, valAddr) == ethAddr {
+		return
+	}
+	if pending, ok := k.GetPendingValidatorEthereumAddress(ctx, valAddr); ok && pending == ethAddr {
+		return
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.MakeEthereumAddressValidatorKey(ethAddr, valAddr))
+}
+
 ////////////////////////
 // ETH -> ORC ADDRESS //
 ////////////////////////
@@ -258,6 +347,7 @@ func (k Keeper) getEthereumAddressesByOrchestrator(ctx sdk.Context, orch sdk.Acc
 // CreateSignerSetTx gets the current signer set from the staking keeper, increments the nonce,
 // creates the signer set tx object, emits an event and sets the signer set in state
 func (k Keeper) CreateSignerSetTx(ctx sdk.Context) *types.SignerSetTx {
+	previousSignerSetTx := k.GetLatestSignerSetTx(ctx)
 	nonce := k.incrementLatestSignerSetTxNonce(ctx)
 	currSignerSet := k.CurrentSignerSet(ctx)
 	newSignerSetTx := types.NewSignerSetTx(nonce, uint64(ctx.BlockHeight()), currSignerSet)
@@ -272,6 +362,8 @@ func (k Keeper) CreateSignerSetTx(ctx sdk.Context) *types.SignerSetTx {
 		),
 	)
 	k.SetOutgoingTx(ctx, newSignerSetTx)
+	k.setSignerSetTxDelta(ctx, types.NewSignerSetTxDelta(previousSignerSetTx, newSignerSetTx))
+	telemetrySignerSetSize(newSignerSetTx)
 	k.Logger(ctx).Info(
 		"SignerSetTx created",
 		"nonce", newSignerSetTx.Nonce,
@@ -291,17 +383,37 @@ func (k Keeper) CreateSignerSetTx(ctx sdk.Context) *types.SignerSetTx {
 // Cosmos power / total cosmos power ratio, leaving us at uint32 Max - 1
 // total voting power. This is an acceptable rounding error since floating
 // point may cause consensus problems if different floating point unit
-// implementations are involved.
+// implementations are involved. The Cosmos power of a validator is counted in
+// units of the PowerReduction param, validators below it or opted out of the
+// bridge are left out.
 func (k Keeper) CurrentSignerSet(ctx sdk.Context) types.EthereumSigners {
 	validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	powerDivisor := k.signerSetPowerDivisor(ctx)
 	ethereumSigners := make([]*types.EthereumSigner, 0)
 	var totalPower uint64
 	for _, validator := range validators {
 		val := validator.GetOperator()
+		if k.IsValidatorOptedOut(ctx, val) {
+			continue
+		}
+
+		p := uint64(k.StakingKeeper.GetLastValidatorPower(ctx, val)) / powerDivisor
+		// a validator below the power reduction has no power in the bridge
+		if p == 0 {
+			continue
+		}
 
-		p := uint64(k.StakingKeeper.GetLastValidatorPower(ctx, val))
+		ethAddr := k.GetValidatorEthereumAddress(ctx, val)
+		// a rotated key joins the bridge through the next signer set
+		if pending, ok := k.GetPendingValidatorEthereumAddress(ctx, val); ok {
+			ethAddr = pending
+		}
+		// a compromised key stays out of the bridge until it is rotated
+		if k.IsEthereumSignerExcluded(ctx, ethAddr) {
+			continue
+		}
 
-		if ethAddr := k.GetValidatorEthereumAddress(ctx, val); ethAddr.Hex() != "0x0000000000000000000000000000000000000000" {
+		if ethAddr.Hex() != "0x0000000000000000000000000000000000000000" {
 			es := &types.EthereumSigner{Power: p, EthereumAddress: ethAddr.Hex()}
 			ethereumSigners = append(ethereumSigners, es)
 			totalPower += p
@@ -315,6 +427,28 @@ func (k Keeper) CurrentSignerSet(ctx sdk.Context) types.EthereumSigners {
 	return ethereumSigners
 }
 
+// ValidatePowerReduction checks that a signer set power reduction is a
+// multiple of the staking power reduction, so that the power of a signer is a
+// whole fraction of its consensus power
+func (k Keeper) ValidatePowerReduction(ctx sdk.Context, powerReduction sdk.Int) error {
+	stakingPowerReduction := k.StakingKeeper.PowerReduction(ctx)
+	if powerReduction.LT(stakingPowerReduction) || !powerReduction.Mod(stakingPowerReduction).IsZero() {
+		return sdkerrors.Wrapf(types.ErrInvalid, "power reduction %s is not a multiple of the staking power reduction %s", powerReduction, stakingPowerReduction)
+	}
+	return nil
+}
+
+// signerSetPowerDivisor returns the consensus power per unit of signer set
+// power, ignoring a power reduction the staking one no longer divides
+func (k Keeper) signerSetPowerDivisor(ctx sdk.Context) uint64 {
+	powerReduction := k.getPowerReduction(ctx)
+	if err := k.ValidatePowerReduction(ctx, powerReduction); err != nil {
+		k.Logger(ctx).Error("ignoring the signer set power reduction", "error", err)
+		return 1
+	}
+	return powerReduction.Quo(k.StakingKeeper.PowerReduction(ctx)).Uint64()
+}
+
 // GetSignerSetTxs returns all the signer set txs from the store
 func (k Keeper) GetSignerSetTxs(ctx sdk.Context) (out []*types.SignerSetTx) {
 	k.IterateOutgoingTxsByType(ctx, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
@@ -325,6 +459,20 @@ func (k Keeper) GetSignerSetTxs(ctx sdk.Context) (out []*types.SignerSetTx) {
 	return
 }
 
+// ExecuteAtomically runs fn on a cached context and only writes its state
+// changes and events back if it succeeds, so that a sequence of bridge
+// operations is applied all or nothing
+func (k Keeper) ExecuteAtomically(ctx sdk.Context, fn func(ctx sdk.Context) error) error {
+	cacheCtx, commit := ctx.CacheContext()
+	if err := fn(cacheCtx); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events()) // copy events to original context
+	commit()                                                        // persist transient storage
+	return nil
+}
+
 /////////////////////////////
 //       PARAMETERS        //
 /////////////////////////////
@@ -335,8 +483,11 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return
 }
 
-// SetParams sets the parameters in the store
+// SetParams sets the parameters in the store, panicking if they are invalid
 func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) {
+	if err := ps.Validate(); err != nil {
+		panic(err)
+	}
 	k.paramSpace.SetParamSet(ctx, &ps)
 }
 
@@ -347,6 +498,14 @@ func (k Keeper) getBridgeContractAddress(ctx sdk.Context) string {
 	return a
 }
 
+// getPowerReduction returns the tokens per unit of power of the validators in
+// signer sets
+func (k Keeper) getPowerReduction(ctx sdk.Context) sdk.Int {
+	var powerReduction sdk.Int
+	k.paramSpace.Get(ctx, types.ParamStorePowerReduction, &powerReduction)
+	return powerReduction
+}
+
 // getBridgeChainID returns the chain id of the ETH chain we are running against
 func (k Keeper) getBridgeChainID(ctx sdk.Context) uint64 {
 	var a uint64
@@ -354,6 +513,21 @@ func (k Keeper) getBridgeChainID(ctx sdk.Context) uint64 {
 	return a
 }
 
+// GetEventVotePowerThreshold returns the fraction of the total power the votes
+// for an ethereum event must reach for it to be observed
+func (k Keeper) GetEventVotePowerThreshold(ctx sdk.Context) sdk.Dec {
+	var a sdk.Dec
+	k.paramSpace.Get(ctx, types.ParamStoreEventVotePowerThreshold, &a)
+	return a
+}
+
+// getNativeEtherDenom returns the denom of the vouchers minted for native ether
+func (k Keeper) getNativeEtherDenom(ctx sdk.Context) string {
+	var a string
+	k.paramSpace.Get(ctx, types.ParamStoreNativeEtherDenom, &a)
+	return a
+}
+
 // getGravityID returns the GravityID the GravityID is essentially a salt value
 // for bridge signatures, provided each chain running Gravity has a unique ID
 // it won't be possible to play back signatures from one bridge onto another
@@ -384,6 +558,11 @@ func (k Keeper) getDelegateKeys(ctx sdk.Context) (out []*types.MsgDelegateKeys)
 	store := ctx.KVStore(k.storeKey)
 	iter := prefix.NewStore(store, []byte{types.ValidatorEthereumAddressKey}).Iterator(nil, nil)
 	for ; iter.Valid(); iter.Next() {
+		// validators with a revoked orchestrator are exported separately
+		if k.GetEthereumOrchestratorAddress(ctx, common.BytesToAddress(iter.Value())) == nil {
+			continue
+		}
+
 		out = append(out, &types.MsgDelegateKeys{
 			ValidatorAddress: sdk.ValAddress(iter.Key()).String(),
 			EthereumAddress:  common.BytesToAddress(iter.Value()).Hex(),
@@ -414,8 +593,26 @@ func (k Keeper) GetUnbondingvalidators(unbondingVals []byte) stakingtypes.ValAdd
 }
 
 // This gets the timeout height in Ethereum blocks for expiring old batches and contract calls.
-func (k Keeper) getTimeoutHeight(ctx sdk.Context) uint64 {
-	params := k.GetParams(ctx)
+func (k Keeper) getTimeoutHeight(ctx sdk.Context, params types.Params) uint64 {
+	// the median of the validators' recent height votes reflects the actual ethereum block times
+	if params.TimeoutEthereumBlockMargin > 0 {
+		if medianHeight, ok := k.medianEthereumHeightVote(ctx, params.TimeoutHeightVoteWindow); ok {
+			return medianHeight + params.TimeoutEthereumBlockMargin
+		}
+	}
+	projectedCurrentEthereumHeight := k.projectEthereumHeight(ctx, params)
+	if projectedCurrentEthereumHeight == 0 {
+		return 0
+	}
+	// we convert our target time for block timeouts (lets say 12 hours) into a number of blocks to
+	// place on top of our projection of the current Ethereum block height.
+	blocksToAdd := params.TargetEthTxTimeout / params.AverageEthereumBlockTime
+	return projectedCurrentEthereumHeight + blocksToAdd
+}
+
+// projectEthereumHeight projects the current Ethereum height from the last
+// observed Cosmos and Ethereum heights, or returns zero if none were observed
+func (k Keeper) projectEthereumHeight(ctx sdk.Context, params types.Params) uint64 {
 	currentCosmosHeight := ctx.BlockHeight()
 	// we store the last observed Cosmos and Ethereum heights, we do not concern ourselves if these values are zero because
 	// no batch can be produced if the last Ethereum block height is not first populated by a deposit event.
@@ -426,23 +623,27 @@ func (k Keeper) getTimeoutHeight(ctx sdk.Context) uint64 {
 	// we project how long it has been in milliseconds since the last Ethereum block height was observed
 	projectedMillis := (uint64(currentCosmosHeight) - heights.CosmosHeight) * params.AverageBlockTime
 	// we convert that projection into the current Ethereum height using the average Ethereum block time in millis
-	projectedCurrentEthereumHeight := (projectedMillis / params.AverageEthereumBlockTime) + heights.EthereumHeight
-	// we convert our target time for block timeouts (lets say 12 hours) into a number of blocks to
-	// place on top of our projection of the current Ethereum block height.
-	blocksToAdd := params.TargetEthTxTimeout / params.AverageEthereumBlockTime
-	return projectedCurrentEthereumHeight + blocksToAdd
+	return (projectedMillis / params.AverageEthereumBlockTime) + heights.EthereumHeight
 }
 
 /////////////////
 // OUTGOING TX //
 /////////////////
 
-// GetOutgoingTx todo: outgoingTx prefix byte
-func (k Keeper) GetOutgoingTx(ctx sdk.Context, storeIndex []byte) (out types.OutgoingTx) {
-	if err := k.cdc.UnmarshalInterface(ctx.KVStore(k.storeKey).Get(types.MakeOutgoingTxKey(storeIndex)), &out); err != nil {
-		panic(err)
+// GetOutgoingTx returns the outgoing tx stored under storeIndex. It returns
+// ErrOutgoingTxNotFound if there is none, rather than panicking, as it is
+// reached from queries and from observed ethereum events.
+func (k Keeper) GetOutgoingTx(ctx sdk.Context, storeIndex []byte) (types.OutgoingTx, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeOutgoingTxKey(storeIndex))
+	if bz == nil {
+		return nil, sdkerrors.Wrapf(types.ErrOutgoingTxNotFound, "%X", storeIndex)
 	}
-	return out
+
+	var otx types.OutgoingTx
+	if err := k.cdc.UnmarshalInterface(bz, &otx); err != nil {
+		return nil, sdkerrors.Wrapf(err, "unmarshal outgoing tx %X", storeIndex)
+	}
+	return otx, nil
 }
 
 func (k Keeper) SetOutgoingTx(ctx sdk.Context, outgoing types.OutgoingTx) {
@@ -454,32 +655,42 @@ func (k Keeper) SetOutgoingTx(ctx sdk.Context, outgoing types.OutgoingTx) {
 		types.MakeOutgoingTxKey(outgoing.GetStoreIndex()),
 		k.cdc.MustMarshal(any),
 	)
+	k.setOutgoingTxTimeout(ctx, outgoing)
+	k.setOutgoingTxHeight(ctx, outgoing)
+	k.setPastEthereumSignatureCheckpoint(ctx, outgoing.GetCheckpoint([]byte(k.getGravityID(ctx))))
 }
 
 // DeleteOutgoingTx deletes a given outgoingtx
 func (k Keeper) DeleteOutgoingTx(ctx sdk.Context, storeIndex []byte) {
+	if otx, err := k.GetOutgoingTx(ctx, storeIndex); err == nil {
+		k.deleteOutgoingTxTimeout(ctx, otx)
+		k.deleteOutgoingTxHeight(ctx, otx)
+	}
 	ctx.KVStore(k.storeKey).Delete(types.MakeOutgoingTxKey(storeIndex))
 }
 
-func (k Keeper) PaginateOutgoingTxsByType(ctx sdk.Context, pageReq *query.PageRequest, prefixByte byte, cb func(key []byte, outgoing types.OutgoingTx) bool) (*query.PageResponse, error) {
+// PaginateOutgoingTxsByType paginates over a specific type of outgoing transaction denoted by the
+// chosen prefix byte, skipping txs rejected by the optional filter
+func (k Keeper) PaginateOutgoingTxsByType(ctx sdk.Context, pageReq *query.PageRequest, prefixByte byte, filter func(outgoing types.OutgoingTx) bool, cb func(key []byte, outgoing types.OutgoingTx) bool) (*query.PageResponse, error) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeOutgoingTxKey([]byte{prefixByte}))
 
 	return query.FilteredPaginate(prefixStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
-		if !accumulate {
-			return false, nil
-		}
-
 		var any cdctypes.Any
 		k.cdc.MustUnmarshal(value, &any)
 		var otx types.OutgoingTx
 		if err := k.cdc.UnpackAny(&any, &otx); err != nil {
 			panic(err)
 		}
+
+		// filtered out txs must not count towards the page offset either
+		if filter != nil && !filter(otx) {
+			return false, nil
+		}
 		if accumulate {
 			return cb(key, otx), nil
 		}
 
-		return false, nil
+		return true, nil
 	})
 }
 
@@ -536,29 +747,101 @@ func (k Keeper) setLastObservedSignerSetTx(ctx sdk.Context, signerSet types.Sign
 	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&signerSet))
 }
 
-// CreateContractCallTx xxx
+// CreateContractCallTx creates and stores a contract call tx, it fails if the
+// payload is larger than the maximum contract call payload size. With a
+// refundee, an account address or a module name, the tokens and fees are
+// escrowed from it and refunded if the call times out or is invalidated.
 func (k Keeper) CreateContractCallTx(ctx sdk.Context, invalidationNonce uint64, invalidationScope tmbytes.HexBytes,
-	address common.Address, payload []byte, tokens []types.ERC20Token, fees []types.ERC20Token) *types.ContractCallTx {
+	address common.Address, payload []byte, tokens []types.ERC20Token, fees []types.ERC20Token, refundee string) (*types.ContractCallTx, error) {
 	params := k.GetParams(ctx)
+	if params.MaxContractCallPayloadBytes != 0 && uint64(len(payload)) > params.MaxContractCallPayloadBytes {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "contract call payload of %d bytes exceeds the maximum of %d", len(payload), params.MaxContractCallPayloadBytes)
+	}
 
 	newContractCallTx := &types.ContractCallTx{
 		InvalidationNonce: invalidationNonce,
 		InvalidationScope: invalidationScope,
 		Address:           address.String(),
 		Payload:           payload,
-		Timeout:           k.getTimeoutHeight(ctx),
+		Timeout:           k.getTimeoutHeight(ctx, params),
 		Tokens:            tokens,
 		Fees:              fees,
 		Height:            uint64(ctx.BlockHeight()),
+		Refundee:          refundee,
 	}
+	if err := k.escrowContractCallTx(ctx, newContractCallTx); err != nil {
+		return nil, err
+	}
+
+	k.storeContractCallTx(ctx, params, newContractCallTx, address.String(), string(payload))
+	return newContractCallTx, nil
+}
+
+// CreateMultiContractCallTx creates and stores a contract call tx executing
+// the calls in order and atomically, the fees paying for all of them. It fails
+// if the payloads together are larger than the maximum contract call payload
+// size. The tokens and fees are escrowed from the refundee as by
+// CreateContractCallTx and the tokens are transferred to the contract of the
+// first call. The wei values of the calls are paid by the relayer.
+func (k Keeper) CreateMultiContractCallTx(ctx sdk.Context, invalidationNonce uint64, invalidationScope tmbytes.HexBytes,
+	calls []types.ContractCall, tokens []types.ERC20Token, fees []types.ERC20Token, refundee string) (*types.ContractCallTx, error) {
+	if len(calls) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "multi contract call without calls")
+	}
+
+	var (
+		payloadBytes uint64
+		addresses    []string
+		payloads     []string
+	)
+	for i, call := range calls {
+		if err := types.ValidateEthAddress(call.Address); err != nil {
+			return nil, sdkerrors.Wrapf(err, "call %d", i)
+		}
+		if call.Value.IsNil() {
+			calls[i].Value = sdk.ZeroInt()
+		} else if call.Value.IsNegative() {
+			return nil, sdkerrors.Wrapf(types.ErrInvalid, "call %d has a negative value", i)
+		}
+		payloadBytes += uint64(len(call.Payload))
+		addresses = append(addresses, call.Address)
+		payloads = append(payloads, string(call.Payload))
+	}
+
+	params := k.GetParams(ctx)
+	if params.MaxContractCallPayloadBytes != 0 && payloadBytes > params.MaxContractCallPayloadBytes {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "contract call payloads of %d bytes exceed the maximum of %d", payloadBytes, params.MaxContractCallPayloadBytes)
+	}
+
+	newContractCallTx := &types.ContractCallTx{
+		InvalidationNonce: invalidationNonce,
+		InvalidationScope: invalidationScope,
+		Timeout:           k.getTimeoutHeight(ctx, params),
+		Tokens:            tokens,
+		Fees:              fees,
+		Height:            uint64(ctx.BlockHeight()),
+		Calls:             calls,
+		Refundee:          refundee,
+	}
+	if err := k.escrowContractCallTx(ctx, newContractCallTx); err != nil {
+		return nil, err
+	}
+
+	k.storeContractCallTx(ctx, params, newContractCallTx, strings.Join(addresses, "|"), strings.Join(payloads, "|"))
+	return newContractCallTx, nil
+}
 
+// storeContractCallTx stores a new contract call tx and emits the event
+// requesting its signatures, the addresses and payloads of multi calls being
+// joined
+func (k Keeper) storeContractCallTx(ctx sdk.Context, params types.Params, contractCallTx *types.ContractCallTx, address, payload string) {
 	var tokenString []string
-	for _, token := range tokens {
+	for _, token := range contractCallTx.Tokens {
 		tokenString = append(tokenString, token.String())
 	}
 
 	var feeString []string
-	for _, fee := range fees {
+	for _, fee := range contractCallTx.Fees {
 		feeString = append(feeString, fee.String())
 	}
 
@@ -568,29 +851,28 @@ func (k Keeper) CreateContractCallTx(ctx sdk.Context, invalidationNonce uint64,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
 			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
-			sdk.NewAttribute(types.AttributeKeyContractCallInvalidationNonce, fmt.Sprint(invalidationNonce)),
-			sdk.NewAttribute(types.AttributeKeyContractCallInvalidationScope, fmt.Sprint(invalidationScope)),
-			sdk.NewAttribute(types.AttributeKeyContractCallAddress, fmt.Sprint(address.String())),
-			sdk.NewAttribute(types.AttributeKeyContractCallPayload, string(payload)),
+			sdk.NewAttribute(types.AttributeKeyContractCallInvalidationNonce, fmt.Sprint(contractCallTx.InvalidationNonce)),
+			sdk.NewAttribute(types.AttributeKeyContractCallInvalidationScope, fmt.Sprint(contractCallTx.InvalidationScope)),
+			sdk.NewAttribute(types.AttributeKeyContractCallAddress, address),
+			sdk.NewAttribute(types.AttributeKeyContractCallPayload, payload),
 			sdk.NewAttribute(types.AttributeKeyContractCallTokens, strings.Join(tokenString, "|")),
 			sdk.NewAttribute(types.AttributeKeyContractCallFees, strings.Join(feeString, "|")),
 			sdk.NewAttribute(types.AttributeKeyEthTxTimeout, strconv.FormatUint(params.TargetEthTxTimeout, 10)),
 		),
 	)
-	k.SetOutgoingTx(ctx, newContractCallTx)
+	k.SetOutgoingTx(ctx, contractCallTx)
 	k.Logger(ctx).Info(
 		"ContractCallTx created",
 		"bridge_contract", k.getBridgeContractAddress(ctx),
 		"bridge_chain_id", strconv.Itoa(int(k.getBridgeChainID(ctx))),
-		"invalidation_nonce", newContractCallTx.InvalidationNonce,
-		"invalidation_scope", newContractCallTx.InvalidationScope,
-		"address", address.String(),
-		"payload", string(payload),
+		"invalidation_nonce", contractCallTx.InvalidationNonce,
+		"invalidation_scope", contractCallTx.InvalidationScope,
+		"address", address,
+		"payload", payload,
 		"tokens", strings.Join(tokenString, "|"),
 		"fees", strings.Join(feeString, "|"),
 		"eth_tx_timeout", strconv.FormatUint(params.TargetEthTxTimeout, 10),
 	)
-	return newContractCallTx
 }
 
 //////////////////////////////////////
@@ -617,11 +899,14 @@ func (k Keeper) GetEthereumHeightVote(ctx sdk.Context, valAddress sdk.ValAddress
 
 // SetEthereumHeightVoteRecord sets the latest observed heights per validator
 func (k Keeper) SetEthereumHeightVote(ctx sdk.Context, valAddress sdk.ValAddress, ethereumHeight uint64) {
-	store := ctx.KVStore(k.storeKey)
-	height := types.LatestEthereumBlockHeight{
+	k.setEthereumHeightVote(ctx, valAddress, types.LatestEthereumBlockHeight{
 		EthereumHeight: ethereumHeight,
 		CosmosHeight:   uint64(ctx.BlockHeight()),
-	}
+	})
+}
+
+func (k Keeper) setEthereumHeightVote(ctx sdk.Context, valAddress sdk.ValAddress, height types.LatestEthereumBlockHeight) {
+	store := ctx.KVStore(k.storeKey)
 	key := types.MakeEthereumHeightVoteKey(valAddress)
 	store.Set(key, k.cdc.MustMarshal(&height))
 }
@@ -643,6 +928,47 @@ func (k Keeper) IterateEthereumHeightVotes(ctx sdk.Context, cb func(val sdk.ValA
 	}
 }
 
+// medianEthereumHeightVote returns the power weighted median of the ethereum
+// heights voted by bonded validators within the window of cosmos blocks, if
+// any were voted
+func (k Keeper) medianEthereumHeightVote(ctx sdk.Context, window uint64) (uint64, bool) {
+	type heightPower struct {
+		height uint64
+		power  int64
+	}
+
+	var (
+		votes      []heightPower
+		totalPower int64
+	)
+	k.IterateEthereumHeightVotes(ctx, func(val sdk.ValAddress, height types.LatestEthereumBlockHeight) bool {
+		if height.CosmosHeight+window <= uint64(ctx.BlockHeight()) {
+			return false
+		}
+		if power := k.StakingKeeper.GetLastValidatorPower(ctx, val); power > 0 {
+			votes = append(votes, heightPower{height.EthereumHeight, power})
+			totalPower += power
+		}
+		return false
+	})
+	if len(votes) == 0 {
+		return 0, false
+	}
+
+	sort.SliceStable(votes, func(i, j int) bool {
+		return votes[i].height < votes[j].height
+	})
+
+	var power int64
+	for _, vote := range votes {
+		power += vote.power
+		if 2*power >= totalPower {
+			return vote.height, true
+		}
+	}
+	return votes[len(votes)-1].height, true
+}
+
 // DeleteEthereumSignatures deletes the ethereum signatures for a specific outgoing tx
 func (k Keeper) DeleteEthereumSignatures(ctx sdk.Context, otx types.OutgoingTx) {
 	prefixStoreSig := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.EthereumSignatureKey}, otx.GetStoreIndex()...))
@@ -658,28 +984,29 @@ func (k Keeper) DeleteEthereumSignatures(ctx sdk.Context, otx types.OutgoingTx)
 // MIGRATE     //
 /////////////////
 
+// gravityContractCleanupBudget is the number of event vote records of the previous gravity contract checked for
+// deletion per block, the rest is left to the following blocks
+const gravityContractCleanupBudget = 100
+
 // Clean up all state associated a previous gravity contract and set a new contract. This is intended to run in the upgrade handler.
 // This implementation is partial at best. It doees not contain necessary functionality to freeze the bridge.
 // We will have yet to implement functionality to Migrate the Cosmos ERC20 tokens or any other ERC20 tokens bridged to the gravity contracts.
 // This just does keeper state cleanup if a new gravity contract has been deployed
+//
+// The outgoing txs are deleted right away, as the nonces they were created with are reset and reused by the txs
+// of the new contract. The event vote records of the previous contract are deleted by
+// CleanupPreviousGravityContract over the following blocks.
 func (k Keeper) MigrateGravityContract(ctx sdk.Context, newBridgeAddress string, bridgeDeploymentHeight uint64) {
 	// Delete Any Outgoing TXs.
-
-	prefixStoreOtx := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.OutgoingTxKey})
-	iterOtx := prefixStoreOtx.ReverseIterator(nil, nil)
-	defer iterOtx.Close()
-	for ; iterOtx.Valid(); iterOtx.Next() {
-
-		var any cdctypes.Any
-		k.cdc.MustUnmarshal(iterOtx.Value(), &any)
-		var otx types.OutgoingTx
-		if err := k.cdc.UnpackAny(&any, &otx); err != nil {
-			panic(err)
-		}
+	var otxs []types.OutgoingTx
+	k.iterateOutgoingTxs(ctx, func(_ []byte, otx types.OutgoingTx) bool {
+		otxs = append(otxs, otx)
+		return false
+	})
+	for _, otx := range otxs {
 		// Delete any partial Eth Signatures handging around
 		k.DeleteEthereumSignatures(ctx, otx)
-
-		prefixStoreOtx.Delete(iterOtx.Key())
+		k.DeleteOutgoingTx(ctx, otx.GetStoreIndex())
 	}
 
 	// Reset the last observed signer set nonce
@@ -688,28 +1015,21 @@ func (k Keeper) MigrateGravityContract(ctx sdk.Context, newBridgeAddress string,
 
 	// Reset all ethereum event nonces to zero
 	k.setLastObservedEventNonce(ctx, 0)
-	k.iterateEthereumEventVoteRecords(ctx, func(_ []byte, voteRecord *types.EthereumEventVoteRecord) bool {
-		for _, vote := range voteRecord.Votes {
-			val, err := sdk.ValAddressFromBech32(vote)
-
-			if err != nil {
-				panic(err)
-			}
-
-			k.setLastEventNonceByValidator(ctx, val, 0)
-		}
-
+	var voters []sdk.ValAddress
+	k.iterateLastEventNonceByValidator(ctx, func(val sdk.ValAddress, _ uint64) bool {
+		voters = append(voters, val)
 		return false
 	})
-
-	// Delete all Ethereum Events
-	prefixStoreEthereumEvent := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventVoteRecordKey})
-	iterEvent := prefixStoreEthereumEvent.Iterator(nil, nil)
-	defer iterEvent.Close()
-	for ; iterEvent.Valid(); iterEvent.Next() {
-		prefixStoreEthereumEvent.Delete(iterEvent.Key())
+	for _, val := range voters {
+		k.setLastEventNonceByValidator(ctx, val, 0)
 	}
 
+	// Delete all Ethereum Events, starting from the first record
+	k.setGravityContractCleanup(ctx, bridgeDeploymentHeight, []byte{})
+
+	// Restart the log of observed events, whose nonces are reused
+	k.resetObservedEthereumEvents(ctx)
+
 	// Set the Last oberved Ethereum Blockheight to zero
 	height := types.LatestEthereumBlockHeight{
 		EthereumHeight: (bridgeDeploymentHeight - 1),
@@ -733,6 +1053,87 @@ func (k Keeper) MigrateGravityContract(ctx sdk.Context, newBridgeAddress string,
 	k.SetParams(ctx, params)
 }
 
+// CleanupPreviousGravityContract deletes the event vote records of the gravity contract replaced by
+// MigrateGravityContract, those of events emitted before the new contract was deployed. At most
+// gravityContractCleanupBudget records are read per call, the cursor the next call resumes from is kept in state.
+// It returns true once the cleanup is done, the event vote records must not be tallied before.
+func (k Keeper) CleanupPreviousGravityContract(ctx sdk.Context) (done bool) {
+	bridgeDeploymentHeight, cursor, pending := k.getGravityContractCleanup(ctx)
+	if !pending {
+		return true
+	}
+
+	if len(cursor) == 0 {
+		cursor = nil
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventVoteRecordKey})
+	iter := store.Iterator(cursor, nil)
+
+	// collect first, the store can't be written while it is being iterated
+	var stale [][]byte
+	for read := 0; iter.Valid() && read < gravityContractCleanupBudget; iter.Next() {
+		read++
+		var record types.EthereumEventVoteRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		event, err := types.UnpackEvent(record.Event)
+		if err != nil || event.GetEthereumHeight() < bridgeDeploymentHeight {
+			stale = append(stale, iter.Key())
+		}
+	}
+	var next []byte
+	if iter.Valid() {
+		next = append([]byte{}, iter.Key()...)
+	}
+	iter.Close()
+
+	for _, key := range stale {
+		store.Delete(key)
+	}
+
+	if next == nil {
+		ctx.KVStore(k.storeKey).Delete([]byte{types.GravityContractCleanupKey})
+		return true
+	}
+
+	k.setGravityContractCleanup(ctx, bridgeDeploymentHeight, next)
+	telemetry.IncrCounter(1, types.ModuleName, "iteration_budget_exhausted", "gravity_contract_cleanup")
+	k.Logger(ctx).Info("gravity contract cleanup budget exhausted, resuming next block", "deleted", len(stale))
+	return false
+}
+
+// setGravityContractCleanup records the deployment height of the new gravity contract and the key, without the
+// event vote record prefix, the cleanup of the event vote records of the previous one resumes from
+func (k Keeper) setGravityContractCleanup(ctx sdk.Context, bridgeDeploymentHeight uint64, cursor []byte) {
+	ctx.KVStore(k.storeKey).Set(
+		[]byte{types.GravityContractCleanupKey},
+		append(sdk.Uint64ToBigEndian(bridgeDeploymentHeight), cursor...),
+	)
+}
+
+func (k Keeper) getGravityContractCleanup(ctx sdk.Context) (bridgeDeploymentHeight uint64, cursor []byte, pending bool) {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.GravityContractCleanupKey})
+	if bz == nil {
+		return 0, nil, false
+	}
+	return sdk.BigEndianToUint64(bz[:8]), bz[8:], true
+}
+
+// IsInboundEnabled reports whether deposits from ethereum are credited
+func (k Keeper) IsInboundEnabled(ctx sdk.Context) bool {
+	return inboundEnabled(k.GetParams(ctx))
+}
+
+func inboundEnabled(params types.Params) bool {
+	return params.BridgeActive && params.InboundEnabled
+}
+
+// IsOutboundEnabled reports whether sends to ethereum are accepted and batched
+func (k Keeper) IsOutboundEnabled(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	return params.BridgeActive && params.OutboundEnabled
+}
+
 // DisableBridge disable the bridge processing all outgoing and ingoing transactions
 func (k Keeper) DisableBridge(ctx sdk.Context) {
 	gravityParam := k.GetParams(ctx)
@@ -253,6 +253,116 @@ func TestLastSlashedValsetNonce(t *testing.T) {
 	assert.Equal(t, 6, len(unslashedValsets))
 }
 
+func TestUnSlashedOutgoingTxsBudget(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+
+	total := outgoingTxSlashingBudget + 10
+	for i := 1; i <= total; i++ {
+		ctx = ctx.WithBlockHeight(int64(i))
+		k.CreateSignerSetTx(ctx)
+	}
+	// a second tx at the height the budget runs out at must not be split off
+	k.CreateSignerSetTx(ctx.WithBlockHeight(int64(outgoingTxSlashingBudget)))
+
+	unslashed := k.GetUnSlashedOutgoingTxs(ctx, uint64(total+1))
+	require.Len(t, unslashed, outgoingTxSlashingBudget+1)
+	for i := 1; i < len(unslashed); i++ {
+		require.LessOrEqual(t, unslashed[i-1].GetCosmosHeight(), unslashed[i].GetCosmosHeight())
+	}
+	require.Equal(t, uint64(outgoingTxSlashingBudget), unslashed[len(unslashed)-1].GetCosmosHeight())
+
+	// the last slashed height acts as the cursor for the following block
+	k.SetLastSlashedOutgoingTxBlockHeight(ctx, uint64(outgoingTxSlashingBudget))
+	unslashed = k.GetUnSlashedOutgoingTxs(ctx, uint64(total+1))
+	require.Len(t, unslashed, 10)
+}
+
+func TestReindexOutgoingTxHeights(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+
+	var otxs []types.OutgoingTx
+	for i := 1; i <= 3; i++ {
+		otxs = append(otxs, k.CreateSignerSetTx(ctx.WithBlockHeight(int64(i))))
+	}
+	require.Len(t, k.GetUnSlashedOutgoingTxs(ctx, 4), 3)
+
+	// txs created before the index existed are only found once reindexed
+	for _, otx := range otxs {
+		k.deleteOutgoingTxHeight(ctx, otx)
+	}
+	require.Empty(t, k.GetUnSlashedOutgoingTxs(ctx, 4))
+
+	k.reindexOutgoingTxHeights(ctx)
+	require.Len(t, k.GetUnSlashedOutgoingTxs(ctx, 4), 3)
+}
+
+func TestCleanupPreviousGravityContract(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+
+	const bridgeDeploymentHeight = 1000
+	record := func(nonce, ethereumHeight uint64) *types.SendToCosmosEvent {
+		event := &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  EthAddrs[0].Hex(),
+			Amount:         sdk.NewInt(1),
+			EthereumSender: EthAddrs[1].Hex(),
+			CosmosReceiver: AccAddrs[0].String(),
+			EthereumHeight: ethereumHeight,
+		}
+		packed, err := types.PackEvent(event)
+		require.NoError(t, err)
+		k.setEthereumEventVoteRecord(ctx, nonce, event.Hash(), &types.EthereumEventVoteRecord{Event: packed})
+		return event
+	}
+
+	var stale []*types.SendToCosmosEvent
+	for nonce := uint64(1); nonce <= gravityContractCleanupBudget+10; nonce++ {
+		stale = append(stale, record(nonce, 10))
+	}
+
+	k.MigrateGravityContract(ctx, "0x5e175bE4d23Fa25604CE7848F60FB340894D5CDA", bridgeDeploymentHeight)
+	// an event of the new contract observed while the cleanup is in progress
+	fresh := record(1, bridgeDeploymentHeight)
+
+	// the records are deleted over several blocks
+	require.False(t, k.CleanupPreviousGravityContract(ctx))
+	require.True(t, k.CleanupPreviousGravityContract(ctx))
+	require.True(t, k.CleanupPreviousGravityContract(ctx))
+
+	for _, event := range stale {
+		require.Nil(t, k.GetEthereumEventVoteRecord(ctx, event.EventNonce, event.Hash()))
+	}
+	require.NotNil(t, k.GetEthereumEventVoteRecord(ctx, fresh.EventNonce, fresh.Hash()))
+}
+
+func TestGetValidatorsByEthereumAddress(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	var (
+		val1     = sdk.ValAddress([]byte("validator1__________"))
+		val2     = sdk.ValAddress([]byte("validator2__________"))
+		ethAddr1 = common.HexToAddress("0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09")
+		ethAddr2 = common.HexToAddress("0x610277F0208D342C576b991daFdCb36E36515e76")
+	)
+
+	k.setValidatorEthereumAddress(ctx, val1, ethAddr1)
+	k.setValidatorEthereumAddress(ctx, val2, ethAddr1)
+	require.ElementsMatch(t, []sdk.ValAddress{val1, val2}, k.getValidatorsByEthereumAddress(ctx, ethAddr1))
+
+	// rotating a key drops the validator from the old address
+	k.setValidatorEthereumAddress(ctx, val1, ethAddr2)
+	require.Equal(t, []sdk.ValAddress{val2}, k.getValidatorsByEthereumAddress(ctx, ethAddr1))
+	require.Equal(t, []sdk.ValAddress{val1}, k.getValidatorsByEthereumAddress(ctx, ethAddr2))
+}
+
 // ---
 
 func TestKeeper_GetLatestSignerSetTx(t *testing.T) {
@@ -286,6 +396,45 @@ func TestKeeper_GetLatestSignerSetTx(t *testing.T) {
 	})
 }
 
+func TestKeeper_GetOutgoingTx(t *testing.T) {
+	var (
+		env           = CreateTestEnv(t)
+		ctx           = env.Context
+		gk            = env.GravityKeeper
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		missing       = types.MakeBatchTxKey(tokenContract, 1)
+		corrupted     = types.MakeBatchTxKey(tokenContract, 2)
+	)
+	ctx.KVStore(env.GravityStoreKey).Set(types.MakeOutgoingTxKey(corrupted), []byte("not an outgoing tx"))
+
+	_, err := gk.GetOutgoingTx(ctx, missing)
+	require.ErrorIs(t, err, types.ErrOutgoingTxNotFound)
+	_, err = gk.GetOutgoingTx(ctx, corrupted)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, types.ErrOutgoingTxNotFound)
+
+	// queries fail instead of panicking
+	_, err = gk.BatchTx(sdk.WrapSDKContext(ctx), &types.BatchTxRequest{TokenContract: tokenContract.Hex(), BatchNonce: 1})
+	require.Error(t, err)
+	_, err = gk.BatchTx(sdk.WrapSDKContext(ctx), &types.BatchTxRequest{TokenContract: tokenContract.Hex(), BatchNonce: 2})
+	require.Error(t, err)
+	_, err = gk.BatchTxCheckpoint(sdk.WrapSDKContext(ctx), &types.BatchTxRequest{TokenContract: tokenContract.Hex(), BatchNonce: 2})
+	require.Error(t, err)
+	res, err := gk.SignerSetTx(sdk.WrapSDKContext(ctx), &types.SignerSetTxRequest{SignerSetNonce: 1})
+	require.NoError(t, err)
+	require.Nil(t, res.SignerSet)
+
+	// the execution of an unknown batch is ignored, that of an unreadable one fails
+	require.NoError(t, gk.batchTxExecuted(ctx, gk.GetParams(ctx), tokenContract, 1, ""))
+	require.NoError(t, gk.Handle(ctx, &types.BatchExecutedEvent{TokenContract: tokenContract.Hex(), EventNonce: 1, BatchNonce: 1}))
+	require.Error(t, gk.batchTxExecuted(ctx, gk.GetParams(ctx), tokenContract, 2, ""))
+
+	// as is that of an unknown contract call
+	completedCallTx, err := gk.contractCallExecuted(ctx, []byte("scope"), 1)
+	require.NoError(t, err)
+	require.Nil(t, completedCallTx)
+}
+
 func TestKeeper_GetSignerSetTxs(t *testing.T) {
 	t.Run("read before there's any in state", func(t *testing.T) {
 		env := CreateTestEnv(t)
@@ -579,7 +728,8 @@ func TestKeeper_Migration(t *testing.T) {
 	firstBatch := input.GravityKeeper.BuildBatchTx(ctx, myTokenContractAddr, 2)
 
 	// then batch is persisted
-	gotFirstBatch := input.GravityKeeper.GetOutgoingTx(ctx, firstBatch.GetStoreIndex())
+	gotFirstBatch, err := input.GravityKeeper.GetOutgoingTx(ctx, firstBatch.GetStoreIndex())
+	require.NoError(t, err)
 	require.NotNil(t, gotFirstBatch)
 
 	gk.setEthereumEventVoteRecord(ctx, stce.GetEventNonce(), stce.Hash(), evr)
@@ -636,6 +786,7 @@ func TestKeeper_Migration(t *testing.T) {
 	}
 
 	gk.MigrateGravityContract(ctx, "0x5e175bE4d23Fa25604CE7848F60FB340894D5CDA", 1000)
+	require.True(t, gk.CleanupPreviousGravityContract(ctx))
 
 	storedAfterMigrate := gk.GetEthereumEventVoteRecord(ctx, stce.GetEventNonce(), stce.Hash())
 	require.Nil(t, storedAfterMigrate)
@@ -664,3 +815,319 @@ func TestKeeper_Migration(t *testing.T) {
 // TODO(levi) review/ensure coverage for:
 // PaginateOutgoingTxsByType
 // GetUnbondingvalidators(unbondingVals []byte) stakingtypes.ValAddresses
+
+type oracleFunc func(ctx sdk.Context, event types.EthereumEvent, record *types.EthereumEventVoteRecord) bool
+
+func (f oracleFunc) IsObserved(ctx sdk.Context, event types.EthereumEvent, record *types.EthereumEventVoteRecord) bool {
+	return f(ctx, event, record)
+}
+
+func TestKeeper_Oracle(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+	gk.StakingKeeper = NewStakingKeeperMock(ValAddrs[0], ValAddrs[1], ValAddrs[2])
+
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  EthAddrs[0].Hex(),
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[1].Hex(),
+		CosmosReceiver: AccAddrs[0].String(),
+		EthereumHeight: 10,
+	}
+	record, err := gk.recordEventVote(ctx, event, ValAddrs[0])
+	require.NoError(t, err)
+
+	// a third of the voting power is not enough for the default voting oracle
+	gk.TryEventVoteRecord(ctx, gk.GetParams(ctx), record)
+	require.False(t, record.Accepted)
+	require.Zero(t, gk.GetLastObservedEventNonce(ctx))
+
+	// another oracle decides on the same stored vote records
+	gk.SetOracle(oracleFunc(func(_ sdk.Context, observed types.EthereumEvent, record *types.EthereumEventVoteRecord) bool {
+		return observed.GetEventNonce() == event.EventNonce && len(record.Votes) == 1
+	}))
+	gk.TryEventVoteRecord(ctx, gk.GetParams(ctx), record)
+	require.True(t, record.Accepted)
+	require.Equal(t, uint64(1), gk.GetLastObservedEventNonce(ctx))
+	require.Equal(t, sdk.NewInt(100), env.BankKeeper.GetAllBalances(ctx, AccAddrs[0]).AmountOf(types.GravityDenom(EthAddrs[0])))
+
+	require.Panics(t, func() { gk.SetOracle(types.NewVotingOracle(gk.StakingKeeper, gk.GetEventVotePowerThreshold)) })
+}
+
+type observedRecordingHooks struct {
+	types.GravityHooks
+
+	signerSets []uint64
+	events     []uint64
+}
+
+func (h *observedRecordingHooks) AfterSignerSetExecutedEvent(sdk.Context, types.SignerSetTxExecutedEvent) {
+}
+
+func (h *observedRecordingHooks) AfterSendToCosmosEvent(sdk.Context, types.SendToCosmosEvent) {
+}
+
+func (h *observedRecordingHooks) AfterSignerSetObserved(_ sdk.Context, event types.SignerSetTxExecutedEvent) {
+	h.signerSets = append(h.signerSets, event.SignerSetTxNonce)
+}
+
+func (h *observedRecordingHooks) AfterEventObserved(_ sdk.Context, event types.EthereumEvent) {
+	h.events = append(h.events, event.GetEventNonce())
+}
+
+func TestKeeper_ObservedEventHooks(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+	hooks := &observedRecordingHooks{}
+	gk.SetHooks(hooks)
+	gk.SetOracle(oracleFunc(func(sdk.Context, types.EthereumEvent, *types.EthereumEventVoteRecord) bool {
+		return true
+	}))
+
+	events := []types.EthereumEvent{
+		&types.SendToCosmosEvent{
+			EventNonce:     1,
+			TokenContract:  EthAddrs[0].Hex(),
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[1].Hex(),
+			CosmosReceiver: AccAddrs[0].String(),
+			EthereumHeight: 10,
+		},
+		&types.SignerSetTxExecutedEvent{
+			EventNonce:       2,
+			SignerSetTxNonce: 1,
+			EthereumHeight:   11,
+		},
+	}
+	for _, event := range events {
+		record, err := gk.recordEventVote(ctx, event, ValAddrs[0])
+		require.NoError(t, err)
+		gk.TryEventVoteRecord(ctx, gk.GetParams(ctx), record)
+		require.True(t, record.Accepted)
+	}
+
+	require.Equal(t, []uint64{1, 2}, hooks.events)
+	require.Equal(t, []uint64{1}, hooks.signerSets)
+}
+
+func TestKeeper_EventVotePowerThreshold(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+	gk.StakingKeeper = NewStakingKeeperMock(ValAddrs[0], ValAddrs[1], ValAddrs[2])
+
+	params := gk.GetParams(ctx)
+	params.EventVotePowerThreshold = sdk.NewDecWithPrec(9, 1)
+	gk.SetParams(ctx, params)
+
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  EthAddrs[0].Hex(),
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[1].Hex(),
+		CosmosReceiver: AccAddrs[0].String(),
+		EthereumHeight: 10,
+	}
+	var (
+		record *types.EthereumEventVoteRecord
+		err    error
+	)
+	for _, val := range ValAddrs[:2] {
+		record, err = gk.recordEventVote(ctx, event, val)
+		require.NoError(t, err)
+	}
+
+	// two thirds of the voting power is not enough for a 90% threshold
+	gk.TryEventVoteRecord(ctx, gk.GetParams(ctx), record)
+	require.False(t, record.Accepted)
+	require.Zero(t, gk.GetLastObservedEventNonce(ctx))
+
+	record, err = gk.recordEventVote(ctx, event, ValAddrs[2])
+	require.NoError(t, err)
+	gk.TryEventVoteRecord(ctx, gk.GetParams(ctx), record)
+	require.True(t, record.Accepted)
+	require.Equal(t, uint64(1), gk.GetLastObservedEventNonce(ctx))
+
+	// the threshold is bounded
+	params.EventVotePowerThreshold = sdk.NewDecWithPrec(5, 1)
+	require.Panics(t, func() { gk.SetParams(ctx, params) })
+}
+
+func TestKeeper_EthereumEventConfirmationDepth(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+	gk.StakingKeeper = NewStakingKeeperMock(ValAddrs[0], ValAddrs[1], ValAddrs[2])
+
+	params := gk.GetParams(ctx)
+	params.EthereumEventConfirmationDepth = 10
+	gk.SetParams(ctx, params)
+
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  EthAddrs[0].Hex(),
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[1].Hex(),
+		CosmosReceiver: AccAddrs[0].String(),
+		EthereumHeight: 100,
+	}
+	var (
+		record *types.EthereumEventVoteRecord
+		err    error
+	)
+	for _, val := range ValAddrs[:3] {
+		record, err = gk.recordEventVote(ctx, event, val)
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint64(100), record.EthereumHeight)
+
+	// all the power voted but there are no height votes yet
+	gk.TryEventVoteRecord(ctx, gk.GetParams(ctx), record)
+	require.False(t, record.Accepted)
+
+	// the median height vote must exceed the event height by more than the depth
+	for i, ethereumHeight := range []uint64{105, 110, 200} {
+		gk.setEthereumHeightVote(ctx, ValAddrs[i], types.LatestEthereumBlockHeight{
+			EthereumHeight: ethereumHeight,
+			CosmosHeight:   uint64(ctx.BlockHeight()),
+		})
+	}
+	gk.TryEventVoteRecord(ctx, gk.GetParams(ctx), record)
+	require.False(t, record.Accepted)
+	require.Zero(t, gk.GetLastObservedEventNonce(ctx))
+
+	gk.setEthereumHeightVote(ctx, ValAddrs[1], types.LatestEthereumBlockHeight{
+		EthereumHeight: 111,
+		CosmosHeight:   uint64(ctx.BlockHeight()),
+	})
+	gk.TryEventVoteRecord(ctx, gk.GetParams(ctx), record)
+	require.True(t, record.Accepted)
+	require.Equal(t, uint64(1), gk.GetLastObservedEventNonce(ctx))
+}
+
+func TestTimeoutHeightFromEthereumHeightVotes(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	gk.SetLastObservedEthereumBlockHeightWithCosmos(ctx, 100, uint64(ctx.BlockHeight()))
+	projected := gk.getTimeoutHeight(ctx, gk.GetParams(ctx))
+
+	for i, height := range []uint64{1000, 3000, 2000, 5000, 4000} {
+		gk.SetEthereumHeightVote(ctx, ValAddrs[i], height)
+	}
+
+	// the height votes are ignored until a margin is set
+	require.Equal(t, projected, gk.getTimeoutHeight(ctx, gk.GetParams(ctx)))
+
+	params := gk.GetParams(ctx)
+	params.TimeoutEthereumBlockMargin = 3600
+	gk.SetParams(ctx, params)
+	require.Equal(t, uint64(3000+3600), gk.getTimeoutHeight(ctx, gk.GetParams(ctx)))
+
+	// votes older than the window don't count towards the median
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.TimeoutHeightVoteWindow) - 1)
+	gk.SetEthereumHeightVote(ctx, ValAddrs[0], 6000)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.Equal(t, uint64(6000+3600), gk.getTimeoutHeight(ctx, gk.GetParams(ctx)))
+
+	// without recent votes the timeout is projected again
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.TimeoutHeightVoteWindow))
+	require.Less(t, gk.getTimeoutHeight(ctx, gk.GetParams(ctx)), uint64(6000))
+}
+
+func TestKeeper_BridgeDirectionEnabled(t *testing.T) {
+	var (
+		input         = CreateTestEnv(t)
+		ctx           = input.Context
+		gk            = input.GravityKeeper
+		msgServer     = NewMsgServerImpl(gk)
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom         = types.GravityDenom(tokenContract)
+		receiver      = AccAddrs[0]
+	)
+
+	params := gk.GetParams(ctx)
+	params.InboundEnabled = false
+	gk.SetParams(ctx, params)
+	require.False(t, gk.IsInboundEnabled(ctx))
+	require.True(t, gk.IsOutboundEnabled(ctx))
+
+	// deposits observed while inbound is disabled wait in the mint queue
+	require.NoError(t, gk.Handle(ctx, &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  tokenContract.Hex(),
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: receiver.String(),
+		EthereumHeight: 10,
+	}))
+	require.True(t, input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.IsZero())
+	require.True(t, gk.hasQueuedSendToCosmos(ctx, tokenContract))
+
+	params.InboundEnabled = true
+	gk.SetParams(ctx, params)
+
+	gk.DrainMintQueue(ctx)
+	require.Equal(t, int64(100), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+	require.False(t, gk.hasQueuedSendToCosmos(ctx, tokenContract))
+
+	send := func() error {
+		_, err := msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), &types.MsgSendToEthereum{
+			Sender:            receiver.String(),
+			EthereumRecipient: EthAddrs[1].Hex(),
+			Amount:            sdk.NewCoin(denom, sdk.NewInt(10)),
+			BridgeFee:         sdk.NewCoin(denom, sdk.NewInt(1)),
+		})
+		return err
+	}
+	require.NoError(t, send())
+
+	// sends and batches are refused while outbound is disabled
+	params.OutboundEnabled = false
+	gk.SetParams(ctx, params)
+	require.ErrorIs(t, send(), types.ErrBridgeInactive)
+
+	_, err := msgServer.RequestBatchTx(sdk.WrapSDKContext(ctx), &types.MsgRequestBatchTx{
+		Denom:  denom,
+		Signer: receiver.String(),
+	})
+	require.ErrorIs(t, err, types.ErrBridgeInactive)
+}
+
+func TestKeeper_PowerReduction(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	operators := []MockStakingValidatorData{
+		{Operator: ValAddrs[0], Power: 300},
+		{Operator: ValAddrs[1], Power: 100},
+		{Operator: ValAddrs[2], Power: 1},
+	}
+	for i, operator := range operators {
+		gk.setValidatorEthereumAddress(ctx, operator.Operator, EthAddrs[i])
+	}
+	gk.StakingKeeper = NewStakingKeeperWeightedMock(operators...)
+	require.Len(t, gk.CurrentSignerSet(ctx), 3)
+
+	// the power reduction must be a multiple of the staking one
+	require.ErrorIs(t, gk.ValidatePowerReduction(ctx, sdk.DefaultPowerReduction.QuoRaw(2)), types.ErrInvalid)
+	require.ErrorIs(t, gk.ValidatePowerReduction(ctx, sdk.DefaultPowerReduction.MulRaw(3).QuoRaw(2)), types.ErrInvalid)
+	require.NoError(t, gk.ValidatePowerReduction(ctx, sdk.DefaultPowerReduction.MulRaw(100)))
+
+	// a coarser power reduction leaves out the validators below it
+	params := gk.GetParams(ctx)
+	params.PowerReduction = sdk.DefaultPowerReduction.MulRaw(100)
+	gk.SetParams(ctx, params)
+	signers := gk.CurrentSignerSet(ctx)
+	require.Len(t, signers, 2)
+	require.Equal(t, []uint64{3221225471, 1073741823}, signers.GetPowers())
+
+	// the migration sets the staking power reduction
+	require.NoError(t, NewMigrator(gk).Migrate5to6(ctx))
+	require.Equal(t, sdk.DefaultPowerReduction, gk.GetParams(ctx).PowerReduction)
+	require.Len(t, gk.CurrentSignerSet(ctx), 3)
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// GetLastSignatureHeightByValidator returns the height of the last outgoing tx
+// signature of a validator, zero if it never signed one
+func (k Keeper) GetLastSignatureHeightByValidator(ctx sdk.Context, validator sdk.ValAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeLastSignatureHeightByValidatorKey(validator))
+	if len(bz) == 0 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setLastSignatureHeightByValidator(ctx sdk.Context, validator sdk.ValAddress, height uint64) {
+	ctx.KVStore(k.storeKey).Set(types.MakeLastSignatureHeightByValidatorKey(validator), sdk.Uint64ToBigEndian(height))
+}
+
+// GetLastEventVoteHeightByValidator returns the height of the last ethereum
+// event vote of a validator, zero if it never voted on one
+func (k Keeper) GetLastEventVoteHeightByValidator(ctx sdk.Context, validator sdk.ValAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeLastEventVoteHeightByValidatorKey(validator))
+	if len(bz) == 0 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setLastEventVoteHeightByValidator(ctx sdk.Context, validator sdk.ValAddress, height uint64) {
+	ctx.KVStore(k.storeKey).Set(types.MakeLastEventVoteHeightByValidatorKey(validator), sdk.Uint64ToBigEndian(height))
+}
+
+// GetMissedSignaturesByValidator returns the number of outgoing txs a
+// validator left unsigned past their signing window since it last signed one
+func (k Keeper) GetMissedSignaturesByValidator(ctx sdk.Context, validator sdk.ValAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeMissedSignaturesByValidatorKey(validator))
+	if len(bz) == 0 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setMissedSignaturesByValidator(ctx sdk.Context, validator sdk.ValAddress, missed uint64) {
+	if missed == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.MakeMissedSignaturesByValidatorKey(validator))
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.MakeMissedSignaturesByValidatorKey(validator), sdk.Uint64ToBigEndian(missed))
+}
+
+// IncrementMissedSignaturesByValidator counts an outgoing tx the validator
+// left unsigned past its signing window
+func (k Keeper) IncrementMissedSignaturesByValidator(ctx sdk.Context, validator sdk.ValAddress) {
+	k.setMissedSignaturesByValidator(ctx, validator, k.GetMissedSignaturesByValidator(ctx, validator)+1)
+}
+
+// getBridgeValidatorLiveness returns how long ago a validator last took part
+// in the bridge. Blocks since a validator never signed or voted count from
+// the start of the chain.
+func (k Keeper) getBridgeValidatorLiveness(ctx sdk.Context, validator sdk.ValAddress) types.BridgeValidatorLiveness {
+	height := uint64(ctx.BlockHeight())
+	liveness := types.BridgeValidatorLiveness{
+		ValidatorAddress:    validator.String(),
+		LastSignatureHeight: k.GetLastSignatureHeightByValidator(ctx, validator),
+		LastEventVoteHeight: k.GetLastEventVoteHeightByValidator(ctx, validator),
+		LastEventNonce:      k.getLastEventNonceByValidator(ctx, validator),
+		MissedSignatures:    k.GetMissedSignaturesByValidator(ctx, validator),
+	}
+	liveness.BlocksSinceLastSignature = height - liveness.LastSignatureHeight
+	liveness.BlocksSinceLastEventVote = height - liveness.LastEventVoteHeight
+	return liveness
+}
+
+// iterateLastSignatureHeightByValidator iterates over the validators that
+// signed an outgoing tx
+func (k Keeper) iterateLastSignatureHeightByValidator(ctx sdk.Context, cb func(validator sdk.ValAddress, height uint64) (stop bool)) {
+	k.iterateValidatorUint64s(ctx, types.LastSignatureHeightByValidatorKey, cb)
+}
+
+// iterateLastEventVoteHeightByValidator iterates over the validators that
+// voted on an ethereum event
+func (k Keeper) iterateLastEventVoteHeightByValidator(ctx sdk.Context, cb func(validator sdk.ValAddress, height uint64) (stop bool)) {
+	k.iterateValidatorUint64s(ctx, types.LastEventVoteHeightByValidatorKey, cb)
+}
+
+// iterateMissedSignaturesByValidator iterates over the validators that missed
+// outgoing txs since their last signature
+func (k Keeper) iterateMissedSignaturesByValidator(ctx sdk.Context, cb func(validator sdk.ValAddress, missed uint64) (stop bool)) {
+	k.iterateValidatorUint64s(ctx, types.MissedSignaturesByValidatorKey, cb)
+}
+
+// iterateValidatorUint64s iterates over a store of big endian uint64s keyed
+// by the prefix and a validator address
+func (k Keeper) iterateValidatorUint64s(ctx sdk.Context, keyPrefix byte, cb func(validator sdk.ValAddress, value uint64) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{keyPrefix}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(sdk.ValAddress(iter.Key()), binary.BigEndian.Uint64(iter.Value())) {
+			break
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestBridgeValidatorLiveness(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		Amount:         sdk.NewInt(100),
+		EthereumSender: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+		CosmosReceiver: "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
+		EthereumHeight: 42,
+	}
+	voteHeight := ctx.BlockHeight()
+	_, err := gk.recordEventVote(ctx, event, ValAddrs[0])
+	require.NoError(t, err)
+	gk.setLastSignatureHeightByValidator(ctx, ValAddrs[0], uint64(voteHeight))
+
+	ctx = ctx.WithBlockHeight(voteHeight + 10)
+	res, err := gk.BridgeValidatorLiveness(sdk.WrapSDKContext(ctx), &types.BridgeValidatorLivenessRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Validators, len(ValAddrs))
+
+	for _, liveness := range res.Validators {
+		if liveness.ValidatorAddress != ValAddrs[0].String() {
+			require.Zero(t, liveness.LastEventVoteHeight)
+			require.Equal(t, uint64(ctx.BlockHeight()), liveness.BlocksSinceLastEventVote)
+			continue
+		}
+		require.Equal(t, types.BridgeValidatorLiveness{
+			ValidatorAddress:         ValAddrs[0].String(),
+			LastSignatureHeight:      uint64(voteHeight),
+			LastEventVoteHeight:      uint64(voteHeight),
+			BlocksSinceLastSignature: 10,
+			BlocksSinceLastEventVote: 10,
+			LastEventNonce:           1,
+		}, liveness)
+	}
+}
+
+func TestBridgeValidatorInfo(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	gk.IncrementMissedSignaturesByValidator(ctx, ValAddrs[0])
+	gk.IncrementMissedSignaturesByValidator(ctx, ValAddrs[0])
+
+	res, err := gk.BridgeValidatorInfo(sdk.WrapSDKContext(ctx), &types.BridgeValidatorInfoRequest{ValidatorAddress: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, ValAddrs[0].String(), res.Validator.ValidatorAddress)
+	require.Equal(t, AccAddrs[0].String(), res.Validator.OrchestratorAddress)
+	require.Equal(t, EthAddrs[0].Hex(), res.Validator.EthereumAddress)
+	require.Equal(t, types.SIGNATURE_SCHEME_ECDSA, res.Validator.SignatureScheme)
+	require.True(t, res.Validator.Bonded)
+	require.Equal(t, uint64(2), res.Validator.Liveness.MissedSignatures)
+
+	// the counter is exported with the liveness heights
+	genesis := ExportGenesis(ctx, gk)
+	require.Len(t, genesis.ValidatorLivenessHeights, 1)
+	require.Equal(t, uint64(2), genesis.ValidatorLivenessHeights[0].MissedSignatures)
+
+	// a zero counter is not stored
+	gk.setMissedSignaturesByValidator(ctx, ValAddrs[0], 0)
+	require.Empty(t, ExportGenesis(ctx, gk).ValidatorLivenessHeights)
+
+	_, err = gk.BridgeValidatorInfo(sdk.WrapSDKContext(ctx), &types.BridgeValidatorInfoRequest{ValidatorAddress: "cosmosvaloper1"})
+	require.Error(t, err)
+}
@@ -3,6 +3,12 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v1 "github.com/peggyjv/gravity-bridge/module/v2/x/gravity/migrations/v1"
+	v2 "github.com/peggyjv/gravity-bridge/module/v2/x/gravity/migrations/v2"
+	v3 "github.com/peggyjv/gravity-bridge/module/v2/x/gravity/migrations/v3"
+	v4 "github.com/peggyjv/gravity-bridge/module/v2/x/gravity/migrations/v4"
+	v5 "github.com/peggyjv/gravity-bridge/module/v2/x/gravity/migrations/v5"
+	v6 "github.com/peggyjv/gravity-bridge/module/v2/x/gravity/migrations/v6"
+	v7 "github.com/peggyjv/gravity-bridge/module/v2/x/gravity/migrations/v7"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -19,3 +25,39 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v1.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from consensus version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate3to4 migrates from consensus version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate4to5 migrates from consensus version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.getGravityID(ctx))
+}
+
+// Migrate5to6 migrates from consensus version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.paramSpace, m.keeper.StakingKeeper.PowerReduction(ctx))
+}
+
+// Migrate6to7 migrates from consensus version 6 to 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate7to8 migrates from consensus version 7 to 8.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	if err := v7.MigrateStore(ctx, m.keeper.storeKey, m.keeper.paramSpace); err != nil {
+		return err
+	}
+
+	// build the index added in this version from the existing state
+	m.keeper.reindexOutgoingTxHeights(ctx)
+	return nil
+}
@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// getMintRateLimit returns the configured mint rate limit for a token, if any
+func getMintRateLimit(params types.Params, tokenContract common.Address) (sdk.Int, bool) {
+	for _, limit := range params.MintRateLimits {
+		if common.HexToAddress(limit.TokenContract) == tokenContract {
+			return limit.Limit, true
+		}
+	}
+	return sdk.Int{}, false
+}
+
+// GetMintRateLimitUsage returns the amount of a token credited by deposits in
+// the current mint rate limit window
+func (k Keeper) GetMintRateLimitUsage(ctx sdk.Context, tokenContract common.Address) sdk.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeMintRateLimitUsageKey(tokenContract))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var usage sdk.Int
+	if err := usage.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return usage
+}
+
+func (k Keeper) setMintRateLimitUsage(ctx sdk.Context, tokenContract common.Address, usage sdk.Int) {
+	bz, err := usage.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.MakeMintRateLimitUsageKey(tokenContract), bz)
+}
+
+// resetMintRateLimitUsage clears the usage of every token, starting a new window
+func (k Keeper) resetMintRateLimitUsage(ctx sdk.Context) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.MintRateLimitUsageKey})
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
+// recordMint adds a deposit to the usage of its token when the token is rate limited
+func (k Keeper) recordMint(ctx sdk.Context, params types.Params, event *types.SendToCosmosEvent) {
+	tokenContract := common.HexToAddress(event.TokenContract)
+	if _, ok := getMintRateLimit(params, tokenContract); ok {
+		k.setMintRateLimitUsage(ctx, tokenContract, k.GetMintRateLimitUsage(ctx, tokenContract).Add(event.Amount))
+	}
+}
+
+// withinMintRateLimit reports whether a deposit can be credited in the current
+// window. A deposit larger than the limit itself is let through on its own at
+// the start of a window, otherwise it would never be released.
+func (k Keeper) withinMintRateLimit(ctx sdk.Context, params types.Params, event *types.SendToCosmosEvent) bool {
+	tokenContract := common.HexToAddress(event.TokenContract)
+	limit, ok := getMintRateLimit(params, tokenContract)
+	if !ok {
+		return true
+	}
+	usage := k.GetMintRateLimitUsage(ctx, tokenContract)
+	return usage.IsZero() || usage.Add(event.Amount).LTE(limit)
+}
+
+// shouldQueueSendToCosmos reports whether a deposit must wait in the mint
+// queue, either because inbound is disabled, because it is over the limit or
+// because earlier deposits of the same token are still queued
+func (k Keeper) shouldQueueSendToCosmos(ctx sdk.Context, params types.Params, event *types.SendToCosmosEvent) bool {
+	return !inboundEnabled(params) || k.hasQueuedSendToCosmos(ctx, common.HexToAddress(event.TokenContract)) || !k.withinMintRateLimit(ctx, params, event)
+}
+
+////////////////////////////
+// QUEUED SEND TO COSMOS //
+////////////////////////////
+
+func (k Keeper) setQueuedSendToCosmos(ctx sdk.Context, event *types.SendToCosmosEvent) {
+	key := types.MakeQueuedSendToCosmosKey(common.HexToAddress(event.TokenContract), event.EventNonce)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(event))
+}
+
+func (k Keeper) deleteQueuedSendToCosmos(ctx sdk.Context, event *types.SendToCosmosEvent) {
+	key := types.MakeQueuedSendToCosmosKey(common.HexToAddress(event.TokenContract), event.EventNonce)
+	ctx.KVStore(k.storeKey).Delete(key)
+}
+
+func (k Keeper) hasQueuedSendToCosmos(ctx sdk.Context, tokenContract common.Address) bool {
+	found := false
+	k.IterateQueuedSendToCosmosByContract(ctx, tokenContract, func(*types.SendToCosmosEvent) bool {
+		found = true
+		return true
+	})
+	return found
+}
+
+// enqueueSendToCosmos stores a deposit to be credited once the mint rate limit allows
+func (k Keeper) enqueueSendToCosmos(ctx sdk.Context, event *types.SendToCosmosEvent) {
+	k.setQueuedSendToCosmos(ctx, event)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBridgeDepositQueued,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, event.TokenContract),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.EventNonce)),
+		),
+	)
+}
+
+// IterateQueuedSendToCosmos iterates over all queued deposits, by token and then in event nonce order
+func (k Keeper) IterateQueuedSendToCosmos(ctx sdk.Context, cb func(*types.SendToCosmosEvent) bool) {
+	k.iterateQueuedSendToCosmosByPrefix(ctx, []byte{types.QueuedSendToCosmosKey}, cb)
+}
+
+// IterateQueuedSendToCosmosByContract iterates over the queued deposits of a token in event nonce order
+func (k Keeper) IterateQueuedSendToCosmosByContract(ctx sdk.Context, tokenContract common.Address, cb func(*types.SendToCosmosEvent) bool) {
+	k.iterateQueuedSendToCosmosByPrefix(ctx, append([]byte{types.QueuedSendToCosmosKey}, tokenContract.Bytes()...), cb)
+}
+
+func (k Keeper) iterateQueuedSendToCosmosByPrefix(ctx sdk.Context, prefixKey []byte, cb func(*types.SendToCosmosEvent) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var event types.SendToCosmosEvent
+		k.cdc.MustUnmarshal(iter.Value(), &event)
+		if cb(&event) {
+			break
+		}
+	}
+}
+
+// DrainMintQueue starts a new mint rate limit window when due and credits
+// queued deposits, per token in order, for as long as the limits allow. A
+// deposit that fails to be credited is moved to the held deposits.
+func (k Keeper) DrainMintQueue(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if ctx.BlockHeight()%int64(params.MintRateLimitWindow) == 0 {
+		k.resetMintRateLimitUsage(ctx)
+	}
+
+	var queued []*types.SendToCosmosEvent
+	k.IterateQueuedSendToCosmos(ctx, func(event *types.SendToCosmosEvent) bool {
+		queued = append(queued, event)
+		return false
+	})
+
+	blocked := make(map[string]bool)
+	for _, event := range queued {
+		if blocked[event.TokenContract] {
+			continue
+		}
+		if !k.withinMintRateLimit(ctx, params, event) {
+			// keep the remaining deposits of this token queued to preserve ordering
+			blocked[event.TokenContract] = true
+			continue
+		}
+
+		cacheCtx, commit := ctx.CacheContext()
+		if err := k.sendToCosmos(cacheCtx, params, event); err != nil {
+			k.Logger(ctx).Error(
+				"queued send to cosmos failed",
+				"cause", err.Error(),
+				"token contract", event.TokenContract,
+				"nonce", fmt.Sprint(event.EventNonce),
+			)
+			// hold the deposit for governance to release rather than drop it
+			// or retry it every block
+			k.deleteQueuedSendToCosmos(ctx, event)
+			k.holdSendToCosmos(ctx, event)
+			continue
+		}
+		k.deleteQueuedSendToCosmos(cacheCtx, event)
+		commit()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
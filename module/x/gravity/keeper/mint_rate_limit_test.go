@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestMintRateLimitQueue(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		receiver      = AccAddrs[0]
+		denom         = types.GravityDenom(tokenContract)
+	)
+
+	params := gk.GetParams(ctx)
+	params.MintRateLimits = []types.MintRateLimit{{TokenContract: tokenContract.Hex(), Limit: sdk.NewInt(100)}}
+	gk.SetParams(ctx, params)
+
+	deposit := func(nonce uint64, amount int64) *types.SendToCosmosEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  tokenContract.Hex(),
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: EthAddrs[0].Hex(),
+			CosmosReceiver: receiver.String(),
+			EthereumHeight: 10,
+		}
+	}
+
+	// the first deposit fits within the limit, the next two have to wait
+	require.NoError(t, gk.Handle(ctx, deposit(1, 60)))
+	require.NoError(t, gk.Handle(ctx, deposit(2, 60)))
+	require.NoError(t, gk.Handle(ctx, deposit(3, 30)))
+	require.Equal(t, int64(60), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+
+	var queued []uint64
+	gk.IterateQueuedSendToCosmosByContract(ctx, tokenContract, func(event *types.SendToCosmosEvent) bool {
+		queued = append(queued, event.EventNonce)
+		return false
+	})
+	require.Equal(t, []uint64{2, 3}, queued)
+
+	// nothing is released before the window ends
+	gk.DrainMintQueue(ctx.WithBlockHeight(int64(params.MintRateLimitWindow) + 1))
+	require.Equal(t, int64(60), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+
+	// a new window releases the queue in order
+	gk.DrainMintQueue(ctx.WithBlockHeight(int64(params.MintRateLimitWindow) * 2))
+	require.Equal(t, int64(150), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+	require.False(t, gk.hasQueuedSendToCosmos(ctx, tokenContract))
+	require.Equal(t, sdk.NewInt(90), gk.GetMintRateLimitUsage(ctx, tokenContract))
+}
+
+func TestMintRateLimitQueueFailedDeposit(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		receiver      = AccAddrs[0]
+		denom         = types.GravityDenom(tokenContract)
+		// module accounts are blocked from receiving funds by the bank keeper
+		blocked = authtypes.NewModuleAddress(types.ModuleName)
+	)
+
+	params := gk.GetParams(ctx)
+	params.MintRateLimits = []types.MintRateLimit{{TokenContract: tokenContract.Hex(), Limit: sdk.NewInt(100)}}
+	gk.SetParams(ctx, params)
+
+	for nonce, receiver := range []sdk.AccAddress{blocked, receiver} {
+		gk.enqueueSendToCosmos(ctx, &types.SendToCosmosEvent{
+			EventNonce:     uint64(nonce + 1),
+			TokenContract:  tokenContract.Hex(),
+			Amount:         sdk.NewInt(10),
+			EthereumSender: EthAddrs[0].Hex(),
+			CosmosReceiver: receiver.String(),
+			EthereumHeight: 10,
+		})
+	}
+
+	// the failed deposit is held rather than dropped, and doesn't count
+	// against the limit
+	gk.DrainMintQueue(ctx.WithBlockHeight(int64(params.MintRateLimitWindow)))
+	require.False(t, gk.hasQueuedSendToCosmos(ctx, tokenContract))
+	held, found := gk.GetHeldSendToCosmos(ctx, tokenContract, 1)
+	require.True(t, found)
+	require.Equal(t, blocked.String(), held.CosmosReceiver)
+	require.Equal(t, int64(10), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+	require.Equal(t, sdk.NewInt(10), gk.GetMintRateLimitUsage(ctx, tokenContract))
+	require.True(t, input.BankKeeper.GetSupply(ctx, denom).Amount.Equal(sdk.NewInt(10)))
+}
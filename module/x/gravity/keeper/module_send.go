@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// SendToEthereumFromModule sends coins of a module account registered as a
+// sender module account to ethereum. The amount and fee are escrowed from the
+// module account, and are returned to it if the send is cancelled or vetoed.
+func (k Keeper) SendToEthereumFromModule(ctx sdk.Context, moduleName string, ethRecipient string, amount sdk.Coin, fee sdk.Coin) (uint64, error) {
+	return k.sendToEthereumFromModule(ctx, moduleName, ethRecipient, amount, fee, false)
+}
+
+// PrioritySendToEthereumFromModule sends coins of a sender module account to
+// ethereum as a priority tx, which goes into the next batch of its token
+// before the other txs whatever its fee. Protocol operations don't have to
+// outbid user fees this way.
+func (k Keeper) PrioritySendToEthereumFromModule(ctx sdk.Context, moduleName string, ethRecipient string, amount sdk.Coin, fee sdk.Coin) (uint64, error) {
+	return k.sendToEthereumFromModule(ctx, moduleName, ethRecipient, amount, fee, true)
+}
+
+func (k Keeper) sendToEthereumFromModule(ctx sdk.Context, moduleName string, ethRecipient string, amount sdk.Coin, fee sdk.Coin, priority bool) (uint64, error) {
+	params := k.GetParams(ctx)
+	if !params.BridgeActive {
+		return 0, types.ErrBridgeInactive
+	}
+	if !params.OutboundEnabled {
+		return 0, sdkerrors.Wrap(types.ErrBridgeInactive, "outbound is disabled")
+	}
+
+	sender, err := k.senderModuleAddress(moduleName)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := types.ValidateEthAddress(ethRecipient); err != nil {
+		return 0, sdkerrors.Wrap(err, "invalid eth dest")
+	}
+
+	types.NormalizeCoinDenom(&amount)
+	types.NormalizeCoinDenom(&fee)
+
+	return k.createSendToEthereumWithPriority(ctx, sender, ethRecipient, amount, fee, priority)
+}
+
+// CancelSendToEthereumFromModule cancels an unbatched send to ethereum of a
+// sender module account and returns its amount and fee to the module account
+func (k Keeper) CancelSendToEthereumFromModule(ctx sdk.Context, moduleName string, id uint64) error {
+	sender, err := k.senderModuleAddress(moduleName)
+	if err != nil {
+		return err
+	}
+
+	return k.cancelSendToEthereum(ctx, id, sender.String())
+}
+
+// ModuleCosmosReceiver returns the cosmos receiver to use on ethereum for
+// deposits to a module account registered as a receiver module account. The
+// deposited coins are credited to the module account.
+func (k Keeper) ModuleCosmosReceiver(moduleName string) (string, error) {
+	receiver := authtypes.NewModuleAddress(moduleName).String()
+	if name, ok := k.ReceiverModuleAccounts[receiver]; !ok || name != moduleName {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a receiver module account", moduleName)
+	}
+	return receiver, nil
+}
+
+func (k Keeper) senderModuleAddress(moduleName string) (sdk.AccAddress, error) {
+	sender := authtypes.NewModuleAddress(moduleName)
+	if name, ok := k.SenderModuleAccounts[sender.String()]; !ok || name != moduleName {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a sender module account", moduleName)
+	}
+	return sender, nil
+}
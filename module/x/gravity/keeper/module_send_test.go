@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestSendToEthereumFromModule(t *testing.T) {
+	var (
+		input = CreateTestEnv(t)
+		ctx   = input.Context
+		gk    = input.GravityKeeper
+
+		myReceiver    = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		moduleAddress = authtypes.NewModuleAddress(distrtypes.ModuleName)
+	)
+
+	voucher := types.NewERC20Token(1000, tokenContract).GravityCoin()
+	require.NoError(t, fundModAccount(ctx, input.BankKeeper, distrtypes.ModuleName, sdk.NewCoins(voucher)))
+
+	amount := sdk.NewCoin(voucher.Denom, sdk.NewInt(100))
+	fee := sdk.NewCoin(voucher.Denom, sdk.NewInt(1))
+
+	// only registered sender module accounts can send
+	_, err := gk.SendToEthereumFromModule(ctx, govtypes.ModuleName, myReceiver.Hex(), amount, fee)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	id, err := gk.SendToEthereumFromModule(ctx, distrtypes.ModuleName, myReceiver.Hex(), amount, fee)
+	require.NoError(t, err)
+
+	pool := gk.getUnbatchedSendToEthereums(ctx)
+	require.Len(t, pool, 1)
+	require.Equal(t, moduleAddress.String(), pool[0].Sender)
+	require.Equal(t, sdk.NewInt(899), input.BankKeeper.GetBalance(ctx, moduleAddress, voucher.Denom).Amount)
+
+	// cancelling returns the escrow to the module account
+	require.NoError(t, gk.CancelSendToEthereumFromModule(ctx, distrtypes.ModuleName, id))
+	require.Empty(t, gk.getUnbatchedSendToEthereums(ctx))
+	require.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetBalance(ctx, moduleAddress, voucher.Denom).Amount)
+
+	receiver, err := gk.ModuleCosmosReceiver(distrtypes.ModuleName)
+	require.NoError(t, err)
+	require.Equal(t, moduleAddress.String(), receiver)
+
+	_, err = gk.ModuleCosmosReceiver(govtypes.ModuleName)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestPrioritySendToEthereum(t *testing.T) {
+	var (
+		input = CreateTestEnv(t)
+		ctx   = input.Context
+		gk    = input.GravityKeeper
+
+		mySender, _   = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver    = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+
+	voucher := types.NewERC20Token(1000, tokenContract).GravityCoin()
+	require.NoError(t, fundModAccount(ctx, input.BankKeeper, distrtypes.ModuleName, sdk.NewCoins(voucher)))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	MintVouchersFromAir(t, ctx, gk, mySender, types.NewERC20Token(99999, tokenContract))
+
+	// ids 1 and 2 with fees 5 and 6
+	input.AddSendToEthTxsToPool(t, ctx, tokenContract, mySender, myReceiver, 5, 6)
+
+	// only registered sender module accounts can send priority txs
+	amount := sdk.NewCoin(voucher.Denom, sdk.NewInt(100))
+	fee := sdk.NewCoin(voucher.Denom, sdk.NewInt(1))
+	_, err := gk.PrioritySendToEthereumFromModule(ctx, govtypes.ModuleName, myReceiver.Hex(), amount, fee)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	priorityID, err := gk.PrioritySendToEthereumFromModule(ctx, distrtypes.ModuleName, myReceiver.Hex(), amount, fee)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), priorityID)
+
+	// id 4 with fee 7
+	input.AddSendToEthTxsToPool(t, ctx, tokenContract, mySender, myReceiver, 7)
+
+	// the priority tx goes first despite the lowest fee
+	batch := gk.BuildBatchTx(ctx, tokenContract, 2)
+	require.NotNil(t, batch)
+	require.Len(t, batch.Transactions, 2)
+	require.Equal(t, priorityID, batch.Transactions[0].Id)
+	require.True(t, batch.Transactions[0].Priority)
+	require.Equal(t, uint64(4), batch.Transactions[1].Id)
+
+	// txs prioritized by governance don't wait for a more profitable batch
+	proposal := types.NewSendToEthereumPriorityProposal("priority", "rebalance liquidity", []uint64{1})
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, gk.HandleSendToEthereumPriorityProposal(ctx, proposal))
+
+	batch = gk.BuildBatchTx(ctx, tokenContract, 1)
+	require.NotNil(t, batch)
+	require.Len(t, batch.Transactions, 1)
+	require.Equal(t, uint64(1), batch.Transactions[0].Id)
+
+	pool := gk.getUnbatchedSendToEthereums(ctx)
+	require.Len(t, pool, 1)
+	require.Equal(t, uint64(2), pool[0].Id)
+	require.False(t, pool[0].Priority)
+
+	// batched txs can't be prioritized
+	err = gk.HandleSendToEthereumPriorityProposal(ctx, types.NewSendToEthereumPriorityProposal("priority", "too late", []uint64{priorityID}))
+	require.ErrorIs(t, err, types.ErrSendToEthereumNotFound)
+}
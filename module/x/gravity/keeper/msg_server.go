@@ -1,16 +1,19 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"fmt"
 	"strconv"
 
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
 )
@@ -47,11 +50,26 @@ func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys
 		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, valAddr.String())
 	}
 
+	// frozen keys can only be replaced once the cooldown has passed, and not
+	// by themselves
+	freeze, frozen := k.GetOrchestratorFreeze(ctx, valAddr)
+	if frozen {
+		if uint64(ctx.BlockHeight()) < freeze.CooldownEndHeight {
+			return nil, sdkerrors.Wrapf(types.ErrOrchestratorFrozen, "validator %s can set delegate keys from height %d", valAddr, freeze.CooldownEndHeight)
+		}
+		if ethAddr == common.HexToAddress(freeze.EthereumAddress) || orchAddr.String() == freeze.OrchestratorAddress {
+			return nil, sdkerrors.Wrap(types.ErrOrchestratorFrozen, "frozen keys can't be delegated again")
+		}
+	}
+
 	// check if the Ethereum address is currently not used
 	validators := k.getValidatorsByEthereumAddress(ctx, ethAddr)
 	if len(validators) > 0 {
 		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "ethereum address %s in use", ethAddr)
 	}
+	if k.IsEthereumSignerExcluded(ctx, ethAddr) {
+		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "ethereum address %s excluded from signer sets", ethAddr)
+	}
 
 	// check if the orchestrator address is currently not used
 	ethAddrs := k.getEthereumAddressesByOrchestrator(ctx, orchAddr)
@@ -59,37 +77,22 @@ func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys
 		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "orchestrator address %s in use", orchAddr)
 	}
 
-	valAccAddr := sdk.AccAddress(valAddr)
-	valAccSeq, err := k.accountKeeper.GetSequence(ctx, valAccAddr)
-	if err != nil {
-		return nil, sdkerrors.Wrapf(types.ErrDelegateKeys, "failed to get sequence for validator account %s", valAccAddr)
-	}
-
-	var nonce uint64
-	if valAccSeq > 0 {
-		nonce = valAccSeq - 1
+	if err = k.verifyDelegateKeysSignature(ctx, valAddr, ethAddr, msg.EthSignature); err != nil {
+		return nil, err
 	}
 
-	signMsgBz := k.cdc.MustMarshal(&types.DelegateKeysSignMsg{
-		ValidatorAddress: valAddr.String(),
-		// We decrement since we process the message after the ante-handler which
-		// increments the nonce.
-		Nonce: nonce,
-	})
-
-	hash := crypto.Keccak256Hash(signMsgBz).Bytes()
-
-	if err = types.ValidateEthereumSignature(hash, msg.EthSignature, ethAddr); err != nil {
-		return nil, sdkerrors.Wrapf(
-			types.ErrDelegateKeys,
-			"failed to validate delegate keys signature for Ethereum address %X; %s ;%d",
-			ethAddr, err, nonce,
-		)
+	// the validator can only select a scheme the chain can verify
+	if _, err = k.getSignatureVerifier(msg.SignatureScheme); err != nil {
+		return nil, err
 	}
 
 	k.SetOrchestratorValidatorAddress(ctx, valAddr, orchAddr)
 	k.setValidatorEthereumAddress(ctx, valAddr, ethAddr)
 	k.setEthereumOrchestratorAddress(ctx, ethAddr, orchAddr)
+	k.setValidatorSignatureScheme(ctx, valAddr, msg.SignatureScheme)
+	if frozen {
+		k.deleteOrchestratorFreeze(ctx, valAddr)
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -119,13 +122,14 @@ func (k msgServer) SubmitEthereumTxConfirmation(c context.Context, msg *types.Ms
 		return nil, err
 	}
 
-	otx := k.GetOutgoingTx(ctx, confirmation.GetStoreIndex())
-	if otx == nil {
+	otx, err := k.GetOutgoingTx(ctx, confirmation.GetStoreIndex())
+	if err != nil {
 		k.Logger(ctx).Error(
 			"no outgoing tx",
 			"store index", fmt.Sprintf("%x", confirmation.GetStoreIndex()),
+			"cause", err.Error(),
 		)
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "couldn't find outgoing tx")
+		return nil, err
 	}
 
 	gravityID := k.getGravityID(ctx)
@@ -133,10 +137,11 @@ func (k msgServer) SubmitEthereumTxConfirmation(c context.Context, msg *types.Ms
 
 	ethAddress := k.GetValidatorEthereumAddress(ctx, val)
 	if ethAddress != confirmation.GetSigner() {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "eth address does not match signer eth address")
+		return nil, sdkerrors.Wrapf(types.ErrEthereumSignerMismatch, "%s is not %s", confirmation.GetSigner(), ethAddress)
 	}
 
-	if err = types.ValidateEthereumSignature(checkpoint, confirmation.GetSignature(), ethAddress); err != nil {
+	valid, err := k.verifyEthereumTxConfirmation(ctx, val, confirmation, checkpoint, ethAddress)
+	if err != nil {
 		k.Logger(ctx).Error("error validating signature",
 			"eth addr", ethAddress.String(),
 			"gravityID", gravityID,
@@ -144,7 +149,7 @@ func (k msgServer) SubmitEthereumTxConfirmation(c context.Context, msg *types.Ms
 			"type url", msg.Confirmation.TypeUrl,
 			"signature", hex.EncodeToString(confirmation.GetSignature()),
 			"error", err)
-		return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf(
+		return nil, sdkerrors.Wrap(types.ErrInvalidEthereumSignature, fmt.Sprintf(
 			"signature verification failed ethAddress %s gravityID %s checkpoint %s typeURL %s signature %s err %s",
 			ethAddress.Hex(),
 			gravityID,
@@ -154,23 +159,100 @@ func (k msgServer) SubmitEthereumTxConfirmation(c context.Context, msg *types.Ms
 			err,
 		))
 	}
+	if !valid {
+		// a signature that doesn't sign the checkpoint is only provably wrong
+		// if it is by the validator's key over the checkpoint of another
+		// outgoing tx of the chain, otherwise anyone could have produced it
+		conflicting := k.conflictingOutgoingTx(ctx, val, confirmation, ethAddress)
+		if conflicting == nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidEthereumSignature,
+				"signature is not by %s over checkpoint %s", ethAddress.Hex(), hex.EncodeToString(checkpoint))
+		}
+		// The signature is not stored and the msg succeeds, so that the
+		// slashing is not reverted along with it.
+		k.slashEthereumSigner(ctx, k.GetParams(ctx), val, otx.GetStoreIndex(), types.AttributeWrongBridgeSig)
+		return &types.MsgSubmitEthereumTxConfirmationResponse{}, nil
+	}
 	// TODO: should validators be able to overwrite their signatures?
 	if k.getEthereumSignature(ctx, confirmation.GetStoreIndex(), val) != nil {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "signature duplicate")
+		return nil, sdkerrors.Wrapf(types.ErrDuplicateSignature, "by %s", val)
 	}
 
-	key := k.SetEthereumSignature(ctx, confirmation, val)
+	k.SetEthereumSignature(ctx, confirmation, val)
+	telemetryConfirmationLatency(ctx, otx)
+	k.setLastSignatureHeightByValidator(ctx, val, uint64(ctx.BlockHeight()))
+	k.setMissedSignaturesByValidator(ctx, val, 0)
+
+	if k.hooks != nil {
+		var signedPower uint64
+		if signerSet := k.getConfirmationSignerSet(ctx); signerSet != nil {
+			signedPower = k.confirmationProgress(ctx, confirmation.GetStoreIndex(), signerSet).SignedPower
+		}
+		k.AfterEthereumSignatureReceived(ctx, confirmation.GetStoreIndex(), val, signedPower)
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
-			sdk.NewAttribute(types.AttributeKeyEthereumSignatureKey, string(key)),
 		),
 	)
+	types.EmitTypedEvent(ctx, &types.EventEthereumTxConfirmation{
+		StoreIndex:     confirmation.GetStoreIndex(),
+		Validator:      val.String(),
+		EthereumSigner: ethAddress.Hex(),
+	})
 	return &types.MsgSubmitEthereumTxConfirmationResponse{}, nil
 }
 
+// conflictingOutgoingTx returns the outgoing tx, other than the confirmed
+// one, whose checkpoint the signature of a confirmation signs with the
+// validator's ethereum key, or nil if there is none
+func (k Keeper) conflictingOutgoingTx(ctx sdk.Context, val sdk.ValAddress, confirmation types.EthereumTxConfirmation, ethAddress common.Address) (conflicting types.OutgoingTx) {
+	gravityID := []byte(k.getGravityID(ctx))
+	k.iterateOutgoingTxs(ctx, func(_ []byte, otx types.OutgoingTx) bool {
+		if bytes.Equal(otx.GetStoreIndex(), confirmation.GetStoreIndex()) {
+			return false
+		}
+		if valid, err := k.verifyEthereumTxConfirmation(ctx, val, confirmation, otx.GetCheckpoint(gravityID), ethAddress); err == nil && valid {
+			conflicting = otx
+			return true
+		}
+		return false
+	})
+	return conflicting
+}
+
+// slashEthereumSigner slashes and jails a validator whose ethereum key
+// provably signed something else than the checkpoints of the outgoing txs it
+// is expected to sign, either submitted by its orchestrator as the signature of
+// an outgoing tx, rather than waiting for relaying to fail on it, or over an
+// outgoing tx the chain never created
+func (k Keeper) slashEthereumSigner(ctx sdk.Context, params types.Params, val sdk.ValAddress, storeIndex []byte, reason string) {
+	validator := k.StakingKeeper.Validator(ctx, val)
+	if validator == nil || validator.IsJailed() {
+		return
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		k.Logger(ctx).Error("failed to get validator consensus address", "validator", val.String(), "error", err)
+		return
+	}
+
+	power := validator.GetConsensusPower(k.StakingKeeper.PowerReduction(ctx))
+	k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, params.SlashFractionConflictingEthereumSignature)
+	k.StakingKeeper.Jail(ctx, consAddr)
+	TelemetryValidatorSlashed(reason)
+
+	types.EmitTypedEvent(ctx, &types.EventValidatorSlashed{
+		Validator:        val.String(),
+		ConsensusAddress: consAddr.String(),
+		Power:            power,
+		Reason:           reason,
+		StoreIndex:       storeIndex,
+	})
+}
+
 // SubmitEthereumEvent handles MsgSubmitEthereumEvent
 func (k msgServer) SubmitEthereumEvent(c context.Context, msg *types.MsgSubmitEthereumEvent) (*types.MsgSubmitEthereumEventResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -205,12 +287,85 @@ func (k msgServer) SubmitEthereumEvent(c context.Context, msg *types.MsgSubmitEt
 	return &types.MsgSubmitEthereumEventResponse{}, nil
 }
 
+// SubmitAggregatedEthereumEvent handles MsgSubmitAggregatedEthereumEvent. The
+// signer's validator and every cosigner vote for the event, and the vote record
+// is tallied right away so that the event can be observed within this tx
+// rather than in the EndBlocker.
+func (k msgServer) SubmitAggregatedEthereumEvent(c context.Context, msg *types.MsgSubmitAggregatedEthereumEvent) (*types.MsgSubmitAggregatedEthereumEventResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	event, err := types.UnpackEvent(msg.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	// return an error if the validator isn't in the active set
+	val, err := k.getSignerValidator(ctx, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	// every cosigner must be bonded and have signed the event with its ethereum key
+	voters := []sdk.ValAddress{val}
+	hash := types.EthereumEventCosignatureHash(k.getGravityID(ctx), event)
+	for _, cosig := range msg.Cosignatures {
+		cosigner, err := sdk.ValAddressFromBech32(cosig.ValidatorAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalid, "cosigner address")
+		}
+		if cosigner.Equals(val) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalid, "duplicate cosignature by %s", cosigner)
+		}
+
+		validator := k.StakingKeeper.Validator(ctx, cosigner)
+		if validator == nil || !validator.IsBonded() {
+			return nil, sdkerrors.Wrap(types.ErrValidatorNotBonded, cosigner.String())
+		}
+
+		ethAddr := k.GetValidatorEthereumAddress(ctx, cosigner)
+		if ethAddr == (common.Address{}) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalid, "no ethereum address for validator %s", cosigner)
+		}
+		if err = types.ValidateEthereumSignature(hash, cosig.Signature, ethAddr); err != nil {
+			return nil, sdkerrors.Wrapf(err, "cosignature by %s", cosigner)
+		}
+
+		voters = append(voters, cosigner)
+	}
+
+	var eventVoteRecord *types.EthereumEventVoteRecord
+	for _, voter := range voters {
+		if eventVoteRecord, err = k.recordEventVote(ctx, event, voter); err != nil {
+			return nil, sdkerrors.Wrapf(err, "create event vote record for %s", voter)
+		}
+	}
+
+	// only the next event in line can be observed, the EndBlocker picks up the rest
+	if params := k.GetParams(ctx); params.BridgeActive && !eventVoteRecord.Accepted &&
+		event.GetEventNonce() == k.GetLastObservedEventNonce(ctx)+1 {
+		k.TryEventVoteRecord(ctx, params, eventVoteRecord)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, fmt.Sprintf("%T", event)),
+			sdk.NewAttribute(types.AttributeKeyEthereumEventVoteRecordID, string(types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()))),
+		),
+	)
+
+	return &types.MsgSubmitAggregatedEthereumEventResponse{}, nil
+}
+
 // SendToEthereum handles MsgSendToEthereum
 func (k msgServer) SendToEthereum(c context.Context, msg *types.MsgSendToEthereum) (*types.MsgSendToEthereumResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	params := k.GetParams(ctx)
 	if !params.BridgeActive {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "the bridge is disabled")
+		return nil, types.ErrBridgeInactive
+	}
+	if !params.OutboundEnabled {
+		return nil, sdkerrors.Wrap(types.ErrBridgeInactive, "outbound is disabled")
 	}
 
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -218,6 +373,20 @@ func (k msgServer) SendToEthereum(c context.Context, msg *types.MsgSendToEthereu
 		return nil, err
 	}
 
+	// the bank refuses to pay module accounts, so the send of one could never
+	// be refunded. Modules send through SendToEthereumFromModule instead, and
+	// interchain accounts of remote chains are not module accounts.
+	if _, ok := k.accountKeeper.GetAccount(ctx, sender).(authtypes.ModuleAccountI); ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "module account %s can't send to ethereum", sender)
+	}
+
+	// a single account can't flood the pool with sends
+	if limit := params.MaxPendingSendToEthereumPerAccount; limit != 0 {
+		if pending := k.countUnbatchedSendToEthereums(ctx, sender); pending >= limit {
+			return nil, sdkerrors.Wrapf(types.ErrTooManyPendingSends, "%s has %d unbatched sends, the maximum is %d", sender, pending, limit)
+		}
+	}
+
 	// ensure the denoms provided in the message will map correctly if they are gravity denoms
 	types.NormalizeCoinDenom(&msg.Amount)
 	types.NormalizeCoinDenom(&msg.BridgeFee)
@@ -228,7 +397,15 @@ func (k msgServer) SendToEthereum(c context.Context, msg *types.MsgSendToEthereu
 		return nil, sdkerrors.Wrap(err, "invalid eth dest")
 	}
 
-	txID, err := k.createSendToEthereum(ctx, sender, msg.EthereumRecipient, msg.Amount, msg.BridgeFee)
+	// the fee is paid out of the allowance of the fee granter if there is one
+	var feeGranter sdk.AccAddress
+	if msg.FeeGranter != "" {
+		if feeGranter, err = sdk.AccAddressFromBech32(msg.FeeGranter); err != nil {
+			return nil, err
+		}
+	}
+
+	txID, err := k.createSendToEthereumWithFeeGranter(ctx, sender, feeGranter, msg.EthereumRecipient, msg.Amount, msg.BridgeFee, msg.ExecuteAfterHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -257,6 +434,15 @@ func (k msgServer) RequestBatchTx(c context.Context, msg *types.MsgRequestBatchT
 	// TODO: limit this to only orchestrators and validators?
 	ctx := sdk.UnwrapSDKContext(c)
 	params := k.GetParams(ctx)
+	if params.MirrorMode {
+		return nil, sdkerrors.Wrap(types.ErrMirrorMode, "cannot request batch tx")
+	}
+	if !params.BridgeActive {
+		return nil, types.ErrBridgeInactive
+	}
+	if !params.OutboundEnabled {
+		return nil, sdkerrors.Wrap(types.ErrBridgeInactive, "outbound is disabled")
+	}
 
 	// Check if the denom is a gravity coin, if not, check if there is a deployed ERC20 representing it.
 	// If not, error out. Normalizes the format of the input denom if it's a gravity denom.
@@ -265,9 +451,35 @@ func (k msgServer) RequestBatchTx(c context.Context, msg *types.MsgRequestBatchT
 		return nil, err
 	}
 
-	batchID := k.BuildBatchTx(ctx, tokenContract, int(params.BatchMaxElement))
+	if pause, ok := k.GetTokenPause(ctx, tokenContract); ok {
+		return nil, sdkerrors.Wrapf(types.ErrTokenPaused, "%s: %s", tokenContract.Hex(), pause.Anomaly)
+	}
+
+	maxElements := int(params.BatchMaxElement)
+	if msg.MaxElements != 0 {
+		maxElements = int(msg.MaxElements)
+	}
+
+	// the floor applies to the txs the batch would hold
+	if !msg.MinFee.IsNil() && msg.MinFee.IsPositive() {
+		if fees := k.batchFeesByTokenType(ctx, params, tokenContract, batchSize(params, maxElements)); fees.LT(msg.MinFee) {
+			return nil, sdkerrors.Wrapf(types.ErrInsufficientFee, "batch fees %s below the requested minimum of %s", fees, msg.MinFee)
+		}
+	}
+
+	batchID := k.buildBatchTx(ctx, params, tokenContract, maxElements)
 	if batchID == nil {
-		return nil, fmt.Errorf("no suitable batch to create")
+		// with the token not paused, either there is nothing to batch or the
+		// batch already waiting for the token pays more fees
+		var unbatched bool
+		k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContract, func(*types.SendToEthereum) bool {
+			unbatched = true
+			return true
+		})
+		if unbatched {
+			return nil, sdkerrors.Wrapf(types.ErrInsufficientFee, "token %s", tokenContract.Hex())
+		}
+		return nil, sdkerrors.Wrapf(types.ErrNoSuitableBatch, "no unbatched sends for token %s", tokenContract.Hex())
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -320,6 +532,350 @@ func (k msgServer) SubmitEthereumHeightVote(c context.Context, msg *types.MsgEth
 	return &types.MsgEthereumHeightVoteResponse{}, nil
 }
 
+// ERC20DeployedConfirm adopts a pending ERC20 deployment as the representation of a cosmos originated denom
+func (k msgServer) ERC20DeployedConfirm(c context.Context, msg *types.MsgERC20DeployedConfirm) (*types.MsgERC20DeployedConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, err := k.getSignerValidator(ctx, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.confirmERC20Deployment(ctx, msg.CosmosDenom, common.HexToAddress(msg.TokenContract)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeERC20DeploymentConfirmed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyCosmosDenom, msg.CosmosDenom),
+			sdk.NewAttribute(types.AttributeKeyTokenContract, msg.TokenContract),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+		),
+	})
+
+	return &types.MsgERC20DeployedConfirmResponse{}, nil
+}
+
+// SubmitEthereumAnomalyReport records the Ethereum-side anomaly a validator observed for a token
+func (k msgServer) SubmitEthereumAnomalyReport(c context.Context, msg *types.MsgEthereumAnomalyReport) (*types.MsgEthereumAnomalyReportResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, err := k.getSignerValidator(ctx, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	k.ReportEthereumAnomaly(ctx, val, common.HexToAddress(msg.TokenContract), msg.Anomaly)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyTokenContract, msg.TokenContract),
+			sdk.NewAttribute(types.AttributeKeyEthereumAnomaly, msg.Anomaly.String()),
+		),
+	)
+
+	return &types.MsgEthereumAnomalyReportResponse{}, nil
+}
+
+// SubmitEthereumHeaderVote records the ethereum header a validator voted for at the next checkpoint
+func (k msgServer) SubmitEthereumHeaderVote(c context.Context, msg *types.MsgEthereumHeaderVote) (*types.MsgEthereumHeaderVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, err := k.getSignerValidator(ctx, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.VoteEthereumHeader(ctx, val, msg.Header); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyEthereumHeight, fmt.Sprint(msg.Header.Height)),
+			sdk.NewAttribute(types.AttributeKeyEthereumBlockHash, msg.Header.Hash.String()),
+		),
+	)
+
+	return &types.MsgEthereumHeaderVoteResponse{}, nil
+}
+
+func (k msgServer) RegisterOrchestratorQueryIdentity(c context.Context, msg *types.MsgRegisterOrchestratorQueryIdentity) (*types.MsgRegisterOrchestratorQueryIdentityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, err := k.getSignerValidator(ctx, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	signer, _ := sdk.AccAddressFromBech32(msg.Signer)
+	identity := k.Keeper.RegisterOrchestratorQueryIdentity(ctx, signer, msg.KeyHash)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyExpiryHeight, fmt.Sprint(identity.ExpiryHeight)),
+		),
+	)
+
+	return &types.MsgRegisterOrchestratorQueryIdentityResponse{ExpiryHeight: identity.ExpiryHeight}, nil
+}
+
+func (k msgServer) ExecuteAtomic(c context.Context, msg *types.MsgExecuteAtomic) (*types.MsgExecuteAtomicResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*cdctypes.Any, 0, len(msgs))
+	err = k.ExecuteAtomically(ctx, func(ctx sdk.Context) error {
+		for i, m := range msgs {
+			res, err := k.executeMsg(ctx, m)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "message %d", i)
+			}
+
+			anyRes, err := cdctypes.NewAnyWithValue(res)
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrPackAny, err.Error())
+			}
+			responses = append(responses, anyRes)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyMsgCount, fmt.Sprint(len(msgs))),
+		),
+	)
+
+	return &types.MsgExecuteAtomicResponse{Responses: responses}, nil
+}
+
+func (k msgServer) VetoDelayedSendToEthereum(c context.Context, msg *types.MsgVetoDelayedSendToEthereum) (*types.MsgVetoDelayedSendToEthereumResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if council := k.GetParams(ctx).SecurityCouncil; council == "" || msg.Signer != council {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the security council", msg.Signer)
+	}
+
+	if err := k.Keeper.VetoDelayedSendToEthereums(ctx, msg.Ids, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+		),
+	)
+
+	return &types.MsgVetoDelayedSendToEthereumResponse{}, nil
+}
+
+// GrantBridgeFeeAllowance handles MsgGrantBridgeFeeAllowance
+func (k msgServer) GrantBridgeFeeAllowance(c context.Context, msg *types.MsgGrantBridgeFeeAllowance) (*types.MsgGrantBridgeFeeAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Keeper.GrantBridgeFeeAllowance(ctx, granter, grantee, msg.SpendLimit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+		),
+	)
+
+	return &types.MsgGrantBridgeFeeAllowanceResponse{}, nil
+}
+
+// RevokeBridgeFeeAllowance handles MsgRevokeBridgeFeeAllowance
+func (k msgServer) RevokeBridgeFeeAllowance(c context.Context, msg *types.MsgRevokeBridgeFeeAllowance) (*types.MsgRevokeBridgeFeeAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RevokeBridgeFeeAllowance(ctx, granter, grantee); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+		),
+	)
+
+	return &types.MsgRevokeBridgeFeeAllowanceResponse{}, nil
+}
+
+// SubmitBadEthereumSignatureEvidence handles MsgSubmitBadEthereumSignatureEvidence
+func (k msgServer) SubmitBadEthereumSignatureEvidence(c context.Context, msg *types.MsgSubmitBadEthereumSignatureEvidence) (*types.MsgSubmitBadEthereumSignatureEvidenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	subject, err := types.UnpackOutgoingTx(msg.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SubmitBadEthereumSignatureEvidence(ctx, subject, msg.Signature, common.HexToAddress(msg.EthereumSigner)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+		),
+	)
+
+	return &types.MsgSubmitBadEthereumSignatureEvidenceResponse{}, nil
+}
+
+// OptOutOfBridge opts a validator out of the bridge duties, from the next
+// signer set on
+func (k msgServer) OptOutOfBridge(c context.Context, msg *types.MsgOptOutOfBridge) (*types.MsgOptOutOfBridgeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if k.StakingKeeper.Validator(ctx, valAddr) == nil {
+		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, valAddr.String())
+	}
+	if k.IsValidatorOptedOut(ctx, valAddr) {
+		return nil, sdkerrors.Wrap(types.ErrValidatorOptedOut, valAddr.String())
+	}
+
+	k.setValidatorOptedOut(ctx, valAddr)
+	types.EmitTypedEvent(ctx, &types.EventValidatorOptedOutOfBridge{Validator: valAddr.String()})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, valAddr.String()),
+		),
+	)
+
+	return &types.MsgOptOutOfBridgeResponse{}, nil
+}
+
+// FreezeOrchestrator freezes the delegate keys of a validator whose
+// orchestrator or ethereum key is compromised, without unbonding it
+func (k msgServer) FreezeOrchestrator(c context.Context, msg *types.MsgFreezeOrchestrator) (*types.MsgFreezeOrchestratorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if k.StakingKeeper.Validator(ctx, valAddr) == nil {
+		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, valAddr.String())
+	}
+
+	freeze, err := k.Keeper.FreezeOrchestrator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySetOrchestratorAddr, freeze.OrchestratorAddress),
+			sdk.NewAttribute(types.AttributeKeySetEthereumAddr, freeze.EthereumAddress),
+			sdk.NewAttribute(types.AttributeKeyExpiryHeight, fmt.Sprint(freeze.CooldownEndHeight)),
+		),
+	)
+
+	return &types.MsgFreezeOrchestratorResponse{}, nil
+}
+
+// executeMsg routes a message of a MsgExecuteAtomic to its msg server method
+func (k msgServer) executeMsg(ctx sdk.Context, msg sdk.Msg) (proto.Message, error) {
+	c := sdk.WrapSDKContext(ctx)
+
+	switch msg := msg.(type) {
+	case *types.MsgSendToEthereum:
+		return k.SendToEthereum(c, msg)
+	case *types.MsgCancelSendToEthereum:
+		return k.CancelSendToEthereum(c, msg)
+	case *types.MsgRequestBatchTx:
+		return k.RequestBatchTx(c, msg)
+	case *types.MsgSubmitEthereumTxConfirmation:
+		return k.SubmitEthereumTxConfirmation(c, msg)
+	case *types.MsgSubmitEthereumEvent:
+		return k.SubmitEthereumEvent(c, msg)
+	case *types.MsgDelegateKeys:
+		return k.SetDelegateKeys(c, msg)
+	case *types.MsgEthereumHeightVote:
+		return k.SubmitEthereumHeightVote(c, msg)
+	case *types.MsgUpdateDelegateKeys:
+		return k.UpdateDelegateKeys(c, msg)
+	case *types.MsgRevokeOrchestratorKey:
+		return k.RevokeOrchestratorKey(c, msg)
+	case *types.MsgSubmitAggregatedEthereumEvent:
+		return k.SubmitAggregatedEthereumEvent(c, msg)
+	case *types.MsgERC20DeployedConfirm:
+		return k.ERC20DeployedConfirm(c, msg)
+	case *types.MsgEthereumAnomalyReport:
+		return k.SubmitEthereumAnomalyReport(c, msg)
+	case *types.MsgRegisterOrchestratorQueryIdentity:
+		return k.RegisterOrchestratorQueryIdentity(c, msg)
+	case *types.MsgVetoDelayedSendToEthereum:
+		return k.VetoDelayedSendToEthereum(c, msg)
+	case *types.MsgGrantBridgeFeeAllowance:
+		return k.GrantBridgeFeeAllowance(c, msg)
+	case *types.MsgRevokeBridgeFeeAllowance:
+		return k.RevokeBridgeFeeAllowance(c, msg)
+	case *types.MsgSubmitBadEthereumSignatureEvidence:
+		return k.SubmitBadEthereumSignatureEvidence(c, msg)
+	case *types.MsgOptOutOfBridge:
+		return k.OptOutOfBridge(c, msg)
+	case *types.MsgEthereumHeaderVote:
+		return k.SubmitEthereumHeaderVote(c, msg)
+	case *types.MsgFreezeOrchestrator:
+		return k.FreezeOrchestrator(c, msg)
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot execute %T atomically", msg)
+	}
+}
+
 // getSignerValidator takes an sdk.AccAddress that represents either a validator or orchestrator address and returns
 // the assoicated validator address
 func (k Keeper) getSignerValidator(ctx sdk.Context, signerString string) (sdk.ValAddress, error) {
@@ -335,9 +891,9 @@ func (k Keeper) getSignerValidator(ctx sdk.Context, signerString string) (sdk.Va
 	}
 
 	if validatorI == nil {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "not orchestrator or validator")
+		return nil, sdkerrors.Wrap(types.ErrNotOrchestratorOrValidator, signerString)
 	} else if !validatorI.IsBonded() {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("validator is not bonded: %s", validatorI.GetOperator()))
+		return nil, sdkerrors.Wrap(types.ErrValidatorNotBonded, validatorI.GetOperator().String())
 	}
 
 	return validatorI.GetOperator(), nil
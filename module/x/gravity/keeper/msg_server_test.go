@@ -4,9 +4,14 @@ import (
 	"bytes"
 	"crypto/ecdsa"
 	"fmt"
+	"strings"
 	"testing"
 
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -64,6 +69,8 @@ func TestMsgServer_SubmitEthereumSignature(t *testing.T) {
 	confirmation, err := types.PackConfirmation(signerSetTxConfirmation)
 	require.NoError(t, err)
 
+	hooks := &signatureRecordingHooks{}
+	gk.SetHooks(hooks)
 	msgServer := NewMsgServerImpl(gk)
 
 	msg := &types.MsgSubmitEthereumTxConfirmation{
@@ -73,6 +80,110 @@ func TestMsgServer_SubmitEthereumSignature(t *testing.T) {
 
 	_, err = msgServer.SubmitEthereumTxConfirmation(sdk.WrapSDKContext(ctx), msg)
 	require.NoError(t, err)
+
+	// the hook sees the signature along with the signed power of the signer set
+	require.Equal(t, signerSetTx.GetStoreIndex(), hooks.storeIndex)
+	require.Equal(t, valAddr1, hooks.validator)
+	require.Equal(t, signerSetTx.Signers.TotalPower(), hooks.cumulativePower)
+
+	require.Equal(t, uint64(ctx.BlockHeight()), gk.GetLastSignatureHeightByValidator(ctx, valAddr1))
+}
+
+func TestMsgServer_SubmitWrongEthereumSignature(t *testing.T) {
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+		ethAddr1    = crypto.PubkeyToAddress(ethPrivKey.PublicKey)
+	)
+
+	stakingKeeper := NewStakingKeeperMock(valAddr1)
+	gk.StakingKeeper = stakingKeeper
+	gk.SetOrchestratorValidatorAddress(ctx, valAddr1, orcAddr1)
+	gk.setValidatorEthereumAddress(ctx, valAddr1, ethAddr1)
+
+	signerSetTx := gk.CreateSignerSetTx(ctx)
+	msgServer := NewMsgServerImpl(gk)
+	submit := func(signature []byte) error {
+		confirmation, err := types.PackConfirmation(&types.SignerSetTxConfirmation{
+			SignerSetNonce: signerSetTx.Nonce,
+			EthereumSigner: ethAddr1.Hex(),
+			Signature:      signature,
+		})
+		require.NoError(t, err)
+		_, err = msgServer.SubmitEthereumTxConfirmation(sdk.WrapSDKContext(ctx), &types.MsgSubmitEthereumTxConfirmation{
+			Confirmation: confirmation,
+			Signer:       orcAddr1.String(),
+		})
+		return err
+	}
+
+	// a malformed signature is rejected without slashing
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.ErrorIs(t, submit([]byte{0x1}), types.ErrInvalidEthereumSignature)
+	require.Empty(t, ctx.EventManager().ABCIEvents())
+
+	// a signature over data the chain never created, or by another key, is
+	// rejected without slashing
+	signature, err := types.NewEthereumSignature(signerSetTx.GetCheckpoint([]byte("wrong-gravity-id")), ethPrivKey)
+	require.NoError(t, err)
+	require.ErrorIs(t, submit(signature), types.ErrInvalidEthereumSignature)
+	otherPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	signature, err = types.NewEthereumSignature(signerSetTx.GetCheckpoint([]byte(gk.getGravityID(ctx))), otherPrivKey)
+	require.NoError(t, err)
+	require.ErrorIs(t, submit(signature), types.ErrInvalidEthereumSignature)
+	require.Empty(t, ctx.EventManager().ABCIEvents())
+
+	// a signature by the validator's key over the checkpoint of another
+	// outgoing tx is not stored and slashes its validator
+	otherSignerSetTx := gk.CreateSignerSetTx(ctx)
+	require.NotEqual(t, signerSetTx.Nonce, otherSignerSetTx.Nonce)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	signature, err = types.NewEthereumSignature(otherSignerSetTx.GetCheckpoint([]byte(gk.getGravityID(ctx))), ethPrivKey)
+	require.NoError(t, err)
+	require.NoError(t, submit(signature))
+	require.Nil(t, gk.getEthereumSignature(ctx, signerSetTx.GetStoreIndex(), valAddr1))
+
+	consAddr, err := stakingKeeper.BondedValidators[0].GetConsAddr()
+	require.NoError(t, err)
+	events := ctx.EventManager().ABCIEvents()
+	require.Len(t, events, 1)
+	typed, err := sdk.ParseTypedEvent(events[0])
+	require.NoError(t, err)
+	require.Equal(t, &types.EventValidatorSlashed{
+		Validator:        valAddr1.String(),
+		ConsensusAddress: consAddr.String(),
+		Power:            stakingKeeper.BondedValidators[0].GetConsensusPower(sdk.DefaultPowerReduction),
+		Reason:           types.AttributeWrongBridgeSig,
+		StoreIndex:       signerSetTx.GetStoreIndex(),
+	}, typed)
+
+	// the signature over the checkpoint of the keeper is still accepted
+	signature, err = types.NewEthereumSignature(signerSetTx.GetCheckpoint([]byte(gk.getGravityID(ctx))), ethPrivKey)
+	require.NoError(t, err)
+	require.NoError(t, submit(signature))
+	require.NotNil(t, gk.getEthereumSignature(ctx, signerSetTx.GetStoreIndex(), valAddr1))
+}
+
+type signatureRecordingHooks struct {
+	types.GravityHooks
+
+	storeIndex      []byte
+	validator       sdk.ValAddress
+	cumulativePower uint64
+}
+
+func (h *signatureRecordingHooks) AfterEthereumSignatureReceived(_ sdk.Context, storeIndex []byte, validator sdk.ValAddress, cumulativePower uint64) {
+	h.storeIndex = storeIndex
+	h.validator = validator
+	h.cumulativePower = cumulativePower
 }
 
 func TestMsgServer_SendToEthereum(t *testing.T) {
@@ -138,6 +249,53 @@ func TestMsgServer_SendToEthereum(t *testing.T) {
 
 	_, err = msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), msg)
 	require.NoError(t, err)
+
+	// a module account could never be refunded
+	msg.Sender = env.AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String()
+	_, err = msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrInvalid)
+}
+
+func TestMsgServer_SendToEthereumMaxPending(t *testing.T) {
+	var (
+		env       = CreateTestEnv(t)
+		ctx       = env.Context
+		gk        = env.GravityKeeper
+		msgServer = NewMsgServerImpl(gk)
+
+		sender, _     = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		receiver      = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+
+	env.AccountKeeper.NewAccountWithAddress(ctx, sender)
+	voucher := MintVouchersFromAir(t, ctx, gk, sender, types.NewERC20Token(1000, tokenContract))
+
+	params := gk.GetParams(ctx)
+	params.MaxPendingSendToEthereumPerAccount = 2
+	gk.SetParams(ctx, params)
+
+	send := func() (uint64, error) {
+		msg := types.NewMsgSendToEthereum(sender, receiver.Hex(), sdk.NewCoin(voucher.Denom, sdk.NewInt(10)), sdk.NewCoin(voucher.Denom, sdk.NewInt(1)))
+		res, err := msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			return 0, err
+		}
+		return res.Id, nil
+	}
+
+	id, err := send()
+	require.NoError(t, err)
+	_, err = send()
+	require.NoError(t, err)
+	_, err = send()
+	require.ErrorIs(t, err, types.ErrTooManyPendingSends)
+
+	// cancelling a send makes room for another one
+	_, err = msgServer.CancelSendToEthereum(sdk.WrapSDKContext(ctx), types.NewMsgCancelSendToEthereum(id, sender))
+	require.NoError(t, err)
+	_, err = send()
+	require.NoError(t, err)
 }
 
 func TestMsgServer_CancelSendToEthereum(t *testing.T) {
@@ -213,6 +371,113 @@ func TestMsgServer_CancelSendToEthereum(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_ExecuteAtomic(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		orcAddr2, _ = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		ethAddr     = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+
+		testDenom    = "stake"
+		testContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+
+	require.NoError(t, env.AddBalanceToBank(ctx, orcAddr1, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 10000))))
+	gk.setCosmosOriginatedDenomToERC20(ctx, testDenom, testContract)
+
+	msgServer := NewMsgServerImpl(gk)
+	sendMsg := func(fee int64) *types.MsgSendToEthereum {
+		return types.NewMsgSendToEthereum(orcAddr1, ethAddr.Hex(), sdk.NewInt64Coin(testDenom, 1000), sdk.NewInt64Coin(testDenom, fee))
+	}
+
+	response, err := msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), sendMsg(10))
+	require.NoError(t, err)
+
+	// cancel the send and send it again with a higher fee
+	msg, err := types.NewMsgExecuteAtomic([]sdk.Msg{
+		types.NewMsgCancelSendToEthereum(response.Id, orcAddr1),
+		sendMsg(20),
+	}, orcAddr1)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+
+	res, err := msgServer.ExecuteAtomic(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Len(t, res.Responses, 2)
+	resend, ok := res.Responses[1].GetCachedValue().(*types.MsgSendToEthereumResponse)
+	require.True(t, ok)
+
+	pool := gk.getUnbatchedSendToEthereums(ctx)
+	require.Len(t, pool, 1)
+	require.Equal(t, resend.Id, pool[0].Id)
+	require.Equal(t, sdk.NewInt(20), pool[0].Erc20Fee.Amount)
+	require.Equal(t, sdk.NewInt(8980), env.BankKeeper.GetBalance(ctx, orcAddr1, testDenom).Amount)
+
+	// the cancel is not applied when the send after it fails
+	msg, err = types.NewMsgExecuteAtomic([]sdk.Msg{
+		types.NewMsgCancelSendToEthereum(resend.Id, orcAddr1),
+		sendMsg(100000),
+	}, orcAddr1)
+	require.NoError(t, err)
+
+	_, err = msgServer.ExecuteAtomic(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+	require.Equal(t, pool, gk.getUnbatchedSendToEthereums(ctx))
+	require.Equal(t, sdk.NewInt(8980), env.BankKeeper.GetBalance(ctx, orcAddr1, testDenom).Amount)
+
+	// messages of other signers are rejected
+	msg, err = types.NewMsgExecuteAtomic([]sdk.Msg{sendMsg(10)}, orcAddr2)
+	require.NoError(t, err)
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrUnauthorized)
+}
+
+func TestMsgServer_ExecuteAtomicCoversMsgs(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		gk  = env.GravityKeeper
+	)
+
+	registry := cdctypes.NewInterfaceRegistry()
+	sdk.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	msgServer := NewMsgServerImpl(gk).(*msgServer)
+
+	typeURLs := registry.ListImplementations(sdk.MsgInterfaceProtoName)
+	require.NotEmpty(t, typeURLs)
+	for _, typeURL := range typeURLs {
+		if !strings.HasPrefix(typeURL, "/gravity.v1.") || typeURL == sdk.MsgTypeURL(&types.MsgExecuteAtomic{}) {
+			continue
+		}
+		resolved, err := registry.Resolve(typeURL)
+		require.NoError(t, err)
+		msg, ok := resolved.(sdk.Msg)
+		require.True(t, ok, typeURL)
+
+		// the handlers reject or panic on empty messages, only a missing case matters here
+		ctx, _ := env.Context.CacheContext()
+		func() {
+			defer func() { recover() }()
+			_, err = msgServer.executeMsg(ctx, msg)
+		}()
+		require.NotErrorIs(t, err, sdkerrors.ErrUnknownRequest, "%s cannot be executed atomically", typeURL)
+	}
+}
+
+func TestMsgExecuteAtomic_ValidateBasicMalformedSigner(t *testing.T) {
+	orcAddr, _ := sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+
+	msg, err := types.NewMsgExecuteAtomic([]sdk.Msg{
+		&types.MsgCancelSendToEthereum{Id: 1, Sender: "not-an-address"},
+	}, orcAddr)
+	require.NoError(t, err)
+	require.NotPanics(t, func() {
+		require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	})
+}
+
 func TestMsgServer_RequestBatchTx(t *testing.T) {
 	ethPrivKey, err := ethCrypto.GenerateKey()
 	require.NoError(t, err)
@@ -284,6 +549,52 @@ func TestMsgServer_RequestBatchTx(t *testing.T) {
 
 	_, err = msgServer.RequestBatchTx(sdk.WrapSDKContext(ctx), requestMsg)
 	require.NoError(t, err)
+
+	// nothing is left to batch
+	_, err = msgServer.RequestBatchTx(sdk.WrapSDKContext(ctx), requestMsg)
+	require.ErrorIs(t, err, types.ErrNoSuitableBatch)
+
+	// a send paying no fee can't replace the waiting batch
+	msg.BridgeFee = sdk.NewCoin(testDenom, sdk.ZeroInt())
+	_, err = msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	_, err = msgServer.RequestBatchTx(sdk.WrapSDKContext(ctx), requestMsg)
+	require.ErrorIs(t, err, types.ErrInsufficientFee)
+}
+
+func TestMsgServer_RequestBatchTxMaxElementsAndMinFee(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+
+	env.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	MintVouchersFromAir(t, ctx, gk, mySender, types.NewERC20Token(99999, myTokenContractAddr))
+	env.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 1, 2, 3, 4, 5)
+
+	msgServer := NewMsgServerImpl(gk)
+	msg := types.NewMsgRequestBatchTx(types.GravityDenom(myTokenContractAddr), mySender)
+	msg.MaxElements = 2
+
+	// the two best paying txs only add up to 9
+	msg.MinFee = sdk.NewInt(10)
+	_, err := msgServer.RequestBatchTx(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrInsufficientFee)
+
+	msg.MinFee = sdk.NewInt(9)
+	_, err = msgServer.RequestBatchTx(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	otx, err := gk.GetOutgoingTx(ctx, types.MakeBatchTxKey(myTokenContractAddr, 1))
+	require.NoError(t, err)
+	txs := otx.(*types.BatchTx).Transactions
+	require.Len(t, txs, 2)
+	require.Equal(t, sdk.NewInt(9), txs[0].Erc20Fee.Amount.Add(txs[1].Erc20Fee.Amount))
 }
 
 func TestMsgServer_RequestEmptyBatchTx(t *testing.T) {
@@ -321,8 +632,11 @@ func TestMsgServer_RequestEmptyBatchTx(t *testing.T) {
 	}
 
 	_, err := msgServer.RequestBatchTx(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrNoSuitableBatch)
 
-	require.Error(t, err)
+	msg.Denom = "unknown"
+	_, err = msgServer.RequestBatchTx(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrUnknownToken)
 }
 
 func TestMsgServer_SubmitEthereumEvent(t *testing.T) {
@@ -380,6 +694,85 @@ func TestMsgServer_SubmitEthereumEvent(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_SubmitAggregatedEthereumEvent(t *testing.T) {
+	ethPrivKey2, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	ethPrivKey3, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+
+		orcAddr2, _ = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		valAddr2    = sdk.ValAddress(orcAddr2)
+		ethAddr2    = crypto.PubkeyToAddress(ethPrivKey2.PublicKey)
+
+		orcAddr3, _ = sdk.AccAddressFromBech32("cosmos193fw83ynn76328pty4yl7473vg9x86alq2cft7")
+		valAddr3    = sdk.ValAddress(orcAddr3)
+		ethAddr3    = crypto.PubkeyToAddress(ethPrivKey3.PublicKey)
+
+		testContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr1, valAddr2, valAddr3)
+	gk.SetOrchestratorValidatorAddress(ctx, valAddr1, orcAddr1)
+	gk.setValidatorEthereumAddress(ctx, valAddr2, ethAddr2)
+	gk.setValidatorEthereumAddress(ctx, valAddr3, ethAddr3)
+
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  testContract.Hex(),
+		Amount:         sdk.NewInt(1000),
+		EthereumSender: ethAddr2.String(),
+		CosmosReceiver: orcAddr1.String(),
+		EthereumHeight: 200,
+	}
+
+	hash := types.EthereumEventCosignatureHash(gk.getGravityID(ctx), event)
+	sig2, err := types.NewEthereumSignature(hash, ethPrivKey2)
+	require.NoError(t, err)
+	sig3, err := types.NewEthereumSignature(hash, ethPrivKey3)
+	require.NoError(t, err)
+
+	msgServer := NewMsgServerImpl(gk)
+
+	// a cosignature by the wrong key is rejected and no vote is recorded
+	msg, err := types.NewMsgSubmitAggregatedEthereumEvent(event, orcAddr1, []types.EthereumEventCosignature{
+		{ValidatorAddress: valAddr2.String(), Signature: sig3},
+	})
+	require.NoError(t, err)
+	_, err = msgServer.SubmitAggregatedEthereumEvent(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+
+	// the signer cannot cosign for itself
+	msg, err = types.NewMsgSubmitAggregatedEthereumEvent(event, orcAddr1, []types.EthereumEventCosignature{
+		{ValidatorAddress: valAddr1.String(), Signature: sig2},
+	})
+	require.NoError(t, err)
+	_, err = msgServer.SubmitAggregatedEthereumEvent(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+
+	// all three validators vote in one tx and the event is observed right away
+	msg, err = types.NewMsgSubmitAggregatedEthereumEvent(event, orcAddr1, []types.EthereumEventCosignature{
+		{ValidatorAddress: valAddr2.String(), Signature: sig2},
+		{ValidatorAddress: valAddr3.String(), Signature: sig3},
+	})
+	require.NoError(t, err)
+	_, err = msgServer.SubmitAggregatedEthereumEvent(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(1), gk.GetLastObservedEventNonce(ctx))
+	record := gk.GetEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash())
+	require.NotNil(t, record)
+	require.True(t, record.Accepted)
+	require.Len(t, record.Votes, 3)
+}
+
 func TestMsgServer_SetDelegateKeys(t *testing.T) {
 	ethPrivKey, err := ethCrypto.GenerateKey()
 	require.NoError(t, err)
@@ -425,6 +818,206 @@ func TestMsgServer_SetDelegateKeys(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_UpdateDelegateKeys(t *testing.T) {
+	ethPrivKey1, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	ethPrivKey2, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		env         = CreateTestEnv(t)
+		ctx         = env.Context
+		gk          = env.GravityKeeper
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		orcAddr2, _ = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+		ethAddr1    = crypto.PubkeyToAddress(ethPrivKey1.PublicKey)
+		ethAddr2    = crypto.PubkeyToAddress(ethPrivKey2.PublicKey)
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr1)
+
+	acc := env.AccountKeeper.NewAccountWithAddress(ctx, orcAddr1)
+	acc.SetSequence(1)
+	env.AccountKeeper.SetAccount(ctx, acc)
+
+	msgServer := NewMsgServerImpl(gk)
+
+	signMsgBz := env.Marshaler.MustMarshal(&types.DelegateKeysSignMsg{
+		ValidatorAddress: valAddr1.String(),
+		Nonce:            0,
+	})
+	hash := crypto.Keccak256Hash(signMsgBz).Bytes()
+
+	sig1, err := types.NewEthereumSignature(hash, ethPrivKey1)
+	require.NoError(t, err)
+	sig2, err := types.NewEthereumSignature(hash, ethPrivKey2)
+	require.NoError(t, err)
+
+	// rotating requires existing delegate keys
+	_, err = msgServer.UpdateDelegateKeys(sdk.WrapSDKContext(ctx), types.NewMsgUpdateDelegateKeys(valAddr1, orcAddr2, ethAddr2.Hex(), sig2))
+	require.Error(t, err)
+
+	_, err = msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), types.NewMsgDelegateKeys(valAddr1, orcAddr1, ethAddr1.Hex(), sig1))
+	require.NoError(t, err)
+
+	// the new key must sign
+	_, err = msgServer.UpdateDelegateKeys(sdk.WrapSDKContext(ctx), types.NewMsgUpdateDelegateKeys(valAddr1, orcAddr2, ethAddr2.Hex(), sig1))
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateDelegateKeys(sdk.WrapSDKContext(ctx), types.NewMsgUpdateDelegateKeys(valAddr1, orcAddr2, ethAddr2.Hex(), sig2))
+	require.NoError(t, err)
+
+	// the orchestrator is replaced immediately
+	require.Nil(t, gk.GetOrchestratorValidatorAddress(ctx, orcAddr1))
+	require.Equal(t, valAddr1, gk.GetOrchestratorValidatorAddress(ctx, orcAddr2))
+	require.Equal(t, orcAddr2, gk.GetEthereumOrchestratorAddress(ctx, ethAddr1))
+
+	// the ethereum address waits on the next signer set
+	require.Equal(t, ethAddr1, gk.GetValidatorEthereumAddress(ctx, valAddr1))
+	pending, ok := gk.GetPendingValidatorEthereumAddress(ctx, valAddr1)
+	require.True(t, ok)
+	require.Equal(t, ethAddr2, pending)
+	require.Equal(t, ethAddr2.Hex(), gk.CurrentSignerSet(ctx)[0].EthereumAddress)
+
+	gk.applyPendingEthereumAddresses(ctx, types.EthereumSigners{{Power: 1, EthereumAddress: ethAddr2.Hex()}})
+
+	require.Equal(t, ethAddr2, gk.GetValidatorEthereumAddress(ctx, valAddr1))
+	require.Nil(t, gk.GetEthereumOrchestratorAddress(ctx, ethAddr1))
+	require.Equal(t, orcAddr2, gk.GetEthereumOrchestratorAddress(ctx, ethAddr2))
+	_, ok = gk.GetPendingValidatorEthereumAddress(ctx, valAddr1)
+	require.False(t, ok)
+}
+
+func TestMsgServer_RevokeOrchestratorKey(t *testing.T) {
+	var (
+		env         = CreateTestEnv(t)
+		ctx         = env.Context
+		gk          = env.GravityKeeper
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+		ethAddr1    = common.HexToAddress("0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09")
+	)
+
+	msgServer := NewMsgServerImpl(gk)
+
+	_, err := msgServer.RevokeOrchestratorKey(sdk.WrapSDKContext(ctx), types.NewMsgRevokeOrchestratorKey(valAddr1))
+	require.Error(t, err)
+
+	gk.SetOrchestratorValidatorAddress(ctx, valAddr1, orcAddr1)
+	gk.setValidatorEthereumAddress(ctx, valAddr1, ethAddr1)
+	gk.setEthereumOrchestratorAddress(ctx, ethAddr1, orcAddr1)
+
+	_, err = msgServer.RevokeOrchestratorKey(sdk.WrapSDKContext(ctx), types.NewMsgRevokeOrchestratorKey(valAddr1))
+	require.NoError(t, err)
+
+	require.Nil(t, gk.GetOrchestratorValidatorAddress(ctx, orcAddr1))
+	require.Nil(t, gk.GetEthereumOrchestratorAddress(ctx, ethAddr1))
+	require.Equal(t, ethAddr1, gk.GetValidatorEthereumAddress(ctx, valAddr1))
+}
+
+func TestMsgServer_OptOutOfBridge(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(ValAddrs[0], ValAddrs[1])
+	msgServer := NewMsgServerImpl(gk)
+	for i, val := range []sdk.ValAddress{ValAddrs[0], ValAddrs[1]} {
+		gk.setValidatorEthereumAddress(ctx, val, EthAddrs[i])
+	}
+	require.Len(t, gk.CurrentSignerSet(ctx), 2)
+
+	// only existing validators can opt out
+	_, err := msgServer.OptOutOfBridge(sdk.WrapSDKContext(ctx), types.NewMsgOptOutOfBridge(ValAddrs[2]))
+	require.Error(t, err)
+
+	_, err = msgServer.OptOutOfBridge(sdk.WrapSDKContext(ctx), types.NewMsgOptOutOfBridge(ValAddrs[1]))
+	require.NoError(t, err)
+	require.True(t, gk.IsValidatorOptedOut(ctx, ValAddrs[1]))
+
+	signers := gk.CurrentSignerSet(ctx)
+	require.Len(t, signers, 1)
+	require.Equal(t, EthAddrs[0].Hex(), signers[0].EthereumAddress)
+
+	_, err = msgServer.OptOutOfBridge(sdk.WrapSDKContext(ctx), types.NewMsgOptOutOfBridge(ValAddrs[1]))
+	require.ErrorIs(t, err, types.ErrValidatorOptedOut)
+}
+
+func TestMsgServer_FreezeOrchestrator(t *testing.T) {
+	ethPrivKey1, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	ethPrivKey2, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		env         = CreateTestEnv(t)
+		ctx         = env.Context
+		gk          = env.GravityKeeper
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		orcAddr2, _ = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+		ethAddr1    = crypto.PubkeyToAddress(ethPrivKey1.PublicKey)
+		ethAddr2    = crypto.PubkeyToAddress(ethPrivKey2.PublicKey)
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr1)
+	params := gk.GetParams(ctx)
+	params.OrchestratorFreezeCooldown = 100
+	gk.SetParams(ctx, params)
+
+	acc := env.AccountKeeper.NewAccountWithAddress(ctx, orcAddr1)
+	acc.SetSequence(1)
+	env.AccountKeeper.SetAccount(ctx, acc)
+
+	msgServer := NewMsgServerImpl(gk)
+
+	hash := crypto.Keccak256Hash(env.Marshaler.MustMarshal(&types.DelegateKeysSignMsg{
+		ValidatorAddress: valAddr1.String(),
+		Nonce:            0,
+	})).Bytes()
+	sig1, err := types.NewEthereumSignature(hash, ethPrivKey1)
+	require.NoError(t, err)
+	sig2, err := types.NewEthereumSignature(hash, ethPrivKey2)
+	require.NoError(t, err)
+
+	// only delegate keys that are set can be frozen
+	_, err = msgServer.FreezeOrchestrator(sdk.WrapSDKContext(ctx), types.NewMsgFreezeOrchestrator(valAddr1))
+	require.ErrorIs(t, err, types.ErrDelegateKeys)
+
+	_, err = msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), types.NewMsgDelegateKeys(valAddr1, orcAddr2, ethAddr1.Hex(), sig1))
+	require.NoError(t, err)
+
+	_, err = msgServer.FreezeOrchestrator(sdk.WrapSDKContext(ctx), types.NewMsgFreezeOrchestrator(valAddr1))
+	require.NoError(t, err)
+
+	// the compromised keys no longer map to the validator, which leaves the signer set
+	require.Nil(t, gk.GetOrchestratorValidatorAddress(ctx, orcAddr2))
+	require.Nil(t, gk.GetEthereumOrchestratorAddress(ctx, ethAddr1))
+	require.Equal(t, common.Address{}, gk.GetValidatorEthereumAddress(ctx, valAddr1))
+	require.Empty(t, gk.CurrentSignerSet(ctx))
+	_, err = gk.getSignerValidator(ctx, orcAddr2.String())
+	require.Error(t, err)
+	require.True(t, gk.IsOrchestratorFreezeCoolingDown(ctx, valAddr1))
+
+	// new keys wait on the cooldown and can't be the frozen ones
+	_, err = msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), types.NewMsgDelegateKeys(valAddr1, orcAddr1, ethAddr2.Hex(), sig2))
+	require.ErrorIs(t, err, types.ErrOrchestratorFrozen)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 100)
+	require.False(t, gk.IsOrchestratorFreezeCoolingDown(ctx, valAddr1))
+	_, err = msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), types.NewMsgDelegateKeys(valAddr1, orcAddr2, ethAddr2.Hex(), sig2))
+	require.ErrorIs(t, err, types.ErrOrchestratorFrozen)
+
+	_, err = msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), types.NewMsgDelegateKeys(valAddr1, orcAddr1, ethAddr2.Hex(), sig2))
+	require.NoError(t, err)
+	require.Equal(t, valAddr1, gk.GetOrchestratorValidatorAddress(ctx, orcAddr1))
+	_, frozen := gk.GetOrchestratorFreeze(ctx, valAddr1)
+	require.False(t, frozen)
+}
+
 func TestMsgServer_SubmitEthereumHeightVote(t *testing.T) {
 	var (
 		env = CreateTestEnv(t)
@@ -461,6 +1054,82 @@ func TestMsgServer_SubmitEthereumHeightVote(t *testing.T) {
 	require.Equal(t, gk.GetEthereumHeightVote(ctx, valAddr1).EthereumHeight, uint64(5))
 }
 
+func TestMsgServer_ERC20DeployedConfirm(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+
+		denom     = "uatom"
+		contract1 = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		contract2 = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr1)
+	gk.SetOrchestratorValidatorAddress(ctx, valAddr1, orcAddr1)
+
+	metadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "atom",
+		Name:    "Cosmos Token",
+		Symbol:  "ATOM",
+	}
+	env.BankKeeper.SetDenomMetaData(ctx, metadata)
+
+	for i, contract := range []common.Address{contract1, contract2} {
+		require.NoError(t, gk.Handle(ctx, &types.ERC20DeployedEvent{
+			EventNonce:    uint64(i + 1),
+			CosmosDenom:   denom,
+			TokenContract: contract.Hex(),
+			Erc20Name:     "Cosmos Token",
+			Erc20Symbol:   "ATOM",
+			Erc20Decimals: 6,
+		}))
+	}
+
+	res, err := gk.PendingERC20Deployments(sdk.WrapSDKContext(ctx), &types.PendingERC20DeploymentsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Deployments, 2)
+	_, _, err = gk.DenomToERC20Lookup(ctx, denom)
+	require.Error(t, err, "deployments are not adopted before being confirmed")
+
+	msgServer := NewMsgServerImpl(gk)
+	confirm := func(contract common.Address) error {
+		_, err := msgServer.ERC20DeployedConfirm(sdk.WrapSDKContext(ctx), types.NewMsgERC20DeployedConfirm(denom, contract, orcAddr1))
+		return err
+	}
+
+	// a deployment that was never observed cannot be confirmed
+	require.Error(t, confirm(common.HexToAddress("0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4")))
+
+	// the metadata is checked again on confirmation
+	metadata.Symbol = "XATOM"
+	env.BankKeeper.SetDenomMetaData(ctx, metadata)
+	require.ErrorIs(t, confirm(contract2), types.ErrInvalidERC20Event)
+
+	metadata.Symbol = "ATOM"
+	env.BankKeeper.SetDenomMetaData(ctx, metadata)
+	require.NoError(t, confirm(contract2))
+
+	cosmosOriginated, erc20, err := gk.DenomToERC20Lookup(ctx, denom)
+	require.NoError(t, err)
+	require.True(t, cosmosOriginated)
+	require.Equal(t, contract2, erc20)
+
+	// the other deployments of the denom are dropped
+	res, err = gk.PendingERC20Deployments(sdk.WrapSDKContext(ctx), &types.PendingERC20DeploymentsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Deployments)
+	require.Error(t, confirm(contract1))
+}
+
 func TestEthVerify(t *testing.T) {
 	// Replace privKeyHexStr and addrHexStr with your own private key and address
 	// HEX values.
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func (k Keeper) setOrchestratorFreeze(ctx sdk.Context, freeze types.OrchestratorFreeze) {
+	val, _ := sdk.ValAddressFromBech32(freeze.ValidatorAddress)
+	ctx.KVStore(k.storeKey).Set(types.MakeOrchestratorFreezeKey(val), k.cdc.MustMarshal(&freeze))
+}
+
+func (k Keeper) deleteOrchestratorFreeze(ctx sdk.Context, val sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeOrchestratorFreezeKey(val))
+}
+
+// GetOrchestratorFreeze returns the freeze of the delegate keys of a
+// validator, if they are frozen
+func (k Keeper) GetOrchestratorFreeze(ctx sdk.Context, val sdk.ValAddress) (types.OrchestratorFreeze, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeOrchestratorFreezeKey(val))
+	if bz == nil {
+		return types.OrchestratorFreeze{}, false
+	}
+
+	var freeze types.OrchestratorFreeze
+	k.cdc.MustUnmarshal(bz, &freeze)
+	return freeze, true
+}
+
+// IterateOrchestratorFreezes iterates over the frozen delegate keys of
+// validators
+func (k Keeper) IterateOrchestratorFreezes(ctx sdk.Context, cb func(types.OrchestratorFreeze) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.OrchestratorFreezeKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var freeze types.OrchestratorFreeze
+		k.cdc.MustUnmarshal(iter.Value(), &freeze)
+		if cb(freeze) {
+			break
+		}
+	}
+}
+
+// IsOrchestratorFreezeCoolingDown reports whether a validator froze its
+// delegate keys and can't set new ones yet
+func (k Keeper) IsOrchestratorFreezeCoolingDown(ctx sdk.Context, val sdk.ValAddress) bool {
+	freeze, ok := k.GetOrchestratorFreeze(ctx, val)
+	return ok && uint64(ctx.BlockHeight()) < freeze.CooldownEndHeight
+}
+
+// FreezeOrchestrator removes the orchestrator and ethereum address of a
+// validator at once, so that the signatures and events of its compromised
+// keys are rejected, and records the freeze until new delegate keys are set
+// after the cooldown. The validator is left bonded, but out of the next
+// signer sets.
+func (k Keeper) FreezeOrchestrator(ctx sdk.Context, val sdk.ValAddress) (types.OrchestratorFreeze, error) {
+	ethAddr := k.GetValidatorEthereumAddress(ctx, val)
+	if ethAddr == (common.Address{}) {
+		return types.OrchestratorFreeze{}, sdkerrors.Wrapf(types.ErrDelegateKeys, "no delegate keys set for validator %s", val)
+	}
+
+	freeze := types.OrchestratorFreeze{
+		ValidatorAddress:  val.String(),
+		EthereumAddress:   ethAddr.Hex(),
+		FrozenHeight:      uint64(ctx.BlockHeight()),
+		CooldownEndHeight: uint64(ctx.BlockHeight()) + k.GetParams(ctx).OrchestratorFreezeCooldown,
+	}
+	if orchAddr := k.GetEthereumOrchestratorAddress(ctx, ethAddr); orchAddr != nil {
+		freeze.OrchestratorAddress = orchAddr.String()
+		k.deleteOrchestratorValidatorAddress(ctx, orchAddr)
+	}
+	k.deleteEthereumOrchestratorAddress(ctx, ethAddr)
+	k.deletePendingValidatorEthereumAddress(ctx, val)
+	k.deleteValidatorEthereumAddress(ctx, val)
+	k.setOrchestratorFreeze(ctx, freeze)
+
+	k.Logger(ctx).Info("delegate keys frozen",
+		"validator", val.String(),
+		"orchestrator", freeze.OrchestratorAddress,
+		"ethereum_address", freeze.EthereumAddress,
+	)
+	return freeze, nil
+}
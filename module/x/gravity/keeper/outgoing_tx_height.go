@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func (k Keeper) setOutgoingTxHeight(ctx sdk.Context, otx types.OutgoingTx) {
+	ctx.KVStore(k.storeKey).Set(types.MakeOutgoingTxHeightKey(otx.GetCosmosHeight(), otx.GetStoreIndex()), []byte{0x1})
+}
+
+func (k Keeper) deleteOutgoingTxHeight(ctx sdk.Context, otx types.OutgoingTx) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeOutgoingTxHeightKey(otx.GetCosmosHeight(), otx.GetStoreIndex()))
+}
+
+// iterateOutgoingTxsByHeight iterates over the outgoing txs created in the
+// cosmos height range [start, end), by height ascending
+func (k Keeper) iterateOutgoingTxsByHeight(ctx sdk.Context, start, end uint64, cb func(otx types.OutgoingTx) (stop bool)) {
+	if start >= end {
+		return
+	}
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.OutgoingTxHeightKey}).
+		Iterator(sdk.Uint64ToBigEndian(start), sdk.Uint64ToBigEndian(end))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// the key is the height followed by the store index
+		otx, err := k.GetOutgoingTx(ctx, iter.Key()[8:])
+		if err != nil || otx.GetCosmosHeight() != sdk.BigEndianToUint64(iter.Key()[:8]) {
+			continue
+		}
+		if cb(otx) {
+			break
+		}
+	}
+}
+
+// reindexOutgoingTxHeights indexes the outgoing txs created before the index
+// by height existed
+func (k Keeper) reindexOutgoingTxHeights(ctx sdk.Context) {
+	// collect first, the store can't be written while it is being iterated
+	var otxs []types.OutgoingTx
+	k.iterateOutgoingTxs(ctx, func(_ []byte, otx types.OutgoingTx) bool {
+		otxs = append(otxs, otx)
+		return false
+	})
+
+	for _, otx := range otxs {
+		k.setOutgoingTxHeight(ctx, otx)
+	}
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func (k Keeper) setOutgoingTxTimeout(ctx sdk.Context, otx types.OutgoingTx) {
+	if timeout, ok := types.OutgoingTxTimeout(otx); ok {
+		ctx.KVStore(k.storeKey).Set(types.MakeOutgoingTxTimeoutKey(timeout, otx.GetStoreIndex()), []byte{0x1})
+	}
+}
+
+func (k Keeper) deleteOutgoingTxTimeout(ctx sdk.Context, otx types.OutgoingTx) {
+	if timeout, ok := types.OutgoingTxTimeout(otx); ok {
+		ctx.KVStore(k.storeKey).Delete(types.MakeOutgoingTxTimeoutKey(timeout, otx.GetStoreIndex()))
+	}
+}
+
+// IterateTimedOutOutgoingTxs iterates over the outgoing txs of a type whose
+// timeout is below the ethereum height, by timeout ascending. The txs are
+// collected before the callback is called so that it can delete them, a tx
+// deleted by the callback of an earlier one is skipped.
+func (k Keeper) IterateTimedOutOutgoingTxs(ctx sdk.Context, prefixByte byte, ethereumHeight uint64, cb func(otx types.OutgoingTx) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.OutgoingTxTimeoutKey, prefixByte})
+	iter := prefixStore.Iterator(nil, sdk.Uint64ToBigEndian(ethereumHeight))
+
+	var storeIndexes [][]byte
+	for ; iter.Valid(); iter.Next() {
+		// the key is the timeout followed by the store index
+		storeIndexes = append(storeIndexes, iter.Key()[8:])
+	}
+	iter.Close()
+
+	for _, storeIndex := range storeIndexes {
+		otx, err := k.GetOutgoingTx(ctx, storeIndex)
+		if err != nil {
+			continue
+		}
+		if cb(otx) {
+			break
+		}
+	}
+}
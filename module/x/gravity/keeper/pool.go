@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"encoding/binary"
-	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -16,11 +15,67 @@ import (
 // createSendToEthereum
 // - checks a counterpart denominator exists for the given voucher type
 // - burns the voucher for transfer amount and fees
+// - escrows the fee instead if it is paid in the bridge fee denom
+// - escrows the chain fee deducted from the amount until the tx's batch executes
 // - persists an OutgoingTx
 // - adds the TX to the `available` TX pool via a second index
+// - or holds it in the delayed send queue if it exceeds the delayed withdrawal threshold
 func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, counterpartReceiver string, amount sdk.Coin, fee sdk.Coin) (uint64, error) {
+	return k.createSendToEthereumWithPriority(ctx, sender, counterpartReceiver, amount, fee, false)
+}
+
+// createSendToEthereumWithPriority creates a send to ethereum as
+// createSendToEthereum does, flagged as a priority tx if requested. Callers
+// must only request priority on behalf of sender module accounts.
+func (k Keeper) createSendToEthereumWithPriority(ctx sdk.Context, sender sdk.AccAddress, counterpartReceiver string, amount sdk.Coin, fee sdk.Coin, priority bool) (uint64, error) {
+	return k.createSendToEthereumTx(ctx, sender, nil, counterpartReceiver, amount, fee, priority, 0)
+}
+
+// createSendToEthereumWithFeeGranter creates a send to ethereum as
+// createSendToEthereum does, with the fee paid by the fee granter, if any, out
+// of the bridge fee allowance it granted the sender. A non zero execute after
+// height keeps the send out of batches up to that height.
+func (k Keeper) createSendToEthereumWithFeeGranter(ctx sdk.Context, sender sdk.AccAddress, feeGranter sdk.AccAddress, counterpartReceiver string, amount sdk.Coin, fee sdk.Coin, executeAfterHeight uint64) (uint64, error) {
+	return k.createSendToEthereumTx(ctx, sender, feeGranter, counterpartReceiver, amount, fee, false, executeAfterHeight)
+}
+
+func (k Keeper) createSendToEthereumTx(ctx sdk.Context, sender sdk.AccAddress, feeGranter sdk.AccAddress, counterpartReceiver string, amount sdk.Coin, fee sdk.Coin, priority bool, executeAfterHeight uint64) (uint64, error) {
+	params := k.GetParams(ctx)
+	if params.MirrorMode {
+		return 0, sdkerrors.Wrap(types.ErrMirrorMode, "cannot send to ethereum")
+	}
+
+	if k.IsEthereumAddressBlocklisted(ctx, common.HexToAddress(counterpartReceiver)) {
+		return 0, sdkerrors.Wrapf(types.ErrEthereumAddressBlocklisted, "recipient %s", counterpartReceiver)
+	}
+
+	// the fee as given, which the fee granter pays if there is one
+	grantedFee := fee
+
+	// a fee in another denom than the amount must be paid in the bridge fee
+	// denom, it is kept on the cosmos side and the erc20 fee left zero
+	var bridgeFee sdk.Coin
+	if fee.Denom != amount.Denom {
+		if bridgeFeeDenom := params.BridgeFeeDenom; bridgeFeeDenom == "" || fee.Denom != bridgeFeeDenom {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fee must be paid in %s or the bridge fee denom, got %s", amount.Denom, fee.Denom)
+		}
+		bridgeFee = fee
+		fee = sdk.NewCoin(amount.Denom, sdk.ZeroInt())
+	}
+
 	totalAmount := amount.Add(fee)
 	totalInVouchers := sdk.Coins{totalAmount}
+	if bridgeFee.IsValid() && bridgeFee.IsPositive() {
+		totalInVouchers = totalInVouchers.Add(bridgeFee)
+	}
+
+	// the chain fee comes out of the amount, so the sender pays the same
+	// vouchers and the recipient receives less on ethereum
+	chainFee := sdk.NewCoin(amount.Denom, types.ChainFeeAmount(amount.Amount, params.ChainFeeBasisPoints))
+	amount = amount.Sub(chainFee)
+	if !amount.IsPositive() {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "nothing left to send after the chain fee of %s", chainFee)
+	}
 
 	// If the coin is a gravity voucher, burn the coins. If not, check if there is a deployed ERC20 contract representing it.
 	// If there is, lock the coins.
@@ -30,16 +85,54 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 		return 0, err
 	}
 
+	if !k.IsTokenAllowlisted(ctx, tokenContract) {
+		return 0, sdkerrors.Wrap(types.ErrTokenNotAllowlisted, tokenContract.Hex())
+	}
+
+	// the gravity contract may hold less of a fee on transfer token than the
+	// vouchers of it, so it can't be withdrawn without draining other deposits
+	if k.IsFeeOnTransferToken(ctx, tokenContract) {
+		return 0, sdkerrors.Wrap(types.ErrFeeOnTransferToken, tokenContract.Hex())
+	}
+
+	if pause, ok := k.GetTokenPause(ctx, tokenContract); ok {
+		return 0, sdkerrors.Wrapf(types.ErrTokenPaused, "%s: %s", tokenContract.Hex(), pause.Anomaly)
+	}
+
+	// the pool holds amounts in the units of the ERC20
+	erc20Amount, err := k.CosmosToERC20Amount(ctx, tokenContract, amount.Amount)
+	if err != nil {
+		return 0, err
+	}
+	erc20Fee, err := k.CosmosToERC20Amount(ctx, tokenContract, fee.Amount)
+	if err != nil {
+		return 0, err
+	}
+
 	if senderModule, ok := k.SenderModuleAccounts[sender.String()]; ok {
 		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, totalInVouchers); err != nil {
 			return 0, err
 		}
+	} else if feeGranter != nil && grantedFee.IsPositive() {
+		// the sender escrows the amount and the granter the fee
+		if err := k.useBridgeFeeAllowance(ctx, feeGranter, sender, grantedFee); err != nil {
+			return 0, err
+		}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, feeGranter, types.ModuleName, sdk.Coins{grantedFee}); err != nil {
+			return 0, err
+		}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, totalInVouchers.Sub(grantedFee)); err != nil {
+			return 0, err
+		}
 	} else {
 		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, totalInVouchers); err != nil {
 			return 0, err
 		}
 	}
 
+	k.recordBridgeOutflow(ctx, tokenContract, erc20Amount.Add(erc20Fee))
+	k.recordBridgeStatsOutflow(ctx, tokenContract, erc20Amount, erc20Fee)
+
 	// get next tx id from keeper
 	nextID := k.incrementLastSendToEthereumIDKey(ctx)
 
@@ -48,13 +141,32 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 	// rather than the denom that is the input to this function.
 
 	// set the outgoing tx in the pool index
-	k.setUnbatchedSendToEthereum(ctx, &types.SendToEthereum{
-		Id:                nextID,
-		Sender:            sender.String(),
-		EthereumRecipient: counterpartReceiver,
-		Erc20Token:        types.NewSDKIntERC20Token(amount.Amount, tokenContract),
-		Erc20Fee:          types.NewSDKIntERC20Token(fee.Amount, tokenContract),
-	})
+	ste := &types.SendToEthereum{
+		Id:                 nextID,
+		Sender:             sender.String(),
+		EthereumRecipient:  counterpartReceiver,
+		Erc20Token:         types.NewSDKIntERC20Token(erc20Amount, tokenContract),
+		Erc20Fee:           types.NewSDKIntERC20Token(erc20Fee, tokenContract),
+		Height:             uint64(ctx.BlockHeight()),
+		Priority:           priority,
+		ExecuteAfterHeight: executeAfterHeight,
+	}
+	if bridgeFee.IsValid() && bridgeFee.IsPositive() {
+		ste.BridgeFee = &bridgeFee
+	}
+	if chainFee.IsPositive() {
+		ste.ChainFee = &chainFee
+	}
+	if feeGranter != nil && grantedFee.IsPositive() {
+		ste.FeeGranter = feeGranter.String()
+	}
+
+	if threshold, ok := k.getDelayedWithdrawalThreshold(ctx, tokenContract); ok && erc20Amount.GT(threshold) {
+		k.delaySendToEthereum(ctx, ste)
+		return nextID, nil
+	}
+	k.setUnbatchedSendToEthereum(ctx, ste)
+	k.AfterSendToEthereumPooled(ctx, *ste)
 
 	return nextID, nil
 }
@@ -62,7 +174,7 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 // cancelSendToEthereum
 // - checks that the provided tx actually exists
 // - deletes the unbatched tx from the pool
-// - issues the tokens back to the sender
+// - issues the tokens back to the sender, chain fee included
 func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error {
 	sender, _ := sdk.AccAddressFromBech32(s)
 
@@ -74,18 +186,14 @@ func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error
 	}
 	if send == nil {
 		// NOTE: this case will also be hit if the transaction is in a batch
-		return sdkerrors.Wrap(types.ErrInvalid, "id not found in send to ethereum pool")
+		return sdkerrors.Wrapf(types.ErrSendToEthereumNotFound, "id %d not in the unbatched pool", id)
 	}
 
 	if sender.String() != send.Sender {
-		return fmt.Errorf("can't cancel a message you didn't send")
+		return sdkerrors.Wrapf(types.ErrNotSender, "can't cancel send to ethereum %d", id)
 	}
 
-	_, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(send.Erc20Token.Contract))
-	amountToRefund := send.Erc20Token.Amount.Add(send.Erc20Fee.Amount)
-	coinsToRefund := sdk.NewCoins(sdk.NewCoin(denom, amountToRefund))
-
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coinsToRefund); err != nil {
+	if err := k.refundSendToEthereum(ctx, *send); err != nil {
 		return sdkerrors.Wrap(err, "sending coins from module account")
 	}
 
@@ -101,12 +209,18 @@ func (k Keeper) deleteUnbatchedSendToEthereum(ctx sdk.Context, id uint64, fee ty
 	ctx.KVStore(k.storeKey).Delete(types.MakeSendToEthereumKey(id, fee))
 }
 
+// iterateUnbatchedSendToEthereumsByContract iterates over the unbatched txs of
+// a token by fee descending, then by id ascending. Scheduled txs are skipped
+// up to their execute after height, so they are only batched after it.
 func (k Keeper) iterateUnbatchedSendToEthereumsByContract(ctx sdk.Context, contract common.Address, cb func(*types.SendToEthereum) bool) {
 	iter := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.SendToEthereumKey}, contract.Bytes()...)).ReverseIterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		var ste types.SendToEthereum
 		k.cdc.MustUnmarshal(iter.Value(), &ste)
+		if ste.ExecuteAfterHeight >= uint64(ctx.BlockHeight()) {
+			continue
+		}
 		if cb(&ste) {
 			break
 		}
@@ -125,6 +239,18 @@ func (k Keeper) IterateUnbatchedSendToEthereums(ctx sdk.Context, cb func(*types.
 	}
 }
 
+// countUnbatchedSendToEthereums returns the number of the sender's txs in the
+// unbatched pool
+func (k Keeper) countUnbatchedSendToEthereums(ctx sdk.Context, sender sdk.AccAddress) (count uint64) {
+	k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		if ste.Sender == sender.String() {
+			count++
+		}
+		return false
+	})
+	return count
+}
+
 func (k Keeper) getUnbatchedSendToEthereums(ctx sdk.Context) []*types.SendToEthereum {
 	var out []*types.SendToEthereum
 	k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
@@ -135,14 +261,18 @@ func (k Keeper) getUnbatchedSendToEthereums(ctx sdk.Context) []*types.SendToEthe
 }
 
 func (k Keeper) incrementLastSendToEthereumIDKey(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte{types.LastSendToEthereumIDKey})
-	var id uint64 = 0
-	if bz != nil {
-		id = binary.BigEndian.Uint64(bz)
-	}
-	newId := id + 1
-	bz = sdk.Uint64ToBigEndian(newId)
-	store.Set([]byte{types.LastSendToEthereumIDKey}, bz)
+	newId := k.getLastSendToEthereumID(ctx) + 1
+	k.setLastSendToEthereumID(ctx, newId)
 	return newId
 }
+
+func (k Keeper) getLastSendToEthereumID(ctx sdk.Context) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastSendToEthereumIDKey}); bz != nil {
+		return binary.BigEndian.Uint64(bz)
+	}
+	return 0
+}
+
+func (k Keeper) setLastSendToEthereumID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastSendToEthereumIDKey}, sdk.Uint64ToBigEndian(id))
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
@@ -38,10 +39,10 @@ func TestAddToOutgoingPool(t *testing.T) {
 	})
 
 	exp := []*types.SendToEthereum{
-		types.NewSendToEthereumTx(2, myTokenContractAddr, mySender, myReceiver, 101, 3),
-		types.NewSendToEthereumTx(3, myTokenContractAddr, mySender, myReceiver, 102, 2),
-		types.NewSendToEthereumTx(1, myTokenContractAddr, mySender, myReceiver, 100, 2),
-		types.NewSendToEthereumTx(4, myTokenContractAddr, mySender, myReceiver, 103, 1),
+		newPoolSendToEthereumTx(2, myTokenContractAddr, mySender, myReceiver, 101, 3),
+		newPoolSendToEthereumTx(1, myTokenContractAddr, mySender, myReceiver, 100, 2),
+		newPoolSendToEthereumTx(3, myTokenContractAddr, mySender, myReceiver, 102, 2),
+		newPoolSendToEthereumTx(4, myTokenContractAddr, mySender, myReceiver, 103, 1),
 	}
 
 	require.Equal(t, exp, got)
@@ -51,3 +52,119 @@ func TestAddToOutgoingPool(t *testing.T) {
 	require.EqualValues(t, exp[3], got[3])
 	require.Len(t, got, 4)
 }
+
+func TestERC20Conversion(t *testing.T) {
+	var (
+		input = CreateTestEnv(t)
+		ctx   = input.Context
+		gk    = input.GravityKeeper
+
+		mySender, _   = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		ethReceiver   = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		contract      = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom         = "uatom"
+	)
+
+	input.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "atom",
+		Name:    "Cosmos Token",
+		Symbol:  "ATOM",
+	})
+
+	// an ERC20 with more decimals than the denom is scaled once confirmed
+	require.NoError(t, gk.Handle(ctx, &types.ERC20DeployedEvent{
+		EventNonce:    1,
+		CosmosDenom:   denom,
+		TokenContract: contract.Hex(),
+		Erc20Name:     "Cosmos Token",
+		Erc20Symbol:   "ATOM",
+		Erc20Decimals: 18,
+	}))
+	require.NoError(t, gk.confirmERC20Deployment(ctx, denom, contract))
+
+	res, err := gk.ERC20Conversion(sdk.WrapSDKContext(ctx), &types.ERC20ConversionRequest{Erc20: contract.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, types.NewERC20Conversion(contract, 18, 6), res.Conversion)
+	require.Equal(t, denom, res.Denom)
+	require.Equal(t, sdk.NewInt(1_000_000_000_000), res.Factor)
+
+	// amounts leaving cosmos are scaled up to the ERC20 decimals
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, sdk.NewCoins(sdk.NewInt64Coin(denom, 20))))
+	id, err := gk.createSendToEthereum(ctx, mySender, ethReceiver.Hex(), sdk.NewInt64Coin(denom, 5), sdk.NewInt64Coin(denom, 1))
+	require.NoError(t, err)
+
+	var sends []*types.SendToEthereum
+	gk.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		sends = append(sends, ste)
+		return false
+	})
+	require.Len(t, sends, 1)
+	require.Equal(t, sdk.NewInt(5_000_000_000_000), sends[0].Erc20Token.Amount)
+	require.Equal(t, sdk.NewInt(1_000_000_000_000), sends[0].Erc20Fee.Amount)
+
+	// amounts arriving from ethereum are truncated to the precision of the denom
+	require.NoError(t, gk.sendToCosmos(ctx, gk.GetParams(ctx), &types.SendToCosmosEvent{
+		EventNonce:     2,
+		TokenContract:  contract.Hex(),
+		Amount:         sdk.NewInt(2_500_000_000_000),
+		EthereumSender: ethReceiver.Hex(),
+		CosmosReceiver: myReceiver.String(),
+	}))
+	require.Equal(t, sdk.NewInt(2), input.BankKeeper.GetAllBalances(ctx, myReceiver).AmountOf(denom))
+
+	// a deposit below the precision of the denom is not credited
+	require.NoError(t, gk.sendToCosmos(ctx, gk.GetParams(ctx), &types.SendToCosmosEvent{
+		EventNonce:     3,
+		TokenContract:  contract.Hex(),
+		Amount:         sdk.NewInt(999_999_999_999),
+		EthereumSender: ethReceiver.Hex(),
+		CosmosReceiver: myReceiver.String(),
+	}))
+	require.Equal(t, sdk.NewInt(2), input.BankKeeper.GetAllBalances(ctx, myReceiver).AmountOf(denom))
+	// and doesn't count towards the inflow of the token
+	require.Equal(t, sdk.NewInt(2_500_000_000_000), gk.GetBridgeFlow(ctx, contract).Inflow)
+
+	// cancelling refunds the amount in the units of the denom
+	// restore the escrow paid out by the deposit above
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 2))))
+	require.NoError(t, gk.cancelSendToEthereum(ctx, id, mySender.String()))
+	require.Equal(t, sdk.NewInt(20), input.BankKeeper.GetAllBalances(ctx, mySender).AmountOf(denom))
+}
+
+func TestScheduledSendToEthereum(t *testing.T) {
+	var (
+		input         = CreateTestEnv(t)
+		ctx           = input.Context.WithBlockHeight(10)
+		gk            = input.GravityKeeper
+		msgServer     = NewMsgServerImpl(gk)
+		mySender, _   = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver    = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	voucher := MintVouchersFromAir(t, ctx, gk, mySender, types.NewERC20Token(1000, tokenContract))
+
+	msg := types.NewMsgSendToEthereum(mySender, myReceiver.Hex(), sdk.NewCoin(voucher.Denom, sdk.NewInt(100)), sdk.NewCoin(voucher.Denom, sdk.NewInt(10)))
+	msg.ExecuteAfterHeight = 20
+	_, err := msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// the send is escrowed right away
+	require.Equal(t, int64(890), input.BankKeeper.GetBalance(ctx, mySender, voucher.Denom).Amount.Int64())
+
+	// but not batched up to its execute after height
+	require.Nil(t, gk.BuildBatchTx(ctx.WithBlockHeight(20), tokenContract, 10))
+
+	batch := gk.BuildBatchTx(ctx.WithBlockHeight(21), tokenContract, 10)
+	require.NotNil(t, batch)
+	require.Len(t, batch.Transactions, 1)
+	require.Equal(t, uint64(20), batch.Transactions[0].ExecuteAfterHeight)
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestHandleCommunityPoolEthereumSpendProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	erc20 := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	recipient := common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	gk.setCosmosOriginatedDenomToERC20(ctx, "stake", erc20)
+
+	feePool := input.DistKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoinsFromCoins(sdk.NewInt64Coin("stake", 1000))
+	input.DistKeeper.SetFeePool(ctx, feePool)
+
+	// spending more than the community pool holds fails
+	proposal := types.NewCommunityPoolEthereumSpendProposal("title", "description", recipient.Hex(),
+		sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("stake", 1))
+	require.ErrorIs(t, gk.HandleCommunityPoolEthereumSpendProposal(ctx, proposal), distributiontypes.ErrBadDistribution)
+
+	proposal = types.NewCommunityPoolEthereumSpendProposal("title", "description", recipient.Hex(),
+		sdk.NewInt64Coin("stake", 900), sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, gk.HandleCommunityPoolEthereumSpendProposal(ctx, proposal))
+
+	// the community pool is debited and the coins are locked in the gravity module
+	require.True(t, input.DistKeeper.GetFeePool(ctx).CommunityPool.IsZero())
+	moduleAddr := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetBalance(ctx, moduleAddr, "stake").Amount)
+
+	// the spend waits in the pool as an ERC20 transfer to the recipient
+	unbatched := gk.getUnbatchedSendToEthereums(ctx)
+	require.Len(t, unbatched, 1)
+	require.Equal(t, authtypes.NewModuleAddress(distributiontypes.ModuleName).String(), unbatched[0].Sender)
+	require.Equal(t, recipient.Hex(), unbatched[0].EthereumRecipient)
+	require.Equal(t, types.NewSDKIntERC20Token(sdk.NewInt(900), erc20), unbatched[0].Erc20Token)
+	require.Equal(t, types.NewSDKIntERC20Token(sdk.NewInt(100), erc20), unbatched[0].Erc20Fee)
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func (k Keeper) setOrchestratorQueryIdentity(ctx sdk.Context, identity types.OrchestratorQueryIdentity) {
+	ctx.KVStore(k.storeKey).Set(types.MakeOrchestratorQueryIdentityKey(identity.KeyHash), k.cdc.MustMarshal(&identity))
+}
+
+// GetOrchestratorQueryIdentity returns the identity registered for an API key
+// hash, if it has not expired yet
+func (k Keeper) GetOrchestratorQueryIdentity(ctx sdk.Context, keyHash []byte) (types.OrchestratorQueryIdentity, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeOrchestratorQueryIdentityKey(keyHash))
+	if bz == nil {
+		return types.OrchestratorQueryIdentity{}, false
+	}
+
+	var identity types.OrchestratorQueryIdentity
+	k.cdc.MustUnmarshal(bz, &identity)
+	if identity.IsExpired(uint64(ctx.BlockHeight())) {
+		return types.OrchestratorQueryIdentity{}, false
+	}
+	return identity, true
+}
+
+// IterateOrchestratorQueryIdentities iterates over the stored orchestrator
+// query identities, including the expired ones not pruned yet
+func (k Keeper) IterateOrchestratorQueryIdentities(ctx sdk.Context, cb func(types.OrchestratorQueryIdentity) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.OrchestratorQueryIdentityKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var identity types.OrchestratorQueryIdentity
+		k.cdc.MustUnmarshal(iter.Value(), &identity)
+		if cb(identity) {
+			break
+		}
+	}
+}
+
+// RegisterOrchestratorQueryIdentity registers the API key hash of an
+// orchestrator for the identity lifetime param, replacing the key it
+// registered before. Expired identities of other orchestrators are pruned
+// along the way.
+func (k Keeper) RegisterOrchestratorQueryIdentity(ctx sdk.Context, orchestrator sdk.AccAddress, keyHash []byte) types.OrchestratorQueryIdentity {
+	height := uint64(ctx.BlockHeight())
+
+	var keys [][]byte
+	k.IterateOrchestratorQueryIdentities(ctx, func(identity types.OrchestratorQueryIdentity) bool {
+		if identity.Orchestrator == orchestrator.String() || identity.IsExpired(height) {
+			keys = append(keys, types.MakeOrchestratorQueryIdentityKey(identity.KeyHash))
+		}
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	identity := types.OrchestratorQueryIdentity{
+		Orchestrator: orchestrator.String(),
+		KeyHash:      keyHash,
+		ExpiryHeight: height + k.GetParams(ctx).OrchestratorQueryIdentityLifetime,
+	}
+	k.setOrchestratorQueryIdentity(ctx, identity)
+
+	return identity
+}
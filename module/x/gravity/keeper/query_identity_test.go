@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestRegisterOrchestratorQueryIdentity(t *testing.T) {
+	var (
+		input = CreateTestEnv(t)
+		ctx   = input.Context
+		gk    = input.GravityKeeper
+
+		firstKey  = types.HashOrchestratorQueryKey("first")
+		secondKey = types.HashOrchestratorQueryKey("second")
+		otherKey  = types.HashOrchestratorQueryKey("other")
+	)
+
+	params := gk.GetParams(ctx)
+	params.OrchestratorQueryIdentityLifetime = 10
+	gk.SetParams(ctx, params)
+
+	identity := gk.RegisterOrchestratorQueryIdentity(ctx, AccAddrs[0], firstKey)
+	require.Equal(t, uint64(ctx.BlockHeight())+10, identity.ExpiryHeight)
+	gk.RegisterOrchestratorQueryIdentity(ctx, AccAddrs[1], otherKey)
+
+	res, err := gk.OrchestratorQueryIdentity(sdk.WrapSDKContext(ctx), &types.OrchestratorQueryIdentityRequest{KeyHash: firstKey})
+	require.NoError(t, err)
+	require.Equal(t, AccAddrs[0].String(), res.Identity.Orchestrator)
+
+	// registering a new key replaces the previous one of the orchestrator
+	gk.RegisterOrchestratorQueryIdentity(ctx, AccAddrs[0], secondKey)
+	_, found := gk.GetOrchestratorQueryIdentity(ctx, firstKey)
+	require.False(t, found)
+	_, found = gk.GetOrchestratorQueryIdentity(ctx, secondKey)
+	require.True(t, found)
+
+	// identities are not valid past their expiry height, and are pruned on the next registration
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	_, err = gk.OrchestratorQueryIdentity(sdk.WrapSDKContext(ctx), &types.OrchestratorQueryIdentityRequest{KeyHash: otherKey})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	gk.RegisterOrchestratorQueryIdentity(ctx, AccAddrs[0], firstKey)
+	var identities []types.OrchestratorQueryIdentity
+	gk.IterateOrchestratorQueryIdentities(ctx, func(identity types.OrchestratorQueryIdentity) bool {
+		identities = append(identities, identity)
+		return false
+	})
+	require.Len(t, identities, 1)
+	require.Equal(t, firstKey, identities[0].KeyHash)
+}
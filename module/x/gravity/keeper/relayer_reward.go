@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// relayerRewardAmount returns the share of the batch fees kept for the relayer
+// reward pool, in the units of the batch token's cosmos denom
+func (k Keeper) relayerRewardAmount(ctx sdk.Context, params types.Params, batchTx *types.BatchTx) sdk.Int {
+	fraction := params.RelayerRewardFraction
+	if fraction.IsNil() || fraction.IsZero() {
+		return sdk.ZeroInt()
+	}
+
+	fees := sdk.ZeroInt()
+	for _, tx := range batchTx.Transactions {
+		fees = fees.Add(tx.Erc20Fee.Amount)
+	}
+
+	fees = k.ERC20ToCosmosAmount(ctx, common.HexToAddress(batchTx.TokenContract), fees)
+	return fraction.MulInt(fees).TruncateInt()
+}
+
+// rewardRelayer moves the reward from the module account into the relayer
+// reward pool and pays it out to the orchestrator registered for the relayer's
+// ethereum address. Rewards of relayers without an orchestrator, or whose
+// validator opted out of the bridge, stay in the pool.
+func (k Keeper) rewardRelayer(ctx sdk.Context, rewards sdk.Coins, relayer string) error {
+	if rewards.Empty() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.RelayerRewardPoolName, rewards); err != nil {
+		return sdkerrors.Wrapf(err, "fund relayer reward pool: %s", rewards)
+	}
+
+	if !common.IsHexAddress(relayer) {
+		return nil
+	}
+
+	orchAddr := k.GetEthereumOrchestratorAddress(ctx, common.HexToAddress(relayer))
+	if orchAddr == nil {
+		return nil
+	}
+	if val := k.GetOrchestratorValidatorAddress(ctx, orchAddr); val != nil && k.IsValidatorOptedOut(ctx, val) {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RelayerRewardPoolName, orchAddr, rewards); err != nil {
+		return sdkerrors.Wrapf(err, "pay relayer reward: %s", rewards)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRelayerReward,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyRelayer, common.HexToAddress(relayer).Hex()),
+		sdk.NewAttribute(types.AttributeKeyRelayerOrchestrator, orchAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyRelayerReward, rewards.String()),
+	))
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestRelayerReward(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos12luku6uxehhak02py4rcz65zu0swh7wj8a5enl")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		myTokenDenom        = "gravity0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		relayer             = common.HexToAddress("0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09")
+		relayerOrch, _      = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+	)
+
+	params := gk.GetParams(ctx)
+	params.RelayerRewardFraction = sdk.NewDecWithPrec(5, 1)
+	gk.SetParams(ctx, params)
+
+	gk.setEthereumOrchestratorAddress(ctx, relayer, relayerOrch)
+
+	allVouchers := sdk.NewCoins(sdk.NewCoin(myTokenDenom, sdk.NewInt(99999)))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	// fees add up to 9
+	for i, fee := range []int64{2, 3, 4} {
+		_, err := gk.createSendToEthereum(
+			ctx,
+			mySender,
+			myReceiver,
+			sdk.NewCoin(myTokenDenom, sdk.NewInt(int64(i+100))),
+			sdk.NewCoin(myTokenDenom, sdk.NewInt(fee)),
+		)
+		require.NoError(t, err)
+	}
+
+	batch := gk.BuildBatchTx(ctx, myTokenContractAddr, 100)
+	require.NotNil(t, batch)
+
+	require.NoError(t, gk.batchTxExecuted(ctx, gk.GetParams(ctx), myTokenContractAddr, batch.BatchNonce, relayer.Hex()))
+
+	// half of the fees, truncated, go to the relayer's orchestrator
+	require.Equal(t, sdk.NewInt(4), input.BankKeeper.GetBalance(ctx, relayerOrch, myTokenDenom).Amount)
+	require.True(t, input.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.RelayerRewardPoolName), myTokenDenom).IsZero())
+	checkInvariant(t, ctx, gk, true)
+}
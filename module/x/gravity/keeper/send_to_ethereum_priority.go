@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// PrioritizeSendToEthereums flags sends to ethereum of the unbatched pool or
+// of the delayed send queue as priority txs. It fails if any of them was
+// already batched or doesn't exist.
+func (k Keeper) PrioritizeSendToEthereums(ctx sdk.Context, ids []uint64) error {
+	unbatched := make(map[uint64]*types.SendToEthereum)
+	k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		unbatched[ste.Id] = ste
+		return false
+	})
+
+	for _, id := range ids {
+		if ste, ok := unbatched[id]; ok {
+			// the pool index doesn't depend on the priority flag
+			ste.Priority = true
+			k.setUnbatchedSendToEthereum(ctx, ste)
+			continue
+		}
+
+		delayed, found := k.GetDelayedSendToEthereum(ctx, id)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrSendToEthereumNotFound, "id %d not in the unbatched pool or the delayed send queue", id)
+		}
+		delayed.SendToEthereum.Priority = true
+		k.setDelayedSendToEthereum(ctx, delayed)
+	}
+	return nil
+}
+
+// HandleSendToEthereumPriorityProposal prioritizes the sends to ethereum of
+// the proposal
+func (k Keeper) HandleSendToEthereumPriorityProposal(ctx sdk.Context, p *types.SendToEthereumPriorityProposal) error {
+	if err := k.PrioritizeSendToEthereums(ctx, p.Ids); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("sends to ethereum prioritized by governance", "ids", p.Ids)
+	return nil
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// defaultSignatureVerifiers returns the verifiers of the schemes every chain
+// supports, which is ECDSA only as that is what the gravity contract verifies
+func defaultSignatureVerifiers() map[types.SignatureScheme]types.SignatureVerifier {
+	return map[types.SignatureScheme]types.SignatureVerifier{
+		types.SIGNATURE_SCHEME_ECDSA: types.ECDSASignatureVerifier{},
+	}
+}
+
+// RegisterSignatureVerifier adds support for a signature scheme, so that
+// validators can select it when setting their delegate keys
+func (k *Keeper) RegisterSignatureVerifier(scheme types.SignatureScheme, verifier types.SignatureVerifier) *Keeper {
+	if _, ok := k.signatureVerifiers[scheme]; ok {
+		panic(fmt.Sprintf("cannot register signature verifier %s twice", scheme))
+	}
+
+	k.signatureVerifiers[scheme] = verifier
+
+	return k
+}
+
+// getSignatureVerifier returns the verifier of a signature scheme, or an
+// error if the scheme is not supported
+func (k Keeper) getSignatureVerifier(scheme types.SignatureScheme) (types.SignatureVerifier, error) {
+	verifier, ok := k.signatureVerifiers[scheme]
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrUnsupportedSignatureScheme, scheme.String())
+	}
+	return verifier, nil
+}
+
+// setValidatorSignatureScheme sets the signature scheme of a validator. Only
+// the schemes other than ECDSA are stored.
+func (k Keeper) setValidatorSignatureScheme(ctx sdk.Context, val sdk.ValAddress, scheme types.SignatureScheme) {
+	key := types.MakeValidatorSignatureSchemeKey(val)
+	if scheme == types.SIGNATURE_SCHEME_ECDSA {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(key, sdk.Uint64ToBigEndian(uint64(scheme)))
+}
+
+// GetValidatorSignatureScheme returns the signature scheme a validator selected
+// with its delegate keys
+func (k Keeper) GetValidatorSignatureScheme(ctx sdk.Context, val sdk.ValAddress) types.SignatureScheme {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeValidatorSignatureSchemeKey(val))
+	if bz == nil {
+		return types.SIGNATURE_SCHEME_ECDSA
+	}
+	return types.SignatureScheme(sdk.BigEndianToUint64(bz))
+}
+
+// iterateValidatorSignatureSchemes iterates over the validators not signing
+// with ECDSA
+func (k Keeper) iterateValidatorSignatureSchemes(ctx sdk.Context, cb func(sdk.ValAddress, types.SignatureScheme) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ValidatorSignatureSchemeKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key(), types.SignatureScheme(sdk.BigEndianToUint64(iter.Value()))) {
+			break
+		}
+	}
+}
+
+// verifyEthereumTxConfirmation verifies the signature of a confirmation over
+// the checkpoint of its outgoing tx with the scheme of the validator. It
+// returns an error if the signature is malformed or of another scheme, and
+// false if it is a well formed signature over anything but the checkpoint.
+func (k Keeper) verifyEthereumTxConfirmation(ctx sdk.Context, val sdk.ValAddress, confirmation types.EthereumTxConfirmation, checkpoint []byte, ethAddress common.Address) (bool, error) {
+	scheme := k.GetValidatorSignatureScheme(ctx, val)
+	if confirmation.GetScheme() != scheme {
+		return false, sdkerrors.Wrapf(types.ErrInvalidEthereumSignature, "%s signature by a validator signing with %s", confirmation.GetScheme(), scheme)
+	}
+
+	verifier, err := k.getSignatureVerifier(scheme)
+	if err != nil {
+		return false, err
+	}
+
+	return verifier.VerifySignature(ctx, checkpoint, confirmation.GetSignature(), ethAddress)
+}
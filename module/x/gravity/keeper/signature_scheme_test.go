@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// fakeSignatureVerifier accepts the signature "valid" and rejects the
+// signature "wrong" as a well formed signature over something else
+type fakeSignatureVerifier struct{}
+
+func (fakeSignatureVerifier) VerifySignature(_ sdk.Context, _ []byte, signature []byte, _ common.Address) (bool, error) {
+	switch {
+	case bytes.Equal(signature, []byte("valid")):
+		return true, nil
+	case bytes.Equal(signature, []byte("wrong")):
+		return false, nil
+	default:
+		return false, types.ErrInvalidEthereumSignature
+	}
+}
+
+func TestSignatureSchemes(t *testing.T) {
+	ethPrivKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+		ethAddr1    = crypto.PubkeyToAddress(ethPrivKey.PublicKey)
+	)
+
+	require.Panics(t, func() { gk.RegisterSignatureVerifier(types.SIGNATURE_SCHEME_ECDSA, fakeSignatureVerifier{}) })
+	gk.RegisterSignatureVerifier(types.SIGNATURE_SCHEME_BLS, fakeSignatureVerifier{})
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr1)
+	acc := env.AccountKeeper.NewAccountWithAddress(ctx, orcAddr1)
+	acc.SetSequence(1)
+	env.AccountKeeper.SetAccount(ctx, acc)
+
+	sig, err := types.NewEthereumSignature(crypto.Keccak256Hash(env.Marshaler.MustMarshal(&types.DelegateKeysSignMsg{
+		ValidatorAddress: valAddr1.String(),
+	})).Bytes(), ethPrivKey)
+	require.NoError(t, err)
+
+	msgServer := NewMsgServerImpl(gk)
+	delegateKeys := types.NewMsgDelegateKeys(valAddr1, orcAddr1, ethAddr1.Hex(), sig)
+
+	// only the schemes with a registered verifier can be selected
+	delegateKeys.SignatureScheme = types.SIGNATURE_SCHEME_EIP1271
+	_, err = msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), delegateKeys)
+	require.ErrorIs(t, err, types.ErrUnsupportedSignatureScheme)
+
+	delegateKeys.SignatureScheme = types.SIGNATURE_SCHEME_BLS
+	_, err = msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), delegateKeys)
+	require.NoError(t, err)
+	require.Equal(t, types.SIGNATURE_SCHEME_BLS, gk.GetValidatorSignatureScheme(ctx, valAddr1))
+
+	signerSetTx := gk.CreateSignerSetTx(ctx)
+	submit := func(scheme types.SignatureScheme, signature []byte) error {
+		confirmation, err := types.PackConfirmation(&types.SignerSetTxConfirmation{
+			SignerSetNonce: signerSetTx.Nonce,
+			EthereumSigner: ethAddr1.Hex(),
+			Signature:      signature,
+			Scheme:         scheme,
+		})
+		require.NoError(t, err)
+		_, err = msgServer.SubmitEthereumTxConfirmation(sdk.WrapSDKContext(ctx), &types.MsgSubmitEthereumTxConfirmation{
+			Confirmation: confirmation,
+			Signer:       orcAddr1.String(),
+		})
+		return err
+	}
+
+	// the signatures must be of the scheme of the validator
+	ecdsaSig, err := types.NewEthereumSignature(signerSetTx.GetCheckpoint([]byte(gk.getGravityID(ctx))), ethPrivKey)
+	require.NoError(t, err)
+	require.ErrorIs(t, submit(types.SIGNATURE_SCHEME_ECDSA, ecdsaSig), types.ErrInvalidEthereumSignature)
+	require.ErrorIs(t, submit(types.SIGNATURE_SCHEME_BLS, []byte("malformed")), types.ErrInvalidEthereumSignature)
+
+	// a wrong signature of the scheme over no other outgoing tx is rejected
+	require.ErrorIs(t, submit(types.SIGNATURE_SCHEME_BLS, []byte("wrong")), types.ErrInvalidEthereumSignature)
+	require.Nil(t, gk.getEthereumSignature(ctx, signerSetTx.GetStoreIndex(), valAddr1))
+
+	require.NoError(t, submit(types.SIGNATURE_SCHEME_BLS, []byte("valid")))
+	res, err := gk.SignerSetTxConfirmations(sdk.WrapSDKContext(ctx), &types.SignerSetTxConfirmationsRequest{SignerSetNonce: signerSetTx.Nonce})
+	require.NoError(t, err)
+	require.Len(t, res.Signatures, 1)
+	require.Equal(t, types.SIGNATURE_SCHEME_BLS, res.Signatures[0].Scheme)
+	require.Equal(t, []byte("valid"), res.Signatures[0].Signature)
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// GetSignerSetTxDelta returns the difference between the signer set of the
+// nonce and the one before it, if it was recorded
+func (k Keeper) GetSignerSetTxDelta(ctx sdk.Context, nonce uint64) (types.SignerSetTxDelta, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeSignerSetTxDeltaKey(nonce))
+	if bz == nil {
+		return types.SignerSetTxDelta{}, false
+	}
+	var delta types.SignerSetTxDelta
+	k.cdc.MustUnmarshal(bz, &delta)
+	return delta, true
+}
+
+func (k Keeper) setSignerSetTxDelta(ctx sdk.Context, delta types.SignerSetTxDelta) {
+	ctx.KVStore(k.storeKey).Set(types.MakeSignerSetTxDeltaKey(delta.Nonce), k.cdc.MustMarshal(&delta))
+}
+
+func (k Keeper) deleteSignerSetTxDelta(ctx sdk.Context, nonce uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeSignerSetTxDeltaKey(nonce))
+}
+
+// IterateSignerSetTxDeltas iterates over the signer set deltas by nonce
+func (k Keeper) IterateSignerSetTxDeltas(ctx sdk.Context, cb func(types.SignerSetTxDelta) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.SignerSetTxDeltaKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var delta types.SignerSetTxDelta
+		k.cdc.MustUnmarshal(iter.Value(), &delta)
+		if cb(delta) {
+			break
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+/////////////////////////////
+// SIGNER SET EXCLUSIONS //
+/////////////////////////////
+
+func (k Keeper) setEthereumSignerExcluded(ctx sdk.Context, addr common.Address) {
+	ctx.KVStore(k.storeKey).Set(types.MakeExcludedEthereumSignerKey(addr), []byte{1})
+}
+
+// IsEthereumSignerExcluded reports whether governance has excluded an ethereum
+// address from signer sets
+func (k Keeper) IsEthereumSignerExcluded(ctx sdk.Context, addr common.Address) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MakeExcludedEthereumSignerKey(addr))
+}
+
+// IterateExcludedEthereumSigners iterates over all ethereum addresses excluded
+// from signer sets
+func (k Keeper) IterateExcludedEthereumSigners(ctx sdk.Context, cb func(common.Address) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ExcludedEthereumSignerKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(common.BytesToAddress(iter.Key())) {
+			break
+		}
+	}
+}
+
+// HandleEmergencySignerSetUpdateProposal excludes the compromised ethereum
+// addresses of the proposal from signer sets and creates a new signer set tx
+// right away, without waiting for a power change to trigger one. A validator
+// whose address is excluded rejoins the signer set by rotating its ethereum key.
+func (k Keeper) HandleEmergencySignerSetUpdateProposal(ctx sdk.Context, p *types.EmergencySignerSetUpdateProposal) error {
+	for _, addr := range p.ExcludedEthereumAddresses {
+		k.setEthereumSignerExcluded(ctx, common.HexToAddress(addr))
+	}
+
+	if len(k.CurrentSignerSet(ctx)) == 0 {
+		return sdkerrors.Wrap(types.ErrInvalid, "no ethereum signers left after exclusions")
+	}
+
+	signerSetTx := k.CreateSignerSetTx(ctx)
+
+	k.Logger(ctx).Info(
+		"emergency signer set update",
+		"nonce", signerSetTx.Nonce,
+		"excluded", len(p.ExcludedEthereumAddresses),
+	)
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestEmergencySignerSetUpdate(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	unusedKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	unused := crypto.PubkeyToAddress(unusedKey.PublicKey)
+
+	// excluding every signer is rejected
+	all := make([]string, len(EthAddrs))
+	for i, addr := range EthAddrs {
+		all[i] = addr.Hex()
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	require.ErrorIs(t, gk.HandleEmergencySignerSetUpdateProposal(cacheCtx, types.NewEmergencySignerSetUpdateProposal("exclude", "exclude all", all)), types.ErrInvalid)
+
+	// the update creates a signer set right away, without the compromised key
+	nonce := gk.GetLatestSignerSetTxNonce(ctx)
+	proposal := types.NewEmergencySignerSetUpdateProposal("exclude", "exclude a leaked key", []string{EthAddrs[0].Hex(), unused.Hex()})
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, gk.HandleEmergencySignerSetUpdateProposal(ctx, proposal))
+	require.True(t, gk.IsEthereumSignerExcluded(ctx, EthAddrs[0]))
+
+	latest := gk.GetLatestSignerSetTx(ctx)
+	require.Equal(t, nonce+1, latest.Nonce)
+	require.Len(t, latest.Signers, len(EthAddrs)-1)
+	for _, signer := range latest.Signers {
+		require.NotEqual(t, EthAddrs[0].Hex(), signer.EthereumAddress)
+	}
+
+	// excluded addresses can't be registered again
+	msgServer := NewMsgServerImpl(gk)
+	_, err = msgServer.UpdateDelegateKeys(sdk.WrapSDKContext(ctx), types.NewMsgUpdateDelegateKeys(ValAddrs[1], AccAddrs[1], unused.Hex(), []byte("signature")))
+	require.ErrorIs(t, err, types.ErrDelegateKeys)
+
+	// the validator rejoins once it rotates its key
+	rotatedKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	rotated := crypto.PubkeyToAddress(rotatedKey.PublicKey)
+	gk.setPendingValidatorEthereumAddress(ctx, ValAddrs[0], rotated)
+
+	signers := gk.CurrentSignerSet(ctx)
+	require.Len(t, signers, len(EthAddrs))
+	var found bool
+	for _, signer := range signers {
+		found = found || signer.EthereumAddress == rotated.Hex()
+	}
+	require.True(t, found)
+
+	// exclusions survive genesis
+	genesis := ExportGenesis(ctx, gk)
+	require.ElementsMatch(t, []string{EthAddrs[0].Hex(), unused.Hex()}, genesis.ExcludedEthereumSigners)
+}
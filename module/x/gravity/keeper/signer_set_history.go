@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// setObservedSignerSetTx records a signer set observed on Ethereum, keyed by
+// the Ethereum height it took effect at
+func (k Keeper) setObservedSignerSetTx(ctx sdk.Context, observed types.ObservedSignerSetTx) {
+	ctx.KVStore(k.storeKey).Set(
+		types.MakeObservedSignerSetTxKey(observed.EthereumHeight, observed.SignerSet.Nonce),
+		k.cdc.MustMarshal(&observed),
+	)
+}
+
+// IterateObservedSignerSetTxs iterates over the signer sets observed on
+// Ethereum, oldest first
+func (k Keeper) IterateObservedSignerSetTxs(ctx sdk.Context, cb func(types.ObservedSignerSetTx) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ObservedSignerSetTxKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var observed types.ObservedSignerSetTx
+		k.cdc.MustUnmarshal(iter.Value(), &observed)
+		if cb(observed) {
+			break
+		}
+	}
+}
+
+// PaginateObservedSignerSetTxsByHeightRange paginates over the signer sets
+// that were active on Ethereum between startHeight and endHeight inclusive:
+// the last one that took effect at or before startHeight, followed by those
+// that took effect within the range. An endHeight of zero leaves the range
+// unbounded.
+func (k Keeper) PaginateObservedSignerSetTxsByHeightRange(ctx sdk.Context, pageReq *query.PageRequest, startHeight, endHeight uint64, cb func(types.ObservedSignerSetTx)) (*query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, errors.New("invalid request, either offset or key is expected, got both")
+	}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ObservedSignerSetTxKey})
+
+	// keys are the height followed by the nonce, so the last key below the
+	// height after startHeight is the signer set active at startHeight
+	start := sdk.Uint64ToBigEndian(startHeight)
+	var activeEnd []byte
+	if startHeight != math.MaxUint64 {
+		activeEnd = sdk.Uint64ToBigEndian(startHeight + 1)
+	}
+	activeIter := prefixStore.ReverseIterator(nil, activeEnd)
+	if activeIter.Valid() && sdk.BigEndianToUint64(activeIter.Key()[:8]) < startHeight {
+		start = append([]byte{}, activeIter.Key()...)
+	}
+	activeIter.Close()
+
+	var end []byte
+	if endHeight != 0 && endHeight != math.MaxUint64 {
+		end = sdk.Uint64ToBigEndian(endHeight + 1)
+	}
+	if len(pageReq.Key) != 0 && bytes.Compare(pageReq.Key, start) > 0 {
+		start = pageReq.Key
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	pageRes := &query.PageResponse{}
+	iter := prefixStore.Iterator(start, end)
+	defer iter.Close()
+
+	var count uint64
+	for ; iter.Valid(); iter.Next() {
+		count++
+		if count <= pageReq.Offset {
+			continue
+		}
+		if count > pageReq.Offset+limit {
+			if pageRes.NextKey == nil {
+				pageRes.NextKey = append([]byte{}, iter.Key()...)
+			}
+			if !pageReq.CountTotal {
+				break
+			}
+			continue
+		}
+
+		var observed types.ObservedSignerSetTx
+		k.cdc.MustUnmarshal(iter.Value(), &observed)
+		cb(observed)
+	}
+	if pageReq.CountTotal && len(pageReq.Key) == 0 {
+		pageRes.Total = count
+	}
+
+	return pageRes, nil
+}
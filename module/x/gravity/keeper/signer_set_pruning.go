@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// PruneSignerSetTxs deletes the signer set txs, and their signatures, more
+// than SignerSetRetention nonces below the last observed signer set. A signer
+// set is only pruned once the signed signer set txs window has passed since
+// its creation, so that validators could be slashed for not signing it. The
+// last observed signer set is always retained, as is any signer set a batch
+// is still waiting for signatures of its members under.
+func (k Keeper) PruneSignerSetTxs(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	lastObserved := k.GetLastObservedSignerSetTx(ctx)
+	currentBlock := uint64(ctx.BlockHeight())
+	if lastObserved == nil || currentBlock < params.SignedSignerSetTxsWindow || lastObserved.Nonce <= params.SignerSetRetention {
+		return
+	}
+
+	pruneBelow := lastObserved.Nonce - params.SignerSetRetention
+	earliestToPrune := currentBlock - params.SignedSignerSetTxsWindow
+	signerSets := k.GetSignerSetTxs(ctx)
+	referenced := k.signerSetsReferencedByUnsignedBatches(ctx, signerSets)
+	for _, set := range signerSets {
+		if set.Nonce >= pruneBelow || set.Height >= earliestToPrune || referenced[set.Nonce] {
+			continue
+		}
+
+		k.DeleteEthereumSignatures(ctx, set)
+		k.DeleteOutgoingTx(ctx, set.GetStoreIndex())
+		k.deleteSignerSetTxDelta(ctx, set.Nonce)
+		k.RecordEndBlockerAction(ctx, types.EndBlockerActionSignerSetPruned, fmt.Sprintf(
+			"nonce %d: more than %d below last observed nonce %d", set.Nonce, params.SignerSetRetention, lastObserved.Nonce,
+		))
+	}
+}
+
+// signerSetsReferencedByUnsignedBatches returns the nonces of the signer sets
+// that batches were created under, the latest signer set at their height,
+// for the batches some members of that signer set haven't signed yet
+func (k Keeper) signerSetsReferencedByUnsignedBatches(ctx sdk.Context, signerSets []*types.SignerSetTx) map[uint64]bool {
+	referenced := make(map[uint64]bool)
+	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		var signerSet *types.SignerSetTx
+		for _, set := range signerSets {
+			if set.Height <= otx.GetCosmosHeight() && (signerSet == nil || set.Nonce > signerSet.Nonce) {
+				signerSet = set
+			}
+		}
+		if signerSet == nil || referenced[signerSet.Nonce] {
+			return false
+		}
+
+		signed := make(map[common.Address]bool)
+		k.iterateEthereumSignatures(ctx, otx.GetStoreIndex(), func(val sdk.ValAddress, _ types.EthereumSignature) bool {
+			signed[k.GetValidatorEthereumAddress(ctx, val)] = true
+			return false
+		})
+		for _, signer := range signerSet.Signers {
+			if !signed[common.HexToAddress(signer.EthereumAddress)] {
+				referenced[signerSet.Nonce] = true
+				break
+			}
+		}
+		return false
+	})
+	return referenced
+}
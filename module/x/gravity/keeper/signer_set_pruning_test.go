@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestPruneSignerSetTxs(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+
+	params := gk.GetParams(ctx)
+	params.SignerSetRetention = 1
+	gk.SetParams(ctx, params)
+
+	// signer sets 1 to 4, one per block
+	var signerSets []*types.SignerSetTx
+	for height := int64(1); height <= 4; height++ {
+		signerSets = append(signerSets, gk.CreateSignerSetTx(ctx.WithBlockHeight(height)))
+	}
+	gk.setLastObservedSignerSetTx(ctx, *signerSets[3])
+
+	// a batch created under signer set 1 that no one signed yet
+	batch := &types.BatchTx{BatchNonce: 1, TokenContract: tokenContract.Hex(), Height: 1}
+	gk.SetOutgoingTx(ctx, batch)
+
+	nonces := func() (out []uint64) {
+		for _, set := range gk.GetSignerSetTxs(ctx) {
+			out = append(out, set.Nonce)
+		}
+		sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+		return out
+	}
+
+	// nothing is pruned within the signed signer set txs window
+	gk.PruneSignerSetTxs(ctx.WithBlockHeight(int64(params.SignedSignerSetTxsWindow)))
+	require.Equal(t, []uint64{1, 2, 3, 4}, nonces())
+
+	// set 3 is retained below the last observed set 4, and set 1 for the batch
+	ctx = ctx.WithBlockHeight(100)
+	gk.PruneSignerSetTxs(ctx)
+	require.Equal(t, []uint64{1, 3, 4}, nonces())
+
+	// set 1 goes once all of its members signed the batch
+	for i, val := range ValAddrs {
+		gk.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+			TokenContract:  tokenContract.Hex(),
+			BatchNonce:     batch.BatchNonce,
+			EthereumSigner: EthAddrs[i].Hex(),
+			Signature:      []byte("signature"),
+		}, val)
+	}
+	gk.PruneSignerSetTxs(ctx)
+	require.Equal(t, []uint64{3, 4}, nonces())
+
+	// the last observed set is never pruned
+	params.SignerSetRetention = 0
+	gk.SetParams(ctx, params)
+	gk.PruneSignerSetTxs(ctx)
+	require.Equal(t, []uint64{4}, nonces())
+}
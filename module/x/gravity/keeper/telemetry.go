@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// outgoingTxTypeLabel labels the metrics of an outgoing tx with its type
+func outgoingTxTypeLabel(otx types.OutgoingTx) metrics.Label {
+	var txType string
+	switch otx.(type) {
+	case *types.SignerSetTx:
+		txType = "signer_set"
+	case *types.BatchTx:
+		txType = "batch"
+	case *types.ContractCallTx:
+		txType = "contract_call"
+	}
+	return telemetry.NewLabel("type", txType)
+}
+
+// EmitPoolTelemetry sets the gauge of the number of unbatched txs of each
+// token, so that pools piling up show without querying them
+func (k Keeper) EmitPoolTelemetry(ctx sdk.Context) {
+	poolSizes := make(map[string]int)
+	var contracts []string
+	k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		if _, ok := poolSizes[ste.Erc20Token.Contract]; !ok {
+			contracts = append(contracts, ste.Erc20Token.Contract)
+		}
+		poolSizes[ste.Erc20Token.Contract]++
+		return false
+	})
+
+	for _, contract := range contracts {
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, "pool_size"},
+			float32(poolSizes[contract]),
+			[]metrics.Label{telemetry.NewLabel("token_contract", contract)},
+		)
+	}
+}
+
+func telemetryBatch(batch *types.BatchTx, action string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "batch", action},
+		1,
+		[]metrics.Label{telemetry.NewLabel("token_contract", batch.TokenContract)},
+	)
+}
+
+func telemetryEventObserved(event types.EthereumEvent) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "event", "observed"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("type", proto.MessageName(event))},
+	)
+}
+
+// telemetryConfirmationLatency sets the gauge of the number of blocks between
+// the creation of an outgoing tx and a signature of it
+func telemetryConfirmationLatency(ctx sdk.Context, otx types.OutgoingTx) {
+	telemetry.SetGaugeWithLabels(
+		[]string{types.ModuleName, "confirmation_latency_blocks"},
+		float32(uint64(ctx.BlockHeight())-otx.GetCosmosHeight()),
+		[]metrics.Label{outgoingTxTypeLabel(otx)},
+	)
+}
+
+func telemetrySignerSetSize(signerSetTx *types.SignerSetTx) {
+	telemetry.SetGauge(float32(len(signerSetTx.Signers)), types.ModuleName, "signer_set_size")
+}
+
+// TelemetryValidatorSlashed counts the slashings of validators by reason
+func TelemetryValidatorSlashed(reason string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "validator_slashed"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("reason", reason)},
+	)
+}
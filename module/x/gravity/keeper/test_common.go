@@ -205,6 +205,24 @@ var (
 		BatchCreationPeriod:                       10,
 		BatchMaxElement:                           100,
 		ObserveEthereumHeightPeriod:               50,
+		MintRateLimitWindow:                       100,
+		BridgeProposalQuorum:                      sdk.ZeroDec(),
+		BridgeProposalThreshold:                   sdk.ZeroDec(),
+		RelayerRewardFraction:                     sdk.ZeroDec(),
+		CircuitBreakerWindow:                      100,
+		CircuitBreakerMultiple:                    sdk.ZeroDec(),
+		OrchestratorQueryIdentityLifetime:         100,
+		DelayedWithdrawalPeriod:                   100,
+		BatchTimeoutFeeBoost:                      sdk.ZeroDec(),
+		TimeoutHeightVoteWindow:                   500,
+		ChainFeeDestination:                       types.ChainFeeDestinationCommunityPool,
+		EventVotePowerThreshold:                   sdk.NewDecWithPrec(66, 2),
+		NativeEtherDenom:                          "gravity/eth",
+		InboundEnabled:                            true,
+		OutboundEnabled:                           true,
+		PowerReduction:                            sdk.DefaultPowerReduction,
+		SignerSetPowerChangeThresholdBps:          500,
+		EthereumHeaderCheckpointInterval:          100,
 	}
 )
 
@@ -217,6 +235,7 @@ type TestInput struct {
 	DistKeeper        distrkeeper.Keeper
 	BankKeeper        bankkeeper.BaseKeeper
 	GovKeeper         govkeeper.Keeper
+	ParamsKeeper      paramskeeper.Keeper
 	Context           sdk.Context
 	Marshaler         codec.Codec
 	LegacyAmino       *codec.LegacyAmino
@@ -224,7 +243,7 @@ type TestInput struct {
 	InterfaceRegistry codectypes.InterfaceRegistry
 }
 
-func (input TestInput) AddSendToEthTxsToPool(t *testing.T, ctx sdk.Context, tokenContract gethcommon.Address, sender sdk.AccAddress, receiver gethcommon.Address, ids ...uint64) {
+func (input TestInput) AddSendToEthTxsToPool(t testing.TB, ctx sdk.Context, tokenContract gethcommon.Address, sender sdk.AccAddress, receiver gethcommon.Address, ids ...uint64) {
 	for i, id := range ids {
 		amount := types.NewERC20Token(uint64(i+100), tokenContract).GravityCoin()
 		fee := types.NewERC20Token(id, tokenContract).GravityCoin()
@@ -233,6 +252,14 @@ func (input TestInput) AddSendToEthTxsToPool(t *testing.T, ctx sdk.Context, toke
 	}
 }
 
+// newPoolSendToEthereumTx returns the tx createSendToEthereum stores for the
+// given values at the height of the test env
+func newPoolSendToEthereumTx(id uint64, tokenContract gethcommon.Address, sender sdk.AccAddress, recipient gethcommon.Address, amount, feeAmount uint64) *types.SendToEthereum {
+	tx := types.NewSendToEthereumTx(id, tokenContract, sender, recipient, amount, feeAmount)
+	tx.Height = 1234567
+	return tx
+}
+
 func (input TestInput) AddBalanceToBank(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) error {
 	return fundAccount(ctx, input.BankKeeper, addr, balances)
 }
@@ -252,7 +279,7 @@ func (t TestInput) AssertInvariants() {
 }
 
 // SetupFiveValChain does all the initialization for a 5 Validator chain using the keys here
-func SetupFiveValChain(t *testing.T) (TestInput, sdk.Context) {
+func SetupFiveValChain(t testing.TB) (TestInput, sdk.Context) {
 	t.Helper()
 	input := CreateTestEnv(t)
 
@@ -301,7 +328,7 @@ func SetupFiveValChain(t *testing.T) (TestInput, sdk.Context) {
 }
 
 // CreateTestEnv creates the keeper testing environment for gravity
-func CreateTestEnv(t *testing.T) TestInput {
+func CreateTestEnv(t testing.TB) TestInput {
 	t.Helper()
 
 	// Initialize store keys
@@ -356,6 +383,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		types.ModuleName:               {authtypes.Minter, authtypes.Burner},
+		types.RelayerRewardPoolName:    nil,
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -449,7 +477,6 @@ func CreateTestEnv(t *testing.T) TestInput {
 		bankKeeper,
 		slashingKeeper,
 		distKeeper,
-		sdk.DefaultPowerReduction,
 		receiverModuleAccounts,
 		senderModuleAccounts,
 	)
@@ -472,6 +499,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 		SlashingKeeper:    slashingKeeper,
 		DistKeeper:        distKeeper,
 		GovKeeper:         govKeeper,
+		ParamsKeeper:      paramsKeeper,
 		Context:           ctx,
 		Marshaler:         marshaler,
 		LegacyAmino:       cdc,
@@ -510,7 +538,7 @@ func MakeTestMarshaler() (codec.Codec, codectypes.InterfaceRegistry) {
 }
 
 // MintVouchersFromAir creates new gravity vouchers given erc20tokens
-func MintVouchersFromAir(t *testing.T, ctx sdk.Context, k Keeper, dest sdk.AccAddress, amount types.ERC20Token) sdk.Coin {
+func MintVouchersFromAir(t testing.TB, ctx sdk.Context, k Keeper, dest sdk.AccAddress, amount types.ERC20Token) sdk.Coin {
 	coin := amount.GravityCoin()
 	vouchers := sdk.Coins{coin}
 	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers)
@@ -533,6 +561,7 @@ func NewStakingKeeperMock(operators ...sdk.ValAddress) *StakingKeeperMock {
 			ConsensusPubkey: codectypes.UnsafePackAny(ed25519.GenPrivKey().PubKey()),
 			OperatorAddress: a.String(),
 			Status:          stakingtypes.Bonded,
+			Tokens:          sdk.TokensFromConsensusPower(defaultTestPower, sdk.DefaultPowerReduction),
 		})
 		r.ValidatorPower[a.String()] = defaultTestPower
 	}
@@ -596,6 +625,15 @@ func (s *StakingKeeperMock) GetLastTotalPower(ctx sdk.Context) (power sdk.Int) {
 	return sdk.NewInt(total)
 }
 
+// TotalBondedTokens staisfies the interface
+func (s *StakingKeeperMock) TotalBondedTokens(ctx sdk.Context) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, v := range s.BondedValidators {
+		total = total.Add(v.GetBondedTokens())
+	}
+	return total
+}
+
 // IterateValidators staisfies the interface
 func (s *StakingKeeperMock) IterateValidators(ctx sdk.Context, cb func(index int64, validator stakingtypes.ValidatorI) (stop bool)) {
 	for i, val := range s.BondedValidators {
@@ -654,6 +692,11 @@ func (s *StakingKeeperMock) GetParams(ctx sdk.Context) stakingtypes.Params {
 	return stakingtypes.DefaultParams()
 }
 
+// PowerReduction staisfies the interface
+func (s *StakingKeeperMock) PowerReduction(ctx sdk.Context) sdk.Int {
+	return sdk.DefaultPowerReduction
+}
+
 func (s *StakingKeeperMock) GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool) {
 	panic("unexpected call")
 }
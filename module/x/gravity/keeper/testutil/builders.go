@@ -0,0 +1,110 @@
+package testutil
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// SignerSet returns the ethereum signers of validators, with their
+// consensus power as signer power
+func SignerSet(validators ...Validator) types.EthereumSigners {
+	signers := make(types.EthereumSigners, len(validators))
+	for i, validator := range validators {
+		signers[i] = &types.EthereumSigner{
+			Power:           uint64(validator.Power),
+			EthereumAddress: validator.EthereumAddress.Hex(),
+		}
+	}
+	return signers
+}
+
+// NewSignerSetTx returns a signer set tx of the validators
+func NewSignerSetTx(nonce, height uint64, validators ...Validator) *types.SignerSetTx {
+	return types.NewSignerSetTx(nonce, height, SignerSet(validators...))
+}
+
+// NewBatchTx returns a batch of txs of a token, with the bridge fees of the
+// txs paid in the bridge fee denom
+func NewBatchTx(nonce, timeout, height uint64, tokenContract common.Address, txs ...*types.SendToEthereum) *types.BatchTx {
+	bridgeFees := sdk.NewCoins()
+	for _, tx := range txs {
+		bridgeFees = bridgeFees.Add(tx.GetBridgeFeeCoins()...)
+	}
+	return &types.BatchTx{
+		BatchNonce:    nonce,
+		Timeout:       timeout,
+		Transactions:  txs,
+		TokenContract: tokenContract.Hex(),
+		Height:        height,
+		BridgeFees:    bridgeFees,
+	}
+}
+
+// NewConfirmation returns the confirmation of an outgoing tx by an ethereum
+// signer, signed with the ECDSA scheme
+func NewConfirmation(otx types.OutgoingTx, ethSigner common.Address, signature []byte) types.EthereumTxConfirmation {
+	switch otx := otx.(type) {
+	case *types.SignerSetTx:
+		return &types.SignerSetTxConfirmation{
+			SignerSetNonce: otx.Nonce,
+			EthereumSigner: ethSigner.Hex(),
+			Signature:      signature,
+			Scheme:         types.SIGNATURE_SCHEME_ECDSA,
+		}
+	case *types.BatchTx:
+		return &types.BatchTxConfirmation{
+			TokenContract:  otx.TokenContract,
+			BatchNonce:     otx.BatchNonce,
+			EthereumSigner: ethSigner.Hex(),
+			Signature:      signature,
+			Scheme:         types.SIGNATURE_SCHEME_ECDSA,
+		}
+	case *types.ContractCallTx:
+		return &types.ContractCallTxConfirmation{
+			InvalidationScope: otx.InvalidationScope,
+			InvalidationNonce: otx.InvalidationNonce,
+			EthereumSigner:    ethSigner.Hex(),
+			Signature:         signature,
+			Scheme:            types.SIGNATURE_SCHEME_ECDSA,
+		}
+	default:
+		panic(fmt.Sprintf("unknown outgoing tx type %T", otx))
+	}
+}
+
+// NewSendToCosmosEvent returns a deposit of a token to a cosmos receiver
+func NewSendToCosmosEvent(nonce uint64, tokenContract common.Address, amount sdk.Int, sender common.Address, receiver sdk.AccAddress, ethereumHeight uint64) *types.SendToCosmosEvent {
+	return &types.SendToCosmosEvent{
+		EventNonce:     nonce,
+		TokenContract:  tokenContract.Hex(),
+		Amount:         amount,
+		EthereumSender: sender.Hex(),
+		CosmosReceiver: receiver.String(),
+		EthereumHeight: ethereumHeight,
+	}
+}
+
+// NewBatchExecutedEvent returns the execution of a batch relayed by relayer
+func NewBatchExecutedEvent(nonce uint64, batch *types.BatchTx, relayer common.Address, ethereumHeight uint64) *types.BatchExecutedEvent {
+	return &types.BatchExecutedEvent{
+		TokenContract:  batch.TokenContract,
+		EventNonce:     nonce,
+		EthereumHeight: ethereumHeight,
+		BatchNonce:     batch.BatchNonce,
+		Relayer:        relayer.Hex(),
+	}
+}
+
+// NewSignerSetTxExecutedEvent returns the execution of a signer set tx
+func NewSignerSetTxExecutedEvent(nonce uint64, signerSetTx *types.SignerSetTx, ethereumHeight uint64) *types.SignerSetTxExecutedEvent {
+	return &types.SignerSetTxExecutedEvent{
+		EventNonce:       nonce,
+		SignerSetTxNonce: signerSetTx.Nonce,
+		EthereumHeight:   ethereumHeight,
+		Members:          signerSetTx.Signers,
+	}
+}
@@ -0,0 +1,195 @@
+// Package testutil provides a gravity keeper backed by in-memory stores and
+// mock staking, bank, slashing and distribution keepers, so that chains
+// embedding x/gravity can test against it without setting up a full app. The
+// external tests of the keeper package use it the same way.
+package testutil
+
+import (
+	"crypto/ecdsa"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// Validator is a bonded validator of the test keeper along with its
+// registered delegate keys
+type Validator struct {
+	Address             sdk.ValAddress
+	OrchestratorAddress sdk.AccAddress
+	EthereumKey         *ecdsa.PrivateKey
+	EthereumAddress     common.Address
+	Power               int64
+}
+
+// TestKeeper holds a gravity keeper, the context its stores are mounted on and
+// the mock keepers it was built with
+type TestKeeper struct {
+	GravityKeeper      keeper.Keeper
+	Context            sdk.Context
+	StakingKeeper      *keeper.StakingKeeperMock
+	AccountKeeper      *AccountKeeperMock
+	BankKeeper         *BankKeeperMock
+	SlashingKeeper     *SlashingKeeperMock
+	DistributionKeeper *DistributionKeeperMock
+	Marshaler          codec.Codec
+	GravityStoreKey    *storetypes.KVStoreKey
+	Validators         []Validator
+}
+
+// NewTestKeeper creates a gravity keeper with the testing gravity params and
+// one bonded validator per power, each with its delegate keys registered
+func NewTestKeeper(t testing.TB, powers ...int64) TestKeeper {
+	t.Helper()
+
+	gravityKey := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(gravityKey, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, storetypes.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, tmproto.Header{
+		Height: 1234567,
+		Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+	}, false, log.TestingLogger())
+
+	marshaler, _ := keeper.MakeTestMarshaler()
+	paramsKeeper := paramskeeper.NewKeeper(marshaler, keeper.MakeTestCodec(), keyParams, tkeyParams)
+
+	validators := make([]keeper.MockStakingValidatorData, len(powers))
+	for i, power := range powers {
+		validators[i] = keeper.MockStakingValidatorData{
+			Operator: sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+			Power:    power,
+		}
+	}
+
+	tk := TestKeeper{
+		Context:            ctx,
+		StakingKeeper:      keeper.NewStakingKeeperWeightedMock(validators...),
+		AccountKeeper:      NewAccountKeeperMock(),
+		BankKeeper:         NewBankKeeperMock(),
+		SlashingKeeper:     NewSlashingKeeperMock(),
+		DistributionKeeper: NewDistributionKeeperMock(),
+		Marshaler:          marshaler,
+		GravityStoreKey:    gravityKey,
+	}
+	tk.GravityKeeper = keeper.NewKeeper(
+		marshaler,
+		gravityKey,
+		paramsKeeper.Subspace(types.DefaultParamspace),
+		tk.AccountKeeper,
+		tk.StakingKeeper,
+		tk.BankKeeper,
+		tk.SlashingKeeper,
+		tk.DistributionKeeper,
+		map[string]string{},
+		map[string]string{},
+	)
+	tk.GravityKeeper.SetParams(ctx, keeper.TestingGravityParams)
+
+	for _, validator := range validators {
+		tk.registerValidator(t, validator)
+	}
+
+	return tk
+}
+
+// registerValidator sets the delegate keys of a validator of the mock staking
+// keeper through the msg server, signing them with a new ethereum key
+func (tk *TestKeeper) registerValidator(t testing.TB, data keeper.MockStakingValidatorData) {
+	t.Helper()
+
+	ethKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	validator := Validator{
+		Address:             data.Operator,
+		OrchestratorAddress: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		EthereumKey:         ethKey,
+		EthereumAddress:     crypto.PubkeyToAddress(ethKey.PublicKey),
+		Power:               data.Power,
+	}
+
+	tk.AccountKeeper.SetAccount(authtypes.NewBaseAccountWithAddress(sdk.AccAddress(validator.Address)))
+
+	signMsgBz := tk.Marshaler.MustMarshal(&types.DelegateKeysSignMsg{
+		ValidatorAddress: validator.Address.String(),
+		Nonce:            0,
+	})
+	ethSig, err := types.NewEthereumSignature(crypto.Keccak256Hash(signMsgBz).Bytes(), ethKey)
+	require.NoError(t, err)
+
+	msg := types.NewMsgDelegateKeys(validator.Address, validator.OrchestratorAddress, validator.EthereumAddress.Hex(), ethSig)
+	_, err = keeper.NewMsgServerImpl(tk.GravityKeeper).SetDelegateKeys(sdk.WrapSDKContext(tk.Context), msg)
+	require.NoError(t, err)
+
+	tk.Validators = append(tk.Validators, validator)
+}
+
+// ObserveEvent votes for an ethereum event from every validator and tallies
+// the event vote record, applying the event to state as the EndBlocker would
+func (tk TestKeeper) ObserveEvent(t testing.TB, event types.EthereumEvent) {
+	t.Helper()
+
+	any, err := types.PackEvent(event)
+	require.NoError(t, err)
+
+	msgServer := keeper.NewMsgServerImpl(tk.GravityKeeper)
+	for _, validator := range tk.Validators {
+		_, err = msgServer.SubmitEthereumEvent(sdk.WrapSDKContext(tk.Context), &types.MsgSubmitEthereumEvent{
+			Event:  any,
+			Signer: validator.OrchestratorAddress.String(),
+		})
+		require.NoError(t, err)
+	}
+
+	record := tk.GravityKeeper.GetEthereumEventVoteRecord(tk.Context, event.GetEventNonce(), event.Hash())
+	require.NotNil(t, record)
+	tk.GravityKeeper.TryEventVoteRecord(tk.Context, tk.GravityKeeper.GetParams(tk.Context), record)
+}
+
+// ConfirmOutgoingTx signs the checkpoint of an outgoing tx with the ethereum
+// key of every validator and submits the confirmations
+func (tk TestKeeper) ConfirmOutgoingTx(t testing.TB, otx types.OutgoingTx) {
+	t.Helper()
+
+	params := tk.GravityKeeper.GetParams(tk.Context)
+	checkpoint := otx.GetCheckpoint([]byte(params.GravityId))
+
+	msgServer := keeper.NewMsgServerImpl(tk.GravityKeeper)
+	for _, validator := range tk.Validators {
+		sig, err := types.NewEthereumSignature(checkpoint, validator.EthereumKey)
+		require.NoError(t, err)
+
+		confirmation, err := types.PackConfirmation(NewConfirmation(otx, validator.EthereumAddress, sig))
+		require.NoError(t, err)
+
+		_, err = msgServer.SubmitEthereumTxConfirmation(sdk.WrapSDKContext(tk.Context), &types.MsgSubmitEthereumTxConfirmation{
+			Confirmation: confirmation,
+			Signer:       validator.OrchestratorAddress.String(),
+		})
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,43 @@
+package testutil_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/keeper/testutil"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestTestKeeper(t *testing.T) {
+	var (
+		tk            = testutil.NewTestKeeper(t, 10, 20, 30)
+		ctx           = tk.Context
+		gk            = tk.GravityKeeper
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		receiver      = sdk.AccAddress(tk.Validators[0].Address)
+	)
+
+	require.Len(t, tk.Validators, 3)
+	for _, validator := range tk.Validators {
+		require.Equal(t, validator.EthereumAddress, gk.GetValidatorEthereumAddress(ctx, validator.Address))
+	}
+
+	tk.ObserveEvent(t, testutil.NewSendToCosmosEvent(1, tokenContract, sdk.NewInt(100), tk.Validators[1].EthereumAddress, receiver, 10))
+	require.Equal(t, uint64(1), gk.GetLastObservedEventNonce(ctx))
+	require.Equal(t, int64(100), tk.BankKeeper.GetAllBalances(ctx, receiver).AmountOf(types.GravityDenom(tokenContract)).Int64())
+
+	signerSetTx := testutil.NewSignerSetTx(1, uint64(ctx.BlockHeight()), tk.Validators...)
+	require.Len(t, signerSetTx.Signers, 3)
+	gk.SetOutgoingTx(ctx, signerSetTx)
+	tk.ConfirmOutgoingTx(t, signerSetTx)
+	require.Len(t, gk.GetEthereumSignatures(ctx, signerSetTx.GetStoreIndex()), 3)
+
+	tx := types.NewSendToEthereumTx(1, tokenContract, receiver, tk.Validators[2].EthereumAddress, 50, 5)
+	batchTx := testutil.NewBatchTx(1, 1000, uint64(ctx.BlockHeight()), tokenContract, tx)
+	gk.SetOutgoingTx(ctx, batchTx)
+	tk.ConfirmOutgoingTx(t, batchTx)
+	require.Len(t, gk.GetEthereumSignatures(ctx, batchTx.GetStoreIndex()), 3)
+}
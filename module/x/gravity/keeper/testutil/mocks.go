@@ -0,0 +1,188 @@
+package testutil
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// The mock keepers hold their state in memory rather than in the context's
+// multistore, so their writes are not reverted with a cache context that is
+// discarded.
+var (
+	_ types.AccountKeeper      = &AccountKeeperMock{}
+	_ types.BankKeeper         = &BankKeeperMock{}
+	_ types.SlashingKeeper     = &SlashingKeeperMock{}
+	_ types.DistributionKeeper = &DistributionKeeperMock{}
+)
+
+// AccountKeeperMock is a mock account keeper holding accounts by address
+type AccountKeeperMock struct {
+	Accounts map[string]authtypes.AccountI
+}
+
+// NewAccountKeeperMock creates a new mock account keeper without accounts
+func NewAccountKeeperMock() *AccountKeeperMock {
+	return &AccountKeeperMock{Accounts: make(map[string]authtypes.AccountI)}
+}
+
+// SetAccount stores an account
+func (a *AccountKeeperMock) SetAccount(acc authtypes.AccountI) {
+	a.Accounts[acc.GetAddress().String()] = acc
+}
+
+// GetAccount satisfies the interface
+func (a *AccountKeeperMock) GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	return a.Accounts[addr.String()]
+}
+
+// GetSequence satisfies the interface
+func (a *AccountKeeperMock) GetSequence(ctx sdk.Context, addr sdk.AccAddress) (uint64, error) {
+	acc, ok := a.Accounts[addr.String()]
+	if !ok {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+	return acc.GetSequence(), nil
+}
+
+// BankKeeperMock is a mock bank keeper holding balances by address, module
+// accounts being addressed by their module address
+type BankKeeperMock struct {
+	Balances map[string]sdk.Coins
+	Supply   sdk.Coins
+	Metadata map[string]banktypes.Metadata
+}
+
+// NewBankKeeperMock creates a new mock bank keeper without balances
+func NewBankKeeperMock() *BankKeeperMock {
+	return &BankKeeperMock{
+		Balances: make(map[string]sdk.Coins),
+		Metadata: make(map[string]banktypes.Metadata),
+	}
+}
+
+// SetDenomMetaData stores the metadata of a denom
+func (b *BankKeeperMock) SetDenomMetaData(metadata banktypes.Metadata) {
+	b.Metadata[metadata.Base] = metadata
+}
+
+// FundAccount mints coins into an account, as the bank module does at genesis
+func (b *BankKeeperMock) FundAccount(addr sdk.AccAddress, amt sdk.Coins) {
+	b.Supply = b.Supply.Add(amt...)
+	b.Balances[addr.String()] = b.Balances[addr.String()].Add(amt...)
+}
+
+func (b *BankKeeperMock) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := b.Balances[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", b.Balances[from.String()], amt)
+	}
+	b.Balances[from.String()] = balance
+	b.Balances[to.String()] = b.Balances[to.String()].Add(amt...)
+	return nil
+}
+
+// GetSupply satisfies the interface
+func (b *BankKeeperMock) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.Supply.AmountOf(denom))
+}
+
+// SendCoinsFromModuleToAccount satisfies the interface
+func (b *BankKeeperMock) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+// SendCoinsFromModuleToModule satisfies the interface
+func (b *BankKeeperMock) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+// SendCoinsFromAccountToModule satisfies the interface
+func (b *BankKeeperMock) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+// MintCoins satisfies the interface
+func (b *BankKeeperMock) MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error {
+	if !amt.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+	b.FundAccount(authtypes.NewModuleAddress(name), amt)
+	return nil
+}
+
+// BurnCoins satisfies the interface
+func (b *BankKeeperMock) BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(name)
+	balance, hasNeg := b.Balances[addr.String()].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", b.Balances[addr.String()], amt)
+	}
+	b.Balances[addr.String()] = balance
+	b.Supply = b.Supply.Sub(amt...)
+	return nil
+}
+
+// GetAllBalances satisfies the interface
+func (b *BankKeeperMock) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.Balances[addr.String()]
+}
+
+// GetDenomMetaData satisfies the interface
+func (b *BankKeeperMock) GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool) {
+	metadata, ok := b.Metadata[denom]
+	return metadata, ok
+}
+
+// SpendableCoins satisfies the interface
+func (b *BankKeeperMock) SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.Balances[addr.String()]
+}
+
+// SlashingKeeperMock is a mock slashing keeper holding signing infos by
+// consensus address
+type SlashingKeeperMock struct {
+	SigningInfos map[string]slashingtypes.ValidatorSigningInfo
+}
+
+// NewSlashingKeeperMock creates a new mock slashing keeper without signing infos
+func NewSlashingKeeperMock() *SlashingKeeperMock {
+	return &SlashingKeeperMock{SigningInfos: make(map[string]slashingtypes.ValidatorSigningInfo)}
+}
+
+// SetValidatorSigningInfo stores the signing info of a validator
+func (s *SlashingKeeperMock) SetValidatorSigningInfo(address sdk.ConsAddress, info slashingtypes.ValidatorSigningInfo) {
+	s.SigningInfos[address.String()] = info
+}
+
+// GetValidatorSigningInfo satisfies the interface
+func (s *SlashingKeeperMock) GetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool) {
+	info, ok := s.SigningInfos[address.String()]
+	return info, ok
+}
+
+// DistributionKeeperMock is a mock distribution keeper holding the fee pool
+type DistributionKeeperMock struct {
+	FeePool distributiontypes.FeePool
+}
+
+// NewDistributionKeeperMock creates a new mock distribution keeper with an
+// empty community pool
+func NewDistributionKeeperMock() *DistributionKeeperMock {
+	return &DistributionKeeperMock{FeePool: distributiontypes.InitialFeePool()}
+}
+
+// GetFeePool satisfies the interface
+func (d *DistributionKeeperMock) GetFeePool(ctx sdk.Context) distributiontypes.FeePool {
+	return d.FeePool
+}
+
+// SetFeePool satisfies the interface
+func (d *DistributionKeeperMock) SetFeePool(ctx sdk.Context, feePool distributiontypes.FeePool) {
+	d.FeePool = feePool
+}
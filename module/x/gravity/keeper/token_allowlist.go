@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// IsTokenAllowlisted reports whether a token can be bridged. Every token is
+// allowed while the allowlist mode is disabled.
+func (k Keeper) IsTokenAllowlisted(ctx sdk.Context, tokenContract common.Address) bool {
+	return isTokenAllowlisted(k.GetParams(ctx), tokenContract)
+}
+
+func isTokenAllowlisted(params types.Params, tokenContract common.Address) bool {
+	if !params.TokenAllowlistEnabled {
+		return true
+	}
+	for _, contract := range params.TokenAllowlist {
+		if common.HexToAddress(contract) == tokenContract {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFeeOnTransferToken reports whether a token takes a fee on transfer or
+// rebases, so that the gravity contract may receive less than is deposited
+// and hold less than is withdrawn
+func (k Keeper) IsFeeOnTransferToken(ctx sdk.Context, tokenContract common.Address) bool {
+	return isFeeOnTransferToken(k.GetParams(ctx), tokenContract)
+}
+
+func isFeeOnTransferToken(params types.Params, tokenContract common.Address) bool {
+	for _, contract := range params.FeeOnTransferTokens {
+		if common.HexToAddress(contract) == tokenContract {
+			return true
+		}
+	}
+	return false
+}
+
+// creditedSendToCosmos returns the deposit as it is credited, deposits of fee
+// on transfer tokens credit the amount the gravity contract received. It
+// reports false for those that don't report the received amount.
+func creditedSendToCosmos(params types.Params, event *types.SendToCosmosEvent) (*types.SendToCosmosEvent, bool) {
+	if !isFeeOnTransferToken(params, common.HexToAddress(event.TokenContract)) {
+		return event, true
+	}
+	// a missing received amount decodes as zero from the store
+	if event.ReceivedAmount.IsNil() || event.ReceivedAmount.IsZero() {
+		return event, false
+	}
+	credited := *event
+	credited.Amount = event.ReceivedAmount
+	return &credited, true
+}
+
+//////////////////////////
+// HELD SEND TO COSMOS //
+//////////////////////////
+
+func (k Keeper) setHeldSendToCosmos(ctx sdk.Context, event *types.SendToCosmosEvent) {
+	key := types.MakeHeldSendToCosmosKey(common.HexToAddress(event.TokenContract), event.EventNonce)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(event))
+}
+
+func (k Keeper) deleteHeldSendToCosmos(ctx sdk.Context, event *types.SendToCosmosEvent) {
+	key := types.MakeHeldSendToCosmosKey(common.HexToAddress(event.TokenContract), event.EventNonce)
+	ctx.KVStore(k.storeKey).Delete(key)
+}
+
+// GetHeldSendToCosmos returns a held deposit by token and event nonce
+func (k Keeper) GetHeldSendToCosmos(ctx sdk.Context, tokenContract common.Address, eventNonce uint64) (*types.SendToCosmosEvent, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeHeldSendToCosmosKey(tokenContract, eventNonce))
+	if bz == nil {
+		return nil, false
+	}
+	var event types.SendToCosmosEvent
+	k.cdc.MustUnmarshal(bz, &event)
+	return &event, true
+}
+
+// holdSendToCosmos stores a deposit of a token off the allowlist, of a fee on
+// transfer token without a received amount, or that failed to be credited from
+// the mint queue, until governance releases it
+func (k Keeper) holdSendToCosmos(ctx sdk.Context, event *types.SendToCosmosEvent) {
+	k.setHeldSendToCosmos(ctx, event)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBridgeDepositHeld,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, event.TokenContract),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.EventNonce)),
+		),
+	)
+}
+
+// IterateHeldSendToCosmos iterates over all held deposits, by token and then in event nonce order
+func (k Keeper) IterateHeldSendToCosmos(ctx sdk.Context, cb func(*types.SendToCosmosEvent) bool) {
+	k.iterateHeldSendToCosmosByPrefix(ctx, []byte{types.HeldSendToCosmosKey}, cb)
+}
+
+// IterateHeldSendToCosmosByContract iterates over the held deposits of a token in event nonce order
+func (k Keeper) IterateHeldSendToCosmosByContract(ctx sdk.Context, tokenContract common.Address, cb func(*types.SendToCosmosEvent) bool) {
+	k.iterateHeldSendToCosmosByPrefix(ctx, append([]byte{types.HeldSendToCosmosKey}, tokenContract.Bytes()...), cb)
+}
+
+func (k Keeper) iterateHeldSendToCosmosByPrefix(ctx sdk.Context, prefixKey []byte, cb func(*types.SendToCosmosEvent) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var event types.SendToCosmosEvent
+		k.cdc.MustUnmarshal(iter.Value(), &event)
+		if cb(&event) {
+			break
+		}
+	}
+}
+
+// ReleaseHeldSendToCosmos credits the received amount of held deposits of a
+// token to their receivers, regardless of the allowlist. Released deposits still go through
+// the mint rate limit queue.
+func (k Keeper) ReleaseHeldSendToCosmos(ctx sdk.Context, tokenContract common.Address, eventNonces []uint64) error {
+	var events []*types.SendToCosmosEvent
+	for _, nonce := range eventNonces {
+		event, found := k.GetHeldSendToCosmos(ctx, tokenContract, nonce)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "held deposit %s/%d", tokenContract.Hex(), nonce)
+		}
+		events = append(events, event)
+	}
+
+	params := k.GetParams(ctx)
+	for _, event := range events {
+		k.deleteHeldSendToCosmos(ctx, event)
+		// only what the gravity contract received is credited, whether or not
+		// the token is listed as taking a fee on transfer. Gravity.sol emits
+		// the growth of its balance as the amount of a deposit, which is what
+		// is credited for the events lacking a received amount.
+		if !event.ReceivedAmount.IsNil() && !event.ReceivedAmount.IsZero() {
+			received := *event
+			received.Amount = event.ReceivedAmount
+			event = &received
+		}
+
+		if k.shouldQueueSendToCosmos(ctx, params, event) {
+			k.enqueueSendToCosmos(ctx, event)
+		} else if err := k.sendToCosmos(ctx, params, event); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBridgeDepositReleased,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyContract, event.TokenContract),
+				sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.EventNonce)),
+			),
+		)
+	}
+	return nil
+}
+
+// HandleHeldSendToCosmosReleaseProposal releases the held deposits listed in
+// the proposal
+func (k Keeper) HandleHeldSendToCosmosReleaseProposal(ctx sdk.Context, p *types.HeldSendToCosmosReleaseProposal) error {
+	return k.ReleaseHeldSendToCosmos(ctx, common.HexToAddress(p.TokenContract), p.EventNonces)
+}
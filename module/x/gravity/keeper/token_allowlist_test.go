@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestTokenAllowlist(t *testing.T) {
+	var (
+		input = CreateTestEnv(t)
+		ctx   = input.Context
+		gk    = input.GravityKeeper
+
+		mySender, _   = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver    = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		otherContract = common.HexToAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+		receiver      = AccAddrs[0]
+		denom         = types.GravityDenom(otherContract)
+	)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	voucher := MintVouchersFromAir(t, ctx, gk, mySender, types.NewERC20Token(1000, otherContract))
+	send := func() error {
+		_, err := gk.createSendToEthereum(ctx, mySender, myReceiver.Hex(), sdk.NewCoin(voucher.Denom, sdk.NewInt(10)), sdk.NewCoin(voucher.Denom, sdk.NewInt(1)))
+		return err
+	}
+	deposit := func(nonce uint64) *types.SendToCosmosEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  otherContract.Hex(),
+			Amount:         sdk.NewInt(50),
+			EthereumSender: EthAddrs[0].Hex(),
+			CosmosReceiver: receiver.String(),
+			EthereumHeight: 10,
+		}
+	}
+
+	// every token is bridgeable while the allowlist is disabled
+	require.NoError(t, send())
+
+	params := gk.GetParams(ctx)
+	params.TokenAllowlistEnabled = true
+	params.TokenAllowlist = []string{tokenContract.Hex()}
+	gk.SetParams(ctx, params)
+
+	require.True(t, gk.IsTokenAllowlisted(ctx, tokenContract))
+	require.False(t, gk.IsTokenAllowlisted(ctx, otherContract))
+	require.ErrorIs(t, send(), types.ErrTokenNotAllowlisted)
+
+	// deposits of the unlisted token are held instead of credited
+	require.NoError(t, gk.Handle(ctx, deposit(1)))
+	received := deposit(2)
+	received.ReceivedAmount = sdk.NewInt(45)
+	require.NoError(t, gk.Handle(ctx, received))
+	require.True(t, input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.IsZero())
+
+	res, err := gk.HeldSendToCosmosEvents(sdk.WrapSDKContext(ctx), &types.HeldSendToCosmosEventsRequest{TokenContract: otherContract.Hex()})
+	require.NoError(t, err)
+	require.Len(t, res.Events, 2)
+
+	// releasing an unknown deposit fails without releasing the others
+	require.Error(t, gk.ReleaseHeldSendToCosmos(ctx, otherContract, []uint64{1, 3}))
+	_, found := gk.GetHeldSendToCosmos(ctx, otherContract, 1)
+	require.True(t, found)
+
+	require.NoError(t, gk.HandleHeldSendToCosmosReleaseProposal(ctx, types.NewHeldSendToCosmosReleaseProposal("release", "release", otherContract.Hex(), []uint64{1})))
+	require.Equal(t, int64(50), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+	_, found = gk.GetHeldSendToCosmos(ctx, otherContract, 1)
+	require.False(t, found)
+	_, found = gk.GetHeldSendToCosmos(ctx, otherContract, 2)
+	require.True(t, found)
+
+	// a released deposit only credits what the gravity contract received
+	require.NoError(t, gk.ReleaseHeldSendToCosmos(ctx, otherContract, []uint64{2}))
+	require.Equal(t, int64(95), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+}
+
+func TestFeeOnTransferTokens(t *testing.T) {
+	var (
+		input = CreateTestEnv(t)
+		ctx   = input.Context
+		gk    = input.GravityKeeper
+
+		mySender, _   = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver    = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		receiver      = AccAddrs[0]
+		denom         = types.GravityDenom(tokenContract)
+	)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	voucher := MintVouchersFromAir(t, ctx, gk, mySender, types.NewERC20Token(1000, tokenContract))
+
+	params := gk.GetParams(ctx)
+	params.FeeOnTransferTokens = []string{tokenContract.Hex()}
+	gk.SetParams(ctx, params)
+	require.True(t, gk.IsFeeOnTransferToken(ctx, tokenContract))
+
+	_, err := gk.createSendToEthereum(ctx, mySender, myReceiver.Hex(), sdk.NewCoin(voucher.Denom, sdk.NewInt(10)), sdk.NewCoin(voucher.Denom, sdk.NewInt(1)))
+	require.ErrorIs(t, err, types.ErrFeeOnTransferToken)
+
+	// the deposit credits what the gravity contract received
+	deposit := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  tokenContract.Hex(),
+		Amount:         sdk.NewInt(50),
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: receiver.String(),
+		EthereumHeight: 10,
+		ReceivedAmount: sdk.NewInt(48),
+	}
+	require.NoError(t, gk.Handle(ctx, deposit))
+	require.Equal(t, int64(48), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+
+	// a deposit that doesn't report it is held
+	deposit = &types.SendToCosmosEvent{
+		EventNonce:     2,
+		TokenContract:  tokenContract.Hex(),
+		Amount:         sdk.NewInt(50),
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: receiver.String(),
+		EthereumHeight: 11,
+	}
+	require.NoError(t, gk.Handle(ctx, deposit))
+	require.Equal(t, int64(48), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.Int64())
+	_, found := gk.GetHeldSendToCosmos(ctx, tokenContract, 2)
+	require.True(t, found)
+}
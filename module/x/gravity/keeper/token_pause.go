@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func (k Keeper) setEthereumAnomalyReport(ctx sdk.Context, report types.EthereumAnomalyReport) {
+	validator, _ := sdk.ValAddressFromBech32(report.Validator)
+	ctx.KVStore(k.storeKey).Set(
+		types.MakeEthereumAnomalyReportKey(common.HexToAddress(report.TokenContract), validator),
+		k.cdc.MustMarshal(&report),
+	)
+}
+
+// IterateEthereumAnomalyReports iterates over the anomalies last reported by
+// each validator for a token
+func (k Keeper) IterateEthereumAnomalyReports(ctx sdk.Context, tokenContract common.Address, cb func(types.EthereumAnomalyReport) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeEthereumAnomalyReportKey(tokenContract, nil)).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var report types.EthereumAnomalyReport
+		k.cdc.MustUnmarshal(iter.Value(), &report)
+		if cb(report) {
+			break
+		}
+	}
+}
+
+// deleteEthereumAnomalyReports clears the reports of a token once they led to
+// a pause or a resume, so that the next incident is attested afresh
+func (k Keeper) deleteEthereumAnomalyReports(ctx sdk.Context, tokenContract common.Address) {
+	var keys [][]byte
+	k.IterateEthereumAnomalyReports(ctx, tokenContract, func(report types.EthereumAnomalyReport) bool {
+		validator, _ := sdk.ValAddressFromBech32(report.Validator)
+		keys = append(keys, types.MakeEthereumAnomalyReportKey(tokenContract, validator))
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) setTokenPause(ctx sdk.Context, pause types.TokenPause) {
+	ctx.KVStore(k.storeKey).Set(types.MakeTokenPauseKey(common.HexToAddress(pause.TokenContract)), k.cdc.MustMarshal(&pause))
+}
+
+// GetTokenPause returns the pause of a token, if its sends to ethereum are paused
+func (k Keeper) GetTokenPause(ctx sdk.Context, tokenContract common.Address) (types.TokenPause, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeTokenPauseKey(tokenContract))
+	if bz == nil {
+		return types.TokenPause{}, false
+	}
+
+	var pause types.TokenPause
+	k.cdc.MustUnmarshal(bz, &pause)
+	return pause, true
+}
+
+// IterateTokenPauses iterates over the tokens whose sends to ethereum are paused
+func (k Keeper) IterateTokenPauses(ctx sdk.Context, cb func(types.TokenPause) (stop bool)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.TokenPauseKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var pause types.TokenPause
+		k.cdc.MustUnmarshal(iter.Value(), &pause)
+		if cb(pause) {
+			break
+		}
+	}
+}
+
+// ReportEthereumAnomaly records the anomaly a validator observed for a token.
+// Once validators holding the event vote threshold of power report the same
+// anomaly, sends of the token to ethereum are paused, or resumed for
+// ETHEREUM_ANOMALY_RESOLVED.
+func (k Keeper) ReportEthereumAnomaly(ctx sdk.Context, validator sdk.ValAddress, tokenContract common.Address, anomaly types.EthereumAnomaly) {
+	k.setEthereumAnomalyReport(ctx, types.EthereumAnomalyReport{
+		TokenContract: tokenContract.Hex(),
+		Validator:     validator.String(),
+		Anomaly:       anomaly,
+	})
+
+	reportedPower := sdk.ZeroInt()
+	k.IterateEthereumAnomalyReports(ctx, tokenContract, func(report types.EthereumAnomalyReport) bool {
+		if report.Anomaly == anomaly {
+			val, _ := sdk.ValAddressFromBech32(report.Validator)
+			reportedPower = reportedPower.Add(sdk.NewInt(k.StakingKeeper.GetLastValidatorPower(ctx, val)))
+		}
+		return false
+	})
+	if reportedPower.LT(types.EventVoteRecordPowerThreshold(k.StakingKeeper.GetLastTotalPower(ctx), k.GetEventVotePowerThreshold(ctx))) {
+		return
+	}
+
+	pause, paused := k.GetTokenPause(ctx, tokenContract)
+	switch {
+	case anomaly == types.ETHEREUM_ANOMALY_RESOLVED && paused:
+		ctx.KVStore(k.storeKey).Delete(types.MakeTokenPauseKey(tokenContract))
+		k.Logger(ctx).Info("sends to ethereum resumed", "token", tokenContract.Hex(), "anomaly", pause.Anomaly)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeTokenOutboundResumed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyTokenContract, tokenContract.Hex()),
+			sdk.NewAttribute(types.AttributeKeyEthereumAnomaly, pause.Anomaly.String()),
+		))
+	case anomaly != types.ETHEREUM_ANOMALY_RESOLVED && (!paused || pause.Anomaly != anomaly):
+		k.setTokenPause(ctx, types.TokenPause{
+			TokenContract: tokenContract.Hex(),
+			Anomaly:       anomaly,
+			Height:        uint64(ctx.BlockHeight()),
+		})
+		k.Logger(ctx).Error("sends to ethereum paused on attested ethereum anomaly", "token", tokenContract.Hex(), "anomaly", anomaly)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeTokenOutboundPaused,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyTokenContract, tokenContract.Hex()),
+			sdk.NewAttribute(types.AttributeKeyEthereumAnomaly, anomaly.String()),
+		))
+	default:
+		return
+	}
+
+	k.deleteEthereumAnomalyReports(ctx, tokenContract)
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestReportEthereumAnomaly(t *testing.T) {
+	var (
+		input = CreateTestEnv(t)
+		ctx   = input.Context
+		gk    = input.GravityKeeper
+
+		mySender, _   = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver    = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(ValAddrs[0], ValAddrs[1], ValAddrs[2])
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	voucher := MintVouchersFromAir(t, ctx, gk, mySender, types.NewERC20Token(1000, tokenContract))
+	send := func() error {
+		_, err := gk.createSendToEthereum(ctx, mySender, myReceiver.Hex(), sdk.NewCoin(voucher.Denom, sdk.NewInt(10)), sdk.NewCoin(voucher.Denom, sdk.NewInt(1)))
+		return err
+	}
+	require.NoError(t, send())
+
+	// a single report is below the threshold
+	gk.ReportEthereumAnomaly(ctx, ValAddrs[0], tokenContract, types.ETHEREUM_ANOMALY_TOKEN_PAUSED)
+	_, paused := gk.GetTokenPause(ctx, tokenContract)
+	require.False(t, paused)
+
+	// reports of different anomalies are not added up
+	gk.ReportEthereumAnomaly(ctx, ValAddrs[1], tokenContract, types.ETHEREUM_ANOMALY_BRIDGE_BLACKLISTED)
+	_, paused = gk.GetTokenPause(ctx, tokenContract)
+	require.False(t, paused)
+
+	gk.ReportEthereumAnomaly(ctx, ValAddrs[2], tokenContract, types.ETHEREUM_ANOMALY_TOKEN_PAUSED)
+	pause, paused := gk.GetTokenPause(ctx, tokenContract)
+	require.True(t, paused)
+	require.Equal(t, types.ETHEREUM_ANOMALY_TOKEN_PAUSED, pause.Anomaly)
+
+	res, err := gk.TokenPauses(sdk.WrapSDKContext(ctx), &types.TokenPausesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.TokenPause{pause}, res.Pauses)
+
+	// neither sends nor batches of the token are created while it is paused
+	require.ErrorIs(t, send(), types.ErrTokenPaused)
+	require.Nil(t, gk.BuildBatchTx(ctx, tokenContract, 10))
+
+	// the reports that led to the pause are cleared, resolving needs the threshold again
+	gk.ReportEthereumAnomaly(ctx, ValAddrs[0], tokenContract, types.ETHEREUM_ANOMALY_RESOLVED)
+	_, paused = gk.GetTokenPause(ctx, tokenContract)
+	require.True(t, paused)
+
+	gk.ReportEthereumAnomaly(ctx, ValAddrs[1], tokenContract, types.ETHEREUM_ANOMALY_RESOLVED)
+	_, paused = gk.GetTokenPause(ctx, tokenContract)
+	require.False(t, paused)
+
+	require.NoError(t, send())
+	require.NotNil(t, gk.BuildBatchTx(ctx, tokenContract, 10))
+}
@@ -0,0 +1,43 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// MigrateStore tags the ethereum signatures over outgoing txs, stored as raw
+// ECDSA signatures up to consensus version 2, with their scheme
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Gravity v2 to v3: Beginning store migration")
+
+	store := ctx.KVStore(storeKey)
+
+	migrateEthereumSignatures(store, cdc)
+
+	ctx.Logger().Info("Gravity v2 to v3: Store migration complete")
+
+	return nil
+}
+
+func migrateEthereumSignatures(store storetypes.KVStore, cdc codec.BinaryCodec) {
+	prefixStore := prefix.NewStore(store, []byte{types.EthereumSignatureKey})
+
+	// collect first, the store can't be written while it is being iterated
+	var keys, signatures [][]byte
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		signatures = append(signatures, iter.Value())
+	}
+	iter.Close()
+
+	for i, key := range keys {
+		prefixStore.Set(key, cdc.MustMarshal(&types.EthereumSignature{
+			Scheme:    types.SIGNATURE_SCHEME_ECDSA,
+			Signature: signatures[i],
+		}))
+	}
+}
@@ -0,0 +1,24 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/keeper"
+	v2 "github.com/peggyjv/gravity-bridge/module/v2/x/gravity/migrations/v2"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestMigrateEthereumSignatures(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	storeIndex := types.MakeSignerSetTxKey(1)
+	ctx.KVStore(input.GravityStoreKey).Set(types.MakeEthereumSignatureKey(storeIndex, keeper.ValAddrs[0]), []byte("raw-signature"))
+
+	require.NoError(t, v2.MigrateStore(ctx, input.GravityStoreKey, input.Marshaler))
+
+	require.Equal(t, map[string][]byte{keeper.ValAddrs[0].String(): []byte("raw-signature")}, gk.GetEthereumSignatures(ctx, storeIndex))
+}
@@ -0,0 +1,47 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// MigrateStore re-keys the pool of unbatched sends to ethereum, keyed by id
+// up to consensus version 3, by inverted id so that the pool of a token is
+// iterated by fee descending then by id ascending
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Gravity v3 to v4: Beginning store migration")
+
+	store := ctx.KVStore(storeKey)
+
+	migrateUnbatchedSendToEthereums(store, cdc)
+
+	ctx.Logger().Info("Gravity v3 to v4: Store migration complete")
+
+	return nil
+}
+
+func migrateUnbatchedSendToEthereums(store storetypes.KVStore, cdc codec.BinaryCodec) {
+	prefixStore := prefix.NewStore(store, []byte{types.SendToEthereumKey})
+
+	// collect first, the store can't be written while it is being iterated
+	var keys, values [][]byte
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		values = append(values, iter.Value())
+	}
+	iter.Close()
+
+	// delete every old key before writing the new ones, which could collide
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+	for _, value := range values {
+		var ste types.SendToEthereum
+		cdc.MustUnmarshal(value, &ste)
+		store.Set(types.MakeSendToEthereumKey(ste.Id, ste.Erc20Fee), value)
+	}
+}
@@ -0,0 +1,42 @@
+package v3_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/keeper"
+	v3 "github.com/peggyjv/gravity-bridge/module/v2/x/gravity/migrations/v3"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestMigrateUnbatchedSendToEthereums(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+	tokenContract := common.HexToAddress(keeper.TokenContractAddrs[0])
+
+	// store the pool with the keys of consensus version 3
+	store := ctx.KVStore(input.GravityStoreKey)
+	for _, ste := range []*types.SendToEthereum{
+		types.NewSendToEthereumTx(1, tokenContract, keeper.AccAddrs[0], keeper.EthAddrs[0], 100, 2),
+		types.NewSendToEthereumTx(2, tokenContract, keeper.AccAddrs[0], keeper.EthAddrs[0], 100, 3),
+		types.NewSendToEthereumTx(3, tokenContract, keeper.AccAddrs[0], keeper.EthAddrs[0], 100, 2),
+	} {
+		amount := make([]byte, 32)
+		key := bytes.Join([][]byte{{types.SendToEthereumKey}, tokenContract.Bytes(), ste.Erc20Fee.Amount.BigInt().FillBytes(amount), sdk.Uint64ToBigEndian(ste.Id)}, []byte{})
+		store.Set(key, input.Marshaler.MustMarshal(ste))
+	}
+
+	require.NoError(t, v3.MigrateStore(ctx, input.GravityStoreKey, input.Marshaler))
+
+	var ids []uint64
+	gk.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		ids = append(ids, ste.Id)
+		return false
+	})
+	require.Equal(t, []uint64{2, 1, 3}, ids)
+}
@@ -0,0 +1,133 @@
+package v4
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// MigrateStore builds the secondary indexes introduced after consensus version
+// 4 from the records they index: the index of validators by ethereum address,
+// and the checkpoints of the outgoing txs the chain created, of which only the
+// pending ones are still known. The highest nonces of the outgoing txs created
+// so far are recorded in place of the checkpoints of the others.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, gravityID string) error {
+	ctx.Logger().Info("Gravity v4 to v5: Beginning store migration")
+
+	store := ctx.KVStore(storeKey)
+
+	indexEthereumAddressValidators(store)
+	if err := recordPastEthereumSignatureCheckpoints(store, cdc, gravityID); err != nil {
+		return err
+	}
+	if err := recordPastOutgoingTxNonces(store, cdc); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("Gravity v4 to v5: Store migration complete")
+
+	return nil
+}
+
+func indexEthereumAddressValidators(store storetypes.KVStore) {
+	// collect first, the store can't be written while it is being iterated
+	var keys [][]byte
+	for _, keyPrefix := range []byte{types.ValidatorEthereumAddressKey, types.PendingValidatorEthereumAddressKey} {
+		iter := prefix.NewStore(store, []byte{keyPrefix}).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, types.MakeEthereumAddressValidatorKey(common.BytesToAddress(iter.Value()), sdk.ValAddress(iter.Key())))
+		}
+		iter.Close()
+	}
+
+	for _, key := range keys {
+		store.Set(key, []byte{0x1})
+	}
+}
+
+func recordPastEthereumSignatureCheckpoints(store storetypes.KVStore, cdc codec.BinaryCodec, gravityID string) error {
+	var checkpoints [][]byte
+	iter := prefix.NewStore(store, []byte{types.OutgoingTxKey}).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var otx types.OutgoingTx
+		if err := cdc.UnmarshalInterface(iter.Value(), &otx); err != nil {
+			iter.Close()
+			return err
+		}
+		checkpoints = append(checkpoints, otx.GetCheckpoint([]byte(gravityID)))
+	}
+	iter.Close()
+
+	for _, checkpoint := range checkpoints {
+		store.Set(types.MakePastEthereumSignatureCheckpointKey(checkpoint), []byte{1})
+	}
+	return nil
+}
+
+func recordPastOutgoingTxNonces(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	pastNonces := make(map[string]uint64)
+	record := func(scope []byte, nonce uint64) {
+		if nonce > pastNonces[string(scope)] {
+			pastNonces[string(scope)] = nonce
+		}
+	}
+
+	if bz := store.Get([]byte{types.LatestSignerSetTxNonceKey}); bz != nil {
+		record([]byte{types.SignerSetTxPrefixByte}, sdk.BigEndianToUint64(bz))
+	}
+	if bz := store.Get([]byte{types.LastOutgoingBatchNonceKey}); bz != nil {
+		record([]byte{types.BatchTxPrefixByte}, sdk.BigEndianToUint64(bz))
+	}
+
+	// contract call nonces increase by invalidation scope, which are only
+	// known from the pending and archived contract calls
+	iter := prefix.NewStore(store, []byte{types.OutgoingTxKey}).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var otx types.OutgoingTx
+		if err := cdc.UnmarshalInterface(iter.Value(), &otx); err != nil {
+			iter.Close()
+			return err
+		}
+		if _, ok := otx.(*types.ContractCallTx); ok {
+			record(types.OutgoingTxNonce(otx))
+		}
+	}
+	iter.Close()
+
+	iter = prefix.NewStore(store, []byte{types.ExecutedContractCallTxKey}).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var executed types.ContractCallTxExecutionRecord
+		if err := cdc.Unmarshal(iter.Value(), &executed); err != nil {
+			iter.Close()
+			return err
+		}
+		record(append([]byte{types.ContractCallTxPrefixByte}, executed.InvalidationScope...), executed.InvalidationNonce)
+	}
+	iter.Close()
+
+	iter = prefix.NewStore(store, []byte{types.RefundedContractCallTxKey}).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var refunded types.ContractCallTxRefundRecord
+		if err := cdc.Unmarshal(iter.Value(), &refunded); err != nil {
+			iter.Close()
+			return err
+		}
+		record(append([]byte{types.ContractCallTxPrefixByte}, refunded.InvalidationScope...), refunded.InvalidationNonce)
+	}
+	iter.Close()
+
+	scopes := make([]string, 0, len(pastNonces))
+	for scope := range pastNonces {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+	for _, scope := range scopes {
+		store.Set(types.MakePastOutgoingTxNonceKey([]byte(scope)), sdk.Uint64ToBigEndian(pastNonces[scope]))
+	}
+	return nil
+}
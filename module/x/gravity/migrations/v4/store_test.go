@@ -0,0 +1,52 @@
+package v4_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/keeper"
+	v4 "github.com/peggyjv/gravity-bridge/module/v2/x/gravity/migrations/v4"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	store := ctx.KVStore(input.GravityStoreKey)
+	gravityID := input.GravityKeeper.GetParams(ctx).GravityId
+
+	// store the records of consensus version 4, without their indexes
+	valAddr := sdk.ValAddress(keeper.AccAddrs[0])
+	store.Set(types.MakeValidatorEthereumAddressKey(valAddr), keeper.EthAddrs[0].Bytes())
+	store.Set(types.MakePendingValidatorEthereumAddressKey(valAddr), keeper.EthAddrs[1].Bytes())
+
+	signerSetTx := types.NewSignerSetTx(1, 1, nil)
+	any, err := types.PackOutgoingTx(signerSetTx)
+	require.NoError(t, err)
+	store.Set(types.MakeOutgoingTxKey(signerSetTx.GetStoreIndex()), input.Marshaler.MustMarshal(any))
+
+	store.Set([]byte{types.LatestSignerSetTxNonceKey}, sdk.Uint64ToBigEndian(signerSetTx.Nonce))
+	store.Set([]byte{types.LastOutgoingBatchNonceKey}, sdk.Uint64ToBigEndian(7))
+	contractCallTx := &types.ContractCallTx{InvalidationScope: []byte{0x1}, InvalidationNonce: 3}
+	any, err = types.PackOutgoingTx(contractCallTx)
+	require.NoError(t, err)
+	store.Set(types.MakeOutgoingTxKey(contractCallTx.GetStoreIndex()), input.Marshaler.MustMarshal(any))
+	executed := types.ContractCallTxExecutionRecord{InvalidationScope: []byte{0x1}, InvalidationNonce: 2, ExecutedHeight: 1}
+	store.Set(types.MakeExecutedContractCallTxKey(1, executed.InvalidationScope, executed.InvalidationNonce), input.Marshaler.MustMarshal(&executed))
+
+	require.NoError(t, v4.MigrateStore(ctx, input.GravityStoreKey, input.Marshaler, gravityID))
+
+	require.True(t, store.Has(types.MakeEthereumAddressValidatorKey(keeper.EthAddrs[0], valAddr)))
+	require.True(t, store.Has(types.MakeEthereumAddressValidatorKey(keeper.EthAddrs[1], valAddr)))
+	require.True(t, store.Has(types.MakePastEthereumSignatureCheckpointKey(signerSetTx.GetCheckpoint([]byte(gravityID)))))
+
+	for scope, nonce := range map[string]uint64{
+		string([]byte{types.SignerSetTxPrefixByte}):         signerSetTx.Nonce,
+		string([]byte{types.BatchTxPrefixByte}):             7,
+		string([]byte{types.ContractCallTxPrefixByte, 0x1}): 3,
+	} {
+		require.Equal(t, sdk.Uint64ToBigEndian(nonce), store.Get(types.MakePastOutgoingTxNonceKey([]byte(scope))))
+	}
+}
@@ -0,0 +1,20 @@
+package v5
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// MigrateStore sets the signer set power reduction, a constructor argument of
+// the keeper up to consensus version 5, to the staking power reduction the
+// keeper was constructed with so that signer sets are left unchanged
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace, powerReduction sdk.Int) error {
+	ctx.Logger().Info("Gravity v5 to v6: Beginning store migration")
+
+	paramSpace.Set(ctx, types.ParamStorePowerReduction, powerReduction)
+
+	ctx.Logger().Info("Gravity v5 to v6: Store migration complete")
+
+	return nil
+}
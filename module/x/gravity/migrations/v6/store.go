@@ -0,0 +1,47 @@
+package v6
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// MigrateStore builds the index of the outgoing txs by timeout introduced
+// after consensus version 6 from the pending batches and contract calls
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Gravity v6 to v7: Beginning store migration")
+
+	store := ctx.KVStore(storeKey)
+
+	if err := indexOutgoingTxTimeouts(store, cdc); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("Gravity v6 to v7: Store migration complete")
+
+	return nil
+}
+
+func indexOutgoingTxTimeouts(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	// collect first, the store can't be written while it is being iterated
+	var keys [][]byte
+	iter := prefix.NewStore(store, []byte{types.OutgoingTxKey}).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var otx types.OutgoingTx
+		if err := cdc.UnmarshalInterface(iter.Value(), &otx); err != nil {
+			iter.Close()
+			return err
+		}
+		if timeout, ok := types.OutgoingTxTimeout(otx); ok {
+			keys = append(keys, types.MakeOutgoingTxTimeoutKey(timeout, otx.GetStoreIndex()))
+		}
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Set(key, []byte{0x1})
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/keeper"
+	v6 "github.com/peggyjv/gravity-bridge/module/v2/x/gravity/migrations/v6"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	store := ctx.KVStore(input.GravityStoreKey)
+
+	// store the outgoing txs of consensus version 6, without their index
+	outgoingTxs := []types.OutgoingTx{
+		types.NewSignerSetTx(1, 1, nil),
+		&types.BatchTx{BatchNonce: 1, Timeout: 100, TokenContract: common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5").Hex(), Height: 1},
+		&types.ContractCallTx{InvalidationScope: []byte{0x1}, InvalidationNonce: 1, Timeout: 200, Height: 1},
+	}
+	for _, otx := range outgoingTxs {
+		any, err := types.PackOutgoingTx(otx)
+		require.NoError(t, err)
+		store.Set(types.MakeOutgoingTxKey(otx.GetStoreIndex()), input.Marshaler.MustMarshal(any))
+	}
+
+	require.NoError(t, v6.MigrateStore(ctx, input.GravityStoreKey, input.Marshaler))
+
+	require.True(t, store.Has(types.MakeOutgoingTxTimeoutKey(100, outgoingTxs[1].GetStoreIndex())))
+	require.True(t, store.Has(types.MakeOutgoingTxTimeoutKey(200, outgoingTxs[2].GetStoreIndex())))
+
+	var timedOut []types.OutgoingTx
+	input.GravityKeeper.IterateTimedOutOutgoingTxs(ctx, types.ContractCallTxPrefixByte, 201, func(otx types.OutgoingTx) bool {
+		timedOut = append(timedOut, otx)
+		return false
+	})
+	require.Len(t, timedOut, 1)
+}
@@ -0,0 +1,56 @@
+package v7
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// MigrateStore sets the params added up to consensus version 7 that a chain
+// upgrading from an earlier version doesn't have in its store to their
+// defaults, leaving the params it already has untouched. Reading the params
+// panics while any of them is missing. It then moves the records of the
+// ethereum header chain under the bridge chain id they were voted for.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace) error {
+	ctx.Logger().Info("Gravity v7 to v8: Beginning store migration")
+
+	setMissingParams(ctx, paramSpace)
+
+	var chainID uint64
+	paramSpace.Get(ctx, types.ParamsStoreKeyBridgeContractChainID, &chainID)
+	prefixEthereumHeaderKeys(ctx.KVStore(storeKey), chainID)
+
+	ctx.Logger().Info("Gravity v7 to v8: Store migration complete")
+
+	return nil
+}
+
+func setMissingParams(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	for _, pair := range types.DefaultParams().ParamSetPairs() {
+		if !paramSpace.Has(ctx, pair.Key) {
+			paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
+
+// prefixEthereumHeaderKeys inserts the bridge chain id after the prefix of the
+// keys of the ethereum header votes and agreed headers
+func prefixEthereumHeaderKeys(store storetypes.KVStore, chainID uint64) {
+	// collect first, the store can't be written while it is being iterated
+	var keys, values [][]byte
+	for _, keyPrefix := range []byte{types.EthereumHeaderVoteKey, types.AgreedEthereumHeaderKey, types.AgreedEthereumHeaderByHeightKey} {
+		iter := prefix.NewStore(store, []byte{keyPrefix}).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, append([]byte{keyPrefix}, iter.Key()...))
+			values = append(values, iter.Value())
+		}
+		iter.Close()
+	}
+
+	for i, key := range keys {
+		store.Delete(key)
+		store.Set(append(append([]byte{key[0]}, sdk.Uint64ToBigEndian(chainID)...), key[1:]...), values[i])
+	}
+}
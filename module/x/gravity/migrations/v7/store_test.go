@@ -0,0 +1,101 @@
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/keeper"
+	v7 "github.com/peggyjv/gravity-bridge/module/v2/x/gravity/migrations/v7"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsStoreKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(tStoreKey, storetypes.StoreTypeTransient, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	paramSpace := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), paramsStoreKey, tStoreKey, types.DefaultParamspace).
+		WithKeyTable(types.ParamKeyTable())
+
+	// a chain upgrading from an earlier version only has some of the params
+	paramSpace.Set(ctx, types.ParamsStoreKeyGravityID, "gravity-test")
+	paramSpace.Set(ctx, types.ParamsStoreKeySignedBatchesWindow, uint64(12345))
+	require.Panics(t, func() {
+		var params types.Params
+		paramSpace.GetParamSet(ctx, &params)
+	})
+
+	require.NoError(t, v7.MigrateStore(ctx, storeKey, paramSpace))
+
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+	require.Equal(t, "gravity-test", params.GravityId)
+	require.Equal(t, uint64(12345), params.SignedBatchesWindow)
+
+	defaults := types.DefaultParams()
+	require.Equal(t, defaults.TargetEthTxTimeout, params.TargetEthTxTimeout)
+	require.Equal(t, defaults.PowerReduction, params.PowerReduction)
+}
+
+func TestMigrateStoreEthereumHeaderKeys(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+	store := ctx.KVStore(input.GravityStoreKey)
+	paramSpace, _ := input.ParamsKeeper.GetSubspace(types.DefaultParamspace)
+
+	// store the ethereum header records of consensus version 7, keyed without
+	// the bridge chain id
+	previous := types.EthereumHeader{Height: 1000, Hash: []byte{0x1}}
+	agreed := types.EthereumHeader{Height: 1100, Hash: []byte{0x2}, ParentCheckpointHash: previous.Hash}
+	vote := types.EthereumHeaderVote{ValidatorAddress: keeper.ValAddrs[0].String(), Header: agreed}
+	oldKeys := map[string][]byte{
+		string([]byte{types.AgreedEthereumHeaderKey}):                                                 input.Marshaler.MustMarshal(&agreed),
+		string(append([]byte{types.AgreedEthereumHeaderByHeightKey}, sdk.Uint64ToBigEndian(1000)...)): input.Marshaler.MustMarshal(&previous),
+		string(append([]byte{types.AgreedEthereumHeaderByHeightKey}, sdk.Uint64ToBigEndian(1100)...)): input.Marshaler.MustMarshal(&agreed),
+		string(append([]byte{types.EthereumHeaderVoteKey}, keeper.ValAddrs[0]...)):                    input.Marshaler.MustMarshal(&vote),
+	}
+	for key, value := range oldKeys {
+		store.Set([]byte(key), value)
+	}
+
+	require.NoError(t, v7.MigrateStore(ctx, input.GravityStoreKey, paramSpace))
+
+	for key := range oldKeys {
+		require.False(t, store.Has([]byte(key)))
+	}
+	require.Equal(t, &agreed, gk.GetAgreedEthereumHeader(ctx))
+
+	var headers []types.EthereumHeader
+	gk.IterateAgreedEthereumHeaders(ctx, func(header types.EthereumHeader) bool {
+		headers = append(headers, header)
+		return false
+	})
+	require.Equal(t, []types.EthereumHeader{previous, agreed}, headers)
+
+	var votes []types.EthereumHeaderVote
+	gk.IterateEthereumHeaderVotes(ctx, func(vote types.EthereumHeaderVote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+	require.Equal(t, []types.EthereumHeaderVote{vote}, votes)
+}
@@ -0,0 +1,178 @@
+// Package replay rebuilds the derived bridge state by folding the log of the
+// observed ethereum events the gravity module keeps in its store, so that it
+// can be diffed against the live state to detect corruption.
+//
+// The log starts with the upgrade that introduced it, or with the last
+// gravity contract migration, so the state rebuilt covers the events observed
+// since then: the bridged supplies are the net amounts bridged in since the
+// log started, and the live ones are kept from the same point on.
+package replay
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// Source is the read access to the gravity store the replay is built from,
+// implemented by the gravity keeper
+type Source interface {
+	IterateObservedEthereumEvents(ctx sdk.Context, cb func(entry types.ObservedEthereumEvent) (stop bool))
+}
+
+// State is the derived bridge state compared by Diff
+type State struct {
+	LastObservedEventNonce     uint64
+	LastObservedEthereumHeight uint64
+	LastObservedSignerSet      *types.SignerSetTx
+	// BridgedSupplies is the net amount of each ERC20 bridged in: the
+	// deposits less the transfers and fees of the executed batches and
+	// contract calls, in units of the ERC20
+	BridgedSupplies map[common.Address]sdk.Int
+}
+
+// NewState returns the bridge state before any event is applied
+func NewState() State {
+	return State{BridgedSupplies: make(map[common.Address]sdk.Int)}
+}
+
+// Rebuild folds the log of observed ethereum events into the bridge state
+func Rebuild(ctx sdk.Context, src Source) (State, error) {
+	state := NewState()
+
+	var err error
+	src.IterateObservedEthereumEvents(ctx, func(entry types.ObservedEthereumEvent) bool {
+		err = Apply(&state, entry)
+		return err != nil
+	})
+	if err != nil {
+		return State{}, err
+	}
+
+	return state, nil
+}
+
+// Apply folds an entry of the log of observed ethereum events into the state.
+// The entries must be applied in event nonce order, without gaps.
+func Apply(state *State, entry types.ObservedEthereumEvent) error {
+	event, err := types.UnpackEvent(entry.Event)
+	if err != nil {
+		return err
+	}
+	if state.LastObservedEventNonce != 0 && event.GetEventNonce() != state.LastObservedEventNonce+1 {
+		return sdkerrors.Wrapf(types.ErrInvalid, "event log skips from nonce %d to %d", state.LastObservedEventNonce, event.GetEventNonce())
+	}
+	state.LastObservedEventNonce = event.GetEventNonce()
+	state.LastObservedEthereumHeight = event.GetEthereumHeight()
+
+	switch event := event.(type) {
+	case *types.SendToCosmosEvent:
+		state.addBridgedSupply(common.HexToAddress(event.TokenContract), event.Amount)
+
+	case *types.SendEtherToCosmosEvent:
+		state.addBridgedSupply(types.NativeEtherContract, event.Amount)
+
+	case *types.BatchExecutedEvent:
+		if batchTx := entry.ExecutedBatchTx; batchTx != nil {
+			for _, tx := range batchTx.Transactions {
+				state.addBridgedSupply(common.HexToAddress(batchTx.TokenContract), tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount).Neg())
+			}
+		}
+
+	case *types.ContractCallExecutedEvent:
+		if contractCallTx := entry.ExecutedContractCallTx; contractCallTx != nil {
+			for _, tokens := range [][]types.ERC20Token{contractCallTx.Tokens, contractCallTx.Fees} {
+				for _, token := range tokens {
+					state.addBridgedSupply(common.HexToAddress(token.Contract), token.Amount.Neg())
+				}
+			}
+		}
+
+	case *types.SignerSetTxExecutedEvent:
+		state.LastObservedSignerSet = &types.SignerSetTx{
+			Nonce:   event.SignerSetTxNonce,
+			Signers: event.Members,
+		}
+	}
+
+	return nil
+}
+
+func (s *State) addBridgedSupply(tokenContract common.Address, amount sdk.Int) {
+	supply, ok := s.BridgedSupplies[tokenContract]
+	if !ok {
+		supply = sdk.ZeroInt()
+	}
+	s.BridgedSupplies[tokenContract] = supply.Add(amount)
+}
+
+// Diff compares a rebuilt state against the live one and returns every field
+// they disagree on. The event nonce is not rebuilt from an empty log, and the
+// live ethereum height may run ahead of the last event through height votes,
+// so it is only checked as a lower bound.
+func Diff(rebuilt, live State) (out []types.ReplayDiscrepancy) {
+	report := func(field string, rebuilt, live interface{}) {
+		out = append(out, types.ReplayDiscrepancy{
+			Field:   field,
+			Rebuilt: fmt.Sprint(rebuilt),
+			Live:    fmt.Sprint(live),
+		})
+	}
+
+	if rebuilt.LastObservedEventNonce != 0 && rebuilt.LastObservedEventNonce != live.LastObservedEventNonce {
+		report("last_observed_event_nonce", rebuilt.LastObservedEventNonce, live.LastObservedEventNonce)
+	}
+	if live.LastObservedEthereumHeight < rebuilt.LastObservedEthereumHeight {
+		report("last_observed_ethereum_height", rebuilt.LastObservedEthereumHeight, live.LastObservedEthereumHeight)
+	}
+
+	if rebuilt.LastObservedSignerSet != nil {
+		switch {
+		case live.LastObservedSignerSet == nil:
+			report("last_observed_signer_set.nonce", rebuilt.LastObservedSignerSet.Nonce, "none")
+		case rebuilt.LastObservedSignerSet.Nonce != live.LastObservedSignerSet.Nonce:
+			report("last_observed_signer_set.nonce", rebuilt.LastObservedSignerSet.Nonce, live.LastObservedSignerSet.Nonce)
+		case !bytes.Equal(rebuilt.LastObservedSignerSet.Signers.Hash(), live.LastObservedSignerSet.Signers.Hash()):
+			report("last_observed_signer_set.signers", rebuilt.LastObservedSignerSet.Signers, live.LastObservedSignerSet.Signers)
+		}
+	}
+
+	for _, tokenContract := range tokenContracts(rebuilt.BridgedSupplies, live.BridgedSupplies) {
+		r, l := supplyOf(rebuilt, tokenContract), supplyOf(live, tokenContract)
+		if !r.Equal(l) {
+			report("bridged_supplies."+tokenContract.Hex(), r, l)
+		}
+	}
+
+	return out
+}
+
+func supplyOf(state State, tokenContract common.Address) sdk.Int {
+	if supply, ok := state.BridgedSupplies[tokenContract]; ok {
+		return supply
+	}
+	return sdk.ZeroInt()
+}
+
+// tokenContracts returns the sorted union of the token contracts of both
+// supply sets
+func tokenContracts(a, b map[common.Address]sdk.Int) []common.Address {
+	seen := make(map[common.Address]bool)
+	var out []common.Address
+	for _, supplies := range []map[common.Address]sdk.Int{a, b} {
+		for tokenContract := range supplies {
+			if !seen[tokenContract] {
+				seen[tokenContract] = true
+				out = append(out, tokenContract)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return bytes.Compare(out[i].Bytes(), out[j].Bytes()) < 0 })
+	return out
+}
@@ -0,0 +1,154 @@
+package replay
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+var (
+	tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	ethSender     = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	cosmosAddr    = "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn"
+	blockHash     = common.HexToHash("0x01").Bytes()
+)
+
+// eventLog is a Source over a log of observed ethereum events
+type eventLog []types.ObservedEthereumEvent
+
+func (l eventLog) IterateObservedEthereumEvents(_ sdk.Context, cb func(entry types.ObservedEthereumEvent) (stop bool)) {
+	for _, entry := range l {
+		if cb(entry) {
+			return
+		}
+	}
+}
+
+func logEntry(t *testing.T, event types.EthereumEvent) types.ObservedEthereumEvent {
+	any, err := types.PackEvent(event)
+	require.NoError(t, err)
+	return types.ObservedEthereumEvent{Event: any}
+}
+
+func deposit(nonce uint64, amount int64, height uint64) *types.SendToCosmosEvent {
+	return &types.SendToCosmosEvent{
+		EventNonce:        nonce,
+		TokenContract:     tokenContract.Hex(),
+		Amount:            sdk.NewInt(amount),
+		EthereumSender:    ethSender.Hex(),
+		CosmosReceiver:    cosmosAddr,
+		EthereumHeight:    height,
+		EthereumBlockHash: blockHash,
+	}
+}
+
+func TestRebuild(t *testing.T) {
+	signers := types.EthereumSigners{{Power: 100, EthereumAddress: ethSender.Hex()}}
+
+	batchExecuted := logEntry(t, &types.BatchExecutedEvent{
+		TokenContract:     tokenContract.Hex(),
+		EventNonce:        3,
+		EthereumHeight:    12,
+		BatchNonce:        1,
+		EthereumBlockHash: blockHash,
+	})
+	batchExecuted.ExecutedBatchTx = &types.BatchTx{
+		BatchNonce:    1,
+		TokenContract: tokenContract.Hex(),
+		Transactions: []*types.SendToEthereum{
+			{Erc20Token: types.NewERC20Token(300, tokenContract), Erc20Fee: types.NewERC20Token(10, tokenContract)},
+			{Erc20Token: types.NewERC20Token(200, tokenContract), Erc20Fee: types.NewERC20Token(5, tokenContract)},
+		},
+	}
+
+	contractCallExecuted := logEntry(t, &types.ContractCallExecutedEvent{
+		EventNonce:        4,
+		InvalidationScope: []byte{0x1},
+		InvalidationNonce: 1,
+		EthereumHeight:    13,
+		Success:           true,
+		EthereumBlockHash: blockHash,
+	})
+	contractCallExecuted.ExecutedContractCallTx = &types.ContractCallTx{
+		InvalidationScope: []byte{0x1},
+		InvalidationNonce: 1,
+		Tokens:            []types.ERC20Token{types.NewERC20Token(50, tokenContract)},
+		Fees:              []types.ERC20Token{types.NewERC20Token(5, tokenContract)},
+	}
+
+	state, err := Rebuild(sdk.Context{}, eventLog{
+		logEntry(t, deposit(1, 1000, 10)),
+		logEntry(t, &types.SendEtherToCosmosEvent{
+			EventNonce:        2,
+			Amount:            sdk.NewInt(7),
+			EthereumSender:    ethSender.Hex(),
+			CosmosReceiver:    cosmosAddr,
+			EthereumHeight:    11,
+			EthereumBlockHash: blockHash,
+		}),
+		batchExecuted,
+		contractCallExecuted,
+		logEntry(t, &types.SignerSetTxExecutedEvent{
+			EventNonce:        5,
+			SignerSetTxNonce:  2,
+			EthereumHeight:    14,
+			Members:           signers,
+			EthereumBlockHash: blockHash,
+		}),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(5), state.LastObservedEventNonce)
+	require.Equal(t, uint64(14), state.LastObservedEthereumHeight)
+	require.Equal(t, &types.SignerSetTx{Nonce: 2, Signers: signers}, state.LastObservedSignerSet)
+	require.Equal(t, map[common.Address]sdk.Int{
+		tokenContract:             sdk.NewInt(1000 - 515 - 55),
+		types.NativeEtherContract: sdk.NewInt(7),
+	}, state.BridgedSupplies)
+}
+
+func TestRebuildEventLogGap(t *testing.T) {
+	_, err := Rebuild(sdk.Context{}, eventLog{
+		logEntry(t, deposit(4, 100, 10)),
+		logEntry(t, deposit(6, 100, 11)),
+	})
+	require.Error(t, err)
+}
+
+func TestDiff(t *testing.T) {
+	rebuilt, err := Rebuild(sdk.Context{}, eventLog{
+		logEntry(t, deposit(1, 100, 10)),
+		logEntry(t, deposit(2, 50, 11)),
+	})
+	require.NoError(t, err)
+
+	live := NewState()
+	live.LastObservedEventNonce = 2
+	live.LastObservedEthereumHeight = 15
+	live.BridgedSupplies[tokenContract] = sdk.NewInt(150)
+	require.Empty(t, Diff(rebuilt, live))
+
+	// the nonce and height are not rebuilt from an empty log
+	require.Equal(t, []types.ReplayDiscrepancy{
+		{Field: "bridged_supplies." + tokenContract.Hex(), Rebuilt: "0", Live: "150"},
+	}, Diff(NewState(), live))
+
+	// seed a mismatch in every field
+	live.LastObservedEventNonce = 3
+	live.LastObservedEthereumHeight = 9
+	live.BridgedSupplies[tokenContract] = sdk.NewInt(140)
+	live.BridgedSupplies[types.NativeEtherContract] = sdk.NewInt(1)
+	rebuilt.LastObservedSignerSet = &types.SignerSetTx{Nonce: 1}
+
+	require.Equal(t, []types.ReplayDiscrepancy{
+		{Field: "last_observed_event_nonce", Rebuilt: "2", Live: "3"},
+		{Field: "last_observed_ethereum_height", Rebuilt: "11", Live: "9"},
+		{Field: "last_observed_signer_set.nonce", Rebuilt: "1", Live: "none"},
+		{Field: "bridged_supplies." + tokenContract.Hex(), Rebuilt: "150", Live: "140"},
+		{Field: "bridged_supplies." + types.NativeEtherContract.Hex(), Rebuilt: "0", Live: "1"},
+	}, Diff(rebuilt, live))
+}
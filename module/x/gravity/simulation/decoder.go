@@ -0,0 +1,69 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding gravity type. Values without a dedicated decoding
+// are printed as hex.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch kvA.Key[0] {
+		case types.OutgoingTxKey:
+			var anyA, anyB codectypes.Any
+			cdc.MustUnmarshal(kvA.Value, &anyA)
+			cdc.MustUnmarshal(kvB.Value, &anyB)
+			return fmt.Sprintf("%v\n%v", anyA.String(), anyB.String())
+
+		case types.EthereumEventVoteRecordKey, types.EthereumTxHashEventKey:
+			var recordA, recordB types.EthereumEventVoteRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case types.SendToEthereumKey:
+			var sendA, sendB types.SendToEthereum
+			cdc.MustUnmarshal(kvA.Value, &sendA)
+			cdc.MustUnmarshal(kvB.Value, &sendB)
+			return fmt.Sprintf("%v\n%v", sendA, sendB)
+
+		case types.LastEventNonceByValidatorKey,
+			types.LastObservedEventNonceKey,
+			types.LatestSignerSetTxNonceKey,
+			types.LastSlashedOutgoingTxBlockKey,
+			types.LastSlashedSignerSetTxNonceKey,
+			types.LastOutgoingBatchNonceKey,
+			types.LastSendToEthereumIDKey,
+			types.LastUnBondingBlockHeightKey:
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case types.ValidatorEthereumAddressKey, types.DenomToERC20Key:
+			return fmt.Sprintf("%s\n%s", common.BytesToAddress(kvA.Value), common.BytesToAddress(kvB.Value))
+
+		case types.OrchestratorValidatorAddressKey:
+			return fmt.Sprintf("%s\n%s", sdk.ValAddress(kvA.Value), sdk.ValAddress(kvB.Value))
+
+		case types.EthereumOrchestratorAddressKey:
+			return fmt.Sprintf("%s\n%s", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+		case types.ERC20ToDenomKey:
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		default:
+			if bytes.Equal(kvA.Value, kvB.Value) {
+				return fmt.Sprintf("%X", kvA.Value)
+			}
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+		}
+	}
+}
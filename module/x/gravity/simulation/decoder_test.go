@@ -0,0 +1,52 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/simulation"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc, _ := keeper.MakeTestMarshaler()
+	dec := simulation.NewDecodeStore(cdc)
+
+	send := types.SendToEthereum{
+		Id:                1,
+		Sender:            "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
+		EthereumRecipient: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+		Erc20Token:        types.NewERC20Token(100, common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")),
+		Erc20Fee:          types.NewERC20Token(1, common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")),
+	}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: []byte{types.SendToEthereumKey}, Value: cdc.MustMarshal(&send)},
+			{Key: []byte{types.LastObservedEventNonceKey}, Value: sdk.Uint64ToBigEndian(7)},
+			{Key: []byte{types.TokenPauseKey}, Value: []byte{0x01}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"SendToEthereum", fmt.Sprintf("%v\n%v", send, send)},
+		{"LastObservedEventNonce", "7\n7"},
+		{"other", "01"},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// Simulation parameter constants
+const (
+	SignedWindow          = "signed_window"
+	BatchCreationPeriod   = "batch_creation_period"
+	BatchMaxElement       = "batch_max_element"
+	SlashFraction         = "slash_fraction"
+	RelayerRewardFraction = "relayer_reward_fraction"
+	ChainFeeBasisPoints   = "chain_fee_basis_points"
+)
+
+// GenSignedWindow randomized the window shared by the signer set, batch and
+// ethereum signature slashing params
+func GenSignedWindow(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 100, 10_000))
+}
+
+// GenBatchCreationPeriod randomized BatchCreationPeriod
+func GenBatchCreationPeriod(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 50))
+}
+
+// GenBatchMaxElement randomized BatchMaxElement
+func GenBatchMaxElement(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 100))
+}
+
+// GenSlashFraction randomized the slash fractions
+func GenSlashFraction(r *rand.Rand) sdk.Dec {
+	return sdk.NewDec(int64(simtypes.RandIntBetween(r, 1, 100))).Quo(sdk.NewDec(10_000))
+}
+
+// GenRelayerRewardFraction randomized RelayerRewardFraction
+func GenRelayerRewardFraction(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(51)), 2)
+}
+
+// GenChainFeeBasisPoints randomized ChainFeeBasisPoints
+func GenChainFeeBasisPoints(r *rand.Rand) uint64 {
+	return uint64(r.Intn(101))
+}
+
+// GenEthereumAddress returns a random ethereum address
+func GenEthereumAddress(r *rand.Rand) common.Address {
+	bz := make([]byte, common.AddressLength)
+	r.Read(bz)
+	return common.BytesToAddress(bz)
+}
+
+// RandomizedGenState generates a random GenesisState for gravity. The bond
+// denom is registered as a cosmos originated asset so that it can be sent to
+// ethereum right away.
+func RandomizedGenState(simState *module.SimulationState) {
+	var signedWindow uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SignedWindow, &signedWindow, simState.Rand,
+		func(r *rand.Rand) { signedWindow = GenSignedWindow(r) },
+	)
+
+	var batchCreationPeriod uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BatchCreationPeriod, &batchCreationPeriod, simState.Rand,
+		func(r *rand.Rand) { batchCreationPeriod = GenBatchCreationPeriod(r) },
+	)
+
+	var batchMaxElement uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BatchMaxElement, &batchMaxElement, simState.Rand,
+		func(r *rand.Rand) { batchMaxElement = GenBatchMaxElement(r) },
+	)
+
+	var slashFraction sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFraction, &slashFraction, simState.Rand,
+		func(r *rand.Rand) { slashFraction = GenSlashFraction(r) },
+	)
+
+	var relayerRewardFraction sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, RelayerRewardFraction, &relayerRewardFraction, simState.Rand,
+		func(r *rand.Rand) { relayerRewardFraction = GenRelayerRewardFraction(r) },
+	)
+
+	var chainFeeBasisPoints uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ChainFeeBasisPoints, &chainFeeBasisPoints, simState.Rand,
+		func(r *rand.Rand) { chainFeeBasisPoints = GenChainFeeBasisPoints(r) },
+	)
+
+	params := types.DefaultParams()
+	params.SignedSignerSetTxsWindow = signedWindow
+	params.SignedBatchesWindow = signedWindow
+	params.EthereumSignaturesWindow = signedWindow
+	params.UnbondSlashingSignerSetTxsWindow = signedWindow
+	params.BatchCreationPeriod = batchCreationPeriod
+	params.BatchMaxElement = batchMaxElement
+	params.SlashFractionSignerSetTx = slashFraction
+	params.SlashFractionBatch = slashFraction
+	params.SlashFractionEthereumSignature = slashFraction
+	params.SlashFractionConflictingEthereumSignature = slashFraction
+	params.RelayerRewardFraction = relayerRewardFraction
+	params.ChainFeeBasisPoints = chainFeeBasisPoints
+
+	gravityGenesis := types.DefaultGenesisState()
+	gravityGenesis.Params = params
+	gravityGenesis.Erc20ToDenoms = []*types.ERC20ToDenom{{
+		Erc20: GenEthereumAddress(simState.Rand).Hex(),
+		Denom: sdk.DefaultBondDenom,
+	}}
+
+	bz, err := json.MarshalIndent(params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated gravity parameters:\n%s\n", bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(gravityGenesis)
+}
@@ -0,0 +1,411 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSendToEthereum               = "op_weight_msg_send_to_ethereum"
+	OpWeightMsgRequestBatchTx               = "op_weight_msg_request_batch_tx"
+	OpWeightMsgSubmitEthereumTxConfirmation = "op_weight_msg_submit_ethereum_tx_confirmation"
+	OpWeightMsgSubmitEthereumEvent          = "op_weight_msg_submit_ethereum_event"
+	OpWeightMsgDelegateKeys                 = "op_weight_msg_delegate_keys"
+
+	DefaultWeightMsgSendToEthereum               = 100
+	DefaultWeightMsgRequestBatchTx               = 20
+	DefaultWeightMsgSubmitEthereumTxConfirmation = 50
+	DefaultWeightMsgSubmitEthereumEvent          = 50
+	DefaultWeightMsgDelegateKeys                 = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgSendToEthereum int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendToEthereum, &weightMsgSendToEthereum, nil,
+		func(_ *rand.Rand) { weightMsgSendToEthereum = DefaultWeightMsgSendToEthereum },
+	)
+
+	var weightMsgRequestBatchTx int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRequestBatchTx, &weightMsgRequestBatchTx, nil,
+		func(_ *rand.Rand) { weightMsgRequestBatchTx = DefaultWeightMsgRequestBatchTx },
+	)
+
+	var weightMsgSubmitEthereumTxConfirmation int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitEthereumTxConfirmation, &weightMsgSubmitEthereumTxConfirmation, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitEthereumTxConfirmation = DefaultWeightMsgSubmitEthereumTxConfirmation
+		},
+	)
+
+	var weightMsgSubmitEthereumEvent int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitEthereumEvent, &weightMsgSubmitEthereumEvent, nil,
+		func(_ *rand.Rand) { weightMsgSubmitEthereumEvent = DefaultWeightMsgSubmitEthereumEvent },
+	)
+
+	var weightMsgDelegateKeys int
+	appParams.GetOrGenerate(cdc, OpWeightMsgDelegateKeys, &weightMsgDelegateKeys, nil,
+		func(_ *rand.Rand) { weightMsgDelegateKeys = DefaultWeightMsgDelegateKeys },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgSendToEthereum, SimulateMsgSendToEthereum(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgRequestBatchTx, SimulateMsgRequestBatchTx(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSubmitEthereumTxConfirmation, SimulateMsgSubmitEthereumTxConfirmation(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSubmitEthereumEvent, SimulateMsgSubmitEthereumEvent(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgDelegateKeys, SimulateMsgDelegateKeys(ak, bk, k)),
+	}
+}
+
+// SimulateMsgSendToEthereum generates a MsgSendToEthereum of a random coin
+// the account can bridge, paying part of the rest as the bridge fee
+func SimulateMsgSendToEthereum(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSendToEthereum{})
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		var bridgeable sdk.Coins
+		for _, coin := range bk.SpendableCoins(ctx, simAccount.Address) {
+			if _, _, err := k.DenomToERC20Lookup(ctx, coin.Denom); err == nil && coin.Amount.GT(sdk.OneInt()) {
+				bridgeable = append(bridgeable, coin)
+			}
+		}
+		if len(bridgeable) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bridgeable coins"), nil, nil
+		}
+
+		coin := bridgeable[r.Intn(len(bridgeable))]
+		amount, err := simtypes.RandPositiveInt(r, coin.Amount.QuoRaw(2))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate amount"), nil, err
+		}
+		fee := simtypes.RandomAmount(r, coin.Amount.QuoRaw(2))
+
+		msg := types.NewMsgSendToEthereum(
+			simAccount.Address,
+			GenEthereumAddress(r).Hex(),
+			sdk.NewCoin(coin.Denom, amount),
+			sdk.NewCoin(coin.Denom, fee),
+		)
+		if !precheck(ctx, k, msg) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "send to ethereum rejected"), nil, nil
+		}
+
+		return genAndDeliverTx(r, app, ctx, chainID, ak, bk, simAccount, msg, sdk.NewCoins(sdk.NewCoin(coin.Denom, amount.Add(fee))))
+	}
+}
+
+// SimulateMsgRequestBatchTx generates a MsgRequestBatchTx for the token of one
+// of the account's unbatched sends
+func SimulateMsgRequestBatchTx(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRequestBatchTx{})
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		res, err := k.UnbatchedSendToEthereums(sdk.WrapSDKContext(ctx), &types.UnbatchedSendToEthereumsRequest{
+			SenderAddress: simAccount.Address.String(),
+		})
+		if err != nil || len(res.SendToEthereums) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unbatched sends"), nil, nil
+		}
+
+		send := res.SendToEthereums[r.Intn(len(res.SendToEthereums))]
+		denom := send.Erc20Token.GravityCoin().Denom
+		if isCosmosOriginated, cosmosDenom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(send.Erc20Token.Contract)); isCosmosOriginated {
+			denom = cosmosDenom
+		}
+
+		msg := types.NewMsgRequestBatchTx(denom, simAccount.Address)
+		if !precheck(ctx, k, msg) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no suitable batch to create"), nil, nil
+		}
+
+		return genAndDeliverTx(r, app, ctx, chainID, ak, bk, simAccount, msg, nil)
+	}
+}
+
+// SimulateMsgSubmitEthereumTxConfirmation generates a MsgSubmitEthereumTxConfirmation
+// signing an outgoing tx a bonded validator hasn't signed yet with the ethereum
+// key derived from its account key
+func SimulateMsgSubmitEthereumTxConfirmation(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSubmitEthereumTxConfirmation{})
+		simAccount, ok := randomDelegatedValidatorAccount(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded validator with delegate keys"), nil, nil
+		}
+
+		var unsigned []types.OutgoingTx
+		goCtx := sdk.WrapSDKContext(ctx)
+		if res, err := k.UnsignedSignerSetTxs(goCtx, &types.UnsignedSignerSetTxsRequest{Address: simAccount.Address.String()}); err == nil {
+			for _, signerSet := range res.SignerSets {
+				unsigned = append(unsigned, signerSet)
+			}
+		}
+		if res, err := k.UnsignedBatchTxs(goCtx, &types.UnsignedBatchTxsRequest{Address: simAccount.Address.String()}); err == nil {
+			for _, batch := range res.Batches {
+				unsigned = append(unsigned, batch)
+			}
+		}
+		if len(unsigned) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unsigned outgoing txs"), nil, nil
+		}
+
+		privKey, err := ethcrypto.ToECDSA(simAccount.PrivKey.Bytes())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to derive ethereum key"), nil, err
+		}
+		ethSigner := ethcrypto.PubkeyToAddress(privKey.PublicKey)
+
+		otx := unsigned[r.Intn(len(unsigned))]
+		signature, err := types.NewEthereumSignature(otx.GetCheckpoint([]byte(k.GetParams(ctx).GravityId)), privKey)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign outgoing tx"), nil, err
+		}
+
+		var confirmation types.EthereumTxConfirmation
+		switch otx := otx.(type) {
+		case *types.SignerSetTx:
+			confirmation = &types.SignerSetTxConfirmation{
+				SignerSetNonce: otx.Nonce,
+				EthereumSigner: ethSigner.Hex(),
+				Signature:      signature,
+			}
+		case *types.BatchTx:
+			confirmation = &types.BatchTxConfirmation{
+				TokenContract:  otx.TokenContract,
+				BatchNonce:     otx.BatchNonce,
+				EthereumSigner: ethSigner.Hex(),
+				Signature:      signature,
+			}
+		}
+
+		confirmationAny, err := types.PackConfirmation(confirmation)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack confirmation"), nil, err
+		}
+
+		msg := &types.MsgSubmitEthereumTxConfirmation{
+			Confirmation: confirmationAny,
+			Signer:       simAccount.Address.String(),
+		}
+		if !precheck(ctx, k, msg) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "confirmation rejected"), nil, nil
+		}
+
+		return genAndDeliverTx(r, app, ctx, chainID, ak, bk, simAccount, msg, nil)
+	}
+}
+
+// SimulateMsgSubmitEthereumEvent generates a MsgSubmitEthereumEvent voting for
+// the next event nonce of a bonded validator. The event is derived from its
+// nonce only, so that every validator votes for the same event and it gets
+// observed once enough of them did.
+func SimulateMsgSubmitEthereumEvent(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSubmitEthereumEvent{})
+		simAccount, ok := randomDelegatedValidatorAccount(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded validator with delegate keys"), nil, nil
+		}
+
+		res, err := k.LastSubmittedEthereumEvent(sdk.WrapSDKContext(ctx), &types.LastSubmittedEthereumEventRequest{
+			Address: simAccount.Address.String(),
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get last submitted event"), nil, nil
+		}
+
+		eventNonce := res.EventNonce + 1
+		eventRand := rand.New(rand.NewSource(int64(eventNonce)))
+		receiver, _ := simtypes.RandomAcc(eventRand, accs)
+		event := &types.SendToCosmosEvent{
+			EventNonce:     eventNonce,
+			TokenContract:  GenEthereumAddress(eventRand).Hex(),
+			Amount:         sdk.NewInt(int64(simtypes.RandIntBetween(eventRand, 1, 1_000_000))),
+			EthereumSender: GenEthereumAddress(eventRand).Hex(),
+			CosmosReceiver: receiver.Address.String(),
+			EthereumHeight: eventNonce,
+		}
+
+		eventAny, err := types.PackEvent(event)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack event"), nil, err
+		}
+
+		msg := &types.MsgSubmitEthereumEvent{
+			Event:  eventAny,
+			Signer: simAccount.Address.String(),
+		}
+		if !precheck(ctx, k, msg) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "event rejected"), nil, nil
+		}
+
+		return genAndDeliverTx(r, app, ctx, chainID, ak, bk, simAccount, msg, nil)
+	}
+}
+
+// SimulateMsgDelegateKeys generates a MsgDelegateKeys for a validator without
+// delegate keys, using its own account as orchestrator and the ethereum key
+// derived from its account key
+func SimulateMsgDelegateKeys(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgDelegateKeys{})
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		valAddr := sdk.ValAddress(simAccount.Address)
+
+		if k.StakingKeeper.Validator(ctx, valAddr) == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account is not a validator"), nil, nil
+		}
+		if k.GetValidatorEthereumAddress(ctx, valAddr) != (common.Address{}) ||
+			k.GetOrchestratorValidatorAddress(ctx, simAccount.Address) != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "delegate keys already set"), nil, nil
+		}
+
+		privKey, err := ethcrypto.ToECDSA(simAccount.PrivKey.Bytes())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to derive ethereum key"), nil, err
+		}
+		ethAddr := ethcrypto.PubkeyToAddress(privKey.PublicKey)
+		if k.GetEthereumOrchestratorAddress(ctx, ethAddr) != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "ethereum address in use"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		if account == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account not found"), nil, nil
+		}
+
+		// the signature is checked after the ante handler increments the
+		// sequence, against the sequence the tx is signed with
+		signMsg := types.DelegateKeysSignMsg{
+			ValidatorAddress: valAddr.String(),
+			Nonce:            account.GetSequence(),
+		}
+		signMsgBz, err := signMsg.Marshal()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to marshal sign msg"), nil, err
+		}
+		ethSig, err := types.NewEthereumSignature(ethcrypto.Keccak256Hash(signMsgBz).Bytes(), privKey)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign delegate keys"), nil, err
+		}
+
+		msg := types.NewMsgDelegateKeys(valAddr, simAccount.Address, ethAddr.Hex(), ethSig)
+
+		return genAndDeliverTx(r, app, ctx, chainID, ak, bk, simAccount, msg, nil)
+	}
+}
+
+// randomDelegatedValidatorAccount returns the account of a random bonded
+// validator which set its delegate keys through SimulateMsgDelegateKeys
+func randomDelegatedValidatorAccount(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, bool) {
+	validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	if len(validators) == 0 {
+		return simtypes.Account{}, false
+	}
+
+	validator := validators[r.Intn(len(validators))]
+	simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(validator.GetOperator()))
+	if !found {
+		return simtypes.Account{}, false
+	}
+
+	privKey, err := ethcrypto.ToECDSA(simAccount.PrivKey.Bytes())
+	if err != nil || k.GetValidatorEthereumAddress(ctx, validator.GetOperator()) != ethcrypto.PubkeyToAddress(privKey.PublicKey) {
+		return simtypes.Account{}, false
+	}
+
+	return simAccount, true
+}
+
+// precheck runs the msg against a cached context, so that msgs the keeper
+// would reject are skipped instead of failing the simulation
+func precheck(ctx sdk.Context, k keeper.Keeper, msg sdk.Msg) bool {
+	cacheCtx, _ := ctx.CacheContext()
+	handler := keeper.NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(cacheCtx)
+
+	var err error
+	switch msg := msg.(type) {
+	case *types.MsgSendToEthereum:
+		_, err = handler.SendToEthereum(goCtx, msg)
+	case *types.MsgRequestBatchTx:
+		_, err = handler.RequestBatchTx(goCtx, msg)
+	case *types.MsgSubmitEthereumTxConfirmation:
+		_, err = handler.SubmitEthereumTxConfirmation(goCtx, msg)
+	case *types.MsgSubmitEthereumEvent:
+		_, err = handler.SubmitEthereumEvent(goCtx, msg)
+	}
+
+	return err == nil
+}
+
+// genAndDeliverTx signs msg with random fees and delivers it. The gravity msgs
+// don't support legacy amino signing, so unlike simulation.GenAndDeliverTx the
+// operation msg is built without the msg sign bytes.
+func genAndDeliverTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, chainID string,
+	ak types.AccountKeeper, bk types.BankKeeper, simAccount simtypes.Account, msg sdk.Msg, coinsSpentInMsg sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msgType := sdk.MsgTypeURL(msg)
+	account := ak.GetAccount(ctx, simAccount.Address)
+	spendable := bk.SpendableCoins(ctx, simAccount.Address)
+
+	coins, hasNeg := spendable.SafeSub(coinsSpentInMsg...)
+	if hasNeg {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "message doesn't leave room for fees"), nil, nil
+	}
+
+	fees, err := simtypes.RandomFees(r, ctx, coins)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate fees"), nil, err
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenSignedMockTx(
+		r,
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate mock tx"), nil, err
+	}
+
+	if _, _, err = app.SimDeliver(txGen.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsgBasic(types.ModuleName, msgType, "", true, nil), nil, nil
+}
@@ -0,0 +1,38 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/peggyjv/gravity-bridge/module/v2/x/gravity/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change
+// proposals on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreBatchCreationPeriod),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenBatchCreationPeriod(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreBatchMaxElement),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenBatchMaxElement(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreRelayerRewardFraction),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenRelayerRewardFraction(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreChainFeeBasisPoints),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenChainFeeBasisPoints(r))
+			},
+		),
+	}
+}
@@ -74,6 +74,28 @@ const (
       ]
     }]`
 
+	// OutgoingMultiLogicCallABIJSON checks the ETH ABI for compatability of the multi logic call message
+	OutgoingMultiLogicCallABIJSON = `[{
+	  "name": "checkpoint",
+      "outputs": [],
+      "stateMutability": "pure",
+      "type": "function",
+      "inputs": [
+			{ "internalType": "bytes32",   "name": "_gravityId",               "type": "bytes32"   },
+			{ "internalType": "bytes32",   "name": "_methodName",              "type": "bytes32"   },
+			{ "internalType": "uint256[]", "name": "_transferAmounts",         "type": "uint256[]" },
+			{ "internalType": "address[]", "name": "_transferTokenContracts",  "type": "address[]" },
+			{ "internalType": "uint256[]", "name": "_feeAmounts",              "type": "uint256[]" },
+			{ "internalType": "address[]", "name": "_feeTokenContracts",       "type": "address[]" },
+			{ "internalType": "address[]", "name": "_logicContractAddresses",  "type": "address[]" },
+			{ "internalType": "bytes[]",   "name": "_payloads",                "type": "bytes[]"   },
+			{ "internalType": "uint256[]", "name": "_values",                  "type": "uint256[]" },
+			{ "internalType": "uint256",   "name": "_timeout",                 "type": "uint256"   },
+			{ "internalType": "bytes32",   "name": "_invalidationId",          "type": "bytes32"   },
+			{ "internalType": "uint256",   "name": "_invalidationNonce",       "type": "uint256"   }
+      ]
+    }]`
+
 	DeployERC20ABIJSON = `[{
     "inputs": [
       {
@@ -126,4 +148,89 @@ const (
     "stateMutability": "nonpayable",
     "type": "function"
   	}]`
+
+	// GravitySubmitABIJSON is the ABI of the gravity contract functions that
+	// submit signed outgoing txs, used to encode their calldata for relayers
+	GravitySubmitABIJSON = `[{
+		"name": "updateValset",
+		"stateMutability": "nonpayable",
+		"type": "function",
+		"inputs": [
+			{ "internalType": "struct ValsetArgs", "name": "_newValset", "type": "tuple", "components": [
+				{ "internalType": "address[]", "name": "validators",   "type": "address[]" },
+				{ "internalType": "uint256[]", "name": "powers",       "type": "uint256[]" },
+				{ "internalType": "uint256",   "name": "valsetNonce",  "type": "uint256"   },
+				{ "internalType": "uint256",   "name": "rewardAmount", "type": "uint256"   },
+				{ "internalType": "address",   "name": "rewardToken",  "type": "address"   }
+			] },
+			{ "internalType": "struct ValsetArgs", "name": "_currentValset", "type": "tuple", "components": [
+				{ "internalType": "address[]", "name": "validators",   "type": "address[]" },
+				{ "internalType": "uint256[]", "name": "powers",       "type": "uint256[]" },
+				{ "internalType": "uint256",   "name": "valsetNonce",  "type": "uint256"   },
+				{ "internalType": "uint256",   "name": "rewardAmount", "type": "uint256"   },
+				{ "internalType": "address",   "name": "rewardToken",  "type": "address"   }
+			] },
+			{ "internalType": "struct ValSignature[]", "name": "_sigs", "type": "tuple[]", "components": [
+				{ "internalType": "uint8",   "name": "v", "type": "uint8"   },
+				{ "internalType": "bytes32", "name": "r", "type": "bytes32" },
+				{ "internalType": "bytes32", "name": "s", "type": "bytes32" }
+			] }
+		],
+		"outputs": []
+	}, {
+		"name": "submitBatch",
+		"stateMutability": "nonpayable",
+		"type": "function",
+		"inputs": [
+			{ "internalType": "struct ValsetArgs", "name": "_currentValset", "type": "tuple", "components": [
+				{ "internalType": "address[]", "name": "validators",   "type": "address[]" },
+				{ "internalType": "uint256[]", "name": "powers",       "type": "uint256[]" },
+				{ "internalType": "uint256",   "name": "valsetNonce",  "type": "uint256"   },
+				{ "internalType": "uint256",   "name": "rewardAmount", "type": "uint256"   },
+				{ "internalType": "address",   "name": "rewardToken",  "type": "address"   }
+			] },
+			{ "internalType": "struct ValSignature[]", "name": "_sigs", "type": "tuple[]", "components": [
+				{ "internalType": "uint8",   "name": "v", "type": "uint8"   },
+				{ "internalType": "bytes32", "name": "r", "type": "bytes32" },
+				{ "internalType": "bytes32", "name": "s", "type": "bytes32" }
+			] },
+			{ "internalType": "uint256[]", "name": "_amounts",       "type": "uint256[]" },
+			{ "internalType": "address[]", "name": "_destinations",  "type": "address[]" },
+			{ "internalType": "uint256[]", "name": "_fees",          "type": "uint256[]" },
+			{ "internalType": "uint256",   "name": "_batchNonce",    "type": "uint256"   },
+			{ "internalType": "address",   "name": "_tokenContract", "type": "address"   },
+			{ "internalType": "uint256",   "name": "_batchTimeout",  "type": "uint256"   }
+		],
+		"outputs": []
+	}, {
+		"name": "submitLogicCall",
+		"stateMutability": "nonpayable",
+		"type": "function",
+		"inputs": [
+			{ "internalType": "struct ValsetArgs", "name": "_currentValset", "type": "tuple", "components": [
+				{ "internalType": "address[]", "name": "validators",   "type": "address[]" },
+				{ "internalType": "uint256[]", "name": "powers",       "type": "uint256[]" },
+				{ "internalType": "uint256",   "name": "valsetNonce",  "type": "uint256"   },
+				{ "internalType": "uint256",   "name": "rewardAmount", "type": "uint256"   },
+				{ "internalType": "address",   "name": "rewardToken",  "type": "address"   }
+			] },
+			{ "internalType": "struct ValSignature[]", "name": "_sigs", "type": "tuple[]", "components": [
+				{ "internalType": "uint8",   "name": "v", "type": "uint8"   },
+				{ "internalType": "bytes32", "name": "r", "type": "bytes32" },
+				{ "internalType": "bytes32", "name": "s", "type": "bytes32" }
+			] },
+			{ "internalType": "struct LogicCallArgs", "name": "_args", "type": "tuple", "components": [
+				{ "internalType": "uint256[]", "name": "transferAmounts",        "type": "uint256[]" },
+				{ "internalType": "address[]", "name": "transferTokenContracts", "type": "address[]" },
+				{ "internalType": "uint256[]", "name": "feeAmounts",             "type": "uint256[]" },
+				{ "internalType": "address[]", "name": "feeTokenContracts",      "type": "address[]" },
+				{ "internalType": "address",   "name": "logicContractAddress",   "type": "address"   },
+				{ "internalType": "bytes",     "name": "payload",                "type": "bytes"     },
+				{ "internalType": "uint256",   "name": "timeOut",                "type": "uint256"   },
+				{ "internalType": "bytes32",   "name": "invalidationId",         "type": "bytes32"   },
+				{ "internalType": "uint256",   "name": "invalidationNonce",      "type": "uint256"   }
+			] }
+		],
+		"outputs": []
+	}]`
 )
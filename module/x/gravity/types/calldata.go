@@ -0,0 +1,174 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// The structs below mirror the tuples the gravity contract submit functions
+// take, the go-ethereum ABI encoder matches their fields to the tuple
+// components by name.
+
+type valsetArgs struct {
+	Validators   []gethcommon.Address
+	Powers       []*big.Int
+	ValsetNonce  *big.Int
+	RewardAmount *big.Int
+	RewardToken  gethcommon.Address
+}
+
+type valSignature struct {
+	V uint8
+	R [32]byte
+	S [32]byte
+}
+
+type logicCallArgs struct {
+	TransferAmounts        []*big.Int
+	TransferTokenContracts []gethcommon.Address
+	FeeAmounts             []*big.Int
+	FeeTokenContracts      []gethcommon.Address
+	LogicContractAddress   gethcommon.Address
+	Payload                []byte
+	TimeOut                *big.Int
+	InvalidationId         [32]byte
+	InvalidationNonce      *big.Int
+}
+
+// newValsetArgs returns the signer set as the gravity contract checkpoints
+// it, with the signers sorted as in GetCheckpoint
+func newValsetArgs(u SignerSetTx) valsetArgs {
+	u.Signers.Sort()
+
+	args := valsetArgs{
+		Validators:   make([]gethcommon.Address, len(u.Signers)),
+		Powers:       make([]*big.Int, len(u.Signers)),
+		ValsetNonce:  big.NewInt(int64(u.Nonce)),
+		RewardAmount: big.NewInt(0),
+	}
+	for i, s := range u.Signers {
+		args.Validators[i] = gethcommon.HexToAddress(s.EthereumAddress)
+		args.Powers[i] = big.NewInt(int64(s.Power))
+	}
+	return args
+}
+
+// newValSignatures splits the signatures into the v, r and s the gravity
+// contract takes. A nil signature is left zero, which the contract skips.
+func newValSignatures(signatures [][]byte) ([]valSignature, error) {
+	out := make([]valSignature, len(signatures))
+	for i, sig := range signatures {
+		if sig == nil {
+			continue
+		}
+		if len(sig) != crypto.SignatureLength {
+			return nil, sdkerrors.Wrapf(ErrInvalidEthereumSignature, "signature must be %d bytes, got %x", crypto.SignatureLength, sig)
+		}
+		copy(out[i].R[:], sig[:32])
+		copy(out[i].S[:], sig[32:64])
+		// signatures are stored with the recovery id, the contract takes it
+		// offset by 27 as ecrecover does
+		out[i].V = sig[64]
+		if out[i].V < 27 {
+			out[i].V += 27
+		}
+	}
+	return out, nil
+}
+
+// GetSubmitCalldata returns the calldata of the updateValset call moving the
+// gravity contract from the current signer set to this one. The signatures
+// are those of the current signer set over the checkpoint of this one, in
+// the order of the sorted signers of the current set.
+func (u SignerSetTx) GetSubmitCalldata(current SignerSetTx, signatures [][]byte) ([]byte, error) {
+	sigs, err := newValSignatures(signatures)
+	if err != nil {
+		return nil, err
+	}
+	return packSubmitCall("updateValset", newValsetArgs(u), newValsetArgs(current), sigs)
+}
+
+// GetSubmitCalldata returns the calldata of the submitBatch call executing
+// the batch, signed by the current signer set as for the updateValset call
+func (b BatchTx) GetSubmitCalldata(current SignerSetTx, signatures [][]byte) ([]byte, error) {
+	sigs, err := newValSignatures(signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	txAmounts := make([]*big.Int, len(b.Transactions))
+	txDestinations := make([]gethcommon.Address, len(b.Transactions))
+	txFees := make([]*big.Int, len(b.Transactions))
+	for i, tx := range b.Transactions {
+		txAmounts[i] = tx.Erc20Token.Amount.BigInt()
+		txDestinations[i] = gethcommon.HexToAddress(tx.EthereumRecipient)
+		txFees[i] = tx.Erc20Fee.Amount.BigInt()
+	}
+
+	return packSubmitCall(
+		"submitBatch",
+		newValsetArgs(current),
+		sigs,
+		txAmounts,
+		txDestinations,
+		txFees,
+		big.NewInt(int64(b.BatchNonce)),
+		gethcommon.HexToAddress(b.TokenContract),
+		big.NewInt(int64(b.Timeout)),
+	)
+}
+
+// GetSubmitCalldata returns the calldata of the submitLogicCall call
+// executing the contract call, signed by the current signer set as for the
+// updateValset call. The gravity contract has no function taking multi calls.
+func (c ContractCallTx) GetSubmitCalldata(current SignerSetTx, signatures [][]byte) ([]byte, error) {
+	if len(c.Calls) > 0 {
+		return nil, sdkerrors.Wrap(ErrInvalid, "the gravity contract can't submit multi calls")
+	}
+
+	sigs, err := newValSignatures(signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	args := logicCallArgs{
+		TransferAmounts:        make([]*big.Int, len(c.Tokens)),
+		TransferTokenContracts: make([]gethcommon.Address, len(c.Tokens)),
+		FeeAmounts:             make([]*big.Int, len(c.Fees)),
+		FeeTokenContracts:      make([]gethcommon.Address, len(c.Fees)),
+		LogicContractAddress:   gethcommon.HexToAddress(c.Address),
+		Payload:                append([]byte{}, c.Payload...),
+		TimeOut:                big.NewInt(int64(c.Timeout)),
+		InvalidationNonce:      big.NewInt(int64(c.InvalidationNonce)),
+	}
+	for i, coin := range c.Tokens {
+		args.TransferAmounts[i] = coin.Amount.BigInt()
+		args.TransferTokenContracts[i] = gethcommon.HexToAddress(coin.Contract)
+	}
+	for i, coin := range c.Fees {
+		args.FeeAmounts[i] = coin.Amount.BigInt()
+		args.FeeTokenContracts[i] = gethcommon.HexToAddress(coin.Contract)
+	}
+	copy(args.InvalidationId[:], c.InvalidationScope)
+
+	return packSubmitCall("submitLogicCall", newValsetArgs(current), sigs, args)
+}
+
+// packSubmitCall encodes a call of a gravity contract submit function, the
+// method selector included, unlike packCall
+func packSubmitCall(method string, args ...interface{}) ([]byte, error) {
+	contractAbi, err := abi.JSON(strings.NewReader(GravitySubmitABIJSON))
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "bad ABI definition in code"))
+	}
+	calldata, err := contractAbi.Pack(method, args...)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "packing %s: %s", method, err)
+	}
+	return calldata, nil
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ChainFeeBasisPointsMax is the chain fee taking the whole amount of a send
+const ChainFeeBasisPointsMax = 10_000
+
+// Destinations of the chain fees of executed batches
+const (
+	ChainFeeDestinationCommunityPool = "community_pool"
+	ChainFeeDestinationBurn          = "burn"
+	ChainFeeDestinationStakers       = "stakers"
+)
+
+// ChainFeeAmount returns the share of an amount in basis points kept as a
+// chain fee, rounded down
+func ChainFeeAmount(amount sdk.Int, basisPoints uint64) sdk.Int {
+	return amount.Mul(sdk.NewIntFromUint64(basisPoints)).QuoRaw(ChainFeeBasisPointsMax)
+}
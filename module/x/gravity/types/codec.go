@@ -46,12 +46,27 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSubmitEthereumTxConfirmation{},
 		&MsgDelegateKeys{},
 		&MsgEthereumHeightVote{},
+		&MsgUpdateDelegateKeys{},
+		&MsgRevokeOrchestratorKey{},
+		&MsgSubmitAggregatedEthereumEvent{},
+		&MsgERC20DeployedConfirm{},
+		&MsgEthereumAnomalyReport{},
+		&MsgRegisterOrchestratorQueryIdentity{},
+		&MsgExecuteAtomic{},
+		&MsgVetoDelayedSendToEthereum{},
+		&MsgGrantBridgeFeeAllowance{},
+		&MsgRevokeBridgeFeeAllowance{},
+		&MsgSubmitBadEthereumSignatureEvidence{},
+		&MsgOptOutOfBridge{},
+		&MsgEthereumHeaderVote{},
+		&MsgFreezeOrchestrator{},
 	)
 
 	registry.RegisterInterface(
 		"gravity.v1.EthereumEvent",
 		(*EthereumEvent)(nil),
 		&SendToCosmosEvent{},
+		&SendEtherToCosmosEvent{},
 		&BatchExecutedEvent{},
 		&ERC20DeployedEvent{},
 		&ContractCallExecutedEvent{},
@@ -76,6 +91,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*govtypes.Content)(nil),
 		&CommunityPoolEthereumSpendProposal{},
+		&EthereumBlocklistProposal{},
+		&BridgeReenableProposal{},
+		&DelayedSendToEthereumVetoProposal{},
+		&HeldSendToCosmosReleaseProposal{},
+		&EmergencySignerSetUpdateProposal{},
+		&SendToEthereumPriorityProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
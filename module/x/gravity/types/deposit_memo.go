@@ -0,0 +1,41 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DepositMemoHandler processes deposits whose memo is routed to it, e.g. to
+// swap or stake the deposit on behalf of its receiver
+type DepositMemoHandler interface {
+	// HandleDepositMemo is called once the coins of the deposit are credited to
+	// the receiver, with the value of the handler route in the memo. If it
+	// returns an error its state changes are discarded and the coins stay with
+	// the receiver.
+	HandleDepositMemo(ctx sdk.Context, event SendToCosmosEvent, receiver sdk.AccAddress, coins sdk.Coins, payload json.RawMessage) error
+}
+
+// ParseDepositMemo returns the handler route and payload of a deposit memo,
+// which is a JSON object with the route as its only key. It returns false for
+// memos of any other form.
+func ParseDepositMemo(memo []byte) (string, json.RawMessage, bool) {
+	var routes map[string]json.RawMessage
+	if err := json.Unmarshal(memo, &routes); err != nil || len(routes) != 1 {
+		return "", nil, false
+	}
+	for route, payload := range routes {
+		return route, payload, true
+	}
+	return "", nil, false
+}
+
+// DepositMemoRouteStake is the route of the deposit memos delegating the
+// deposit to a validator
+const DepositMemoRouteStake = "stake"
+
+// StakeDepositMemo is the payload of a deposit memo routed to
+// DepositMemoRouteStake
+type StakeDepositMemo struct {
+	Validator string `json:"validator"`
+}
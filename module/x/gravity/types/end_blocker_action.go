@@ -0,0 +1,17 @@
+package types
+
+// EndBlockerActionHistorySize is the number of end blocker actions kept in
+// state, older ones are overwritten
+const EndBlockerActionHistorySize = 256
+
+// End blocker actions recorded for postmortems
+const (
+	EndBlockerActionSignerSetCreated         = "signer_set_created"
+	EndBlockerActionSignerSetPruned          = "signer_set_pruned"
+	EndBlockerActionBatchCreated             = "batch_created"
+	EndBlockerActionBatchTimedOut            = "batch_timed_out"
+	EndBlockerActionContractCallTimedOut     = "contract_call_timed_out"
+	EndBlockerActionValidatorSlashed         = "validator_slashed"
+	EndBlockerActionBridgeHalted             = "bridge_halted"
+	EndBlockerActionEthereumSignaturesPruned = "ethereum_signatures_pruned"
+)
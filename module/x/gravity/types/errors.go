@@ -1,7 +1,9 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
 )
 
 var (
@@ -14,4 +16,41 @@ var (
 	ErrInvalidEthereumProposalAmount    = sdkerrors.Register(ModuleName, 9, "invalid community pool Ethereum spend proposal amount")
 	ErrInvalidEthereumProposalBridgeFee = sdkerrors.Register(ModuleName, 10, "invalid community pool Ethereum spend proposal bridge fee")
 	ErrEthereumProposalDenomMismatch    = sdkerrors.Register(ModuleName, 11, "community pool Ethereum spend proposal amount and bridge fee denom mismatch")
+	ErrMirrorMode                       = sdkerrors.Register(ModuleName, 12, "bridge is running in read-only mirror mode")
+	ErrEthereumAddressBlocklisted       = sdkerrors.Register(ModuleName, 13, "ethereum address is blocklisted")
+	ErrInvalidEthereumBlocklistProposal = sdkerrors.Register(ModuleName, 14, "invalid ethereum blocklist proposal")
+	ErrInvalidIBCForward                = sdkerrors.Register(ModuleName, 15, "invalid IBC forward")
+	ErrBridgeProposalSupport            = sdkerrors.Register(ModuleName, 16, "bridge proposal did not reach the elevated quorum or threshold")
+	ErrTokenPaused                      = sdkerrors.Register(ModuleName, 17, "sends of the token to ethereum are paused")
+
+	// The errors below carry a gRPC status code along with their ABCI code, so
+	// that clients and orchestrators can branch on the cause of a failure.
+	ErrBridgeInactive             = errorsmod.RegisterWithGRPCCode(ModuleName, 18, codes.FailedPrecondition, "the bridge is disabled")
+	ErrUnknownToken               = errorsmod.RegisterWithGRPCCode(ModuleName, 19, codes.NotFound, "denom is neither a gravity voucher nor a cosmos originated asset with an ERC20")
+	ErrInsufficientFee            = errorsmod.RegisterWithGRPCCode(ModuleName, 20, codes.FailedPrecondition, "fees do not exceed those of the batch already waiting for the token")
+	ErrNoSuitableBatch            = errorsmod.RegisterWithGRPCCode(ModuleName, 21, codes.FailedPrecondition, "no suitable batch to create")
+	ErrBatchNotFound              = errorsmod.RegisterWithGRPCCode(ModuleName, 22, codes.NotFound, "batch not found")
+	ErrOutgoingTxNotFound         = errorsmod.RegisterWithGRPCCode(ModuleName, 23, codes.NotFound, "outgoing tx not found")
+	ErrSendToEthereumNotFound     = errorsmod.RegisterWithGRPCCode(ModuleName, 24, codes.NotFound, "send to ethereum not found")
+	ErrNotOrchestratorOrValidator = errorsmod.RegisterWithGRPCCode(ModuleName, 25, codes.PermissionDenied, "signer is not an orchestrator or validator")
+	ErrValidatorNotBonded         = errorsmod.RegisterWithGRPCCode(ModuleName, 26, codes.FailedPrecondition, "validator is not bonded")
+	ErrEthereumSignerMismatch     = errorsmod.RegisterWithGRPCCode(ModuleName, 27, codes.PermissionDenied, "ethereum signer does not match the validator's ethereum address")
+	ErrInvalidEthereumSignature   = errorsmod.RegisterWithGRPCCode(ModuleName, 28, codes.InvalidArgument, "invalid ethereum signature")
+	ErrDuplicateSignature         = errorsmod.RegisterWithGRPCCode(ModuleName, 29, codes.AlreadyExists, "duplicate ethereum signature")
+	ErrNonContiguousEventNonce    = errorsmod.RegisterWithGRPCCode(ModuleName, 30, codes.FailedPrecondition, "non contiguous event nonce")
+	ErrNotSender                  = errorsmod.RegisterWithGRPCCode(ModuleName, 31, codes.PermissionDenied, "signer is not the sender")
+	ErrTokenNotAllowlisted        = errorsmod.RegisterWithGRPCCode(ModuleName, 32, codes.PermissionDenied, "token is not on the bridge allowlist")
+	ErrUnsupportedSignatureScheme = errorsmod.RegisterWithGRPCCode(ModuleName, 33, codes.Unimplemented, "signature scheme is not supported")
+	ErrBridgeFeeAllowanceNotFound = errorsmod.RegisterWithGRPCCode(ModuleName, 34, codes.NotFound, "bridge fee allowance not found")
+	ErrBridgeFeeAllowanceExceeded = errorsmod.RegisterWithGRPCCode(ModuleName, 35, codes.FailedPrecondition, "fee exceeds the bridge fee allowance")
+	ErrNotBadEthereumSignature    = errorsmod.RegisterWithGRPCCode(ModuleName, 36, codes.InvalidArgument, "ethereum signature is not evidence of a bad signature")
+	ErrTooManyPendingSends        = errorsmod.RegisterWithGRPCCode(ModuleName, 37, codes.ResourceExhausted, "too many pending sends to ethereum")
+	ErrValidatorOptedOut          = errorsmod.RegisterWithGRPCCode(ModuleName, 38, codes.FailedPrecondition, "validator opted out of the bridge")
+	ErrDuplicateEthereumEvent     = errorsmod.RegisterWithGRPCCode(ModuleName, 39, codes.AlreadyExists, "ethereum event already observed")
+	ErrInvalidParams              = errorsmod.RegisterWithGRPCCode(ModuleName, 40, codes.InvalidArgument, "invalid gravity params")
+	ErrInsufficientSignatures     = errorsmod.RegisterWithGRPCCode(ModuleName, 41, codes.FailedPrecondition, "outgoing tx lacks the ethereum signatures of enough power")
+	ErrFeeOnTransferToken         = errorsmod.RegisterWithGRPCCode(ModuleName, 42, codes.FailedPrecondition, "fee on transfer tokens can't be sent to ethereum")
+	ErrInvalidEthereumHeader      = errorsmod.RegisterWithGRPCCode(ModuleName, 43, codes.InvalidArgument, "invalid ethereum header")
+	ErrEventBeyondAgreedHeader    = errorsmod.RegisterWithGRPCCode(ModuleName, 44, codes.FailedPrecondition, "ethereum event block is not an ancestor of the agreed ethereum header")
+	ErrOrchestratorFrozen         = errorsmod.RegisterWithGRPCCode(ModuleName, 45, codes.FailedPrecondition, "delegate keys of the validator are frozen")
 )
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
+	"math/big"
 	"strings"
 )
 
@@ -22,8 +23,17 @@ const (
 
 	// GravityDenomLen is the length of the denoms generated by the gravity module
 	GravityDenomLen = len(GravityDenomPrefix) + len(GravityDenomSeparator) + EthereumContractAddressLen
+
+	// MaxERC20ConversionDecimals is the largest difference between the decimals of an ERC20 and the exponent
+	// of its denom, which keeps the conversion factor well within the range of a uint256
+	MaxERC20ConversionDecimals = 36
 )
 
+// NativeEtherContract is the pseudo contract address standing for native ether,
+// which has no ERC20 contract. Batches of it are unwrapped to ether by the
+// gravity contract.
+var NativeEtherContract = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+
 // EthAddress Regular EthAddress
 type EthAddress struct {
 	address common.Address
@@ -157,6 +167,78 @@ func GravityDenomToERC20(denom string) (string, error) {
 	}
 }
 
+/////////////////////////
+//   ERC20Conversion   //
+/////////////////////////
+
+// NewERC20Conversion returns a new instance of an ERC20Conversion
+func NewERC20Conversion(contract common.Address, erc20Decimals, cosmosExponent uint32) ERC20Conversion {
+	return ERC20Conversion{
+		TokenContract:  contract.Hex(),
+		Erc20Decimals:  erc20Decimals,
+		CosmosExponent: cosmosExponent,
+	}
+}
+
+// ValidateBasic performs stateless checks
+func (c ERC20Conversion) ValidateBasic() error {
+	if err := ValidateEthAddress(c.TokenContract); err != nil {
+		return sdkerrors.Wrap(err, "token contract")
+	}
+	if c.Erc20Decimals < c.CosmosExponent {
+		return sdkerrors.Wrapf(ErrInvalid, "erc20 decimals %d below cosmos exponent %d", c.Erc20Decimals, c.CosmosExponent)
+	}
+	if c.Erc20Decimals-c.CosmosExponent > MaxERC20ConversionDecimals {
+		return sdkerrors.Wrapf(ErrInvalid, "erc20 decimals %d exceed cosmos exponent %d by more than %d", c.Erc20Decimals, c.CosmosExponent, MaxERC20ConversionDecimals)
+	}
+	return nil
+}
+
+// Factor returns the number of ERC20 base units one base unit of the denom is worth
+func (c ERC20Conversion) Factor() sdk.Int {
+	return sdk.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(c.Erc20Decimals-c.CosmosExponent)), nil))
+}
+
+// ToCosmos converts an ERC20 amount to the denom, truncating the units below the precision of the denom
+func (c ERC20Conversion) ToCosmos(amount sdk.Int) sdk.Int {
+	return amount.Quo(c.Factor())
+}
+
+// ToERC20 converts an amount of the denom to the ERC20, failing if the result does not fit in a uint256
+func (c ERC20Conversion) ToERC20(amount sdk.Int) (sdk.Int, error) {
+	scaled := new(big.Int).Mul(amount.BigInt(), c.Factor().BigInt())
+	if scaled.BitLen() > 256 {
+		return sdk.Int{}, sdkerrors.Wrapf(ErrInvalid, "%s scaled to %s overflows a uint256", amount, c.TokenContract)
+	}
+	return sdk.NewIntFromBigInt(scaled), nil
+}
+
+/////////////////////////
+//   EthereumAnomaly   //
+/////////////////////////
+
+// ValidateBasic checks the anomaly is a known value
+func (a EthereumAnomaly) ValidateBasic() error {
+	if _, ok := EthereumAnomaly_name[int32(a)]; !ok || a == ETHEREUM_ANOMALY_UNSPECIFIED {
+		return sdkerrors.Wrapf(ErrInvalid, "ethereum anomaly %d", a)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless checks
+func (p TokenPause) ValidateBasic() error {
+	if err := ValidateEthAddress(p.TokenContract); err != nil {
+		return sdkerrors.Wrap(err, "token contract")
+	}
+	if err := p.Anomaly.ValidateBasic(); err != nil {
+		return err
+	}
+	if p.Anomaly == ETHEREUM_ANOMALY_RESOLVED {
+		return sdkerrors.Wrap(ErrInvalid, "a resolved anomaly does not pause a token")
+	}
+	return nil
+}
+
 func NormalizeCoinDenom(coin *sdk.Coin) {
 	coin.Denom = NormalizeDenom(coin.Denom)
 }
@@ -4,16 +4,20 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
 var (
 	_ EthereumEvent = &SendToCosmosEvent{}
+	_ EthereumEvent = &SendEtherToCosmosEvent{}
 	_ EthereumEvent = &BatchExecutedEvent{}
 	_ EthereumEvent = &ContractCallExecutedEvent{}
 	_ EthereumEvent = &ERC20DeployedEvent{}
@@ -26,6 +30,63 @@ func (m *EthereumEventVoteRecord) UnpackInterfaces(unpacker types.AnyUnpacker) e
 	return unpacker.UnpackAny(m.Event, &event)
 }
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *ObservedEthereumEvent) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var event EthereumEvent
+	return unpacker.UnpackAny(m.Event, &event)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *PendingEventVoteRecordsResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, record := range m.EventVoteRecords {
+		if err := record.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *EventByEthereumTxHashResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, record := range m.EventVoteRecords {
+		if err := record.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *ConflictingEthereumEvents) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, record := range m.EventVoteRecords {
+		if err := record.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *ConflictingEthereumEventsResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for i := range m.Conflicts {
+		if err := m.Conflicts[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EthereumEventCosignatureHash returns the hash a validator signs with its
+// ethereum key to co-sign an event in a MsgSubmitAggregatedEthereumEvent. The
+// gravity ID keeps cosignatures from being replayed on another bridge.
+func EthereumEventCosignatureHash(gravityID string, event EthereumEvent) []byte {
+	return crypto.Keccak256(
+		[]byte(gravityID),
+		[]byte("ethereumEventCosignature"),
+		event.Hash(),
+	)
+}
+
 //////////
 // Hash //
 //////////
@@ -43,6 +104,38 @@ func (stce *SendToCosmosEvent) Hash() tmbytes.HexBytes {
 		},
 		[]byte{},
 	)
+	// only fold the forward in when present so plain deposits hash as before
+	if stce.IbcForward != "" {
+		path = append(path, []byte(stce.IbcForward)...)
+	}
+	if len(stce.Memo) != 0 {
+		path = append(path, stce.Memo...)
+	}
+	path = append(path, stce.EthereumTxHash...)
+	path = appendEthereumLogIndex(path, stce.EthereumLogIndex, stce.HasEthereumLogIndex)
+	if !stce.ReceivedAmount.IsNil() && !stce.ReceivedAmount.IsZero() {
+		path = append(path, stce.ReceivedAmount.BigInt().Bytes()...)
+	}
+	path = append(path, stce.EthereumBlockHash...)
+	hash := sha256.Sum256([]byte(path))
+	return hash[:]
+}
+
+func (sete *SendEtherToCosmosEvent) Hash() tmbytes.HexBytes {
+	rcv, _ := sdk.AccAddressFromBech32(sete.CosmosReceiver)
+	path := bytes.Join(
+		[][]byte{
+			sdk.Uint64ToBigEndian(sete.EventNonce),
+			sete.Amount.BigInt().Bytes(),
+			common.HexToAddress(sete.EthereumSender).Bytes(),
+			rcv.Bytes(),
+			sdk.Uint64ToBigEndian(sete.EthereumHeight),
+			sete.EthereumTxHash,
+		},
+		[]byte{},
+	)
+	path = appendEthereumLogIndex(path, sete.EthereumLogIndex, sete.HasEthereumLogIndex)
+	path = append(path, sete.EthereumBlockHash...)
 	hash := sha256.Sum256([]byte(path))
 	return hash[:]
 }
@@ -57,6 +150,13 @@ func (bee *BatchExecutedEvent) Hash() tmbytes.HexBytes {
 		},
 		[]byte{},
 	)
+	// only fold the relayer in when present so events hash as before
+	if bee.Relayer != "" {
+		path = append(path, common.HexToAddress(bee.Relayer).Bytes()...)
+	}
+	path = append(path, bee.EthereumTxHash...)
+	path = appendEthereumLogIndex(path, bee.EthereumLogIndex, bee.HasEthereumLogIndex)
+	path = append(path, bee.EthereumBlockHash...)
 	hash := sha256.Sum256([]byte(path))
 	return hash[:]
 }
@@ -71,6 +171,17 @@ func (ccee *ContractCallExecutedEvent) Hash() tmbytes.HexBytes {
 		},
 		[]byte{},
 	)
+	// only fold the call result in when present so events hash as before
+	if ccee.Success || len(ccee.ReturnDataHash) > 0 {
+		var success byte
+		if ccee.Success {
+			success = 1
+		}
+		path = append(append(path, success), ccee.ReturnDataHash...)
+	}
+	path = append(path, ccee.EthereumTxHash...)
+	path = appendEthereumLogIndex(path, ccee.EthereumLogIndex, ccee.HasEthereumLogIndex)
+	path = append(path, ccee.EthereumBlockHash...)
 	hash := sha256.Sum256([]byte(path))
 	return hash[:]
 }
@@ -85,9 +196,12 @@ func (e20de *ERC20DeployedEvent) Hash() tmbytes.HexBytes {
 			[]byte(e20de.Erc20Symbol),
 			sdk.Uint64ToBigEndian(e20de.Erc20Decimals),
 			sdk.Uint64ToBigEndian(e20de.EthereumHeight),
+			e20de.EthereumTxHash,
 		},
 		[]byte{},
 	)
+	path = appendEthereumLogIndex(path, e20de.EthereumLogIndex, e20de.HasEthereumLogIndex)
+	path = append(path, e20de.EthereumBlockHash...)
 	hash := sha256.Sum256([]byte(path))
 	return hash[:]
 }
@@ -99,9 +213,12 @@ func (sse *SignerSetTxExecutedEvent) Hash() tmbytes.HexBytes {
 			sdk.Uint64ToBigEndian(sse.SignerSetTxNonce),
 			sdk.Uint64ToBigEndian(sse.EthereumHeight),
 			EthereumSigners(sse.Members).Hash(),
+			sse.EthereumTxHash,
 		},
 		[]byte{},
 	)
+	path = appendEthereumLogIndex(path, sse.EthereumLogIndex, sse.HasEthereumLogIndex)
+	path = append(path, sse.EthereumBlockHash...)
 	hash := sha256.Sum256(([]byte(path)))
 	return hash[:]
 }
@@ -120,15 +237,81 @@ func (stce *SendToCosmosEvent) Validate() error {
 	if stce.Amount.IsNegative() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
 	}
+	if !stce.ReceivedAmount.IsNil() && (stce.ReceivedAmount.IsNegative() || stce.ReceivedAmount.GT(stce.Amount)) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "received amount must be positive and at most the amount")
+	}
 	if !common.IsHexAddress(stce.EthereumSender) {
 		return sdkerrors.Wrap(ErrInvalid, "ethereum sender")
 	}
+	if err := validateEthereumTxHash(stce.EthereumTxHash); err != nil {
+		return err
+	}
+	if err := validateEthereumBlockHash(stce.EthereumBlockHash); err != nil {
+		return err
+	}
 	if _, err := sdk.AccAddressFromBech32(stce.CosmosReceiver); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, stce.CosmosReceiver)
 	}
+	// a malformed ibc forward, or an ibc forward along with a memo, is not
+	// checked here: the deposit happened on ethereum regardless, and rejecting
+	// its event would halt the event nonces. The handler credits it to the
+	// receiver without forwarding it instead.
 	return nil
 }
 
+func (sete *SendEtherToCosmosEvent) Validate() error {
+	if sete.EventNonce == 0 {
+		return fmt.Errorf("event nonce cannot be 0")
+	}
+	if sete.Amount.IsNil() || sete.Amount.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+	if !common.IsHexAddress(sete.EthereumSender) {
+		return sdkerrors.Wrap(ErrInvalid, "ethereum sender")
+	}
+	if err := validateEthereumTxHash(sete.EthereumTxHash); err != nil {
+		return err
+	}
+	if err := validateEthereumBlockHash(sete.EthereumBlockHash); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(sete.CosmosReceiver); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, sete.CosmosReceiver)
+	}
+	return nil
+}
+
+// SendToCosmosEvent returns the native ether deposit as a deposit of the
+// native ether pseudo contract, which the deposit path credits as any token
+func (sete *SendEtherToCosmosEvent) SendToCosmosEvent() *SendToCosmosEvent {
+	return &SendToCosmosEvent{
+		EventNonce:          sete.EventNonce,
+		TokenContract:       NativeEtherContract.Hex(),
+		Amount:              sete.Amount,
+		EthereumSender:      sete.EthereumSender,
+		CosmosReceiver:      sete.CosmosReceiver,
+		EthereumHeight:      sete.EthereumHeight,
+		EthereumTxHash:      sete.EthereumTxHash,
+		EthereumLogIndex:    sete.EthereumLogIndex,
+		HasEthereumLogIndex: sete.HasEthereumLogIndex,
+	}
+}
+
+// ParseIBCForward splits an IBC forward of the form "<source-channel>:<receiver>"
+func ParseIBCForward(forward string) (channel string, receiver string, err error) {
+	parts := strings.SplitN(forward, ":", 2)
+	if len(parts) != 2 {
+		return "", "", sdkerrors.Wrapf(ErrInvalidIBCForward, "expected <channel>:<receiver>, got %s", forward)
+	}
+	if err := host.ChannelIdentifierValidator(parts[0]); err != nil {
+		return "", "", sdkerrors.Wrap(ErrInvalidIBCForward, err.Error())
+	}
+	if strings.TrimSpace(parts[1]) == "" {
+		return "", "", sdkerrors.Wrap(ErrInvalidIBCForward, "empty receiver")
+	}
+	return parts[0], parts[1], nil
+}
+
 func (bee *BatchExecutedEvent) Validate() error {
 	if bee.EventNonce == 0 {
 		return fmt.Errorf("event nonce cannot be 0")
@@ -136,14 +319,26 @@ func (bee *BatchExecutedEvent) Validate() error {
 	if !common.IsHexAddress(bee.TokenContract) {
 		return sdkerrors.Wrap(ErrInvalid, "ethereum contract address")
 	}
-	return nil
+	if bee.Relayer != "" && !common.IsHexAddress(bee.Relayer) {
+		return sdkerrors.Wrap(ErrInvalid, "relayer ethereum address")
+	}
+	if err := validateEthereumTxHash(bee.EthereumTxHash); err != nil {
+		return err
+	}
+	return validateEthereumBlockHash(bee.EthereumBlockHash)
 }
 
 func (ccee *ContractCallExecutedEvent) Validate() error {
 	if ccee.EventNonce == 0 {
 		return fmt.Errorf("event nonce cannot be 0")
 	}
-	return nil
+	if len(ccee.ReturnDataHash) != 0 && len(ccee.ReturnDataHash) != 32 {
+		return sdkerrors.Wrap(ErrInvalid, "return data hash must be 32 bytes")
+	}
+	if err := validateEthereumTxHash(ccee.EthereumTxHash); err != nil {
+		return err
+	}
+	return validateEthereumBlockHash(ccee.EthereumBlockHash)
 }
 
 func (e20de *ERC20DeployedEvent) Validate() error {
@@ -156,7 +351,10 @@ func (e20de *ERC20DeployedEvent) Validate() error {
 	if err := sdk.ValidateDenom(e20de.CosmosDenom); err != nil {
 		return err
 	}
-	return nil
+	if err := validateEthereumTxHash(e20de.EthereumTxHash); err != nil {
+		return err
+	}
+	return validateEthereumBlockHash(e20de.EthereumBlockHash)
 }
 
 func (sse *SignerSetTxExecutedEvent) Validate() error {
@@ -171,5 +369,41 @@ func (sse *SignerSetTxExecutedEvent) Validate() error {
 			return fmt.Errorf("ethereum signer %d error: %w", i, err)
 		}
 	}
+	if err := validateEthereumTxHash(sse.EthereumTxHash); err != nil {
+		return err
+	}
+	return validateEthereumBlockHash(sse.EthereumBlockHash)
+}
+
+// validateEthereumTxHash checks the optional hash of the ethereum transaction
+// that emitted an event
+func validateEthereumTxHash(txHash []byte) error {
+	if len(txHash) != 0 && len(txHash) != common.HashLength {
+		return sdkerrors.Wrapf(ErrInvalid, "ethereum tx hash must be %d bytes", common.HashLength)
+	}
+	return nil
+}
+
+// validateEthereumBlockHash checks the hash, if any, of the ethereum block
+// an event was emitted in
+func validateEthereumBlockHash(blockHash []byte) error {
+	if len(blockHash) != 0 && len(blockHash) != common.HashLength {
+		return sdkerrors.Wrapf(ErrInvalid, "ethereum block hash must be %d bytes", common.HashLength)
+	}
 	return nil
 }
+
+// appendEthereumLogIndex folds the log index of an event into the path it is
+// hashed from. A log index the event reports as present is folded in with a
+// marker, as zero is a valid index, and a non zero one without the marker as
+// before so that older events hash the same.
+func appendEthereumLogIndex(path []byte, logIndex uint64, hasLogIndex bool) []byte {
+	switch {
+	case hasLogIndex:
+		return append(append(path, 1), sdk.Uint64ToBigEndian(logIndex)...)
+	case logIndex != 0:
+		return append(path, sdk.Uint64ToBigEndian(logIndex)...)
+	default:
+		return path
+	}
+}
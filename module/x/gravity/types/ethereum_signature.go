@@ -59,7 +59,7 @@ func (u *SignerSetTxConfirmation) Validate() error {
 	if u.Signature == nil {
 		return fmt.Errorf("signature must be set")
 	}
-	return nil
+	return ValidateSignatureScheme(u.Scheme)
 }
 
 func (u *ContractCallTxConfirmation) Validate() error {
@@ -75,7 +75,7 @@ func (u *ContractCallTxConfirmation) Validate() error {
 	if u.Signature == nil {
 		return fmt.Errorf("signature must be set")
 	}
-	return nil
+	return ValidateSignatureScheme(u.Scheme)
 }
 
 func (u *BatchTxConfirmation) Validate() error {
@@ -91,5 +91,5 @@ func (u *BatchTxConfirmation) Validate() error {
 	if u.Signature == nil {
 		return fmt.Errorf("signature must be set")
 	}
-	return nil
+	return ValidateSignatureScheme(u.Scheme)
 }
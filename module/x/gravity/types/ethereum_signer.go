@@ -2,6 +2,7 @@ package types
 
 import (
 	"crypto/ecdsa"
+	"math/big"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
@@ -12,23 +13,47 @@ const (
 	signaturePrefix = "\x19Ethereum Signed Message:\n32"
 )
 
+// EthereumSignedHash returns the digest that is signed for a checkpoint, the
+// checkpoint prefixed as an ethereum signed message and hashed again
+func EthereumSignedHash(hash []byte) []byte {
+	return crypto.Keccak256Hash(append([]byte(signaturePrefix), hash...)).Bytes()
+}
+
 // NewEthereumSignature creates a new signuature over a given byte array
 func NewEthereumSignature(hash []byte, privateKey *ecdsa.PrivateKey) ([]byte, error) {
 	if privateKey == nil {
 		return nil, sdkerrors.Wrap(ErrInvalid, "did not pass in private key")
 	}
-	protectedHash := crypto.Keccak256Hash(append([]byte(signaturePrefix), hash...))
-	return crypto.Sign(protectedHash.Bytes(), privateKey)
+	return crypto.Sign(EthereumSignedHash(hash), privateKey)
 }
 
 // ValidateEthereumSignature takes a message, an associated signature and public key and
 // returns an error if the signature isn't valid
 func ValidateEthereumSignature(hash []byte, signature []byte, ethAddress common.Address) error {
+	addr, err := EthereumSignatureSigner(hash, signature)
+	if err != nil {
+		return err
+	}
+	if addr != ethAddress {
+		return sdkerrors.Wrapf(ErrInvalidEthereumSignature, "signature not matching addr %x sig %x hash %x", addr, signature, hash)
+	}
+
+	return nil
+}
 
+// EthereumSignatureSigner returns the address recovered from a well formed
+// signature over a message. A signature over any other message recovers to
+// another address.
+func EthereumSignatureSigner(hash []byte, signature []byte) (common.Address, error) {
 	/// signature to public key: invalid signature length: invalid
 	/// signature not matching: invalid: invalid
-	if len(signature) < 65 {
-		return sdkerrors.Wrapf(ErrInvalid, "signature too short signature %x", signature)
+	if len(hash) != common.HashLength {
+		return common.Address{}, sdkerrors.Wrapf(ErrInvalidEthereumSignature, "hash must be %d bytes, got %x", common.HashLength, hash)
+	}
+	// the bridge contract takes exactly v, r and s, so any trailing bytes would
+	// only end up in relay payloads
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, sdkerrors.Wrapf(ErrInvalidEthereumSignature, "signature must be %d bytes, got %x", crypto.SignatureLength, signature)
 	}
 
 	// Copy to avoid mutating signature slice by accident
@@ -38,7 +63,7 @@ func ValidateEthereumSignature(hash []byte, signature []byte, ethAddress common.
 	// To verify signature
 	// - use crypto.SigToPub to get the public key
 	// - use crypto.PubkeyToAddress to get the address
-	// - compare this to the address given.
+	// - the caller compares this to the address it expects.
 
 	// for backwards compatibility reasons  the V value of an Ethereum sig is presented
 	// as 27 or 28, internally though it should be a 0-3 value due to changed formats.
@@ -53,16 +78,19 @@ func ValidateEthereumSignature(hash []byte, signature []byte, ethAddress common.
 		sigCopy[64] -= 27
 	}
 
+	// reject malleable (high s) or otherwise out of range signature values
+	// before they are stored and handed to relayers
+	r, sv := new(big.Int).SetBytes(sigCopy[:32]), new(big.Int).SetBytes(sigCopy[32:64])
+	if !crypto.ValidateSignatureValues(sigCopy[64], r, sv, true) {
+		return common.Address{}, sdkerrors.Wrapf(ErrInvalidEthereumSignature, "invalid signature values %x", signature)
+	}
+
 	hash = append([]uint8(signaturePrefix), hash...)
 
 	pubkey, err := crypto.SigToPub(crypto.Keccak256Hash(hash).Bytes(), sigCopy)
 	if err != nil {
-		return sdkerrors.Wrapf(err, "signature to public key sig %x hash %x", sigCopy, hash)
+		return common.Address{}, sdkerrors.Wrapf(err, "signature to public key sig %x hash %x", sigCopy, hash)
 	}
 
-	if addr := crypto.PubkeyToAddress(*pubkey); addr != ethAddress {
-		return sdkerrors.Wrapf(ErrInvalid, "signature not matching addr %x sig %x hash %x", addr, signature, hash)
-	}
-
-	return nil
+	return crypto.PubkeyToAddress(*pubkey), nil
 }
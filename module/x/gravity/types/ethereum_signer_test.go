@@ -2,9 +2,11 @@ package types
 
 import (
 	"encoding/hex"
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -62,6 +64,18 @@ func TestSignerSetConfirmSig(t *testing.T) {
 			srcETHAddr:   ethAddress,
 			expErr:       true,
 		},
+		"signature too long": {
+			srcHash:      hash,
+			srcSignature: correctSig + "00",
+			srcETHAddr:   ethAddress,
+			expErr:       true,
+		},
+		"malleable signature": {
+			srcHash:      hash,
+			srcSignature: malleableSig(t, correctSig),
+			srcETHAddr:   ethAddress,
+			expErr:       true,
+		},
 		"empty eth address": {
 			srcHash:      hash,
 			srcSignature: correctSig,
@@ -89,3 +103,20 @@ func TestSignerSetConfirmSig(t *testing.T) {
 		})
 	}
 }
+
+// malleableSig returns the high s counterpart of a signature, which recovers
+// to the same address
+func malleableSig(t *testing.T, sig string) string {
+	bz, err := hex.DecodeString(sig)
+	require.NoError(t, err)
+
+	s := new(big.Int).SetBytes(bz[32:64])
+	s.Sub(crypto.S256().Params().N, s)
+	copy(bz[32:64], common.LeftPadBytes(s.Bytes(), 32))
+	if bz[64] == 27 {
+		bz[64] = 28
+	} else {
+		bz[64] = 27
+	}
+	return hex.EncodeToString(bz)
+}
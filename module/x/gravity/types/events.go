@@ -1,21 +1,35 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
 const (
-	EventTypeObservation              = "observation"
-	EventTypeOutgoingBatch            = "outgoing_batch"
 	EventTypeMultisigUpdateRequest    = "multisig_update_request"
-	EventTypeOutgoingBatchCanceled    = "outgoing_batch_canceled"
 	EventTypeContractCallTxCanceled   = "outgoing_logic_call_canceled"
 	EventTypeBridgeWithdrawalReceived = "withdrawal_received"
 	EventTypeBridgeDepositReceived    = "deposit_received"
+	EventTypeBridgeDepositQueued      = "deposit_queued"
+	EventTypeBridgeDepositHeld        = "deposit_held"
+	EventTypeBridgeDepositReleased    = "deposit_released"
+	EventTypeBridgeDepositForwarded   = "deposit_forwarded"
+	EventTypeBridgeDepositMemoHandled = "deposit_memo_handled"
+	EventTypeBridgeDepositStaked      = "deposit_staked"
 	EventTypeBridgeWithdrawCanceled   = "withdraw_canceled"
+	EventTypeRelayerReward            = "relayer_reward"
+	EventTypeChainFee                 = "chain_fee"
+	EventTypeBridgeHalted             = "bridge_halted"
+	EventTypeBridgeReenabled          = "bridge_reenabled"
+	EventTypeERC20DeploymentPending   = "erc20_deployment_pending"
+	EventTypeERC20DeploymentConfirmed = "erc20_deployment_confirmed"
+	EventTypeTokenOutboundPaused      = "token_outbound_paused"
+	EventTypeTokenOutboundResumed     = "token_outbound_resumed"
+	EventTypeEthereumHeaderAgreed     = "ethereum_header_agreed"
 
 	AttributeKeyEthereumEventVoteRecordID     = "ethereum_event_vote_record_id"
 	AttributeKeyBatchConfirmKey               = "batch_confirm_key"
-	AttributeKeyEthereumSignatureKey          = "ethereum_signature_key"
-	AttributeKeyOutgoingBatchID               = "batch_id"
 	AttributeKeyOutgoingTXID                  = "outgoing_tx_id"
-	AttributeKeyEthereumEventType             = "ethereum_event_type"
 	AttributeKeyContract                      = "bridge_contract"
 	AttributeKeyNonce                         = "nonce"
 	AttributeKeySignerSetNonce                = "signerset_nonce"
@@ -31,5 +45,31 @@ const (
 	AttributeKeyContractCallFees              = "contract_call_fees"
 	AttributeKeyContractCallAddress           = "contract_call_address"
 	AttributeKeyEthTxTimeout                  = "eth_tx_timeout"
+	AttributeKeyIBCForward                    = "ibc_forward"
+	AttributeKeyMemoRoute                     = "memo_route"
+	AttributeKeyRelayer                       = "relayer"
+	AttributeKeyRelayerReward                 = "relayer_reward"
+	AttributeKeyRelayerOrchestrator           = "relayer_orchestrator"
+	AttributeKeyChainFee                      = "chain_fee"
+	AttributeKeyChainFeeDestination           = "chain_fee_destination"
+	AttributeKeyHaltReason                    = "halt_reason"
+	AttributeKeyCosmosDenom                   = "cosmos_denom"
+	AttributeKeyTokenContract                 = "token_contract"
+	AttributeKeyEthereumAnomaly               = "ethereum_anomaly"
+	AttributeKeyExpiryHeight                  = "expiry_height"
+	AttributeKeyMsgCount                      = "msg_count"
+	AttributeKeyEthereumHeight                = "ethereum_height"
+	AttributeKeyEthereumBlockHash             = "ethereum_block_hash"
 	AttributeMissingBridgeBatchSig            = "missing_bridge_batch_signature"
+	AttributeMissingBridgeSignerSetSig        = "missing_bridge_signer_set_signature"
+	AttributeWrongBridgeSig                   = "wrong_bridge_signature"
+	AttributeBadBridgeSig                     = "bad_bridge_signature"
 )
+
+// EmitTypedEvent emits one of the typed events of the module. These are
+// generated protobuf messages, which always marshal.
+func EmitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(err)
+	}
+}
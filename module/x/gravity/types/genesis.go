@@ -17,6 +17,8 @@ const (
 	// todo: implement oracle constants as params
 	DefaultParamspace     = ModuleName
 	EventVoteRecordPeriod = 24 * time.Hour // TODO: value????
+	// IBCForwardTimeout bounds how long a forwarded deposit may take to reach its destination chain
+	IBCForwardTimeout = 10 * time.Minute
 )
 
 var (
@@ -77,6 +79,150 @@ var (
 	// ParamStoreObserveEthereumHeightPeriod store the observe ethereum height period
 	ParamStoreObserveEthereumHeightPeriod = []byte("ObserveEthereumHeightPeriod")
 
+	// ParamStoreMirrorMode store the parameter to run the bridge in read-only mirror mode
+	ParamStoreMirrorMode = []byte("MirrorMode")
+
+	// ParamStoreMintRateLimits stores the per token mint rate limits
+	ParamStoreMintRateLimits = []byte("MintRateLimits")
+
+	// ParamStoreMintRateLimitWindow stores the mint rate limit window in blocks
+	ParamStoreMintRateLimitWindow = []byte("MintRateLimitWindow")
+
+	// ParamStoreBridgeProposalQuorum stores the quorum required for bridge-critical proposals
+	ParamStoreBridgeProposalQuorum = []byte("BridgeProposalQuorum")
+
+	// ParamStoreBridgeProposalThreshold stores the yes threshold required for bridge-critical proposals
+	ParamStoreBridgeProposalThreshold = []byte("BridgeProposalThreshold")
+
+	// ParamStoreBatchFeeThresholds stores the per token unbatched fee thresholds
+	ParamStoreBatchFeeThresholds = []byte("BatchFeeThresholds")
+
+	// ParamStoreRelayerRewardFraction stores the fraction of executed batch fees paid to the relayer reward pool
+	ParamStoreRelayerRewardFraction = []byte("RelayerRewardFraction")
+
+	// ParamStoreCircuitBreakerWindow stores the circuit breaker window in blocks
+	ParamStoreCircuitBreakerWindow = []byte("CircuitBreakerWindow")
+
+	// ParamStoreCircuitBreakerMultiple stores how far a window's flow may exceed its trailing average
+	ParamStoreCircuitBreakerMultiple = []byte("CircuitBreakerMultiple")
+
+	// ParamStoreCircuitBreakerOutflowCaps stores the per token outflow caps
+	ParamStoreCircuitBreakerOutflowCaps = []byte("CircuitBreakerOutflowCaps")
+
+	// ParamStoreBatchMaxTxAge stores the unbatched tx age in blocks that triggers a batch
+	ParamStoreBatchMaxTxAge = []byte("BatchMaxTxAge")
+
+	// ParamStoreBatchMaxPoolSize stores the number of unbatched txs that triggers a batch
+	ParamStoreBatchMaxPoolSize = []byte("BatchMaxPoolSize")
+
+	// ParamStoreOrchestratorQueryIdentityLifetime stores the number of blocks an orchestrator query identity is valid for
+	ParamStoreOrchestratorQueryIdentityLifetime = []byte("OrchestratorQueryIdentityLifetime")
+
+	// ParamStoreBridgeFeeDenom stores the denom SendToEthereum fees may be paid in instead of the bridged token
+	ParamStoreBridgeFeeDenom = []byte("BridgeFeeDenom")
+
+	// ParamStoreDelayedWithdrawalThresholds stores the per token amounts above which sends to ethereum are delayed
+	ParamStoreDelayedWithdrawalThresholds = []byte("DelayedWithdrawalThresholds")
+
+	// ParamStoreDelayedWithdrawalPeriod stores the number of blocks delayed sends to ethereum are held for
+	ParamStoreDelayedWithdrawalPeriod = []byte("DelayedWithdrawalPeriod")
+
+	// ParamStoreSecurityCouncil stores the account that may veto delayed sends to ethereum
+	ParamStoreSecurityCouncil = []byte("SecurityCouncil")
+
+	// ParamStoreBatchTimeoutFeeBoost stores the fraction of its fee an unbatched tx gains in priority per batch timeout
+	ParamStoreBatchTimeoutFeeBoost = []byte("BatchTimeoutFeeBoost")
+
+	// ParamStoreTimeoutEthereumBlockMargin stores the number of ethereum blocks outgoing txs time out after the median height vote
+	ParamStoreTimeoutEthereumBlockMargin = []byte("TimeoutEthereumBlockMargin")
+
+	// ParamStoreTimeoutHeightVoteWindow stores the number of blocks a height vote counts towards the timeout median
+	ParamStoreTimeoutHeightVoteWindow = []byte("TimeoutHeightVoteWindow")
+
+	// ParamStoreMaxBatchTxSize stores the maximum number of sends to ethereum in a batch
+	ParamStoreMaxBatchTxSize = []byte("MaxBatchTxSize")
+
+	// ParamStoreMaxContractCallPayloadBytes stores the maximum size of the payload of a contract call
+	ParamStoreMaxContractCallPayloadBytes = []byte("MaxContractCallPayloadBytes")
+
+	// ParamStoreChainFeeBasisPoints stores the share of sends to ethereum kept as a chain fee
+	ParamStoreChainFeeBasisPoints = []byte("ChainFeeBasisPoints")
+
+	// ParamStoreChainFeeDestination stores where chain fees go
+	ParamStoreChainFeeDestination = []byte("ChainFeeDestination")
+
+	// ParamStoreSignerSetRetention stores the number of signer set txs kept below the last observed one
+	ParamStoreSignerSetRetention = []byte("SignerSetRetention")
+
+	// ParamStoreTokenAllowlistEnabled stores whether only allowlisted tokens can be bridged
+	ParamStoreTokenAllowlistEnabled = []byte("TokenAllowlistEnabled")
+
+	// ParamStoreTokenAllowlist stores the erc20 contracts that can be bridged when the allowlist is enabled
+	ParamStoreTokenAllowlist = []byte("TokenAllowlist")
+
+	// ParamStoreExecutedBatchRetention stores the number of blocks executed batches are archived for
+	ParamStoreExecutedBatchRetention = []byte("ExecutedBatchRetention")
+
+	// ParamStoreEventVotePowerThreshold stores the fraction of the total power required to observe an ethereum event
+	ParamStoreEventVotePowerThreshold = []byte("EventVotePowerThreshold")
+
+	// ParamStoreEthereumEventConfirmationDepth stores the number of ethereum blocks an event must be buried under before it is observed
+	ParamStoreEthereumEventConfirmationDepth = []byte("EthereumEventConfirmationDepth")
+
+	// ParamStoreNativeEtherDenom stores the denom of the vouchers minted for native ether deposits
+	ParamStoreNativeEtherDenom = []byte("NativeEtherDenom")
+
+	// ParamStoreInboundEnabled stores whether deposits from ethereum are credited
+	ParamStoreInboundEnabled = []byte("InboundEnabled")
+
+	// ParamStoreOutboundEnabled stores whether sends to ethereum are accepted and batched
+	ParamStoreOutboundEnabled = []byte("OutboundEnabled")
+
+	// ParamStoreMaxPendingSendToEthereumPerAccount stores the maximum number of unbatched sends to ethereum per account
+	ParamStoreMaxPendingSendToEthereumPerAccount = []byte("MaxPendingSendToEthereumPerAccount")
+
+	// ParamStoreBatchGasBaseCost stores the base cost in ethereum gas of relaying a batch
+	ParamStoreBatchGasBaseCost = []byte("BatchGasBaseCost")
+
+	// ParamStoreBatchGasPerTransfer stores the cost in ethereum gas of each transfer of a batch
+	ParamStoreBatchGasPerTransfer = []byte("BatchGasPerTransfer")
+
+	// ParamStoreBatchGasPerSignature stores the cost in ethereum gas of each signature a batch is relayed with
+	ParamStoreBatchGasPerSignature = []byte("BatchGasPerSignature")
+
+	// ParamStorePowerReduction stores the tokens per unit of power of the validators in signer sets
+	ParamStorePowerReduction = []byte("PowerReduction")
+
+	// ParamStoreSignerSetPowerChangeThresholdBps stores the power change in basis points above which a signer set tx is created
+	ParamStoreSignerSetPowerChangeThresholdBps = []byte("SignerSetPowerChangeThresholdBps")
+
+	// ParamStoreMaxBlocksBetweenSignerSets stores the blocks after the latest signer set tx a new one is created
+	ParamStoreMaxBlocksBetweenSignerSets = []byte("MaxBlocksBetweenSignerSets")
+
+	// ParamStoreMaxTxsPerSenderPerBatch stores the maximum number of txs of a sender in a batch
+	ParamStoreMaxTxsPerSenderPerBatch = []byte("MaxTxsPerSenderPerBatch")
+
+	// ParamStoreFeeOnTransferTokens stores the erc20 contracts that take a fee on transfer or rebase
+	ParamStoreFeeOnTransferTokens = []byte("FeeOnTransferTokens")
+
+	// ParamStoreEthereumHeaderValidationEnabled stores whether ethereum events are checked against the agreed ethereum header
+	ParamStoreEthereumHeaderValidationEnabled = []byte("EthereumHeaderValidationEnabled")
+
+	// ParamStoreEthereumHeaderCheckpointInterval stores the number of ethereum blocks between header checkpoints
+	ParamStoreEthereumHeaderCheckpointInterval = []byte("EthereumHeaderCheckpointInterval")
+
+	// ParamStoreOrchestratorFreezeCooldown stores the number of blocks a validator can't set delegate keys after a freeze
+	ParamStoreOrchestratorFreezeCooldown = []byte("OrchestratorFreezeCooldown")
+
+	// ParamStoreContractCallRefundRetention stores the number of blocks refunded contract calls are archived for
+	ParamStoreContractCallRefundRetention = []byte("ContractCallRefundRetention")
+
+	// MinEventVotePowerThreshold and MaxEventVotePowerThreshold bound the
+	// event vote power threshold, observing events with less than a
+	// majority of the power would let a minority of validators mint tokens
+	MinEventVotePowerThreshold = sdk.NewDecWithPrec(52, 2)
+	MaxEventVotePowerThreshold = sdk.NewDecWithPrec(90, 2)
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -99,17 +245,29 @@ func (gs *GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, evr := range gs.EthereumTxHashEventVoteRecords {
+		if err := evr.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	for i := range gs.ObservedEthereumEvents {
+		if err := gs.ObservedEthereumEvents[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func EventVoteRecordPowerThreshold(totalPower sdk.Int) sdk.Int {
-	return sdk.NewInt(66).Mul(totalPower).Quo(sdk.NewInt(100))
+// EventVoteRecordPowerThreshold returns the power the votes for an ethereum
+// event must reach, the threshold fraction of the total power
+func EventVoteRecordPowerThreshold(totalPower sdk.Int, threshold sdk.Dec) sdk.Int {
+	return threshold.MulInt(totalPower).TruncateInt()
 }
 
 // ValidateBasic validates genesis state by looping through the params and
 // calling their validation functions
 func (s GenesisState) ValidateBasic() error {
-	if err := s.Params.ValidateBasic(); err != nil {
+	if err := s.Params.Validate(); err != nil {
 		return sdkerrors.Wrap(err, "params")
 	}
 	if len(s.DelegateKeys) != 0 {
@@ -119,9 +277,299 @@ func (s GenesisState) ValidateBasic() error {
 			}
 		}
 	}
+	for _, event := range s.QueuedSendToCosmosEvents {
+		if err := event.Validate(); err != nil {
+			return sdkerrors.Wrap(err, "queued send to cosmos events")
+		}
+	}
+	for _, event := range s.HeldSendToCosmosEvents {
+		if err := event.Validate(); err != nil {
+			return sdkerrors.Wrap(err, "held send to cosmos events")
+		}
+	}
+	for _, record := range s.ExecutedBatchTxs {
+		if err := ValidateEthAddress(record.TokenContract); err != nil {
+			return sdkerrors.Wrap(err, "executed batch txs")
+		}
+	}
+	for _, record := range s.ExecutedContractCallTxs {
+		if err := ValidateEthAddress(record.Address); err != nil {
+			return sdkerrors.Wrap(err, "executed contract call txs")
+		}
+	}
+	for _, record := range s.RefundedContractCallTxs {
+		if record.Refundee == "" || !record.Refunded.IsValid() {
+			return sdkerrors.Wrap(ErrInvalid, "refunded contract call txs")
+		}
+	}
+	for _, allowance := range s.BridgeFeeAllowances {
+		if err := validateBridgeFeeAllowanceParties(allowance.Granter, allowance.Grantee); err != nil {
+			return sdkerrors.Wrap(err, "bridge fee allowances")
+		}
+		if !allowance.SpendLimit.IsValid() || allowance.SpendLimit.IsZero() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "bridge fee allowances")
+		}
+	}
+	for _, entry := range s.ValidatorSignatureSchemes {
+		if _, err := sdk.ValAddressFromBech32(entry.ValidatorAddress); err != nil {
+			return sdkerrors.Wrap(err, "validator signature schemes")
+		}
+		if err := ValidateSignatureScheme(entry.Scheme); err != nil {
+			return sdkerrors.Wrap(err, "validator signature schemes")
+		}
+	}
+	for _, addr := range s.EthereumBlocklist {
+		if err := ValidateEthAddress(addr); err != nil {
+			return sdkerrors.Wrap(err, "ethereum blocklist")
+		}
+	}
+	for _, addr := range s.ExcludedEthereumSigners {
+		if err := ValidateEthAddress(addr); err != nil {
+			return sdkerrors.Wrap(err, "excluded ethereum signers")
+		}
+	}
+	for _, addr := range s.OptedOutValidators {
+		if _, err := sdk.ValAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrap(err, "opted out validators")
+		}
+	}
+	for _, bucket := range s.BridgeStatsBuckets {
+		if err := ValidateEthAddress(bucket.TokenContract); err != nil {
+			return sdkerrors.Wrap(err, "bridge stats buckets")
+		}
+	}
+	if s.AgreedEthereumHeader != nil {
+		if err := s.AgreedEthereumHeader.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "agreed ethereum header")
+		}
+	}
+	for _, header := range s.AgreedEthereumHeaders {
+		if err := header.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "agreed ethereum headers")
+		}
+	}
+	for _, freeze := range s.OrchestratorFreezes {
+		if _, err := sdk.ValAddressFromBech32(freeze.ValidatorAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "orchestrator freezes: %s", freeze.ValidatorAddress)
+		}
+	}
+	for _, vote := range s.EthereumHeaderVotes {
+		if _, err := sdk.ValAddressFromBech32(vote.ValidatorAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "ethereum header votes: %s", vote.ValidatorAddress)
+		}
+		if err := vote.Header.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "ethereum header votes")
+		}
+	}
+	for _, entry := range s.PendingEthereumAddresses {
+		if err := entry.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "pending ethereum addresses")
+		}
+	}
+	for _, entry := range s.OrchestratorlessEthereumAddresses {
+		if err := entry.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "orchestratorless ethereum addresses")
+		}
+	}
+	for _, supply := range s.BridgedSupplies {
+		if err := ValidateEthAddress(supply.Contract); err != nil {
+			return sdkerrors.Wrap(err, "bridged supplies")
+		}
+	}
+	for _, flow := range s.BridgeFlows {
+		if err := ValidateEthAddress(flow.TokenContract); err != nil {
+			return sdkerrors.Wrap(err, "bridge flows")
+		}
+	}
+	for _, observed := range s.ObservedSignerSetTxs {
+		for _, signer := range observed.SignerSet.Signers {
+			if err := signer.ValidateBasic(); err != nil {
+				return sdkerrors.Wrap(err, "observed signer set txs")
+			}
+		}
+	}
+	for _, deployment := range s.PendingErc20Deployments {
+		if err := deployment.Validate(); err != nil {
+			return sdkerrors.Wrap(err, "pending erc20 deployments")
+		}
+	}
+	for _, conversion := range s.Erc20Conversions {
+		if err := conversion.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "erc20 conversions")
+		}
+	}
+	for _, pause := range s.TokenPauses {
+		if err := pause.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "token pauses")
+		}
+	}
+	for _, identity := range s.OrchestratorQueryIdentities {
+		if err := identity.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "orchestrator query identities")
+		}
+	}
+	for _, delayed := range s.DelayedSendToEthereums {
+		if err := delayed.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "delayed send to ethereums")
+		}
+	}
+	for _, vote := range s.EthereumHeightVotes {
+		if _, err := sdk.ValAddressFromBech32(vote.ValidatorAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "ethereum height votes: %s", vote.ValidatorAddress)
+		}
+	}
+	for _, entry := range s.LastEventNoncesByValidator {
+		if _, err := sdk.ValAddressFromBech32(entry.ValidatorAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "last event nonces by validator: %s", entry.ValidatorAddress)
+		}
+	}
+	for _, entry := range s.ValidatorLivenessHeights {
+		if _, err := sdk.ValAddressFromBech32(entry.ValidatorAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "validator liveness heights: %s", entry.ValidatorAddress)
+		}
+	}
+	return s.validateCrossReferences()
+}
+
+// validateCrossReferences checks that the entries of the genesis state that
+// refer to each other agree, which InitGenesis otherwise only finds out by
+// panicking halfway through the import
+func (s GenesisState) validateCrossReferences() error {
+	// the delegate keys must map validators, orchestrators and ethereum
+	// addresses one to one
+	validators := make(map[string]bool)
+	orchestrators := make(map[string]bool)
+	ethereumSigners := make(map[common.Address]bool)
+	for _, keys := range s.DelegateKeys {
+		eth := common.HexToAddress(keys.EthereumAddress)
+		switch {
+		case validators[keys.ValidatorAddress]:
+			return sdkerrors.Wrapf(ErrInvalid, "delegates: duplicate validator %s", keys.ValidatorAddress)
+		case orchestrators[keys.OrchestratorAddress]:
+			return sdkerrors.Wrapf(ErrInvalid, "delegates: duplicate orchestrator %s", keys.OrchestratorAddress)
+		case ethereumSigners[eth]:
+			return sdkerrors.Wrapf(ErrInvalid, "delegates: duplicate ethereum address %s", eth.Hex())
+		}
+		validators[keys.ValidatorAddress] = true
+		orchestrators[keys.OrchestratorAddress] = true
+		ethereumSigners[eth] = true
+	}
+
+	// cosmos originated denoms and their ERC20s must map one to one
+	cosmosOriginated := make(map[common.Address]string)
+	denoms := make(map[string]bool)
+	for _, item := range s.Erc20ToDenoms {
+		if err := ValidateEthAddress(item.Erc20); err != nil {
+			return sdkerrors.Wrap(err, "erc20 to denoms")
+		}
+		if err := sdk.ValidateDenom(item.Denom); err != nil {
+			return sdkerrors.Wrap(err, "erc20 to denoms")
+		}
+		erc20 := common.HexToAddress(item.Erc20)
+		if _, ok := cosmosOriginated[erc20]; ok || denoms[item.Denom] {
+			return sdkerrors.Wrapf(ErrInvalid, "erc20 to denoms: duplicate %s %s", item.Erc20, item.Denom)
+		}
+		cosmosOriginated[erc20] = item.Denom
+		denoms[item.Denom] = true
+	}
+
+	// the denom of an unbatched send is that of its ERC20, through the
+	// mappings above for cosmos originated tokens
+	var maxSendToEthereumID uint64
+	sendToEthereumIDs := make(map[uint64]bool)
+	for _, tx := range s.UnbatchedSendToEthereumTxs {
+		if err := ValidateEthAddress(tx.Erc20Token.Contract); err != nil {
+			return sdkerrors.Wrapf(err, "unbatched send to ethereum %d", tx.Id)
+		}
+		erc20 := common.HexToAddress(tx.Erc20Token.Contract)
+		if common.HexToAddress(tx.Erc20Fee.Contract) != erc20 {
+			return sdkerrors.Wrapf(ErrInvalid, "unbatched send to ethereum %d: fee in %s, token %s", tx.Id, tx.Erc20Fee.Contract, tx.Erc20Token.Contract)
+		}
+		denom, ok := cosmosOriginated[erc20]
+		switch {
+		case ok:
+		case erc20 == NativeEtherContract:
+			denom = s.Params.NativeEtherDenom
+		default:
+			denom = GravityDenom(erc20)
+		}
+		if tx.ChainFee != nil && tx.ChainFee.Denom != denom {
+			return sdkerrors.Wrapf(ErrUnknownToken, "unbatched send to ethereum %d: chain fee in %s, %s maps to %s", tx.Id, tx.ChainFee.Denom, erc20.Hex(), denom)
+		}
+		if sendToEthereumIDs[tx.Id] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate unbatched send to ethereum %d", tx.Id)
+		}
+		sendToEthereumIDs[tx.Id] = true
+		if tx.Id > maxSendToEthereumID {
+			maxSendToEthereumID = tx.Id
+		}
+	}
+
+	var maxBatchNonce, maxSignerSetNonce uint64
+	outgoingTxs := make(map[string]bool)
+	for _, ota := range s.OutgoingTxs {
+		otx, err := UnpackOutgoingTx(ota)
+		if err != nil {
+			return sdkerrors.Wrap(err, "outgoing txs")
+		}
+		storeIndex := string(otx.GetStoreIndex())
+		if outgoingTxs[storeIndex] {
+			return sdkerrors.Wrapf(ErrInvalid, "outgoing txs: duplicate %x", storeIndex)
+		}
+		outgoingTxs[storeIndex] = true
+
+		switch tx := otx.(type) {
+		case *BatchTx:
+			if tx.BatchNonce > maxBatchNonce {
+				maxBatchNonce = tx.BatchNonce
+			}
+		case *SignerSetTx:
+			if tx.Nonce > maxSignerSetNonce {
+				maxSignerSetNonce = tx.Nonce
+			}
+		}
+	}
+
+	// a zero counter is derived from the imported ids and nonces, as for
+	// genesis files predating the counters, any other can't fall behind them
+	switch {
+	case s.LastSendToEthereumId != 0 && s.LastSendToEthereumId < maxSendToEthereumID:
+		return sdkerrors.Wrapf(ErrInvalid, "last send to ethereum id %d is below unbatched send %d", s.LastSendToEthereumId, maxSendToEthereumID)
+	case s.LastOutgoingBatchNonce != 0 && s.LastOutgoingBatchNonce < maxBatchNonce:
+		return sdkerrors.Wrapf(ErrInvalid, "last outgoing batch nonce %d is below batch %d", s.LastOutgoingBatchNonce, maxBatchNonce)
+	case s.LatestSignerSetTxNonce != 0 && s.LatestSignerSetTxNonce < maxSignerSetNonce:
+		return sdkerrors.Wrapf(ErrInvalid, "latest signer set tx nonce %d is below signer set %d", s.LatestSignerSetTxNonce, maxSignerSetNonce)
+	}
+
+	// a signature is stored under the validator of its signer and the outgoing
+	// tx it signs
+	for _, confa := range s.Confirmations {
+		conf, err := UnpackConfirmation(confa)
+		if err != nil {
+			return sdkerrors.Wrap(err, "confirmations")
+		}
+		if err := conf.Validate(); err != nil {
+			return sdkerrors.Wrap(err, "confirmations")
+		}
+		if !ethereumSigners[conf.GetSigner()] {
+			return sdkerrors.Wrapf(ErrInvalid, "confirmations: no delegate keys for ethereum signer %s", conf.GetSigner().Hex())
+		}
+		if !outgoingTxs[string(conf.GetStoreIndex())] {
+			return sdkerrors.Wrapf(ErrInvalid, "confirmations: no outgoing tx %x", conf.GetStoreIndex())
+		}
+	}
+
 	return nil
 }
 
+// ValidateBasic validates the validator and ethereum addresses
+func (v ValidatorEthereumAddress) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(v.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, v.ValidatorAddress)
+	}
+	return ValidateEthAddress(v.EthereumAddress)
+}
+
 // DefaultGenesisState returns empty genesis state
 // TODO: set some better defaults here
 func DefaultGenesisState() *GenesisState {
@@ -150,6 +598,52 @@ func DefaultParams() *Params {
 		BatchCreationPeriod:                       10,
 		BatchMaxElement:                           100,
 		ObserveEthereumHeightPeriod:               50,
+		MirrorMode:                                false,
+		MintRateLimits:                            []MintRateLimit{},
+		MintRateLimitWindow:                       600,
+		BridgeProposalQuorum:                      sdk.ZeroDec(),
+		BridgeProposalThreshold:                   sdk.ZeroDec(),
+		BatchFeeThresholds:                        []BatchFeeThreshold{},
+		RelayerRewardFraction:                     sdk.ZeroDec(),
+		CircuitBreakerWindow:                      600,
+		CircuitBreakerMultiple:                    sdk.ZeroDec(),
+		CircuitBreakerOutflowCaps:                 []OutflowCap{},
+		BatchMaxTxAge:                             0,
+		BatchMaxPoolSize:                          0,
+		OrchestratorQueryIdentityLifetime:         100_800,
+		BridgeFeeDenom:                            "",
+		DelayedWithdrawalThresholds:               []DelayedWithdrawalThreshold{},
+		DelayedWithdrawalPeriod:                   14_400,
+		SecurityCouncil:                           "",
+		BatchTimeoutFeeBoost:                      sdk.NewDecWithPrec(1, 1),
+		TimeoutEthereumBlockMargin:                0,
+		TimeoutHeightVoteWindow:                   500,
+		MaxBatchTxSize:                            0,
+		MaxContractCallPayloadBytes:               0,
+		ChainFeeBasisPoints:                       0,
+		ChainFeeDestination:                       ChainFeeDestinationCommunityPool,
+		SignerSetRetention:                        0,
+		TokenAllowlistEnabled:                     false,
+		TokenAllowlist:                            []string{},
+		ExecutedBatchRetention:                    100_800,
+		EventVotePowerThreshold:                   sdk.NewDecWithPrec(66, 2),
+		EthereumEventConfirmationDepth:            0,
+		NativeEtherDenom:                          "gravity/eth",
+		InboundEnabled:                            true,
+		OutboundEnabled:                           true,
+		MaxPendingSendToEthereumPerAccount:        0,
+		BatchGasBaseCost:                          100_000,
+		BatchGasPerTransfer:                       35_000,
+		BatchGasPerSignature:                      5_000,
+		PowerReduction:                            sdk.DefaultPowerReduction,
+		SignerSetPowerChangeThresholdBps:          500,
+		MaxBlocksBetweenSignerSets:                0,
+		MaxTxsPerSenderPerBatch:                   0,
+		FeeOnTransferTokens:                       []string{},
+		EthereumHeaderValidationEnabled:           false,
+		EthereumHeaderCheckpointInterval:          100,
+		OrchestratorFreezeCooldown:                14_400,
+		ContractCallRefundRetention:               100_800,
 	}
 }
 
@@ -200,10 +694,154 @@ func (p Params) ValidateBasic() error {
 	if err := validateUnbondSlashingSignerSetTxsWindow(p.UnbondSlashingSignerSetTxsWindow); err != nil {
 		return sdkerrors.Wrap(err, "unbond slashing signersettx window")
 	}
+	if err := validateMintRateLimits(p.MintRateLimits); err != nil {
+		return sdkerrors.Wrap(err, "mint rate limits")
+	}
+	if err := validateMintRateLimitWindow(p.MintRateLimitWindow); err != nil {
+		return sdkerrors.Wrap(err, "mint rate limit window")
+	}
+	if err := validateBridgeProposalQuorum(p.BridgeProposalQuorum); err != nil {
+		return sdkerrors.Wrap(err, "bridge proposal quorum")
+	}
+	if err := validateBridgeProposalThreshold(p.BridgeProposalThreshold); err != nil {
+		return sdkerrors.Wrap(err, "bridge proposal threshold")
+	}
+	if err := validateBatchFeeThresholds(p.BatchFeeThresholds); err != nil {
+		return sdkerrors.Wrap(err, "batch fee thresholds")
+	}
+	if err := validateRelayerRewardFraction(p.RelayerRewardFraction); err != nil {
+		return sdkerrors.Wrap(err, "relayer reward fraction")
+	}
+	if err := validateCircuitBreakerWindow(p.CircuitBreakerWindow); err != nil {
+		return sdkerrors.Wrap(err, "circuit breaker window")
+	}
+	if err := validateCircuitBreakerMultiple(p.CircuitBreakerMultiple); err != nil {
+		return sdkerrors.Wrap(err, "circuit breaker multiple")
+	}
+	if err := validateCircuitBreakerOutflowCaps(p.CircuitBreakerOutflowCaps); err != nil {
+		return sdkerrors.Wrap(err, "circuit breaker outflow caps")
+	}
+	if err := validateBatchMaxTxAge(p.BatchMaxTxAge); err != nil {
+		return sdkerrors.Wrap(err, "batch max tx age")
+	}
+	if err := validateBatchMaxPoolSize(p.BatchMaxPoolSize); err != nil {
+		return sdkerrors.Wrap(err, "batch max pool size")
+	}
+	if err := validateOrchestratorQueryIdentityLifetime(p.OrchestratorQueryIdentityLifetime); err != nil {
+		return sdkerrors.Wrap(err, "orchestrator query identity lifetime")
+	}
+	if err := validateBridgeFeeDenom(p.BridgeFeeDenom); err != nil {
+		return sdkerrors.Wrap(err, "bridge fee denom")
+	}
+	if err := validateDelayedWithdrawalThresholds(p.DelayedWithdrawalThresholds); err != nil {
+		return sdkerrors.Wrap(err, "delayed withdrawal thresholds")
+	}
+	if err := validateDelayedWithdrawalPeriod(p.DelayedWithdrawalPeriod); err != nil {
+		return sdkerrors.Wrap(err, "delayed withdrawal period")
+	}
+	if err := validateSecurityCouncil(p.SecurityCouncil); err != nil {
+		return sdkerrors.Wrap(err, "security council")
+	}
+	if err := validateBatchTimeoutFeeBoost(p.BatchTimeoutFeeBoost); err != nil {
+		return sdkerrors.Wrap(err, "batch timeout fee boost")
+	}
+	if err := validateTimeoutEthereumBlockMargin(p.TimeoutEthereumBlockMargin); err != nil {
+		return sdkerrors.Wrap(err, "timeout ethereum block margin")
+	}
+	if err := validateTimeoutHeightVoteWindow(p.TimeoutHeightVoteWindow); err != nil {
+		return sdkerrors.Wrap(err, "timeout height vote window")
+	}
+	if err := validateMaxBatchTxSize(p.MaxBatchTxSize); err != nil {
+		return sdkerrors.Wrap(err, "max batch tx size")
+	}
+	if err := validateMaxContractCallPayloadBytes(p.MaxContractCallPayloadBytes); err != nil {
+		return sdkerrors.Wrap(err, "max contract call payload bytes")
+	}
+	if err := validateChainFeeBasisPoints(p.ChainFeeBasisPoints); err != nil {
+		return sdkerrors.Wrap(err, "chain fee basis points")
+	}
+	if err := validateChainFeeDestination(p.ChainFeeDestination); err != nil {
+		return sdkerrors.Wrap(err, "chain fee destination")
+	}
+	if err := validateSignerSetRetention(p.SignerSetRetention); err != nil {
+		return sdkerrors.Wrap(err, "signer set retention")
+	}
+	if err := validateTokenAllowlistEnabled(p.TokenAllowlistEnabled); err != nil {
+		return sdkerrors.Wrap(err, "token allowlist enabled")
+	}
+	if err := validateTokenAllowlist(p.TokenAllowlist); err != nil {
+		return sdkerrors.Wrap(err, "token allowlist")
+	}
+	if err := validateExecutedBatchRetention(p.ExecutedBatchRetention); err != nil {
+		return sdkerrors.Wrap(err, "executed batch retention")
+	}
+	if err := validateEventVotePowerThreshold(p.EventVotePowerThreshold); err != nil {
+		return sdkerrors.Wrap(err, "event vote power threshold")
+	}
+	if err := validateEthereumEventConfirmationDepth(p.EthereumEventConfirmationDepth); err != nil {
+		return sdkerrors.Wrap(err, "ethereum event confirmation depth")
+	}
+	if err := validateNativeEtherDenom(p.NativeEtherDenom); err != nil {
+		return sdkerrors.Wrap(err, "native ether denom")
+	}
+	if err := validateBridgeDirectionEnabled(p.InboundEnabled); err != nil {
+		return sdkerrors.Wrap(err, "inbound enabled")
+	}
+	if err := validateBridgeDirectionEnabled(p.OutboundEnabled); err != nil {
+		return sdkerrors.Wrap(err, "outbound enabled")
+	}
+	if err := validateMaxPendingSendToEthereumPerAccount(p.MaxPendingSendToEthereumPerAccount); err != nil {
+		return sdkerrors.Wrap(err, "max pending send to ethereum per account")
+	}
+	if err := validatePowerReduction(p.PowerReduction); err != nil {
+		return sdkerrors.Wrap(err, "power reduction")
+	}
+	if err := validateSignerSetPowerChangeThresholdBps(p.SignerSetPowerChangeThresholdBps); err != nil {
+		return sdkerrors.Wrap(err, "signer set power change threshold bps")
+	}
+	if err := validateMaxBlocksBetweenSignerSets(p.MaxBlocksBetweenSignerSets); err != nil {
+		return sdkerrors.Wrap(err, "max blocks between signer sets")
+	}
+	if err := validateMaxTxsPerSenderPerBatch(p.MaxTxsPerSenderPerBatch); err != nil {
+		return sdkerrors.Wrap(err, "max txs per sender per batch")
+	}
+	if err := validateFeeOnTransferTokens(p.FeeOnTransferTokens); err != nil {
+		return sdkerrors.Wrap(err, "fee on transfer tokens")
+	}
+	if err := validateEthereumHeaderValidationEnabled(p.EthereumHeaderValidationEnabled); err != nil {
+		return sdkerrors.Wrap(err, "ethereum header validation enabled")
+	}
+	if err := validateEthereumHeaderCheckpointInterval(p.EthereumHeaderCheckpointInterval); err != nil {
+		return sdkerrors.Wrap(err, "ethereum header checkpoint interval")
+	}
+	if err := validateOrchestratorFreezeCooldown(p.OrchestratorFreezeCooldown); err != nil {
+		return sdkerrors.Wrap(err, "orchestrator freeze cooldown")
+	}
+	if err := validateContractCallRefundRetention(p.ContractCallRefundRetention); err != nil {
+		return sdkerrors.Wrap(err, "contract call refund retention")
+	}
 
 	return nil
 }
 
+// Validate checks that the parameters have valid values on their own and
+// together. Each parameter is validated on its own when set, so a parameter
+// change can still leave them inconsistent, e.g. an average ethereum block
+// time above the target timeout makes outgoing txs time out on creation.
+func (p Params) Validate() error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	if p.TargetEthTxTimeout <= 2*p.AverageEthereumBlockTime {
+		return sdkerrors.Wrapf(
+			ErrInvalidParams,
+			"target eth tx timeout %d must be more than twice the average ethereum block time %d",
+			p.TargetEthTxTimeout, p.AverageEthereumBlockTime,
+		)
+	}
+	return nil
+}
+
 // ParamKeyTable for auth module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -232,6 +870,52 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreBatchCreationPeriod, &p.BatchCreationPeriod, validateBatchCreationPeriod),
 		paramtypes.NewParamSetPair(ParamStoreBatchMaxElement, &p.BatchMaxElement, validateBatchMaxElement),
 		paramtypes.NewParamSetPair(ParamStoreObserveEthereumHeightPeriod, &p.ObserveEthereumHeightPeriod, validateObserveEthereumHeightPeriod),
+		paramtypes.NewParamSetPair(ParamStoreMirrorMode, &p.MirrorMode, validateMirrorMode),
+		paramtypes.NewParamSetPair(ParamStoreMintRateLimits, &p.MintRateLimits, validateMintRateLimits),
+		paramtypes.NewParamSetPair(ParamStoreMintRateLimitWindow, &p.MintRateLimitWindow, validateMintRateLimitWindow),
+		paramtypes.NewParamSetPair(ParamStoreBridgeProposalQuorum, &p.BridgeProposalQuorum, validateBridgeProposalQuorum),
+		paramtypes.NewParamSetPair(ParamStoreBridgeProposalThreshold, &p.BridgeProposalThreshold, validateBridgeProposalThreshold),
+		paramtypes.NewParamSetPair(ParamStoreBatchFeeThresholds, &p.BatchFeeThresholds, validateBatchFeeThresholds),
+		paramtypes.NewParamSetPair(ParamStoreRelayerRewardFraction, &p.RelayerRewardFraction, validateRelayerRewardFraction),
+		paramtypes.NewParamSetPair(ParamStoreCircuitBreakerWindow, &p.CircuitBreakerWindow, validateCircuitBreakerWindow),
+		paramtypes.NewParamSetPair(ParamStoreCircuitBreakerMultiple, &p.CircuitBreakerMultiple, validateCircuitBreakerMultiple),
+		paramtypes.NewParamSetPair(ParamStoreCircuitBreakerOutflowCaps, &p.CircuitBreakerOutflowCaps, validateCircuitBreakerOutflowCaps),
+		paramtypes.NewParamSetPair(ParamStoreBatchMaxTxAge, &p.BatchMaxTxAge, validateBatchMaxTxAge),
+		paramtypes.NewParamSetPair(ParamStoreBatchMaxPoolSize, &p.BatchMaxPoolSize, validateBatchMaxPoolSize),
+		paramtypes.NewParamSetPair(ParamStoreOrchestratorQueryIdentityLifetime, &p.OrchestratorQueryIdentityLifetime, validateOrchestratorQueryIdentityLifetime),
+		paramtypes.NewParamSetPair(ParamStoreBridgeFeeDenom, &p.BridgeFeeDenom, validateBridgeFeeDenom),
+		paramtypes.NewParamSetPair(ParamStoreDelayedWithdrawalThresholds, &p.DelayedWithdrawalThresholds, validateDelayedWithdrawalThresholds),
+		paramtypes.NewParamSetPair(ParamStoreDelayedWithdrawalPeriod, &p.DelayedWithdrawalPeriod, validateDelayedWithdrawalPeriod),
+		paramtypes.NewParamSetPair(ParamStoreSecurityCouncil, &p.SecurityCouncil, validateSecurityCouncil),
+		paramtypes.NewParamSetPair(ParamStoreBatchTimeoutFeeBoost, &p.BatchTimeoutFeeBoost, validateBatchTimeoutFeeBoost),
+		paramtypes.NewParamSetPair(ParamStoreTimeoutEthereumBlockMargin, &p.TimeoutEthereumBlockMargin, validateTimeoutEthereumBlockMargin),
+		paramtypes.NewParamSetPair(ParamStoreTimeoutHeightVoteWindow, &p.TimeoutHeightVoteWindow, validateTimeoutHeightVoteWindow),
+		paramtypes.NewParamSetPair(ParamStoreMaxBatchTxSize, &p.MaxBatchTxSize, validateMaxBatchTxSize),
+		paramtypes.NewParamSetPair(ParamStoreMaxContractCallPayloadBytes, &p.MaxContractCallPayloadBytes, validateMaxContractCallPayloadBytes),
+		paramtypes.NewParamSetPair(ParamStoreChainFeeBasisPoints, &p.ChainFeeBasisPoints, validateChainFeeBasisPoints),
+		paramtypes.NewParamSetPair(ParamStoreChainFeeDestination, &p.ChainFeeDestination, validateChainFeeDestination),
+		paramtypes.NewParamSetPair(ParamStoreSignerSetRetention, &p.SignerSetRetention, validateSignerSetRetention),
+		paramtypes.NewParamSetPair(ParamStoreTokenAllowlistEnabled, &p.TokenAllowlistEnabled, validateTokenAllowlistEnabled),
+		paramtypes.NewParamSetPair(ParamStoreTokenAllowlist, &p.TokenAllowlist, validateTokenAllowlist),
+		paramtypes.NewParamSetPair(ParamStoreExecutedBatchRetention, &p.ExecutedBatchRetention, validateExecutedBatchRetention),
+		paramtypes.NewParamSetPair(ParamStoreEventVotePowerThreshold, &p.EventVotePowerThreshold, validateEventVotePowerThreshold),
+		paramtypes.NewParamSetPair(ParamStoreEthereumEventConfirmationDepth, &p.EthereumEventConfirmationDepth, validateEthereumEventConfirmationDepth),
+		paramtypes.NewParamSetPair(ParamStoreNativeEtherDenom, &p.NativeEtherDenom, validateNativeEtherDenom),
+		paramtypes.NewParamSetPair(ParamStoreInboundEnabled, &p.InboundEnabled, validateBridgeDirectionEnabled),
+		paramtypes.NewParamSetPair(ParamStoreOutboundEnabled, &p.OutboundEnabled, validateBridgeDirectionEnabled),
+		paramtypes.NewParamSetPair(ParamStoreMaxPendingSendToEthereumPerAccount, &p.MaxPendingSendToEthereumPerAccount, validateMaxPendingSendToEthereumPerAccount),
+		paramtypes.NewParamSetPair(ParamStoreBatchGasBaseCost, &p.BatchGasBaseCost, validateBatchGasCost),
+		paramtypes.NewParamSetPair(ParamStoreBatchGasPerTransfer, &p.BatchGasPerTransfer, validateBatchGasCost),
+		paramtypes.NewParamSetPair(ParamStoreBatchGasPerSignature, &p.BatchGasPerSignature, validateBatchGasCost),
+		paramtypes.NewParamSetPair(ParamStorePowerReduction, &p.PowerReduction, validatePowerReduction),
+		paramtypes.NewParamSetPair(ParamStoreSignerSetPowerChangeThresholdBps, &p.SignerSetPowerChangeThresholdBps, validateSignerSetPowerChangeThresholdBps),
+		paramtypes.NewParamSetPair(ParamStoreMaxBlocksBetweenSignerSets, &p.MaxBlocksBetweenSignerSets, validateMaxBlocksBetweenSignerSets),
+		paramtypes.NewParamSetPair(ParamStoreMaxTxsPerSenderPerBatch, &p.MaxTxsPerSenderPerBatch, validateMaxTxsPerSenderPerBatch),
+		paramtypes.NewParamSetPair(ParamStoreFeeOnTransferTokens, &p.FeeOnTransferTokens, validateFeeOnTransferTokens),
+		paramtypes.NewParamSetPair(ParamStoreEthereumHeaderValidationEnabled, &p.EthereumHeaderValidationEnabled, validateEthereumHeaderValidationEnabled),
+		paramtypes.NewParamSetPair(ParamStoreEthereumHeaderCheckpointInterval, &p.EthereumHeaderCheckpointInterval, validateEthereumHeaderCheckpointInterval),
+		paramtypes.NewParamSetPair(ParamStoreOrchestratorFreezeCooldown, &p.OrchestratorFreezeCooldown, validateOrchestratorFreezeCooldown),
+		paramtypes.NewParamSetPair(ParamStoreContractCallRefundRetention, &p.ContractCallRefundRetention, validateContractCallRefundRetention),
 	}
 }
 
@@ -253,6 +937,9 @@ func validateGravityID(i interface{}) error {
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if v == "" {
+		return fmt.Errorf("gravity id cannot be empty")
+	}
 	if _, err := strToFixByteArray(v); err != nil {
 		return err
 	}
@@ -301,6 +988,8 @@ func validateAverageEthereumBlockTime(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	} else if val < 100 {
 		return fmt.Errorf("invalid average Ethereum block time, too short for latency limitations")
+	} else if val > 600000 {
+		return fmt.Errorf("invalid average Ethereum block time, more than 10 minutes is too long")
 	}
 	return nil
 }
@@ -428,3 +1117,468 @@ func validateObserveEthereumHeightPeriod(i interface{}) error {
 	}
 	return nil
 }
+
+func validateMirrorMode(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMintRateLimits(i interface{}) error {
+	limits, ok := i.([]MintRateLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, limit := range limits {
+		if err := ValidateEthAddress(limit.TokenContract); err != nil {
+			return sdkerrors.Wrap(err, "token contract")
+		}
+		contract := common.HexToAddress(limit.TokenContract).Hex()
+		if seen[contract] {
+			return fmt.Errorf("duplicate mint rate limit for %s", contract)
+		}
+		seen[contract] = true
+		if limit.Limit.IsNil() || !limit.Limit.IsPositive() {
+			return fmt.Errorf("mint rate limit for %s must be positive", contract)
+		}
+	}
+	return nil
+}
+
+func validateBatchFeeThresholds(i interface{}) error {
+	thresholds, ok := i.([]BatchFeeThreshold)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, threshold := range thresholds {
+		if err := ValidateEthAddress(threshold.TokenContract); err != nil {
+			return sdkerrors.Wrap(err, "token contract")
+		}
+		contract := common.HexToAddress(threshold.TokenContract).Hex()
+		if seen[contract] {
+			return fmt.Errorf("duplicate batch fee threshold for %s", contract)
+		}
+		seen[contract] = true
+		if threshold.Threshold.IsNil() || !threshold.Threshold.IsPositive() {
+			return fmt.Errorf("batch fee threshold for %s must be positive", contract)
+		}
+	}
+	return nil
+}
+
+func validateMintRateLimitWindow(i interface{}) error {
+	if window, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if window == 0 {
+		return fmt.Errorf("cannot be zero")
+	}
+	return nil
+}
+
+func validateBridgeProposalQuorum(i interface{}) error {
+	return validateBridgeProposalFraction(i)
+}
+
+func validateBridgeProposalThreshold(i interface{}) error {
+	return validateBridgeProposalFraction(i)
+}
+
+func validateBridgeProposalFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("must be between 0 and 1, got %s", v)
+	}
+	return nil
+}
+
+func validateRelayerRewardFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("must be between 0 and 1, got %s", v)
+	}
+	return nil
+}
+
+func validateCircuitBreakerWindow(i interface{}) error {
+	if window, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if window == 0 {
+		return fmt.Errorf("cannot be zero")
+	}
+	return nil
+}
+
+func validateCircuitBreakerMultiple(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// zero disables the deviation check, anything else must allow at least the average itself
+	if v.IsNil() || v.IsNegative() || (v.IsPositive() && v.LT(sdk.OneDec())) {
+		return fmt.Errorf("must be zero or at least 1, got %s", v)
+	}
+	return nil
+}
+
+func validateCircuitBreakerOutflowCaps(i interface{}) error {
+	caps, ok := i.([]OutflowCap)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, outflowCap := range caps {
+		if err := ValidateEthAddress(outflowCap.TokenContract); err != nil {
+			return sdkerrors.Wrap(err, "token contract")
+		}
+		contract := common.HexToAddress(outflowCap.TokenContract).Hex()
+		if seen[contract] {
+			return fmt.Errorf("duplicate outflow cap for %s", contract)
+		}
+		seen[contract] = true
+		if outflowCap.Cap.IsNil() || !outflowCap.Cap.IsPositive() {
+			return fmt.Errorf("outflow cap for %s must be positive", contract)
+		}
+	}
+	return nil
+}
+
+func validateBatchMaxTxAge(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateBatchMaxPoolSize(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateOrchestratorQueryIdentityLifetime(i interface{}) error {
+	if lifetime, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if lifetime == 0 {
+		return fmt.Errorf("cannot be zero")
+	}
+	return nil
+}
+
+func validateBridgeFeeDenom(i interface{}) error {
+	denom, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if denom == "" {
+		return nil
+	}
+	return sdk.ValidateDenom(denom)
+}
+
+func validateDelayedWithdrawalThresholds(i interface{}) error {
+	thresholds, ok := i.([]DelayedWithdrawalThreshold)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, threshold := range thresholds {
+		if err := ValidateEthAddress(threshold.TokenContract); err != nil {
+			return sdkerrors.Wrap(err, "token contract")
+		}
+		contract := common.HexToAddress(threshold.TokenContract).Hex()
+		if seen[contract] {
+			return fmt.Errorf("duplicate delayed withdrawal threshold for %s", contract)
+		}
+		seen[contract] = true
+		if threshold.Threshold.IsNil() || !threshold.Threshold.IsPositive() {
+			return fmt.Errorf("delayed withdrawal threshold for %s must be positive", contract)
+		}
+	}
+	return nil
+}
+
+func validateDelayedWithdrawalPeriod(i interface{}) error {
+	if period, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if period == 0 {
+		return fmt.Errorf("cannot be zero")
+	}
+	return nil
+}
+
+func validateSecurityCouncil(i interface{}) error {
+	council, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if council == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(council); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, council)
+	}
+	return nil
+}
+
+func validateBatchTimeoutFeeBoost(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("cannot be negative, got %s", v)
+	}
+	return nil
+}
+
+func validateTimeoutEthereumBlockMargin(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateTimeoutHeightVoteWindow(i interface{}) error {
+	if window, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if window == 0 {
+		return fmt.Errorf("cannot be zero")
+	}
+	return nil
+}
+
+func validateMaxBatchTxSize(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxContractCallPayloadBytes(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateChainFeeBasisPoints(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > ChainFeeBasisPointsMax {
+		return fmt.Errorf("chain fee basis points must be at most %d: %d", ChainFeeBasisPointsMax, v)
+	}
+	return nil
+}
+
+func validateChainFeeDestination(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	switch v {
+	case ChainFeeDestinationCommunityPool, ChainFeeDestinationBurn, ChainFeeDestinationStakers:
+		return nil
+	default:
+		return fmt.Errorf("unknown chain fee destination: %q", v)
+	}
+}
+
+func validateSignerSetRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateTokenAllowlistEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateTokenAllowlist(i interface{}) error {
+	contracts, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, contract := range contracts {
+		if err := ValidateEthAddress(contract); err != nil {
+			return sdkerrors.Wrap(err, "token contract")
+		}
+		contract = common.HexToAddress(contract).Hex()
+		if seen[contract] {
+			return fmt.Errorf("duplicate allowlisted token %s", contract)
+		}
+		seen[contract] = true
+	}
+	return nil
+}
+
+func validateExecutedBatchRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateEventVotePowerThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.LT(MinEventVotePowerThreshold) || v.GT(MaxEventVotePowerThreshold) {
+		return fmt.Errorf("must be between %s and %s, got %s", MinEventVotePowerThreshold, MaxEventVotePowerThreshold, v)
+	}
+	return nil
+}
+
+func validateEthereumEventConfirmationDepth(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateNativeEtherDenom(i interface{}) error {
+	denom, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+	// an ERC20 voucher denom would be looked up as its token contract
+	if _, err := GravityDenomToERC20(denom); err == nil {
+		return fmt.Errorf("cannot be the voucher denom of an ERC20: %s", denom)
+	}
+	return nil
+}
+
+func validateBridgeDirectionEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxPendingSendToEthereumPerAccount(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateBatchGasCost(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePowerReduction(i interface{}) error {
+	powerReduction, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if powerReduction.IsNil() || !powerReduction.IsPositive() {
+		return fmt.Errorf("power reduction must be positive: %s", powerReduction)
+	}
+	return nil
+}
+
+func validateSignerSetPowerChangeThresholdBps(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// the power diff of two signer sets is at most twice the total power
+	if v > 20_000 {
+		return fmt.Errorf("signer set power change threshold bps must be at most 20000: %d", v)
+	}
+	return nil
+}
+
+func validateMaxBlocksBetweenSignerSets(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxTxsPerSenderPerBatch(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateFeeOnTransferTokens(i interface{}) error {
+	contracts, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, contract := range contracts {
+		if err := ValidateEthAddress(contract); err != nil {
+			return sdkerrors.Wrap(err, "token contract")
+		}
+		contract = common.HexToAddress(contract).Hex()
+		if seen[contract] {
+			return fmt.Errorf("duplicate fee on transfer token %s", contract)
+		}
+		seen[contract] = true
+	}
+	return nil
+}
+
+func validateEthereumHeaderValidationEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateEthereumHeaderCheckpointInterval(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("cannot be zero")
+	}
+	return nil
+}
+
+func validateOrchestratorFreezeCooldown(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateContractCallRefundRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
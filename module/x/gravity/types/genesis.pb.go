@@ -86,6 +86,29 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // The slashing fractions for the various gravity related slashing conditions.
 // The first three refer to not submitting a particular message, the third for
 // submitting a different ethereum_signature for the same Ethereum event
+//
+// mirror_mode:
+//
+// When enabled the module keeps observing and attesting Ethereum events, so
+// denom supplies and accounting stay in sync with the bridge contract, but it
+// never creates outgoing txs (signer sets, batches or contract calls) and
+// rejects new sends to Ethereum. This is intended for standby chains that may
+// take over a bridge after a coordinated migration.
+//
+// mint_rate_limits
+// mint_rate_limit_window
+//
+// The maximum amount of each listed ERC20 that may be credited by deposits
+// (SendToCosmos events) within a window of mint_rate_limit_window blocks.
+// Deposits over the limit are queued in order and released at the allowed
+// rate in the EndBlocker. Tokens without a limit are never queued.
+//
+// bridge_proposal_quorum
+// bridge_proposal_threshold
+//
+// Elevated quorum and yes threshold required for bridge-critical governance
+// proposals, i.e. gravity proposals and parameter changes to the gravity
+// subspace, on top of the ordinary gov tally. Zero disables either check.
 type Params struct {
 	GravityId                string `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
 	ContractSourceHash       string `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
@@ -107,6 +130,122 @@ type Params struct {
 	BatchCreationPeriod                       uint64                                 `protobuf:"varint,19,opt,name=batch_creation_period,json=batchCreationPeriod,proto3" json:"batch_creation_period,omitempty"`
 	BatchMaxElement                           uint64                                 `protobuf:"varint,20,opt,name=batch_max_element,json=batchMaxElement,proto3" json:"batch_max_element,omitempty"`
 	ObserveEthereumHeightPeriod               uint64                                 `protobuf:"varint,21,opt,name=observe_ethereum_height_period,json=observeEthereumHeightPeriod,proto3" json:"observe_ethereum_height_period,omitempty"`
+	MirrorMode                                bool                                   `protobuf:"varint,22,opt,name=mirror_mode,json=mirrorMode,proto3" json:"mirror_mode,omitempty"`
+	MintRateLimits                            []MintRateLimit                        `protobuf:"bytes,23,rep,name=mint_rate_limits,json=mintRateLimits,proto3" json:"mint_rate_limits"`
+	MintRateLimitWindow                       uint64                                 `protobuf:"varint,24,opt,name=mint_rate_limit_window,json=mintRateLimitWindow,proto3" json:"mint_rate_limit_window,omitempty"`
+	BridgeProposalQuorum                      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,25,opt,name=bridge_proposal_quorum,json=bridgeProposalQuorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bridge_proposal_quorum"`
+	BridgeProposalThreshold                   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,26,opt,name=bridge_proposal_threshold,json=bridgeProposalThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bridge_proposal_threshold"`
+	BatchFeeThresholds                        []BatchFeeThreshold                    `protobuf:"bytes,27,rep,name=batch_fee_thresholds,json=batchFeeThresholds,proto3" json:"batch_fee_thresholds"`
+	RelayerRewardFraction                     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,28,opt,name=relayer_reward_fraction,json=relayerRewardFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"relayer_reward_fraction"`
+	CircuitBreakerWindow                      uint64                                 `protobuf:"varint,29,opt,name=circuit_breaker_window,json=circuitBreakerWindow,proto3" json:"circuit_breaker_window,omitempty"`
+	CircuitBreakerMultiple                    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,30,opt,name=circuit_breaker_multiple,json=circuitBreakerMultiple,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"circuit_breaker_multiple"`
+	CircuitBreakerOutflowCaps                 []OutflowCap                           `protobuf:"bytes,31,rep,name=circuit_breaker_outflow_caps,json=circuitBreakerOutflowCaps,proto3" json:"circuit_breaker_outflow_caps"`
+	// number of blocks after which the oldest unbatched tx of a token makes the
+	// EndBlocker create a batch for it, zero disables the policy
+	BatchMaxTxAge uint64 `protobuf:"varint,32,opt,name=batch_max_tx_age,json=batchMaxTxAge,proto3" json:"batch_max_tx_age,omitempty"`
+	// number of unbatched txs of a token that makes the EndBlocker create a
+	// batch for it, zero disables the policy
+	BatchMaxPoolSize uint64 `protobuf:"varint,33,opt,name=batch_max_pool_size,json=batchMaxPoolSize,proto3" json:"batch_max_pool_size,omitempty"`
+	// number of blocks an orchestrator query identity is valid for once
+	// registered
+	OrchestratorQueryIdentityLifetime uint64 `protobuf:"varint,34,opt,name=orchestrator_query_identity_lifetime,json=orchestratorQueryIdentityLifetime,proto3" json:"orchestrator_query_identity_lifetime,omitempty"`
+	// denom SendToEthereum fees may be paid in instead of the bridged token,
+	// empty disables it
+	BridgeFeeDenom string `protobuf:"bytes,35,opt,name=bridge_fee_denom,json=bridgeFeeDenom,proto3" json:"bridge_fee_denom,omitempty"`
+	// sends to ethereum of more than the threshold of their token are held in
+	// the delayed send queue before they can be batched
+	DelayedWithdrawalThresholds []DelayedWithdrawalThreshold `protobuf:"bytes,36,rep,name=delayed_withdrawal_thresholds,json=delayedWithdrawalThresholds,proto3" json:"delayed_withdrawal_thresholds"`
+	// number of blocks a delayed send to ethereum is held for, during which it
+	// can be vetoed
+	DelayedWithdrawalPeriod uint64 `protobuf:"varint,37,opt,name=delayed_withdrawal_period,json=delayedWithdrawalPeriod,proto3" json:"delayed_withdrawal_period,omitempty"`
+	// account that may veto delayed sends to ethereum besides governance, empty
+	// leaves it to governance alone
+	SecurityCouncil string `protobuf:"bytes,38,opt,name=security_council,json=securityCouncil,proto3" json:"security_council,omitempty"`
+	// fraction of its fee by which the priority of an unbatched tx is raised
+	// for each time a batch holding it timed out, zero disables it
+	BatchTimeoutFeeBoost github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,39,opt,name=batch_timeout_fee_boost,json=batchTimeoutFeeBoost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"batch_timeout_fee_boost"`
+	// recent ethereum height votes, zero projects the timeout from the average
+	// ethereum block time instead
+	TimeoutEthereumBlockMargin uint64 `protobuf:"varint,40,opt,name=timeout_ethereum_block_margin,json=timeoutEthereumBlockMargin,proto3" json:"timeout_ethereum_block_margin,omitempty"`
+	// number of blocks an ethereum height vote counts towards the median used
+	// for timeouts
+	TimeoutHeightVoteWindow uint64 `protobuf:"varint,41,opt,name=timeout_height_vote_window,json=timeoutHeightVoteWindow,proto3" json:"timeout_height_vote_window,omitempty"`
+	// maximum number of sends to ethereum in a batch, whatever the number of
+	// elements requested, zero means no limit
+	MaxBatchTxSize uint64 `protobuf:"varint,42,opt,name=max_batch_tx_size,json=maxBatchTxSize,proto3" json:"max_batch_tx_size,omitempty"`
+	// maximum size in bytes of the payload of a contract call, zero means no
+	// limit
+	MaxContractCallPayloadBytes uint64 `protobuf:"varint,43,opt,name=max_contract_call_payload_bytes,json=maxContractCallPayloadBytes,proto3" json:"max_contract_call_payload_bytes,omitempty"`
+	// share of the amount of every send to ethereum, in basis points, kept as a
+	// chain fee once its batch executes, zero disables it
+	ChainFeeBasisPoints uint64 `protobuf:"varint,44,opt,name=chain_fee_basis_points,json=chainFeeBasisPoints,proto3" json:"chain_fee_basis_points,omitempty"`
+	// where chain fees go: "community_pool", "burn" or "stakers"
+	ChainFeeDestination string `protobuf:"bytes,45,opt,name=chain_fee_destination,json=chainFeeDestination,proto3" json:"chain_fee_destination,omitempty"`
+	// number of signer set txs below the last observed one kept in state, older
+	// ones are pruned once they can't be slashed for anymore
+	SignerSetRetention uint64 `protobuf:"varint,46,opt,name=signer_set_retention,json=signerSetRetention,proto3" json:"signer_set_retention,omitempty"`
+	// when enabled, only the ERC20s of the token allowlist can be bridged in
+	// either direction, deposits of other tokens are held instead of credited
+	TokenAllowlistEnabled bool `protobuf:"varint,47,opt,name=token_allowlist_enabled,json=tokenAllowlistEnabled,proto3" json:"token_allowlist_enabled,omitempty"`
+	// the ERC20 contracts that can be bridged when the allowlist is enabled
+	TokenAllowlist []string `protobuf:"bytes,48,rep,name=token_allowlist,json=tokenAllowlist,proto3" json:"token_allowlist,omitempty"`
+	// number of blocks the records of executed batches and contract calls are
+	// archived for, no records are kept when zero
+	ExecutedBatchRetention uint64 `protobuf:"varint,49,opt,name=executed_batch_retention,json=executedBatchRetention,proto3" json:"executed_batch_retention,omitempty"`
+	// fraction of the total bonded power the votes for an ethereum event must
+	// reach for it to be observed, between 0.52 and 0.90
+	EventVotePowerThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,50,opt,name=event_vote_power_threshold,json=eventVotePowerThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"event_vote_power_threshold"`
+	// number of ethereum blocks the median ethereum height vote of validators
+	// must exceed the height of an event by before the event is observed, so
+	// that shallow reorgs can't get an event observed, zero disables it
+	EthereumEventConfirmationDepth uint64 `protobuf:"varint,51,opt,name=ethereum_event_confirmation_depth,json=ethereumEventConfirmationDepth,proto3" json:"ethereum_event_confirmation_depth,omitempty"`
+	// denom of the vouchers minted for native ether deposits, which are sent
+	// back to ethereum as the native ether pseudo contract and unwrapped to
+	// ether by the gravity contract
+	NativeEtherDenom string `protobuf:"bytes,52,opt,name=native_ether_denom,json=nativeEtherDenom,proto3" json:"native_ether_denom,omitempty"`
+	// inbound_enabled and outbound_enabled pause a single direction of the
+	// bridge while bridge_active is set. Deposits observed while inbound is
+	// disabled wait in the mint queue, sends to ethereum and batches are
+	// refused while outbound is disabled.
+	InboundEnabled  bool `protobuf:"varint,53,opt,name=inbound_enabled,json=inboundEnabled,proto3" json:"inbound_enabled,omitempty"`
+	OutboundEnabled bool `protobuf:"varint,54,opt,name=outbound_enabled,json=outboundEnabled,proto3" json:"outbound_enabled,omitempty"`
+	// maximum number of unbatched sends to ethereum an account may have in the
+	// pool, zero means no limit
+	MaxPendingSendToEthereumPerAccount uint64 `protobuf:"varint,55,opt,name=max_pending_send_to_ethereum_per_account,json=maxPendingSendToEthereumPerAccount,proto3" json:"max_pending_send_to_ethereum_per_account,omitempty"`
+	// costs in ethereum gas the gas estimate of batches is computed from: a base
+	// cost, a cost per transfer and a cost per signature of the signer set
+	BatchGasBaseCost     uint64 `protobuf:"varint,56,opt,name=batch_gas_base_cost,json=batchGasBaseCost,proto3" json:"batch_gas_base_cost,omitempty"`
+	BatchGasPerTransfer  uint64 `protobuf:"varint,57,opt,name=batch_gas_per_transfer,json=batchGasPerTransfer,proto3" json:"batch_gas_per_transfer,omitempty"`
+	BatchGasPerSignature uint64 `protobuf:"varint,58,opt,name=batch_gas_per_signature,json=batchGasPerSignature,proto3" json:"batch_gas_per_signature,omitempty"`
+	// tokens per unit of power of the validators in signer sets, a multiple of
+	// the staking power reduction so that signer sets can be made coarser than
+	// the consensus power
+	PowerReduction github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,59,opt,name=power_reduction,json=powerReduction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"power_reduction"`
+	// change in the power of the signers, in basis points of the total power,
+	// from the latest signer set tx above which a new one is created
+	SignerSetPowerChangeThresholdBps uint64 `protobuf:"varint,60,opt,name=signer_set_power_change_threshold_bps,json=signerSetPowerChangeThresholdBps,proto3" json:"signer_set_power_change_threshold_bps,omitempty"`
+	// blocks after the latest signer set tx a new one is created even if the
+	// power barely changed, zero means never
+	MaxBlocksBetweenSignerSets uint64 `protobuf:"varint,61,opt,name=max_blocks_between_signer_sets,json=maxBlocksBetweenSignerSets,proto3" json:"max_blocks_between_signer_sets,omitempty"`
+	// maximum number of txs of a single sender a batch may hold, zero means no
+	// limit
+	MaxTxsPerSenderPerBatch uint64 `protobuf:"varint,62,opt,name=max_txs_per_sender_per_batch,json=maxTxsPerSenderPerBatch,proto3" json:"max_txs_per_sender_per_batch,omitempty"`
+	// the ERC20 contracts that take a fee on transfer or rebase, deposits of
+	// which credit the amount the gravity contract received and which can't be
+	// sent back to ethereum
+	FeeOnTransferTokens []string `protobuf:"bytes,63,rep,name=fee_on_transfer_tokens,json=feeOnTransferTokens,proto3" json:"fee_on_transfer_tokens,omitempty"`
+	// when enabled, orchestrators vote on the ethereum headers at checkpoint
+	// heights and ethereum events claiming a block beyond the agreed head are
+	// rejected
+	EthereumHeaderValidationEnabled bool `protobuf:"varint,64,opt,name=ethereum_header_validation_enabled,json=ethereumHeaderValidationEnabled,proto3" json:"ethereum_header_validation_enabled,omitempty"`
+	// number of ethereum blocks between the checkpoints headers are voted at
+	EthereumHeaderCheckpointInterval uint64 `protobuf:"varint,65,opt,name=ethereum_header_checkpoint_interval,json=ethereumHeaderCheckpointInterval,proto3" json:"ethereum_header_checkpoint_interval,omitempty"`
+	// number of blocks after an orchestrator freeze before the validator can
+	// set new delegate keys
+	OrchestratorFreezeCooldown uint64 `protobuf:"varint,66,opt,name=orchestrator_freeze_cooldown,json=orchestratorFreezeCooldown,proto3" json:"orchestrator_freeze_cooldown,omitempty"`
+	// number of blocks the records of refunded contract calls are archived for,
+	// they are kept forever when zero
+	ContractCallRefundRetention uint64 `protobuf:"varint,67,opt,name=contract_call_refund_retention,json=contractCallRefundRetention,proto3" json:"contract_call_refund_retention,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -247,743 +386,6976 @@ func (m *Params) GetObserveEthereumHeightPeriod() uint64 {
 	return 0
 }
 
-// GenesisState struct
-// TODO: this need to be audited and potentially simplified using the new
-// interfaces
-type GenesisState struct {
-	Params                     *Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	LastObservedEventNonce     uint64                     `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
-	OutgoingTxs                []*types.Any               `protobuf:"bytes,3,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs,omitempty"`
-	Confirmations              []*types.Any               `protobuf:"bytes,4,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
-	EthereumEventVoteRecords   []*EthereumEventVoteRecord `protobuf:"bytes,9,rep,name=ethereum_event_vote_records,json=ethereumEventVoteRecords,proto3" json:"ethereum_event_vote_records,omitempty"`
-	DelegateKeys               []*MsgDelegateKeys         `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
-	Erc20ToDenoms              []*ERC20ToDenom            `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
-	UnbatchedSendToEthereumTxs []*SendToEthereum          `protobuf:"bytes,12,rep,name=unbatched_send_to_ethereum_txs,json=unbatchedSendToEthereumTxs,proto3" json:"unbatched_send_to_ethereum_txs,omitempty"`
+func (m *Params) GetMirrorMode() bool {
+	if m != nil {
+		return m.MirrorMode
+	}
+	return false
 }
 
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{1}
-}
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *Params) GetMintRateLimits() []MintRateLimit {
+	if m != nil {
+		return m.MintRateLimits
+	}
+	return nil
 }
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Params) GetMintRateLimitWindow() uint64 {
+	if m != nil {
+		return m.MintRateLimitWindow
 	}
+	return 0
 }
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
+
+func (m *Params) GetBatchFeeThresholds() []BatchFeeThreshold {
+	if m != nil {
+		return m.BatchFeeThresholds
+	}
+	return nil
 }
-func (m *GenesisState) XXX_Size() int {
-	return m.Size()
+
+func (m *Params) GetCircuitBreakerWindow() uint64 {
+	if m != nil {
+		return m.CircuitBreakerWindow
+	}
+	return 0
 }
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+
+func (m *Params) GetCircuitBreakerOutflowCaps() []OutflowCap {
+	if m != nil {
+		return m.CircuitBreakerOutflowCaps
+	}
+	return nil
 }
 
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+func (m *Params) GetBatchMaxTxAge() uint64 {
+	if m != nil {
+		return m.BatchMaxTxAge
+	}
+	return 0
+}
 
-func (m *GenesisState) GetParams() *Params {
+func (m *Params) GetBatchMaxPoolSize() uint64 {
 	if m != nil {
-		return m.Params
+		return m.BatchMaxPoolSize
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetLastObservedEventNonce() uint64 {
+func (m *Params) GetOrchestratorQueryIdentityLifetime() uint64 {
 	if m != nil {
-		return m.LastObservedEventNonce
+		return m.OrchestratorQueryIdentityLifetime
 	}
 	return 0
 }
 
-func (m *GenesisState) GetOutgoingTxs() []*types.Any {
+func (m *Params) GetBridgeFeeDenom() string {
 	if m != nil {
-		return m.OutgoingTxs
+		return m.BridgeFeeDenom
 	}
-	return nil
+	return ""
 }
 
-func (m *GenesisState) GetConfirmations() []*types.Any {
+func (m *Params) GetDelayedWithdrawalThresholds() []DelayedWithdrawalThreshold {
 	if m != nil {
-		return m.Confirmations
+		return m.DelayedWithdrawalThresholds
 	}
 	return nil
 }
 
-func (m *GenesisState) GetEthereumEventVoteRecords() []*EthereumEventVoteRecord {
+func (m *Params) GetDelayedWithdrawalPeriod() uint64 {
 	if m != nil {
-		return m.EthereumEventVoteRecords
+		return m.DelayedWithdrawalPeriod
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetDelegateKeys() []*MsgDelegateKeys {
+func (m *Params) GetSecurityCouncil() string {
 	if m != nil {
-		return m.DelegateKeys
+		return m.SecurityCouncil
 	}
-	return nil
+	return ""
 }
 
-func (m *GenesisState) GetErc20ToDenoms() []*ERC20ToDenom {
+func (m *Params) GetTimeoutEthereumBlockMargin() uint64 {
 	if m != nil {
-		return m.Erc20ToDenoms
+		return m.TimeoutEthereumBlockMargin
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetUnbatchedSendToEthereumTxs() []*SendToEthereum {
+func (m *Params) GetTimeoutHeightVoteWindow() uint64 {
 	if m != nil {
-		return m.UnbatchedSendToEthereumTxs
+		return m.TimeoutHeightVoteWindow
 	}
-	return nil
+	return 0
 }
 
-// This records the relationship between an ERC20 token and the denom
-// of the corresponding Cosmos originated asset
-type ERC20ToDenom struct {
-	Erc20 string `protobuf:"bytes,1,opt,name=erc20,proto3" json:"erc20,omitempty"`
-	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+func (m *Params) GetMaxBatchTxSize() uint64 {
+	if m != nil {
+		return m.MaxBatchTxSize
+	}
+	return 0
 }
 
-func (m *ERC20ToDenom) Reset()         { *m = ERC20ToDenom{} }
-func (m *ERC20ToDenom) String() string { return proto.CompactTextString(m) }
-func (*ERC20ToDenom) ProtoMessage()    {}
-func (*ERC20ToDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{2}
+func (m *Params) GetMaxContractCallPayloadBytes() uint64 {
+	if m != nil {
+		return m.MaxContractCallPayloadBytes
+	}
+	return 0
 }
-func (m *ERC20ToDenom) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *Params) GetChainFeeBasisPoints() uint64 {
+	if m != nil {
+		return m.ChainFeeBasisPoints
+	}
+	return 0
 }
-func (m *ERC20ToDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ERC20ToDenom.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Params) GetChainFeeDestination() string {
+	if m != nil {
+		return m.ChainFeeDestination
 	}
+	return ""
 }
-func (m *ERC20ToDenom) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ERC20ToDenom.Merge(m, src)
+
+func (m *Params) GetSignerSetRetention() uint64 {
+	if m != nil {
+		return m.SignerSetRetention
+	}
+	return 0
 }
-func (m *ERC20ToDenom) XXX_Size() int {
-	return m.Size()
+
+func (m *Params) GetTokenAllowlistEnabled() bool {
+	if m != nil {
+		return m.TokenAllowlistEnabled
+	}
+	return false
 }
-func (m *ERC20ToDenom) XXX_DiscardUnknown() {
-	xxx_messageInfo_ERC20ToDenom.DiscardUnknown(m)
+
+func (m *Params) GetTokenAllowlist() []string {
+	if m != nil {
+		return m.TokenAllowlist
+	}
+	return nil
 }
 
-var xxx_messageInfo_ERC20ToDenom proto.InternalMessageInfo
+func (m *Params) GetExecutedBatchRetention() uint64 {
+	if m != nil {
+		return m.ExecutedBatchRetention
+	}
+	return 0
+}
 
-func (m *ERC20ToDenom) GetErc20() string {
+func (m *Params) GetEthereumEventConfirmationDepth() uint64 {
 	if m != nil {
-		return m.Erc20
+		return m.EthereumEventConfirmationDepth
 	}
-	return ""
+	return 0
 }
 
-func (m *ERC20ToDenom) GetDenom() string {
+func (m *Params) GetNativeEtherDenom() string {
 	if m != nil {
-		return m.Denom
+		return m.NativeEtherDenom
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
-	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
-	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+func (m *Params) GetInboundEnabled() bool {
+	if m != nil {
+		return m.InboundEnabled
+	}
+	return false
 }
 
-func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
-
-var fileDescriptor_387b0aba880adb60 = []byte{
-	// 1001 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0x8e, 0x69, 0x1a, 0xda, 0x89, 0x43, 0xda, 0xa9, 0x03, 0x5b, 0xa7, 0xb8, 0x26, 0x15, 0x55,
-	0xa8, 0x88, 0x9d, 0x18, 0x09, 0x44, 0xf8, 0x51, 0xf3, 0x07, 0xad, 0x50, 0x69, 0xb5, 0x36, 0x20,
-	0x71, 0xc1, 0x30, 0xde, 0x39, 0xd9, 0x5d, 0xe2, 0x9d, 0x89, 0x76, 0xc6, 0xae, 0x7d, 0xc7, 0x03,
-	0x70, 0xd1, 0x67, 0xe1, 0x29, 0x7a, 0xd9, 0x4b, 0x84, 0x50, 0x85, 0x92, 0x17, 0x41, 0x73, 0x66,
-	0xd6, 0x59, 0xa7, 0xe1, 0x26, 0x57, 0xf6, 0x9c, 0xef, 0xfb, 0xce, 0x77, 0xf6, 0x9c, 0x9d, 0xb3,
-	0x24, 0x88, 0x73, 0x3e, 0x4a, 0xcd, 0xa4, 0x3d, 0xda, 0x6a, 0xc7, 0x20, 0x41, 0xa7, 0xba, 0x75,
-	0x9c, 0x2b, 0xa3, 0x28, 0xf1, 0x48, 0x6b, 0xb4, 0x55, 0xaf, 0xc5, 0x2a, 0x56, 0x18, 0x6e, 0xdb,
-	0x7f, 0x8e, 0x51, 0x9f, 0xd1, 0x7a, 0xb2, 0x43, 0x56, 0x4a, 0x48, 0xa6, 0x63, 0x9f, 0xb2, 0x7e,
-	0x3b, 0x56, 0x2a, 0x1e, 0x40, 0x1b, 0x4f, 0xfd, 0xe1, 0x61, 0x9b, 0x4b, 0xaf, 0x58, 0xfb, 0x83,
-	0x90, 0x85, 0x67, 0x3c, 0xe7, 0x99, 0xa6, 0xef, 0x93, 0xc2, 0x9a, 0xa5, 0x22, 0xa8, 0x34, 0x2b,
-	0xeb, 0xd7, 0xc3, 0xeb, 0x3e, 0xf2, 0x58, 0xd0, 0x4d, 0x52, 0x8b, 0x94, 0x34, 0x39, 0x8f, 0x0c,
-	0xd3, 0x6a, 0x98, 0x47, 0xc0, 0x12, 0xae, 0x93, 0xe0, 0x2d, 0x24, 0xd2, 0x02, 0xeb, 0x22, 0xf4,
-	0x88, 0xeb, 0x84, 0x7e, 0x4a, 0xde, 0xeb, 0xe7, 0xa9, 0x88, 0x81, 0x81, 0x49, 0x20, 0x87, 0x61,
-	0xc6, 0xb8, 0x10, 0x39, 0x68, 0x1d, 0xcc, 0xa3, 0x68, 0xc5, 0xc1, 0x07, 0x1e, 0xdd, 0x71, 0x20,
-	0xbd, 0x4f, 0x96, 0xbd, 0x2e, 0x4a, 0x78, 0x2a, 0x6d, 0x35, 0x57, 0x9b, 0x95, 0xf5, 0xf9, 0x70,
-	0xc9, 0x85, 0xf7, 0x6c, 0xf4, 0xb1, 0xa0, 0x5f, 0x93, 0x3b, 0x3a, 0x8d, 0x25, 0x08, 0x86, 0x3f,
-	0x39, 0xd3, 0x60, 0x98, 0x19, 0x6b, 0xf6, 0x3c, 0x95, 0x42, 0x3d, 0x0f, 0x16, 0x50, 0x14, 0x38,
-	0x4e, 0x17, 0x29, 0x5d, 0x30, 0xbd, 0xb1, 0xfe, 0x09, 0x71, 0xda, 0x21, 0x2b, 0x5e, 0xdf, 0xe7,
-	0x26, 0x4a, 0x60, 0x2a, 0x7c, 0x1b, 0x85, 0xb7, 0x1c, 0xb8, 0xeb, 0x30, 0xaf, 0xf9, 0x92, 0xd4,
-	0xa7, 0x0f, 0x63, 0x71, 0x6e, 0x86, 0xf9, 0x99, 0xf0, 0x9a, 0x73, 0x2c, 0x18, 0xdd, 0x29, 0xc1,
-	0xab, 0xb7, 0xc8, 0x8a, 0xe1, 0x79, 0x0c, 0xc6, 0x76, 0x84, 0x99, 0x31, 0x33, 0x69, 0x06, 0x6a,
-	0x68, 0x02, 0x82, 0x42, 0xea, 0xc0, 0x03, 0x93, 0xf4, 0xc6, 0x3d, 0x87, 0xd0, 0x8f, 0x09, 0xe5,
-	0x23, 0xc8, 0x79, 0x0c, 0xac, 0x3f, 0x50, 0xd1, 0x11, 0x4a, 0x82, 0x45, 0xe4, 0xdf, 0xf0, 0xc8,
-	0xae, 0x05, 0xac, 0x80, 0x7e, 0x45, 0x56, 0x0b, 0xf6, 0xb4, 0xcc, 0x92, 0xac, 0xea, 0xea, 0xf3,
-	0x94, 0xa2, 0xef, 0x67, 0x72, 0x49, 0xee, 0xe8, 0x01, 0xd7, 0x09, 0x3b, 0xb4, 0xa3, 0x4c, 0x95,
-	0x9c, 0xed, 0x6c, 0xb0, 0xd4, 0xac, 0xac, 0x57, 0x77, 0x5b, 0x2f, 0x5f, 0xdf, 0x9d, 0xfb, 0xfb,
-	0xf5, 0xdd, 0xfb, 0x71, 0x6a, 0x92, 0x61, 0xbf, 0x15, 0xa9, 0xac, 0x1d, 0x29, 0x9d, 0x29, 0xed,
-	0x7f, 0x36, 0xb4, 0x38, 0x6a, 0x9b, 0xc9, 0x31, 0xe8, 0xd6, 0x3e, 0x44, 0x61, 0x80, 0x39, 0xbf,
-	0xf1, 0x29, 0x4b, 0x83, 0xa0, 0xbf, 0x92, 0xda, 0x39, 0x3f, 0x9c, 0x44, 0xf0, 0xce, 0xa5, 0x7c,
-	0xe8, 0x8c, 0x0f, 0xce, 0x8d, 0x4e, 0xc8, 0x07, 0xe7, 0x1c, 0xde, 0x1c, 0x5f, 0xb0, 0x7c, 0x29,
-	0xbb, 0xc6, 0x8c, 0xdd, 0xc1, 0xf9, 0x99, 0xd3, 0x17, 0x15, 0xb2, 0x71, 0xce, 0x3b, 0x52, 0xf2,
-	0x70, 0x90, 0x46, 0x26, 0x95, 0xf1, 0x45, 0x75, 0xdc, 0xb8, 0x54, 0x1d, 0x1f, 0xcd, 0xd4, 0xb1,
-	0x77, 0x66, 0xf1, 0x66, 0x49, 0x4f, 0xc9, 0x87, 0x43, 0xd9, 0x57, 0x52, 0x30, 0xd4, 0xd8, 0x32,
-	0x2e, 0xbe, 0x3a, 0x37, 0xf1, 0x45, 0x69, 0x3a, 0x72, 0xd7, 0x73, 0x2f, 0xb8, 0x42, 0xf7, 0x88,
-	0xbf, 0x93, 0xcc, 0xba, 0x8f, 0x20, 0xa0, 0xcd, 0xca, 0xfa, 0xb5, 0xb0, 0xea, 0x82, 0x3b, 0x18,
-	0xb3, 0xf7, 0x0c, 0xc7, 0xca, 0xa2, 0x1c, 0x38, 0xf6, 0xe1, 0x18, 0xf2, 0x54, 0x89, 0xe0, 0x96,
-	0xbb, 0x67, 0x08, 0xee, 0x79, 0xec, 0x19, 0x42, 0xf4, 0x01, 0xb9, 0xe9, 0x34, 0x19, 0x1f, 0x33,
-	0x18, 0x40, 0x06, 0xd2, 0x04, 0x35, 0xe4, 0x2f, 0x23, 0xf0, 0x84, 0x8f, 0x0f, 0x5c, 0x98, 0xee,
-	0x91, 0x86, 0xea, 0x6b, 0xc8, 0x47, 0xa5, 0x97, 0x3e, 0x81, 0x34, 0x4e, 0x4c, 0x61, 0xb4, 0x82,
-	0xc2, 0x55, 0xcf, 0x2a, 0xfa, 0xf2, 0x08, 0x39, 0xce, 0x70, 0x7b, 0xfe, 0xf7, 0x7f, 0x9a, 0x73,
-	0x6b, 0x7f, 0xce, 0x93, 0xea, 0xb7, 0x6e, 0x1d, 0x77, 0x0d, 0x37, 0x40, 0x1f, 0x90, 0x85, 0x63,
-	0x5c, 0x8f, 0xb8, 0x10, 0x17, 0x3b, 0xb4, 0x75, 0xb6, 0x9e, 0x5b, 0x6e, 0x71, 0x86, 0x9e, 0x41,
-	0x3f, 0x27, 0xb7, 0x07, 0x5c, 0x1b, 0xe6, 0x6d, 0x04, 0x83, 0x11, 0x48, 0xc3, 0xa4, 0x92, 0x11,
-	0xe0, 0x9a, 0x9c, 0x0f, 0xdf, 0xb5, 0x84, 0xa7, 0x1e, 0x3f, 0xb0, 0xf0, 0xf7, 0x16, 0xa5, 0x9f,
-	0x91, 0xaa, 0x1a, 0x9a, 0x58, 0xd9, 0x89, 0x98, 0xb1, 0x0e, 0xae, 0x34, 0xaf, 0xac, 0x2f, 0x76,
-	0x6a, 0x2d, 0xb7, 0xb8, 0x5b, 0xc5, 0xe2, 0x6e, 0xed, 0xc8, 0x49, 0xb8, 0x58, 0x30, 0x7b, 0x63,
-	0x4d, 0xb7, 0xc9, 0x92, 0x7d, 0xa9, 0xd2, 0x3c, 0xc3, 0xee, 0xd9, 0xcd, 0xfa, 0xff, 0xca, 0x59,
-	0x2a, 0xed, 0x93, 0xd5, 0x69, 0xbf, 0x5c, 0xa9, 0x23, 0x65, 0x80, 0xe5, 0x10, 0xa9, 0x5c, 0xe8,
-	0xe0, 0x3a, 0x66, 0xba, 0x57, 0x7e, 0xe0, 0xa2, 0x73, 0x58, 0xf9, 0x8f, 0xca, 0x40, 0x88, 0xdc,
-	0xb3, 0x8d, 0x77, 0x0e, 0xd0, 0xf4, 0x21, 0x59, 0x12, 0x30, 0x80, 0x98, 0x1b, 0x60, 0x47, 0x30,
-	0xd1, 0x01, 0xc1, 0xac, 0xab, 0xe5, 0xac, 0x4f, 0x74, 0xbc, 0xef, 0x39, 0xdf, 0xc1, 0x44, 0x87,
-	0x55, 0x51, 0x3a, 0xd1, 0x87, 0x64, 0x19, 0xf2, 0xa8, 0xb3, 0xc9, 0x8c, 0x62, 0x02, 0xa4, 0xca,
-	0x74, 0xb0, 0x88, 0x39, 0x82, 0x99, 0xca, 0xc2, 0xbd, 0xce, 0x66, 0x4f, 0xed, 0x5b, 0x42, 0xb8,
-	0x84, 0x02, 0x7f, 0xd2, 0xf4, 0x17, 0xd2, 0x18, 0x4a, 0xb7, 0xe2, 0x05, 0xd3, 0x20, 0x85, 0x4d,
-	0x35, 0x7d, 0x72, 0xdb, 0xee, 0x2a, 0x26, 0xac, 0x97, 0x13, 0x76, 0x41, 0x8a, 0x9e, 0x2a, 0x1e,
-	0x38, 0xac, 0x4f, 0x33, 0xcc, 0x02, 0xbd, 0xb1, 0x5e, 0xdb, 0x26, 0xd5, 0xb2, 0x3d, 0xad, 0x91,
-	0xab, 0x58, 0x80, 0xff, 0x86, 0xba, 0x83, 0x8d, 0x62, 0xf9, 0xfe, 0x83, 0xe9, 0x0e, 0xbb, 0x3f,
-	0xbc, 0x3c, 0x69, 0x54, 0x5e, 0x9d, 0x34, 0x2a, 0xff, 0x9e, 0x34, 0x2a, 0x2f, 0x4e, 0x1b, 0x73,
-	0xaf, 0x4e, 0x1b, 0x73, 0x7f, 0x9d, 0x36, 0xe6, 0x7e, 0xfe, 0xa2, 0x74, 0xfd, 0x8f, 0x21, 0x8e,
-	0x27, 0xbf, 0x8d, 0x8a, 0xaf, 0xfd, 0x86, 0xbb, 0x5e, 0xed, 0x4c, 0x89, 0xe1, 0x00, 0xda, 0xa3,
-	0x4e, 0x7b, 0x5c, 0x40, 0x6e, 0x2f, 0xf4, 0x17, 0x70, 0xee, 0x9f, 0xfc, 0x17, 0x00, 0x00, 0xff,
-	0xff, 0x99, 0xaf, 0x83, 0x3a, 0x67, 0x08, 0x00, 0x00,
+func (m *Params) GetOutboundEnabled() bool {
+	if m != nil {
+		return m.OutboundEnabled
+	}
+	return false
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetMaxPendingSendToEthereumPerAccount() uint64 {
+	if m != nil {
+		return m.MaxPendingSendToEthereumPerAccount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetBatchGasBaseCost() uint64 {
+	if m != nil {
+		return m.BatchGasBaseCost
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ObserveEthereumHeightPeriod != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.ObserveEthereumHeightPeriod))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
+func (m *Params) GetBatchGasPerTransfer() uint64 {
+	if m != nil {
+		return m.BatchGasPerTransfer
 	}
-	if m.BatchMaxElement != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchMaxElement))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa0
+	return 0
+}
+
+func (m *Params) GetBatchGasPerSignature() uint64 {
+	if m != nil {
+		return m.BatchGasPerSignature
 	}
-	if m.BatchCreationPeriod != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchCreationPeriod))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
+	return 0
+}
+
+func (m *Params) GetSignerSetPowerChangeThresholdBps() uint64 {
+	if m != nil {
+		return m.SignerSetPowerChangeThresholdBps
 	}
-	if m.BridgeActive {
-		i--
-		if m.BridgeActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
+	return 0
+}
+
+func (m *Params) GetMaxBlocksBetweenSignerSets() uint64 {
+	if m != nil {
+		return m.MaxBlocksBetweenSignerSets
 	}
-	if m.UnbondSlashingSignerSetTxsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingSignerSetTxsWindow))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
+	return 0
+}
+
+func (m *Params) GetMaxTxsPerSenderPerBatch() uint64 {
+	if m != nil {
+		return m.MaxTxsPerSenderPerBatch
 	}
-	{
-		size := m.SlashFractionConflictingEthereumSignature.Size()
-		i -= size
-		if _, err := m.SlashFractionConflictingEthereumSignature.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *Params) GetFeeOnTransferTokens() []string {
+	if m != nil {
+		return m.FeeOnTransferTokens
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	{
-		size := m.SlashFractionEthereumSignature.Size()
-		i -= size
-		if _, err := m.SlashFractionEthereumSignature.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x7a
-	{
-		size := m.SlashFractionBatch.Size()
-		i -= size
-		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.SlashFractionSignerSetTx.Size()
-		i -= size
-		if _, err := m.SlashFractionSignerSetTx.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x6a
-	if m.AverageEthereumBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.AverageBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.TargetEthTxTimeout != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetEthTxTimeout))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.EthereumSignaturesWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumSignaturesWindow))
-		i--
-		dAtA[i] = 0x40
+	return nil
+}
+
+func (m *Params) GetEthereumHeaderValidationEnabled() bool {
+	if m != nil {
+		return m.EthereumHeaderValidationEnabled
 	}
-	if m.SignedBatchesWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
-		i--
-		dAtA[i] = 0x38
+	return false
+}
+
+func (m *Params) GetEthereumHeaderCheckpointInterval() uint64 {
+	if m != nil {
+		return m.EthereumHeaderCheckpointInterval
 	}
-	if m.SignedSignerSetTxsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedSignerSetTxsWindow))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *Params) GetOrchestratorFreezeCooldown() uint64 {
+	if m != nil {
+		return m.OrchestratorFreezeCooldown
 	}
-	if m.BridgeChainId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *Params) GetContractCallRefundRetention() uint64 {
+	if m != nil {
+		return m.ContractCallRefundRetention
 	}
-	if len(m.BridgeEthereumAddress) > 0 {
-		i -= len(m.BridgeEthereumAddress)
-		copy(dAtA[i:], m.BridgeEthereumAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+// MintRateLimit is the maximum amount of an ERC20 that deposits may credit
+// within a single mint rate limit window
+type MintRateLimit struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Limit         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"limit"`
+}
+
+func (m *MintRateLimit) Reset()         { *m = MintRateLimit{} }
+func (m *MintRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MintRateLimit) ProtoMessage()    {}
+func (*MintRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{1}
+}
+func (m *MintRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ContractSourceHash) > 0 {
-		i -= len(m.ContractSourceHash)
-		copy(dAtA[i:], m.ContractSourceHash)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MintRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRateLimit.Merge(m, src)
+}
+func (m *MintRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintRateLimit proto.InternalMessageInfo
+
+func (m *MintRateLimit) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if len(m.GravityId) > 0 {
-		i -= len(m.GravityId)
-		copy(dAtA[i:], m.GravityId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+// BatchFeeThreshold is the amount of unbatched fees of an ERC20 that makes the
+// EndBlocker create a batch for it without waiting for a batch request
+type BatchFeeThreshold struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Threshold     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"threshold"`
+}
+
+func (m *BatchFeeThreshold) Reset()         { *m = BatchFeeThreshold{} }
+func (m *BatchFeeThreshold) String() string { return proto.CompactTextString(m) }
+func (*BatchFeeThreshold) ProtoMessage()    {}
+func (*BatchFeeThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{2}
+}
+func (m *BatchFeeThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchFeeThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchFeeThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BatchFeeThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchFeeThreshold.Merge(m, src)
+}
+func (m *BatchFeeThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchFeeThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchFeeThreshold.DiscardUnknown(m)
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BatchFeeThreshold proto.InternalMessageInfo
+
+func (m *BatchFeeThreshold) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// OutflowCap is the maximum amount of an ERC20 that may leave the bridge
+// within a single circuit breaker window before the bridge is halted
+type OutflowCap struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Cap           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=cap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cap"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UnbatchedSendToEthereumTxs) > 0 {
-		for iNdEx := len(m.UnbatchedSendToEthereumTxs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnbatchedSendToEthereumTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
+func (m *OutflowCap) Reset()         { *m = OutflowCap{} }
+func (m *OutflowCap) String() string { return proto.CompactTextString(m) }
+func (*OutflowCap) ProtoMessage()    {}
+func (*OutflowCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{3}
+}
+func (m *OutflowCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutflowCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutflowCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
+}
+func (m *OutflowCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutflowCap.Merge(m, src)
+}
+func (m *OutflowCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutflowCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutflowCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutflowCap proto.InternalMessageInfo
+
+func (m *OutflowCap) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if len(m.DelegateKeys) > 0 {
-		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
+	return ""
+}
+
+// DelayedWithdrawalThreshold is the amount of an ERC20 above which a send to
+// ethereum is delayed for the delayed withdrawal period
+type DelayedWithdrawalThreshold struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Threshold     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"threshold"`
+}
+
+func (m *DelayedWithdrawalThreshold) Reset()         { *m = DelayedWithdrawalThreshold{} }
+func (m *DelayedWithdrawalThreshold) String() string { return proto.CompactTextString(m) }
+func (*DelayedWithdrawalThreshold) ProtoMessage()    {}
+func (*DelayedWithdrawalThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{4}
+}
+func (m *DelayedWithdrawalThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedWithdrawalThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedWithdrawalThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.EthereumEventVoteRecords) > 0 {
-		for iNdEx := len(m.EthereumEventVoteRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EthereumEventVoteRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+}
+func (m *DelayedWithdrawalThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedWithdrawalThreshold.Merge(m, src)
+}
+func (m *DelayedWithdrawalThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedWithdrawalThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedWithdrawalThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelayedWithdrawalThreshold proto.InternalMessageInfo
+
+func (m *DelayedWithdrawalThreshold) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if len(m.Confirmations) > 0 {
-		for iNdEx := len(m.Confirmations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Confirmations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.OutgoingTxs) > 0 {
-		for iNdEx := len(m.OutgoingTxs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OutgoingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	return ""
+}
+
+// GenesisState struct
+// TODO: this need to be audited and potentially simplified using the new
+// interfaces
+type GenesisState struct {
+	Params                     *Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	LastObservedEventNonce     uint64                     `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
+	OutgoingTxs                []*types.Any               `protobuf:"bytes,3,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs,omitempty"`
+	Confirmations              []*types.Any               `protobuf:"bytes,4,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
+	EthereumEventVoteRecords   []*EthereumEventVoteRecord `protobuf:"bytes,9,rep,name=ethereum_event_vote_records,json=ethereumEventVoteRecords,proto3" json:"ethereum_event_vote_records,omitempty"`
+	DelegateKeys               []*MsgDelegateKeys         `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
+	Erc20ToDenoms              []*ERC20ToDenom            `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
+	UnbatchedSendToEthereumTxs []*SendToEthereum          `protobuf:"bytes,12,rep,name=unbatched_send_to_ethereum_txs,json=unbatchedSendToEthereumTxs,proto3" json:"unbatched_send_to_ethereum_txs,omitempty"`
+	QueuedSendToCosmosEvents   []*SendToCosmosEvent       `protobuf:"bytes,13,rep,name=queued_send_to_cosmos_events,json=queuedSendToCosmosEvents,proto3" json:"queued_send_to_cosmos_events,omitempty"`
+	EthereumBlocklist          []string                   `protobuf:"bytes,14,rep,name=ethereum_blocklist,json=ethereumBlocklist,proto3" json:"ethereum_blocklist,omitempty"`
+	// ethereum addresses waiting to replace a validator's current one
+	PendingEthereumAddresses []*ValidatorEthereumAddress `protobuf:"bytes,15,rep,name=pending_ethereum_addresses,json=pendingEthereumAddresses,proto3" json:"pending_ethereum_addresses,omitempty"`
+	// ethereum addresses of validators whose orchestrator was revoked
+	OrchestratorlessEthereumAddresses []*ValidatorEthereumAddress `protobuf:"bytes,16,rep,name=orchestratorless_ethereum_addresses,json=orchestratorlessEthereumAddresses,proto3" json:"orchestratorless_ethereum_addresses,omitempty"`
+	BridgeFlows                       []*BridgeFlow               `protobuf:"bytes,17,rep,name=bridge_flows,json=bridgeFlows,proto3" json:"bridge_flows,omitempty"`
+	ObservedSignerSetTxs              []*ObservedSignerSetTx      `protobuf:"bytes,18,rep,name=observed_signer_set_txs,json=observedSignerSetTxs,proto3" json:"observed_signer_set_txs,omitempty"`
+	PendingErc20Deployments           []ERC20DeployedEvent        `protobuf:"bytes,19,rep,name=pending_erc20_deployments,json=pendingErc20Deployments,proto3" json:"pending_erc20_deployments"`
+	Erc20Conversions                  []ERC20Conversion           `protobuf:"bytes,20,rep,name=erc20_conversions,json=erc20Conversions,proto3" json:"erc20_conversions"`
+	TokenPauses                       []TokenPause                `protobuf:"bytes,21,rep,name=token_pauses,json=tokenPauses,proto3" json:"token_pauses"`
+	OrchestratorQueryIdentities       []OrchestratorQueryIdentity `protobuf:"bytes,22,rep,name=orchestrator_query_identities,json=orchestratorQueryIdentities,proto3" json:"orchestrator_query_identities"`
+	DelayedSendToEthereums            []DelayedSendToEthereum     `protobuf:"bytes,23,rep,name=delayed_send_to_ethereums,json=delayedSendToEthereums,proto3" json:"delayed_send_to_ethereums"`
+	// the latest ethereum height vote of each validator
+	EthereumHeightVotes []ValidatorEthereumHeightVote `protobuf:"bytes,24,rep,name=ethereum_height_votes,json=ethereumHeightVotes,proto3" json:"ethereum_height_votes"`
+	// the nonce of the last ethereum event each validator voted on
+	LastEventNoncesByValidator []ValidatorEventNonce     `protobuf:"bytes,25,rep,name=last_event_nonces_by_validator,json=lastEventNoncesByValidator,proto3" json:"last_event_nonces_by_validator"`
+	LastObservedEthereumHeight LatestEthereumBlockHeight `protobuf:"bytes,26,opt,name=last_observed_ethereum_height,json=lastObservedEthereumHeight,proto3" json:"last_observed_ethereum_height"`
+	// the counters of the ids and nonces of the sends to ethereum and
+	// outgoing txs, so that a restarted chain doesn't reuse them
+	LastSendToEthereumId     uint64                     `protobuf:"varint,27,opt,name=last_send_to_ethereum_id,json=lastSendToEthereumId,proto3" json:"last_send_to_ethereum_id,omitempty"`
+	LastOutgoingBatchNonce   uint64                     `protobuf:"varint,28,opt,name=last_outgoing_batch_nonce,json=lastOutgoingBatchNonce,proto3" json:"last_outgoing_batch_nonce,omitempty"`
+	LatestSignerSetTxNonce   uint64                     `protobuf:"varint,29,opt,name=latest_signer_set_tx_nonce,json=latestSignerSetTxNonce,proto3" json:"latest_signer_set_tx_nonce,omitempty"`
+	ValidatorLivenessHeights []ValidatorLivenessHeights `protobuf:"bytes,30,rep,name=validator_liveness_heights,json=validatorLivenessHeights,proto3" json:"validator_liveness_heights"`
+	// the observed ethereum event vote records indexed by the hash of the
+	// ethereum transaction that emitted their event
+	EthereumTxHashEventVoteRecords []*EthereumEventVoteRecord `protobuf:"bytes,31,rep,name=ethereum_tx_hash_event_vote_records,json=ethereumTxHashEventVoteRecords,proto3" json:"ethereum_tx_hash_event_vote_records,omitempty"`
+	// the deposits of tokens off the allowlist held until governance releases
+	// them
+	HeldSendToCosmosEvents []*SendToCosmosEvent     `protobuf:"bytes,32,rep,name=held_send_to_cosmos_events,json=heldSendToCosmosEvents,proto3" json:"held_send_to_cosmos_events,omitempty"`
+	ExecutedBatchTxs       []BatchTxExecutionRecord `protobuf:"bytes,33,rep,name=executed_batch_txs,json=executedBatchTxs,proto3" json:"executed_batch_txs"`
+	// the signature schemes of the validators not signing with ECDSA
+	ValidatorSignatureSchemes []ValidatorSignatureScheme `protobuf:"bytes,34,rep,name=validator_signature_schemes,json=validatorSignatureSchemes,proto3" json:"validator_signature_schemes"`
+	// the ethereum addresses excluded from signer sets by an emergency signer
+	// set update proposal
+	ExcludedEthereumSigners []string                        `protobuf:"bytes,35,rep,name=excluded_ethereum_signers,json=excludedEthereumSigners,proto3" json:"excluded_ethereum_signers,omitempty"`
+	ExecutedContractCallTxs []ContractCallTxExecutionRecord `protobuf:"bytes,36,rep,name=executed_contract_call_txs,json=executedContractCallTxs,proto3" json:"executed_contract_call_txs"`
+	BridgeFeeAllowances     []BridgeFeeAllowance            `protobuf:"bytes,37,rep,name=bridge_fee_allowances,json=bridgeFeeAllowances,proto3" json:"bridge_fee_allowances"`
+	// checkpoints of all the outgoing txs the chain created, which are never
+	// bad ethereum signature evidence
+	PastEthereumSignatureCheckpoints [][]byte `protobuf:"bytes,38,rep,name=past_ethereum_signature_checkpoints,json=pastEthereumSignatureCheckpoints,proto3" json:"past_ethereum_signature_checkpoints,omitempty"`
+	// hashes of the bad ethereum signature evidence already submitted
+	BadEthereumSignatureEvidence [][]byte                     `protobuf:"bytes,39,rep,name=bad_ethereum_signature_evidence,json=badEthereumSignatureEvidence,proto3" json:"bad_ethereum_signature_evidence,omitempty"`
+	RefundedContractCallTxs      []ContractCallTxRefundRecord `protobuf:"bytes,40,rep,name=refunded_contract_call_txs,json=refundedContractCallTxs,proto3" json:"refunded_contract_call_txs"`
+	SignerSetTxDeltas            []SignerSetTxDelta           `protobuf:"bytes,41,rep,name=signer_set_tx_deltas,json=signerSetTxDeltas,proto3" json:"signer_set_tx_deltas"`
+	// the validators opted out of the bridge duties
+	OptedOutValidators []string `protobuf:"bytes,42,rep,name=opted_out_validators,json=optedOutValidators,proto3" json:"opted_out_validators,omitempty"`
+	// the hourly bridge usage stats of the last week
+	BridgeStatsBuckets []BridgeStatsBucket `protobuf:"bytes,43,rep,name=bridge_stats_buckets,json=bridgeStatsBuckets,proto3" json:"bridge_stats_buckets"`
+	// the ethereum header agreed on at the latest checkpoint
+	AgreedEthereumHeader *EthereumHeader `protobuf:"bytes,44,opt,name=agreed_ethereum_header,json=agreedEthereumHeader,proto3" json:"agreed_ethereum_header,omitempty"`
+	// the latest ethereum header vote of each validator
+	EthereumHeaderVotes []EthereumHeaderVote `protobuf:"bytes,45,rep,name=ethereum_header_votes,json=ethereumHeaderVotes,proto3" json:"ethereum_header_votes"`
+	// the delegate keys frozen until new ones are set
+	OrchestratorFreezes []OrchestratorFreeze `protobuf:"bytes,46,rep,name=orchestrator_freezes,json=orchestratorFreezes,proto3" json:"orchestrator_freezes"`
+	// the highest nonces of the outgoing txs created before the checkpoints of
+	// the outgoing txs were recorded
+	PastOutgoingTxNonces []PastOutgoingTxNonce `protobuf:"bytes,47,rep,name=past_outgoing_tx_nonces,json=pastOutgoingTxNonces,proto3" json:"past_outgoing_tx_nonces"`
+	// the agreed ethereum headers the events not yet observed are checked
+	// against
+	AgreedEthereumHeaders []EthereumHeader `protobuf:"bytes,48,rep,name=agreed_ethereum_headers,json=agreedEthereumHeaders,proto3" json:"agreed_ethereum_headers"`
+	// the log of the observed ethereum events
+	ObservedEthereumEvents []ObservedEthereumEvent `protobuf:"bytes,49,rep,name=observed_ethereum_events,json=observedEthereumEvents,proto3" json:"observed_ethereum_events"`
+	// the net amount of each ERC20 bridged in since the event log started
+	BridgedSupplies []ERC20Token `protobuf:"bytes,50,rep,name=bridged_supplies,json=bridgedSupplies,proto3" json:"bridged_supplies"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{5}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.LastObservedEventNonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedEventNonce))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() *Params {
+	if m != nil {
+		return m.Params
 	}
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *GenesisState) GetLastObservedEventNonce() uint64 {
+	if m != nil {
+		return m.LastObservedEventNonce
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GenesisState) GetOutgoingTxs() []*types.Any {
+	if m != nil {
+		return m.OutgoingTxs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GenesisState) GetConfirmations() []*types.Any {
+	if m != nil {
+		return m.Confirmations
+	}
+	return nil
 }
 
-func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func (m *GenesisState) GetEthereumEventVoteRecords() []*EthereumEventVoteRecord {
+	if m != nil {
+		return m.EthereumEventVoteRecords
 	}
-	if len(m.Erc20) > 0 {
-		i -= len(m.Erc20)
-		copy(dAtA[i:], m.Erc20)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *GenesisState) GetDelegateKeys() []*MsgDelegateKeys {
+	if m != nil {
+		return m.DelegateKeys
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GenesisState) GetErc20ToDenoms() []*ERC20ToDenom {
+	if m != nil {
+		return m.Erc20ToDenoms
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *GenesisState) GetUnbatchedSendToEthereumTxs() []*SendToEthereum {
+	if m != nil {
+		return m.UnbatchedSendToEthereumTxs
 	}
-	var l int
-	_ = l
-	l = len(m.GravityId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+func (m *GenesisState) GetQueuedSendToCosmosEvents() []*SendToCosmosEvent {
+	if m != nil {
+		return m.QueuedSendToCosmosEvents
 	}
-	l = len(m.ContractSourceHash)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+func (m *GenesisState) GetEthereumBlocklist() []string {
+	if m != nil {
+		return m.EthereumBlocklist
 	}
-	l = len(m.BridgeEthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+func (m *GenesisState) GetPendingEthereumAddresses() []*ValidatorEthereumAddress {
+	if m != nil {
+		return m.PendingEthereumAddresses
 	}
-	if m.BridgeChainId != 0 {
-		n += 1 + sovGenesis(uint64(m.BridgeChainId))
+	return nil
+}
+
+func (m *GenesisState) GetOrchestratorlessEthereumAddresses() []*ValidatorEthereumAddress {
+	if m != nil {
+		return m.OrchestratorlessEthereumAddresses
 	}
-	if m.SignedSignerSetTxsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedSignerSetTxsWindow))
+	return nil
+}
+
+func (m *GenesisState) GetBridgeFlows() []*BridgeFlow {
+	if m != nil {
+		return m.BridgeFlows
 	}
-	if m.SignedBatchesWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
+	return nil
+}
+
+func (m *GenesisState) GetObservedSignerSetTxs() []*ObservedSignerSetTx {
+	if m != nil {
+		return m.ObservedSignerSetTxs
 	}
-	if m.EthereumSignaturesWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.EthereumSignaturesWindow))
+	return nil
+}
+
+func (m *GenesisState) GetPendingErc20Deployments() []ERC20DeployedEvent {
+	if m != nil {
+		return m.PendingErc20Deployments
 	}
-	if m.TargetEthTxTimeout != 0 {
-		n += 1 + sovGenesis(uint64(m.TargetEthTxTimeout))
+	return nil
+}
+
+func (m *GenesisState) GetErc20Conversions() []ERC20Conversion {
+	if m != nil {
+		return m.Erc20Conversions
 	}
-	if m.AverageBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageBlockTime))
+	return nil
+}
+
+func (m *GenesisState) GetTokenPauses() []TokenPause {
+	if m != nil {
+		return m.TokenPauses
 	}
-	if m.AverageEthereumBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
+	return nil
+}
+
+func (m *GenesisState) GetOrchestratorQueryIdentities() []OrchestratorQueryIdentity {
+	if m != nil {
+		return m.OrchestratorQueryIdentities
 	}
-	l = m.SlashFractionSignerSetTx.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionBatch.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionEthereumSignature.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionConflictingEthereumSignature.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	if m.UnbondSlashingSignerSetTxsWindow != 0 {
-		n += 2 + sovGenesis(uint64(m.UnbondSlashingSignerSetTxsWindow))
+	return nil
+}
+
+func (m *GenesisState) GetDelayedSendToEthereums() []DelayedSendToEthereum {
+	if m != nil {
+		return m.DelayedSendToEthereums
 	}
-	if m.BridgeActive {
-		n += 3
+	return nil
+}
+
+func (m *GenesisState) GetEthereumHeightVotes() []ValidatorEthereumHeightVote {
+	if m != nil {
+		return m.EthereumHeightVotes
 	}
-	if m.BatchCreationPeriod != 0 {
-		n += 2 + sovGenesis(uint64(m.BatchCreationPeriod))
+	return nil
+}
+
+func (m *GenesisState) GetLastEventNoncesByValidator() []ValidatorEventNonce {
+	if m != nil {
+		return m.LastEventNoncesByValidator
 	}
-	if m.BatchMaxElement != 0 {
-		n += 2 + sovGenesis(uint64(m.BatchMaxElement))
+	return nil
+}
+
+func (m *GenesisState) GetLastObservedEthereumHeight() LatestEthereumBlockHeight {
+	if m != nil {
+		return m.LastObservedEthereumHeight
 	}
-	if m.ObserveEthereumHeightPeriod != 0 {
-		n += 2 + sovGenesis(uint64(m.ObserveEthereumHeightPeriod))
+	return LatestEthereumBlockHeight{}
+}
+
+func (m *GenesisState) GetLastSendToEthereumId() uint64 {
+	if m != nil {
+		return m.LastSendToEthereumId
 	}
-	return n
+	return 0
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisState) GetLastOutgoingBatchNonce() uint64 {
+	if m != nil {
+		return m.LastOutgoingBatchNonce
 	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	return 0
+}
+
+func (m *GenesisState) GetLatestSignerSetTxNonce() uint64 {
+	if m != nil {
+		return m.LatestSignerSetTxNonce
 	}
-	if m.LastObservedEventNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LastObservedEventNonce))
+	return 0
+}
+
+func (m *GenesisState) GetValidatorLivenessHeights() []ValidatorLivenessHeights {
+	if m != nil {
+		return m.ValidatorLivenessHeights
 	}
-	if len(m.OutgoingTxs) > 0 {
-		for _, e := range m.OutgoingTxs {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return nil
+}
+
+func (m *GenesisState) GetEthereumTxHashEventVoteRecords() []*EthereumEventVoteRecord {
+	if m != nil {
+		return m.EthereumTxHashEventVoteRecords
 	}
-	if len(m.Confirmations) > 0 {
-		for _, e := range m.Confirmations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return nil
+}
+
+func (m *GenesisState) GetHeldSendToCosmosEvents() []*SendToCosmosEvent {
+	if m != nil {
+		return m.HeldSendToCosmosEvents
 	}
-	if len(m.EthereumEventVoteRecords) > 0 {
-		for _, e := range m.EthereumEventVoteRecords {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return nil
+}
+
+func (m *GenesisState) GetExecutedBatchTxs() []BatchTxExecutionRecord {
+	if m != nil {
+		return m.ExecutedBatchTxs
 	}
-	if len(m.DelegateKeys) > 0 {
-		for _, e := range m.DelegateKeys {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorSignatureSchemes() []ValidatorSignatureScheme {
+	if m != nil {
+		return m.ValidatorSignatureSchemes
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for _, e := range m.Erc20ToDenoms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return nil
+}
+
+func (m *GenesisState) GetExcludedEthereumSigners() []string {
+	if m != nil {
+		return m.ExcludedEthereumSigners
 	}
-	if len(m.UnbatchedSendToEthereumTxs) > 0 {
-		for _, e := range m.UnbatchedSendToEthereumTxs {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return nil
+}
+
+func (m *GenesisState) GetExecutedContractCallTxs() []ContractCallTxExecutionRecord {
+	if m != nil {
+		return m.ExecutedContractCallTxs
 	}
-	return n
+	return nil
 }
 
-func (m *ERC20ToDenom) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisState) GetBridgeFeeAllowances() []BridgeFeeAllowance {
+	if m != nil {
+		return m.BridgeFeeAllowances
 	}
-	var l int
-	_ = l
-	l = len(m.Erc20)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+func (m *GenesisState) GetPastEthereumSignatureCheckpoints() [][]byte {
+	if m != nil {
+		return m.PastEthereumSignatureCheckpoints
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+func (m *GenesisState) GetBadEthereumSignatureEvidence() [][]byte {
+	if m != nil {
+		return m.BadEthereumSignatureEvidence
 	}
-	return n
+	return nil
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *GenesisState) GetRefundedContractCallTxs() []ContractCallTxRefundRecord {
+	if m != nil {
+		return m.RefundedContractCallTxs
+	}
+	return nil
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *GenesisState) GetSignerSetTxDeltas() []SignerSetTxDelta {
+	if m != nil {
+		return m.SignerSetTxDeltas
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+
+func (m *GenesisState) GetOptedOutValidators() []string {
+	if m != nil {
+		return m.OptedOutValidators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgeStatsBuckets() []BridgeStatsBucket {
+	if m != nil {
+		return m.BridgeStatsBuckets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAgreedEthereumHeader() *EthereumHeader {
+	if m != nil {
+		return m.AgreedEthereumHeader
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEthereumHeaderVotes() []EthereumHeaderVote {
+	if m != nil {
+		return m.EthereumHeaderVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOrchestratorFreezes() []OrchestratorFreeze {
+	if m != nil {
+		return m.OrchestratorFreezes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPastOutgoingTxNonces() []PastOutgoingTxNonce {
+	if m != nil {
+		return m.PastOutgoingTxNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAgreedEthereumHeaders() []EthereumHeader {
+	if m != nil {
+		return m.AgreedEthereumHeaders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetObservedEthereumEvents() []ObservedEthereumEvent {
+	if m != nil {
+		return m.ObservedEthereumEvents
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgedSupplies() []ERC20Token {
+	if m != nil {
+		return m.BridgedSupplies
+	}
+	return nil
+}
+
+// PastOutgoingTxNonce is the highest nonce, in a scope the nonces of outgoing
+// txs increase in on ethereum, of the outgoing txs the chain created before it
+// recorded the checkpoints of the outgoing txs it creates. The scope is the
+// outgoing tx prefix byte, followed by the invalidation scope for contract
+// calls.
+type PastOutgoingTxNonce struct {
+	Scope []byte `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *PastOutgoingTxNonce) Reset()         { *m = PastOutgoingTxNonce{} }
+func (m *PastOutgoingTxNonce) String() string { return proto.CompactTextString(m) }
+func (*PastOutgoingTxNonce) ProtoMessage()    {}
+func (*PastOutgoingTxNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{6}
+}
+func (m *PastOutgoingTxNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PastOutgoingTxNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PastOutgoingTxNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		return b[:n], nil
+	}
+}
+func (m *PastOutgoingTxNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PastOutgoingTxNonce.Merge(m, src)
+}
+func (m *PastOutgoingTxNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *PastOutgoingTxNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_PastOutgoingTxNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PastOutgoingTxNonce proto.InternalMessageInfo
+
+func (m *PastOutgoingTxNonce) GetScope() []byte {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
+func (m *PastOutgoingTxNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// ValidatorEthereumHeightVote is the latest ethereum height voted by a
+// validator, with the cosmos height of the vote
+type ValidatorEthereumHeightVote struct {
+	ValidatorAddress string                    `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Height           LatestEthereumBlockHeight `protobuf:"bytes,2,opt,name=height,proto3" json:"height"`
+}
+
+func (m *ValidatorEthereumHeightVote) Reset()         { *m = ValidatorEthereumHeightVote{} }
+func (m *ValidatorEthereumHeightVote) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEthereumHeightVote) ProtoMessage()    {}
+func (*ValidatorEthereumHeightVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{7}
+}
+func (m *ValidatorEthereumHeightVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorEthereumHeightVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorEthereumHeightVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		switch fieldNum {
-		case 1:
+		return b[:n], nil
+	}
+}
+func (m *ValidatorEthereumHeightVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEthereumHeightVote.Merge(m, src)
+}
+func (m *ValidatorEthereumHeightVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorEthereumHeightVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEthereumHeightVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorEthereumHeightVote proto.InternalMessageInfo
+
+func (m *ValidatorEthereumHeightVote) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorEthereumHeightVote) GetHeight() LatestEthereumBlockHeight {
+	if m != nil {
+		return m.Height
+	}
+	return LatestEthereumBlockHeight{}
+}
+
+// ValidatorEventNonce is the nonce of the last ethereum event a validator
+// voted on
+type ValidatorEventNonce struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	EventNonce       uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+}
+
+func (m *ValidatorEventNonce) Reset()         { *m = ValidatorEventNonce{} }
+func (m *ValidatorEventNonce) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEventNonce) ProtoMessage()    {}
+func (*ValidatorEventNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{8}
+}
+func (m *ValidatorEventNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorEventNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorEventNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorEventNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEventNonce.Merge(m, src)
+}
+func (m *ValidatorEventNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorEventNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEventNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorEventNonce proto.InternalMessageInfo
+
+func (m *ValidatorEventNonce) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorEventNonce) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+// ValidatorLivenessHeights are the cosmos heights of the last outgoing tx
+// signature and ethereum event vote of a validator, along with the outgoing
+// txs it missed since its last signature
+type ValidatorLivenessHeights struct {
+	ValidatorAddress    string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	LastSignatureHeight uint64 `protobuf:"varint,2,opt,name=last_signature_height,json=lastSignatureHeight,proto3" json:"last_signature_height,omitempty"`
+	LastEventVoteHeight uint64 `protobuf:"varint,3,opt,name=last_event_vote_height,json=lastEventVoteHeight,proto3" json:"last_event_vote_height,omitempty"`
+	MissedSignatures    uint64 `protobuf:"varint,4,opt,name=missed_signatures,json=missedSignatures,proto3" json:"missed_signatures,omitempty"`
+}
+
+func (m *ValidatorLivenessHeights) Reset()         { *m = ValidatorLivenessHeights{} }
+func (m *ValidatorLivenessHeights) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLivenessHeights) ProtoMessage()    {}
+func (*ValidatorLivenessHeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{9}
+}
+func (m *ValidatorLivenessHeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLivenessHeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLivenessHeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorLivenessHeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLivenessHeights.Merge(m, src)
+}
+func (m *ValidatorLivenessHeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLivenessHeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLivenessHeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLivenessHeights proto.InternalMessageInfo
+
+func (m *ValidatorLivenessHeights) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorLivenessHeights) GetLastSignatureHeight() uint64 {
+	if m != nil {
+		return m.LastSignatureHeight
+	}
+	return 0
+}
+
+func (m *ValidatorLivenessHeights) GetLastEventVoteHeight() uint64 {
+	if m != nil {
+		return m.LastEventVoteHeight
+	}
+	return 0
+}
+
+func (m *ValidatorLivenessHeights) GetMissedSignatures() uint64 {
+	if m != nil {
+		return m.MissedSignatures
+	}
+	return 0
+}
+
+// ValidatorEthereumAddress pairs a validator with an ethereum address
+type ValidatorEthereumAddress struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	EthereumAddress  string `protobuf:"bytes,2,opt,name=ethereum_address,json=ethereumAddress,proto3" json:"ethereum_address,omitempty"`
+}
+
+func (m *ValidatorEthereumAddress) Reset()         { *m = ValidatorEthereumAddress{} }
+func (m *ValidatorEthereumAddress) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEthereumAddress) ProtoMessage()    {}
+func (*ValidatorEthereumAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{10}
+}
+func (m *ValidatorEthereumAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorEthereumAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorEthereumAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorEthereumAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEthereumAddress.Merge(m, src)
+}
+func (m *ValidatorEthereumAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorEthereumAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEthereumAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorEthereumAddress proto.InternalMessageInfo
+
+func (m *ValidatorEthereumAddress) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorEthereumAddress) GetEthereumAddress() string {
+	if m != nil {
+		return m.EthereumAddress
+	}
+	return ""
+}
+
+// ValidatorSignatureScheme pairs a validator with the scheme of its
+// signatures over outgoing txs
+type ValidatorSignatureScheme struct {
+	ValidatorAddress string          `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Scheme           SignatureScheme `protobuf:"varint,2,opt,name=scheme,proto3,enum=gravity.v1.SignatureScheme" json:"scheme,omitempty"`
+}
+
+func (m *ValidatorSignatureScheme) Reset()         { *m = ValidatorSignatureScheme{} }
+func (m *ValidatorSignatureScheme) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSignatureScheme) ProtoMessage()    {}
+func (*ValidatorSignatureScheme) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{11}
+}
+func (m *ValidatorSignatureScheme) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSignatureScheme) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSignatureScheme.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSignatureScheme) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSignatureScheme.Merge(m, src)
+}
+func (m *ValidatorSignatureScheme) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSignatureScheme) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSignatureScheme.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSignatureScheme proto.InternalMessageInfo
+
+func (m *ValidatorSignatureScheme) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorSignatureScheme) GetScheme() SignatureScheme {
+	if m != nil {
+		return m.Scheme
+	}
+	return SIGNATURE_SCHEME_ECDSA
+}
+
+// This records the relationship between an ERC20 token and the denom
+// of the corresponding Cosmos originated asset
+type ERC20ToDenom struct {
+	Erc20 string `protobuf:"bytes,1,opt,name=erc20,proto3" json:"erc20,omitempty"`
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *ERC20ToDenom) Reset()         { *m = ERC20ToDenom{} }
+func (m *ERC20ToDenom) String() string { return proto.CompactTextString(m) }
+func (*ERC20ToDenom) ProtoMessage()    {}
+func (*ERC20ToDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{12}
+}
+func (m *ERC20ToDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20ToDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20ToDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20ToDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20ToDenom.Merge(m, src)
+}
+func (m *ERC20ToDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20ToDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20ToDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20ToDenom proto.InternalMessageInfo
+
+func (m *ERC20ToDenom) GetErc20() string {
+	if m != nil {
+		return m.Erc20
+	}
+	return ""
+}
+
+func (m *ERC20ToDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
+	proto.RegisterType((*MintRateLimit)(nil), "gravity.v1.MintRateLimit")
+	proto.RegisterType((*BatchFeeThreshold)(nil), "gravity.v1.BatchFeeThreshold")
+	proto.RegisterType((*OutflowCap)(nil), "gravity.v1.OutflowCap")
+	proto.RegisterType((*DelayedWithdrawalThreshold)(nil), "gravity.v1.DelayedWithdrawalThreshold")
+	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
+	proto.RegisterType((*PastOutgoingTxNonce)(nil), "gravity.v1.PastOutgoingTxNonce")
+	proto.RegisterType((*ValidatorEthereumHeightVote)(nil), "gravity.v1.ValidatorEthereumHeightVote")
+	proto.RegisterType((*ValidatorEventNonce)(nil), "gravity.v1.ValidatorEventNonce")
+	proto.RegisterType((*ValidatorLivenessHeights)(nil), "gravity.v1.ValidatorLivenessHeights")
+	proto.RegisterType((*ValidatorEthereumAddress)(nil), "gravity.v1.ValidatorEthereumAddress")
+	proto.RegisterType((*ValidatorSignatureScheme)(nil), "gravity.v1.ValidatorSignatureScheme")
+	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+}
+
+func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
+
+var fileDescriptor_387b0aba880adb60 = []byte{
+	// 3384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x5f, 0x73, 0x1c, 0xc7,
+	0x71, 0x27, 0x44, 0x8a, 0xb1, 0x06, 0xff, 0x07, 0xc0, 0x61, 0x70, 0x00, 0x0e, 0xe0, 0x51, 0x24,
+	0x01, 0xc9, 0x04, 0x44, 0xd0, 0x96, 0x2d, 0xca, 0xb2, 0x89, 0x3b, 0x80, 0x34, 0xca, 0xa4, 0x09,
+	0x1f, 0x60, 0x32, 0x49, 0xc5, 0x59, 0xcf, 0xed, 0x36, 0xee, 0x56, 0xd8, 0xdb, 0x39, 0xed, 0xcc,
+	0x1d, 0x0e, 0x2a, 0x3f, 0xe4, 0x31, 0x6f, 0x71, 0x3e, 0x45, 0x2a, 0xdf, 0xc4, 0x8f, 0x7e, 0x4c,
+	0xa5, 0x12, 0x55, 0x4a, 0xfa, 0x22, 0xa9, 0xe9, 0x99, 0xfd, 0x7f, 0x60, 0x91, 0x78, 0xf1, 0x13,
+	0x70, 0xd3, 0xbf, 0xee, 0x9e, 0xed, 0x99, 0xfe, 0xbb, 0x4b, 0x58, 0x27, 0xe2, 0x43, 0x5f, 0x5d,
+	0xee, 0x0e, 0x1f, 0xed, 0x76, 0x20, 0x04, 0xe9, 0xcb, 0x9d, 0x7e, 0x24, 0x94, 0xa0, 0xc4, 0x52,
+	0x76, 0x86, 0x8f, 0xaa, 0x8b, 0x1d, 0xd1, 0x11, 0xb8, 0xbc, 0xab, 0xff, 0x33, 0x88, 0x6a, 0x8e,
+	0xd7, 0x82, 0x0d, 0x65, 0x29, 0x43, 0xe9, 0xc9, 0x8e, 0x15, 0x59, 0x5d, 0xe9, 0x08, 0xd1, 0x09,
+	0x60, 0x17, 0x7f, 0xb5, 0x07, 0x67, 0xbb, 0x3c, 0xb4, 0x1c, 0xf5, 0xff, 0xa8, 0x93, 0xdb, 0xc7,
+	0x3c, 0xe2, 0x3d, 0x49, 0xd7, 0x49, 0xac, 0xda, 0xf1, 0x3d, 0x36, 0xb1, 0x39, 0xb1, 0xf5, 0x51,
+	0xeb, 0x23, 0xbb, 0x72, 0xe4, 0xd1, 0xcf, 0xc8, 0xa2, 0x2b, 0x42, 0x15, 0x71, 0x57, 0x39, 0x52,
+	0x0c, 0x22, 0x17, 0x9c, 0x2e, 0x97, 0x5d, 0xf6, 0x01, 0x02, 0x69, 0x4c, 0x3b, 0x41, 0xd2, 0xaf,
+	0xb9, 0xec, 0xd2, 0xcf, 0xc9, 0x72, 0x3b, 0xf2, 0xbd, 0x0e, 0x38, 0xa0, 0xba, 0x10, 0xc1, 0xa0,
+	0xe7, 0x70, 0xcf, 0x8b, 0x40, 0x4a, 0x76, 0x0b, 0x99, 0x96, 0x0c, 0xf9, 0xd0, 0x52, 0xf7, 0x0d,
+	0x91, 0xde, 0x27, 0xb3, 0x96, 0xcf, 0xed, 0x72, 0x3f, 0xd4, 0xbb, 0xf9, 0x70, 0x73, 0x62, 0xeb,
+	0x56, 0x6b, 0xda, 0x2c, 0x37, 0xf5, 0xea, 0x91, 0x47, 0x7f, 0x49, 0xd6, 0xa4, 0xdf, 0x09, 0xc1,
+	0x73, 0xf0, 0x4f, 0xe4, 0x48, 0x50, 0x8e, 0x1a, 0x49, 0xe7, 0xc2, 0x0f, 0x3d, 0x71, 0xc1, 0x6e,
+	0x23, 0x13, 0x33, 0x98, 0x13, 0x84, 0x9c, 0x80, 0x3a, 0x1d, 0xc9, 0x37, 0x48, 0xa7, 0x7b, 0x64,
+	0xc9, 0xf2, 0xb7, 0xb9, 0x72, 0xbb, 0x90, 0x30, 0xfe, 0x1d, 0x32, 0x2e, 0x18, 0x62, 0xc3, 0xd0,
+	0x2c, 0xcf, 0x2f, 0x48, 0x35, 0x79, 0x18, 0x4d, 0xe7, 0x6a, 0x10, 0xa5, 0x8c, 0x3f, 0x32, 0x1a,
+	0x63, 0xc4, 0x49, 0x02, 0xb0, 0xdc, 0x8f, 0xc8, 0x92, 0xe2, 0x51, 0x07, 0x94, 0xb6, 0x88, 0xa3,
+	0x46, 0x8e, 0xf2, 0x7b, 0x20, 0x06, 0x8a, 0x11, 0x64, 0xa4, 0x86, 0x78, 0xa8, 0xba, 0xa7, 0xa3,
+	0x53, 0x43, 0xa1, 0x3f, 0x26, 0x94, 0x0f, 0x21, 0xe2, 0x1d, 0x70, 0xda, 0x81, 0x70, 0xcf, 0x91,
+	0x85, 0x4d, 0x22, 0x7e, 0xce, 0x52, 0x1a, 0x9a, 0xa0, 0x19, 0xe8, 0x57, 0x64, 0x35, 0x46, 0x27,
+	0xdb, 0xcc, 0xb0, 0x4d, 0x99, 0xfd, 0x59, 0x48, 0x6c, 0xf7, 0x94, 0x3d, 0x24, 0x6b, 0x32, 0xe0,
+	0xb2, 0xeb, 0x9c, 0xe9, 0xa3, 0xf4, 0x45, 0x98, 0xb7, 0x2c, 0x9b, 0xde, 0x9c, 0xd8, 0x9a, 0x6a,
+	0xec, 0xfc, 0xe5, 0xbb, 0x8d, 0x1b, 0xff, 0xfd, 0xdd, 0xc6, 0xfd, 0x8e, 0xaf, 0xba, 0x83, 0xf6,
+	0x8e, 0x2b, 0x7a, 0xbb, 0xae, 0x90, 0x3d, 0x21, 0xed, 0x9f, 0x87, 0xd2, 0x3b, 0xdf, 0x55, 0x97,
+	0x7d, 0x90, 0x3b, 0x07, 0xe0, 0xb6, 0x18, 0xca, 0x7c, 0x66, 0x45, 0x66, 0x0e, 0x82, 0xfe, 0x91,
+	0x2c, 0x16, 0xf4, 0xe1, 0x49, 0xb0, 0x99, 0x6b, 0xe9, 0xa1, 0x39, 0x3d, 0x78, 0x6e, 0xf4, 0x92,
+	0xdc, 0x29, 0x68, 0x28, 0x1f, 0x1f, 0x9b, 0xbd, 0x96, 0xba, 0x5a, 0x4e, 0xdd, 0x61, 0xf1, 0xcc,
+	0xe9, 0x9f, 0x27, 0xc8, 0xc3, 0x82, 0x6e, 0x57, 0x84, 0x67, 0x81, 0xef, 0x2a, 0x3f, 0xec, 0x8c,
+	0xdb, 0xc7, 0xdc, 0xb5, 0xf6, 0xb1, 0x9d, 0xdb, 0x47, 0x33, 0x55, 0x51, 0xde, 0xd2, 0x2b, 0x72,
+	0x6f, 0x10, 0xb6, 0x45, 0xe8, 0x39, 0xc8, 0xa3, 0xb7, 0x31, 0xde, 0x75, 0xe6, 0xf1, 0xa2, 0x6c,
+	0x1a, 0xf0, 0x89, 0xc5, 0x8e, 0x71, 0xa1, 0xbb, 0xc4, 0xfa, 0xa4, 0xa3, 0xb5, 0x0f, 0x81, 0xd1,
+	0xcd, 0x89, 0xad, 0x1f, 0xb5, 0xa6, 0xcc, 0xe2, 0x3e, 0xae, 0x69, 0x3f, 0xc3, 0x63, 0x75, 0xdc,
+	0x08, 0x38, 0xda, 0xa1, 0x0f, 0x91, 0x2f, 0x3c, 0xb6, 0x60, 0xfc, 0x0c, 0x89, 0x4d, 0x4b, 0x3b,
+	0x46, 0x12, 0xfd, 0x84, 0xcc, 0x1b, 0x9e, 0x1e, 0x1f, 0x39, 0x10, 0x40, 0x0f, 0x42, 0xc5, 0x16,
+	0x11, 0x3f, 0x8b, 0x84, 0x97, 0x7c, 0x74, 0x68, 0x96, 0x69, 0x93, 0xd4, 0x44, 0x5b, 0x42, 0x34,
+	0xcc, 0x5c, 0xfa, 0x2e, 0xf8, 0x9d, 0xae, 0x8a, 0x15, 0x2d, 0x21, 0xe3, 0xaa, 0x45, 0xc5, 0x76,
+	0xf9, 0x35, 0x62, 0xac, 0xc2, 0x0d, 0x32, 0xd9, 0xf3, 0xa3, 0x48, 0x44, 0x4e, 0x4f, 0x78, 0xc0,
+	0x2a, 0xf8, 0x1c, 0xc4, 0x2c, 0xbd, 0x14, 0x1e, 0xd0, 0x23, 0x32, 0xd7, 0xf3, 0x43, 0xe5, 0x44,
+	0x5c, 0x81, 0x13, 0xf8, 0x3d, 0x5f, 0x49, 0xb6, 0xbc, 0x79, 0x73, 0x6b, 0x72, 0x6f, 0x65, 0x27,
+	0x0d, 0xd9, 0x3b, 0x2f, 0xfd, 0x50, 0xb5, 0xb8, 0x82, 0x17, 0x1a, 0xd1, 0xb8, 0xa5, 0xcf, 0xb2,
+	0x35, 0xd3, 0xcb, 0x2e, 0x4a, 0xfa, 0x98, 0x54, 0x0a, 0xa2, 0x62, 0xbb, 0x33, 0x63, 0x91, 0x1c,
+	0xde, 0x9a, 0xda, 0x23, 0x15, 0x6b, 0xea, 0x7e, 0x24, 0xfa, 0x42, 0xf2, 0xc0, 0xf9, 0x66, 0x20,
+	0xa2, 0x41, 0x8f, 0xad, 0x5c, 0xeb, 0xda, 0x2c, 0x1a, 0x69, 0xc7, 0x56, 0xd8, 0xef, 0x50, 0x16,
+	0xfd, 0x9a, 0xac, 0x14, 0xb5, 0xa8, 0x6e, 0x04, 0xb2, 0x2b, 0x02, 0x8f, 0x55, 0xaf, 0xa5, 0x68,
+	0x39, 0xaf, 0xe8, 0x34, 0x16, 0x47, 0x7f, 0x4f, 0x16, 0xcd, 0x19, 0x9f, 0x01, 0xa4, 0x5a, 0x24,
+	0x5b, 0x45, 0xab, 0xae, 0x67, 0xad, 0x8a, 0xce, 0xfc, 0x0c, 0x20, 0x61, 0xb6, 0x96, 0xa5, 0xed,
+	0x22, 0x41, 0xd2, 0x33, 0xb2, 0x1c, 0x41, 0xc0, 0x2f, 0x21, 0x72, 0x22, 0xb8, 0xe0, 0x91, 0x97,
+	0xf8, 0x1f, 0x5b, 0xbb, 0xd6, 0x03, 0x2c, 0x59, 0x71, 0x2d, 0x94, 0x16, 0x3b, 0x1a, 0xfd, 0x09,
+	0xa9, 0xb8, 0x7e, 0xe4, 0x0e, 0x7c, 0xe5, 0xb4, 0x23, 0xe0, 0xe7, 0x10, 0xc5, 0xa7, 0xb8, 0x8e,
+	0xa7, 0xb8, 0x68, 0xa9, 0x0d, 0x43, 0xb4, 0xc7, 0xd8, 0x25, 0xac, 0xc8, 0xd5, 0x1b, 0x04, 0xca,
+	0xef, 0x07, 0xc0, 0x6a, 0xd7, 0xda, 0x5e, 0x25, 0xaf, 0xe7, 0xa5, 0x95, 0x46, 0xff, 0x40, 0xd6,
+	0x8a, 0x9a, 0xc4, 0x40, 0x9d, 0x05, 0xe2, 0xc2, 0x71, 0x79, 0x5f, 0xb2, 0x0d, 0x34, 0x73, 0x25,
+	0x6b, 0xe6, 0x57, 0x86, 0xde, 0xe4, 0x7d, 0x6b, 0xdf, 0x95, 0xbc, 0xec, 0x94, 0x2e, 0xe9, 0x03,
+	0x32, 0x97, 0x7a, 0xa8, 0x1a, 0x39, 0xbc, 0x03, 0x6c, 0xd3, 0xa6, 0x69, 0xeb, 0xa0, 0xa7, 0xa3,
+	0xfd, 0x0e, 0xd0, 0x87, 0x64, 0x21, 0x05, 0xf6, 0x85, 0x08, 0x1c, 0xe9, 0x7f, 0x0b, 0xec, 0x8e,
+	0x49, 0x61, 0x31, 0xf6, 0x58, 0x88, 0xe0, 0xc4, 0xff, 0x56, 0xc7, 0xa8, 0x8f, 0x45, 0xa4, 0x33,
+	0xae, 0x8a, 0xb8, 0x12, 0x91, 0xf3, 0xcd, 0x00, 0x22, 0x5d, 0x91, 0x40, 0xa8, 0x74, 0x69, 0x12,
+	0xf8, 0x67, 0x80, 0xb9, 0xac, 0x8e, 0xfc, 0x77, 0xb2, 0xd8, 0xdf, 0x69, 0xe8, 0x91, 0x45, 0xbe,
+	0xb0, 0x40, 0xba, 0x45, 0xe6, 0xec, 0x95, 0xd6, 0xf7, 0xcc, 0x83, 0x50, 0xf4, 0xd8, 0x5d, 0xac,
+	0x3f, 0x66, 0xcc, 0xfa, 0x33, 0x80, 0x03, 0xbd, 0x4a, 0xfb, 0x64, 0xdd, 0xc3, 0xa3, 0xf6, 0x9c,
+	0x0b, 0x5f, 0x75, 0xbd, 0x88, 0x5f, 0x64, 0xef, 0xbf, 0x64, 0x1f, 0xa3, 0xc9, 0xee, 0x67, 0x4d,
+	0x76, 0x60, 0x18, 0xde, 0x24, 0xf8, 0xe2, 0x15, 0x5d, 0xf5, 0xae, 0x44, 0x48, 0xfa, 0x84, 0xac,
+	0x8c, 0xd1, 0x68, 0xa3, 0xd6, 0x3d, 0x7c, 0xc2, 0xe5, 0x12, 0xbf, 0x8d, 0x58, 0xdb, 0x64, 0x4e,
+	0x82, 0x3b, 0x88, 0xb4, 0x55, 0x5c, 0x31, 0x08, 0x5d, 0x3f, 0x60, 0xf7, 0xf1, 0xb9, 0x66, 0xe3,
+	0xf5, 0xa6, 0x59, 0xa6, 0x40, 0x96, 0xcd, 0x11, 0xd8, 0x7a, 0x03, 0x2d, 0xd1, 0x16, 0x42, 0x2a,
+	0xf6, 0xe0, 0x9a, 0xc1, 0x43, 0x8b, 0xb3, 0x35, 0xca, 0x33, 0x80, 0x86, 0x96, 0x45, 0xf7, 0xc9,
+	0x7a, 0xac, 0xa0, 0x50, 0x7d, 0xf4, 0x78, 0xd4, 0xf1, 0x43, 0xb6, 0x85, 0x4f, 0x54, 0xb5, 0xa0,
+	0x5c, 0xfd, 0xf1, 0x12, 0x11, 0xf4, 0x4b, 0x12, 0x53, 0xe3, 0x10, 0x3e, 0x14, 0x0a, 0x62, 0xc7,
+	0xda, 0x36, 0x16, 0xb1, 0x08, 0x13, 0xbf, 0x5f, 0x0b, 0x05, 0xd6, 0xb7, 0xb6, 0xc9, 0xbc, 0xbe,
+	0x63, 0xf6, 0x51, 0x47, 0xe6, 0x9e, 0x7d, 0x82, 0x3c, 0x33, 0x3d, 0x3e, 0xc2, 0x20, 0x72, 0x3a,
+	0xc2, 0x5b, 0x76, 0x40, 0x36, 0x34, 0x34, 0xa9, 0x68, 0x5d, 0x1e, 0x04, 0x4e, 0x9f, 0x5f, 0x06,
+	0x82, 0x7b, 0x4e, 0xfb, 0x52, 0x81, 0x64, 0x9f, 0x9a, 0xa4, 0xd1, 0xe3, 0xa3, 0xa6, 0x45, 0x35,
+	0x79, 0x10, 0x1c, 0x1b, 0x4c, 0x43, 0x43, 0x74, 0x20, 0x37, 0x25, 0x2a, 0xda, 0x93, 0x4b, 0x5f,
+	0x3a, 0x7d, 0xe1, 0x87, 0x4a, 0xb2, 0x1f, 0x9b, 0x40, 0x8e, 0x54, 0x6d, 0x1f, 0x4d, 0x3b, 0x46,
+	0x92, 0x4e, 0x87, 0x29, 0x93, 0x07, 0x52, 0xf9, 0x21, 0x66, 0x3e, 0xf6, 0x10, 0x0f, 0x2f, 0xe1,
+	0x39, 0x48, 0x49, 0xba, 0xf8, 0xce, 0x24, 0xea, 0x08, 0x94, 0xbe, 0xe3, 0x22, 0x64, 0x3b, 0xa6,
+	0x6e, 0x94, 0x71, 0x66, 0x6e, 0xc5, 0x14, 0x5d, 0x7c, 0x2b, 0x71, 0x0e, 0xa1, 0xc3, 0x83, 0x40,
+	0x5c, 0x04, 0xbe, 0x54, 0x0e, 0x84, 0xbc, 0x1d, 0x80, 0xc7, 0x76, 0x31, 0xb7, 0x2d, 0x21, 0x79,
+	0x3f, 0xa6, 0x1e, 0x1a, 0x22, 0x7d, 0x40, 0x66, 0x0b, 0x7c, 0xec, 0xb3, 0xcd, 0x9b, 0xda, 0x59,
+	0xf2, 0x78, 0xfa, 0x73, 0xc2, 0x60, 0x04, 0xee, 0x40, 0xc5, 0xf5, 0x73, 0x66, 0x5b, 0x8f, 0x70,
+	0x5b, 0x95, 0x98, 0x8e, 0x86, 0x4f, 0xb7, 0x76, 0x4e, 0xaa, 0x30, 0x84, 0xd0, 0x1e, 0x6d, 0x5f,
+	0x5c, 0x40, 0x94, 0x49, 0x32, 0x7b, 0xd7, 0x4b, 0x32, 0x28, 0x51, 0xdf, 0x85, 0x63, 0x2d, 0x2f,
+	0x4d, 0x32, 0x47, 0xe4, 0x4e, 0x72, 0x17, 0x8d, 0x56, 0x5d, 0x84, 0xf9, 0x51, 0xcf, 0x54, 0x22,
+	0x1e, 0xf4, 0x55, 0x97, 0x3d, 0xc6, 0xfd, 0xd6, 0x62, 0xe0, 0xa1, 0xc6, 0x35, 0x33, 0xb0, 0x03,
+	0x8d, 0xd2, 0xa5, 0xb8, 0x3e, 0x8e, 0xb8, 0xcc, 0xb0, 0xa1, 0xe4, 0x27, 0x78, 0x6a, 0x73, 0x86,
+	0x82, 0x57, 0xda, 0x04, 0x93, 0x07, 0x64, 0xd6, 0x0f, 0xdb, 0x62, 0x10, 0x7a, 0x89, 0xe1, 0x7f,
+	0x8a, 0x86, 0x9f, 0xb1, 0xcb, 0xb1, 0xc5, 0xb7, 0xc9, 0x9c, 0x18, 0xa8, 0x3c, 0xf2, 0x73, 0x44,
+	0xce, 0xc6, 0xeb, 0x31, 0xf4, 0x94, 0x6c, 0x61, 0x10, 0x85, 0xd0, 0xc3, 0xda, 0x0d, 0x42, 0xcf,
+	0x51, 0x22, 0x75, 0xb6, 0x3e, 0x44, 0x0e, 0x77, 0x75, 0x30, 0x50, 0xec, 0x67, 0xf8, 0x4c, 0xf5,
+	0x1e, 0x1f, 0x1d, 0x1b, 0xf8, 0x09, 0x84, 0xde, 0xa9, 0x88, 0x9d, 0xee, 0x18, 0xa2, 0x7d, 0x83,
+	0x4c, 0x03, 0x74, 0x87, 0x4b, 0x7d, 0x8b, 0xc1, 0x71, 0x75, 0x64, 0xf8, 0x79, 0x26, 0x40, 0x3f,
+	0xe7, 0xb2, 0xc1, 0x25, 0x34, 0xb5, 0x97, 0x3f, 0x26, 0x95, 0x14, 0xae, 0x35, 0xaa, 0x88, 0x87,
+	0xf2, 0x0c, 0x22, 0xf6, 0x45, 0xa6, 0x9e, 0x7b, 0xce, 0xe5, 0x31, 0x44, 0xa7, 0x96, 0x44, 0x7f,
+	0x4a, 0x96, 0xf3, 0x4c, 0x69, 0xd5, 0xfb, 0xc4, 0x64, 0xcb, 0x0c, 0x57, 0x5a, 0xb0, 0xbe, 0x21,
+	0xb3, 0xe6, 0x7e, 0x44, 0xe0, 0x0d, 0x4c, 0x0e, 0xff, 0x52, 0xdb, 0xfb, 0xbd, 0xee, 0xc7, 0x51,
+	0xa8, 0x5a, 0x33, 0x28, 0xa6, 0x15, 0x4b, 0xd1, 0x95, 0x70, 0xc6, 0xa1, 0x8c, 0x0e, 0xb7, 0xcb,
+	0xc3, 0x4e, 0xa6, 0x12, 0x71, 0xda, 0x7d, 0xc9, 0x7e, 0x61, 0x2a, 0xe1, 0xc4, 0xc3, 0xf0, 0x7a,
+	0x35, 0x11, 0x99, 0x46, 0xfa, 0xbe, 0xa4, 0x0d, 0x52, 0xc3, 0xd8, 0xa3, 0x63, 0x99, 0x74, 0xda,
+	0xa0, 0x2e, 0x00, 0xb2, 0xed, 0x93, 0x64, 0x5f, 0x99, 0xe0, 0xa7, 0x03, 0x11, 0x82, 0x1a, 0x06,
+	0x93, 0x54, 0xd5, 0x92, 0x7e, 0x45, 0xd6, 0x4c, 0x32, 0xb5, 0x26, 0x82, 0xd0, 0x83, 0x08, 0xff,
+	0x35, 0x6d, 0xd1, 0x2f, 0x4d, 0xf8, 0xeb, 0xe9, 0xcc, 0x8a, 0x76, 0x42, 0xc0, 0x31, 0x44, 0xa6,
+	0xd7, 0x79, 0x4c, 0x2a, 0x3a, 0xa4, 0x88, 0x30, 0x39, 0x11, 0x07, 0x7d, 0x56, 0xb2, 0x5f, 0xa1,
+	0x07, 0x2f, 0x9c, 0x01, 0xbc, 0x0a, 0xe3, 0x23, 0x39, 0x45, 0x12, 0xfd, 0x0d, 0xa9, 0x67, 0x8a,
+	0x66, 0xae, 0x15, 0x0e, 0x79, 0xe0, 0x7b, 0xc6, 0x3d, 0xe2, 0xfb, 0xf8, 0x14, 0xef, 0xe3, 0x06,
+	0x24, 0x95, 0xb3, 0x06, 0xbe, 0x4e, 0x70, 0xf1, 0xfd, 0x7c, 0x49, 0xee, 0x16, 0x85, 0xb9, 0x5d,
+	0x70, 0xcf, 0x31, 0x28, 0x3a, 0x7e, 0xa8, 0x20, 0x1a, 0xf2, 0x80, 0xed, 0x1b, 0x9b, 0xe6, 0xa5,
+	0x35, 0x13, 0xe0, 0x91, 0xc5, 0xd1, 0xa7, 0x64, 0x2d, 0x57, 0x0a, 0x9c, 0x45, 0x00, 0xdf, 0xea,
+	0xdb, 0x29, 0x02, 0x4f, 0x5c, 0x84, 0xac, 0x61, 0x2c, 0x9a, 0xc5, 0x3c, 0x43, 0x48, 0xd3, 0x22,
+	0x74, 0x6b, 0x90, 0x0f, 0xf1, 0x11, 0x9c, 0x69, 0x3f, 0x4b, 0x43, 0x55, 0xd3, 0x44, 0x79, 0x37,
+	0x13, 0xe2, 0x5b, 0x88, 0x49, 0xe2, 0xd5, 0x93, 0x5b, 0xff, 0xf2, 0x3f, 0x9b, 0x37, 0xea, 0x7f,
+	0x22, 0xd3, 0xb9, 0xda, 0x9e, 0xde, 0x23, 0x26, 0x24, 0x26, 0x49, 0xc4, 0xce, 0x4c, 0xa6, 0x71,
+	0x35, 0xce, 0x19, 0xf4, 0x80, 0x7c, 0x88, 0x25, 0x3e, 0xfb, 0xe0, 0x5a, 0x17, 0xd7, 0x30, 0xd7,
+	0xff, 0x75, 0x82, 0xcc, 0x97, 0x8a, 0xe0, 0x77, 0xdd, 0xc2, 0x0b, 0xf2, 0x51, 0x1a, 0x5f, 0xaf,
+	0xb7, 0x8d, 0x54, 0x40, 0x7d, 0x40, 0x48, 0x5a, 0x07, 0xbe, 0xeb, 0x16, 0x9e, 0x92, 0x9b, 0x2e,
+	0xef, 0x5f, 0x53, 0xb9, 0x66, 0xad, 0xff, 0xfb, 0x04, 0xa9, 0x5e, 0x5d, 0x6c, 0xfd, 0x6d, 0x4c,
+	0xf1, 0x9f, 0x35, 0x32, 0xf5, 0xdc, 0x4c, 0xef, 0x4e, 0x14, 0x57, 0x40, 0x3f, 0x21, 0xb7, 0xfb,
+	0x38, 0x4d, 0x43, 0xed, 0x93, 0x7b, 0x34, 0x5b, 0x2a, 0x9a, 0x39, 0x5b, 0xcb, 0x22, 0xe8, 0x17,
+	0x64, 0x25, 0xe0, 0x52, 0x39, 0xb6, 0x2b, 0xf5, 0x6c, 0x7a, 0x0a, 0x45, 0xe8, 0x02, 0x6e, 0xed,
+	0x56, 0xab, 0xa2, 0x01, 0xaf, 0x2c, 0x1d, 0xb3, 0xd2, 0x6f, 0x35, 0x95, 0xfe, 0x8c, 0x4c, 0x89,
+	0x81, 0xea, 0x08, 0x9d, 0x04, 0xd4, 0x48, 0xb2, 0x9b, 0x58, 0x97, 0x2e, 0xee, 0x98, 0x39, 0xdf,
+	0x4e, 0x3c, 0xe7, 0xdb, 0xd9, 0x0f, 0x2f, 0x5b, 0x93, 0x31, 0xf2, 0x74, 0xa4, 0xeb, 0xcd, 0xe9,
+	0x6c, 0xfa, 0xd3, 0x83, 0xb8, 0xab, 0x39, 0xf3, 0x50, 0xda, 0x26, 0xab, 0x85, 0x4c, 0x8a, 0xf9,
+	0x3b, 0x02, 0x57, 0x44, 0x9e, 0x64, 0x1f, 0xa1, 0xa4, 0xbb, 0xd9, 0x07, 0x3e, 0xcc, 0xe6, 0x53,
+	0x9d, 0x9b, 0x5b, 0x88, 0x4d, 0x07, 0x64, 0x05, 0x82, 0xa4, 0x4f, 0xc9, 0xb4, 0x07, 0x01, 0x74,
+	0xb8, 0x02, 0xe7, 0x1c, 0x2e, 0x25, 0x23, 0x28, 0x75, 0x35, 0xd7, 0x61, 0xcb, 0xce, 0x81, 0xc5,
+	0xfc, 0x06, 0x2e, 0x65, 0x6b, 0xca, 0xcb, 0xfc, 0xa2, 0x4f, 0xc9, 0x2c, 0x44, 0xee, 0xde, 0x67,
+	0x3a, 0x2f, 0x62, 0x82, 0x96, 0x6c, 0x12, 0x65, 0xb0, 0xdc, 0xce, 0x5a, 0xcd, 0xbd, 0xcf, 0x4e,
+	0x05, 0x66, 0xea, 0xd6, 0x34, 0x32, 0xd8, 0x5f, 0x92, 0xfe, 0x33, 0xa9, 0x0d, 0x42, 0x33, 0x11,
+	0xf4, 0xca, 0x29, 0x56, 0x9b, 0x7b, 0x0a, 0x05, 0x56, 0xb3, 0x02, 0xf3, 0xc9, 0xb5, 0x55, 0x4d,
+	0x24, 0xe4, 0x09, 0xfa, 0x0c, 0xfe, 0x40, 0xd6, 0xbe, 0x19, 0xc0, 0x20, 0x23, 0xdc, 0x5c, 0x33,
+	0x63, 0x54, 0xc9, 0xa6, 0xcb, 0xed, 0xaf, 0x11, 0xd2, 0x44, 0x18, 0xda, 0xac, 0xc5, 0x8c, 0x88,
+	0x12, 0x41, 0xd2, 0x87, 0x84, 0xe6, 0x8b, 0x6f, 0xac, 0xe1, 0x66, 0x30, 0x03, 0xcc, 0x43, 0xb6,
+	0xe4, 0xd6, 0x04, 0xda, 0x26, 0xd5, 0xb8, 0x9c, 0x28, 0x4e, 0x69, 0x41, 0xb2, 0x59, 0xdc, 0xcb,
+	0xc7, 0xd9, 0xbd, 0xd8, 0xa8, 0x2f, 0xa2, 0xc2, 0xd8, 0xb6, 0xc5, 0xac, 0x9c, 0xc2, 0x3a, 0x48,
+	0xaa, 0xc8, 0xdd, 0x6c, 0x8c, 0x0e, 0x40, 0xca, 0x71, 0xca, 0xe6, 0xde, 0x43, 0xd9, 0x9d, 0xa2,
+	0xc0, 0xb2, 0xd6, 0x2f, 0xc8, 0x54, 0xdc, 0xf7, 0x05, 0xe2, 0x42, 0xb2, 0xf9, 0x72, 0xbf, 0xdb,
+	0x30, 0xfd, 0x5f, 0x20, 0x2e, 0x5a, 0x93, 0xed, 0xe4, 0x7f, 0x49, 0x5f, 0x93, 0xe5, 0xc4, 0x2b,
+	0xf3, 0x03, 0x32, 0x46, 0x51, 0xca, 0x46, 0xae, 0x6b, 0xb6, 0xd0, 0xcc, 0x7c, 0xac, 0xb5, 0x28,
+	0xca, 0x8b, 0x92, 0xfe, 0x91, 0xac, 0x24, 0xc6, 0xc6, 0x4b, 0xea, 0x41, 0x3f, 0x10, 0x97, 0x3d,
+	0x3c, 0xf7, 0x05, 0x94, 0x5c, 0x2b, 0x5d, 0xd3, 0x03, 0xc4, 0x58, 0xff, 0xb7, 0x4d, 0xe5, 0x72,
+	0x6c, 0xeb, 0xc8, 0x8d, 0x01, 0x28, 0x84, 0xfe, 0x96, 0xcc, 0x1b, 0xc9, 0xae, 0x08, 0x87, 0x10,
+	0x49, 0x74, 0xf2, 0xc5, 0xb2, 0x13, 0xa1, 0xe4, 0x66, 0x82, 0xb1, 0x62, 0xe7, 0x90, 0x37, 0x5d,
+	0x96, 0xf4, 0x57, 0x64, 0xca, 0x84, 0xd5, 0x3e, 0x1f, 0xe8, 0x33, 0x5a, 0x2a, 0x1b, 0x11, 0x0b,
+	0x89, 0x63, 0x4d, 0xb6, 0x52, 0x26, 0x55, 0xb2, 0x22, 0xa9, 0x20, 0xeb, 0x57, 0xb7, 0xf3, 0x3e,
+	0x48, 0x56, 0x41, 0x89, 0xf7, 0x72, 0x06, 0xbd, 0xaa, 0xa7, 0x8f, 0x5b, 0xea, 0xab, 0x9a, 0x7e,
+	0x1f, 0x74, 0x98, 0x4a, 0x5a, 0xea, 0xa2, 0xf3, 0xc6, 0x03, 0xbb, 0x3b, 0x63, 0x1a, 0xf8, 0xbc,
+	0x9f, 0x5a, 0x45, 0x15, 0x6f, 0x1c, 0x51, 0x52, 0x4e, 0x96, 0x8a, 0x93, 0x46, 0x1d, 0x0b, 0x25,
+	0x63, 0x28, 0xff, 0xc1, 0x5b, 0xaf, 0x70, 0xda, 0xb6, 0x5a, 0x2d, 0x0b, 0x50, 0xa2, 0x48, 0xea,
+	0x93, 0x1a, 0x66, 0x87, 0x4c, 0x52, 0x90, 0x4e, 0xfb, 0x32, 0x2e, 0xce, 0x44, 0xc4, 0x56, 0xca,
+	0x37, 0x31, 0xd5, 0x95, 0xe4, 0x0a, 0xab, 0xa3, 0xaa, 0x85, 0xa5, 0xab, 0xb2, 0x71, 0x99, 0x60,
+	0x69, 0x48, 0xd6, 0x0b, 0x89, 0x28, 0xff, 0x6c, 0x38, 0xf7, 0x2b, 0x1c, 0xd1, 0x0b, 0xae, 0x40,
+	0xe6, 0x3b, 0x78, 0xb3, 0xfb, 0xac, 0xbe, 0x24, 0x73, 0xe5, 0x9e, 0x8f, 0x7e, 0x4e, 0x18, 0xea,
+	0x2b, 0xc5, 0x56, 0xdf, 0x63, 0xab, 0xa6, 0x19, 0xd0, 0xf4, 0xbc, 0xd1, 0x8f, 0xbc, 0x34, 0x61,
+	0xc6, 0xa9, 0xcf, 0x74, 0x14, 0x26, 0x61, 0xae, 0x65, 0x12, 0xa6, 0xa5, 0x63, 0xbd, 0x64, 0x12,
+	0xe6, 0x13, 0x52, 0x0d, 0x70, 0xc7, 0x79, 0x77, 0xb6, 0xbc, 0xeb, 0x31, 0xaf, 0x46, 0x64, 0x1c,
+	0xd6, 0xf0, 0x76, 0x49, 0x35, 0x31, 0xba, 0x13, 0xf8, 0x43, 0x08, 0x41, 0x4a, 0x6b, 0x1a, 0xc9,
+	0x6a, 0x6f, 0x09, 0x5a, 0x2f, 0x2c, 0xd8, 0x3c, 0xb7, 0xb4, 0xa6, 0x61, 0xc3, 0x2b, 0xe8, 0xb4,
+	0x9f, 0x29, 0x9f, 0xd5, 0x08, 0x5f, 0xaf, 0x8d, 0xcb, 0xb4, 0x1b, 0xef, 0x9e, 0x69, 0x93, 0x96,
+	0xf6, 0x74, 0xa4, 0x5f, 0xc9, 0x95, 0xf2, 0xed, 0x3f, 0x90, 0x6a, 0x17, 0x82, 0xab, 0x32, 0xd1,
+	0xe6, 0xbb, 0x64, 0xa2, 0x8a, 0x16, 0x30, 0x26, 0x0f, 0xbd, 0x26, 0xb4, 0x30, 0x1f, 0xd0, 0xe1,
+	0xf3, 0x0e, 0x8a, 0xac, 0x97, 0x66, 0xbb, 0xa7, 0xa3, 0x43, 0x04, 0xfb, 0x22, 0x34, 0x7b, 0x4b,
+	0x22, 0x52, 0x76, 0x86, 0xa0, 0x63, 0xe8, 0xd7, 0x64, 0x35, 0x3d, 0x8e, 0xa4, 0x8b, 0x74, 0xa4,
+	0xdb, 0x85, 0x1e, 0x48, 0x56, 0x7f, 0xcb, 0x79, 0x24, 0x7d, 0xe5, 0x09, 0x82, 0xe3, 0x19, 0xe7,
+	0xf0, 0x0a, 0x3a, 0x8e, 0xe7, 0x60, 0xe4, 0x06, 0x03, 0x2f, 0xeb, 0x14, 0xe6, 0x06, 0x49, 0x76,
+	0x17, 0x53, 0xea, 0x72, 0x0c, 0xc8, 0xbe, 0x6d, 0x81, 0x48, 0xd2, 0x80, 0x54, 0x93, 0xe7, 0xcf,
+	0xf7, 0x20, 0x6a, 0x14, 0x4f, 0x12, 0xb7, 0xb3, 0xdb, 0xcc, 0x4e, 0x99, 0xae, 0x32, 0xc7, 0x72,
+	0x2c, 0x32, 0x0f, 0x96, 0xf4, 0xef, 0xc9, 0x52, 0x66, 0xc8, 0x89, 0xb3, 0x1b, 0xae, 0xfd, 0x9c,
+	0xdd, 0x2b, 0x67, 0x95, 0x46, 0x3c, 0xf5, 0xdc, 0x8f, 0x61, 0x71, 0x20, 0x6a, 0x97, 0x28, 0x52,
+	0xf7, 0x74, 0x7d, 0x0c, 0x44, 0xa5, 0xf7, 0x55, 0x99, 0xde, 0x4e, 0xb2, 0xfb, 0x9b, 0x37, 0xb7,
+	0xa6, 0x5a, 0x9b, 0x1a, 0x5a, 0x7a, 0xef, 0x94, 0xb6, 0x76, 0x92, 0x1e, 0x92, 0x8d, 0x36, 0xf7,
+	0xc6, 0x49, 0x83, 0xa1, 0xce, 0x0a, 0x2e, 0xb0, 0x07, 0x28, 0x6a, 0xad, 0xcd, 0xbd, 0x92, 0xa4,
+	0x43, 0x8b, 0xa1, 0x3e, 0xa9, 0x9a, 0x56, 0x6e, 0xac, 0x75, 0xb7, 0xca, 0x73, 0xda, 0xbc, 0xc1,
+	0xe2, 0x16, 0x2f, 0x6b, 0xda, 0x58, 0x5e, 0xd1, 0xb4, 0x27, 0xb9, 0xd9, 0x9b, 0x1a, 0x39, 0x1e,
+	0x04, 0x8a, 0x4b, 0xb6, 0x8d, 0x4a, 0xd6, 0x72, 0xde, 0x91, 0xc6, 0x8e, 0x03, 0x0d, 0xb2, 0xa2,
+	0xe7, 0x65, 0x61, 0x5d, 0xea, 0x81, 0x9e, 0xe8, 0xeb, 0xab, 0xa1, 0x27, 0x9d, 0xc9, 0x05, 0x94,
+	0xec, 0x13, 0xbc, 0x54, 0x14, 0x69, 0xaf, 0x06, 0x2a, 0xb9, 0xba, 0x12, 0xdf, 0x96, 0x98, 0x13,
+	0x96, 0x8a, 0x2b, 0xe9, 0xb4, 0x07, 0xee, 0x39, 0x28, 0x3d, 0xa6, 0x2c, 0xbf, 0x2d, 0x41, 0x9c,
+	0xee, 0x48, 0x64, 0x03, 0x51, 0xc9, 0xdb, 0x92, 0x22, 0x41, 0xd2, 0x63, 0x52, 0xe1, 0x9d, 0x08,
+	0xf2, 0x51, 0x9f, 0x7b, 0x10, 0xe1, 0x08, 0xb3, 0x50, 0xe5, 0x1e, 0xe6, 0x3a, 0xf6, 0xd6, 0xa2,
+	0xe1, 0xcc, 0xaf, 0xea, 0xab, 0x58, 0x9a, 0x28, 0x60, 0x72, 0x7c, 0x38, 0xa6, 0xc0, 0xc9, 0x0f,
+	0x14, 0xc6, 0xe6, 0xc4, 0x98, 0x22, 0xe9, 0x1b, 0xb2, 0x38, 0x66, 0x1e, 0x20, 0xd9, 0x4e, 0x59,
+	0xf0, 0xab, 0xd2, 0x4c, 0x20, 0x16, 0x5c, 0x9e, 0x16, 0x48, 0xfa, 0x4f, 0x64, 0xb9, 0x9f, 0xcb,
+	0x2c, 0x71, 0x6a, 0x90, 0x6c, 0xb7, 0x9c, 0x65, 0x8f, 0x33, 0x39, 0xc6, 0x26, 0x09, 0x2b, 0x7c,
+	0xb1, 0x5f, 0x26, 0x69, 0xdf, 0x5c, 0x1e, 0x6f, 0x62, 0x89, 0xa3, 0xd5, 0xb7, 0xda, 0xd8, 0x0a,
+	0x5e, 0x1a, 0x67, 0x69, 0x5d, 0x87, 0xb0, 0x72, 0xd2, 0xb6, 0xc1, 0xfb, 0x51, 0xb9, 0xd4, 0x29,
+	0xe6, 0xe3, 0x6c, 0x45, 0x59, 0x11, 0xe3, 0x88, 0x92, 0x3e, 0x8f, 0xdf, 0x9e, 0x78, 0x8e, 0x1c,
+	0xf4, 0xfb, 0x81, 0x2e, 0xd9, 0xf6, 0xca, 0x45, 0xa0, 0x6d, 0xa8, 0xce, 0x21, 0x2e, 0x25, 0xed,
+	0x27, 0x1c, 0xde, 0x89, 0x65, 0xaa, 0xef, 0x93, 0x85, 0x31, 0x86, 0xa3, 0x8b, 0xe4, 0x43, 0xe9,
+	0x8a, 0x3e, 0x60, 0xc3, 0x3c, 0xd5, 0x32, 0x3f, 0xf4, 0x6a, 0xb6, 0x0f, 0x36, 0x3f, 0xea, 0xff,
+	0x36, 0x41, 0x56, 0xdf, 0x52, 0x4e, 0xd1, 0x4f, 0xc9, 0x7c, 0x9a, 0x1a, 0xe2, 0x4f, 0x4d, 0xcc,
+	0x18, 0x60, 0x2e, 0x21, 0xc4, 0x5f, 0x99, 0x34, 0xc9, 0x6d, 0x5b, 0xde, 0x7c, 0xf0, 0xfe, 0xe5,
+	0x8d, 0x65, 0xad, 0xbb, 0x64, 0x61, 0x4c, 0xcd, 0xf5, 0x7e, 0x1b, 0xd9, 0x20, 0x93, 0xe5, 0xce,
+	0x9f, 0x40, 0x22, 0xad, 0xfe, 0xbf, 0x13, 0x84, 0x5d, 0x55, 0x53, 0xbc, 0x9f, 0xaa, 0x3d, 0xb2,
+	0x64, 0x2a, 0xaf, 0x24, 0xe8, 0x66, 0x4c, 0x70, 0xab, 0xb5, 0x80, 0x65, 0x57, 0x4c, 0xb3, 0xd5,
+	0xda, 0x63, 0x52, 0xc9, 0x14, 0xa2, 0x58, 0x88, 0x58, 0xa6, 0x9b, 0x29, 0x53, 0x52, 0x58, 0x58,
+	0xa6, 0x4f, 0xc9, 0x7c, 0xcf, 0x97, 0xd2, 0xb6, 0x4f, 0x28, 0xce, 0x7c, 0xf4, 0x73, 0xab, 0x35,
+	0x67, 0x08, 0x89, 0x1a, 0x59, 0x8f, 0x32, 0x8f, 0x57, 0xfc, 0x16, 0xe8, 0xbd, 0x1e, 0x6f, 0x9b,
+	0xcc, 0x95, 0xbe, 0x34, 0x32, 0x9f, 0x27, 0xcd, 0x42, 0x5e, 0x6e, 0xfd, 0x4f, 0x19, 0x9d, 0x85,
+	0xb4, 0xff, 0x7e, 0x3a, 0x1f, 0x93, 0xdb, 0xa6, 0xf4, 0x40, 0x4d, 0x33, 0xf9, 0x2e, 0xab, 0x20,
+	0xb9, 0x65, 0xa1, 0xf5, 0x27, 0x64, 0x2a, 0x3b, 0x81, 0xd0, 0xd7, 0x1d, 0x3b, 0x2f, 0xab, 0xc5,
+	0xfc, 0xd0, 0xab, 0xe6, 0x15, 0x83, 0x79, 0x06, 0xf3, 0xa3, 0xf1, 0xfb, 0xbf, 0x7c, 0x5f, 0x9b,
+	0xf8, 0xeb, 0xf7, 0xb5, 0x89, 0xff, 0xfb, 0xbe, 0x36, 0xf1, 0xe7, 0x1f, 0x6a, 0x37, 0xfe, 0xfa,
+	0x43, 0xed, 0xc6, 0x7f, 0xfd, 0x50, 0xbb, 0xf1, 0x8f, 0x5f, 0x66, 0x06, 0x58, 0x7d, 0xe8, 0x74,
+	0x2e, 0xbf, 0x1e, 0xc6, 0xdf, 0x87, 0x3d, 0x34, 0xde, 0xb8, 0xdb, 0x13, 0xde, 0x20, 0x80, 0xdd,
+	0xe1, 0xde, 0xee, 0x28, 0x26, 0x99, 0xc9, 0x56, 0xfb, 0x36, 0x8e, 0x7e, 0x1e, 0xff, 0xff, 0x00,
+	0x43, 0xe3, 0x2e, 0xa5, 0x99, 0x26, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractCallRefundRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ContractCallRefundRetention))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.OrchestratorFreezeCooldown != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OrchestratorFreezeCooldown))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.EthereumHeaderCheckpointInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumHeaderCheckpointInterval))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.EthereumHeaderValidationEnabled {
+		i--
+		if m.EthereumHeaderValidationEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.FeeOnTransferTokens) > 0 {
+		for iNdEx := len(m.FeeOnTransferTokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeOnTransferTokens[iNdEx])
+			copy(dAtA[i:], m.FeeOnTransferTokens[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FeeOnTransferTokens[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if m.MaxTxsPerSenderPerBatch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxTxsPerSenderPerBatch))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.MaxBlocksBetweenSignerSets != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBlocksBetweenSignerSets))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.SignerSetPowerChangeThresholdBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignerSetPowerChangeThresholdBps))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe0
+	}
+	{
+		size := m.PowerReduction.Size()
+		i -= size
+		if _, err := m.PowerReduction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xda
+	if m.BatchGasPerSignature != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchGasPerSignature))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.BatchGasPerTransfer != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchGasPerTransfer))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.BatchGasBaseCost != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchGasBaseCost))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.MaxPendingSendToEthereumPerAccount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPendingSendToEthereumPerAccount))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.OutboundEnabled {
+		i--
+		if m.OutboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.InboundEnabled {
+		i--
+		if m.InboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.NativeEtherDenom) > 0 {
+		i -= len(m.NativeEtherDenom)
+		copy(dAtA[i:], m.NativeEtherDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.NativeEtherDenom)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.EthereumEventConfirmationDepth != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumEventConfirmationDepth))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size := m.EventVotePowerThreshold.Size()
+		i -= size
+		if _, err := m.EventVotePowerThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x92
+	if m.ExecutedBatchRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExecutedBatchRetention))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.TokenAllowlist) > 0 {
+		for iNdEx := len(m.TokenAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenAllowlist[iNdEx])
+			copy(dAtA[i:], m.TokenAllowlist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.TokenAllowlistEnabled {
+		i--
+		if m.TokenAllowlistEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.SignerSetRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignerSetRetention))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf0
+	}
+	if len(m.ChainFeeDestination) > 0 {
+		i -= len(m.ChainFeeDestination)
+		copy(dAtA[i:], m.ChainFeeDestination)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainFeeDestination)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.ChainFeeBasisPoints != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ChainFeeBasisPoints))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.MaxContractCallPayloadBytes != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxContractCallPayloadBytes))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.MaxBatchTxSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBatchTxSize))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.TimeoutHeightVoteWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutHeightVoteWindow))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.TimeoutEthereumBlockMargin != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutEthereumBlockMargin))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	}
+	{
+		size := m.BatchTimeoutFeeBoost.Size()
+		i -= size
+		if _, err := m.BatchTimeoutFeeBoost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xba
+	if len(m.SecurityCouncil) > 0 {
+		i -= len(m.SecurityCouncil)
+		copy(dAtA[i:], m.SecurityCouncil)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.SecurityCouncil)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.DelayedWithdrawalPeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DelayedWithdrawalPeriod))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.DelayedWithdrawalThresholds) > 0 {
+		for iNdEx := len(m.DelayedWithdrawalThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelayedWithdrawalThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.BridgeFeeDenom) > 0 {
+		i -= len(m.BridgeFeeDenom)
+		copy(dAtA[i:], m.BridgeFeeDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeFeeDenom)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.OrchestratorQueryIdentityLifetime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OrchestratorQueryIdentityLifetime))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.BatchMaxPoolSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchMaxPoolSize))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.BatchMaxTxAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchMaxTxAge))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.CircuitBreakerOutflowCaps) > 0 {
+		for iNdEx := len(m.CircuitBreakerOutflowCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakerOutflowCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	{
+		size := m.CircuitBreakerMultiple.Size()
+		i -= size
+		if _, err := m.CircuitBreakerMultiple.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	if m.CircuitBreakerWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CircuitBreakerWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	{
+		size := m.RelayerRewardFraction.Size()
+		i -= size
+		if _, err := m.RelayerRewardFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	if len(m.BatchFeeThresholds) > 0 {
+		for iNdEx := len(m.BatchFeeThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchFeeThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	{
+		size := m.BridgeProposalThreshold.Size()
+		i -= size
+		if _, err := m.BridgeProposalThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	{
+		size := m.BridgeProposalQuorum.Size()
+		i -= size
+		if _, err := m.BridgeProposalQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	if m.MintRateLimitWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MintRateLimitWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.MintRateLimits) > 0 {
+		for iNdEx := len(m.MintRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.MirrorMode {
+		i--
+		if m.MirrorMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.ObserveEthereumHeightPeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ObserveEthereumHeightPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.BatchMaxElement != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchMaxElement))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.BatchCreationPeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchCreationPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.BridgeActive {
+		i--
+		if m.BridgeActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.UnbondSlashingSignerSetTxsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingSignerSetTxsWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.SlashFractionConflictingEthereumSignature.Size()
+		i -= size
+		if _, err := m.SlashFractionConflictingEthereumSignature.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.SlashFractionEthereumSignature.Size()
+		i -= size
+		if _, err := m.SlashFractionEthereumSignature.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.SlashFractionBatch.Size()
+		i -= size
+		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.SlashFractionSignerSetTx.Size()
+		i -= size
+		if _, err := m.SlashFractionSignerSetTx.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.AverageEthereumBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.AverageBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TargetEthTxTimeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetEthTxTimeout))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EthereumSignaturesWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumSignaturesWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SignedBatchesWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SignedSignerSetTxsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedSignerSetTxsWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BridgeChainId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractSourceHash) > 0 {
+		i -= len(m.ContractSourceHash)
+		copy(dAtA[i:], m.ContractSourceHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchFeeThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchFeeThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchFeeThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutflowCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutflowCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutflowCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedWithdrawalThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedWithdrawalThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedWithdrawalThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BridgedSupplies) > 0 {
+		for iNdEx := len(m.BridgedSupplies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgedSupplies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.ObservedEthereumEvents) > 0 {
+		for iNdEx := len(m.ObservedEthereumEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObservedEthereumEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.AgreedEthereumHeaders) > 0 {
+		for iNdEx := len(m.AgreedEthereumHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AgreedEthereumHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.PastOutgoingTxNonces) > 0 {
+		for iNdEx := len(m.PastOutgoingTxNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PastOutgoingTxNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.OrchestratorFreezes) > 0 {
+		for iNdEx := len(m.OrchestratorFreezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrchestratorFreezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.EthereumHeaderVotes) > 0 {
+		for iNdEx := len(m.EthereumHeaderVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumHeaderVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.AgreedEthereumHeader != nil {
+		{
+			size, err := m.AgreedEthereumHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.BridgeStatsBuckets) > 0 {
+		for iNdEx := len(m.BridgeStatsBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeStatsBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.OptedOutValidators) > 0 {
+		for iNdEx := len(m.OptedOutValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptedOutValidators[iNdEx])
+			copy(dAtA[i:], m.OptedOutValidators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.OptedOutValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.SignerSetTxDeltas) > 0 {
+		for iNdEx := len(m.SignerSetTxDeltas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerSetTxDeltas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.RefundedContractCallTxs) > 0 {
+		for iNdEx := len(m.RefundedContractCallTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundedContractCallTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.BadEthereumSignatureEvidence) > 0 {
+		for iNdEx := len(m.BadEthereumSignatureEvidence) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BadEthereumSignatureEvidence[iNdEx])
+			copy(dAtA[i:], m.BadEthereumSignatureEvidence[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BadEthereumSignatureEvidence[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.PastEthereumSignatureCheckpoints) > 0 {
+		for iNdEx := len(m.PastEthereumSignatureCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PastEthereumSignatureCheckpoints[iNdEx])
+			copy(dAtA[i:], m.PastEthereumSignatureCheckpoints[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PastEthereumSignatureCheckpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.BridgeFeeAllowances) > 0 {
+		for iNdEx := len(m.BridgeFeeAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeFeeAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.ExecutedContractCallTxs) > 0 {
+		for iNdEx := len(m.ExecutedContractCallTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutedContractCallTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.ExcludedEthereumSigners) > 0 {
+		for iNdEx := len(m.ExcludedEthereumSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludedEthereumSigners[iNdEx])
+			copy(dAtA[i:], m.ExcludedEthereumSigners[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExcludedEthereumSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ValidatorSignatureSchemes) > 0 {
+		for iNdEx := len(m.ValidatorSignatureSchemes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSignatureSchemes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.ExecutedBatchTxs) > 0 {
+		for iNdEx := len(m.ExecutedBatchTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutedBatchTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.HeldSendToCosmosEvents) > 0 {
+		for iNdEx := len(m.HeldSendToCosmosEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeldSendToCosmosEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.EthereumTxHashEventVoteRecords) > 0 {
+		for iNdEx := len(m.EthereumTxHashEventVoteRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumTxHashEventVoteRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.ValidatorLivenessHeights) > 0 {
+		for iNdEx := len(m.ValidatorLivenessHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorLivenessHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if m.LatestSignerSetTxNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LatestSignerSetTxNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.LastOutgoingBatchNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastOutgoingBatchNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.LastSendToEthereumId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSendToEthereumId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	{
+		size, err := m.LastObservedEthereumHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	if len(m.LastEventNoncesByValidator) > 0 {
+		for iNdEx := len(m.LastEventNoncesByValidator) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastEventNoncesByValidator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.EthereumHeightVotes) > 0 {
+		for iNdEx := len(m.EthereumHeightVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumHeightVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.DelayedSendToEthereums) > 0 {
+		for iNdEx := len(m.DelayedSendToEthereums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelayedSendToEthereums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.OrchestratorQueryIdentities) > 0 {
+		for iNdEx := len(m.OrchestratorQueryIdentities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrchestratorQueryIdentities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.TokenPauses) > 0 {
+		for iNdEx := len(m.TokenPauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenPauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.Erc20Conversions) > 0 {
+		for iNdEx := len(m.Erc20Conversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20Conversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.PendingErc20Deployments) > 0 {
+		for iNdEx := len(m.PendingErc20Deployments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingErc20Deployments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ObservedSignerSetTxs) > 0 {
+		for iNdEx := len(m.ObservedSignerSetTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObservedSignerSetTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.BridgeFlows) > 0 {
+		for iNdEx := len(m.BridgeFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.OrchestratorlessEthereumAddresses) > 0 {
+		for iNdEx := len(m.OrchestratorlessEthereumAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrchestratorlessEthereumAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.PendingEthereumAddresses) > 0 {
+		for iNdEx := len(m.PendingEthereumAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingEthereumAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.EthereumBlocklist) > 0 {
+		for iNdEx := len(m.EthereumBlocklist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EthereumBlocklist[iNdEx])
+			copy(dAtA[i:], m.EthereumBlocklist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.EthereumBlocklist[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.QueuedSendToCosmosEvents) > 0 {
+		for iNdEx := len(m.QueuedSendToCosmosEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedSendToCosmosEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.UnbatchedSendToEthereumTxs) > 0 {
+		for iNdEx := len(m.UnbatchedSendToEthereumTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbatchedSendToEthereumTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.EthereumEventVoteRecords) > 0 {
+		for iNdEx := len(m.EthereumEventVoteRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumEventVoteRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Confirmations) > 0 {
+		for iNdEx := len(m.Confirmations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confirmations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.OutgoingTxs) > 0 {
+		for iNdEx := len(m.OutgoingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutgoingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LastObservedEventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedEventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PastOutgoingTxNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PastOutgoingTxNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PastOutgoingTxNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Scope) > 0 {
+		i -= len(m.Scope)
+		copy(dAtA[i:], m.Scope)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Scope)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorEthereumHeightVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEthereumHeightVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEthereumHeightVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorEventNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEventNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEventNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLivenessHeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLivenessHeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLivenessHeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedSignatures != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MissedSignatures))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastEventVoteHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastEventVoteHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastSignatureHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSignatureHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorEthereumAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEthereumAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEthereumAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSignatureScheme) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSignatureScheme) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSignatureScheme) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Scheme != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Scheme))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ContractSourceHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BridgeChainId != 0 {
+		n += 1 + sovGenesis(uint64(m.BridgeChainId))
+	}
+	if m.SignedSignerSetTxsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedSignerSetTxsWindow))
+	}
+	if m.SignedBatchesWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
+	}
+	if m.EthereumSignaturesWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.EthereumSignaturesWindow))
+	}
+	if m.TargetEthTxTimeout != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetEthTxTimeout))
+	}
+	if m.AverageBlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.AverageBlockTime))
+	}
+	if m.AverageEthereumBlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
+	}
+	l = m.SlashFractionSignerSetTx.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionBatch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionEthereumSignature.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionConflictingEthereumSignature.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.UnbondSlashingSignerSetTxsWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.UnbondSlashingSignerSetTxsWindow))
+	}
+	if m.BridgeActive {
+		n += 3
+	}
+	if m.BatchCreationPeriod != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchCreationPeriod))
+	}
+	if m.BatchMaxElement != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchMaxElement))
+	}
+	if m.ObserveEthereumHeightPeriod != 0 {
+		n += 2 + sovGenesis(uint64(m.ObserveEthereumHeightPeriod))
+	}
+	if m.MirrorMode {
+		n += 3
+	}
+	if len(m.MintRateLimits) > 0 {
+		for _, e := range m.MintRateLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MintRateLimitWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.MintRateLimitWindow))
+	}
+	l = m.BridgeProposalQuorum.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.BridgeProposalThreshold.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.BatchFeeThresholds) > 0 {
+		for _, e := range m.BatchFeeThresholds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.RelayerRewardFraction.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.CircuitBreakerWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.CircuitBreakerWindow))
+	}
+	l = m.CircuitBreakerMultiple.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.CircuitBreakerOutflowCaps) > 0 {
+		for _, e := range m.CircuitBreakerOutflowCaps {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BatchMaxTxAge != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchMaxTxAge))
+	}
+	if m.BatchMaxPoolSize != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchMaxPoolSize))
+	}
+	if m.OrchestratorQueryIdentityLifetime != 0 {
+		n += 2 + sovGenesis(uint64(m.OrchestratorQueryIdentityLifetime))
+	}
+	l = len(m.BridgeFeeDenom)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.DelayedWithdrawalThresholds) > 0 {
+		for _, e := range m.DelayedWithdrawalThresholds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DelayedWithdrawalPeriod != 0 {
+		n += 2 + sovGenesis(uint64(m.DelayedWithdrawalPeriod))
+	}
+	l = len(m.SecurityCouncil)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = m.BatchTimeoutFeeBoost.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.TimeoutEthereumBlockMargin != 0 {
+		n += 2 + sovGenesis(uint64(m.TimeoutEthereumBlockMargin))
+	}
+	if m.TimeoutHeightVoteWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.TimeoutHeightVoteWindow))
+	}
+	if m.MaxBatchTxSize != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxBatchTxSize))
+	}
+	if m.MaxContractCallPayloadBytes != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxContractCallPayloadBytes))
+	}
+	if m.ChainFeeBasisPoints != 0 {
+		n += 2 + sovGenesis(uint64(m.ChainFeeBasisPoints))
+	}
+	l = len(m.ChainFeeDestination)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.SignerSetRetention != 0 {
+		n += 2 + sovGenesis(uint64(m.SignerSetRetention))
+	}
+	if m.TokenAllowlistEnabled {
+		n += 3
+	}
+	if len(m.TokenAllowlist) > 0 {
+		for _, s := range m.TokenAllowlist {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ExecutedBatchRetention != 0 {
+		n += 2 + sovGenesis(uint64(m.ExecutedBatchRetention))
+	}
+	l = m.EventVotePowerThreshold.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.EthereumEventConfirmationDepth != 0 {
+		n += 2 + sovGenesis(uint64(m.EthereumEventConfirmationDepth))
+	}
+	l = len(m.NativeEtherDenom)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.InboundEnabled {
+		n += 3
+	}
+	if m.OutboundEnabled {
+		n += 3
+	}
+	if m.MaxPendingSendToEthereumPerAccount != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxPendingSendToEthereumPerAccount))
+	}
+	if m.BatchGasBaseCost != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchGasBaseCost))
+	}
+	if m.BatchGasPerTransfer != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchGasPerTransfer))
+	}
+	if m.BatchGasPerSignature != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchGasPerSignature))
+	}
+	l = m.PowerReduction.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.SignerSetPowerChangeThresholdBps != 0 {
+		n += 2 + sovGenesis(uint64(m.SignerSetPowerChangeThresholdBps))
+	}
+	if m.MaxBlocksBetweenSignerSets != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxBlocksBetweenSignerSets))
+	}
+	if m.MaxTxsPerSenderPerBatch != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxTxsPerSenderPerBatch))
+	}
+	if len(m.FeeOnTransferTokens) > 0 {
+		for _, s := range m.FeeOnTransferTokens {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EthereumHeaderValidationEnabled {
+		n += 3
+	}
+	if m.EthereumHeaderCheckpointInterval != 0 {
+		n += 2 + sovGenesis(uint64(m.EthereumHeaderCheckpointInterval))
+	}
+	if m.OrchestratorFreezeCooldown != 0 {
+		n += 2 + sovGenesis(uint64(m.OrchestratorFreezeCooldown))
+	}
+	if m.ContractCallRefundRetention != 0 {
+		n += 2 + sovGenesis(uint64(m.ContractCallRefundRetention))
+	}
+	return n
+}
+
+func (m *MintRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *BatchFeeThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *OutflowCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Cap.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *DelayedWithdrawalThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastObservedEventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastObservedEventNonce))
+	}
+	if len(m.OutgoingTxs) > 0 {
+		for _, e := range m.OutgoingTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Confirmations) > 0 {
+		for _, e := range m.Confirmations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumEventVoteRecords) > 0 {
+		for _, e := range m.EthereumEventVoteRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for _, e := range m.DelegateKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for _, e := range m.Erc20ToDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbatchedSendToEthereumTxs) > 0 {
+		for _, e := range m.UnbatchedSendToEthereumTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedSendToCosmosEvents) > 0 {
+		for _, e := range m.QueuedSendToCosmosEvents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumBlocklist) > 0 {
+		for _, s := range m.EthereumBlocklist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingEthereumAddresses) > 0 {
+		for _, e := range m.PendingEthereumAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OrchestratorlessEthereumAddresses) > 0 {
+		for _, e := range m.OrchestratorlessEthereumAddresses {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgeFlows) > 0 {
+		for _, e := range m.BridgeFlows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ObservedSignerSetTxs) > 0 {
+		for _, e := range m.ObservedSignerSetTxs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingErc20Deployments) > 0 {
+		for _, e := range m.PendingErc20Deployments {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20Conversions) > 0 {
+		for _, e := range m.Erc20Conversions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenPauses) > 0 {
+		for _, e := range m.TokenPauses {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OrchestratorQueryIdentities) > 0 {
+		for _, e := range m.OrchestratorQueryIdentities {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelayedSendToEthereums) > 0 {
+		for _, e := range m.DelayedSendToEthereums {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumHeightVotes) > 0 {
+		for _, e := range m.EthereumHeightVotes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LastEventNoncesByValidator) > 0 {
+		for _, e := range m.LastEventNoncesByValidator {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.LastObservedEthereumHeight.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.LastSendToEthereumId != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSendToEthereumId))
+	}
+	if m.LastOutgoingBatchNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.LastOutgoingBatchNonce))
+	}
+	if m.LatestSignerSetTxNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.LatestSignerSetTxNonce))
+	}
+	if len(m.ValidatorLivenessHeights) > 0 {
+		for _, e := range m.ValidatorLivenessHeights {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumTxHashEventVoteRecords) > 0 {
+		for _, e := range m.EthereumTxHashEventVoteRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HeldSendToCosmosEvents) > 0 {
+		for _, e := range m.HeldSendToCosmosEvents {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExecutedBatchTxs) > 0 {
+		for _, e := range m.ExecutedBatchTxs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorSignatureSchemes) > 0 {
+		for _, e := range m.ValidatorSignatureSchemes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExcludedEthereumSigners) > 0 {
+		for _, s := range m.ExcludedEthereumSigners {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExecutedContractCallTxs) > 0 {
+		for _, e := range m.ExecutedContractCallTxs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgeFeeAllowances) > 0 {
+		for _, e := range m.BridgeFeeAllowances {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PastEthereumSignatureCheckpoints) > 0 {
+		for _, b := range m.PastEthereumSignatureCheckpoints {
+			l = len(b)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BadEthereumSignatureEvidence) > 0 {
+		for _, b := range m.BadEthereumSignatureEvidence {
+			l = len(b)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RefundedContractCallTxs) > 0 {
+		for _, e := range m.RefundedContractCallTxs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SignerSetTxDeltas) > 0 {
+		for _, e := range m.SignerSetTxDeltas {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OptedOutValidators) > 0 {
+		for _, s := range m.OptedOutValidators {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgeStatsBuckets) > 0 {
+		for _, e := range m.BridgeStatsBuckets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AgreedEthereumHeader != nil {
+		l = m.AgreedEthereumHeader.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.EthereumHeaderVotes) > 0 {
+		for _, e := range m.EthereumHeaderVotes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OrchestratorFreezes) > 0 {
+		for _, e := range m.OrchestratorFreezes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PastOutgoingTxNonces) > 0 {
+		for _, e := range m.PastOutgoingTxNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AgreedEthereumHeaders) > 0 {
+		for _, e := range m.AgreedEthereumHeaders {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ObservedEthereumEvents) > 0 {
+		for _, e := range m.ObservedEthereumEvents {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgedSupplies) > 0 {
+		for _, e := range m.BridgedSupplies {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PastOutgoingTxNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Scope)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *ValidatorEthereumHeightVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Height.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ValidatorEventNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func (m *ValidatorLivenessHeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastSignatureHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSignatureHeight))
+	}
+	if m.LastEventVoteHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastEventVoteHeight))
+	}
+	if m.MissedSignatures != 0 {
+		n += 1 + sovGenesis(uint64(m.MissedSignatures))
+	}
+	return n
+}
+
+func (m *ValidatorEthereumAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorSignatureScheme) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Scheme != 0 {
+		n += 1 + sovGenesis(uint64(m.Scheme))
+	}
+	return n
+}
+
+func (m *ERC20ToDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedSignerSetTxsWindow", wireType)
+			}
+			m.SignedSignerSetTxsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			}
+			m.SignedBatchesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSignaturesWindow", wireType)
+			}
+			m.EthereumSignaturesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumSignaturesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetEthTxTimeout", wireType)
+			}
+			m.TargetEthTxTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetEthTxTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			m.AverageBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			}
+			m.AverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionSignerSetTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEthereumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingEthereumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionConflictingEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingSignerSetTxsWindow", wireType)
+			}
+			m.UnbondSlashingSignerSetTxsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondSlashingSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeActive = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchCreationPeriod", wireType)
+			}
+			m.BatchCreationPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchCreationPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchMaxElement", wireType)
+			}
+			m.BatchMaxElement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchMaxElement |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserveEthereumHeightPeriod", wireType)
+			}
+			m.ObserveEthereumHeightPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObserveEthereumHeightPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MirrorMode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MirrorMode = bool(v != 0)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRateLimits = append(m.MintRateLimits, MintRateLimit{})
+			if err := m.MintRateLimits[len(m.MintRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRateLimitWindow", wireType)
+			}
+			m.MintRateLimitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintRateLimitWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeProposalQuorum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeProposalQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeProposalThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeProposalThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchFeeThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchFeeThresholds = append(m.BatchFeeThresholds, BatchFeeThreshold{})
+			if err := m.BatchFeeThresholds[len(m.BatchFeeThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerRewardFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RelayerRewardFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerWindow", wireType)
+			}
+			m.CircuitBreakerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerMultiple", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreakerMultiple.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerOutflowCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerOutflowCaps = append(m.CircuitBreakerOutflowCaps, OutflowCap{})
+			if err := m.CircuitBreakerOutflowCaps[len(m.CircuitBreakerOutflowCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchMaxTxAge", wireType)
+			}
+			m.BatchMaxTxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchMaxTxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchMaxPoolSize", wireType)
+			}
+			m.BatchMaxPoolSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchMaxPoolSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorQueryIdentityLifetime", wireType)
+			}
+			m.OrchestratorQueryIdentityLifetime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrchestratorQueryIdentityLifetime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeFeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedWithdrawalThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelayedWithdrawalThresholds = append(m.DelayedWithdrawalThresholds, DelayedWithdrawalThreshold{})
+			if err := m.DelayedWithdrawalThresholds[len(m.DelayedWithdrawalThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedWithdrawalPeriod", wireType)
+			}
+			m.DelayedWithdrawalPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayedWithdrawalPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityCouncil", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityCouncil = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTimeoutFeeBoost", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchTimeoutFeeBoost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutEthereumBlockMargin", wireType)
+			}
+			m.TimeoutEthereumBlockMargin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutEthereumBlockMargin |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeightVoteWindow", wireType)
+			}
+			m.TimeoutHeightVoteWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeightVoteWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchTxSize", wireType)
+			}
+			m.MaxBatchTxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchTxSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractCallPayloadBytes", wireType)
+			}
+			m.MaxContractCallPayloadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractCallPayloadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainFeeBasisPoints", wireType)
+			}
+			m.ChainFeeBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainFeeBasisPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainFeeDestination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainFeeDestination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetRetention", wireType)
+			}
+			m.SignerSetRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAllowlistEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TokenAllowlistEnabled = bool(v != 0)
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenAllowlist = append(m.TokenAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 49:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBatchRetention", wireType)
+			}
+			m.ExecutedBatchRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedBatchRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventVotePowerThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EventVotePowerThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventConfirmationDepth", wireType)
+			}
+			m.EthereumEventConfirmationDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumEventConfirmationDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeEtherDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeEtherDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 53:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InboundEnabled = bool(v != 0)
+		case 54:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OutboundEnabled = bool(v != 0)
+		case 55:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingSendToEthereumPerAccount", wireType)
+			}
+			m.MaxPendingSendToEthereumPerAccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPendingSendToEthereumPerAccount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 56:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchGasBaseCost", wireType)
+			}
+			m.BatchGasBaseCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchGasBaseCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 57:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchGasPerTransfer", wireType)
+			}
+			m.BatchGasPerTransfer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchGasPerTransfer |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 58:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchGasPerSignature", wireType)
+			}
+			m.BatchGasPerSignature = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchGasPerSignature |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerReduction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PowerReduction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 60:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetPowerChangeThresholdBps", wireType)
+			}
+			m.SignerSetPowerChangeThresholdBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetPowerChangeThresholdBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 61:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlocksBetweenSignerSets", wireType)
+			}
+			m.MaxBlocksBetweenSignerSets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlocksBetweenSignerSets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 62:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerSenderPerBatch", wireType)
+			}
+			m.MaxTxsPerSenderPerBatch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerSenderPerBatch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 63:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeOnTransferTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeOnTransferTokens = append(m.FeeOnTransferTokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 64:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeaderValidationEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EthereumHeaderValidationEnabled = bool(v != 0)
+		case 65:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeaderCheckpointInterval", wireType)
+			}
+			m.EthereumHeaderCheckpointInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeaderCheckpointInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 66:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorFreezeCooldown", wireType)
+			}
+			m.OrchestratorFreezeCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrchestratorFreezeCooldown |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 67:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallRefundRetention", wireType)
+			}
+			m.ContractCallRefundRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractCallRefundRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchFeeThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchFeeThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchFeeThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutflowCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutflowCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutflowCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayedWithdrawalThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedWithdrawalThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedWithdrawalThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+			}
+			m.LastObservedEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutgoingTxs = append(m.OutgoingTxs, &types.Any{})
+			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirmations = append(m.Confirmations, &types.Any{})
+			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventVoteRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumEventVoteRecords = append(m.EthereumEventVoteRecords, &EthereumEventVoteRecord{})
+			if err := m.EthereumEventVoteRecords[len(m.EthereumEventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedSendToEthereumTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbatchedSendToEthereumTxs = append(m.UnbatchedSendToEthereumTxs, &SendToEthereum{})
+			if err := m.UnbatchedSendToEthereumTxs[len(m.UnbatchedSendToEthereumTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedSendToCosmosEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedSendToCosmosEvents = append(m.QueuedSendToCosmosEvents, &SendToCosmosEvent{})
+			if err := m.QueuedSendToCosmosEvents[len(m.QueuedSendToCosmosEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlocklist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumBlocklist = append(m.EthereumBlocklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingEthereumAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingEthereumAddresses = append(m.PendingEthereumAddresses, &ValidatorEthereumAddress{})
+			if err := m.PendingEthereumAddresses[len(m.PendingEthereumAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorlessEthereumAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorlessEthereumAddresses = append(m.OrchestratorlessEthereumAddresses, &ValidatorEthereumAddress{})
+			if err := m.OrchestratorlessEthereumAddresses[len(m.OrchestratorlessEthereumAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeFlows = append(m.BridgeFlows, &BridgeFlow{})
+			if err := m.BridgeFlows[len(m.BridgeFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedSignerSetTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservedSignerSetTxs = append(m.ObservedSignerSetTxs, &ObservedSignerSetTx{})
+			if err := m.ObservedSignerSetTxs[len(m.ObservedSignerSetTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingErc20Deployments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingErc20Deployments = append(m.PendingErc20Deployments, ERC20DeployedEvent{})
+			if err := m.PendingErc20Deployments[len(m.PendingErc20Deployments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Conversions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Conversions = append(m.Erc20Conversions, ERC20Conversion{})
+			if err := m.Erc20Conversions[len(m.Erc20Conversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPauses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPauses = append(m.TokenPauses, TokenPause{})
+			if err := m.TokenPauses[len(m.TokenPauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorQueryIdentities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorQueryIdentities = append(m.OrchestratorQueryIdentities, OrchestratorQueryIdentity{})
+			if err := m.OrchestratorQueryIdentities[len(m.OrchestratorQueryIdentities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedSendToEthereums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelayedSendToEthereums = append(m.DelayedSendToEthereums, DelayedSendToEthereum{})
+			if err := m.DelayedSendToEthereums[len(m.DelayedSendToEthereums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeightVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumHeightVotes = append(m.EthereumHeightVotes, ValidatorEthereumHeightVote{})
+			if err := m.EthereumHeightVotes[len(m.EthereumHeightVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventNoncesByValidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastEventNoncesByValidator = append(m.LastEventNoncesByValidator, ValidatorEventNonce{})
+			if err := m.LastEventNoncesByValidator[len(m.LastEventNoncesByValidator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastObservedEthereumHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSendToEthereumId", wireType)
+			}
+			m.LastSendToEthereumId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSendToEthereumId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOutgoingBatchNonce", wireType)
+			}
+			m.LastOutgoingBatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOutgoingBatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSignerSetTxNonce", wireType)
+			}
+			m.LatestSignerSetTxNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestSignerSetTxNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLivenessHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorLivenessHeights = append(m.ValidatorLivenessHeights, ValidatorLivenessHeights{})
+			if err := m.ValidatorLivenessHeights[len(m.ValidatorLivenessHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumTxHashEventVoteRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumTxHashEventVoteRecords = append(m.EthereumTxHashEventVoteRecords, &EthereumEventVoteRecord{})
+			if err := m.EthereumTxHashEventVoteRecords[len(m.EthereumTxHashEventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldSendToCosmosEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeldSendToCosmosEvents = append(m.HeldSendToCosmosEvents, &SendToCosmosEvent{})
+			if err := m.HeldSendToCosmosEvents[len(m.HeldSendToCosmosEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBatchTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutedBatchTxs = append(m.ExecutedBatchTxs, BatchTxExecutionRecord{})
+			if err := m.ExecutedBatchTxs[len(m.ExecutedBatchTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSignatureSchemes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSignatureSchemes = append(m.ValidatorSignatureSchemes, ValidatorSignatureScheme{})
+			if err := m.ValidatorSignatureSchemes[len(m.ValidatorSignatureSchemes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludedEthereumSigners", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1011,13 +7383,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GravityId = string(dAtA[iNdEx:postIndex])
+			m.ExcludedEthereumSigners = append(m.ExcludedEthereumSigners, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedContractCallTxs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1027,29 +7399,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			m.ExecutedContractCallTxs = append(m.ExecutedContractCallTxs, ContractCallTxExecutionRecord{})
+			if err := m.ExecutedContractCallTxs[len(m.ExecutedContractCallTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFeeAllowances", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1059,29 +7433,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.BridgeFeeAllowances = append(m.BridgeFeeAllowances, BridgeFeeAllowance{})
+			if err := m.BridgeFeeAllowances[len(m.BridgeFeeAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PastEthereumSignatureCheckpoints", wireType)
 			}
-			m.BridgeChainId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1091,16 +7467,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedSignerSetTxsWindow", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.SignedSignerSetTxsWindow = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PastEthereumSignatureCheckpoints = append(m.PastEthereumSignatureCheckpoints, make([]byte, postIndex-iNdEx))
+			copy(m.PastEthereumSignatureCheckpoints[len(m.PastEthereumSignatureCheckpoints)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadEthereumSignatureEvidence", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1110,16 +7499,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.SignedBatchesWindow = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BadEthereumSignatureEvidence = append(m.BadEthereumSignatureEvidence, make([]byte, postIndex-iNdEx))
+			copy(m.BadEthereumSignatureEvidence[len(m.BadEthereumSignatureEvidence)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedContractCallTxs", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1129,16 +7531,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSignaturesWindow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.EthereumSignaturesWindow = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedContractCallTxs = append(m.RefundedContractCallTxs, ContractCallTxRefundRecord{})
+			if err := m.RefundedContractCallTxs[len(m.RefundedContractCallTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetTxDeltas", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1148,16 +7565,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumSignaturesWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetEthTxTimeout", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.TargetEthTxTimeout = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerSetTxDeltas = append(m.SignerSetTxDeltas, SignerSetTxDelta{})
+			if err := m.SignerSetTxDeltas[len(m.SignerSetTxDeltas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedOutValidators", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1167,16 +7599,167 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetEthTxTimeout |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.AverageBlockTime = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptedOutValidators = append(m.OptedOutValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeStatsBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeStatsBuckets = append(m.BridgeStatsBuckets, BridgeStatsBucket{})
+			if err := m.BridgeStatsBuckets[len(m.BridgeStatsBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgreedEthereumHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AgreedEthereumHeader == nil {
+				m.AgreedEthereumHeader = &EthereumHeader{}
+			}
+			if err := m.AgreedEthereumHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeaderVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumHeaderVotes = append(m.EthereumHeaderVotes, EthereumHeaderVote{})
+			if err := m.EthereumHeaderVotes[len(m.EthereumHeaderVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorFreezes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorFreezes = append(m.OrchestratorFreezes, OrchestratorFreeze{})
+			if err := m.OrchestratorFreezes[len(m.OrchestratorFreezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PastOutgoingTxNonces", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1186,16 +7769,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.AverageEthereumBlockTime = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PastOutgoingTxNonces = append(m.PastOutgoingTxNonces, PastOutgoingTxNonce{})
+			if err := m.PastOutgoingTxNonces[len(m.PastOutgoingTxNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgreedEthereumHeaders", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1205,16 +7803,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgreedEthereumHeaders = append(m.AgreedEthereumHeaders, EthereumHeader{})
+			if err := m.AgreedEthereumHeaders[len(m.AgreedEthereumHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionSignerSetTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedEthereumEvents", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1224,30 +7837,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ObservedEthereumEvents = append(m.ObservedEthereumEvents, ObservedEthereumEvent{})
+			if err := m.ObservedEthereumEvents[len(m.ObservedEthereumEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 50:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgedSupplies", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1257,28 +7871,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BridgedSupplies = append(m.BridgedSupplies, ERC20Token{})
+			if err := m.BridgedSupplies[len(m.BridgedSupplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PastOutgoingTxNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PastOutgoingTxNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PastOutgoingTxNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEthereumSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1305,15 +7970,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Scope = append(m.Scope[:0], dAtA[iNdEx:postIndex]...)
+			if m.Scope == nil {
+				m.Scope = []byte{}
 			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingEthereumSignature", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var byteLen int
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1323,30 +7989,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionConflictingEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEthereumHeightVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingSignerSetTxsWindow", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.UnbondSlashingSignerSetTxsWindow = 0
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEthereumHeightVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEthereumHeightVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1356,16 +8058,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondSlashingSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeActive", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1375,17 +8090,80 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BridgeActive = bool(v != 0)
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchCreationPeriod", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEventNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEventNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEventNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.BatchCreationPeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1395,35 +8173,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchCreationPeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchMaxElement", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.BatchMaxElement = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BatchMaxElement |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 21:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserveEthereumHeightPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			m.ObserveEthereumHeightPeriod = 0
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1433,7 +8205,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObserveEthereumHeightPeriod |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1459,7 +8231,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *ValidatorLivenessHeights) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1482,17 +8254,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorLivenessHeights: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorLivenessHeights: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1502,33 +8274,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSignatureHeight", wireType)
 			}
-			m.LastObservedEventNonce = 0
+			m.LastSignatureHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1538,16 +8306,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				m.LastSignatureHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventVoteHeight", wireType)
 			}
-			var msglen int
+			m.LastEventVoteHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1557,31 +8325,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastEventVoteHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OutgoingTxs = append(m.OutgoingTxs, &types.Any{})
-			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedSignatures", wireType)
 			}
-			var msglen int
+			m.MissedSignatures = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1591,31 +8344,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MissedSignatures |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Confirmations = append(m.Confirmations, &types.Any{})
-			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEthereumAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 9:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEthereumAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEthereumAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventVoteRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1625,31 +8413,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumEventVoteRecords = append(m.EthereumEventVoteRecords, &EthereumEventVoteRecord{})
-			if err := m.EthereumEventVoteRecords[len(m.EthereumEventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1659,31 +8445,79 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSignatureScheme) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSignatureScheme: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSignatureScheme: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1693,31 +8527,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
-			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedSendToEthereumTxs", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
 			}
-			var msglen int
+			m.Scheme = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1727,26 +8559,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Scheme |= SignatureScheme(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UnbatchedSendToEthereumTxs = append(m.UnbatchedSendToEthereumTxs, &SendToEthereum{})
-			if err := m.UnbatchedSendToEthereumTxs[len(m.UnbatchedSendToEthereumTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1965,4 +8782,4 @@ var (
 	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
 	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
 	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
-)
\ No newline at end of file
+)
@@ -3,6 +3,9 @@ package types
 import (
 	"testing"
 
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -88,6 +91,119 @@ func TestGenesisStateValidate(t *testing.T) {
 				},
 			},
 		}, expErr: true},
+		"event vote power threshold under the lower bound": {src: &GenesisState{
+			Params: withEventVotePowerThreshold(sdk.NewDecWithPrec(51, 2)),
+		}, expErr: true},
+		"event vote power threshold at the upper bound": {src: &GenesisState{
+			Params: withEventVotePowerThreshold(sdk.NewDecWithPrec(90, 2)),
+		}, expErr: false},
+		"event vote power threshold over the upper bound": {src: &GenesisState{
+			Params: withEventVotePowerThreshold(sdk.NewDecWithPrec(91, 2)),
+		}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGenesisStateCrossReferences(t *testing.T) {
+	delegate := func(val, orch string) *MsgDelegateKeys {
+		return &MsgDelegateKeys{
+			ValidatorAddress:    val,
+			OrchestratorAddress: orch,
+			EthereumAddress:     "0xFDb0aaBD40774BBF3068Bf29E8b0a6C88BE26F83",
+			EthSignature:        []byte("unused"),
+		}
+	}
+	keys := delegate("cosmosvaloper13yfm8as7y0mzsxqkfmk5jvgm45aez0u24jk95z", "cosmos1h706wwrghfpydyh735aet8aluhf95dqj0psgyf")
+	signerSet, err := PackOutgoingTx(&SignerSetTx{Nonce: 3})
+	require.NoError(t, err)
+	confirmation := func(nonce uint64) *cdctypes.Any {
+		conf, err := PackConfirmation(&SignerSetTxConfirmation{
+			SignerSetNonce: nonce,
+			EthereumSigner: keys.EthereumAddress,
+			Signature:      []byte("signature"),
+		})
+		require.NoError(t, err)
+		return conf
+	}
+	send := func(contract, chainFeeDenom string) *SendToEthereum {
+		ste := &SendToEthereum{
+			Id:         5,
+			Erc20Token: ERC20Token{Contract: contract, Amount: sdk.NewInt(100)},
+			Erc20Fee:   ERC20Token{Contract: contract, Amount: sdk.ZeroInt()},
+		}
+		if chainFeeDenom != "" {
+			chainFee := sdk.NewCoin(chainFeeDenom, sdk.ZeroInt())
+			ste.ChainFee = &chainFee
+		}
+		return ste
+	}
+	erc20 := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+
+	specs := map[string]struct {
+		src    *GenesisState
+		expErr bool
+	}{
+		"signature of an exported outgoing tx": {src: &GenesisState{
+			Params:        DefaultParams(),
+			DelegateKeys:  []*MsgDelegateKeys{keys},
+			OutgoingTxs:   []*cdctypes.Any{signerSet},
+			Confirmations: []*cdctypes.Any{confirmation(3)},
+		}, expErr: false},
+		"signature of a missing outgoing tx": {src: &GenesisState{
+			Params:        DefaultParams(),
+			DelegateKeys:  []*MsgDelegateKeys{keys},
+			OutgoingTxs:   []*cdctypes.Any{signerSet},
+			Confirmations: []*cdctypes.Any{confirmation(4)},
+		}, expErr: true},
+		"signature without delegate keys": {src: &GenesisState{
+			Params:        DefaultParams(),
+			OutgoingTxs:   []*cdctypes.Any{signerSet},
+			Confirmations: []*cdctypes.Any{confirmation(3)},
+		}, expErr: true},
+		"delegate keys sharing an ethereum address": {src: &GenesisState{
+			Params: DefaultParams(),
+			DelegateKeys: []*MsgDelegateKeys{
+				keys,
+				delegate("cosmosvaloper1jpz0ahls2chajf78nkqczdwwuqcu97w6z3plt4", "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn"),
+			},
+		}, expErr: true},
+		"unbatched send of a gravity voucher": {src: &GenesisState{
+			Params:                     DefaultParams(),
+			UnbatchedSendToEthereumTxs: []*SendToEthereum{send(erc20, GravityDenom(common.HexToAddress(erc20)))},
+		}, expErr: false},
+		"unbatched send of a mapped cosmos denom": {src: &GenesisState{
+			Params:                     DefaultParams(),
+			Erc20ToDenoms:              []*ERC20ToDenom{{Erc20: erc20, Denom: "uatom"}},
+			UnbatchedSendToEthereumTxs: []*SendToEthereum{send(erc20, "uatom")},
+		}, expErr: false},
+		"unbatched send of an unmapped cosmos denom": {src: &GenesisState{
+			Params:                     DefaultParams(),
+			UnbatchedSendToEthereumTxs: []*SendToEthereum{send(erc20, "uatom")},
+		}, expErr: true},
+		"send to ethereum id counter below an unbatched send": {src: &GenesisState{
+			Params:                     DefaultParams(),
+			UnbatchedSendToEthereumTxs: []*SendToEthereum{send(erc20, "")},
+			LastSendToEthereumId:       4,
+		}, expErr: true},
+		"signer set nonce counter below an outgoing signer set": {src: &GenesisState{
+			Params:                 DefaultParams(),
+			OutgoingTxs:            []*cdctypes.Any{signerSet},
+			LatestSignerSetTxNonce: 2,
+		}, expErr: true},
+		"unset counters": {src: &GenesisState{
+			Params:                     DefaultParams(),
+			OutgoingTxs:                []*cdctypes.Any{signerSet},
+			UnbatchedSendToEthereumTxs: []*SendToEthereum{send(erc20, "")},
+		}, expErr: false},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -101,6 +217,12 @@ func TestGenesisStateValidate(t *testing.T) {
 	}
 }
 
+func withEventVotePowerThreshold(threshold sdk.Dec) *Params {
+	params := DefaultParams()
+	params.EventVotePowerThreshold = threshold
+	return params
+}
+
 func TestStringToByteArray(t *testing.T) {
 	specs := map[string]struct {
 		testString string
@@ -122,3 +244,51 @@ func TestStringToByteArray(t *testing.T) {
 		})
 	}
 }
+
+func TestParamsValidate(t *testing.T) {
+	specs := map[string]struct {
+		modify func(p *Params)
+		expErr bool
+	}{
+		"default params": {func(p *Params) {}, false},
+		"empty gravity id": {func(p *Params) {
+			p.GravityId = ""
+		}, true},
+		"gravity id too long": {func(p *Params) {
+			p.GravityId = "lakjsdflaksdjfdslakjsdflaksdjfdsx"
+		}, true},
+		"invalid bridge contract address": {func(p *Params) {
+			p.BridgeEthereumAddress = "0xinvalid"
+		}, true},
+		"zero average block time": {func(p *Params) {
+			p.AverageBlockTime = 0
+		}, true},
+		"zero average ethereum block time": {func(p *Params) {
+			p.AverageEthereumBlockTime = 0
+		}, true},
+		"timeout at twice the ethereum block time": {func(p *Params) {
+			p.AverageEthereumBlockTime = 100_000
+			p.TargetEthTxTimeout = 200_000
+		}, true},
+		"timeout above twice the ethereum block time": {func(p *Params) {
+			p.AverageEthereumBlockTime = 100_000
+			p.TargetEthTxTimeout = 200_001
+		}, false},
+		"average ethereum block time typo": {func(p *Params) {
+			p.AverageEthereumBlockTime = 150_000_000
+		}, true},
+	}
+
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			params := DefaultParams()
+			spec.modify(params)
+			err := params.Validate()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +12,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/regen-network/cosmos-proto"
+	github_com_tendermint_tendermint_libs_bytes "github.com/tendermint/tendermint/libs/bytes"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -27,6 +29,76 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// EthereumAnomaly is an Ethereum-side condition that prevents a token from
+// being withdrawn from the bridge, as reported by the orchestrators
+type EthereumAnomaly int32
+
+const (
+	ETHEREUM_ANOMALY_UNSPECIFIED EthereumAnomaly = 0
+	// the token contract is paused
+	ETHEREUM_ANOMALY_TOKEN_PAUSED EthereumAnomaly = 1
+	// the token contract blacklisted the gravity contract
+	ETHEREUM_ANOMALY_BRIDGE_BLACKLISTED EthereumAnomaly = 2
+	// a previously reported anomaly no longer applies
+	ETHEREUM_ANOMALY_RESOLVED EthereumAnomaly = 3
+)
+
+var EthereumAnomaly_name = map[int32]string{
+	0: "ETHEREUM_ANOMALY_UNSPECIFIED",
+	1: "ETHEREUM_ANOMALY_TOKEN_PAUSED",
+	2: "ETHEREUM_ANOMALY_BRIDGE_BLACKLISTED",
+	3: "ETHEREUM_ANOMALY_RESOLVED",
+}
+
+var EthereumAnomaly_value = map[string]int32{
+	"ETHEREUM_ANOMALY_UNSPECIFIED":        0,
+	"ETHEREUM_ANOMALY_TOKEN_PAUSED":       1,
+	"ETHEREUM_ANOMALY_BRIDGE_BLACKLISTED": 2,
+	"ETHEREUM_ANOMALY_RESOLVED":           3,
+}
+
+func (x EthereumAnomaly) String() string {
+	return proto.EnumName(EthereumAnomaly_name, int32(x))
+}
+
+func (EthereumAnomaly) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{0}
+}
+
+// SignatureScheme is the scheme of the signatures of a validator over the
+// checkpoints of outgoing txs, selected when its delegate keys are set
+type SignatureScheme int32
+
+const (
+	// an ECDSA signature by the ethereum key of the validator, the scheme the
+	// gravity contract verifies
+	SIGNATURE_SCHEME_ECDSA SignatureScheme = 0
+	// a signature checked by the contract wallet of the validator per EIP-1271
+	SIGNATURE_SCHEME_EIP1271 SignatureScheme = 1
+	// a BLS signature, which can be aggregated with those of other validators
+	SIGNATURE_SCHEME_BLS SignatureScheme = 2
+)
+
+var SignatureScheme_name = map[int32]string{
+	0: "SIGNATURE_SCHEME_ECDSA",
+	1: "SIGNATURE_SCHEME_EIP1271",
+	2: "SIGNATURE_SCHEME_BLS",
+}
+
+var SignatureScheme_value = map[string]int32{
+	"SIGNATURE_SCHEME_ECDSA":   0,
+	"SIGNATURE_SCHEME_EIP1271": 1,
+	"SIGNATURE_SCHEME_BLS":     2,
+}
+
+func (x SignatureScheme) String() string {
+	return proto.EnumName(SignatureScheme_name, int32(x))
+}
+
+func (SignatureScheme) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{1}
+}
+
 // EthereumEventVoteRecord is an event that is pending of confirmation by 2/3 of
 // the signer set. The event is then attested and executed in the state machine
 // once the required threshold is met.
@@ -34,6 +106,9 @@ type EthereumEventVoteRecord struct {
 	Event    *types.Any `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	Votes    []string   `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
 	Accepted bool       `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// ethereum block height the event was claimed at, which must be buried
+	// under the ethereum event confirmation depth before the event is observed
+	EthereumHeight uint64 `protobuf:"varint,4,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
 }
 
 func (m *EthereumEventVoteRecord) Reset()         { *m = EthereumEventVoteRecord{} }
@@ -90,6 +165,13 @@ func (m *EthereumEventVoteRecord) GetAccepted() bool {
 	return false
 }
 
+func (m *EthereumEventVoteRecord) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
+	}
+	return 0
+}
+
 // LatestEthereumBlockHeight defines the latest observed ethereum block height
 // and the corresponding timestamp value in nanoseconds.
 type LatestEthereumBlockHeight struct {
@@ -262,6 +344,95 @@ func (m *SignerSetTx) GetSigners() EthereumSigners {
 	return nil
 }
 
+// SignerSetTxDelta is the difference between a signer set and the one before
+// it, letting relayers update the bridge with the changed signers only
+type SignerSetTxDelta struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// nonce of the signer set the delta applies to, zero for the first one
+	PreviousNonce uint64          `protobuf:"varint,2,opt,name=previous_nonce,json=previousNonce,proto3" json:"previous_nonce,omitempty"`
+	Height        uint64          `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Added         EthereumSigners `protobuf:"bytes,4,rep,name=added,proto3,castrepeated=EthereumSigners" json:"added,omitempty"`
+	// the removed signers with their power in the previous signer set
+	Removed EthereumSigners `protobuf:"bytes,5,rep,name=removed,proto3,castrepeated=EthereumSigners" json:"removed,omitempty"`
+	// the signers of both signer sets with their new power
+	PowerChanged EthereumSigners `protobuf:"bytes,6,rep,name=power_changed,json=powerChanged,proto3,castrepeated=EthereumSigners" json:"power_changed,omitempty"`
+}
+
+func (m *SignerSetTxDelta) Reset()         { *m = SignerSetTxDelta{} }
+func (m *SignerSetTxDelta) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxDelta) ProtoMessage()    {}
+func (*SignerSetTxDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{4}
+}
+func (m *SignerSetTxDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSetTxDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetTxDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerSetTxDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxDelta.Merge(m, src)
+}
+func (m *SignerSetTxDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSetTxDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerSetTxDelta proto.InternalMessageInfo
+
+func (m *SignerSetTxDelta) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *SignerSetTxDelta) GetPreviousNonce() uint64 {
+	if m != nil {
+		return m.PreviousNonce
+	}
+	return 0
+}
+
+func (m *SignerSetTxDelta) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SignerSetTxDelta) GetAdded() EthereumSigners {
+	if m != nil {
+		return m.Added
+	}
+	return nil
+}
+
+func (m *SignerSetTxDelta) GetRemoved() EthereumSigners {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
+}
+
+func (m *SignerSetTxDelta) GetPowerChanged() EthereumSigners {
+	if m != nil {
+		return m.PowerChanged
+	}
+	return nil
+}
+
 // BatchTx represents a batch of transactions going from Cosmos to Ethereum.
 // Batch txs are are identified by a unique hash and the token contract that is
 // shared by all the SendToEthereum
@@ -271,13 +442,19 @@ type BatchTx struct {
 	Transactions  []*SendToEthereum `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
 	TokenContract string            `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
 	Height        uint64            `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// fees of the batch's txs paid in the bridge fee denom, paid out to the
+	// relayer on the cosmos side once the batch is executed
+	BridgeFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=bridge_fees,json=bridgeFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bridge_fees"`
+	// ethereum gas the batch is estimated to cost when relayed, computed from
+	// the batch gas params and the signer set when the batch was built
+	GasEstimate uint64 `protobuf:"varint,7,opt,name=gas_estimate,json=gasEstimate,proto3" json:"gas_estimate,omitempty"`
 }
 
 func (m *BatchTx) Reset()         { *m = BatchTx{} }
 func (m *BatchTx) String() string { return proto.CompactTextString(m) }
 func (*BatchTx) ProtoMessage()    {}
 func (*BatchTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{4}
+	return fileDescriptor_1715a041eadeb531, []int{5}
 }
 func (m *BatchTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,6 +518,20 @@ func (m *BatchTx) GetHeight() uint64 {
 	return 0
 }
 
+func (m *BatchTx) GetBridgeFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BridgeFees
+	}
+	return nil
+}
+
+func (m *BatchTx) GetGasEstimate() uint64 {
+	if m != nil {
+		return m.GasEstimate
+	}
+	return 0
+}
+
 // SendToEthereum represents an individual SendToEthereum from Cosmos to
 // Ethereum
 type SendToEthereum struct {
@@ -349,13 +540,34 @@ type SendToEthereum struct {
 	EthereumRecipient string     `protobuf:"bytes,3,opt,name=ethereum_recipient,json=ethereumRecipient,proto3" json:"ethereum_recipient,omitempty"`
 	Erc20Token        ERC20Token `protobuf:"bytes,4,opt,name=erc20_token,json=erc20Token,proto3" json:"erc20_token"`
 	Erc20Fee          ERC20Token `protobuf:"bytes,5,opt,name=erc20_fee,json=erc20Fee,proto3" json:"erc20_fee"`
+	// cosmos block height the tx was created at
+	Height uint64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// fee paid in the bridge fee denom instead of the token, erc20_fee is zero
+	// then
+	BridgeFee *types1.Coin `protobuf:"bytes,7,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee,omitempty"`
+	// number of times a batch holding the tx timed out on ethereum, which
+	// raises its priority in the next batches of the token
+	BatchTimeouts uint64 `protobuf:"varint,8,opt,name=batch_timeouts,json=batchTimeouts,proto3" json:"batch_timeouts,omitempty"`
+	// chain fee deducted from the amount, escrowed until the batch holding the
+	// tx executes and refunded along with the amount otherwise
+	ChainFee *types1.Coin `protobuf:"bytes,9,opt,name=chain_fee,json=chainFee,proto3" json:"chain_fee,omitempty"`
+	// priority txs go into the batches of their token before the others,
+	// whatever their fee. Only sender module accounts and gov proposals can
+	// prioritize txs.
+	Priority bool `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	// account that paid the fee out of its bridge fee allowance to the sender,
+	// the fee is refunded to it rather than to the sender
+	FeeGranter string `protobuf:"bytes,11,opt,name=fee_granter,json=feeGranter,proto3" json:"fee_granter,omitempty"`
+	// cosmos block height the tx can only be batched after, zero if it can be
+	// batched right away
+	ExecuteAfterHeight uint64 `protobuf:"varint,12,opt,name=execute_after_height,json=executeAfterHeight,proto3" json:"execute_after_height,omitempty"`
 }
 
 func (m *SendToEthereum) Reset()         { *m = SendToEthereum{} }
 func (m *SendToEthereum) String() string { return proto.CompactTextString(m) }
 func (*SendToEthereum) ProtoMessage()    {}
 func (*SendToEthereum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{5}
+	return fileDescriptor_1715a041eadeb531, []int{6}
 }
 func (m *SendToEthereum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,6 +631,55 @@ func (m *SendToEthereum) GetErc20Fee() ERC20Token {
 	return ERC20Token{}
 }
 
+func (m *SendToEthereum) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SendToEthereum) GetBridgeFee() *types1.Coin {
+	if m != nil {
+		return m.BridgeFee
+	}
+	return nil
+}
+
+func (m *SendToEthereum) GetBatchTimeouts() uint64 {
+	if m != nil {
+		return m.BatchTimeouts
+	}
+	return 0
+}
+
+func (m *SendToEthereum) GetChainFee() *types1.Coin {
+	if m != nil {
+		return m.ChainFee
+	}
+	return nil
+}
+
+func (m *SendToEthereum) GetPriority() bool {
+	if m != nil {
+		return m.Priority
+	}
+	return false
+}
+
+func (m *SendToEthereum) GetFeeGranter() string {
+	if m != nil {
+		return m.FeeGranter
+	}
+	return ""
+}
+
+func (m *SendToEthereum) GetExecuteAfterHeight() uint64 {
+	if m != nil {
+		return m.ExecuteAfterHeight
+	}
+	return 0
+}
+
 // ContractCallTx represents an individual arbitrary logic call transaction
 // from Cosmos to Ethereum.
 type ContractCallTx struct {
@@ -430,13 +691,21 @@ type ContractCallTx struct {
 	Tokens            []ERC20Token `protobuf:"bytes,6,rep,name=tokens,proto3" json:"tokens"`
 	Fees              []ERC20Token `protobuf:"bytes,7,rep,name=fees,proto3" json:"fees"`
 	Height            uint64       `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	// calls of a multi call, executed in order and atomically by the gravity
+	// contract, address and payload are empty then. The fees pay for all of
+	// them.
+	Calls []ContractCall `protobuf:"bytes,9,rep,name=calls,proto3" json:"calls"`
+	// module name or account address the tokens and fees of the call were
+	// escrowed from, refunded to it if the call times out or is invalidated.
+	// Empty when nothing was escrowed.
+	Refundee string `protobuf:"bytes,10,opt,name=refundee,proto3" json:"refundee,omitempty"`
 }
 
 func (m *ContractCallTx) Reset()         { *m = ContractCallTx{} }
 func (m *ContractCallTx) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTx) ProtoMessage()    {}
 func (*ContractCallTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{6}
+	return fileDescriptor_1715a041eadeb531, []int{7}
 }
 func (m *ContractCallTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,6 +790,75 @@ func (m *ContractCallTx) GetHeight() uint64 {
 	return 0
 }
 
+func (m *ContractCallTx) GetCalls() []ContractCall {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+func (m *ContractCallTx) GetRefundee() string {
+	if m != nil {
+		return m.Refundee
+	}
+	return ""
+}
+
+// ContractCall is a single call of a multi call contract call tx
+type ContractCall struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// wei of ether sent along with the call
+	Value github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"value"`
+}
+
+func (m *ContractCall) Reset()         { *m = ContractCall{} }
+func (m *ContractCall) String() string { return proto.CompactTextString(m) }
+func (*ContractCall) ProtoMessage()    {}
+func (*ContractCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{8}
+}
+func (m *ContractCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCall.Merge(m, src)
+}
+func (m *ContractCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCall proto.InternalMessageInfo
+
+func (m *ContractCall) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ContractCall) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
 type ERC20Token struct {
 	Contract string                                 `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
 	Amount   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
@@ -530,7 +868,7 @@ func (m *ERC20Token) Reset()         { *m = ERC20Token{} }
 func (m *ERC20Token) String() string { return proto.CompactTextString(m) }
 func (*ERC20Token) ProtoMessage()    {}
 func (*ERC20Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{7}
+	return fileDescriptor_1715a041eadeb531, []int{9}
 }
 func (m *ERC20Token) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -574,7 +912,7 @@ func (m *IDSet) Reset()         { *m = IDSet{} }
 func (m *IDSet) String() string { return proto.CompactTextString(m) }
 func (*IDSet) ProtoMessage()    {}
 func (*IDSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{8}
+	return fileDescriptor_1715a041eadeb531, []int{10}
 }
 func (m *IDSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,6 +948,68 @@ func (m *IDSet) GetIds() []uint64 {
 	return nil
 }
 
+// BridgeFeeAllowance lets the grantee have the fees of its sends to Ethereum
+// paid by the granter, up to the spend limit which decreases as fees are paid
+type BridgeFeeAllowance struct {
+	Granter    string                                   `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee    string                                   `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+}
+
+func (m *BridgeFeeAllowance) Reset()         { *m = BridgeFeeAllowance{} }
+func (m *BridgeFeeAllowance) String() string { return proto.CompactTextString(m) }
+func (*BridgeFeeAllowance) ProtoMessage()    {}
+func (*BridgeFeeAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{11}
+}
+func (m *BridgeFeeAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeFeeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeFeeAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeFeeAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeFeeAllowance.Merge(m, src)
+}
+func (m *BridgeFeeAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeFeeAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeFeeAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeFeeAllowance proto.InternalMessageInfo
+
+func (m *BridgeFeeAllowance) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *BridgeFeeAllowance) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *BridgeFeeAllowance) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
 type CommunityPoolEthereumSpendProposal struct {
 	Title       string      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Description string      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -621,7 +1021,7 @@ type CommunityPoolEthereumSpendProposal struct {
 func (m *CommunityPoolEthereumSpendProposal) Reset()      { *m = CommunityPoolEthereumSpendProposal{} }
 func (*CommunityPoolEthereumSpendProposal) ProtoMessage() {}
 func (*CommunityPoolEthereumSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{9}
+	return fileDescriptor_1715a041eadeb531, []int{12}
 }
 func (m *CommunityPoolEthereumSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,7 +1067,7 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Reset() {
 func (m *CommunityPoolEthereumSpendProposalForCLI) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolEthereumSpendProposalForCLI) ProtoMessage()    {}
 func (*CommunityPoolEthereumSpendProposalForCLI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{10}
+	return fileDescriptor_1715a041eadeb531, []int{13}
 }
 func (m *CommunityPoolEthereumSpendProposalForCLI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -696,954 +1096,11263 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolEthereumSpendProposalForCLI proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*EthereumEventVoteRecord)(nil), "gravity.v1.EthereumEventVoteRecord")
-	proto.RegisterType((*LatestEthereumBlockHeight)(nil), "gravity.v1.LatestEthereumBlockHeight")
-	proto.RegisterType((*EthereumSigner)(nil), "gravity.v1.EthereumSigner")
-	proto.RegisterType((*SignerSetTx)(nil), "gravity.v1.SignerSetTx")
-	proto.RegisterType((*BatchTx)(nil), "gravity.v1.BatchTx")
-	proto.RegisterType((*SendToEthereum)(nil), "gravity.v1.SendToEthereum")
-	proto.RegisterType((*ContractCallTx)(nil), "gravity.v1.ContractCallTx")
-	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
-	proto.RegisterType((*IDSet)(nil), "gravity.v1.IDSet")
-	proto.RegisterType((*CommunityPoolEthereumSpendProposal)(nil), "gravity.v1.CommunityPoolEthereumSpendProposal")
-	proto.RegisterType((*CommunityPoolEthereumSpendProposalForCLI)(nil), "gravity.v1.CommunityPoolEthereumSpendProposalForCLI")
+// EthereumBlocklistProposal adds and removes Ethereum addresses from the
+// blocklist. Sends to a blocked recipient are rejected and deposits from a
+// blocked sender are credited to the community pool instead of the receiver.
+type EthereumBlocklistProposal struct {
+	Title           string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AddAddresses    []string `protobuf:"bytes,3,rep,name=add_addresses,json=addAddresses,proto3" json:"add_addresses,omitempty"`
+	RemoveAddresses []string `protobuf:"bytes,4,rep,name=remove_addresses,json=removeAddresses,proto3" json:"remove_addresses,omitempty"`
 }
 
-func init() { proto.RegisterFile("gravity/v1/gravity.proto", fileDescriptor_1715a041eadeb531) }
-
-var fileDescriptor_1715a041eadeb531 = []byte{
-	// 1047 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcf, 0x6f, 0xe3, 0xc4,
-	0x17, 0x8f, 0x93, 0x36, 0x6d, 0x26, 0x69, 0xb6, 0x9d, 0x6f, 0xbf, 0xc5, 0xad, 0x50, 0x1c, 0x19,
-	0xb1, 0x64, 0x25, 0x6a, 0xb7, 0x61, 0x25, 0xa0, 0x68, 0x57, 0x5a, 0x87, 0xad, 0xa8, 0xb4, 0x42,
-	0x8b, 0x5b, 0x38, 0x70, 0xa9, 0x1c, 0xfb, 0xd5, 0x31, 0x75, 0x3c, 0x96, 0x3d, 0x09, 0xcd, 0x91,
-	0x0b, 0x42, 0x9c, 0x38, 0x72, 0xec, 0x99, 0x33, 0x47, 0x6e, 0x5c, 0x56, 0x9c, 0xf6, 0x08, 0x1c,
-	0x02, 0xb4, 0x17, 0xce, 0xf9, 0x0b, 0x90, 0xe7, 0x87, 0x6b, 0xef, 0xae, 0x28, 0xa7, 0xce, 0x7b,
-	0xef, 0xf3, 0x79, 0xf3, 0xe6, 0x33, 0x9f, 0x7a, 0x82, 0x54, 0x3f, 0x71, 0xa6, 0x01, 0x9d, 0x99,
-	0xd3, 0x7d, 0x53, 0x2c, 0x8d, 0x38, 0x21, 0x94, 0x60, 0x24, 0xc3, 0xe9, 0xfe, 0x4e, 0xc7, 0x25,
-	0xe9, 0x98, 0xa4, 0xe6, 0xd0, 0x49, 0xc1, 0x9c, 0xee, 0x0f, 0x81, 0x3a, 0xfb, 0xa6, 0x4b, 0x82,
-	0x88, 0x63, 0x77, 0xb6, 0x79, 0xfd, 0x94, 0x45, 0x26, 0x0f, 0x44, 0x69, 0xd3, 0x27, 0x3e, 0xe1,
-	0xf9, 0x6c, 0x25, 0x09, 0x3e, 0x21, 0x7e, 0x08, 0x26, 0x8b, 0x86, 0x93, 0x33, 0xd3, 0x89, 0xc4,
-	0xbe, 0xfa, 0xb7, 0x0a, 0x7a, 0xed, 0x31, 0x1d, 0x41, 0x02, 0x93, 0xf1, 0xe3, 0x29, 0x44, 0xf4,
-	0x33, 0x42, 0xc1, 0x06, 0x97, 0x24, 0x1e, 0x7e, 0x80, 0x96, 0x21, 0x4b, 0xa9, 0x4a, 0x57, 0xe9,
-	0x35, 0xfb, 0x9b, 0x06, 0x6f, 0x63, 0xc8, 0x36, 0xc6, 0xa3, 0x68, 0x66, 0x6d, 0xfc, 0xf2, 0xe3,
-	0xee, 0x5a, 0xa9, 0x83, 0xcd, 0x59, 0x78, 0x13, 0x2d, 0x4f, 0x09, 0x85, 0x54, 0xad, 0x76, 0x6b,
-	0xbd, 0x86, 0xcd, 0x03, 0xbc, 0x83, 0x56, 0x1d, 0xd7, 0x85, 0x98, 0x82, 0xa7, 0xd6, 0xba, 0x4a,
-	0x6f, 0xd5, 0xce, 0x63, 0x3d, 0x40, 0xdb, 0x4f, 0x1c, 0x0a, 0x29, 0x95, 0xfd, 0xac, 0x90, 0xb8,
-	0xe7, 0x1f, 0x41, 0xe0, 0x8f, 0x28, 0x7e, 0x0b, 0xdd, 0x01, 0x91, 0x3e, 0x1d, 0xb1, 0x14, 0x9b,
-	0x6b, 0xc9, 0x6e, 0xcb, 0xb4, 0x00, 0xbe, 0x81, 0xd6, 0x84, 0x40, 0x02, 0x56, 0x65, 0xb0, 0x16,
-	0x4f, 0x72, 0x90, 0xfe, 0x09, 0x6a, 0xcb, 0x4d, 0x8e, 0x03, 0x3f, 0x82, 0x24, 0x1b, 0x37, 0x26,
-	0x5f, 0x42, 0x22, 0xba, 0xf2, 0x00, 0xdf, 0x43, 0xeb, 0xf9, 0xae, 0x8e, 0xe7, 0x25, 0x90, 0xa6,
-	0xac, 0x5f, 0xc3, 0xce, 0xa7, 0x79, 0xc4, 0xd3, 0xfa, 0xd7, 0x0a, 0x6a, 0xf2, 0x5e, 0xc7, 0x40,
-	0x4f, 0x2e, 0xb2, 0x86, 0x11, 0x89, 0x5c, 0x90, 0x0d, 0x59, 0x80, 0xb7, 0x50, 0xbd, 0x34, 0x96,
-	0x88, 0xf0, 0x11, 0x5a, 0x49, 0x19, 0x39, 0x55, 0x6b, 0xdd, 0x5a, 0xaf, 0xd9, 0xdf, 0x31, 0x6e,
-	0x2c, 0x61, 0x94, 0x67, 0xb5, 0xfe, 0xf7, 0xc3, 0x1f, 0xda, 0x9d, 0x72, 0x2e, 0xb5, 0x25, 0x5f,
-	0xff, 0x59, 0x41, 0x2b, 0x96, 0x43, 0xdd, 0xd1, 0xc9, 0x05, 0xd6, 0x50, 0x73, 0x98, 0x2d, 0x4f,
-	0x8b, 0xa3, 0x20, 0x96, 0xfa, 0x98, 0xcd, 0xa3, 0xa2, 0x15, 0x1a, 0x8c, 0x81, 0x4c, 0xe4, 0x40,
-	0x32, 0xc4, 0x0f, 0x51, 0x8b, 0x26, 0x4e, 0x94, 0x3a, 0x2e, 0x0d, 0x48, 0xf4, 0xca, 0xb1, 0x8e,
-	0x21, 0xf2, 0x4e, 0x88, 0x1c, 0xc4, 0x2e, 0xe1, 0xf1, 0x9b, 0xa8, 0x4d, 0xc9, 0x39, 0x44, 0xa7,
-	0x2e, 0x89, 0x68, 0xe2, 0xb8, 0x54, 0x5d, 0x62, 0xc2, 0xad, 0xb1, 0xec, 0x40, 0x24, 0x0b, 0x82,
-	0x2c, 0x17, 0x05, 0xd1, 0xff, 0x52, 0x50, 0xbb, 0xdc, 0x1f, 0xb7, 0x51, 0x35, 0xf0, 0xc4, 0x19,
-	0xaa, 0x81, 0x97, 0x51, 0x53, 0x88, 0x3c, 0x48, 0xc4, 0x95, 0x88, 0x08, 0xef, 0x22, 0x9c, 0x5f,
-	0x5a, 0x02, 0x6e, 0x10, 0x07, 0x99, 0x8b, 0x6b, 0x0c, 0xb3, 0x21, 0x2b, 0xb6, 0x2c, 0xe0, 0x07,
-	0xa8, 0x09, 0x89, 0xdb, 0xdf, 0x3b, 0x65, 0x83, 0xb1, 0x29, 0x9b, 0xfd, 0xad, 0x92, 0xfc, 0xf6,
-	0xa0, 0xbf, 0x77, 0x92, 0x55, 0xad, 0xa5, 0x67, 0x73, 0xad, 0x62, 0x23, 0x46, 0x60, 0x19, 0xfc,
-	0x3e, 0x6a, 0x70, 0xfa, 0x19, 0x00, 0x3b, 0xc3, 0x6d, 0xe4, 0x55, 0x06, 0x3f, 0x04, 0xd0, 0x7f,
-	0xaa, 0xa2, 0xb6, 0x14, 0x62, 0xe0, 0x84, 0xe1, 0xc9, 0x45, 0x36, 0x7b, 0x10, 0x4d, 0x9d, 0x30,
-	0xf0, 0x9c, 0x4c, 0xc6, 0xd2, 0xbd, 0x6d, 0x14, 0x2b, 0xfc, 0xfa, 0x5e, 0x84, 0xa7, 0x2e, 0x89,
-	0x81, 0xc9, 0xd1, 0x2a, 0xc3, 0x8f, 0xb3, 0x42, 0x76, 0xdb, 0xd2, 0xc5, 0x5c, 0x0e, 0x19, 0x66,
-	0x95, 0xd8, 0x99, 0x85, 0xc4, 0xf1, 0x98, 0x00, 0x2d, 0x5b, 0x86, 0x45, 0x87, 0x2c, 0x97, 0x1d,
-	0x72, 0x1f, 0xd5, 0x99, 0x64, 0xa9, 0x5a, 0x67, 0xde, 0xf8, 0xf7, 0x63, 0x0b, 0x2c, 0xde, 0x43,
-	0x4b, 0x67, 0x00, 0xa9, 0xba, 0xf2, 0x1f, 0x38, 0x0c, 0x59, 0xb0, 0xc8, 0x6a, 0xc9, 0x22, 0x31,
-	0x42, 0x37, 0x8c, 0xec, 0xcb, 0x92, 0x3b, 0x4d, 0x61, 0x87, 0xcb, 0x63, 0x7c, 0x88, 0xea, 0xce,
-	0x98, 0x4c, 0x22, 0x6e, 0xf2, 0x86, 0x65, 0x64, 0xdd, 0x7f, 0x9f, 0x6b, 0x77, 0xfd, 0x80, 0x8e,
-	0x26, 0x43, 0xc3, 0x25, 0x63, 0xf1, 0x21, 0x15, 0x7f, 0x76, 0x53, 0xef, 0xdc, 0xa4, 0xb3, 0x18,
-	0x52, 0xe3, 0x28, 0xa2, 0xb6, 0x60, 0xeb, 0xdb, 0x68, 0xf9, 0xe8, 0xc3, 0x63, 0xa0, 0x78, 0x1d,
-	0xd5, 0x02, 0x2f, 0x55, 0x95, 0x6e, 0xad, 0xb7, 0x64, 0x67, 0x4b, 0xfd, 0xab, 0x2a, 0xd2, 0x07,
-	0x64, 0x3c, 0x9e, 0x44, 0x01, 0x9d, 0x3d, 0x25, 0x24, 0xcc, 0xff, 0x3f, 0x63, 0x88, 0xbc, 0xa7,
-	0x09, 0x89, 0x49, 0xea, 0x84, 0xd9, 0x57, 0x81, 0x06, 0x34, 0x04, 0x31, 0x22, 0x0f, 0x70, 0x17,
-	0x35, 0x3d, 0x48, 0xdd, 0x24, 0x88, 0xb3, 0xbb, 0x12, 0x76, 0x2e, 0xa6, 0xf0, 0xeb, 0xa8, 0xf1,
-	0xa2, 0x95, 0x6f, 0x12, 0xf8, 0xdd, 0xfc, 0x7c, 0xdc, 0xbd, 0xdb, 0x86, 0x78, 0x16, 0xb2, 0x37,
-	0xc4, 0x10, 0x6f, 0x88, 0x31, 0x20, 0x41, 0x7e, 0x19, 0x1c, 0x8e, 0x1f, 0x22, 0x34, 0x4c, 0x02,
-	0xcf, 0x87, 0x82, 0x7b, 0x6f, 0x25, 0x37, 0x38, 0xe5, 0x10, 0xe0, 0xa0, 0xf5, 0xcd, 0xa5, 0x56,
-	0xf9, 0xfe, 0x52, 0xab, 0xfc, 0x7d, 0xa9, 0x55, 0xf4, 0xdf, 0xaa, 0xa8, 0x77, 0xbb, 0x06, 0x87,
-	0x24, 0x19, 0x3c, 0x39, 0xc2, 0x77, 0x4b, 0x4a, 0x58, 0xeb, 0x8b, 0xb9, 0xd6, 0x9a, 0x39, 0xe3,
-	0xf0, 0x40, 0x67, 0x69, 0x5d, 0x6a, 0xf3, 0xde, 0x2b, 0xb4, 0xb1, 0xb6, 0x16, 0x73, 0x0d, 0x73,
-	0x74, 0xa1, 0xa8, 0x97, 0x35, 0xeb, 0xbf, 0xa4, 0x99, 0xb5, 0xb9, 0x98, 0x6b, 0xeb, 0x9c, 0x97,
-	0x97, 0xf4, 0xa2, 0x92, 0xf7, 0x4a, 0x4a, 0x36, 0xac, 0x8d, 0xc5, 0x5c, 0x5b, 0xe3, 0x04, 0xe1,
-	0x81, 0x5c, 0xbb, 0xfb, 0x2f, 0x69, 0xd7, 0xb0, 0xfe, 0xbf, 0x98, 0x6b, 0x1b, 0x1c, 0x7e, 0x53,
-	0xd3, 0x0b, 0x8a, 0xe1, 0xb7, 0xd1, 0x8a, 0x07, 0x31, 0x49, 0x03, 0xaa, 0xd6, 0x19, 0x05, 0x2f,
-	0xe6, 0x5a, 0x5b, 0x1e, 0x85, 0x15, 0x74, 0x5b, 0x42, 0x0e, 0x56, 0x85, 0xbe, 0x8a, 0xf5, 0xe9,
-	0xb3, 0xab, 0x8e, 0xf2, 0xfc, 0xaa, 0xa3, 0xfc, 0x79, 0xd5, 0x51, 0xbe, 0xbb, 0xee, 0x54, 0x9e,
-	0x5f, 0x77, 0x2a, 0xbf, 0x5e, 0x77, 0x2a, 0x9f, 0x7f, 0x50, 0x30, 0x71, 0x0c, 0xbe, 0x3f, 0xfb,
-	0x62, 0x2a, 0x7f, 0x5d, 0xec, 0xf2, 0x7d, 0xcd, 0x31, 0xf1, 0x26, 0x21, 0x98, 0xd3, 0xbe, 0x79,
-	0x21, 0x4b, 0xdc, 0xdd, 0xc3, 0x3a, 0x7b, 0xcd, 0xdf, 0xf9, 0x27, 0x00, 0x00, 0xff, 0xff, 0xc6,
-	0x10, 0x39, 0x2b, 0x9b, 0x08, 0x00, 0x00,
+func (m *EthereumBlocklistProposal) Reset()      { *m = EthereumBlocklistProposal{} }
+func (*EthereumBlocklistProposal) ProtoMessage() {}
+func (*EthereumBlocklistProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{14}
 }
-
-func (m *EthereumEventVoteRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EthereumBlocklistProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumBlocklistProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumBlocklistProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EthereumEventVoteRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EthereumBlocklistProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumBlocklistProposal.Merge(m, src)
+}
+func (m *EthereumBlocklistProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumBlocklistProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumBlocklistProposal.DiscardUnknown(m)
 }
 
-func (m *EthereumEventVoteRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Accepted {
-		i--
-		if m.Accepted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Votes) > 0 {
-		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Votes[iNdEx])
-			copy(dAtA[i:], m.Votes[iNdEx])
-			i = encodeVarintGravity(dAtA, i, uint64(len(m.Votes[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Event != nil {
-		{
-			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGravity(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_EthereumBlocklistProposal proto.InternalMessageInfo
+
+// This format of the Ethereum blocklist proposal is specifically for the CLI
+// to allow simple text serialization.
+type EthereumBlocklistProposalForCLI struct {
+	Title           string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description     string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	AddAddresses    []string `protobuf:"bytes,3,rep,name=add_addresses,json=addAddresses,proto3" json:"add_addresses,omitempty" yaml:"add_addresses"`
+	RemoveAddresses []string `protobuf:"bytes,4,rep,name=remove_addresses,json=removeAddresses,proto3" json:"remove_addresses,omitempty" yaml:"remove_addresses"`
+	Deposit         string   `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
 }
 
-func (m *LatestEthereumBlockHeight) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EthereumBlocklistProposalForCLI) Reset()         { *m = EthereumBlocklistProposalForCLI{} }
+func (m *EthereumBlocklistProposalForCLI) String() string { return proto.CompactTextString(m) }
+func (*EthereumBlocklistProposalForCLI) ProtoMessage()    {}
+func (*EthereumBlocklistProposalForCLI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{15}
+}
+func (m *EthereumBlocklistProposalForCLI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumBlocklistProposalForCLI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumBlocklistProposalForCLI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *LatestEthereumBlockHeight) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EthereumBlocklistProposalForCLI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumBlocklistProposalForCLI.Merge(m, src)
+}
+func (m *EthereumBlocklistProposalForCLI) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumBlocklistProposalForCLI) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumBlocklistProposalForCLI.DiscardUnknown(m)
 }
 
-func (m *LatestEthereumBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CosmosHeight != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.CosmosHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.EthereumHeight != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_EthereumBlocklistProposalForCLI proto.InternalMessageInfo
+
+// BridgeReenableProposal turns the bridge back on after it was halted, either
+// manually or by the circuit breaker
+type BridgeReenableProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (m *EthereumSigner) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BridgeReenableProposal) Reset()      { *m = BridgeReenableProposal{} }
+func (*BridgeReenableProposal) ProtoMessage() {}
+func (*BridgeReenableProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{16}
+}
+func (m *BridgeReenableProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeReenableProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeReenableProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *BridgeReenableProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeReenableProposal.Merge(m, src)
+}
+func (m *BridgeReenableProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeReenableProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeReenableProposal.DiscardUnknown(m)
 }
 
-func (m *EthereumSigner) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_BridgeReenableProposal proto.InternalMessageInfo
+
+// This format of the bridge re-enable proposal is specifically for the CLI to
+// allow simple text serialization.
+type BridgeReenableProposalForCLI struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Deposit     string `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
 }
 
-func (m *EthereumSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthereumAddress) > 0 {
-		i -= len(m.EthereumAddress)
-		copy(dAtA[i:], m.EthereumAddress)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Power != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x8
+func (m *BridgeReenableProposalForCLI) Reset()         { *m = BridgeReenableProposalForCLI{} }
+func (m *BridgeReenableProposalForCLI) String() string { return proto.CompactTextString(m) }
+func (*BridgeReenableProposalForCLI) ProtoMessage()    {}
+func (*BridgeReenableProposalForCLI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{17}
+}
+func (m *BridgeReenableProposalForCLI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeReenableProposalForCLI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeReenableProposalForCLI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BridgeReenableProposalForCLI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeReenableProposalForCLI.Merge(m, src)
+}
+func (m *BridgeReenableProposalForCLI) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeReenableProposalForCLI) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeReenableProposalForCLI.DiscardUnknown(m)
 }
 
-func (m *SignerSetTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BridgeReenableProposalForCLI proto.InternalMessageInfo
+
+// DelayedSendToEthereumVetoProposal vetoes sends to Ethereum held in the
+// delayed send queue. Their amount and fees are returned to the senders.
+type DelayedSendToEthereumVetoProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Ids         []uint64 `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (m *DelayedSendToEthereumVetoProposal) Reset()      { *m = DelayedSendToEthereumVetoProposal{} }
+func (*DelayedSendToEthereumVetoProposal) ProtoMessage() {}
+func (*DelayedSendToEthereumVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{18}
+}
+func (m *DelayedSendToEthereumVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedSendToEthereumVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedSendToEthereumVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *DelayedSendToEthereumVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedSendToEthereumVetoProposal.Merge(m, src)
+}
+func (m *DelayedSendToEthereumVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedSendToEthereumVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedSendToEthereumVetoProposal.DiscardUnknown(m)
 }
 
-func (m *SignerSetTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_DelayedSendToEthereumVetoProposal proto.InternalMessageInfo
+
+// This format of the delayed send to Ethereum veto proposal is specifically
+// for the CLI to allow simple text serialization.
+type DelayedSendToEthereumVetoProposalForCLI struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Ids         []uint64 `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids,omitempty" yaml:"ids"`
+	Deposit     string   `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
 }
 
-func (m *SignerSetTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGravity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *DelayedSendToEthereumVetoProposalForCLI) Reset() {
+	*m = DelayedSendToEthereumVetoProposalForCLI{}
+}
+func (m *DelayedSendToEthereumVetoProposalForCLI) String() string { return proto.CompactTextString(m) }
+func (*DelayedSendToEthereumVetoProposalForCLI) ProtoMessage()    {}
+func (*DelayedSendToEthereumVetoProposalForCLI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{19}
+}
+func (m *DelayedSendToEthereumVetoProposalForCLI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedSendToEthereumVetoProposalForCLI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedSendToEthereumVetoProposalForCLI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *DelayedSendToEthereumVetoProposalForCLI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedSendToEthereumVetoProposalForCLI.Merge(m, src)
+}
+func (m *DelayedSendToEthereumVetoProposalForCLI) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedSendToEthereumVetoProposalForCLI) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedSendToEthereumVetoProposalForCLI.DiscardUnknown(m)
 }
 
-func (m *BatchTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DelayedSendToEthereumVetoProposalForCLI proto.InternalMessageInfo
+
+// HeldSendToCosmosReleaseProposal releases deposits of tokens off the
+// allowlist held by the bridge, crediting them to their cosmos receivers.
+type HeldSendToCosmosReleaseProposal struct {
+	Title         string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	TokenContract string   `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	EventNonces   []uint64 `protobuf:"varint,4,rep,packed,name=event_nonces,json=eventNonces,proto3" json:"event_nonces,omitempty"`
+}
+
+func (m *HeldSendToCosmosReleaseProposal) Reset()      { *m = HeldSendToCosmosReleaseProposal{} }
+func (*HeldSendToCosmosReleaseProposal) ProtoMessage() {}
+func (*HeldSendToCosmosReleaseProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{20}
+}
+func (m *HeldSendToCosmosReleaseProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeldSendToCosmosReleaseProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeldSendToCosmosReleaseProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *HeldSendToCosmosReleaseProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeldSendToCosmosReleaseProposal.Merge(m, src)
+}
+func (m *HeldSendToCosmosReleaseProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeldSendToCosmosReleaseProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeldSendToCosmosReleaseProposal.DiscardUnknown(m)
 }
 
-func (m *BatchTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_HeldSendToCosmosReleaseProposal proto.InternalMessageInfo
+
+// This format of the held send to Cosmos release proposal is specifically
+// for the CLI to allow simple text serialization.
+type HeldSendToCosmosReleaseProposalForCLI struct {
+	Title         string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description   string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	TokenContract string   `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty" yaml:"token_contract"`
+	EventNonces   []uint64 `protobuf:"varint,4,rep,packed,name=event_nonces,json=eventNonces,proto3" json:"event_nonces,omitempty" yaml:"event_nonces"`
+	Deposit       string   `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
 }
 
-func (m *BatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0x22
+func (m *HeldSendToCosmosReleaseProposalForCLI) Reset()         { *m = HeldSendToCosmosReleaseProposalForCLI{} }
+func (m *HeldSendToCosmosReleaseProposalForCLI) String() string { return proto.CompactTextString(m) }
+func (*HeldSendToCosmosReleaseProposalForCLI) ProtoMessage()    {}
+func (*HeldSendToCosmosReleaseProposalForCLI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{21}
+}
+func (m *HeldSendToCosmosReleaseProposalForCLI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeldSendToCosmosReleaseProposalForCLI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeldSendToCosmosReleaseProposalForCLI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Transactions) > 0 {
-		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGravity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+}
+func (m *HeldSendToCosmosReleaseProposalForCLI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeldSendToCosmosReleaseProposalForCLI.Merge(m, src)
+}
+func (m *HeldSendToCosmosReleaseProposalForCLI) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeldSendToCosmosReleaseProposalForCLI) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeldSendToCosmosReleaseProposalForCLI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeldSendToCosmosReleaseProposalForCLI proto.InternalMessageInfo
+
+// EmergencySignerSetUpdateProposal forces the creation of a new signer set tx,
+// excluding the given compromised ethereum addresses from it until their
+// validators rotate their ethereum keys.
+type EmergencySignerSetUpdateProposal struct {
+	Title                     string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description               string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ExcludedEthereumAddresses []string `protobuf:"bytes,3,rep,name=excluded_ethereum_addresses,json=excludedEthereumAddresses,proto3" json:"excluded_ethereum_addresses,omitempty"`
+}
+
+func (m *EmergencySignerSetUpdateProposal) Reset()      { *m = EmergencySignerSetUpdateProposal{} }
+func (*EmergencySignerSetUpdateProposal) ProtoMessage() {}
+func (*EmergencySignerSetUpdateProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{22}
+}
+func (m *EmergencySignerSetUpdateProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmergencySignerSetUpdateProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmergencySignerSetUpdateProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Timeout != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *EmergencySignerSetUpdateProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmergencySignerSetUpdateProposal.Merge(m, src)
+}
+func (m *EmergencySignerSetUpdateProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmergencySignerSetUpdateProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmergencySignerSetUpdateProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmergencySignerSetUpdateProposal proto.InternalMessageInfo
+
+// This format of the emergency signer set update proposal is specifically
+// for the CLI to allow simple text serialization.
+type EmergencySignerSetUpdateProposalForCLI struct {
+	Title                     string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description               string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	ExcludedEthereumAddresses []string `protobuf:"bytes,3,rep,name=excluded_ethereum_addresses,json=excludedEthereumAddresses,proto3" json:"excluded_ethereum_addresses,omitempty" yaml:"excluded_ethereum_addresses"`
+	Deposit                   string   `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *EmergencySignerSetUpdateProposalForCLI) Reset() {
+	*m = EmergencySignerSetUpdateProposalForCLI{}
+}
+func (m *EmergencySignerSetUpdateProposalForCLI) String() string { return proto.CompactTextString(m) }
+func (*EmergencySignerSetUpdateProposalForCLI) ProtoMessage()    {}
+func (*EmergencySignerSetUpdateProposalForCLI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{23}
+}
+func (m *EmergencySignerSetUpdateProposalForCLI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmergencySignerSetUpdateProposalForCLI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmergencySignerSetUpdateProposalForCLI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.BatchNonce != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.BatchNonce))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EmergencySignerSetUpdateProposalForCLI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmergencySignerSetUpdateProposalForCLI.Merge(m, src)
+}
+func (m *EmergencySignerSetUpdateProposalForCLI) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmergencySignerSetUpdateProposalForCLI) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmergencySignerSetUpdateProposalForCLI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmergencySignerSetUpdateProposalForCLI proto.InternalMessageInfo
+
+// SendToEthereumPriorityProposal prioritizes sends to Ethereum of the
+// unbatched pool or the delayed send queue, so that they go into the next
+// batch of their token whatever their fee.
+type SendToEthereumPriorityProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Ids         []uint64 `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (m *SendToEthereumPriorityProposal) Reset()      { *m = SendToEthereumPriorityProposal{} }
+func (*SendToEthereumPriorityProposal) ProtoMessage() {}
+func (*SendToEthereumPriorityProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{24}
+}
+func (m *SendToEthereumPriorityProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendToEthereumPriorityProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendToEthereumPriorityProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SendToEthereumPriorityProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendToEthereumPriorityProposal.Merge(m, src)
+}
+func (m *SendToEthereumPriorityProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendToEthereumPriorityProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendToEthereumPriorityProposal.DiscardUnknown(m)
 }
 
-func (m *SendToEthereum) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SendToEthereumPriorityProposal proto.InternalMessageInfo
+
+// This format of the send to Ethereum priority proposal is specifically for
+// the CLI to allow simple text serialization.
+type SendToEthereumPriorityProposalForCLI struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Ids         []uint64 `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids,omitempty" yaml:"ids"`
+	Deposit     string   `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *SendToEthereumPriorityProposalForCLI) Reset()         { *m = SendToEthereumPriorityProposalForCLI{} }
+func (m *SendToEthereumPriorityProposalForCLI) String() string { return proto.CompactTextString(m) }
+func (*SendToEthereumPriorityProposalForCLI) ProtoMessage()    {}
+func (*SendToEthereumPriorityProposalForCLI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{25}
+}
+func (m *SendToEthereumPriorityProposalForCLI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendToEthereumPriorityProposalForCLI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendToEthereumPriorityProposalForCLI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *SendToEthereumPriorityProposalForCLI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendToEthereumPriorityProposalForCLI.Merge(m, src)
+}
+func (m *SendToEthereumPriorityProposalForCLI) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendToEthereumPriorityProposalForCLI) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendToEthereumPriorityProposalForCLI.DiscardUnknown(m)
 }
 
-func (m *SendToEthereum) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_SendToEthereumPriorityProposalForCLI proto.InternalMessageInfo
+
+// BridgeFlow is the amount of a token that entered and left the bridge in the
+// current circuit breaker window, along with the trailing averages of the
+// previous windows
+type BridgeFlow struct {
+	TokenContract  string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Inflow         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=inflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflow"`
+	Outflow        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=outflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow"`
+	AverageInflow  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=average_inflow,json=averageInflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"average_inflow"`
+	AverageOutflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=average_outflow,json=averageOutflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"average_outflow"`
 }
 
-func (m *SendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Erc20Fee.MarshalToSizedBuffer(dAtA[:i])
+func (m *BridgeFlow) Reset()         { *m = BridgeFlow{} }
+func (m *BridgeFlow) String() string { return proto.CompactTextString(m) }
+func (*BridgeFlow) ProtoMessage()    {}
+func (*BridgeFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{26}
+}
+func (m *BridgeFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGravity(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.Erc20Token.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *BridgeFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeFlow.Merge(m, src)
+}
+func (m *BridgeFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeFlow proto.InternalMessageInfo
+
+func (m *BridgeFlow) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+// BridgeStatsBucket is the usage of the bridge by a token in an hour of block
+// time, in the units of the ERC20. The outflow fees are the fees of the sends
+// to ethereum paid in the token.
+type BridgeStatsBucket struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	// hours since the unix epoch
+	Hour         uint64                                 `protobuf:"varint,2,opt,name=hour,proto3" json:"hour,omitempty"`
+	Inflow       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=inflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflow"`
+	Outflow      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=outflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow"`
+	InflowCount  uint64                                 `protobuf:"varint,5,opt,name=inflow_count,json=inflowCount,proto3" json:"inflow_count,omitempty"`
+	OutflowCount uint64                                 `protobuf:"varint,6,opt,name=outflow_count,json=outflowCount,proto3" json:"outflow_count,omitempty"`
+	OutflowFees  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=outflow_fees,json=outflowFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow_fees"`
+}
+
+func (m *BridgeStatsBucket) Reset()         { *m = BridgeStatsBucket{} }
+func (m *BridgeStatsBucket) String() string { return proto.CompactTextString(m) }
+func (*BridgeStatsBucket) ProtoMessage()    {}
+func (*BridgeStatsBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{27}
+}
+func (m *BridgeStatsBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeStatsBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeStatsBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGravity(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.EthereumRecipient) > 0 {
-		i -= len(m.EthereumRecipient)
-		copy(dAtA[i:], m.EthereumRecipient)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumRecipient)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *BridgeStatsBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeStatsBucket.Merge(m, src)
+}
+func (m *BridgeStatsBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeStatsBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeStatsBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeStatsBucket proto.InternalMessageInfo
+
+func (m *BridgeStatsBucket) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *BridgeStatsBucket) GetHour() uint64 {
+	if m != nil {
+		return m.Hour
 	}
-	if m.Id != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *BridgeStatsBucket) GetInflowCount() uint64 {
+	if m != nil {
+		return m.InflowCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ContractCallTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BridgeStatsBucket) GetOutflowCount() uint64 {
+	if m != nil {
+		return m.OutflowCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ContractCallTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ObservedSignerSetTx records a signer set once its update has been observed
+// on Ethereum, along with the Ethereum block height it took effect at
+type ObservedSignerSetTx struct {
+	SignerSet      SignerSetTx `protobuf:"bytes,1,opt,name=signer_set,json=signerSet,proto3" json:"signer_set"`
+	EthereumHeight uint64      `protobuf:"varint,2,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
 }
 
-func (m *ContractCallTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x40
+func (m *ObservedSignerSetTx) Reset()         { *m = ObservedSignerSetTx{} }
+func (m *ObservedSignerSetTx) String() string { return proto.CompactTextString(m) }
+func (*ObservedSignerSetTx) ProtoMessage()    {}
+func (*ObservedSignerSetTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{28}
+}
+func (m *ObservedSignerSetTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObservedSignerSetTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObservedSignerSetTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Fees) > 0 {
-		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGravity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGravity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.Timeout != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.InvalidationScope) > 0 {
-		i -= len(m.InvalidationScope)
-		copy(dAtA[i:], m.InvalidationScope)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.InvalidationScope)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.InvalidationNonce))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *ObservedSignerSetTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObservedSignerSetTx.Merge(m, src)
+}
+func (m *ObservedSignerSetTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObservedSignerSetTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObservedSignerSetTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObservedSignerSetTx proto.InternalMessageInfo
+
+func (m *ObservedSignerSetTx) GetSignerSet() SignerSetTx {
+	if m != nil {
+		return m.SignerSet
 	}
-	return len(dAtA) - i, nil
+	return SignerSetTx{}
 }
 
-func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ObservedSignerSetTx) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ERC20Token) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ObservedEthereumEvent is an entry of the log of the observed ethereum events
+// the bridge state is replayed from. The batch or contract call an execution
+// event settled is kept along with it, as the outgoing tx is deleted once
+// executed.
+type ObservedEthereumEvent struct {
+	Event                  *types.Any      `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	ExecutedBatchTx        *BatchTx        `protobuf:"bytes,2,opt,name=executed_batch_tx,json=executedBatchTx,proto3" json:"executed_batch_tx,omitempty"`
+	ExecutedContractCallTx *ContractCallTx `protobuf:"bytes,3,opt,name=executed_contract_call_tx,json=executedContractCallTx,proto3" json:"executed_contract_call_tx,omitempty"`
 }
 
-func (m *ERC20Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ObservedEthereumEvent) Reset()         { *m = ObservedEthereumEvent{} }
+func (m *ObservedEthereumEvent) String() string { return proto.CompactTextString(m) }
+func (*ObservedEthereumEvent) ProtoMessage()    {}
+func (*ObservedEthereumEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{29}
+}
+func (m *ObservedEthereumEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObservedEthereumEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObservedEthereumEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGravity(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ObservedEthereumEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObservedEthereumEvent.Merge(m, src)
+}
+func (m *ObservedEthereumEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObservedEthereumEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObservedEthereumEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObservedEthereumEvent proto.InternalMessageInfo
+
+func (m *ObservedEthereumEvent) GetEvent() *types.Any {
+	if m != nil {
+		return m.Event
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *IDSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ObservedEthereumEvent) GetExecutedBatchTx() *BatchTx {
+	if m != nil {
+		return m.ExecutedBatchTx
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *IDSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ObservedEthereumEvent) GetExecutedContractCallTx() *ContractCallTx {
+	if m != nil {
+		return m.ExecutedContractCallTx
+	}
+	return nil
 }
 
-func (m *IDSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		dAtA5 := make([]byte, len(m.Ids)*10)
-		var j4 int
-		for _, num := range m.Ids {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
+// ERC20Conversion scales amounts between the base units of an ERC20 and of the
+// Cosmos denom it maps to: one unit of the denom is worth
+// 10^(erc20_decimals - cosmos_exponent) units of the ERC20. Tokens without a
+// conversion use identical precision on both sides.
+type ERC20Conversion struct {
+	TokenContract  string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Erc20Decimals  uint32 `protobuf:"varint,2,opt,name=erc20_decimals,json=erc20Decimals,proto3" json:"erc20_decimals,omitempty"`
+	CosmosExponent uint32 `protobuf:"varint,3,opt,name=cosmos_exponent,json=cosmosExponent,proto3" json:"cosmos_exponent,omitempty"`
+}
+
+func (m *ERC20Conversion) Reset()         { *m = ERC20Conversion{} }
+func (m *ERC20Conversion) String() string { return proto.CompactTextString(m) }
+func (*ERC20Conversion) ProtoMessage()    {}
+func (*ERC20Conversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{30}
+}
+func (m *ERC20Conversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20Conversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20Conversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintGravity(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ERC20Conversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20Conversion.Merge(m, src)
+}
+func (m *ERC20Conversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20Conversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20Conversion.DiscardUnknown(m)
 }
 
-func (m *CommunityPoolEthereumSpendProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ERC20Conversion proto.InternalMessageInfo
+
+func (m *ERC20Conversion) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CommunityPoolEthereumSpendProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ERC20Conversion) GetErc20Decimals() uint32 {
+	if m != nil {
+		return m.Erc20Decimals
+	}
+	return 0
 }
 
-func (m *CommunityPoolEthereumSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGravity(dAtA, i, uint64(size))
+func (m *ERC20Conversion) GetCosmosExponent() uint32 {
+	if m != nil {
+		return m.CosmosExponent
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// EthereumAnomalyReport is the anomaly last reported by a validator for a
+// token
+type EthereumAnomalyReport struct {
+	TokenContract string          `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Validator     string          `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Anomaly       EthereumAnomaly `protobuf:"varint,3,opt,name=anomaly,proto3,enum=gravity.v1.EthereumAnomaly" json:"anomaly,omitempty"`
+}
+
+func (m *EthereumAnomalyReport) Reset()         { *m = EthereumAnomalyReport{} }
+func (m *EthereumAnomalyReport) String() string { return proto.CompactTextString(m) }
+func (*EthereumAnomalyReport) ProtoMessage()    {}
+func (*EthereumAnomalyReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{31}
+}
+func (m *EthereumAnomalyReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumAnomalyReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumAnomalyReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGravity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EthereumAnomalyReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumAnomalyReport.Merge(m, src)
+}
+func (m *EthereumAnomalyReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumAnomalyReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumAnomalyReport.DiscardUnknown(m)
 }
 
-func (m *CommunityPoolEthereumSpendProposalForCLI) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EthereumAnomalyReport proto.InternalMessageInfo
+
+func (m *EthereumAnomalyReport) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CommunityPoolEthereumSpendProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EthereumAnomalyReport) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
 }
 
-func (m *CommunityPoolEthereumSpendProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Deposit) > 0 {
-		i -= len(m.Deposit)
-		copy(dAtA[i:], m.Deposit)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
-		i--
-		dAtA[i] = 0x32
+func (m *EthereumAnomalyReport) GetAnomaly() EthereumAnomaly {
+	if m != nil {
+		return m.Anomaly
 	}
-	if len(m.BridgeFee) > 0 {
-		i -= len(m.BridgeFee)
-		copy(dAtA[i:], m.BridgeFee)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.BridgeFee)))
-		i--
-		dAtA[i] = 0x2a
+	return ETHEREUM_ANOMALY_UNSPECIFIED
+}
+
+// TokenPause records a token whose outbound flow was paused once the
+// validators attested an Ethereum-side anomaly
+type TokenPause struct {
+	TokenContract string          `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Anomaly       EthereumAnomaly `protobuf:"varint,2,opt,name=anomaly,proto3,enum=gravity.v1.EthereumAnomaly" json:"anomaly,omitempty"`
+	Height        uint64          `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *TokenPause) Reset()         { *m = TokenPause{} }
+func (m *TokenPause) String() string { return proto.CompactTextString(m) }
+func (*TokenPause) ProtoMessage()    {}
+func (*TokenPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{32}
+}
+func (m *TokenPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *TokenPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPause.Merge(m, src)
+}
+func (m *TokenPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPause proto.InternalMessageInfo
+
+func (m *TokenPause) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *TokenPause) GetAnomaly() EthereumAnomaly {
+	if m != nil {
+		return m.Anomaly
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return ETHEREUM_ANOMALY_UNSPECIFIED
+}
+
+func (m *TokenPause) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+// OrchestratorQueryIdentity registers the hash of an API key an orchestrator
+// presents to nodes so that its queries are exempt from rate limits, until the
+// expiry height
+type OrchestratorQueryIdentity struct {
+	Orchestrator string `protobuf:"bytes,1,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	KeyHash      []byte `protobuf:"bytes,2,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`
+	ExpiryHeight uint64 `protobuf:"varint,3,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *OrchestratorQueryIdentity) Reset()         { *m = OrchestratorQueryIdentity{} }
+func (m *OrchestratorQueryIdentity) String() string { return proto.CompactTextString(m) }
+func (*OrchestratorQueryIdentity) ProtoMessage()    {}
+func (*OrchestratorQueryIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{33}
+}
+func (m *OrchestratorQueryIdentity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrchestratorQueryIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrchestratorQueryIdentity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *OrchestratorQueryIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrchestratorQueryIdentity.Merge(m, src)
+}
+func (m *OrchestratorQueryIdentity) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrchestratorQueryIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrchestratorQueryIdentity.DiscardUnknown(m)
 }
 
-func encodeVarintGravity(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGravity(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_OrchestratorQueryIdentity proto.InternalMessageInfo
+
+func (m *OrchestratorQueryIdentity) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *EthereumEventVoteRecord) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *OrchestratorQueryIdentity) GetKeyHash() []byte {
+	if m != nil {
+		return m.KeyHash
 	}
-	var l int
-	_ = l
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovGravity(uint64(l))
+	return nil
+}
+
+func (m *OrchestratorQueryIdentity) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
 	}
-	if len(m.Votes) > 0 {
-		for _, s := range m.Votes {
-			l = len(s)
-			n += 1 + l + sovGravity(uint64(l))
+	return 0
+}
+
+// EndBlockerAction records a decision taken by the module while processing a
+// block, such as creating a signer set or slashing a validator
+type EndBlockerAction struct {
+	Height int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EndBlockerAction) Reset()         { *m = EndBlockerAction{} }
+func (m *EndBlockerAction) String() string { return proto.CompactTextString(m) }
+func (*EndBlockerAction) ProtoMessage()    {}
+func (*EndBlockerAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{34}
+}
+func (m *EndBlockerAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndBlockerAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndBlockerAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Accepted {
-		n += 2
+}
+func (m *EndBlockerAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndBlockerAction.Merge(m, src)
+}
+func (m *EndBlockerAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndBlockerAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndBlockerAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndBlockerAction proto.InternalMessageInfo
+
+func (m *EndBlockerAction) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return n
+	return 0
 }
 
-func (m *LatestEthereumBlockHeight) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EndBlockerAction) GetAction() string {
+	if m != nil {
+		return m.Action
 	}
-	var l int
-	_ = l
-	if m.EthereumHeight != 0 {
-		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	return ""
+}
+
+func (m *EndBlockerAction) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if m.CosmosHeight != 0 {
-		n += 1 + sovGravity(uint64(m.CosmosHeight))
+	return ""
+}
+
+// DelayedSendToEthereum is a send to Ethereum of more than the delayed
+// withdrawal threshold of its token, held until the release height before it
+// enters the pool of unbatched txs. Until then, governance or the security
+// council can veto it.
+type DelayedSendToEthereum struct {
+	SendToEthereum SendToEthereum `protobuf:"bytes,1,opt,name=send_to_ethereum,json=sendToEthereum,proto3" json:"send_to_ethereum"`
+	ReleaseHeight  uint64         `protobuf:"varint,2,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
+}
+
+func (m *DelayedSendToEthereum) Reset()         { *m = DelayedSendToEthereum{} }
+func (m *DelayedSendToEthereum) String() string { return proto.CompactTextString(m) }
+func (*DelayedSendToEthereum) ProtoMessage()    {}
+func (*DelayedSendToEthereum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{35}
+}
+func (m *DelayedSendToEthereum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedSendToEthereum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedSendToEthereum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *DelayedSendToEthereum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedSendToEthereum.Merge(m, src)
+}
+func (m *DelayedSendToEthereum) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedSendToEthereum) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedSendToEthereum.DiscardUnknown(m)
 }
 
-func (m *EthereumSigner) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_DelayedSendToEthereum proto.InternalMessageInfo
+
+func (m *DelayedSendToEthereum) GetSendToEthereum() SendToEthereum {
+	if m != nil {
+		return m.SendToEthereum
 	}
-	var l int
-	_ = l
-	if m.Power != 0 {
-		n += 1 + sovGravity(uint64(m.Power))
+	return SendToEthereum{}
+}
+
+func (m *DelayedSendToEthereum) GetReleaseHeight() uint64 {
+	if m != nil {
+		return m.ReleaseHeight
 	}
-	l = len(m.EthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return 0
+}
+
+// BatchTxExecutionRecord is the compact record of an executed batch kept in
+// the archive for the executed batch retention
+type BatchTxExecutionRecord struct {
+	BatchNonce    uint64 `protobuf:"varint,1,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	TokenContract string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	TxCount       uint64 `protobuf:"varint,3,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// sum of the amounts of the batch's txs, in units of the ERC20 token
+	TotalAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_amount,json=totalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_amount"`
+	// sum of the fees of the batch's txs, in units of the ERC20 token
+	TotalFee       github_com_cosmos_cosmos_sdk_types.Int               `protobuf:"bytes,5,opt,name=total_fee,json=totalFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_fee"`
+	BridgeFees     github_com_cosmos_cosmos_sdk_types.Coins             `protobuf:"bytes,6,rep,name=bridge_fees,json=bridgeFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bridge_fees"`
+	Relayer        string                                               `protobuf:"bytes,7,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	EthereumTxHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,8,opt,name=ethereum_tx_hash,json=ethereumTxHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"ethereum_tx_hash,omitempty"`
+	EthereumHeight uint64                                               `protobuf:"varint,9,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	// cosmos height the batch was created at
+	CreatedHeight uint64 `protobuf:"varint,10,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// cosmos height the execution of the batch was observed at
+	ExecutedHeight uint64 `protobuf:"varint,11,opt,name=executed_height,json=executedHeight,proto3" json:"executed_height,omitempty"`
+}
+
+func (m *BatchTxExecutionRecord) Reset()         { *m = BatchTxExecutionRecord{} }
+func (m *BatchTxExecutionRecord) String() string { return proto.CompactTextString(m) }
+func (*BatchTxExecutionRecord) ProtoMessage()    {}
+func (*BatchTxExecutionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{36}
+}
+func (m *BatchTxExecutionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchTxExecutionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchTxExecutionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *BatchTxExecutionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxExecutionRecord.Merge(m, src)
+}
+func (m *BatchTxExecutionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchTxExecutionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxExecutionRecord.DiscardUnknown(m)
 }
 
-func (m *SignerSetTx) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_BatchTxExecutionRecord proto.InternalMessageInfo
+
+func (m *BatchTxExecutionRecord) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
 	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovGravity(uint64(m.Nonce))
+	return 0
+}
+
+func (m *BatchTxExecutionRecord) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if m.Height != 0 {
-		n += 1 + sovGravity(uint64(m.Height))
+	return ""
+}
+
+func (m *BatchTxExecutionRecord) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
 	}
-	if len(m.Signers) > 0 {
-		for _, e := range m.Signers {
-			l = e.Size()
-			n += 1 + l + sovGravity(uint64(l))
-		}
+	return 0
+}
+
+func (m *BatchTxExecutionRecord) GetBridgeFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BridgeFees
 	}
-	return n
+	return nil
 }
 
-func (m *BatchTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BatchNonce != 0 {
-		n += 1 + sovGravity(uint64(m.BatchNonce))
-	}
-	if m.Timeout != 0 {
-		n += 1 + sovGravity(uint64(m.Timeout))
+func (m *BatchTxExecutionRecord) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
 	}
-	if len(m.Transactions) > 0 {
-		for _, e := range m.Transactions {
-			l = e.Size()
-			n += 1 + l + sovGravity(uint64(l))
-		}
+	return ""
+}
+
+func (m *BatchTxExecutionRecord) GetEthereumTxHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EthereumTxHash
 	}
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return nil
+}
+
+func (m *BatchTxExecutionRecord) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
 	}
-	if m.Height != 0 {
-		n += 1 + sovGravity(uint64(m.Height))
+	return 0
+}
+
+func (m *BatchTxExecutionRecord) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
 	}
-	return n
+	return 0
 }
 
-func (m *SendToEthereum) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchTxExecutionRecord) GetExecutedHeight() uint64 {
+	if m != nil {
+		return m.ExecutedHeight
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovGravity(uint64(m.Id))
+	return 0
+}
+
+// ContractCallTxExecutionRecord is the compact record of an executed contract
+// call kept in the archive for the executed batch retention
+type ContractCallTxExecutionRecord struct {
+	InvalidationScope github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=invalidation_scope,json=invalidationScope,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"invalidation_scope,omitempty"`
+	InvalidationNonce uint64                                               `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	// the logic contract called
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// whether the call to the logic contract succeeded
+	Success        bool                                                 `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	ReturnDataHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,5,opt,name=return_data_hash,json=returnDataHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"return_data_hash,omitempty"`
+	EthereumTxHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,6,opt,name=ethereum_tx_hash,json=ethereumTxHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"ethereum_tx_hash,omitempty"`
+	EthereumHeight uint64                                               `protobuf:"varint,7,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	// cosmos height the contract call was created at
+	CreatedHeight uint64 `protobuf:"varint,8,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// cosmos height the execution of the contract call was observed at
+	ExecutedHeight uint64 `protobuf:"varint,9,opt,name=executed_height,json=executedHeight,proto3" json:"executed_height,omitempty"`
+}
+
+func (m *ContractCallTxExecutionRecord) Reset()         { *m = ContractCallTxExecutionRecord{} }
+func (m *ContractCallTxExecutionRecord) String() string { return proto.CompactTextString(m) }
+func (*ContractCallTxExecutionRecord) ProtoMessage()    {}
+func (*ContractCallTxExecutionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{37}
+}
+func (m *ContractCallTxExecutionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCallTxExecutionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCallTxExecutionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+}
+func (m *ContractCallTxExecutionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallTxExecutionRecord.Merge(m, src)
+}
+func (m *ContractCallTxExecutionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCallTxExecutionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallTxExecutionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCallTxExecutionRecord proto.InternalMessageInfo
+
+func (m *ContractCallTxExecutionRecord) GetInvalidationScope() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.InvalidationScope
 	}
-	l = len(m.EthereumRecipient)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return nil
+}
+
+func (m *ContractCallTxExecutionRecord) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
 	}
-	l = m.Erc20Token.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	l = m.Erc20Fee.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	return n
+	return 0
 }
 
-func (m *ContractCallTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractCallTxExecutionRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	var l int
-	_ = l
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovGravity(uint64(m.InvalidationNonce))
+	return ""
+}
+
+func (m *ContractCallTxExecutionRecord) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	l = len(m.InvalidationScope)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return false
+}
+
+func (m *ContractCallTxExecutionRecord) GetReturnDataHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.ReturnDataHash
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return nil
+}
+
+func (m *ContractCallTxExecutionRecord) GetEthereumTxHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EthereumTxHash
 	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return nil
+}
+
+func (m *ContractCallTxExecutionRecord) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovGravity(uint64(m.Timeout))
+	return 0
+}
+
+func (m *ContractCallTxExecutionRecord) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
 	}
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovGravity(uint64(l))
-		}
+	return 0
+}
+
+func (m *ContractCallTxExecutionRecord) GetExecutedHeight() uint64 {
+	if m != nil {
+		return m.ExecutedHeight
 	}
-	if len(m.Fees) > 0 {
-		for _, e := range m.Fees {
-			l = e.Size()
-			n += 1 + l + sovGravity(uint64(l))
+	return 0
+}
+
+// ContractCallTxRefundRecord is the record of a contract call that expired or
+// was invalidated without being executed, and whose escrowed tokens and fees
+// were refunded, kept in the archive for the executed batch retention
+type ContractCallTxRefundRecord struct {
+	InvalidationScope github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=invalidation_scope,json=invalidationScope,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"invalidation_scope,omitempty"`
+	InvalidationNonce uint64                                               `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	Refundee          string                                               `protobuf:"bytes,3,opt,name=refundee,proto3" json:"refundee,omitempty"`
+	Refunded          github_com_cosmos_cosmos_sdk_types.Coins             `protobuf:"bytes,4,rep,name=refunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded"`
+	// cosmos height the contract call was created at
+	CreatedHeight uint64 `protobuf:"varint,5,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// cosmos height the contract call was refunded at
+	RefundedHeight uint64 `protobuf:"varint,6,opt,name=refunded_height,json=refundedHeight,proto3" json:"refunded_height,omitempty"`
+}
+
+func (m *ContractCallTxRefundRecord) Reset()         { *m = ContractCallTxRefundRecord{} }
+func (m *ContractCallTxRefundRecord) String() string { return proto.CompactTextString(m) }
+func (*ContractCallTxRefundRecord) ProtoMessage()    {}
+func (*ContractCallTxRefundRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{38}
+}
+func (m *ContractCallTxRefundRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCallTxRefundRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCallTxRefundRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		n += 1 + sovGravity(uint64(m.Height))
-	}
-	return n
+}
+func (m *ContractCallTxRefundRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallTxRefundRecord.Merge(m, src)
+}
+func (m *ContractCallTxRefundRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCallTxRefundRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallTxRefundRecord.DiscardUnknown(m)
 }
 
-func (m *ERC20Token) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ContractCallTxRefundRecord proto.InternalMessageInfo
+
+func (m *ContractCallTxRefundRecord) GetInvalidationScope() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.InvalidationScope
 	}
-	var l int
-	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return nil
+}
+
+func (m *ContractCallTxRefundRecord) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	return n
+	return 0
 }
 
-func (m *IDSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractCallTxRefundRecord) GetRefundee() string {
+	if m != nil {
+		return m.Refundee
 	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovGravity(uint64(e))
-		}
-		n += 1 + sovGravity(uint64(l)) + l
+	return ""
+}
+
+func (m *ContractCallTxRefundRecord) GetRefunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refunded
 	}
-	return n
+	return nil
 }
 
-func (m *CommunityPoolEthereumSpendProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractCallTxRefundRecord) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return 0
+}
+
+func (m *ContractCallTxRefundRecord) GetRefundedHeight() uint64 {
+	if m != nil {
+		return m.RefundedHeight
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return 0
+}
+
+// EthereumHeader is an ethereum block at a checkpoint height, linked to the
+// block at the previous checkpoint height by its hash, so that the agreed
+// checkpoints form a chain of headers
+type EthereumHeader struct {
+	Height               uint64                                               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash                 github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=hash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"hash,omitempty"`
+	ParentCheckpointHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=parent_checkpoint_hash,json=parentCheckpointHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"parent_checkpoint_hash,omitempty"`
+	// hashes of the blocks after the previous checkpoint height up to the
+	// checkpoint height, in height order, which the events emitted in them are
+	// checked against
+	BlockHashes []github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,4,rep,name=block_hashes,json=blockHashes,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"block_hashes,omitempty"`
+}
+
+func (m *EthereumHeader) Reset()         { *m = EthereumHeader{} }
+func (m *EthereumHeader) String() string { return proto.CompactTextString(m) }
+func (*EthereumHeader) ProtoMessage()    {}
+func (*EthereumHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{39}
+}
+func (m *EthereumHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+}
+func (m *EthereumHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumHeader.Merge(m, src)
+}
+func (m *EthereumHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumHeader proto.InternalMessageInfo
+
+func (m *EthereumHeader) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	l = m.BridgeFee.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	return n
+	return 0
 }
 
-func (m *CommunityPoolEthereumSpendProposalForCLI) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EthereumHeader) GetHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.Hash
 	}
+	return nil
+}
+
+func (m *EthereumHeader) GetParentCheckpointHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.ParentCheckpointHash
+	}
+	return nil
+}
+
+func (m *EthereumHeader) GetBlockHashes() []github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.BlockHashes
+	}
+	return nil
+}
+
+// EthereumHeaderVote is the ethereum header last voted by a validator
+type EthereumHeaderVote struct {
+	ValidatorAddress string         `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Header           EthereumHeader `protobuf:"bytes,2,opt,name=header,proto3" json:"header"`
+}
+
+func (m *EthereumHeaderVote) Reset()         { *m = EthereumHeaderVote{} }
+func (m *EthereumHeaderVote) String() string { return proto.CompactTextString(m) }
+func (*EthereumHeaderVote) ProtoMessage()    {}
+func (*EthereumHeaderVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{40}
+}
+func (m *EthereumHeaderVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumHeaderVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumHeaderVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthereumHeaderVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumHeaderVote.Merge(m, src)
+}
+func (m *EthereumHeaderVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumHeaderVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumHeaderVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumHeaderVote proto.InternalMessageInfo
+
+func (m *EthereumHeaderVote) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EthereumHeaderVote) GetHeader() EthereumHeader {
+	if m != nil {
+		return m.Header
+	}
+	return EthereumHeader{}
+}
+
+// OrchestratorFreeze records the delegate keys of a validator frozen with
+// MsgFreezeOrchestrator, and the cosmos height until which the validator can't
+// set new ones
+type OrchestratorFreeze struct {
+	ValidatorAddress    string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	OrchestratorAddress string `protobuf:"bytes,2,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
+	EthereumAddress     string `protobuf:"bytes,3,opt,name=ethereum_address,json=ethereumAddress,proto3" json:"ethereum_address,omitempty"`
+	FrozenHeight        uint64 `protobuf:"varint,4,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height,omitempty"`
+	CooldownEndHeight   uint64 `protobuf:"varint,5,opt,name=cooldown_end_height,json=cooldownEndHeight,proto3" json:"cooldown_end_height,omitempty"`
+}
+
+func (m *OrchestratorFreeze) Reset()         { *m = OrchestratorFreeze{} }
+func (m *OrchestratorFreeze) String() string { return proto.CompactTextString(m) }
+func (*OrchestratorFreeze) ProtoMessage()    {}
+func (*OrchestratorFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{41}
+}
+func (m *OrchestratorFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrchestratorFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrchestratorFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrchestratorFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrchestratorFreeze.Merge(m, src)
+}
+func (m *OrchestratorFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrchestratorFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrchestratorFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrchestratorFreeze proto.InternalMessageInfo
+
+func (m *OrchestratorFreeze) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *OrchestratorFreeze) GetOrchestratorAddress() string {
+	if m != nil {
+		return m.OrchestratorAddress
+	}
+	return ""
+}
+
+func (m *OrchestratorFreeze) GetEthereumAddress() string {
+	if m != nil {
+		return m.EthereumAddress
+	}
+	return ""
+}
+
+func (m *OrchestratorFreeze) GetFrozenHeight() uint64 {
+	if m != nil {
+		return m.FrozenHeight
+	}
+	return 0
+}
+
+func (m *OrchestratorFreeze) GetCooldownEndHeight() uint64 {
+	if m != nil {
+		return m.CooldownEndHeight
+	}
+	return 0
+}
+
+// EthereumSignature is the signature of a validator over the checkpoint of an
+// outgoing tx, tagged with its scheme
+type EthereumSignature struct {
+	Scheme    SignatureScheme `protobuf:"varint,1,opt,name=scheme,proto3,enum=gravity.v1.SignatureScheme" json:"scheme,omitempty"`
+	Signature []byte          `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *EthereumSignature) Reset()         { *m = EthereumSignature{} }
+func (m *EthereumSignature) String() string { return proto.CompactTextString(m) }
+func (*EthereumSignature) ProtoMessage()    {}
+func (*EthereumSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{42}
+}
+func (m *EthereumSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthereumSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumSignature.Merge(m, src)
+}
+func (m *EthereumSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumSignature proto.InternalMessageInfo
+
+func (m *EthereumSignature) GetScheme() SignatureScheme {
+	if m != nil {
+		return m.Scheme
+	}
+	return SIGNATURE_SCHEME_ECDSA
+}
+
+func (m *EthereumSignature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("gravity.v1.EthereumAnomaly", EthereumAnomaly_name, EthereumAnomaly_value)
+	proto.RegisterEnum("gravity.v1.SignatureScheme", SignatureScheme_name, SignatureScheme_value)
+	proto.RegisterType((*EthereumEventVoteRecord)(nil), "gravity.v1.EthereumEventVoteRecord")
+	proto.RegisterType((*LatestEthereumBlockHeight)(nil), "gravity.v1.LatestEthereumBlockHeight")
+	proto.RegisterType((*EthereumSigner)(nil), "gravity.v1.EthereumSigner")
+	proto.RegisterType((*SignerSetTx)(nil), "gravity.v1.SignerSetTx")
+	proto.RegisterType((*SignerSetTxDelta)(nil), "gravity.v1.SignerSetTxDelta")
+	proto.RegisterType((*BatchTx)(nil), "gravity.v1.BatchTx")
+	proto.RegisterType((*SendToEthereum)(nil), "gravity.v1.SendToEthereum")
+	proto.RegisterType((*ContractCallTx)(nil), "gravity.v1.ContractCallTx")
+	proto.RegisterType((*ContractCall)(nil), "gravity.v1.ContractCall")
+	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
+	proto.RegisterType((*IDSet)(nil), "gravity.v1.IDSet")
+	proto.RegisterType((*BridgeFeeAllowance)(nil), "gravity.v1.BridgeFeeAllowance")
+	proto.RegisterType((*CommunityPoolEthereumSpendProposal)(nil), "gravity.v1.CommunityPoolEthereumSpendProposal")
+	proto.RegisterType((*CommunityPoolEthereumSpendProposalForCLI)(nil), "gravity.v1.CommunityPoolEthereumSpendProposalForCLI")
+	proto.RegisterType((*EthereumBlocklistProposal)(nil), "gravity.v1.EthereumBlocklistProposal")
+	proto.RegisterType((*EthereumBlocklistProposalForCLI)(nil), "gravity.v1.EthereumBlocklistProposalForCLI")
+	proto.RegisterType((*BridgeReenableProposal)(nil), "gravity.v1.BridgeReenableProposal")
+	proto.RegisterType((*BridgeReenableProposalForCLI)(nil), "gravity.v1.BridgeReenableProposalForCLI")
+	proto.RegisterType((*DelayedSendToEthereumVetoProposal)(nil), "gravity.v1.DelayedSendToEthereumVetoProposal")
+	proto.RegisterType((*DelayedSendToEthereumVetoProposalForCLI)(nil), "gravity.v1.DelayedSendToEthereumVetoProposalForCLI")
+	proto.RegisterType((*HeldSendToCosmosReleaseProposal)(nil), "gravity.v1.HeldSendToCosmosReleaseProposal")
+	proto.RegisterType((*HeldSendToCosmosReleaseProposalForCLI)(nil), "gravity.v1.HeldSendToCosmosReleaseProposalForCLI")
+	proto.RegisterType((*EmergencySignerSetUpdateProposal)(nil), "gravity.v1.EmergencySignerSetUpdateProposal")
+	proto.RegisterType((*EmergencySignerSetUpdateProposalForCLI)(nil), "gravity.v1.EmergencySignerSetUpdateProposalForCLI")
+	proto.RegisterType((*SendToEthereumPriorityProposal)(nil), "gravity.v1.SendToEthereumPriorityProposal")
+	proto.RegisterType((*SendToEthereumPriorityProposalForCLI)(nil), "gravity.v1.SendToEthereumPriorityProposalForCLI")
+	proto.RegisterType((*BridgeFlow)(nil), "gravity.v1.BridgeFlow")
+	proto.RegisterType((*BridgeStatsBucket)(nil), "gravity.v1.BridgeStatsBucket")
+	proto.RegisterType((*ObservedSignerSetTx)(nil), "gravity.v1.ObservedSignerSetTx")
+	proto.RegisterType((*ObservedEthereumEvent)(nil), "gravity.v1.ObservedEthereumEvent")
+	proto.RegisterType((*ERC20Conversion)(nil), "gravity.v1.ERC20Conversion")
+	proto.RegisterType((*EthereumAnomalyReport)(nil), "gravity.v1.EthereumAnomalyReport")
+	proto.RegisterType((*TokenPause)(nil), "gravity.v1.TokenPause")
+	proto.RegisterType((*OrchestratorQueryIdentity)(nil), "gravity.v1.OrchestratorQueryIdentity")
+	proto.RegisterType((*EndBlockerAction)(nil), "gravity.v1.EndBlockerAction")
+	proto.RegisterType((*DelayedSendToEthereum)(nil), "gravity.v1.DelayedSendToEthereum")
+	proto.RegisterType((*BatchTxExecutionRecord)(nil), "gravity.v1.BatchTxExecutionRecord")
+	proto.RegisterType((*ContractCallTxExecutionRecord)(nil), "gravity.v1.ContractCallTxExecutionRecord")
+	proto.RegisterType((*ContractCallTxRefundRecord)(nil), "gravity.v1.ContractCallTxRefundRecord")
+	proto.RegisterType((*EthereumHeader)(nil), "gravity.v1.EthereumHeader")
+	proto.RegisterType((*EthereumHeaderVote)(nil), "gravity.v1.EthereumHeaderVote")
+	proto.RegisterType((*OrchestratorFreeze)(nil), "gravity.v1.OrchestratorFreeze")
+	proto.RegisterType((*EthereumSignature)(nil), "gravity.v1.EthereumSignature")
+}
+
+func init() { proto.RegisterFile("gravity/v1/gravity.proto", fileDescriptor_1715a041eadeb531) }
+
+var fileDescriptor_1715a041eadeb531 = []byte{
+	// 2999 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4d, 0x6c, 0x23, 0x57,
+	0x39, 0xe3, 0x9f, 0x24, 0xfe, 0xec, 0x78, 0x9d, 0xd9, 0x6c, 0xd6, 0x49, 0xb7, 0x71, 0x76, 0xca,
+	0x6e, 0xd3, 0xc2, 0x26, 0xbb, 0xe9, 0x42, 0x97, 0x85, 0x16, 0x62, 0xc7, 0x69, 0x42, 0xd3, 0xdd,
+	0xed, 0x38, 0x29, 0xa2, 0x48, 0x8c, 0x5e, 0x66, 0x5e, 0x9c, 0x21, 0xe3, 0x79, 0xd6, 0xcc, 0xd8,
+	0x6b, 0x17, 0x24, 0x28, 0x07, 0x54, 0x38, 0x20, 0x24, 0x2e, 0x1c, 0x2b, 0xc1, 0x01, 0x55, 0x5c,
+	0x10, 0x08, 0x71, 0x43, 0xe2, 0x54, 0xb8, 0xd0, 0x03, 0x07, 0xe0, 0xe0, 0xa2, 0xad, 0x90, 0x7a,
+	0xe2, 0x10, 0xc1, 0x05, 0x2e, 0xe8, 0xfd, 0xd9, 0x33, 0xce, 0x64, 0x37, 0x3f, 0xed, 0x0a, 0x71,
+	0xb2, 0xdf, 0xf7, 0x7d, 0xef, 0x7b, 0xdf, 0xfb, 0xfe, 0xde, 0x7b, 0xdf, 0x37, 0x50, 0xac, 0x7b,
+	0xa8, 0x6d, 0x07, 0xdd, 0xa5, 0xf6, 0x8d, 0x25, 0xf1, 0x77, 0xb1, 0xe9, 0x91, 0x80, 0xa8, 0x20,
+	0x87, 0xed, 0x1b, 0xb3, 0x73, 0x26, 0xf1, 0x1b, 0xc4, 0x5f, 0xda, 0x41, 0x3e, 0x5e, 0x6a, 0xdf,
+	0xd8, 0xc1, 0x01, 0xba, 0xb1, 0x64, 0x12, 0xdb, 0xe5, 0xb4, 0xb3, 0x33, 0x1c, 0x6f, 0xb0, 0xd1,
+	0x12, 0x1f, 0x08, 0xd4, 0x54, 0x9d, 0xd4, 0x09, 0x87, 0xd3, 0x7f, 0x72, 0x42, 0x9d, 0x90, 0xba,
+	0x83, 0x97, 0xd8, 0x68, 0xa7, 0xb5, 0xbb, 0x84, 0x5c, 0xb1, 0xae, 0xf6, 0x4b, 0x05, 0x2e, 0x56,
+	0x83, 0x3d, 0xec, 0xe1, 0x56, 0xa3, 0xda, 0xc6, 0x6e, 0xf0, 0x1a, 0x09, 0xb0, 0x8e, 0x4d, 0xe2,
+	0x59, 0xea, 0x0b, 0x90, 0xc6, 0x14, 0x54, 0x54, 0xe6, 0x95, 0x85, 0xec, 0xf2, 0xd4, 0x22, 0x67,
+	0xb3, 0x28, 0xd9, 0x2c, 0xae, 0xb8, 0xdd, 0xf2, 0xe4, 0x1f, 0x7e, 0x75, 0x6d, 0x22, 0xc2, 0x41,
+	0xe7, 0xb3, 0xd4, 0x29, 0x48, 0xb7, 0x49, 0x80, 0xfd, 0x62, 0x62, 0x3e, 0xb9, 0x90, 0xd1, 0xf9,
+	0x40, 0x9d, 0x85, 0x71, 0x64, 0x9a, 0xb8, 0x19, 0x60, 0xab, 0x98, 0x9c, 0x57, 0x16, 0xc6, 0xf5,
+	0xfe, 0x58, 0x7d, 0x1a, 0xce, 0x61, 0xc1, 0xc9, 0xd8, 0xc3, 0x76, 0x7d, 0x2f, 0x28, 0xa6, 0xe6,
+	0x95, 0x85, 0x94, 0x9e, 0x97, 0xe0, 0x75, 0x06, 0xd5, 0x6c, 0x98, 0xd9, 0x44, 0x01, 0xf6, 0x03,
+	0xb9, 0x70, 0xd9, 0x21, 0xe6, 0x3e, 0x47, 0xc6, 0x71, 0x51, 0xe2, 0xb8, 0xa8, 0x4f, 0xc1, 0x84,
+	0xd0, 0xa4, 0x20, 0x4b, 0x30, 0xb2, 0x1c, 0x07, 0x8a, 0xa5, 0x5e, 0x85, 0xbc, 0x5c, 0xa4, 0x66,
+	0xd7, 0x5d, 0xec, 0xd1, 0x7d, 0x35, 0xc9, 0x7d, 0xec, 0x09, 0xae, 0x7c, 0xa0, 0x3e, 0x03, 0x85,
+	0xfe, 0xaa, 0xc8, 0xb2, 0x3c, 0xec, 0xfb, 0x8c, 0x5f, 0x46, 0xef, 0x4b, 0xb3, 0xc2, 0xc1, 0xda,
+	0x77, 0x15, 0xc8, 0x72, 0x5e, 0x35, 0x1c, 0x6c, 0x75, 0x28, 0x43, 0x97, 0xb8, 0x26, 0x96, 0x0c,
+	0xd9, 0x40, 0x9d, 0x86, 0xd1, 0x88, 0x58, 0x62, 0xa4, 0x6e, 0xc0, 0x98, 0xcf, 0x26, 0xfb, 0xc5,
+	0xe4, 0x7c, 0x72, 0x21, 0xbb, 0x3c, 0xbb, 0x38, 0xf0, 0x9d, 0xc5, 0xa8, 0xac, 0xe5, 0xf3, 0xef,
+	0xbc, 0x5f, 0x3a, 0x17, 0x85, 0xf9, 0xba, 0x9c, 0xaf, 0xfd, 0x31, 0x01, 0x85, 0x90, 0x20, 0xab,
+	0xd8, 0x09, 0xd0, 0x11, 0xd2, 0x5c, 0x81, 0x7c, 0xd3, 0xc3, 0x6d, 0x9b, 0xb4, 0x7c, 0x83, 0xa3,
+	0xb9, 0x54, 0x13, 0x12, 0x7a, 0x67, 0x48, 0xe8, 0x64, 0x44, 0xe8, 0x2a, 0xa4, 0x91, 0x65, 0x61,
+	0xab, 0x98, 0x3a, 0x9d, 0xc8, 0x7c, 0x36, 0xdd, 0xbb, 0x87, 0x1b, 0xa4, 0x8d, 0xad, 0x62, 0xfa,
+	0x94, 0x7b, 0x17, 0xf3, 0xd5, 0x2d, 0x98, 0x60, 0x86, 0x33, 0xcc, 0x3d, 0xe4, 0xd6, 0xb1, 0x55,
+	0x1c, 0x3d, 0x1d, 0xc3, 0x1c, 0xe3, 0x52, 0xe1, 0x4c, 0xb4, 0x3f, 0x25, 0x60, 0xac, 0x8c, 0x02,
+	0x73, 0x6f, 0xab, 0xa3, 0x96, 0x20, 0xbb, 0x43, 0xff, 0x1a, 0x61, 0x75, 0x02, 0x03, 0x71, 0x65,
+	0x15, 0x61, 0x2c, 0xb0, 0x1b, 0x98, 0xb4, 0xa4, 0x89, 0xe5, 0x50, 0x7d, 0x11, 0x72, 0x81, 0x87,
+	0x5c, 0x1f, 0x99, 0x81, 0x4d, 0xdc, 0x58, 0x43, 0xd7, 0xb0, 0x6b, 0x6d, 0x11, 0x29, 0x8d, 0x1e,
+	0xa1, 0xa7, 0xd6, 0x0a, 0xc8, 0x3e, 0x76, 0x0d, 0x93, 0xb8, 0x81, 0x87, 0x4c, 0x1e, 0x47, 0x19,
+	0x7d, 0x82, 0x41, 0x2b, 0x02, 0x18, 0xb2, 0x56, 0x3a, 0x62, 0x2d, 0x07, 0xb2, 0x3b, 0x9e, 0x6d,
+	0xd5, 0xb1, 0xb1, 0x8b, 0xb1, 0x2f, 0x34, 0x33, 0xb3, 0x28, 0x32, 0x0d, 0x4d, 0x4b, 0x8b, 0x22,
+	0x2d, 0x2d, 0x56, 0x88, 0xed, 0x96, 0xaf, 0xbf, 0xdb, 0x2b, 0x8d, 0xbc, 0xf3, 0x7e, 0x69, 0xa1,
+	0x6e, 0x07, 0x7b, 0xad, 0x9d, 0x45, 0x93, 0x34, 0x44, 0x5a, 0x12, 0x3f, 0xd7, 0x7c, 0x6b, 0x7f,
+	0x29, 0xe8, 0x36, 0xb1, 0xcf, 0x26, 0xf8, 0x3a, 0x70, 0xfe, 0x6b, 0x18, 0xfb, 0xea, 0x65, 0xc8,
+	0xd5, 0x91, 0x6f, 0x60, 0x3f, 0xb0, 0x1b, 0x28, 0xc0, 0xc5, 0x31, 0x26, 0x4b, 0xb6, 0x8e, 0xfc,
+	0xaa, 0x00, 0x69, 0x6f, 0xa6, 0x20, 0x1f, 0xdd, 0xb0, 0x9a, 0x87, 0x84, 0x6d, 0x09, 0xa5, 0x26,
+	0x6c, 0x8b, 0xee, 0xc5, 0xc7, 0xae, 0x85, 0x3d, 0x11, 0x75, 0x62, 0xa4, 0x5e, 0x03, 0xb5, 0x1f,
+	0x97, 0x1e, 0x36, 0xed, 0xa6, 0x8d, 0x5d, 0xee, 0x9d, 0x19, 0x7d, 0x52, 0x62, 0x74, 0x89, 0x50,
+	0x5f, 0x80, 0x2c, 0xf6, 0xcc, 0xe5, 0xeb, 0x06, 0xd3, 0x14, 0x53, 0x5b, 0x76, 0x79, 0x3a, 0xe2,
+	0x14, 0x7a, 0x65, 0xf9, 0xfa, 0x16, 0xc5, 0x96, 0x53, 0x74, 0xdf, 0x3a, 0xb0, 0x09, 0x0c, 0xa2,
+	0x7e, 0x16, 0x32, 0x7c, 0xfa, 0x2e, 0xc6, 0xc5, 0xf4, 0x31, 0x26, 0x8f, 0x33, 0xf2, 0x35, 0x1c,
+	0x0e, 0x9d, 0xd1, 0x88, 0x31, 0x6e, 0x01, 0x0c, 0x8c, 0xc1, 0x94, 0xf3, 0x30, 0x5b, 0xe8, 0x99,
+	0xbe, 0x66, 0xa9, 0x17, 0x70, 0x07, 0x14, 0x6e, 0xe5, 0x17, 0xc7, 0x79, 0xcc, 0x32, 0xe8, 0x96,
+	0x00, 0xaa, 0x9f, 0x81, 0x8c, 0xb9, 0x87, 0x6c, 0x97, 0xf1, 0xcf, 0x3c, 0x8a, 0xff, 0x38, 0xa3,
+	0xa5, 0xec, 0x67, 0x61, 0xbc, 0xe9, 0xd9, 0xc4, 0xb3, 0x83, 0x6e, 0x11, 0x78, 0x26, 0x97, 0x63,
+	0xea, 0xfb, 0xbb, 0x18, 0x1b, 0x75, 0x0f, 0xb9, 0x01, 0xf6, 0x8a, 0x59, 0xa6, 0x6e, 0xd8, 0xc5,
+	0xf8, 0x25, 0x0e, 0x51, 0xaf, 0xc3, 0x14, 0xee, 0x60, 0xb3, 0x15, 0x60, 0x03, 0xed, 0x06, 0xd8,
+	0x93, 0x29, 0x38, 0xc7, 0x24, 0x54, 0x05, 0x6e, 0x85, 0xa2, 0x44, 0x22, 0xfe, 0x41, 0x12, 0xf2,
+	0xd2, 0x73, 0x2b, 0xc8, 0x71, 0xb6, 0x3a, 0xd4, 0xb6, 0xb6, 0xdb, 0x46, 0x8e, 0x6d, 0x21, 0xea,
+	0xf7, 0x91, 0x40, 0x9b, 0x0c, 0x63, 0x78, 0xbc, 0x0d, 0x93, 0xfb, 0x26, 0x69, 0xf2, 0x3c, 0x96,
+	0x8b, 0x92, 0xd7, 0x28, 0x82, 0x86, 0xa7, 0x4c, 0xe4, 0xdc, 0x5d, 0xe4, 0x90, 0x62, 0x9a, 0xa8,
+	0xeb, 0x10, 0x64, 0x31, 0x07, 0xc9, 0xe9, 0x72, 0x18, 0x0e, 0xe9, 0x74, 0x34, 0xa4, 0x6f, 0xc2,
+	0x28, 0x73, 0x29, 0x19, 0x4e, 0x0f, 0x77, 0x0b, 0x41, 0xab, 0x5e, 0x87, 0x14, 0x0b, 0xc1, 0xb1,
+	0x63, 0xcc, 0x61, 0x94, 0x21, 0x37, 0x1a, 0x8f, 0xb8, 0xd1, 0x4d, 0x48, 0x9b, 0xc8, 0x71, 0xfc,
+	0x62, 0x86, 0xb1, 0x2a, 0x86, 0x59, 0x85, 0xd5, 0x2a, 0x98, 0x71, 0x62, 0x6a, 0x63, 0x0f, 0xef,
+	0xb6, 0x5c, 0x0b, 0x63, 0x66, 0xe3, 0x8c, 0xde, 0x1f, 0x6b, 0x6f, 0x29, 0x90, 0x0b, 0xcf, 0x0c,
+	0x2b, 0x4c, 0x39, 0x52, 0x61, 0x89, 0xa8, 0xc2, 0x56, 0x21, 0xdd, 0x46, 0x4e, 0x0b, 0x73, 0x15,
+	0x97, 0x17, 0xe9, 0xe2, 0x7f, 0xed, 0x95, 0xae, 0x1e, 0x23, 0x93, 0x6c, 0xd0, 0xab, 0x06, 0x9b,
+	0xac, 0x35, 0x01, 0x06, 0xea, 0xa0, 0x42, 0xf7, 0xf3, 0x1e, 0x17, 0xa4, 0x3f, 0x56, 0xd7, 0x60,
+	0x14, 0x35, 0x48, 0xcb, 0xe5, 0x29, 0xf7, 0xe4, 0x0b, 0x8a, 0xd9, 0xda, 0x0c, 0xa4, 0x37, 0x56,
+	0x6b, 0x38, 0x50, 0x0b, 0x90, 0xb4, 0x2d, 0xba, 0xe1, 0xe4, 0x42, 0x4a, 0xa7, 0x7f, 0xb5, 0x5f,
+	0x2b, 0xa0, 0x96, 0x65, 0x10, 0xae, 0x38, 0x0e, 0xb9, 0x8f, 0x44, 0xb6, 0x97, 0xe1, 0x20, 0xb4,
+	0x23, 0x86, 0x03, 0x0c, 0x16, 0xb9, 0x4b, 0x0e, 0x69, 0x22, 0xf6, 0x9b, 0xd8, 0xb5, 0x0c, 0xc7,
+	0x6e, 0xd8, 0x81, 0x38, 0x06, 0x3e, 0xda, 0x44, 0xcc, 0xf8, 0x6f, 0x52, 0xf6, 0xda, 0x9b, 0x09,
+	0xd0, 0x2a, 0xa4, 0xd1, 0x68, 0xb9, 0x76, 0xd0, 0xbd, 0x47, 0x88, 0xd3, 0x3f, 0xeb, 0x28, 0xcd,
+	0x3d, 0x8f, 0x34, 0x89, 0x8f, 0x1c, 0x7a, 0x41, 0x08, 0xec, 0xc0, 0xc1, 0x62, 0x1b, 0x7c, 0xa0,
+	0xce, 0x43, 0xd6, 0xc2, 0xbe, 0xe9, 0xd9, 0x4d, 0x1a, 0x41, 0x62, 0x23, 0x61, 0x90, 0x7a, 0x09,
+	0x32, 0xc3, 0x09, 0x78, 0x00, 0x50, 0x9f, 0xef, 0x1b, 0x26, 0xf5, 0x88, 0x14, 0x24, 0x43, 0x84,
+	0x93, 0xab, 0x2f, 0x46, 0xf2, 0x63, 0xfa, 0x78, 0x93, 0x07, 0x59, 0xf2, 0x76, 0xee, 0xad, 0xb7,
+	0x4b, 0x23, 0x3f, 0x7e, 0xbb, 0x34, 0xf2, 0xe1, 0xdb, 0xa5, 0x11, 0xed, 0x2f, 0x09, 0x58, 0x78,
+	0xb4, 0x0e, 0xd6, 0x88, 0x57, 0xd9, 0xdc, 0x50, 0xaf, 0x46, 0x34, 0x51, 0x2e, 0x1c, 0xf4, 0x4a,
+	0xb9, 0x2e, 0x6a, 0x38, 0xb7, 0x35, 0x06, 0xd6, 0xa4, 0x6e, 0x6e, 0xc5, 0xe8, 0xa6, 0x3c, 0x7d,
+	0xd0, 0x2b, 0xa9, 0x9c, 0x3a, 0x84, 0xd4, 0xa2, 0x3a, 0x5b, 0x3e, 0xa4, 0xb3, 0xf2, 0xd4, 0x41,
+	0xaf, 0x54, 0xe0, 0xf3, 0xfa, 0x28, 0x2d, 0xac, 0xc9, 0x67, 0x22, 0x9a, 0xcc, 0x94, 0x27, 0x0f,
+	0x7a, 0xa5, 0x09, 0x3e, 0x41, 0x38, 0x6f, 0x5f, 0x77, 0x37, 0x0f, 0xe9, 0x2e, 0x53, 0xbe, 0x70,
+	0xd0, 0x2b, 0x4d, 0x72, 0xf2, 0x01, 0x4e, 0x0b, 0x9f, 0x2b, 0x9f, 0x82, 0x31, 0x0b, 0x37, 0x89,
+	0x6f, 0xf3, 0xa3, 0x2a, 0x53, 0x56, 0x0f, 0x7a, 0xa5, 0xbc, 0xdc, 0x0a, 0x43, 0x68, 0xba, 0x24,
+	0xb9, 0x3d, 0x2e, 0xf4, 0xab, 0x68, 0xbf, 0x50, 0x60, 0x26, 0x72, 0x61, 0x77, 0x6c, 0x3f, 0x38,
+	0xb3, 0x5b, 0x3d, 0x05, 0x13, 0xc8, 0xb2, 0xe4, 0x9d, 0x1b, 0xf3, 0xcb, 0x52, 0x46, 0xcf, 0x21,
+	0xcb, 0x5a, 0x91, 0x30, 0x7a, 0x3b, 0xe7, 0x17, 0xbf, 0x10, 0x5d, 0x8a, 0xd1, 0x9d, 0xe3, 0xf0,
+	0x3e, 0xe9, 0x90, 0x3f, 0xfc, 0x2e, 0x01, 0xa5, 0x23, 0x65, 0x7e, 0x6c, 0x6e, 0xf0, 0x42, 0xec,
+	0x1e, 0xcb, 0xc5, 0x83, 0x5e, 0x69, 0x4a, 0x58, 0x36, 0x8c, 0xd6, 0x86, 0x76, 0xbf, 0x76, 0xd4,
+	0xee, 0xcb, 0x4f, 0x1c, 0xf4, 0x4a, 0x17, 0xa5, 0x33, 0x45, 0x29, 0xb4, 0x43, 0xaa, 0x09, 0x1b,
+	0x3e, 0x7d, 0x12, 0xc3, 0x7f, 0x0d, 0xa6, 0x79, 0x42, 0xd4, 0x31, 0x76, 0xd1, 0x8e, 0x83, 0xcf,
+	0x6a, 0xf4, 0x21, 0x23, 0xfd, 0x46, 0x81, 0x4b, 0xf1, 0x0b, 0x3c, 0x36, 0x0b, 0x85, 0x54, 0x93,
+	0x3c, 0x89, 0x6a, 0xbe, 0x01, 0x97, 0x57, 0xb1, 0x83, 0xba, 0xd8, 0x8a, 0xde, 0x6f, 0x5f, 0xc3,
+	0x01, 0x39, 0x73, 0x68, 0x88, 0xb3, 0x29, 0xd9, 0x3f, 0x9b, 0x86, 0xf4, 0xf6, 0x77, 0x05, 0x9e,
+	0x7e, 0xe4, 0xea, 0x8f, 0x4d, 0x85, 0xf3, 0x21, 0x69, 0xcb, 0xf9, 0x83, 0x5e, 0x09, 0xf8, 0x0c,
+	0x7a, 0xa6, 0x32, 0xe9, 0xc3, 0x4a, 0x4e, 0x9d, 0x30, 0xf1, 0x94, 0xd6, 0xb1, 0x23, 0x36, 0x59,
+	0x61, 0x47, 0x83, 0x8e, 0x1d, 0x8c, 0xfc, 0x33, 0x7b, 0x62, 0xcc, 0x53, 0x2b, 0x19, 0xf7, 0xd4,
+	0xba, 0x0c, 0x39, 0x56, 0x15, 0xe1, 0x77, 0x54, 0x1e, 0x7e, 0x29, 0x3d, 0xcb, 0x60, 0xec, 0x76,
+	0x3a, 0x6c, 0x9b, 0xdf, 0x26, 0xe0, 0xca, 0x23, 0x64, 0x7e, 0x6c, 0x96, 0xf9, 0x62, 0xfc, 0x1e,
+	0xcb, 0x33, 0x07, 0xbd, 0xd2, 0x05, 0xb1, 0x54, 0x04, 0xaf, 0x0d, 0x6f, 0xff, 0x76, 0xdc, 0xf6,
+	0xcb, 0x17, 0x0f, 0x7a, 0xa5, 0xf3, 0x7c, 0x7e, 0x18, 0xab, 0x45, 0xf4, 0x72, 0xea, 0xac, 0xf3,
+	0x33, 0x05, 0xe6, 0xab, 0x0d, 0xec, 0xd5, 0xb1, 0x6b, 0x76, 0xfb, 0x65, 0x8e, 0xed, 0xa6, 0x85,
+	0x82, 0xb3, 0x9b, 0xfd, 0x45, 0x78, 0x02, 0x77, 0x4c, 0xa7, 0x65, 0x61, 0xcb, 0x18, 0xae, 0xfb,
+	0xf4, 0xcf, 0xa0, 0x19, 0x49, 0x52, 0x8d, 0x56, 0x80, 0x0e, 0x19, 0xfb, 0x9d, 0x04, 0x5c, 0x7d,
+	0x94, 0xa8, 0x8f, 0xcd, 0xda, 0xbb, 0xc7, 0xd8, 0x5a, 0xf9, 0xea, 0x41, 0xaf, 0xa4, 0x09, 0xd3,
+	0x1d, 0x4d, 0xac, 0x3d, 0x44, 0x05, 0xa7, 0x8e, 0xe6, 0x0e, 0xcc, 0x45, 0xb3, 0xd5, 0x3d, 0xf1,
+	0xea, 0xfc, 0xd8, 0xf3, 0xe5, 0x03, 0x05, 0x3e, 0xf1, 0xf0, 0xa5, 0xff, 0x0f, 0x92, 0xe5, 0xbf,
+	0x12, 0x00, 0xe2, 0xf9, 0xe2, 0x90, 0xfb, 0x31, 0xf9, 0x4d, 0x89, 0xcb, 0x6f, 0x6b, 0x30, 0x6a,
+	0xbb, 0xbb, 0x0e, 0xb9, 0x7f, 0xda, 0x77, 0x15, 0x9f, 0xad, 0xae, 0xc3, 0x18, 0x69, 0x05, 0x8c,
+	0xd1, 0xe9, 0x5e, 0x84, 0x72, 0xba, 0xba, 0x0d, 0x79, 0xd4, 0xc6, 0x1e, 0xaa, 0x63, 0x43, 0x48,
+	0x96, 0x3a, 0x15, 0xc3, 0x09, 0xc1, 0x65, 0x83, 0x0b, 0xf8, 0x65, 0x38, 0x27, 0xd9, 0x4a, 0x41,
+	0xd3, 0xa7, 0xe2, 0x2b, 0xa5, 0xbb, 0xcb, 0xb9, 0x68, 0xff, 0x49, 0xc0, 0x24, 0xd7, 0x7b, 0x2d,
+	0x40, 0x81, 0x5f, 0x6e, 0x99, 0xfb, 0x38, 0x38, 0xae, 0xfa, 0x55, 0x48, 0xed, 0x91, 0x96, 0x27,
+	0xea, 0x88, 0xec, 0x7f, 0xc8, 0x24, 0xc9, 0x8f, 0xca, 0x24, 0xa9, 0xb3, 0x99, 0xe4, 0x32, 0xe4,
+	0x38, 0x4f, 0xc3, 0x64, 0xef, 0x13, 0x5e, 0x22, 0xc9, 0x72, 0x58, 0x85, 0x82, 0xe8, 0x6d, 0x5e,
+	0x50, 0x0b, 0x1a, 0x5e, 0x0c, 0xcb, 0x09, 0x20, 0x27, 0x7a, 0x15, 0xe4, 0xd8, 0x10, 0xd5, 0x91,
+	0xd3, 0x88, 0x95, 0x15, 0x3c, 0x68, 0x11, 0x52, 0xfb, 0x26, 0x9c, 0xbf, 0xbb, 0xe3, 0x63, 0xaf,
+	0x8d, 0xad, 0x70, 0x69, 0xfe, 0xf3, 0x00, 0xbc, 0x58, 0x6e, 0xf8, 0x58, 0xf6, 0x41, 0x2e, 0x46,
+	0xca, 0xb0, 0x03, 0x62, 0xf9, 0xb4, 0xf4, 0x25, 0x28, 0xae, 0x13, 0x91, 0x88, 0xed, 0x67, 0xfc,
+	0x43, 0x81, 0x0b, 0x72, 0xf9, 0x48, 0x2f, 0xe5, 0xac, 0x3d, 0x98, 0x2f, 0xc0, 0xa4, 0x28, 0xa5,
+	0x59, 0x86, 0xa8, 0x05, 0x76, 0x98, 0x0c, 0xd9, 0xe5, 0xf3, 0xe1, 0x6d, 0x88, 0x9a, 0xb5, 0x7e,
+	0x4e, 0x52, 0x0b, 0x80, 0xba, 0x0d, 0x33, 0x7d, 0x06, 0xd2, 0x05, 0x0d, 0x5a, 0x1b, 0xa2, 0x8c,
+	0x92, 0xf3, 0xca, 0x70, 0x59, 0x3a, 0x5a, 0xa1, 0xd3, 0xa7, 0xe5, 0xe4, 0x28, 0x9c, 0xd6, 0x8e,
+	0xce, 0xb1, 0x8a, 0x4d, 0x85, 0xb8, 0x6d, 0xec, 0xf9, 0xf1, 0x37, 0xa9, 0x58, 0x57, 0xbf, 0x02,
+	0x79, 0x5e, 0x62, 0xb5, 0xb0, 0x69, 0x37, 0x90, 0xc3, 0xdb, 0x2c, 0x13, 0xfa, 0x04, 0x83, 0xae,
+	0x0a, 0x20, 0xd5, 0xbd, 0x68, 0xee, 0xe0, 0x4e, 0x93, 0xb8, 0xf2, 0xfd, 0x3c, 0xa1, 0xe7, 0x39,
+	0xb8, 0x2a, 0xa0, 0xda, 0x8f, 0x14, 0xb8, 0xd0, 0x3f, 0x9c, 0x5c, 0xd2, 0x40, 0x4e, 0x57, 0xc7,
+	0x4d, 0xe2, 0x1d, 0x3b, 0xf6, 0x2e, 0x41, 0x46, 0x94, 0x0e, 0x89, 0x2c, 0x3e, 0x0f, 0x00, 0xea,
+	0xa7, 0x61, 0x0c, 0x71, 0xae, 0x6c, 0xfd, 0xfc, 0xf2, 0x13, 0x71, 0x1d, 0x06, 0xb9, 0xb0, 0xa4,
+	0xd5, 0xbe, 0xa3, 0x00, 0xb0, 0x6a, 0xd6, 0x3d, 0xd4, 0xf2, 0xf1, 0x71, 0x45, 0x09, 0x2d, 0x96,
+	0x38, 0xfe, 0x62, 0x47, 0x75, 0x6d, 0xb4, 0x6f, 0xc1, 0xcc, 0x5d, 0xcf, 0xdc, 0xc3, 0x7e, 0xe0,
+	0xd1, 0xbd, 0xbc, 0xda, 0xc2, 0x5e, 0x77, 0xc3, 0xc2, 0x6e, 0x40, 0x4b, 0xbc, 0x1a, 0xe4, 0x48,
+	0x08, 0x29, 0x04, 0x8a, 0xc0, 0xd4, 0x19, 0x18, 0xdf, 0xc7, 0x5d, 0x63, 0x0f, 0xf9, 0x7b, 0xb2,
+	0xf0, 0xb7, 0x8f, 0xbb, 0xeb, 0xc8, 0xdf, 0xa3, 0x81, 0x8e, 0x3b, 0x4d, 0xdb, 0xeb, 0x1a, 0x91,
+	0xa5, 0x73, 0x1c, 0x28, 0xe2, 0xe2, 0x75, 0x28, 0x54, 0x5d, 0x8b, 0xbd, 0xbb, 0xb1, 0xb7, 0xc2,
+	0x9a, 0x1b, 0x21, 0x61, 0xe9, 0x8a, 0xc9, 0x7e, 0x81, 0x73, 0x1a, 0x46, 0x79, 0xfb, 0x43, 0x36,
+	0x00, 0x50, 0x9f, 0xde, 0xc3, 0xc8, 0x27, 0xae, 0xb8, 0x98, 0x8b, 0x91, 0xf6, 0x7d, 0x05, 0x2e,
+	0xc4, 0x3e, 0x7e, 0xd4, 0x2f, 0x41, 0x81, 0x36, 0x0f, 0x8c, 0x80, 0xf4, 0xaf, 0x34, 0x45, 0xe5,
+	0xb0, 0xab, 0x47, 0x67, 0x89, 0xe8, 0xcf, 0xfb, 0x51, 0x5e, 0x57, 0x20, 0xef, 0xf1, 0x5b, 0x7b,
+	0x34, 0x03, 0x4c, 0x08, 0xa8, 0xd8, 0xe8, 0xb7, 0xd3, 0x30, 0x2d, 0x42, 0xae, 0xca, 0x22, 0xc6,
+	0x26, 0xae, 0xe8, 0xc2, 0x3e, 0xb2, 0x8d, 0x74, 0xd8, 0x37, 0x12, 0x71, 0xbe, 0x31, 0x03, 0xe3,
+	0x41, 0x47, 0x24, 0xd5, 0xa4, 0xa8, 0x4d, 0x77, 0xfa, 0xf9, 0x34, 0x20, 0x01, 0x72, 0x8c, 0x48,
+	0xdd, 0xe8, 0xc4, 0xf9, 0x94, 0xf1, 0x58, 0x61, 0x2c, 0xd4, 0x97, 0x21, 0xc3, 0x59, 0x0e, 0x0a,
+	0x4b, 0x27, 0xe5, 0x37, 0xce, 0x18, 0xac, 0xf1, 0x32, 0xe8, 0x63, 0xec, 0x47, 0x15, 0x69, 0x93,
+	0x91, 0xfa, 0x85, 0xc7, 0x0f, 0x16, 0x5d, 0x0e, 0xd5, 0x9d, 0x50, 0x8f, 0x37, 0xe8, 0x70, 0xb7,
+	0xa6, 0x55, 0xf6, 0x5c, 0xf9, 0xd6, 0xbf, 0x7b, 0xa5, 0x9b, 0xa1, 0xd5, 0x02, 0xd6, 0x7c, 0x6a,
+	0xd8, 0x6e, 0x10, 0xfe, 0xeb, 0xd8, 0x3b, 0xfe, 0xd2, 0x4e, 0x37, 0xc0, 0xfe, 0xe2, 0x3a, 0xee,
+	0x94, 0xe9, 0x9f, 0xc1, 0x51, 0xb0, 0xd5, 0x61, 0x71, 0x11, 0x73, 0x66, 0x64, 0x62, 0xbb, 0xd7,
+	0x57, 0x20, 0x6f, 0x7a, 0x18, 0xd1, 0xc4, 0x2c, 0xe8, 0x80, 0x7b, 0x96, 0x80, 0x86, 0xba, 0xe1,
+	0x32, 0x81, 0x0b, 0xba, 0xac, 0xe0, 0x27, 0xc0, 0xc2, 0x05, 0x7f, 0x9e, 0x82, 0x27, 0xa3, 0x59,
+	0x7a, 0xd8, 0x13, 0xeb, 0xb1, 0xfd, 0x13, 0xe5, 0x8c, 0x0a, 0x88, 0xe9, 0xbc, 0xc4, 0xf7, 0x75,
+	0x12, 0x47, 0xf5, 0x75, 0x1e, 0xda, 0xa8, 0xf1, 0x5b, 0xa6, 0x49, 0x31, 0x29, 0xd6, 0xa1, 0x92,
+	0x43, 0x6a, 0x4a, 0x0f, 0x07, 0x2d, 0xcf, 0x35, 0x2c, 0x14, 0x20, 0x6e, 0xca, 0xf4, 0x59, 0x4d,
+	0xc9, 0x39, 0xae, 0xa2, 0x00, 0x31, 0x53, 0xc6, 0xb9, 0xcb, 0xe8, 0xc7, 0xef, 0x2e, 0x63, 0xc7,
+	0x74, 0x97, 0xf1, 0x63, 0xba, 0x4b, 0x26, 0xd6, 0x5d, 0xbe, 0x97, 0x84, 0xd9, 0xa1, 0xc3, 0x9e,
+	0x35, 0x86, 0xfe, 0xc7, 0x7d, 0x25, 0xdc, 0xd0, 0x4a, 0x46, 0x1b, 0x5a, 0x6a, 0xbd, 0x8f, 0x93,
+	0xdf, 0x29, 0x7c, 0xa4, 0x39, 0xa6, 0xcf, 0x3c, 0xc6, 0x16, 0xe9, 0x23, 0x6c, 0x21, 0xa7, 0x18,
+	0x91, 0xd6, 0x70, 0x5e, 0x82, 0x85, 0x2d, 0x7e, 0x9f, 0x18, 0x7c, 0xa4, 0xb2, 0x8e, 0x11, 0x6d,
+	0x7b, 0x47, 0x4f, 0xc9, 0x41, 0x1b, 0x70, 0x13, 0x52, 0x83, 0xd3, 0xf8, 0x0c, 0x96, 0x60, 0x5c,
+	0x54, 0x17, 0xa6, 0x9b, 0xc8, 0xa3, 0x95, 0x1b, 0x73, 0x0f, 0x9b, 0xfb, 0x4d, 0x62, 0xbb, 0x01,
+	0xf7, 0xf3, 0xe4, 0x19, 0xf9, 0x4f, 0x71, 0xbe, 0x95, 0x3e, 0x5b, 0xe6, 0xed, 0x5f, 0x85, 0xdc,
+	0x0e, 0xbd, 0x0c, 0xb0, 0x35, 0x44, 0x19, 0xe9, 0x2c, 0xab, 0x64, 0x19, 0xb7, 0x75, 0xc6, 0xec,
+	0x76, 0xea, 0x43, 0x5e, 0x90, 0x55, 0xa3, 0xaa, 0xa4, 0x1f, 0x44, 0xa9, 0x9f, 0x84, 0xc9, 0xfe,
+	0x95, 0xce, 0x88, 0x36, 0x39, 0x0b, 0x7d, 0x84, 0x28, 0x6d, 0xa8, 0xb7, 0xa8, 0xee, 0x91, 0xfc,
+	0x14, 0xe1, 0x88, 0x6f, 0x4a, 0x38, 0x73, 0xd9, 0xcb, 0xe2, 0xf4, 0xda, 0x3f, 0x15, 0x50, 0xc3,
+	0x37, 0xae, 0x35, 0x0f, 0xe3, 0x37, 0x4e, 0xb8, 0xfa, 0x0d, 0x98, 0x0a, 0xdf, 0xc1, 0x86, 0x3e,
+	0x46, 0x3a, 0x1f, 0xc6, 0xc9, 0x29, 0x71, 0xdf, 0x2e, 0x25, 0x63, 0xbf, 0x5d, 0xa2, 0xd7, 0xb6,
+	0x5d, 0x8f, 0xbc, 0x81, 0xdd, 0xe8, 0x07, 0x5a, 0x39, 0x0e, 0x14, 0x8e, 0xbb, 0x08, 0xe7, 0x4d,
+	0x42, 0x1c, 0x8b, 0xdc, 0x77, 0x0d, 0x7a, 0x91, 0x8a, 0x38, 0xf9, 0xa4, 0x44, 0x55, 0x5d, 0xe9,
+	0xbf, 0xbb, 0x30, 0x19, 0xfe, 0xac, 0x06, 0x05, 0x2d, 0x0f, 0xab, 0xcf, 0xc1, 0xa8, 0x6f, 0xee,
+	0xe1, 0x06, 0xcf, 0x1a, 0x43, 0x57, 0xd9, 0x3e, 0x59, 0x8d, 0x91, 0xe8, 0x82, 0x94, 0xde, 0xc5,
+	0x7d, 0x89, 0x12, 0x37, 0xce, 0x01, 0xe0, 0xd9, 0x9f, 0xd2, 0x57, 0x47, 0xf4, 0x12, 0xac, 0xce,
+	0xc3, 0xa5, 0xea, 0xd6, 0x7a, 0x55, 0xaf, 0x6e, 0xbf, 0x62, 0xac, 0xdc, 0xb9, 0xfb, 0xca, 0xca,
+	0xe6, 0x57, 0x8c, 0xed, 0x3b, 0xb5, 0x7b, 0xd5, 0xca, 0xc6, 0xda, 0x46, 0x75, 0xb5, 0x30, 0xa2,
+	0x5e, 0x86, 0x27, 0x0f, 0x51, 0x6c, 0xdd, 0x7d, 0xb9, 0x7a, 0xc7, 0xb8, 0xb7, 0xb2, 0x5d, 0xab,
+	0xae, 0x16, 0x14, 0xf5, 0x69, 0x78, 0xea, 0x10, 0x49, 0x59, 0xdf, 0x58, 0x7d, 0xa9, 0x6a, 0x94,
+	0x37, 0x57, 0x2a, 0x2f, 0x6f, 0x6e, 0xd4, 0xb6, 0xaa, 0xab, 0x85, 0x84, 0xfa, 0x24, 0xcc, 0x1c,
+	0x22, 0xd4, 0xab, 0xb5, 0xbb, 0x9b, 0xaf, 0x55, 0x57, 0x0b, 0xc9, 0xd9, 0xd4, 0x5b, 0x3f, 0x99,
+	0x1b, 0x79, 0x76, 0x1f, 0xce, 0x0d, 0xed, 0x4f, 0x9d, 0x85, 0xe9, 0xda, 0xc6, 0x4b, 0x77, 0x56,
+	0xb6, 0xb6, 0xf5, 0xaa, 0x51, 0xab, 0xac, 0x57, 0x5f, 0xa9, 0x1a, 0xd5, 0xca, 0x6a, 0x6d, 0xa5,
+	0x30, 0xa2, 0x5e, 0x82, 0xe2, 0x61, 0xdc, 0xc6, 0xbd, 0x1b, 0xcb, 0xcf, 0xdf, 0x28, 0x28, 0x6a,
+	0x11, 0xa6, 0x0e, 0x61, 0xcb, 0x9b, 0xb5, 0x42, 0x82, 0x2f, 0x56, 0xde, 0x7e, 0xf7, 0xc1, 0x9c,
+	0xf2, 0xde, 0x83, 0x39, 0xe5, 0x6f, 0x0f, 0xe6, 0x94, 0x1f, 0x7e, 0x30, 0x37, 0xf2, 0xde, 0x07,
+	0x73, 0x23, 0x7f, 0xfe, 0x60, 0x6e, 0xe4, 0xf5, 0xcf, 0x85, 0x42, 0xaa, 0x89, 0xeb, 0xf5, 0xee,
+	0xd7, 0xdb, 0xf2, 0xa3, 0xc5, 0x6b, 0xfc, 0xba, 0xb4, 0xd4, 0x20, 0x56, 0xcb, 0xc1, 0x4b, 0xed,
+	0xe5, 0xa5, 0x8e, 0x44, 0xf1, 0x94, 0xb7, 0x33, 0xca, 0x1e, 0xa8, 0xcf, 0xfd, 0x77, 0x00, 0x4f,
+	0x2f, 0xde, 0x34, 0xf2, 0x28, 0x00, 0x00,
+}
+
+func (this *EthereumHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EthereumHeader)
+	if !ok {
+		that2, ok := that.(EthereumHeader)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if !bytes.Equal(this.ParentCheckpointHash, that1.ParentCheckpointHash) {
+		return false
+	}
+	if len(this.BlockHashes) != len(that1.BlockHashes) {
+		return false
+	}
+	for i := range this.BlockHashes {
+		if !bytes.Equal(this.BlockHashes[i], that1.BlockHashes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *EthereumEventVoteRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumEventVoteRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumEventVoteRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if m.EthereumHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Votes[iNdEx])
+			copy(dAtA[i:], m.Votes[iNdEx])
+			i = encodeVarintGravity(dAtA, i, uint64(len(m.Votes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.BridgeFee)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGravity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *LatestEthereumBlockHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func sovGravity(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *LatestEthereumBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozGravity(x uint64) (n int) {
-	return sovGravity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *LatestEthereumBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmosHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.CosmosHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumSigner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumSigner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Power != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PowerChanged) > 0 {
+		for iNdEx := len(m.PowerChanged) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PowerChanged[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Removed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Added) > 0 {
+		for iNdEx := len(m.Added) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Added[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PreviousNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.PreviousNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasEstimate != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.GasEstimate))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BridgeFees) > 0 {
+		for iNdEx := len(m.BridgeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendToEthereum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendToEthereum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecuteAfterHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.ExecuteAfterHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.FeeGranter) > 0 {
+		i -= len(m.FeeGranter)
+		copy(dAtA[i:], m.FeeGranter)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.FeeGranter)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Priority {
+		i--
+		if m.Priority {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ChainFee != nil {
+		{
+			size, err := m.ChainFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGravity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.BatchTimeouts != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.BatchTimeouts))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BridgeFee != nil {
+		{
+			size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGravity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Erc20Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Erc20Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.EthereumRecipient) > 0 {
+		i -= len(m.EthereumRecipient)
+		copy(dAtA[i:], m.EthereumRecipient)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refundee) > 0 {
+		i -= len(m.Refundee)
+		copy(dAtA[i:], m.Refundee)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Refundee)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20Token) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IDSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IDSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IDSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA7 := make([]byte, len(m.Ids)*10)
+		var j6 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintGravity(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeFeeAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeFeeAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeFeeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolEthereumSpendProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolEthereumSpendProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolEthereumSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolEthereumSpendProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolEthereumSpendProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolEthereumSpendProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BridgeFee) > 0 {
+		i -= len(m.BridgeFee)
+		copy(dAtA[i:], m.BridgeFee)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.BridgeFee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumBlocklistProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumBlocklistProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumBlocklistProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoveAddresses) > 0 {
+		for iNdEx := len(m.RemoveAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveAddresses[iNdEx])
+			copy(dAtA[i:], m.RemoveAddresses[iNdEx])
+			i = encodeVarintGravity(dAtA, i, uint64(len(m.RemoveAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddAddresses) > 0 {
+		for iNdEx := len(m.AddAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddAddresses[iNdEx])
+			copy(dAtA[i:], m.AddAddresses[iNdEx])
+			i = encodeVarintGravity(dAtA, i, uint64(len(m.AddAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumBlocklistProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumBlocklistProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumBlocklistProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RemoveAddresses) > 0 {
+		for iNdEx := len(m.RemoveAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveAddresses[iNdEx])
+			copy(dAtA[i:], m.RemoveAddresses[iNdEx])
+			i = encodeVarintGravity(dAtA, i, uint64(len(m.RemoveAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddAddresses) > 0 {
+		for iNdEx := len(m.AddAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddAddresses[iNdEx])
+			copy(dAtA[i:], m.AddAddresses[iNdEx])
+			i = encodeVarintGravity(dAtA, i, uint64(len(m.AddAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeReenableProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeReenableProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeReenableProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeReenableProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeReenableProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeReenableProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedSendToEthereumVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedSendToEthereumVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedSendToEthereumVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA11 := make([]byte, len(m.Ids)*10)
+		var j10 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintGravity(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedSendToEthereumVetoProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedSendToEthereumVetoProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedSendToEthereumVetoProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Ids) > 0 {
+		dAtA13 := make([]byte, len(m.Ids)*10)
+		var j12 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintGravity(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeldSendToCosmosReleaseProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeldSendToCosmosReleaseProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeldSendToCosmosReleaseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventNonces) > 0 {
+		dAtA15 := make([]byte, len(m.EventNonces)*10)
+		var j14 int
+		for _, num := range m.EventNonces {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintGravity(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeldSendToCosmosReleaseProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeldSendToCosmosReleaseProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeldSendToCosmosReleaseProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EventNonces) > 0 {
+		dAtA17 := make([]byte, len(m.EventNonces)*10)
+		var j16 int
+		for _, num := range m.EventNonces {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintGravity(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmergencySignerSetUpdateProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmergencySignerSetUpdateProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmergencySignerSetUpdateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExcludedEthereumAddresses) > 0 {
+		for iNdEx := len(m.ExcludedEthereumAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludedEthereumAddresses[iNdEx])
+			copy(dAtA[i:], m.ExcludedEthereumAddresses[iNdEx])
+			i = encodeVarintGravity(dAtA, i, uint64(len(m.ExcludedEthereumAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmergencySignerSetUpdateProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmergencySignerSetUpdateProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmergencySignerSetUpdateProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ExcludedEthereumAddresses) > 0 {
+		for iNdEx := len(m.ExcludedEthereumAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludedEthereumAddresses[iNdEx])
+			copy(dAtA[i:], m.ExcludedEthereumAddresses[iNdEx])
+			i = encodeVarintGravity(dAtA, i, uint64(len(m.ExcludedEthereumAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendToEthereumPriorityProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendToEthereumPriorityProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendToEthereumPriorityProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA19 := make([]byte, len(m.Ids)*10)
+		var j18 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j18++
+			}
+			dAtA19[j18] = uint8(num)
+			j18++
+		}
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintGravity(dAtA, i, uint64(j18))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendToEthereumPriorityProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendToEthereumPriorityProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendToEthereumPriorityProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Ids) > 0 {
+		dAtA21 := make([]byte, len(m.Ids)*10)
+		var j20 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j20++
+			}
+			dAtA21[j20] = uint8(num)
+			j20++
+		}
+		i -= j20
+		copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintGravity(dAtA, i, uint64(j20))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AverageOutflow.Size()
+		i -= size
+		if _, err := m.AverageOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AverageInflow.Size()
+		i -= size
+		if _, err := m.AverageInflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeStatsBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeStatsBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeStatsBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OutflowFees.Size()
+		i -= size
+		if _, err := m.OutflowFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.OutflowCount != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.OutflowCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.InflowCount != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.InflowCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Hour != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Hour))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ObservedSignerSetTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObservedSignerSetTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObservedSignerSetTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SignerSet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ObservedEthereumEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObservedEthereumEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObservedEthereumEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutedContractCallTx != nil {
+		{
+			size, err := m.ExecutedContractCallTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGravity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExecutedBatchTx != nil {
+		{
+			size, err := m.ExecutedBatchTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGravity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGravity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20Conversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20Conversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20Conversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmosExponent != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.CosmosExponent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Erc20Decimals != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Erc20Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumAnomalyReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumAnomalyReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumAnomalyReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Anomaly != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Anomaly))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Anomaly != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Anomaly))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrchestratorQueryIdentity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrchestratorQueryIdentity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrchestratorQueryIdentity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.KeyHash) > 0 {
+		i -= len(m.KeyHash)
+		copy(dAtA[i:], m.KeyHash)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.KeyHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EndBlockerAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndBlockerAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndBlockerAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedSendToEthereum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedSendToEthereum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedSendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SendToEthereum.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxExecutionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxExecutionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxExecutionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutedHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.ExecutedHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.EthereumTxHash) > 0 {
+		i -= len(m.EthereumTxHash)
+		copy(dAtA[i:], m.EthereumTxHash)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumTxHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BridgeFees) > 0 {
+		for iNdEx := len(m.BridgeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.TotalFee.Size()
+		i -= size
+		if _, err := m.TotalFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TxCount != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxExecutionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxExecutionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxExecutionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutedHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.ExecutedHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.EthereumTxHash) > 0 {
+		i -= len(m.EthereumTxHash)
+		copy(dAtA[i:], m.EthereumTxHash)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumTxHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ReturnDataHash) > 0 {
+		i -= len(m.ReturnDataHash)
+		copy(dAtA[i:], m.ReturnDataHash)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.ReturnDataHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxRefundRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxRefundRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxRefundRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RefundedHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.RefundedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Refunded) > 0 {
+		for iNdEx := len(m.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Refundee) > 0 {
+		i -= len(m.Refundee)
+		copy(dAtA[i:], m.Refundee)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Refundee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockHashes) > 0 {
+		for iNdEx := len(m.BlockHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockHashes[iNdEx])
+			copy(dAtA[i:], m.BlockHashes[iNdEx])
+			i = encodeVarintGravity(dAtA, i, uint64(len(m.BlockHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ParentCheckpointHash) > 0 {
+		i -= len(m.ParentCheckpointHash)
+		copy(dAtA[i:], m.ParentCheckpointHash)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.ParentCheckpointHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumHeaderVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumHeaderVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumHeaderVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrchestratorFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrchestratorFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrchestratorFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CooldownEndHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.CooldownEndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FrozenHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.FrozenHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Scheme != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Scheme))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGravity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGravity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EthereumEventVoteRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.Votes) > 0 {
+		for _, s := range m.Votes {
+			l = len(s)
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if m.Accepted {
+		n += 2
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	}
+	return n
+}
+
+func (m *LatestEthereumBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	}
+	if m.CosmosHeight != 0 {
+		n += 1 + sovGravity(uint64(m.CosmosHeight))
+	}
+	return n
+}
+
+func (m *EthereumSigner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Power != 0 {
+		n += 1 + sovGravity(uint64(m.Power))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovGravity(uint64(m.Nonce))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignerSetTxDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovGravity(uint64(m.Nonce))
+	}
+	if m.PreviousNonce != 0 {
+		n += 1 + sovGravity(uint64(m.PreviousNonce))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	if len(m.Added) > 0 {
+		for _, e := range m.Added {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, e := range m.Removed {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if len(m.PowerChanged) > 0 {
+		for _, e := range m.PowerChanged {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovGravity(uint64(m.BatchNonce))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovGravity(uint64(m.Timeout))
+	}
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	if len(m.BridgeFees) > 0 {
+		for _, e := range m.BridgeFees {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if m.GasEstimate != 0 {
+		n += 1 + sovGravity(uint64(m.GasEstimate))
+	}
+	return n
+}
+
+func (m *SendToEthereum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGravity(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.EthereumRecipient)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Erc20Token.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	l = m.Erc20Fee.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	if m.BridgeFee != nil {
+		l = m.BridgeFee.Size()
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.BatchTimeouts != 0 {
+		n += 1 + sovGravity(uint64(m.BatchTimeouts))
+	}
+	if m.ChainFee != nil {
+		l = m.ChainFee.Size()
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Priority {
+		n += 2
+	}
+	l = len(m.FeeGranter)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.ExecuteAfterHeight != 0 {
+		n += 1 + sovGravity(uint64(m.ExecuteAfterHeight))
+	}
+	return n
+}
+
+func (m *ContractCallTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovGravity(uint64(m.InvalidationNonce))
+	}
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovGravity(uint64(m.Timeout))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	l = len(m.Refundee)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *ERC20Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *IDSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovGravity(uint64(e))
+		}
+		n += 1 + sovGravity(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *BridgeFeeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommunityPoolEthereumSpendProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *CommunityPoolEthereumSpendProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.BridgeFee)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *EthereumBlocklistProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.AddAddresses) > 0 {
+		for _, s := range m.AddAddresses {
+			l = len(s)
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if len(m.RemoveAddresses) > 0 {
+		for _, s := range m.RemoveAddresses {
+			l = len(s)
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EthereumBlocklistProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.AddAddresses) > 0 {
+		for _, s := range m.AddAddresses {
+			l = len(s)
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if len(m.RemoveAddresses) > 0 {
+		for _, s := range m.RemoveAddresses {
+			l = len(s)
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeReenableProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeReenableProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *DelayedSendToEthereumVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovGravity(uint64(e))
+		}
+		n += 1 + sovGravity(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *DelayedSendToEthereumVetoProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovGravity(uint64(e))
+		}
+		n += 1 + sovGravity(uint64(l)) + l
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *HeldSendToCosmosReleaseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.EventNonces) > 0 {
+		l = 0
+		for _, e := range m.EventNonces {
+			l += sovGravity(uint64(e))
+		}
+		n += 1 + sovGravity(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *HeldSendToCosmosReleaseProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.EventNonces) > 0 {
+		l = 0
+		for _, e := range m.EventNonces {
+			l += sovGravity(uint64(e))
+		}
+		n += 1 + sovGravity(uint64(l)) + l
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *EmergencySignerSetUpdateProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.ExcludedEthereumAddresses) > 0 {
+		for _, s := range m.ExcludedEthereumAddresses {
+			l = len(s)
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EmergencySignerSetUpdateProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.ExcludedEthereumAddresses) > 0 {
+		for _, s := range m.ExcludedEthereumAddresses {
+			l = len(s)
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *SendToEthereumPriorityProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovGravity(uint64(e))
+		}
+		n += 1 + sovGravity(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *SendToEthereumPriorityProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovGravity(uint64(e))
+		}
+		n += 1 + sovGravity(uint64(l)) + l
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Inflow.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	l = m.AverageInflow.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	l = m.AverageOutflow.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *BridgeStatsBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Hour != 0 {
+		n += 1 + sovGravity(uint64(m.Hour))
+	}
+	l = m.Inflow.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	if m.InflowCount != 0 {
+		n += 1 + sovGravity(uint64(m.InflowCount))
+	}
+	if m.OutflowCount != 0 {
+		n += 1 + sovGravity(uint64(m.OutflowCount))
+	}
+	l = m.OutflowFees.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *ObservedSignerSetTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SignerSet.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	}
+	return n
+}
+
+func (m *ObservedEthereumEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.ExecutedBatchTx != nil {
+		l = m.ExecutedBatchTx.Size()
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.ExecutedContractCallTx != nil {
+		l = m.ExecutedContractCallTx.Size()
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20Conversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Erc20Decimals != 0 {
+		n += 1 + sovGravity(uint64(m.Erc20Decimals))
+	}
+	if m.CosmosExponent != 0 {
+		n += 1 + sovGravity(uint64(m.CosmosExponent))
+	}
+	return n
+}
+
+func (m *EthereumAnomalyReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Anomaly != 0 {
+		n += 1 + sovGravity(uint64(m.Anomaly))
+	}
+	return n
+}
+
+func (m *TokenPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Anomaly != 0 {
+		n += 1 + sovGravity(uint64(m.Anomaly))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *OrchestratorQueryIdentity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.KeyHash)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovGravity(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *EndBlockerAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *DelayedSendToEthereum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SendToEthereum.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovGravity(uint64(m.ReleaseHeight))
+	}
+	return n
+}
+
+func (m *BatchTxExecutionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovGravity(uint64(m.BatchNonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovGravity(uint64(m.TxCount))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	l = m.TotalFee.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	if len(m.BridgeFees) > 0 {
+		for _, e := range m.BridgeFees {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.EthereumTxHash)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovGravity(uint64(m.CreatedHeight))
+	}
+	if m.ExecutedHeight != 0 {
+		n += 1 + sovGravity(uint64(m.ExecutedHeight))
+	}
+	return n
+}
+
+func (m *ContractCallTxExecutionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovGravity(uint64(m.InvalidationNonce))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.ReturnDataHash)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.EthereumTxHash)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovGravity(uint64(m.CreatedHeight))
+	}
+	if m.ExecutedHeight != 0 {
+		n += 1 + sovGravity(uint64(m.ExecutedHeight))
+	}
+	return n
+}
+
+func (m *ContractCallTxRefundRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovGravity(uint64(m.InvalidationNonce))
+	}
+	l = len(m.Refundee)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.Refunded) > 0 {
+		for _, e := range m.Refunded {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovGravity(uint64(m.CreatedHeight))
+	}
+	if m.RefundedHeight != 0 {
+		n += 1 + sovGravity(uint64(m.RefundedHeight))
+	}
+	return n
+}
+
+func (m *EthereumHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.ParentCheckpointHash)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.BlockHashes) > 0 {
+		for _, b := range m.BlockHashes {
+			l = len(b)
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EthereumHeaderVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Header.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *OrchestratorFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.FrozenHeight != 0 {
+		n += 1 + sovGravity(uint64(m.FrozenHeight))
+	}
+	if m.CooldownEndHeight != 0 {
+		n += 1 + sovGravity(uint64(m.CooldownEndHeight))
+	}
+	return n
+}
+
+func (m *EthereumSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scheme != 0 {
+		n += 1 + sovGravity(uint64(m.Scheme))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func sovGravity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGravity(x uint64) (n int) {
+	return sovGravity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumEventVoteRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumEventVoteRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &types.Any{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestEthereumBlockHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosHeight", wireType)
+			}
+			m.CosmosHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumSigner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumSigner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, &EthereumSigner{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousNonce", wireType)
+			}
+			m.PreviousNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Added = append(m.Added, &EthereumSigner{})
+			if err := m.Added[len(m.Added)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, &EthereumSigner{})
+			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerChanged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerChanged = append(m.PowerChanged, &EthereumSigner{})
+			if err := m.PowerChanged[len(m.PowerChanged)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &SendToEthereum{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeFees = append(m.BridgeFees, types1.Coin{})
+			if err := m.BridgeFees[len(m.BridgeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasEstimate", wireType)
+			}
+			m.GasEstimate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasEstimate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendToEthereum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BridgeFee == nil {
+				m.BridgeFee = &types1.Coin{}
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTimeouts", wireType)
+			}
+			m.BatchTimeouts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchTimeouts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChainFee == nil {
+				m.ChainFee = &types1.Coin{}
+			}
+			if err := m.ChainFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Priority = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGranter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGranter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfterHeight", wireType)
+			}
+			m.ExecuteAfterHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAfterHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, ERC20Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, ERC20Token{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calls = append(m.Calls, ContractCall{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refundee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refundee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20Token) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20Token: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20Token: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IDSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IDSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IDSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGravity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGravity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGravity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeFeeAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeFeeAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeFeeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types1.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolEthereumSpendProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolEthereumSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolEthereumSpendProposalForCLI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolEthereumSpendProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumBlocklistProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumBlocklistProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumBlocklistProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddAddresses = append(m.AddAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAddresses = append(m.RemoveAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumBlocklistProposalForCLI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumBlocklistProposalForCLI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumBlocklistProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddAddresses = append(m.AddAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAddresses = append(m.RemoveAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeReenableProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeReenableProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeReenableProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeReenableProposalForCLI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeReenableProposalForCLI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeReenableProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayedSendToEthereumVetoProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedSendToEthereumVetoProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedSendToEthereumVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGravity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGravity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGravity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayedSendToEthereumVetoProposalForCLI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedSendToEthereumVetoProposalForCLI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedSendToEthereumVetoProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGravity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGravity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGravity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeldSendToCosmosReleaseProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeldSendToCosmosReleaseProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeldSendToCosmosReleaseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EventNonces = append(m.EventNonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGravity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGravity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.EventNonces) == 0 {
+					m.EventNonces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGravity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EventNonces = append(m.EventNonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonces", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeldSendToCosmosReleaseProposalForCLI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeldSendToCosmosReleaseProposalForCLI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeldSendToCosmosReleaseProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EventNonces = append(m.EventNonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGravity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGravity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.EventNonces) == 0 {
+					m.EventNonces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGravity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EventNonces = append(m.EventNonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonces", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmergencySignerSetUpdateProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmergencySignerSetUpdateProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmergencySignerSetUpdateProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludedEthereumAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludedEthereumAddresses = append(m.ExcludedEthereumAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmergencySignerSetUpdateProposalForCLI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmergencySignerSetUpdateProposalForCLI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmergencySignerSetUpdateProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludedEthereumAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludedEthereumAddresses = append(m.ExcludedEthereumAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendToEthereumPriorityProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendToEthereumPriorityProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendToEthereumPriorityProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGravity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGravity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGravity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendToEthereumPriorityProposalForCLI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendToEthereumPriorityProposalForCLI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendToEthereumPriorityProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGravity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGravity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGravity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeStatsBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeStatsBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeStatsBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hour", wireType)
+			}
+			m.Hour = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hour |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflowCount", wireType)
+			}
+			m.InflowCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflowCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowCount", wireType)
+			}
+			m.OutflowCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutflowCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutflowFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObservedSignerSetTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObservedSignerSetTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObservedSignerSetTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObservedEthereumEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObservedEthereumEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObservedEthereumEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &types.Any{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBatchTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedBatchTx == nil {
+				m.ExecutedBatchTx = &BatchTx{}
+			}
+			if err := m.ExecutedBatchTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedContractCallTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutedContractCallTx == nil {
+				m.ExecutedContractCallTx = &ContractCallTx{}
+			}
+			if err := m.ExecutedContractCallTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20Conversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20Conversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20Conversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Decimals", wireType)
+			}
+			m.Erc20Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Erc20Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosExponent", wireType)
+			}
+			m.CosmosExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
+func (m *EthereumAnomalyReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1666,17 +12375,17 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EthereumEventVoteRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthereumAnomalyReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthereumEventVoteRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthereumAnomalyReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1686,31 +12395,27 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Event == nil {
-				m.Event = &types.Any{}
-			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1738,13 +12443,13 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, string(dAtA[iNdEx:postIndex]))
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Anomaly", wireType)
 			}
-			var v int
+			m.Anomaly = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1754,12 +12459,11 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Anomaly |= EthereumAnomaly(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Accepted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGravity(dAtA[iNdEx:])
@@ -1781,7 +12485,7 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *TokenPause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1804,17 +12508,17 @@ func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LatestEthereumBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenPause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenPause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			m.EthereumHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1824,16 +12528,29 @@ func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Anomaly", wireType)
 			}
-			m.CosmosHeight = 0
+			m.Anomaly = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1843,7 +12560,26 @@ func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CosmosHeight |= uint64(b&0x7F) << shift
+				m.Anomaly |= EthereumAnomaly(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1869,7 +12605,7 @@ func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
+func (m *OrchestratorQueryIdentity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1892,17 +12628,17 @@ func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EthereumSigner: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrchestratorQueryIdentity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthereumSigner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrchestratorQueryIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
 			}
-			m.Power = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1912,16 +12648,29 @@ func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1931,24 +12680,45 @@ func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.KeyHash = append(m.KeyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyHash == nil {
+				m.KeyHash = []byte{}
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGravity(dAtA[iNdEx:])
@@ -1970,7 +12740,7 @@ func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
+func (m *EndBlockerAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1993,17 +12763,17 @@ func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: EndBlockerAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EndBlockerAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Nonce = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2013,16 +12783,16 @@ func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2032,16 +12802,29 @@ func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2051,25 +12834,23 @@ func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, &EthereumSigner{})
-			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2092,7 +12873,7 @@ func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTx) Unmarshal(dAtA []byte) error {
+func (m *DelayedSendToEthereum) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2108,96 +12889,24 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
-			}
-			m.BatchNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
-			}
-			m.Timeout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timeout |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGravity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGravity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Transactions = append(m.Transactions, &SendToEthereum{})
-			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedSendToEthereum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedSendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereum", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2207,29 +12916,30 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			if err := m.SendToEthereum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
 			}
-			m.Height = 0
+			m.ReleaseHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2239,7 +12949,7 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.ReleaseHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2265,7 +12975,7 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
+func (m *BatchTxExecutionRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2288,17 +12998,17 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendToEthereum: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchTxExecutionRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchTxExecutionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
 			}
-			m.Id = 0
+			m.BatchNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2308,14 +13018,14 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.BatchNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2343,11 +13053,30 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumRecipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2375,13 +13104,15 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2391,28 +13122,29 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Erc20Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2439,10 +13171,134 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Erc20Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BridgeFees = append(m.BridgeFees, types1.Coin{})
+			if err := m.BridgeFees[len(m.BridgeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumTxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumTxHash = append(m.EthereumTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthereumTxHash == nil {
+				m.EthereumTxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedHeight", wireType)
+			}
+			m.ExecutedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGravity(dAtA[iNdEx:])
@@ -2464,7 +13320,7 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
+func (m *ContractCallTxExecutionRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2487,32 +13343,13 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCallTxExecutionRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCallTxExecutionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
-			}
-			m.InvalidationNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
 			}
@@ -2546,6 +13383,25 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				m.InvalidationScope = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
@@ -2579,10 +13435,10 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2592,31 +13448,17 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGravity
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGravity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
-			iNdEx = postIndex
+			m.Success = bool(v != 0)
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnDataHash", wireType)
 			}
-			m.Timeout = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2626,16 +13468,31 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnDataHash = append(m.ReturnDataHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnDataHash == nil {
+				m.ReturnDataHash = []byte{}
+			}
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumTxHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2645,31 +13502,31 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, ERC20Token{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EthereumTxHash = append(m.EthereumTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthereumTxHash == nil {
+				m.EthereumTxHash = []byte{}
 			}
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
 			}
-			var msglen int
+			m.EthereumHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2679,31 +13536,35 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EthereumHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGravity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGravity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
 			}
-			m.Fees = append(m.Fees, ERC20Token{})
-			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedHeight", wireType)
 			}
-			m.Height = 0
+			m.ExecutedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2713,7 +13574,7 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.ExecutedHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2739,7 +13600,7 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20Token) Unmarshal(dAtA []byte) error {
+func (m *ContractCallTxRefundRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2762,17 +13623,17 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20Token: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCallTxRefundRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20Token: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCallTxRefundRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2782,27 +13643,48 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refundee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2830,135 +13712,79 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Refundee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGravity(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGravity
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IDSet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGravity
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Refunded = append(m.Refunded, types1.Coin{})
+			if err := m.Refunded[len(m.Refunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IDSet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IDSet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGravity
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGravity
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGravity
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGravity
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]uint64, 0, elementCount)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGravity
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedHeight", wireType)
+			}
+			m.RefundedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -2981,7 +13807,7 @@ func (m *IDSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
+func (m *EthereumHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3004,17 +13830,36 @@ func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolEthereumSpendProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthereumHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolEthereumSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthereumHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -3024,29 +13869,31 @@ func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCheckpointHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -3056,29 +13903,31 @@ func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ParentCheckpointHash = append(m.ParentCheckpointHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParentCheckpointHash == nil {
+				m.ParentCheckpointHash = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHashes", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -3088,29 +13937,79 @@ func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.BlockHashes = append(m.BlockHashes, make([]byte, postIndex-iNdEx))
+			copy(m.BlockHashes[len(m.BlockHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumHeaderVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumHeaderVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumHeaderVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -3120,28 +14019,27 @@ func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3168,7 +14066,7 @@ func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3193,7 +14091,7 @@ func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error {
+func (m *OrchestratorFreeze) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3216,15 +14114,15 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolEthereumSpendProposalForCLI: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrchestratorFreeze: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolEthereumSpendProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrchestratorFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3252,11 +14150,11 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3284,11 +14182,11 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3316,13 +14214,13 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenHeight", wireType)
 			}
-			var stringLen uint64
+			m.FrozenHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -3332,29 +14230,16 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FrozenHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGravity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGravity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownEndHeight", wireType)
 			}
-			var stringLen uint64
+			m.CooldownEndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -3364,29 +14249,85 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CooldownEndHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGravity
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGravity
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeFee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+			}
+			m.Scheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scheme |= SignatureScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -3396,23 +14337,25 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposit = string(dAtA[iNdEx:postIndex])
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4,10 +4,18 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 
 type GravityHooks interface {
 	AfterContractCallExecutedEvent(ctx sdk.Context, event ContractCallExecutedEvent)
+	AfterContractCallExecuted(ctx sdk.Context, tx ContractCallTx, success bool, returnDataHash []byte)
 	AfterERC20DeployedEvent(ctx sdk.Context, event ERC20DeployedEvent)
 	AfterSignerSetExecutedEvent(ctx sdk.Context, event SignerSetTxExecutedEvent)
 	AfterBatchExecutedEvent(ctx sdk.Context, event BatchExecutedEvent)
 	AfterSendToCosmosEvent(ctx sdk.Context, event SendToCosmosEvent)
+	AfterEthereumSignatureReceived(ctx sdk.Context, storeIndex []byte, validator sdk.ValAddress, cumulativePower uint64)
+	AfterBatchCreated(ctx sdk.Context, batch BatchTx)
+	AfterBatchExecuted(ctx sdk.Context, batch BatchTx)
+	AfterBatchCancelled(ctx sdk.Context, batch BatchTx)
+	AfterSendToEthereumPooled(ctx sdk.Context, ste SendToEthereum)
+	AfterSignerSetObserved(ctx sdk.Context, event SignerSetTxExecutedEvent)
+	AfterEventObserved(ctx sdk.Context, event EthereumEvent)
 }
 
 type MultiGravityHooks []GravityHooks
@@ -22,6 +30,12 @@ func (mghs MultiGravityHooks) AfterContractCallExecutedEvent(ctx sdk.Context, ev
 	}
 }
 
+func (mghs MultiGravityHooks) AfterContractCallExecuted(ctx sdk.Context, tx ContractCallTx, success bool, returnDataHash []byte) {
+	for i := range mghs {
+		mghs[i].AfterContractCallExecuted(ctx, tx, success, returnDataHash)
+	}
+}
+
 func (mghs MultiGravityHooks) AfterERC20DeployedEvent(ctx sdk.Context, event ERC20DeployedEvent) {
 	for i := range mghs {
 		mghs[i].AfterERC20DeployedEvent(ctx, event)
@@ -45,3 +59,45 @@ func (mghs MultiGravityHooks) AfterSendToCosmosEvent(ctx sdk.Context, event Send
 		mghs[i].AfterSendToCosmosEvent(ctx, event)
 	}
 }
+
+func (mghs MultiGravityHooks) AfterEthereumSignatureReceived(ctx sdk.Context, storeIndex []byte, validator sdk.ValAddress, cumulativePower uint64) {
+	for i := range mghs {
+		mghs[i].AfterEthereumSignatureReceived(ctx, storeIndex, validator, cumulativePower)
+	}
+}
+
+func (mghs MultiGravityHooks) AfterBatchCreated(ctx sdk.Context, batch BatchTx) {
+	for i := range mghs {
+		mghs[i].AfterBatchCreated(ctx, batch)
+	}
+}
+
+func (mghs MultiGravityHooks) AfterBatchExecuted(ctx sdk.Context, batch BatchTx) {
+	for i := range mghs {
+		mghs[i].AfterBatchExecuted(ctx, batch)
+	}
+}
+
+func (mghs MultiGravityHooks) AfterBatchCancelled(ctx sdk.Context, batch BatchTx) {
+	for i := range mghs {
+		mghs[i].AfterBatchCancelled(ctx, batch)
+	}
+}
+
+func (mghs MultiGravityHooks) AfterSendToEthereumPooled(ctx sdk.Context, ste SendToEthereum) {
+	for i := range mghs {
+		mghs[i].AfterSendToEthereumPooled(ctx, ste)
+	}
+}
+
+func (mghs MultiGravityHooks) AfterSignerSetObserved(ctx sdk.Context, event SignerSetTxExecutedEvent) {
+	for i := range mghs {
+		mghs[i].AfterSignerSetObserved(ctx, event)
+	}
+}
+
+func (mghs MultiGravityHooks) AfterEventObserved(ctx sdk.Context, event EthereumEvent) {
+	for i := range mghs {
+		mghs[i].AfterEventObserved(ctx, event)
+	}
+}
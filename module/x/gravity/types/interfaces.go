@@ -13,6 +13,7 @@ type EthereumTxConfirmation interface {
 
 	GetSigner() common.Address
 	GetSignature() []byte
+	GetScheme() SignatureScheme
 	GetStoreIndex() []byte
 	Validate() error
 }
@@ -24,6 +25,10 @@ type EthereumEvent interface {
 
 	GetEventNonce() uint64
 	GetEthereumHeight() uint64
+	GetEthereumTxHash() tmbytes.HexBytes
+	GetEthereumLogIndex() uint64
+	GetHasEthereumLogIndex() bool
+	GetEthereumBlockHash() tmbytes.HexBytes
 	Hash() tmbytes.HexBytes
 	Validate() error
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -15,6 +16,9 @@ const (
 	// StoreKey to be used when creating the KVStore
 	StoreKey = ModuleName
 
+	// RelayerRewardPoolName is the module account holding the relayer rewards
+	RelayerRewardPoolName = "gravity_relayer_rewards"
+
 	// RouterKey is the module name router key
 	RouterKey = ModuleName
 
@@ -62,6 +66,134 @@ const (
 
 	// EthereumHeightVoteKey indexes the latest heights observed by each validator
 	EthereumHeightVoteKey
+
+	// MintRateLimitUsageKey indexes the amount credited by deposits per token in the current window
+	MintRateLimitUsageKey
+
+	// QueuedSendToCosmosKey indexes deposits waiting on a mint rate limit
+	QueuedSendToCosmosKey
+
+	// EthereumBlocklistKey indexes the blocklisted Ethereum addresses
+	EthereumBlocklistKey
+
+	// BridgeProposalRejectionKey marks bridge-critical proposals ending this
+	// block that fall short of the elevated quorum or threshold
+	BridgeProposalRejectionKey
+
+	// PendingValidatorEthereumAddressKey indexes the ethereum addresses waiting
+	// to replace a validator's current one
+	PendingValidatorEthereumAddressKey
+
+	// BridgeFlowKey indexes the inflow and outflow of each token for the circuit breaker
+	BridgeFlowKey
+
+	// EndBlockerActionKey indexes the slots of the recent end blocker actions ring buffer
+	EndBlockerActionKey
+
+	// EndBlockerActionSequenceKey indexes the number of end blocker actions recorded so far
+	EndBlockerActionSequenceKey
+
+	// ObservedSignerSetTxKey indexes the signer sets observed on Ethereum by the height they took effect at
+	ObservedSignerSetTxKey
+
+	// EthereumAddressValidatorKey indexes the validators by their current and pending ethereum addresses
+	EthereumAddressValidatorKey
+
+	// PendingERC20DeploymentKey indexes the observed ERC20 deployments awaiting confirmation
+	PendingERC20DeploymentKey
+
+	// ERC20ConversionKey indexes the decimal conversions of ERC20s by token contract
+	ERC20ConversionKey
+
+	// EthereumAnomalyReportKey indexes the anomaly last reported by each validator by token contract
+	EthereumAnomalyReportKey
+
+	// TokenPauseKey indexes the tokens whose sends to ethereum are paused
+	TokenPauseKey
+
+	// OrchestratorQueryIdentityKey indexes the orchestrator query identities by API key hash
+	OrchestratorQueryIdentityKey
+
+	// DelayedSendToEthereumKey indexes the delayed sends to ethereum by release height and id
+	DelayedSendToEthereumKey
+
+	// LastSignatureHeightByValidatorKey indexes the height of each validator's last outgoing tx signature
+	LastSignatureHeightByValidatorKey
+
+	// LastEventVoteHeightByValidatorKey indexes the height of each validator's last ethereum event vote
+	LastEventVoteHeightByValidatorKey
+
+	// EthereumTxHashEventKey indexes the observed ethereum event vote records by ethereum tx hash and event nonce
+	EthereumTxHashEventKey
+
+	// HeldSendToCosmosKey indexes deposits of tokens held off the bridge allowlist
+	HeldSendToCosmosKey
+
+	// ExecutedBatchTxKey indexes the archived records of executed batches by execution height
+	ExecutedBatchTxKey
+
+	// ValidatorSignatureSchemeKey indexes the signature scheme of validators not signing with ECDSA
+	ValidatorSignatureSchemeKey
+
+	// ExcludedEthereumSignerKey indexes the ethereum addresses excluded from signer sets by governance
+	ExcludedEthereumSignerKey
+
+	// MissedSignaturesByValidatorKey indexes the outgoing txs each validator missed since its last signature
+	MissedSignaturesByValidatorKey
+
+	// ExecutedContractCallTxKey indexes the archived records of executed contract calls by execution height
+	ExecutedContractCallTxKey
+
+	// BridgeFeeAllowanceKey indexes the bridge fee allowances by granter and grantee
+	BridgeFeeAllowanceKey
+
+	// PastEthereumSignatureCheckpointKey indexes the checkpoints of all the outgoing txs the chain created
+	PastEthereumSignatureCheckpointKey
+
+	// BadEthereumSignatureEvidenceKey indexes the hashes of the bad ethereum signature evidence already submitted
+	BadEthereumSignatureEvidenceKey
+
+	// RefundedContractCallTxKey indexes the archived records of refunded contract calls by refund height
+	RefundedContractCallTxKey
+
+	// SignerSetTxDeltaKey indexes the differences between consecutive signer sets by nonce
+	SignerSetTxDeltaKey
+
+	// OutgoingTxTimeoutKey indexes the outgoing txs that time out by type and timeout
+	OutgoingTxTimeoutKey
+
+	// OptedOutValidatorKey indexes the validators opted out of the bridge duties
+	OptedOutValidatorKey
+
+	// BridgeStatsKey indexes the hourly bridge usage stats of tokens by hour
+	BridgeStatsKey
+
+	// EthereumHeaderVoteKey indexes the latest ethereum header voted by each validator, per bridge chain id
+	EthereumHeaderVoteKey
+
+	// AgreedEthereumHeaderKey indexes the ethereum header agreed on at the latest checkpoint, per bridge chain id
+	AgreedEthereumHeaderKey
+
+	// OrchestratorFreezeKey indexes the frozen delegate keys of validators
+	OrchestratorFreezeKey
+
+	// PastOutgoingTxNonceKey indexes the highest nonces of the outgoing txs created before their checkpoints were recorded
+	PastOutgoingTxNonceKey
+
+	// OutgoingTxHeightKey indexes the outgoing txs by the cosmos height they were created at
+	OutgoingTxHeightKey
+
+	// GravityContractCleanupKey indexes the progress of the cleanup of the event vote records of the previous gravity contract
+	GravityContractCleanupKey
+
+	// AgreedEthereumHeaderByHeightKey indexes the agreed ethereum headers by bridge chain id and height
+	AgreedEthereumHeaderByHeightKey
+
+	// ObservedEthereumEventKey indexes the log of the observed ethereum events by event nonce
+	ObservedEthereumEventKey
+
+	// BridgedSupplyKey indexes the net amount of each ERC20 bridged in since the event log started
+	BridgedSupplyKey
 )
 
 ////////////////////
@@ -117,16 +249,25 @@ func MakeOutgoingTxKey(storeIndex []byte) []byte {
 	return append([]byte{OutgoingTxKey}, storeIndex...)
 }
 
+// MakeOutgoingTxTimeoutKey returns the following key format
+// prefix    type    timeout             store-index
+// [0x33][0x02][0 0 0 0 0 0 0 1][0x02...]
+func MakeOutgoingTxTimeoutKey(timeout uint64, storeIndex []byte) []byte {
+	return bytes.Join([][]byte{{OutgoingTxTimeoutKey, storeIndex[0]}, sdk.Uint64ToBigEndian(timeout), storeIndex}, []byte{})
+}
+
 //////////////////////
 // Send To Ethereum //
 //////////////////////
 
 // MakeSendToEthereumKey returns the following key format
-// prefix            eth-contract-address            fee_amount        id
-// [0x7][0xc783df8a850f42e7F7e57013759C285caa701eB6][1000000000][0 0 0 0 0 0 0 1]
+// prefix            eth-contract-address            fee_amount        inverted-id
+// [0x7][0xc783df8a850f42e7F7e57013759C285caa701eB6][1000000000][255 255 255 255 255 255 255 254]
+// The id is stored inverted so that iterating the pool of a token in reverse
+// yields its txs by fee descending, then by id ascending.
 func MakeSendToEthereumKey(id uint64, fee ERC20Token) []byte {
 	amount := make([]byte, 32)
-	return bytes.Join([][]byte{{SendToEthereumKey}, common.HexToAddress(fee.Contract).Bytes(), fee.Amount.BigInt().FillBytes(amount), sdk.Uint64ToBigEndian(id)}, []byte{})
+	return bytes.Join([][]byte{{SendToEthereumKey}, common.HexToAddress(fee.Contract).Bytes(), fee.Amount.BigInt().FillBytes(amount), sdk.Uint64ToBigEndian(^id)}, []byte{})
 }
 
 // MakeLastEventNonceByValidatorKey indexes lateset event nonce by validator
@@ -160,3 +301,276 @@ func MakeContractCallTxKey(invalscope []byte, invalnonce uint64) []byte {
 func MakeEthereumHeightVoteKey(validator sdk.ValAddress) []byte {
 	return append([]byte{EthereumHeightVoteKey}, validator.Bytes()...)
 }
+
+// MakeMintRateLimitUsageKey returns the following key format
+// prefix            eth-contract-address
+// [0x15][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeMintRateLimitUsageKey(tokenContract common.Address) []byte {
+	return append([]byte{MintRateLimitUsageKey}, tokenContract.Bytes()...)
+}
+
+// MakeQueuedSendToCosmosKey returns the following key format
+// prefix            eth-contract-address               event-nonce
+// [0x16][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func MakeQueuedSendToCosmosKey(tokenContract common.Address, eventNonce uint64) []byte {
+	return bytes.Join([][]byte{{QueuedSendToCosmosKey}, tokenContract.Bytes(), sdk.Uint64ToBigEndian(eventNonce)}, []byte{})
+}
+
+// MakeEthereumBlocklistKey returns the following key format
+// prefix            eth-address
+// [0x17][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeEthereumBlocklistKey(addr common.Address) []byte {
+	return append([]byte{EthereumBlocklistKey}, addr.Bytes()...)
+}
+
+// MakeBridgeProposalRejectionKey returns the following key format
+// prefix    content-hash
+// [0x18][sha256 of the proposal content]
+func MakeBridgeProposalRejectionKey(contentHash []byte) []byte {
+	return append([]byte{BridgeProposalRejectionKey}, contentHash...)
+}
+
+// MakePendingValidatorEthereumAddressKey returns the following key format
+// prefix    cosmos-validator
+// [0x19][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakePendingValidatorEthereumAddressKey(validator sdk.ValAddress) []byte {
+	return append([]byte{PendingValidatorEthereumAddressKey}, validator.Bytes()...)
+}
+
+// MakeBridgeFlowKey returns the following key format
+// prefix    token-contract
+// [0x1a][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeBridgeFlowKey(tokenContract common.Address) []byte {
+	return append([]byte{BridgeFlowKey}, tokenContract.Bytes()...)
+}
+
+// MakeEndBlockerActionKey returns the following key format
+// prefix    slot
+// [0x1b][0 0 0 0 0 0 0 1]
+func MakeEndBlockerActionKey(slot uint64) []byte {
+	return append([]byte{EndBlockerActionKey}, sdk.Uint64ToBigEndian(slot)...)
+}
+
+// MakeObservedSignerSetTxKey returns the following key format
+// prefix    ethereum-height           nonce
+// [0x1d][0 0 0 0 0 0 3 232][0 0 0 0 0 0 0 1]
+func MakeObservedSignerSetTxKey(ethereumHeight, nonce uint64) []byte {
+	return bytes.Join([][]byte{{ObservedSignerSetTxKey}, sdk.Uint64ToBigEndian(ethereumHeight), sdk.Uint64ToBigEndian(nonce)}, []byte{})
+}
+
+// MakeEthereumAddressValidatorKey returns the following key format
+// prefix    ethereum-address                               validator-address
+// [0x1e][0xc783df8a850f42e7F7e57013759C285caa701eB6][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeEthereumAddressValidatorKey(ethAddr common.Address, validator sdk.ValAddress) []byte {
+	return bytes.Join([][]byte{{EthereumAddressValidatorKey}, ethAddr.Bytes(), validator.Bytes()}, []byte{})
+}
+
+// MakePendingERC20DeploymentKey returns the following key format
+// prefix    token-contract                                 cosmos-denom
+// [0x1f][0xc783df8a850f42e7F7e57013759C285caa701eB6][uatom]
+func MakePendingERC20DeploymentKey(tokenContract common.Address, denom string) []byte {
+	return bytes.Join([][]byte{{PendingERC20DeploymentKey}, tokenContract.Bytes(), []byte(denom)}, []byte{})
+}
+
+// MakeERC20ConversionKey returns the following key format
+// prefix    token-contract
+// [0x20][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeERC20ConversionKey(tokenContract common.Address) []byte {
+	return append([]byte{ERC20ConversionKey}, tokenContract.Bytes()...)
+}
+
+// MakeEthereumAnomalyReportKey returns the following key format
+// prefix    token-contract                                 validator-address
+// [0x21][0xc783df8a850f42e7F7e57013759C285caa701eB6][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeEthereumAnomalyReportKey(tokenContract common.Address, validator sdk.ValAddress) []byte {
+	return bytes.Join([][]byte{{EthereumAnomalyReportKey}, tokenContract.Bytes(), validator.Bytes()}, []byte{})
+}
+
+// MakeTokenPauseKey returns the following key format
+// prefix    token-contract
+// [0x22][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeTokenPauseKey(tokenContract common.Address) []byte {
+	return append([]byte{TokenPauseKey}, tokenContract.Bytes()...)
+}
+
+// MakeOrchestratorQueryIdentityKey returns the following key format
+// prefix    key-hash
+// [0x23][0x5e0a4d8ba2f9bde0b3a7d1c1e2c3b8a4f0e1d2c3b4a5968778695a4b3c2d1e0f]
+func MakeOrchestratorQueryIdentityKey(keyHash []byte) []byte {
+	return append([]byte{OrchestratorQueryIdentityKey}, keyHash...)
+}
+
+// MakeDelayedSendToEthereumKey returns the following key format
+// prefix    release-height            id
+// [0x24][0 0 0 0 0 0 3 232][0 0 0 0 0 0 0 1]
+func MakeDelayedSendToEthereumKey(releaseHeight, id uint64) []byte {
+	return bytes.Join([][]byte{{DelayedSendToEthereumKey}, sdk.Uint64ToBigEndian(releaseHeight), sdk.Uint64ToBigEndian(id)}, []byte{})
+}
+
+// MakeLastSignatureHeightByValidatorKey returns the following key format
+// prefix    cosmos-validator
+// [0x25][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeLastSignatureHeightByValidatorKey(validator sdk.ValAddress) []byte {
+	return append([]byte{LastSignatureHeightByValidatorKey}, validator.Bytes()...)
+}
+
+// MakeLastEventVoteHeightByValidatorKey returns the following key format
+// prefix    cosmos-validator
+// [0x26][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeLastEventVoteHeightByValidatorKey(validator sdk.ValAddress) []byte {
+	return append([]byte{LastEventVoteHeightByValidatorKey}, validator.Bytes()...)
+}
+
+// MakeEthereumTxHashEventKey returns the following key format
+// prefix    ethereum-tx-hash                                                  event-nonce
+// [0x27][0x5e0a4d8ba2f9bde0b3a7d1c1e2c3b8a4f0e1d2c3b4a5968778695a4b3c2d1e0f][0 0 0 0 0 0 0 1]
+func MakeEthereumTxHashEventKey(txHash []byte, eventNonce uint64) []byte {
+	return bytes.Join([][]byte{{EthereumTxHashEventKey}, txHash, sdk.Uint64ToBigEndian(eventNonce)}, []byte{})
+}
+
+// MakeHeldSendToCosmosKey returns the following key format
+// prefix            eth-contract-address               event-nonce
+// [0x28][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func MakeHeldSendToCosmosKey(tokenContract common.Address, eventNonce uint64) []byte {
+	return bytes.Join([][]byte{{HeldSendToCosmosKey}, tokenContract.Bytes(), sdk.Uint64ToBigEndian(eventNonce)}, []byte{})
+}
+
+// MakeExecutedBatchTxKey returns the following key format
+// prefix    executed-height    eth-contract-address                       batch-nonce
+// [0x29][0 0 0 0 0 0 0 1][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func MakeExecutedBatchTxKey(executedHeight uint64, tokenContract common.Address, batchNonce uint64) []byte {
+	return bytes.Join([][]byte{{ExecutedBatchTxKey}, sdk.Uint64ToBigEndian(executedHeight), tokenContract.Bytes(), sdk.Uint64ToBigEndian(batchNonce)}, []byte{})
+}
+
+// MakeExecutedContractCallTxKey returns the following key format
+// prefix    executed-height    invalidation-scope    invalidation-nonce
+// [0x2d][0 0 0 0 0 0 0 1][0x8fc1...e4a2][0 0 0 0 0 0 0 1]
+func MakeExecutedContractCallTxKey(executedHeight uint64, invalidationScope []byte, invalidationNonce uint64) []byte {
+	return bytes.Join([][]byte{{ExecutedContractCallTxKey}, sdk.Uint64ToBigEndian(executedHeight), invalidationScope, sdk.Uint64ToBigEndian(invalidationNonce)}, []byte{})
+}
+
+// MakeRefundedContractCallTxKey returns the following key format
+// prefix    refunded-height    invalidation-scope    invalidation-nonce
+// [0x31][0 0 0 0 0 0 0 1][0x8fc1...e4a2][0 0 0 0 0 0 0 1]
+func MakeRefundedContractCallTxKey(refundedHeight uint64, invalidationScope []byte, invalidationNonce uint64) []byte {
+	return bytes.Join([][]byte{{RefundedContractCallTxKey}, sdk.Uint64ToBigEndian(refundedHeight), invalidationScope, sdk.Uint64ToBigEndian(invalidationNonce)}, []byte{})
+}
+
+// MakeSignerSetTxDeltaKey returns the following key format
+// prefix     nonce
+// [0x32][0 0 0 0 0 0 0 1]
+func MakeSignerSetTxDeltaKey(nonce uint64) []byte {
+	return append([]byte{SignerSetTxDeltaKey}, sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// MakeBridgeFeeAllowanceKey returns the following key format
+// prefix    len granter                                        grantee
+// [0x2e][20][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn][cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej]
+func MakeBridgeFeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	return bytes.Join([][]byte{{BridgeFeeAllowanceKey}, address.MustLengthPrefix(granter), grantee.Bytes()}, []byte{})
+}
+
+// MakePastEthereumSignatureCheckpointKey returns the following key format
+// prefix    checkpoint
+// [0x2f][0xc783...eB6]
+func MakePastEthereumSignatureCheckpointKey(checkpoint []byte) []byte {
+	return append([]byte{PastEthereumSignatureCheckpointKey}, checkpoint...)
+}
+
+// MakeBadEthereumSignatureEvidenceKey returns the following key format
+// prefix    evidence-hash
+// [0x30][0x8fc1...e4a2]
+func MakeBadEthereumSignatureEvidenceKey(evidenceHash []byte) []byte {
+	return append([]byte{BadEthereumSignatureEvidenceKey}, evidenceHash...)
+}
+
+// MakeValidatorSignatureSchemeKey returns the following key format
+// prefix    cosmos-validator
+// [0x2a][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeValidatorSignatureSchemeKey(validator sdk.ValAddress) []byte {
+	return append([]byte{ValidatorSignatureSchemeKey}, validator.Bytes()...)
+}
+
+// MakeExcludedEthereumSignerKey returns the following key format
+// prefix            eth-address
+// [0x2b][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeExcludedEthereumSignerKey(addr common.Address) []byte {
+	return append([]byte{ExcludedEthereumSignerKey}, addr.Bytes()...)
+}
+
+// MakeOptedOutValidatorKey returns the following key format
+// prefix    cosmos-validator
+// [0x34][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeOptedOutValidatorKey(validator sdk.ValAddress) []byte {
+	return append([]byte{OptedOutValidatorKey}, validator.Bytes()...)
+}
+
+// MakeBridgeStatsKey returns the following key format
+// prefix hour      token-contract
+// [0x35][0 0 0 0 0 0 1 1][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeBridgeStatsKey(hour uint64, tokenContract common.Address) []byte {
+	return bytes.Join([][]byte{{BridgeStatsKey}, sdk.Uint64ToBigEndian(hour), tokenContract.Bytes()}, []byte{})
+}
+
+// MakeMissedSignaturesByValidatorKey returns the following key format
+// prefix    cosmos-validator
+// [0x2c][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeMissedSignaturesByValidatorKey(validator sdk.ValAddress) []byte {
+	return append([]byte{MissedSignaturesByValidatorKey}, validator.Bytes()...)
+}
+
+// MakeAgreedEthereumHeaderKey returns the following key format
+// prefix     chain-id
+// [0x37][0 0 0 0 0 0 0 1]
+func MakeAgreedEthereumHeaderKey(chainID uint64) []byte {
+	return append([]byte{AgreedEthereumHeaderKey}, sdk.Uint64ToBigEndian(chainID)...)
+}
+
+// MakeAgreedEthereumHeaderByHeightKey returns the following key format
+// prefix     chain-id           height
+// [0x3c][0 0 0 0 0 0 0 1][0 0 0 0 0 0 0 1]
+func MakeAgreedEthereumHeaderByHeightKey(chainID, height uint64) []byte {
+	return bytes.Join([][]byte{{AgreedEthereumHeaderByHeightKey}, sdk.Uint64ToBigEndian(chainID), sdk.Uint64ToBigEndian(height)}, []byte{})
+}
+
+// MakeObservedEthereumEventKey returns the following key format
+// prefix     nonce
+// [0x3d][0 0 0 0 0 0 0 1]
+func MakeObservedEthereumEventKey(eventNonce uint64) []byte {
+	return append([]byte{ObservedEthereumEventKey}, sdk.Uint64ToBigEndian(eventNonce)...)
+}
+
+// MakeBridgedSupplyKey returns the following key format
+// prefix    token-contract
+// [0x3e][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeBridgedSupplyKey(tokenContract common.Address) []byte {
+	return append([]byte{BridgedSupplyKey}, tokenContract.Bytes()...)
+}
+
+// MakeEthereumHeaderVoteKey returns the following key format
+// prefix     chain-id                 cosmos-validator
+// [0x36][0 0 0 0 0 0 0 1][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeEthereumHeaderVoteKey(chainID uint64, validator sdk.ValAddress) []byte {
+	return bytes.Join([][]byte{{EthereumHeaderVoteKey}, sdk.Uint64ToBigEndian(chainID), validator.Bytes()}, []byte{})
+}
+
+// MakeOrchestratorFreezeKey returns the following key format
+// prefix    cosmos-validator
+// [0x38][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeOrchestratorFreezeKey(validator sdk.ValAddress) []byte {
+	return append([]byte{OrchestratorFreezeKey}, validator.Bytes()...)
+}
+
+// MakePastOutgoingTxNonceKey returns the following key format
+// prefix outgoing-tx-prefix invalidation-scope
+// [0x39][0x3][0x8fc1...e4a2]
+func MakePastOutgoingTxNonceKey(scope []byte) []byte {
+	return append([]byte{PastOutgoingTxNonceKey}, scope...)
+}
+
+// MakeOutgoingTxHeightKey returns the following key format
+// prefix    cosmos-height       store-index
+// [0x3a][0 0 0 0 0 0 0 1][0x02...]
+func MakeOutgoingTxHeightKey(height uint64, storeIndex []byte) []byte {
+	return bytes.Join([][]byte{{OutgoingTxHeightKey}, sdk.Uint64ToBigEndian(height), storeIndex}, []byte{})
+}
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"strings"
 
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,10 +19,27 @@ var (
 	_ sdk.Msg = &MsgSubmitEthereumEvent{}
 	_ sdk.Msg = &MsgSubmitEthereumTxConfirmation{}
 	_ sdk.Msg = &MsgEthereumHeightVote{}
+	_ sdk.Msg = &MsgUpdateDelegateKeys{}
+	_ sdk.Msg = &MsgRevokeOrchestratorKey{}
+	_ sdk.Msg = &MsgSubmitAggregatedEthereumEvent{}
+	_ sdk.Msg = &MsgERC20DeployedConfirm{}
+	_ sdk.Msg = &MsgEthereumAnomalyReport{}
+	_ sdk.Msg = &MsgRegisterOrchestratorQueryIdentity{}
+	_ sdk.Msg = &MsgVetoDelayedSendToEthereum{}
+	_ sdk.Msg = &MsgExecuteAtomic{}
+	_ sdk.Msg = &MsgGrantBridgeFeeAllowance{}
+	_ sdk.Msg = &MsgRevokeBridgeFeeAllowance{}
+	_ sdk.Msg = &MsgSubmitBadEthereumSignatureEvidence{}
+	_ sdk.Msg = &MsgOptOutOfBridge{}
+	_ sdk.Msg = &MsgEthereumHeaderVote{}
+	_ sdk.Msg = &MsgFreezeOrchestrator{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumEvent{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitAggregatedEthereumEvent{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumTxConfirmation{}
 	_ cdctypes.UnpackInterfacesMessage = &EthereumEventVoteRecord{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgExecuteAtomic{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitBadEthereumSignatureEvidence{}
 )
 
 // NewMsgDelegateKeys returns a reference to a new MsgDelegateKeys.
@@ -54,7 +73,7 @@ func (msg *MsgDelegateKeys) ValidateBasic() (err error) {
 		return ErrEmptyEthSig
 	}
 
-	return nil
+	return ValidateSignatureScheme(msg.SignatureScheme)
 }
 
 // GetSignBytes encodes the message for signing
@@ -71,6 +90,19 @@ func (msg *MsgDelegateKeys) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(acc)}
 }
 
+// NewMsgSubmitEthereumEvent returns a reference to a new MsgSubmitEthereumEvent.
+func NewMsgSubmitEthereumEvent(event EthereumEvent, signer sdk.AccAddress) (*MsgSubmitEthereumEvent, error) {
+	eventAny, err := PackEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitEthereumEvent{
+		Event:  eventAny,
+		Signer: signer.String(),
+	}, nil
+}
+
 // Route should return the name of the module
 func (msg *MsgSubmitEthereumEvent) Route() string { return RouterKey }
 
@@ -107,6 +139,19 @@ func (msg *MsgSubmitEthereumEvent) UnpackInterfaces(unpacker cdctypes.AnyUnpacke
 	return unpacker.UnpackAny(msg.Event, &event)
 }
 
+// NewMsgSubmitEthereumTxConfirmation returns a reference to a new MsgSubmitEthereumTxConfirmation.
+func NewMsgSubmitEthereumTxConfirmation(confirmation EthereumTxConfirmation, signer sdk.AccAddress) (*MsgSubmitEthereumTxConfirmation, error) {
+	confirmationAny, err := PackConfirmation(confirmation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitEthereumTxConfirmation{
+		Confirmation: confirmationAny,
+		Signer:       signer.String(),
+	}, nil
+}
+
 // Route should return the name of the module
 func (msg *MsgSubmitEthereumTxConfirmation) Route() string { return RouterKey }
 
@@ -171,22 +216,25 @@ func (msg MsgSendToEthereum) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
 	}
 
-	// fee and send must be of the same denom
-	// this check is VERY IMPORTANT
-	if msg.Amount.Denom != msg.BridgeFee.Denom {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins,
-			fmt.Sprintf("fee and amount must be the same type %s != %s", msg.Amount.Denom, msg.BridgeFee.Denom))
-	}
-
 	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount")
 	}
+	// the fee is either of the denom of the amount or of the bridge fee denom,
+	// which is a param and checked when the send is created
 	if !msg.BridgeFee.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "fee")
 	}
 	if !common.IsHexAddress(msg.EthereumRecipient) {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "ethereum address")
 	}
+	if msg.FeeGranter != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.FeeGranter); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.FeeGranter)
+		}
+		if msg.FeeGranter == msg.Sender {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "fee granter is the sender")
+		}
+	}
 
 	return nil
 }
@@ -211,6 +259,7 @@ func NewMsgRequestBatchTx(denom string, signer sdk.AccAddress) *MsgRequestBatchT
 	return &MsgRequestBatchTx{
 		Denom:  denom,
 		Signer: signer.String(),
+		MinFee: sdk.ZeroInt(),
 	}
 }
 
@@ -228,6 +277,9 @@ func (msg MsgRequestBatchTx) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
 	}
+	if !msg.MinFee.IsNil() && msg.MinFee.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalid, "min fee cannot be negative")
+	}
 	return nil
 }
 
@@ -327,3 +379,695 @@ func (msg MsgEthereumHeightVote) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{acc}
 }
+
+// NewMsgUpdateDelegateKeys returns a reference to a new MsgUpdateDelegateKeys.
+func NewMsgUpdateDelegateKeys(val sdk.ValAddress, orchAddr sdk.AccAddress, ethAddr string, ethSig []byte) *MsgUpdateDelegateKeys {
+	return &MsgUpdateDelegateKeys{
+		ValidatorAddress:    val.String(),
+		OrchestratorAddress: orchAddr.String(),
+		EthereumAddress:     ethAddr,
+		EthSignature:        ethSig,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgUpdateDelegateKeys) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgUpdateDelegateKeys) Type() string { return "update_delegate_keys" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgUpdateDelegateKeys) ValidateBasic() (err error) {
+	if _, err = sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.ValidatorAddress)
+	}
+	if _, err = sdk.AccAddressFromBech32(msg.OrchestratorAddress); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.OrchestratorAddress)
+	}
+	if !common.IsHexAddress(msg.EthereumAddress) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "ethereum address")
+	}
+	if len(msg.EthSignature) == 0 {
+		return ErrEmptyEthSig
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgUpdateDelegateKeys) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgUpdateDelegateKeys) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sdk.AccAddress(acc)}
+}
+
+// NewMsgRevokeOrchestratorKey returns a reference to a new MsgRevokeOrchestratorKey.
+func NewMsgRevokeOrchestratorKey(val sdk.ValAddress) *MsgRevokeOrchestratorKey {
+	return &MsgRevokeOrchestratorKey{ValidatorAddress: val.String()}
+}
+
+// Route should return the name of the module
+func (msg *MsgRevokeOrchestratorKey) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgRevokeOrchestratorKey) Type() string { return "revoke_orchestrator_key" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgRevokeOrchestratorKey) ValidateBasic() (err error) {
+	if _, err = sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.ValidatorAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRevokeOrchestratorKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgRevokeOrchestratorKey) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sdk.AccAddress(acc)}
+}
+
+// NewMsgSubmitAggregatedEthereumEvent returns a reference to a new MsgSubmitAggregatedEthereumEvent.
+func NewMsgSubmitAggregatedEthereumEvent(event EthereumEvent, signer sdk.AccAddress, cosignatures []EthereumEventCosignature) (*MsgSubmitAggregatedEthereumEvent, error) {
+	eventAny, err := PackEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitAggregatedEthereumEvent{
+		Event:        eventAny,
+		Signer:       signer.String(),
+		Cosignatures: cosignatures,
+	}, nil
+}
+
+// Route should return the name of the module
+func (msg *MsgSubmitAggregatedEthereumEvent) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgSubmitAggregatedEthereumEvent) Type() string { return "submit_aggregated_ethereum_event" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgSubmitAggregatedEthereumEvent) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+	if len(msg.Cosignatures) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "no cosignatures")
+	}
+
+	seen := make(map[string]bool, len(msg.Cosignatures))
+	for _, cosig := range msg.Cosignatures {
+		if _, err = sdk.ValAddressFromBech32(cosig.ValidatorAddress); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, cosig.ValidatorAddress)
+		}
+		if seen[cosig.ValidatorAddress] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate cosignature by %s", cosig.ValidatorAddress)
+		}
+		if len(cosig.Signature) == 0 {
+			return ErrEmptyEthSig
+		}
+		seen[cosig.ValidatorAddress] = true
+	}
+
+	_, err = UnpackEvent(msg.Event)
+	return err
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSubmitAggregatedEthereumEvent) GetSignBytes() []byte {
+	panic(fmt.Errorf("deprecated"))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgSubmitAggregatedEthereumEvent) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+func (msg *MsgSubmitAggregatedEthereumEvent) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var event EthereumEvent
+	return unpacker.UnpackAny(msg.Event, &event)
+}
+
+// NewMsgERC20DeployedConfirm returns a new MsgERC20DeployedConfirm
+func NewMsgERC20DeployedConfirm(denom string, tokenContract common.Address, signer sdk.AccAddress) *MsgERC20DeployedConfirm {
+	return &MsgERC20DeployedConfirm{
+		CosmosDenom:   denom,
+		TokenContract: tokenContract.Hex(),
+		Signer:        signer.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgERC20DeployedConfirm) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgERC20DeployedConfirm) Type() string { return "erc20_deployed_confirm" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgERC20DeployedConfirm) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+	if err := sdk.ValidateDenom(msg.CosmosDenom); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	if !common.IsHexAddress(msg.TokenContract) {
+		return sdkerrors.Wrap(ErrInvalid, "token contract address")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgERC20DeployedConfirm) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgERC20DeployedConfirm) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgEthereumAnomalyReport returns a new MsgEthereumAnomalyReport
+func NewMsgEthereumAnomalyReport(tokenContract common.Address, anomaly EthereumAnomaly, signer sdk.AccAddress) *MsgEthereumAnomalyReport {
+	return &MsgEthereumAnomalyReport{
+		TokenContract: tokenContract.Hex(),
+		Anomaly:       anomaly,
+		Signer:        signer.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgEthereumAnomalyReport) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgEthereumAnomalyReport) Type() string { return "ethereum_anomaly_report" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgEthereumAnomalyReport) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+	if !common.IsHexAddress(msg.TokenContract) {
+		return sdkerrors.Wrap(ErrInvalid, "token contract address")
+	}
+	if err := msg.Anomaly.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgEthereumAnomalyReport) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgEthereumAnomalyReport) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgRegisterOrchestratorQueryIdentity returns a new MsgRegisterOrchestratorQueryIdentity
+// for the hash of the API key
+func NewMsgRegisterOrchestratorQueryIdentity(apiKey string, signer sdk.AccAddress) *MsgRegisterOrchestratorQueryIdentity {
+	return &MsgRegisterOrchestratorQueryIdentity{
+		KeyHash: HashOrchestratorQueryKey(apiKey),
+		Signer:  signer.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRegisterOrchestratorQueryIdentity) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRegisterOrchestratorQueryIdentity) Type() string {
+	return "register_orchestrator_query_identity"
+}
+
+// ValidateBasic performs stateless checks
+func (msg MsgRegisterOrchestratorQueryIdentity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+	if len(msg.KeyHash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalid, "key hash must be %d bytes, got %d", sha256.Size, len(msg.KeyHash))
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRegisterOrchestratorQueryIdentity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterOrchestratorQueryIdentity) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// MaxAtomicMsgs is the maximum number of messages a MsgExecuteAtomic executes
+const MaxAtomicMsgs = 16
+
+// NewMsgExecuteAtomic returns a new MsgExecuteAtomic executing the gravity
+// messages in order
+func NewMsgExecuteAtomic(msgs []sdk.Msg, signer sdk.AccAddress) (*MsgExecuteAtomic, error) {
+	anys := make([]*cdctypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := cdctypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrPackAny, err.Error())
+		}
+		anys[i] = any
+	}
+
+	return &MsgExecuteAtomic{
+		Msgs:   anys,
+		Signer: signer.String(),
+	}, nil
+}
+
+// GetMsgs returns the unpacked messages to execute
+func (msg *MsgExecuteAtomic) GetMsgs() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, any := range msg.Msgs {
+		m, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnpackAny, "cannot unpack Any into sdk.Msg %T", any)
+		}
+		msgs[i] = m
+	}
+	return msgs, nil
+}
+
+// Route should return the name of the module
+func (msg *MsgExecuteAtomic) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgExecuteAtomic) Type() string { return "execute_atomic" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgExecuteAtomic) ValidateBasic() error {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+	if len(msg.Msgs) == 0 || len(msg.Msgs) > MaxAtomicMsgs {
+		return sdkerrors.Wrapf(ErrInvalid, "must execute between 1 and %d messages, got %d", MaxAtomicMsgs, len(msg.Msgs))
+	}
+
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return err
+	}
+	for i, m := range msgs {
+		// only gravity messages are executed, and not nested
+		if _, ok := m.(*MsgExecuteAtomic); ok || !strings.HasPrefix(sdk.MsgTypeURL(m), "/gravity.v1.") {
+			return sdkerrors.Wrapf(ErrInvalid, "message %d: cannot execute %s atomically", i, sdk.MsgTypeURL(m))
+		}
+		// validated first, as GetSigners panics on a malformed address
+		if err := m.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+		// the signature of the signer authorizes the messages, so they must be its own
+		if signers := m.GetSigners(); len(signers) != 1 || !signers[0].Equals(signer) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message %d: not signed by %s", i, msg.Signer)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgExecuteAtomic) GetSignBytes() []byte {
+	panic(fmt.Errorf("deprecated"))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgExecuteAtomic) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+func (msg *MsgExecuteAtomic) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, any := range msg.Msgs {
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(any, &m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewMsgVetoDelayedSendToEthereum returns a new MsgVetoDelayedSendToEthereum
+func NewMsgVetoDelayedSendToEthereum(ids []uint64, signer sdk.AccAddress) *MsgVetoDelayedSendToEthereum {
+	return &MsgVetoDelayedSendToEthereum{
+		Ids:    ids,
+		Signer: signer.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgVetoDelayedSendToEthereum) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgVetoDelayedSendToEthereum) Type() string { return "veto_delayed_send_to_ethereum" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgVetoDelayedSendToEthereum) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+	return validateDelayedSendToEthereumIDs(msg.Ids)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgVetoDelayedSendToEthereum) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgVetoDelayedSendToEthereum) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// validateDelayedSendToEthereumIDs checks that a veto names at least one
+// delayed send to ethereum, each only once
+func validateDelayedSendToEthereumIDs(ids []uint64) error {
+	if len(ids) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "no delayed send to ethereum ids")
+	}
+
+	seen := make(map[uint64]bool, len(ids))
+	for _, id := range ids {
+		if id == 0 {
+			return sdkerrors.Wrap(ErrInvalid, "delayed send to ethereum id cannot be zero")
+		}
+		if seen[id] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate delayed send to ethereum id %d", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// NewMsgGrantBridgeFeeAllowance returns a new MsgGrantBridgeFeeAllowance
+func NewMsgGrantBridgeFeeAllowance(granter, grantee sdk.AccAddress, spendLimit sdk.Coins) *MsgGrantBridgeFeeAllowance {
+	return &MsgGrantBridgeFeeAllowance{
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		SpendLimit: spendLimit,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgGrantBridgeFeeAllowance) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgGrantBridgeFeeAllowance) Type() string { return "grant_bridge_fee_allowance" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgGrantBridgeFeeAllowance) ValidateBasic() error {
+	if err := validateBridgeFeeAllowanceParties(msg.Granter, msg.Grantee); err != nil {
+		return err
+	}
+	if !msg.SpendLimit.IsValid() || msg.SpendLimit.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgGrantBridgeFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgGrantBridgeFeeAllowance) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgRevokeBridgeFeeAllowance returns a new MsgRevokeBridgeFeeAllowance
+func NewMsgRevokeBridgeFeeAllowance(granter, grantee sdk.AccAddress) *MsgRevokeBridgeFeeAllowance {
+	return &MsgRevokeBridgeFeeAllowance{
+		Granter: granter.String(),
+		Grantee: grantee.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRevokeBridgeFeeAllowance) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRevokeBridgeFeeAllowance) Type() string { return "revoke_bridge_fee_allowance" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgRevokeBridgeFeeAllowance) ValidateBasic() error {
+	return validateBridgeFeeAllowanceParties(msg.Granter, msg.Grantee)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRevokeBridgeFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRevokeBridgeFeeAllowance) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// validateBridgeFeeAllowanceParties checks the granter and grantee of a bridge
+// fee allowance are distinct accounts
+func validateBridgeFeeAllowanceParties(granter, grantee string) error {
+	if _, err := sdk.AccAddressFromBech32(granter); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, granter)
+	}
+	if _, err := sdk.AccAddressFromBech32(grantee); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, grantee)
+	}
+	if granter == grantee {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "granter and grantee are the same account")
+	}
+	return nil
+}
+
+// NewMsgSubmitBadEthereumSignatureEvidence returns a new MsgSubmitBadEthereumSignatureEvidence
+func NewMsgSubmitBadEthereumSignatureEvidence(subject OutgoingTx, signature []byte, ethSigner common.Address, signer sdk.AccAddress) (*MsgSubmitBadEthereumSignatureEvidence, error) {
+	any, err := PackOutgoingTx(subject)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgSubmitBadEthereumSignatureEvidence{
+		Subject:        any,
+		Signature:      signature,
+		EthereumSigner: ethSigner.Hex(),
+		Signer:         signer.String(),
+	}, nil
+}
+
+// Route should return the name of the module
+func (msg *MsgSubmitBadEthereumSignatureEvidence) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgSubmitBadEthereumSignatureEvidence) Type() string {
+	return "submit_bad_ethereum_signature_evidence"
+}
+
+// ValidateBasic performs stateless checks
+func (msg *MsgSubmitBadEthereumSignatureEvidence) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+	if err := ValidateEthAddress(msg.EthereumSigner); err != nil {
+		return sdkerrors.Wrap(err, "ethereum signer")
+	}
+	if len(msg.Signature) == 0 {
+		return ErrEmptyEthSig
+	}
+	_, err := UnpackOutgoingTx(msg.Subject)
+	return err
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSubmitBadEthereumSignatureEvidence) GetSignBytes() []byte {
+	panic(fmt.Errorf("deprecated"))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgSubmitBadEthereumSignatureEvidence) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+func (msg *MsgSubmitBadEthereumSignatureEvidence) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var subject OutgoingTx
+	return unpacker.UnpackAny(msg.Subject, &subject)
+}
+
+// NewMsgOptOutOfBridge returns a reference to a new MsgOptOutOfBridge.
+func NewMsgOptOutOfBridge(val sdk.ValAddress) *MsgOptOutOfBridge {
+	return &MsgOptOutOfBridge{ValidatorAddress: val.String()}
+}
+
+// Route should return the name of the module
+func (msg *MsgOptOutOfBridge) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgOptOutOfBridge) Type() string { return "opt_out_of_bridge" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgOptOutOfBridge) ValidateBasic() (err error) {
+	if _, err = sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.ValidatorAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgOptOutOfBridge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgOptOutOfBridge) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sdk.AccAddress(acc)}
+}
+
+// NewMsgEthereumHeaderVote returns a new MsgEthereumHeaderVote
+func NewMsgEthereumHeaderVote(header EthereumHeader, signer sdk.AccAddress) *MsgEthereumHeaderVote {
+	return &MsgEthereumHeaderVote{
+		Header: header,
+		Signer: signer.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgEthereumHeaderVote) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgEthereumHeaderVote) Type() string { return "ethereum_header_vote" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgEthereumHeaderVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+	return msg.Header.ValidateBasic()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgEthereumHeaderVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgEthereumHeaderVote) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgFreezeOrchestrator returns a reference to a new MsgFreezeOrchestrator.
+func NewMsgFreezeOrchestrator(val sdk.ValAddress) *MsgFreezeOrchestrator {
+	return &MsgFreezeOrchestrator{ValidatorAddress: val.String()}
+}
+
+// Route should return the name of the module
+func (msg *MsgFreezeOrchestrator) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgFreezeOrchestrator) Type() string { return "freeze_orchestrator" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgFreezeOrchestrator) ValidateBasic() (err error) {
+	if _, err = sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.ValidatorAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgFreezeOrchestrator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgFreezeOrchestrator) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sdk.AccAddress(acc)}
+}
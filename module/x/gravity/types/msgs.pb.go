@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
 	types1 "github.com/cosmos/cosmos-sdk/codec/types"
@@ -42,6 +43,12 @@ type MsgSendToEthereum struct {
 	EthereumRecipient string     `protobuf:"bytes,2,opt,name=ethereum_recipient,json=ethereumRecipient,proto3" json:"ethereum_recipient,omitempty"`
 	Amount            types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 	BridgeFee         types.Coin `protobuf:"bytes,4,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee"`
+	// fee_granter optionally pays the bridge fee out of the bridge fee
+	// allowance it granted the sender
+	FeeGranter string `protobuf:"bytes,5,opt,name=fee_granter,json=feeGranter,proto3" json:"fee_granter,omitempty"`
+	// execute_after_height optionally schedules the send, it is escrowed right
+	// away but stays out of batches until after the cosmos block height
+	ExecuteAfterHeight uint64 `protobuf:"varint,6,opt,name=execute_after_height,json=executeAfterHeight,proto3" json:"execute_after_height,omitempty"`
 }
 
 func (m *MsgSendToEthereum) Reset()         { *m = MsgSendToEthereum{} }
@@ -105,6 +112,20 @@ func (m *MsgSendToEthereum) GetBridgeFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSendToEthereum) GetFeeGranter() string {
+	if m != nil {
+		return m.FeeGranter
+	}
+	return ""
+}
+
+func (m *MsgSendToEthereum) GetExecuteAfterHeight() uint64 {
+	if m != nil {
+		return m.ExecuteAfterHeight
+	}
+	return 0
+}
+
 // MsgSendToEthereumResponse returns the SendToEthereum transaction ID which
 // will be included in the batch tx.
 type MsgSendToEthereumResponse struct {
@@ -248,6 +269,12 @@ var xxx_messageInfo_MsgCancelSendToEthereumResponse proto.InternalMessageInfo
 type MsgRequestBatchTx struct {
 	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// max_elements caps the number of transactions in the batch, the
+	// BatchMaxElement param is used when it is zero
+	MaxElements uint64 `protobuf:"varint,3,opt,name=max_elements,json=maxElements,proto3" json:"max_elements,omitempty"`
+	// min_fee is the total fee, in units of the ERC20 token, below which no
+	// batch is created
+	MinFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_fee,json=minFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee"`
 }
 
 func (m *MsgRequestBatchTx) Reset()         { *m = MsgRequestBatchTx{} }
@@ -297,6 +324,13 @@ func (m *MsgRequestBatchTx) GetSigner() string {
 	return ""
 }
 
+func (m *MsgRequestBatchTx) GetMaxElements() uint64 {
+	if m != nil {
+		return m.MaxElements
+	}
+	return 0
+}
+
 type MsgRequestBatchTxResponse struct {
 }
 
@@ -377,10 +411,11 @@ var xxx_messageInfo_MsgSubmitEthereumTxConfirmation proto.InternalMessageInfo
 // ContractCallTxConfirmation is a signature on behalf of a validator for a
 // ContractCallTx.
 type ContractCallTxConfirmation struct {
-	InvalidationScope []byte `protobuf:"bytes,1,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
-	InvalidationNonce uint64 `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
-	EthereumSigner    string `protobuf:"bytes,3,opt,name=ethereum_signer,json=ethereumSigner,proto3" json:"ethereum_signer,omitempty"`
-	Signature         []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	InvalidationScope []byte          `protobuf:"bytes,1,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
+	InvalidationNonce uint64          `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	EthereumSigner    string          `protobuf:"bytes,3,opt,name=ethereum_signer,json=ethereumSigner,proto3" json:"ethereum_signer,omitempty"`
+	Signature         []byte          `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	Scheme            SignatureScheme `protobuf:"varint,5,opt,name=scheme,proto3,enum=gravity.v1.SignatureScheme" json:"scheme,omitempty"`
 }
 
 func (m *ContractCallTxConfirmation) Reset()         { *m = ContractCallTxConfirmation{} }
@@ -444,12 +479,20 @@ func (m *ContractCallTxConfirmation) GetSignature() []byte {
 	return nil
 }
 
+func (m *ContractCallTxConfirmation) GetScheme() SignatureScheme {
+	if m != nil {
+		return m.Scheme
+	}
+	return SIGNATURE_SCHEME_ECDSA
+}
+
 // BatchTxConfirmation is a signature on behalf of a validator for a BatchTx.
 type BatchTxConfirmation struct {
-	TokenContract  string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
-	BatchNonce     uint64 `protobuf:"varint,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
-	EthereumSigner string `protobuf:"bytes,3,opt,name=ethereum_signer,json=ethereumSigner,proto3" json:"ethereum_signer,omitempty"`
-	Signature      []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	TokenContract  string          `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	BatchNonce     uint64          `protobuf:"varint,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	EthereumSigner string          `protobuf:"bytes,3,opt,name=ethereum_signer,json=ethereumSigner,proto3" json:"ethereum_signer,omitempty"`
+	Signature      []byte          `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	Scheme         SignatureScheme `protobuf:"varint,5,opt,name=scheme,proto3,enum=gravity.v1.SignatureScheme" json:"scheme,omitempty"`
 }
 
 func (m *BatchTxConfirmation) Reset()         { *m = BatchTxConfirmation{} }
@@ -513,12 +556,20 @@ func (m *BatchTxConfirmation) GetSignature() []byte {
 	return nil
 }
 
+func (m *BatchTxConfirmation) GetScheme() SignatureScheme {
+	if m != nil {
+		return m.Scheme
+	}
+	return SIGNATURE_SCHEME_ECDSA
+}
+
 // SignerSetTxConfirmation is a signature on behalf of a validator for a
 // SignerSetTx
 type SignerSetTxConfirmation struct {
-	SignerSetNonce uint64 `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
-	EthereumSigner string `protobuf:"bytes,2,opt,name=ethereum_signer,json=ethereumSigner,proto3" json:"ethereum_signer,omitempty"`
-	Signature      []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	SignerSetNonce uint64          `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
+	EthereumSigner string          `protobuf:"bytes,2,opt,name=ethereum_signer,json=ethereumSigner,proto3" json:"ethereum_signer,omitempty"`
+	Signature      []byte          `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Scheme         SignatureScheme `protobuf:"varint,4,opt,name=scheme,proto3,enum=gravity.v1.SignatureScheme" json:"scheme,omitempty"`
 }
 
 func (m *SignerSetTxConfirmation) Reset()         { *m = SignerSetTxConfirmation{} }
@@ -575,6 +626,13 @@ func (m *SignerSetTxConfirmation) GetSignature() []byte {
 	return nil
 }
 
+func (m *SignerSetTxConfirmation) GetScheme() SignatureScheme {
+	if m != nil {
+		return m.Scheme
+	}
+	return SIGNATURE_SCHEME_ECDSA
+}
+
 type MsgSubmitEthereumTxConfirmationResponse struct {
 }
 
@@ -688,6 +746,141 @@ func (m *MsgSubmitEthereumEventResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitEthereumEventResponse proto.InternalMessageInfo
 
+// MsgSubmitAggregatedEthereumEvent submits a vote for an ethereum event on
+// behalf of the signer's validator and of every co-signing validator at once,
+// so that the event can be observed within a single tx.
+type MsgSubmitAggregatedEthereumEvent struct {
+	Event        *types1.Any                `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Signer       string                     `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	Cosignatures []EthereumEventCosignature `protobuf:"bytes,3,rep,name=cosignatures,proto3" json:"cosignatures"`
+}
+
+func (m *MsgSubmitAggregatedEthereumEvent) Reset()         { *m = MsgSubmitAggregatedEthereumEvent{} }
+func (m *MsgSubmitAggregatedEthereumEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAggregatedEthereumEvent) ProtoMessage()    {}
+func (*MsgSubmitAggregatedEthereumEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{13}
+}
+func (m *MsgSubmitAggregatedEthereumEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitAggregatedEthereumEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAggregatedEthereumEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitAggregatedEthereumEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAggregatedEthereumEvent.Merge(m, src)
+}
+func (m *MsgSubmitAggregatedEthereumEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitAggregatedEthereumEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAggregatedEthereumEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitAggregatedEthereumEvent proto.InternalMessageInfo
+
+// EthereumEventCosignature is a validator's vote for an ethereum event,
+// signed by its registered ethereum key over the hash returned by
+// EthereumEventCosignatureHash.
+type EthereumEventCosignature struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Signature        []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *EthereumEventCosignature) Reset()         { *m = EthereumEventCosignature{} }
+func (m *EthereumEventCosignature) String() string { return proto.CompactTextString(m) }
+func (*EthereumEventCosignature) ProtoMessage()    {}
+func (*EthereumEventCosignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{14}
+}
+func (m *EthereumEventCosignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumEventCosignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumEventCosignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthereumEventCosignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumEventCosignature.Merge(m, src)
+}
+func (m *EthereumEventCosignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumEventCosignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumEventCosignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumEventCosignature proto.InternalMessageInfo
+
+func (m *EthereumEventCosignature) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EthereumEventCosignature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type MsgSubmitAggregatedEthereumEventResponse struct {
+}
+
+func (m *MsgSubmitAggregatedEthereumEventResponse) Reset() {
+	*m = MsgSubmitAggregatedEthereumEventResponse{}
+}
+func (m *MsgSubmitAggregatedEthereumEventResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAggregatedEthereumEventResponse) ProtoMessage()    {}
+func (*MsgSubmitAggregatedEthereumEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{15}
+}
+func (m *MsgSubmitAggregatedEthereumEventResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitAggregatedEthereumEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAggregatedEthereumEventResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitAggregatedEthereumEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAggregatedEthereumEventResponse.Merge(m, src)
+}
+func (m *MsgSubmitAggregatedEthereumEventResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitAggregatedEthereumEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAggregatedEthereumEventResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitAggregatedEthereumEventResponse proto.InternalMessageInfo
+
 // MsgDelegateKey allows validators to delegate their voting responsibilities
 // to a given orchestrator address. This key is then used as an optional
 // authentication method for attesting events from Ethereum.
@@ -696,13 +889,16 @@ type MsgDelegateKeys struct {
 	OrchestratorAddress string `protobuf:"bytes,2,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
 	EthereumAddress     string `protobuf:"bytes,3,opt,name=ethereum_address,json=ethereumAddress,proto3" json:"ethereum_address,omitempty"`
 	EthSignature        []byte `protobuf:"bytes,4,opt,name=eth_signature,json=ethSignature,proto3" json:"eth_signature,omitempty"`
+	// the scheme of the signatures of the validator over outgoing txs, which
+	// must be supported by the chain
+	SignatureScheme SignatureScheme `protobuf:"varint,5,opt,name=signature_scheme,json=signatureScheme,proto3,enum=gravity.v1.SignatureScheme" json:"signature_scheme,omitempty"`
 }
 
 func (m *MsgDelegateKeys) Reset()         { *m = MsgDelegateKeys{} }
 func (m *MsgDelegateKeys) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateKeys) ProtoMessage()    {}
 func (*MsgDelegateKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{13}
+	return fileDescriptor_2f8523f2f6feb451, []int{16}
 }
 func (m *MsgDelegateKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -759,6 +955,13 @@ func (m *MsgDelegateKeys) GetEthSignature() []byte {
 	return nil
 }
 
+func (m *MsgDelegateKeys) GetSignatureScheme() SignatureScheme {
+	if m != nil {
+		return m.SignatureScheme
+	}
+	return SIGNATURE_SCHEME_ECDSA
+}
+
 type MsgDelegateKeysResponse struct {
 }
 
@@ -766,7 +969,7 @@ func (m *MsgDelegateKeysResponse) Reset()         { *m = MsgDelegateKeysResponse
 func (m *MsgDelegateKeysResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateKeysResponse) ProtoMessage()    {}
 func (*MsgDelegateKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{14}
+	return fileDescriptor_2f8523f2f6feb451, []int{17}
 }
 func (m *MsgDelegateKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -807,7 +1010,7 @@ func (m *DelegateKeysSignMsg) Reset()         { *m = DelegateKeysSignMsg{} }
 func (m *DelegateKeysSignMsg) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysSignMsg) ProtoMessage()    {}
 func (*DelegateKeysSignMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{15}
+	return fileDescriptor_2f8523f2f6feb451, []int{18}
 }
 func (m *DelegateKeysSignMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -850,25 +1053,31 @@ func (m *DelegateKeysSignMsg) GetNonce() uint64 {
 	return 0
 }
 
-// Periodic update of latest observed Ethereum and Cosmos heights from the
-// orchestrator
-type MsgEthereumHeightVote struct {
-	EthereumHeight uint64 `protobuf:"varint,1,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
-	Signer         string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+// MsgUpdateDelegateKeys rotates the delegate keys of a validator that already
+// has them set. A new orchestrator address takes effect immediately. A new
+// ethereum address is only included in the next signer set and takes effect
+// once that signer set is observed on Ethereum, so signatures for outgoing txs
+// in flight stay valid. The eth_signature is over a DelegateKeysSignMsg by the
+// new ethereum address.
+type MsgUpdateDelegateKeys struct {
+	ValidatorAddress    string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	OrchestratorAddress string `protobuf:"bytes,2,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
+	EthereumAddress     string `protobuf:"bytes,3,opt,name=ethereum_address,json=ethereumAddress,proto3" json:"ethereum_address,omitempty"`
+	EthSignature        []byte `protobuf:"bytes,4,opt,name=eth_signature,json=ethSignature,proto3" json:"eth_signature,omitempty"`
 }
 
-func (m *MsgEthereumHeightVote) Reset()         { *m = MsgEthereumHeightVote{} }
-func (m *MsgEthereumHeightVote) String() string { return proto.CompactTextString(m) }
-func (*MsgEthereumHeightVote) ProtoMessage()    {}
-func (*MsgEthereumHeightVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{16}
+func (m *MsgUpdateDelegateKeys) Reset()         { *m = MsgUpdateDelegateKeys{} }
+func (m *MsgUpdateDelegateKeys) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDelegateKeys) ProtoMessage()    {}
+func (*MsgUpdateDelegateKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{19}
 }
-func (m *MsgEthereumHeightVote) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateDelegateKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgEthereumHeightVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateDelegateKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgEthereumHeightVote.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateDelegateKeys.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -878,47 +1087,61 @@ func (m *MsgEthereumHeightVote) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgEthereumHeightVote) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgEthereumHeightVote.Merge(m, src)
+func (m *MsgUpdateDelegateKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDelegateKeys.Merge(m, src)
 }
-func (m *MsgEthereumHeightVote) XXX_Size() int {
+func (m *MsgUpdateDelegateKeys) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgEthereumHeightVote) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgEthereumHeightVote.DiscardUnknown(m)
+func (m *MsgUpdateDelegateKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDelegateKeys.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgEthereumHeightVote proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateDelegateKeys proto.InternalMessageInfo
 
-func (m *MsgEthereumHeightVote) GetEthereumHeight() uint64 {
+func (m *MsgUpdateDelegateKeys) GetValidatorAddress() string {
 	if m != nil {
-		return m.EthereumHeight
+		return m.ValidatorAddress
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgEthereumHeightVote) GetSigner() string {
+func (m *MsgUpdateDelegateKeys) GetOrchestratorAddress() string {
 	if m != nil {
-		return m.Signer
+		return m.OrchestratorAddress
 	}
 	return ""
 }
 
-type MsgEthereumHeightVoteResponse struct {
+func (m *MsgUpdateDelegateKeys) GetEthereumAddress() string {
+	if m != nil {
+		return m.EthereumAddress
+	}
+	return ""
 }
 
-func (m *MsgEthereumHeightVoteResponse) Reset()         { *m = MsgEthereumHeightVoteResponse{} }
-func (m *MsgEthereumHeightVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgEthereumHeightVoteResponse) ProtoMessage()    {}
-func (*MsgEthereumHeightVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{17}
+func (m *MsgUpdateDelegateKeys) GetEthSignature() []byte {
+	if m != nil {
+		return m.EthSignature
+	}
+	return nil
 }
-func (m *MsgEthereumHeightVoteResponse) XXX_Unmarshal(b []byte) error {
+
+type MsgUpdateDelegateKeysResponse struct {
+}
+
+func (m *MsgUpdateDelegateKeysResponse) Reset()         { *m = MsgUpdateDelegateKeysResponse{} }
+func (m *MsgUpdateDelegateKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDelegateKeysResponse) ProtoMessage()    {}
+func (*MsgUpdateDelegateKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{20}
+}
+func (m *MsgUpdateDelegateKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgEthereumHeightVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateDelegateKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgEthereumHeightVoteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateDelegateKeysResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -928,42 +1151,37 @@ func (m *MsgEthereumHeightVoteResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgEthereumHeightVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgEthereumHeightVoteResponse.Merge(m, src)
+func (m *MsgUpdateDelegateKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDelegateKeysResponse.Merge(m, src)
 }
-func (m *MsgEthereumHeightVoteResponse) XXX_Size() int {
+func (m *MsgUpdateDelegateKeysResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgEthereumHeightVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgEthereumHeightVoteResponse.DiscardUnknown(m)
+func (m *MsgUpdateDelegateKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDelegateKeysResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgEthereumHeightVoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateDelegateKeysResponse proto.InternalMessageInfo
 
-// SendToCosmosEvent is submitted when the SendToCosmosEvent is emitted by they
-// gravity contract. ERC20 representation coins are minted to the cosmosreceiver
-// address.
-type SendToCosmosEvent struct {
-	EventNonce     uint64                                 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
-	TokenContract  string                                 `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
-	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
-	EthereumSender string                                 `protobuf:"bytes,4,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
-	CosmosReceiver string                                 `protobuf:"bytes,5,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
-	EthereumHeight uint64                                 `protobuf:"varint,6,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+// MsgRevokeOrchestratorKey removes the orchestrator of a validator, e.g. when
+// the orchestrator key is compromised. The validator keeps its ethereum
+// address and can set a new orchestrator with MsgUpdateDelegateKeys.
+type MsgRevokeOrchestratorKey struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *SendToCosmosEvent) Reset()         { *m = SendToCosmosEvent{} }
-func (m *SendToCosmosEvent) String() string { return proto.CompactTextString(m) }
-func (*SendToCosmosEvent) ProtoMessage()    {}
-func (*SendToCosmosEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{18}
+func (m *MsgRevokeOrchestratorKey) Reset()         { *m = MsgRevokeOrchestratorKey{} }
+func (m *MsgRevokeOrchestratorKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeOrchestratorKey) ProtoMessage()    {}
+func (*MsgRevokeOrchestratorKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{21}
 }
-func (m *SendToCosmosEvent) XXX_Unmarshal(b []byte) error {
+func (m *MsgRevokeOrchestratorKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SendToCosmosEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRevokeOrchestratorKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SendToCosmosEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRevokeOrchestratorKey.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -973,74 +1191,40 @@ func (m *SendToCosmosEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *SendToCosmosEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SendToCosmosEvent.Merge(m, src)
+func (m *MsgRevokeOrchestratorKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeOrchestratorKey.Merge(m, src)
 }
-func (m *SendToCosmosEvent) XXX_Size() int {
+func (m *MsgRevokeOrchestratorKey) XXX_Size() int {
 	return m.Size()
 }
-func (m *SendToCosmosEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_SendToCosmosEvent.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_SendToCosmosEvent proto.InternalMessageInfo
-
-func (m *SendToCosmosEvent) GetEventNonce() uint64 {
-	if m != nil {
-		return m.EventNonce
-	}
-	return 0
-}
-
-func (m *SendToCosmosEvent) GetTokenContract() string {
-	if m != nil {
-		return m.TokenContract
-	}
-	return ""
+func (m *MsgRevokeOrchestratorKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeOrchestratorKey.DiscardUnknown(m)
 }
 
-func (m *SendToCosmosEvent) GetEthereumSender() string {
-	if m != nil {
-		return m.EthereumSender
-	}
-	return ""
-}
+var xxx_messageInfo_MsgRevokeOrchestratorKey proto.InternalMessageInfo
 
-func (m *SendToCosmosEvent) GetCosmosReceiver() string {
+func (m *MsgRevokeOrchestratorKey) GetValidatorAddress() string {
 	if m != nil {
-		return m.CosmosReceiver
+		return m.ValidatorAddress
 	}
 	return ""
 }
 
-func (m *SendToCosmosEvent) GetEthereumHeight() uint64 {
-	if m != nil {
-		return m.EthereumHeight
-	}
-	return 0
-}
-
-// BatchExecutedEvent claims that a batch of BatchTxExecutedal operations on the
-// bridge contract was executed successfully on ETH
-type BatchExecutedEvent struct {
-	TokenContract  string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
-	EventNonce     uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
-	EthereumHeight uint64 `protobuf:"varint,3,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
-	BatchNonce     uint64 `protobuf:"varint,4,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+type MsgRevokeOrchestratorKeyResponse struct {
 }
 
-func (m *BatchExecutedEvent) Reset()         { *m = BatchExecutedEvent{} }
-func (m *BatchExecutedEvent) String() string { return proto.CompactTextString(m) }
-func (*BatchExecutedEvent) ProtoMessage()    {}
-func (*BatchExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{19}
+func (m *MsgRevokeOrchestratorKeyResponse) Reset()         { *m = MsgRevokeOrchestratorKeyResponse{} }
+func (m *MsgRevokeOrchestratorKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeOrchestratorKeyResponse) ProtoMessage()    {}
+func (*MsgRevokeOrchestratorKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{22}
 }
-func (m *BatchExecutedEvent) XXX_Unmarshal(b []byte) error {
+func (m *MsgRevokeOrchestratorKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRevokeOrchestratorKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchExecutedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRevokeOrchestratorKeyResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1050,67 +1234,87 @@ func (m *BatchExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *BatchExecutedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchExecutedEvent.Merge(m, src)
+func (m *MsgRevokeOrchestratorKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeOrchestratorKeyResponse.Merge(m, src)
 }
-func (m *BatchExecutedEvent) XXX_Size() int {
+func (m *MsgRevokeOrchestratorKeyResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchExecutedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchExecutedEvent.DiscardUnknown(m)
+func (m *MsgRevokeOrchestratorKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeOrchestratorKeyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchExecutedEvent proto.InternalMessageInfo
+var xxx_messageInfo_MsgRevokeOrchestratorKeyResponse proto.InternalMessageInfo
 
-func (m *BatchExecutedEvent) GetTokenContract() string {
-	if m != nil {
-		return m.TokenContract
-	}
-	return ""
+// Periodic update of latest observed Ethereum and Cosmos heights from the
+// orchestrator
+type MsgEthereumHeightVote struct {
+	EthereumHeight uint64 `protobuf:"varint,1,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	Signer         string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *BatchExecutedEvent) GetEventNonce() uint64 {
-	if m != nil {
-		return m.EventNonce
+func (m *MsgEthereumHeightVote) Reset()         { *m = MsgEthereumHeightVote{} }
+func (m *MsgEthereumHeightVote) String() string { return proto.CompactTextString(m) }
+func (*MsgEthereumHeightVote) ProtoMessage()    {}
+func (*MsgEthereumHeightVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{23}
+}
+func (m *MsgEthereumHeightVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEthereumHeightVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEthereumHeightVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *MsgEthereumHeightVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEthereumHeightVote.Merge(m, src)
+}
+func (m *MsgEthereumHeightVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEthereumHeightVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEthereumHeightVote.DiscardUnknown(m)
 }
 
-func (m *BatchExecutedEvent) GetEthereumHeight() uint64 {
+var xxx_messageInfo_MsgEthereumHeightVote proto.InternalMessageInfo
+
+func (m *MsgEthereumHeightVote) GetEthereumHeight() uint64 {
 	if m != nil {
 		return m.EthereumHeight
 	}
 	return 0
 }
 
-func (m *BatchExecutedEvent) GetBatchNonce() uint64 {
+func (m *MsgEthereumHeightVote) GetSigner() string {
 	if m != nil {
-		return m.BatchNonce
+		return m.Signer
 	}
-	return 0
+	return ""
 }
 
-// NOTE: bytes.HexBytes is supposed to "help" with json encoding/decoding
-// investigate?
-type ContractCallExecutedEvent struct {
-	EventNonce        uint64                                               `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
-	InvalidationScope github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=invalidation_scope,json=invalidationScope,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"invalidation_scope,omitempty"`
-	InvalidationNonce uint64                                               `protobuf:"varint,3,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
-	EthereumHeight    uint64                                               `protobuf:"varint,4,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+type MsgEthereumHeightVoteResponse struct {
 }
 
-func (m *ContractCallExecutedEvent) Reset()         { *m = ContractCallExecutedEvent{} }
-func (m *ContractCallExecutedEvent) String() string { return proto.CompactTextString(m) }
-func (*ContractCallExecutedEvent) ProtoMessage()    {}
-func (*ContractCallExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{20}
+func (m *MsgEthereumHeightVoteResponse) Reset()         { *m = MsgEthereumHeightVoteResponse{} }
+func (m *MsgEthereumHeightVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEthereumHeightVoteResponse) ProtoMessage()    {}
+func (*MsgEthereumHeightVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{24}
 }
-func (m *ContractCallExecutedEvent) XXX_Unmarshal(b []byte) error {
+func (m *MsgEthereumHeightVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContractCallExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgEthereumHeightVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractCallExecutedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgEthereumHeightVoteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1120,70 +1324,90 @@ func (m *ContractCallExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ContractCallExecutedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractCallExecutedEvent.Merge(m, src)
+func (m *MsgEthereumHeightVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEthereumHeightVoteResponse.Merge(m, src)
 }
-func (m *ContractCallExecutedEvent) XXX_Size() int {
+func (m *MsgEthereumHeightVoteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContractCallExecutedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractCallExecutedEvent.DiscardUnknown(m)
+func (m *MsgEthereumHeightVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEthereumHeightVoteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractCallExecutedEvent proto.InternalMessageInfo
+var xxx_messageInfo_MsgEthereumHeightVoteResponse proto.InternalMessageInfo
 
-func (m *ContractCallExecutedEvent) GetEventNonce() uint64 {
-	if m != nil {
-		return m.EventNonce
-	}
-	return 0
+// MsgEthereumHeaderVote votes for the ethereum header at the next checkpoint
+// height while ethereum header validation is enabled. Once validators holding
+// the event vote threshold of power vote for the same header it becomes the
+// agreed head, which ethereum events must not claim a block beyond. It must
+// be signed by the orchestrator of a validator.
+type MsgEthereumHeaderVote struct {
+	Header EthereumHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header"`
+	Signer string         `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *ContractCallExecutedEvent) GetInvalidationScope() github_com_tendermint_tendermint_libs_bytes.HexBytes {
-	if m != nil {
-		return m.InvalidationScope
+func (m *MsgEthereumHeaderVote) Reset()         { *m = MsgEthereumHeaderVote{} }
+func (m *MsgEthereumHeaderVote) String() string { return proto.CompactTextString(m) }
+func (*MsgEthereumHeaderVote) ProtoMessage()    {}
+func (*MsgEthereumHeaderVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{25}
+}
+func (m *MsgEthereumHeaderVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEthereumHeaderVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEthereumHeaderVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *MsgEthereumHeaderVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEthereumHeaderVote.Merge(m, src)
+}
+func (m *MsgEthereumHeaderVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEthereumHeaderVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEthereumHeaderVote.DiscardUnknown(m)
 }
 
-func (m *ContractCallExecutedEvent) GetInvalidationNonce() uint64 {
+var xxx_messageInfo_MsgEthereumHeaderVote proto.InternalMessageInfo
+
+func (m *MsgEthereumHeaderVote) GetHeader() EthereumHeader {
 	if m != nil {
-		return m.InvalidationNonce
+		return m.Header
 	}
-	return 0
+	return EthereumHeader{}
 }
 
-func (m *ContractCallExecutedEvent) GetEthereumHeight() uint64 {
+func (m *MsgEthereumHeaderVote) GetSigner() string {
 	if m != nil {
-		return m.EthereumHeight
+		return m.Signer
 	}
-	return 0
+	return ""
 }
 
-// ERC20DeployedEvent is submitted when an ERC20 contract
-// for a Cosmos SDK coin has been deployed on Ethereum.
-type ERC20DeployedEvent struct {
-	EventNonce     uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
-	CosmosDenom    string `protobuf:"bytes,2,opt,name=cosmos_denom,json=cosmosDenom,proto3" json:"cosmos_denom,omitempty"`
-	TokenContract  string `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
-	Erc20Name      string `protobuf:"bytes,4,opt,name=erc20_name,json=erc20Name,proto3" json:"erc20_name,omitempty"`
-	Erc20Symbol    string `protobuf:"bytes,5,opt,name=erc20_symbol,json=erc20Symbol,proto3" json:"erc20_symbol,omitempty"`
-	Erc20Decimals  uint64 `protobuf:"varint,6,opt,name=erc20_decimals,json=erc20Decimals,proto3" json:"erc20_decimals,omitempty"`
-	EthereumHeight uint64 `protobuf:"varint,7,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+type MsgEthereumHeaderVoteResponse struct {
 }
 
-func (m *ERC20DeployedEvent) Reset()         { *m = ERC20DeployedEvent{} }
-func (m *ERC20DeployedEvent) String() string { return proto.CompactTextString(m) }
-func (*ERC20DeployedEvent) ProtoMessage()    {}
-func (*ERC20DeployedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{21}
+func (m *MsgEthereumHeaderVoteResponse) Reset()         { *m = MsgEthereumHeaderVoteResponse{} }
+func (m *MsgEthereumHeaderVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEthereumHeaderVoteResponse) ProtoMessage()    {}
+func (*MsgEthereumHeaderVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{26}
 }
-func (m *ERC20DeployedEvent) XXX_Unmarshal(b []byte) error {
+func (m *MsgEthereumHeaderVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ERC20DeployedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgEthereumHeaderVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ERC20DeployedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgEthereumHeaderVoteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1193,88 +1417,142 @@ func (m *ERC20DeployedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ERC20DeployedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ERC20DeployedEvent.Merge(m, src)
+func (m *MsgEthereumHeaderVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEthereumHeaderVoteResponse.Merge(m, src)
 }
-func (m *ERC20DeployedEvent) XXX_Size() int {
+func (m *MsgEthereumHeaderVoteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ERC20DeployedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_ERC20DeployedEvent.DiscardUnknown(m)
+func (m *MsgEthereumHeaderVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEthereumHeaderVoteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ERC20DeployedEvent proto.InternalMessageInfo
+var xxx_messageInfo_MsgEthereumHeaderVoteResponse proto.InternalMessageInfo
 
-func (m *ERC20DeployedEvent) GetEventNonce() uint64 {
-	if m != nil {
-		return m.EventNonce
+// MsgERC20DeployedConfirm adopts an observed ERC20 deployment as the
+// representation of a Cosmos originated denom, once its name, symbol and
+// decimals have been checked against the denom metadata. It must be signed by
+// the orchestrator of a validator.
+type MsgERC20DeployedConfirm struct {
+	CosmosDenom   string `protobuf:"bytes,1,opt,name=cosmos_denom,json=cosmosDenom,proto3" json:"cosmos_denom,omitempty"`
+	TokenContract string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Signer        string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgERC20DeployedConfirm) Reset()         { *m = MsgERC20DeployedConfirm{} }
+func (m *MsgERC20DeployedConfirm) String() string { return proto.CompactTextString(m) }
+func (*MsgERC20DeployedConfirm) ProtoMessage()    {}
+func (*MsgERC20DeployedConfirm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{27}
+}
+func (m *MsgERC20DeployedConfirm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgERC20DeployedConfirm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgERC20DeployedConfirm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *MsgERC20DeployedConfirm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgERC20DeployedConfirm.Merge(m, src)
+}
+func (m *MsgERC20DeployedConfirm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgERC20DeployedConfirm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgERC20DeployedConfirm.DiscardUnknown(m)
 }
 
-func (m *ERC20DeployedEvent) GetCosmosDenom() string {
+var xxx_messageInfo_MsgERC20DeployedConfirm proto.InternalMessageInfo
+
+func (m *MsgERC20DeployedConfirm) GetCosmosDenom() string {
 	if m != nil {
 		return m.CosmosDenom
 	}
 	return ""
 }
 
-func (m *ERC20DeployedEvent) GetTokenContract() string {
+func (m *MsgERC20DeployedConfirm) GetTokenContract() string {
 	if m != nil {
 		return m.TokenContract
 	}
 	return ""
 }
 
-func (m *ERC20DeployedEvent) GetErc20Name() string {
+func (m *MsgERC20DeployedConfirm) GetSigner() string {
 	if m != nil {
-		return m.Erc20Name
+		return m.Signer
 	}
 	return ""
 }
 
-func (m *ERC20DeployedEvent) GetErc20Symbol() string {
-	if m != nil {
-		return m.Erc20Symbol
-	}
-	return ""
+type MsgERC20DeployedConfirmResponse struct {
 }
 
-func (m *ERC20DeployedEvent) GetErc20Decimals() uint64 {
-	if m != nil {
-		return m.Erc20Decimals
-	}
-	return 0
+func (m *MsgERC20DeployedConfirmResponse) Reset()         { *m = MsgERC20DeployedConfirmResponse{} }
+func (m *MsgERC20DeployedConfirmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgERC20DeployedConfirmResponse) ProtoMessage()    {}
+func (*MsgERC20DeployedConfirmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{28}
 }
-
-func (m *ERC20DeployedEvent) GetEthereumHeight() uint64 {
-	if m != nil {
-		return m.EthereumHeight
+func (m *MsgERC20DeployedConfirmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgERC20DeployedConfirmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgERC20DeployedConfirmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *MsgERC20DeployedConfirmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgERC20DeployedConfirmResponse.Merge(m, src)
+}
+func (m *MsgERC20DeployedConfirmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgERC20DeployedConfirmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgERC20DeployedConfirmResponse.DiscardUnknown(m)
 }
 
-// This informs the Cosmos module that a validator
-// set has been updated.
-type SignerSetTxExecutedEvent struct {
-	EventNonce       uint64            `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
-	SignerSetTxNonce uint64            `protobuf:"varint,2,opt,name=signer_set_tx_nonce,json=signerSetTxNonce,proto3" json:"signer_set_tx_nonce,omitempty"`
-	EthereumHeight   uint64            `protobuf:"varint,3,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
-	Members          []*EthereumSigner `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+var xxx_messageInfo_MsgERC20DeployedConfirmResponse proto.InternalMessageInfo
+
+// MsgEthereumAnomalyReport reports an Ethereum-side anomaly of a token, such
+// as its contract being paused or blacklisting the gravity contract. Once
+// validators holding the event vote threshold of power report the same
+// anomaly, sends of the token to Ethereum are paused; reporting
+// ETHEREUM_ANOMALY_RESOLVED the same way resumes them. It must be signed by the
+// orchestrator of a validator.
+type MsgEthereumAnomalyReport struct {
+	TokenContract string          `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Anomaly       EthereumAnomaly `protobuf:"varint,2,opt,name=anomaly,proto3,enum=gravity.v1.EthereumAnomaly" json:"anomaly,omitempty"`
+	Signer        string          `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *SignerSetTxExecutedEvent) Reset()         { *m = SignerSetTxExecutedEvent{} }
-func (m *SignerSetTxExecutedEvent) String() string { return proto.CompactTextString(m) }
-func (*SignerSetTxExecutedEvent) ProtoMessage()    {}
-func (*SignerSetTxExecutedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{22}
+func (m *MsgEthereumAnomalyReport) Reset()         { *m = MsgEthereumAnomalyReport{} }
+func (m *MsgEthereumAnomalyReport) String() string { return proto.CompactTextString(m) }
+func (*MsgEthereumAnomalyReport) ProtoMessage()    {}
+func (*MsgEthereumAnomalyReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{29}
 }
-func (m *SignerSetTxExecutedEvent) XXX_Unmarshal(b []byte) error {
+func (m *MsgEthereumAnomalyReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SignerSetTxExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgEthereumAnomalyReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SignerSetTxExecutedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgEthereumAnomalyReport.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1284,1838 +1562,9156 @@ func (m *SignerSetTxExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *SignerSetTxExecutedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignerSetTxExecutedEvent.Merge(m, src)
+func (m *MsgEthereumAnomalyReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEthereumAnomalyReport.Merge(m, src)
 }
-func (m *SignerSetTxExecutedEvent) XXX_Size() int {
+func (m *MsgEthereumAnomalyReport) XXX_Size() int {
 	return m.Size()
 }
-func (m *SignerSetTxExecutedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignerSetTxExecutedEvent.DiscardUnknown(m)
+func (m *MsgEthereumAnomalyReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEthereumAnomalyReport.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SignerSetTxExecutedEvent proto.InternalMessageInfo
+var xxx_messageInfo_MsgEthereumAnomalyReport proto.InternalMessageInfo
 
-func (m *SignerSetTxExecutedEvent) GetEventNonce() uint64 {
+func (m *MsgEthereumAnomalyReport) GetTokenContract() string {
 	if m != nil {
-		return m.EventNonce
+		return m.TokenContract
 	}
-	return 0
+	return ""
 }
 
-func (m *SignerSetTxExecutedEvent) GetSignerSetTxNonce() uint64 {
+func (m *MsgEthereumAnomalyReport) GetAnomaly() EthereumAnomaly {
 	if m != nil {
-		return m.SignerSetTxNonce
+		return m.Anomaly
 	}
-	return 0
+	return ETHEREUM_ANOMALY_UNSPECIFIED
 }
 
-func (m *SignerSetTxExecutedEvent) GetEthereumHeight() uint64 {
+func (m *MsgEthereumAnomalyReport) GetSigner() string {
 	if m != nil {
-		return m.EthereumHeight
+		return m.Signer
 	}
-	return 0
+	return ""
 }
 
-func (m *SignerSetTxExecutedEvent) GetMembers() []*EthereumSigner {
-	if m != nil {
-		return m.Members
+type MsgEthereumAnomalyReportResponse struct {
+}
+
+func (m *MsgEthereumAnomalyReportResponse) Reset()         { *m = MsgEthereumAnomalyReportResponse{} }
+func (m *MsgEthereumAnomalyReportResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEthereumAnomalyReportResponse) ProtoMessage()    {}
+func (*MsgEthereumAnomalyReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{30}
+}
+func (m *MsgEthereumAnomalyReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEthereumAnomalyReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEthereumAnomalyReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *MsgEthereumAnomalyReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEthereumAnomalyReportResponse.Merge(m, src)
+}
+func (m *MsgEthereumAnomalyReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEthereumAnomalyReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEthereumAnomalyReportResponse.DiscardUnknown(m)
 }
 
-func init() {
-	proto.RegisterType((*MsgSendToEthereum)(nil), "gravity.v1.MsgSendToEthereum")
-	proto.RegisterType((*MsgSendToEthereumResponse)(nil), "gravity.v1.MsgSendToEthereumResponse")
-	proto.RegisterType((*MsgCancelSendToEthereum)(nil), "gravity.v1.MsgCancelSendToEthereum")
-	proto.RegisterType((*MsgCancelSendToEthereumResponse)(nil), "gravity.v1.MsgCancelSendToEthereumResponse")
-	proto.RegisterType((*MsgRequestBatchTx)(nil), "gravity.v1.MsgRequestBatchTx")
-	proto.RegisterType((*MsgRequestBatchTxResponse)(nil), "gravity.v1.MsgRequestBatchTxResponse")
-	proto.RegisterType((*MsgSubmitEthereumTxConfirmation)(nil), "gravity.v1.MsgSubmitEthereumTxConfirmation")
-	proto.RegisterType((*ContractCallTxConfirmation)(nil), "gravity.v1.ContractCallTxConfirmation")
-	proto.RegisterType((*BatchTxConfirmation)(nil), "gravity.v1.BatchTxConfirmation")
-	proto.RegisterType((*SignerSetTxConfirmation)(nil), "gravity.v1.SignerSetTxConfirmation")
-	proto.RegisterType((*MsgSubmitEthereumTxConfirmationResponse)(nil), "gravity.v1.MsgSubmitEthereumTxConfirmationResponse")
-	proto.RegisterType((*MsgSubmitEthereumEvent)(nil), "gravity.v1.MsgSubmitEthereumEvent")
-	proto.RegisterType((*MsgSubmitEthereumEventResponse)(nil), "gravity.v1.MsgSubmitEthereumEventResponse")
-	proto.RegisterType((*MsgDelegateKeys)(nil), "gravity.v1.MsgDelegateKeys")
-	proto.RegisterType((*MsgDelegateKeysResponse)(nil), "gravity.v1.MsgDelegateKeysResponse")
-	proto.RegisterType((*DelegateKeysSignMsg)(nil), "gravity.v1.DelegateKeysSignMsg")
-	proto.RegisterType((*MsgEthereumHeightVote)(nil), "gravity.v1.MsgEthereumHeightVote")
-	proto.RegisterType((*MsgEthereumHeightVoteResponse)(nil), "gravity.v1.MsgEthereumHeightVoteResponse")
-	proto.RegisterType((*SendToCosmosEvent)(nil), "gravity.v1.SendToCosmosEvent")
-	proto.RegisterType((*BatchExecutedEvent)(nil), "gravity.v1.BatchExecutedEvent")
-	proto.RegisterType((*ContractCallExecutedEvent)(nil), "gravity.v1.ContractCallExecutedEvent")
-	proto.RegisterType((*ERC20DeployedEvent)(nil), "gravity.v1.ERC20DeployedEvent")
-	proto.RegisterType((*SignerSetTxExecutedEvent)(nil), "gravity.v1.SignerSetTxExecutedEvent")
-}
-
-func init() { proto.RegisterFile("gravity/v1/msgs.proto", fileDescriptor_2f8523f2f6feb451) }
+var xxx_messageInfo_MsgEthereumAnomalyReportResponse proto.InternalMessageInfo
 
-var fileDescriptor_2f8523f2f6feb451 = []byte{
-	// 1335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x16, 0x25, 0xd9, 0x81, 0x47, 0xfe, 0xa4, 0x9d, 0x44, 0x56, 0x12, 0xc9, 0x51, 0x90, 0x37,
-	0xce, 0x1b, 0x88, 0x8c, 0x95, 0x00, 0xef, 0x8b, 0x14, 0x2d, 0x60, 0xc9, 0x0e, 0x52, 0x14, 0xce,
-	0x41, 0x72, 0x0a, 0xa3, 0x17, 0x81, 0x22, 0x27, 0x14, 0x13, 0x91, 0xab, 0x72, 0x57, 0x82, 0x75,
-	0xed, 0xa9, 0xe8, 0xa9, 0x3d, 0xf4, 0x9e, 0x43, 0xd0, 0x5f, 0x90, 0x3f, 0x90, 0x5b, 0x9a, 0x53,
-	0x80, 0x02, 0x45, 0xd1, 0x43, 0x50, 0x24, 0x97, 0xfe, 0x86, 0x02, 0x05, 0x0a, 0xee, 0x2e, 0x65,
-	0x92, 0xa2, 0xbf, 0x80, 0x9e, 0xc4, 0x9d, 0x79, 0x76, 0xbe, 0xf6, 0xd9, 0x9d, 0x11, 0x5c, 0xb4,
-	0x7d, 0x63, 0xe4, 0xb0, 0xb1, 0x3e, 0xda, 0xd2, 0x5d, 0x6a, 0x53, 0x6d, 0xe0, 0x13, 0x46, 0x54,
-	0x90, 0x62, 0x6d, 0xb4, 0x55, 0x2a, 0x9b, 0x84, 0xba, 0x84, 0xea, 0x5d, 0x83, 0xa2, 0x3e, 0xda,
-	0xea, 0x22, 0x33, 0xb6, 0x74, 0x93, 0x38, 0x9e, 0xc0, 0x96, 0xd6, 0x85, 0xbe, 0xc3, 0x57, 0xba,
-	0x58, 0x48, 0x55, 0x31, 0x62, 0x3d, 0xb4, 0x28, 0x34, 0x6b, 0x36, 0xb1, 0x89, 0xd8, 0x11, 0x7c,
-	0x49, 0xe9, 0x55, 0x9b, 0x10, 0xbb, 0x8f, 0xba, 0x31, 0x70, 0x74, 0xc3, 0xf3, 0x08, 0x33, 0x98,
-	0x43, 0xbc, 0xd0, 0xda, 0xba, 0xd4, 0xf2, 0x55, 0x77, 0xf8, 0x54, 0x37, 0x3c, 0x69, 0xae, 0xfa,
-	0x8b, 0x02, 0x2b, 0x7b, 0xd4, 0x6e, 0xa3, 0x67, 0xed, 0x93, 0x5d, 0xd6, 0x43, 0x1f, 0x87, 0xae,
-	0x7a, 0x09, 0x66, 0x29, 0x7a, 0x16, 0xfa, 0x45, 0x65, 0x43, 0xd9, 0x9c, 0x6b, 0xc9, 0x95, 0x5a,
-	0x03, 0x15, 0x25, 0xa6, 0xe3, 0xa3, 0xe9, 0x0c, 0x1c, 0xf4, 0x58, 0x31, 0xcb, 0x31, 0x2b, 0xa1,
-	0xa6, 0x15, 0x2a, 0xd4, 0xff, 0xc1, 0xac, 0xe1, 0x92, 0xa1, 0xc7, 0x8a, 0xb9, 0x0d, 0x65, 0xb3,
-	0x50, 0x5f, 0xd7, 0x64, 0x92, 0x41, 0x45, 0x34, 0x59, 0x11, 0xad, 0x49, 0x1c, 0xaf, 0x91, 0x7f,
-	0xf3, 0xbe, 0x92, 0x69, 0x49, 0xb8, 0xfa, 0x19, 0x40, 0xd7, 0x77, 0x2c, 0x1b, 0x3b, 0x4f, 0x11,
-	0x8b, 0xf9, 0xb3, 0x6d, 0x9e, 0x13, 0x5b, 0x1e, 0x22, 0x56, 0xef, 0xc0, 0xfa, 0x54, 0x52, 0x2d,
-	0xa4, 0x03, 0xe2, 0x51, 0x54, 0x17, 0x21, 0xeb, 0x58, 0x3c, 0xb1, 0x7c, 0x2b, 0xeb, 0x58, 0xd5,
-	0x6d, 0xb8, 0xbc, 0x47, 0xed, 0xa6, 0xe1, 0x99, 0xd8, 0x4f, 0xd4, 0x21, 0x01, 0x8d, 0xd4, 0x25,
-	0x1b, 0xad, 0x4b, 0xf5, 0x3a, 0x54, 0x8e, 0x31, 0x11, 0x7a, 0xad, 0x6e, 0xf3, 0x3a, 0xb7, 0xf0,
-	0xeb, 0x21, 0x52, 0xd6, 0x30, 0x98, 0xd9, 0xdb, 0x3f, 0x54, 0xd7, 0x60, 0xc6, 0x42, 0x8f, 0xb8,
-	0xb2, 0xcc, 0x62, 0xc1, 0xbd, 0x38, 0xb6, 0x17, 0xf1, 0xc2, 0x57, 0xd5, 0x2b, 0x3c, 0xab, 0xb8,
-	0x89, 0x89, 0xfd, 0x1f, 0x15, 0x1e, 0x43, 0x7b, 0xd8, 0x75, 0x1d, 0x16, 0x7a, 0xdf, 0x3f, 0x6c,
-	0x12, 0xef, 0xa9, 0xe3, 0xbb, 0x9c, 0x0e, 0xea, 0x3e, 0xcc, 0x9b, 0x91, 0x35, 0xf7, 0x5a, 0xa8,
-	0xaf, 0x69, 0x82, 0x1e, 0x5a, 0x48, 0x0f, 0x6d, 0xdb, 0x1b, 0x37, 0x4a, 0x6f, 0x5f, 0xd5, 0x2e,
-	0xa5, 0xdb, 0x69, 0xc5, 0xac, 0x1c, 0x17, 0xee, 0x83, 0xfc, 0xb7, 0x2f, 0x2a, 0x99, 0xea, 0x6b,
-	0x05, 0x4a, 0x4d, 0xe2, 0x31, 0xdf, 0x30, 0x59, 0xd3, 0xe8, 0xf7, 0x13, 0x21, 0xd5, 0x40, 0x75,
-	0xbc, 0x91, 0xd1, 0x77, 0x2c, 0xbe, 0xee, 0x50, 0x93, 0x0c, 0x90, 0x07, 0x36, 0xdf, 0x5a, 0x89,
-	0x6a, 0xda, 0x81, 0x62, 0x0a, 0xee, 0x11, 0xcf, 0x44, 0xee, 0x37, 0x1f, 0x87, 0x3f, 0x0e, 0x14,
-	0xea, 0x2d, 0x58, 0x9a, 0xf0, 0x55, 0xc6, 0x98, 0xe3, 0x31, 0x2e, 0x86, 0xe2, 0x36, 0x97, 0xaa,
-	0x57, 0x61, 0x2e, 0xd0, 0x1b, 0x6c, 0xe8, 0x0b, 0xbe, 0xcd, 0xb7, 0x8e, 0x04, 0xd5, 0x97, 0x0a,
-	0xac, 0xca, 0x7a, 0xc7, 0x82, 0xbf, 0x09, 0x8b, 0x8c, 0x3c, 0x47, 0xaf, 0x63, 0xca, 0x04, 0xe5,
-	0x39, 0x2e, 0x70, 0x69, 0x98, 0xb5, 0x5a, 0x81, 0x42, 0x37, 0xd8, 0x1d, 0x8b, 0x16, 0xb8, 0xe8,
-	0x5f, 0x0d, 0xf3, 0x3b, 0x05, 0x2e, 0x0b, 0x60, 0x1b, 0x59, 0x22, 0xd4, 0x4d, 0x58, 0x16, 0x96,
-	0x3b, 0x14, 0x99, 0x0c, 0x44, 0xf0, 0x7a, 0x91, 0x86, 0x5b, 0x8e, 0x0d, 0x26, 0x7b, 0x7a, 0x30,
-	0xb9, 0x64, 0x30, 0xb7, 0xe1, 0xd6, 0x29, 0x74, 0x9c, 0x50, 0x77, 0x08, 0x97, 0xa6, 0xa0, 0xbb,
-	0xa3, 0xe0, 0x01, 0xf9, 0x14, 0x66, 0x30, 0xf8, 0x38, 0x91, 0xa9, 0x2b, 0x6f, 0x5f, 0xd5, 0x16,
-	0x62, 0xfb, 0x5a, 0x62, 0xd7, 0x29, 0xcc, 0xdc, 0x80, 0x72, 0xba, 0xdb, 0x49, 0x60, 0xaf, 0x15,
-	0x58, 0xda, 0xa3, 0xf6, 0x0e, 0xf6, 0xd1, 0x36, 0x18, 0x7e, 0x81, 0x63, 0xaa, 0xde, 0x81, 0x15,
-	0xc9, 0x32, 0xe2, 0x77, 0x0c, 0xcb, 0xf2, 0x91, 0x52, 0x79, 0xec, 0xcb, 0x13, 0xc5, 0xb6, 0x90,
-	0xab, 0x5b, 0xb0, 0x46, 0x7c, 0xb3, 0x87, 0x94, 0xf9, 0x31, 0xbc, 0x08, 0x67, 0x35, 0xaa, 0x0b,
-	0xb7, 0xdc, 0x86, 0xe5, 0x49, 0xf9, 0x43, 0xb8, 0x20, 0xc3, 0xe4, 0x58, 0x42, 0xe8, 0x0d, 0x58,
-	0x40, 0xd6, 0xeb, 0x24, 0x19, 0x31, 0x8f, 0xac, 0xd7, 0x9e, 0x9c, 0xc3, 0x3a, 0x7f, 0xdd, 0xa2,
-	0x29, 0x4c, 0xd2, 0x3b, 0x80, 0xd5, 0xa8, 0x3c, 0xd8, 0xb3, 0x47, 0xed, 0xf3, 0x65, 0xb8, 0x06,
-	0x33, 0x51, 0x56, 0x8b, 0x45, 0xf5, 0x00, 0x2e, 0xee, 0x51, 0x3b, 0x2c, 0xea, 0x23, 0x74, 0xec,
-	0x1e, 0xfb, 0x92, 0xb0, 0x38, 0xb9, 0x7a, 0x5c, 0x1c, 0xb2, 0x10, 0x63, 0xe0, 0x63, 0xdf, 0xc0,
-	0x0a, 0x5c, 0x4b, 0xb5, 0x3c, 0x49, 0xea, 0x65, 0x16, 0x56, 0xc4, 0x13, 0xdc, 0xe4, 0xed, 0x42,
-	0x10, 0xa9, 0x02, 0x05, 0x4e, 0x89, 0x18, 0xf3, 0x81, 0x8b, 0x04, 0xeb, 0xa7, 0xaf, 0x72, 0x36,
-	0xed, 0x2a, 0x3f, 0x8c, 0x75, 0xb4, 0xb9, 0x86, 0x16, 0x74, 0x9e, 0xdf, 0xdf, 0x57, 0xfe, 0x63,
-	0x3b, 0xac, 0x37, 0xec, 0x6a, 0x26, 0x71, 0x65, 0x23, 0x97, 0x3f, 0x35, 0x6a, 0x3d, 0xd7, 0xd9,
-	0x78, 0x80, 0x54, 0xfb, 0xdc, 0x63, 0x93, 0x06, 0x17, 0xbb, 0x64, 0xa2, 0xa3, 0xe4, 0x13, 0x97,
-	0x4c, 0x74, 0xdc, 0x5b, 0xb0, 0x24, 0xa7, 0x04, 0x1f, 0x4d, 0x74, 0x46, 0xe8, 0x17, 0x67, 0x04,
-	0x50, 0x88, 0x5b, 0x52, 0x9a, 0x56, 0xd9, 0xd9, 0xb4, 0xca, 0x3e, 0xc8, 0xff, 0xf9, 0xa2, 0xa2,
-	0x54, 0x7f, 0x52, 0x40, 0xe5, 0x4f, 0xda, 0xee, 0x21, 0x9a, 0x43, 0x86, 0x96, 0xa8, 0xd3, 0xd9,
-	0x5f, 0xb4, 0x68, 0x39, 0xb3, 0x53, 0xe5, 0x4c, 0x89, 0x26, 0x97, 0x7a, 0xce, 0x89, 0xb7, 0x31,
-	0x9f, 0x7c, 0x1b, 0xab, 0x7f, 0x2b, 0xb0, 0x1e, 0xed, 0x1f, 0xf1, 0x78, 0x4f, 0x3d, 0x57, 0x3b,
-	0xb5, 0xbf, 0x04, 0x01, 0xcf, 0x37, 0xfe, 0xff, 0xd7, 0xfb, 0xca, 0xfd, 0xc8, 0xc1, 0x31, 0x5e,
-	0x72, 0xd7, 0xf1, 0x58, 0xf4, 0xb3, 0xef, 0x74, 0xa9, 0xde, 0x1d, 0x33, 0xa4, 0xda, 0x23, 0x3c,
-	0x6c, 0x04, 0x1f, 0x67, 0xef, 0x4c, 0xb9, 0xb3, 0x74, 0x26, 0x59, 0xa0, 0x7c, 0x5a, 0x81, 0xaa,
-	0x3f, 0x64, 0x41, 0xdd, 0x6d, 0x35, 0xeb, 0x77, 0x77, 0x70, 0xd0, 0x27, 0xe3, 0x33, 0x27, 0x7e,
-	0x3d, 0xe8, 0xf5, 0x9c, 0x38, 0x62, 0xc2, 0x10, 0x74, 0x2e, 0x08, 0xd9, 0x0e, 0x9f, 0x33, 0xa6,
-	0x0f, 0x3b, 0x97, 0x76, 0xd8, 0xd7, 0x00, 0xd0, 0x37, 0xeb, 0x77, 0x3b, 0x9e, 0xe1, 0xa2, 0xa4,
-	0xe9, 0x1c, 0x97, 0x3c, 0x36, 0x5c, 0xee, 0x48, 0xa8, 0xe9, 0xd8, 0xed, 0x92, 0xbe, 0xa4, 0x67,
-	0x81, 0xcb, 0xda, 0x5c, 0x14, 0x38, 0x12, 0x10, 0x0b, 0x4d, 0xc7, 0x35, 0xfa, 0x54, 0x52, 0x73,
-	0x81, 0x4b, 0x77, 0xa4, 0x30, 0xad, 0x26, 0x17, 0x52, 0x6b, 0xf2, 0xb3, 0x02, 0xc5, 0x48, 0xa3,
-	0x3b, 0x27, 0x25, 0x6a, 0xb0, 0x1a, 0x69, 0x85, 0xec, 0x30, 0x46, 0xe2, 0x65, 0x7a, 0x64, 0xf7,
-	0x9c, 0x54, 0xbe, 0x0f, 0x17, 0x5c, 0x74, 0xbb, 0xe8, 0xd3, 0x62, 0x7e, 0x23, 0xb7, 0x59, 0xa8,
-	0x97, 0xb4, 0xa3, 0x3f, 0x03, 0xda, 0x6e, 0xac, 0x79, 0xb6, 0x42, 0x68, 0xfd, 0xd7, 0x19, 0xc8,
-	0x05, 0xaf, 0xee, 0x01, 0x2c, 0x26, 0x86, 0xcf, 0x6b, 0xd1, 0xed, 0x53, 0xe3, 0x6c, 0xe9, 0xe6,
-	0x89, 0xea, 0xc9, 0x7b, 0x98, 0x51, 0x9f, 0xc1, 0x5a, 0xea, 0x70, 0x7b, 0x23, 0x61, 0x20, 0x0d,
-	0x54, 0xba, 0x73, 0x06, 0x50, 0xc4, 0xd7, 0x01, 0x2c, 0x26, 0x46, 0xdc, 0x64, 0x16, 0x71, 0xf5,
-	0x54, 0x16, 0xc7, 0x4c, 0xb7, 0x19, 0xf5, 0x1b, 0x05, 0xae, 0x9e, 0x38, 0xdc, 0x26, 0x23, 0x3d,
-	0x09, 0x5c, 0xba, 0x77, 0x0e, 0x70, 0x24, 0x08, 0x1b, 0x56, 0xd3, 0xc6, 0x94, 0xea, 0x89, 0xd6,
-	0x38, 0xa6, 0xf4, 0xdf, 0xd3, 0x31, 0x11, 0x47, 0x4f, 0x60, 0xa9, 0x8d, 0x2c, 0x36, 0x78, 0x5c,
-	0x49, 0x18, 0x88, 0x2a, 0x4b, 0x37, 0x4e, 0x50, 0xc6, 0xa8, 0x50, 0x8c, 0xfb, 0x8d, 0xb4, 0xe6,
-	0xeb, 0x09, 0x13, 0xd3, 0x90, 0xd2, 0xed, 0x53, 0x21, 0x47, 0xbe, 0x1a, 0x4f, 0xde, 0x7c, 0x28,
-	0x2b, 0xef, 0x3e, 0x94, 0x95, 0x3f, 0x3e, 0x94, 0x95, 0xef, 0x3f, 0x96, 0x33, 0xef, 0x3e, 0x96,
-	0x33, 0xbf, 0x7d, 0x2c, 0x67, 0xbe, 0xfa, 0x24, 0xf2, 0xe6, 0x0e, 0xd0, 0xb6, 0xc7, 0xcf, 0x46,
-	0xe1, 0x9f, 0xdc, 0x9a, 0xf8, 0x0f, 0xa7, 0xbb, 0xc4, 0x1a, 0xf6, 0x51, 0x1f, 0xd5, 0xf5, 0xc3,
-	0x50, 0x25, 0xba, 0x68, 0x77, 0x96, 0xcf, 0x7e, 0xf7, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x19,
-	0x83, 0xff, 0x28, 0x80, 0x0f, 0x00, 0x00,
+// MsgRegisterOrchestratorQueryIdentity registers the SHA-256 hash of an API
+// key for the signing orchestrator, replacing its previous one. Nodes can
+// exempt queries presenting the API key from rate limits until the identity
+// expires. It must be signed by the orchestrator of a validator.
+type MsgRegisterOrchestratorQueryIdentity struct {
+	KeyHash []byte `protobuf:"bytes,1,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`
+	Signer  string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (this *SendToCosmosEvent) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*SendToCosmosEvent)
-	if !ok {
-		that2, ok := that.(SendToCosmosEvent)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *MsgRegisterOrchestratorQueryIdentity) Reset()         { *m = MsgRegisterOrchestratorQueryIdentity{} }
+func (m *MsgRegisterOrchestratorQueryIdentity) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterOrchestratorQueryIdentity) ProtoMessage()    {}
+func (*MsgRegisterOrchestratorQueryIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{31}
+}
+func (m *MsgRegisterOrchestratorQueryIdentity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterOrchestratorQueryIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterOrchestratorQueryIdentity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.EventNonce != that1.EventNonce {
-		return false
-	}
-	if this.TokenContract != that1.TokenContract {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	if this.EthereumSender != that1.EthereumSender {
-		return false
-	}
-	if this.CosmosReceiver != that1.CosmosReceiver {
-		return false
-	}
-	if this.EthereumHeight != that1.EthereumHeight {
-		return false
-	}
-	return true
+}
+func (m *MsgRegisterOrchestratorQueryIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterOrchestratorQueryIdentity.Merge(m, src)
+}
+func (m *MsgRegisterOrchestratorQueryIdentity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterOrchestratorQueryIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterOrchestratorQueryIdentity.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgRegisterOrchestratorQueryIdentity proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	SendToEthereum(ctx context.Context, in *MsgSendToEthereum, opts ...grpc.CallOption) (*MsgSendToEthereumResponse, error)
-	CancelSendToEthereum(ctx context.Context, in *MsgCancelSendToEthereum, opts ...grpc.CallOption) (*MsgCancelSendToEthereumResponse, error)
-	RequestBatchTx(ctx context.Context, in *MsgRequestBatchTx, opts ...grpc.CallOption) (*MsgRequestBatchTxResponse, error)
-	SubmitEthereumTxConfirmation(ctx context.Context, in *MsgSubmitEthereumTxConfirmation, opts ...grpc.CallOption) (*MsgSubmitEthereumTxConfirmationResponse, error)
-	SubmitEthereumEvent(ctx context.Context, in *MsgSubmitEthereumEvent, opts ...grpc.CallOption) (*MsgSubmitEthereumEventResponse, error)
-	SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, opts ...grpc.CallOption) (*MsgDelegateKeysResponse, error)
-	SubmitEthereumHeightVote(ctx context.Context, in *MsgEthereumHeightVote, opts ...grpc.CallOption) (*MsgEthereumHeightVoteResponse, error)
+func (m *MsgRegisterOrchestratorQueryIdentity) GetKeyHash() []byte {
+	if m != nil {
+		return m.KeyHash
+	}
+	return nil
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterOrchestratorQueryIdentity) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+type MsgRegisterOrchestratorQueryIdentityResponse struct {
+	ExpiryHeight uint64 `protobuf:"varint,1,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
 }
 
-func (c *msgClient) SendToEthereum(ctx context.Context, in *MsgSendToEthereum, opts ...grpc.CallOption) (*MsgSendToEthereumResponse, error) {
-	out := new(MsgSendToEthereumResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SendToEthereum", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterOrchestratorQueryIdentityResponse) Reset() {
+	*m = MsgRegisterOrchestratorQueryIdentityResponse{}
+}
+func (m *MsgRegisterOrchestratorQueryIdentityResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgRegisterOrchestratorQueryIdentityResponse) ProtoMessage() {}
+func (*MsgRegisterOrchestratorQueryIdentityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{32}
+}
+func (m *MsgRegisterOrchestratorQueryIdentityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterOrchestratorQueryIdentityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterOrchestratorQueryIdentityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRegisterOrchestratorQueryIdentityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterOrchestratorQueryIdentityResponse.Merge(m, src)
+}
+func (m *MsgRegisterOrchestratorQueryIdentityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterOrchestratorQueryIdentityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterOrchestratorQueryIdentityResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CancelSendToEthereum(ctx context.Context, in *MsgCancelSendToEthereum, opts ...grpc.CallOption) (*MsgCancelSendToEthereumResponse, error) {
-	out := new(MsgCancelSendToEthereumResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/CancelSendToEthereum", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterOrchestratorQueryIdentityResponse proto.InternalMessageInfo
+
+func (m *MsgRegisterOrchestratorQueryIdentityResponse) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RequestBatchTx(ctx context.Context, in *MsgRequestBatchTx, opts ...grpc.CallOption) (*MsgRequestBatchTxResponse, error) {
-	out := new(MsgRequestBatchTxResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/RequestBatchTx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgExecuteAtomic executes a sequence of gravity messages of the same signer
+// with all-or-nothing semantics, e.g. canceling a SendToEthereum and sending it
+// again with a higher fee. If any of the messages fails, none of them is
+// applied.
+type MsgExecuteAtomic struct {
+	Msgs   []*types1.Any `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	Signer string        `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (c *msgClient) SubmitEthereumTxConfirmation(ctx context.Context, in *MsgSubmitEthereumTxConfirmation, opts ...grpc.CallOption) (*MsgSubmitEthereumTxConfirmationResponse, error) {
-	out := new(MsgSubmitEthereumTxConfirmationResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitEthereumTxConfirmation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgExecuteAtomic) Reset()         { *m = MsgExecuteAtomic{} }
+func (m *MsgExecuteAtomic) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteAtomic) ProtoMessage()    {}
+func (*MsgExecuteAtomic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{33}
+}
+func (m *MsgExecuteAtomic) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteAtomic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteAtomic.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgExecuteAtomic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteAtomic.Merge(m, src)
+}
+func (m *MsgExecuteAtomic) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteAtomic) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteAtomic.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubmitEthereumEvent(ctx context.Context, in *MsgSubmitEthereumEvent, opts ...grpc.CallOption) (*MsgSubmitEthereumEventResponse, error) {
-	out := new(MsgSubmitEthereumEventResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitEthereumEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgExecuteAtomic proto.InternalMessageInfo
+
+// MsgExecuteAtomicResponse returns the responses of the executed messages, in
+// their order
+type MsgExecuteAtomicResponse struct {
+	Responses []*types1.Any `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
 }
 
-func (c *msgClient) SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, opts ...grpc.CallOption) (*MsgDelegateKeysResponse, error) {
-	out := new(MsgDelegateKeysResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SetDelegateKeys", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgExecuteAtomicResponse) Reset()         { *m = MsgExecuteAtomicResponse{} }
+func (m *MsgExecuteAtomicResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteAtomicResponse) ProtoMessage()    {}
+func (*MsgExecuteAtomicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{34}
+}
+func (m *MsgExecuteAtomicResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteAtomicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteAtomicResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgExecuteAtomicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteAtomicResponse.Merge(m, src)
+}
+func (m *MsgExecuteAtomicResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteAtomicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteAtomicResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubmitEthereumHeightVote(ctx context.Context, in *MsgEthereumHeightVote, opts ...grpc.CallOption) (*MsgEthereumHeightVoteResponse, error) {
-	out := new(MsgEthereumHeightVoteResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitEthereumHeightVote", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgExecuteAtomicResponse proto.InternalMessageInfo
+
+func (m *MsgExecuteAtomicResponse) GetResponses() []*types1.Any {
+	if m != nil {
+		return m.Responses
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	SendToEthereum(context.Context, *MsgSendToEthereum) (*MsgSendToEthereumResponse, error)
-	CancelSendToEthereum(context.Context, *MsgCancelSendToEthereum) (*MsgCancelSendToEthereumResponse, error)
-	RequestBatchTx(context.Context, *MsgRequestBatchTx) (*MsgRequestBatchTxResponse, error)
-	SubmitEthereumTxConfirmation(context.Context, *MsgSubmitEthereumTxConfirmation) (*MsgSubmitEthereumTxConfirmationResponse, error)
-	SubmitEthereumEvent(context.Context, *MsgSubmitEthereumEvent) (*MsgSubmitEthereumEventResponse, error)
-	SetDelegateKeys(context.Context, *MsgDelegateKeys) (*MsgDelegateKeysResponse, error)
-	SubmitEthereumHeightVote(context.Context, *MsgEthereumHeightVote) (*MsgEthereumHeightVoteResponse, error)
+// MsgVetoDelayedSendToEthereum vetoes sends to Ethereum held in the delayed
+// send queue, returning their amount and fees to the senders. It must be
+// signed by the security council account set in the params.
+type MsgVetoDelayedSendToEthereum struct {
+	Ids    []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Signer string   `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgVetoDelayedSendToEthereum) Reset()         { *m = MsgVetoDelayedSendToEthereum{} }
+func (m *MsgVetoDelayedSendToEthereum) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoDelayedSendToEthereum) ProtoMessage()    {}
+func (*MsgVetoDelayedSendToEthereum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{35}
 }
-
-func (*UnimplementedMsgServer) SendToEthereum(ctx context.Context, req *MsgSendToEthereum) (*MsgSendToEthereumResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendToEthereum not implemented")
+func (m *MsgVetoDelayedSendToEthereum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CancelSendToEthereum(ctx context.Context, req *MsgCancelSendToEthereum) (*MsgCancelSendToEthereumResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelSendToEthereum not implemented")
+func (m *MsgVetoDelayedSendToEthereum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoDelayedSendToEthereum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RequestBatchTx(ctx context.Context, req *MsgRequestBatchTx) (*MsgRequestBatchTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestBatchTx not implemented")
+func (m *MsgVetoDelayedSendToEthereum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoDelayedSendToEthereum.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SubmitEthereumTxConfirmation(ctx context.Context, req *MsgSubmitEthereumTxConfirmation) (*MsgSubmitEthereumTxConfirmationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumTxConfirmation not implemented")
+func (m *MsgVetoDelayedSendToEthereum) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SubmitEthereumEvent(ctx context.Context, req *MsgSubmitEthereumEvent) (*MsgSubmitEthereumEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumEvent not implemented")
+func (m *MsgVetoDelayedSendToEthereum) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoDelayedSendToEthereum.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) SetDelegateKeys(ctx context.Context, req *MsgDelegateKeys) (*MsgDelegateKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDelegateKeys not implemented")
+
+var xxx_messageInfo_MsgVetoDelayedSendToEthereum proto.InternalMessageInfo
+
+func (m *MsgVetoDelayedSendToEthereum) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) SubmitEthereumHeightVote(ctx context.Context, req *MsgEthereumHeightVote) (*MsgEthereumHeightVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumHeightVote not implemented")
+
+func (m *MsgVetoDelayedSendToEthereum) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+type MsgVetoDelayedSendToEthereumResponse struct {
 }
 
-func _Msg_SendToEthereum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSendToEthereum)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SendToEthereum(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Msg/SendToEthereum",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SendToEthereum(ctx, req.(*MsgSendToEthereum))
+func (m *MsgVetoDelayedSendToEthereumResponse) Reset()         { *m = MsgVetoDelayedSendToEthereumResponse{} }
+func (m *MsgVetoDelayedSendToEthereumResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoDelayedSendToEthereumResponse) ProtoMessage()    {}
+func (*MsgVetoDelayedSendToEthereumResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{36}
+}
+func (m *MsgVetoDelayedSendToEthereumResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoDelayedSendToEthereumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoDelayedSendToEthereumResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgVetoDelayedSendToEthereumResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoDelayedSendToEthereumResponse.Merge(m, src)
+}
+func (m *MsgVetoDelayedSendToEthereumResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoDelayedSendToEthereumResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoDelayedSendToEthereumResponse.DiscardUnknown(m)
 }
 
-func _Msg_CancelSendToEthereum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelSendToEthereum)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelSendToEthereum(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Msg/CancelSendToEthereum",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelSendToEthereum(ctx, req.(*MsgCancelSendToEthereum))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgVetoDelayedSendToEthereumResponse proto.InternalMessageInfo
+
+// MsgGrantBridgeFeeAllowance lets the granter pay the bridge fees of the
+// grantee's sends to Ethereum, up to the spend limit. It replaces any
+// allowance the granter already granted the grantee.
+type MsgGrantBridgeFeeAllowance struct {
+	Granter    string                                   `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee    string                                   `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
 }
 
-func _Msg_RequestBatchTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestBatchTx)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestBatchTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Msg/RequestBatchTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestBatchTx(ctx, req.(*MsgRequestBatchTx))
+func (m *MsgGrantBridgeFeeAllowance) Reset()         { *m = MsgGrantBridgeFeeAllowance{} }
+func (m *MsgGrantBridgeFeeAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantBridgeFeeAllowance) ProtoMessage()    {}
+func (*MsgGrantBridgeFeeAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{37}
+}
+func (m *MsgGrantBridgeFeeAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantBridgeFeeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantBridgeFeeAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgGrantBridgeFeeAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantBridgeFeeAllowance.Merge(m, src)
+}
+func (m *MsgGrantBridgeFeeAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantBridgeFeeAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantBridgeFeeAllowance.DiscardUnknown(m)
 }
 
-func _Msg_SubmitEthereumTxConfirmation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitEthereumTxConfirmation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitEthereumTxConfirmation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Msg/SubmitEthereumTxConfirmation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitEthereumTxConfirmation(ctx, req.(*MsgSubmitEthereumTxConfirmation))
+var xxx_messageInfo_MsgGrantBridgeFeeAllowance proto.InternalMessageInfo
+
+func (m *MsgGrantBridgeFeeAllowance) GetGranter() string {
+	if m != nil {
+		return m.Granter
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitEthereumEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitEthereumEvent)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitEthereumEvent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Msg/SubmitEthereumEvent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitEthereumEvent(ctx, req.(*MsgSubmitEthereumEvent))
+func (m *MsgGrantBridgeFeeAllowance) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetDelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegateKeys)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetDelegateKeys(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Msg/SetDelegateKeys",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetDelegateKeys(ctx, req.(*MsgDelegateKeys))
+func (m *MsgGrantBridgeFeeAllowance) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_SubmitEthereumHeightVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEthereumHeightVote)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitEthereumHeightVote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Msg/SubmitEthereumHeightVote",
+type MsgGrantBridgeFeeAllowanceResponse struct {
+}
+
+func (m *MsgGrantBridgeFeeAllowanceResponse) Reset()         { *m = MsgGrantBridgeFeeAllowanceResponse{} }
+func (m *MsgGrantBridgeFeeAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantBridgeFeeAllowanceResponse) ProtoMessage()    {}
+func (*MsgGrantBridgeFeeAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{38}
+}
+func (m *MsgGrantBridgeFeeAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantBridgeFeeAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantBridgeFeeAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitEthereumHeightVote(ctx, req.(*MsgEthereumHeightVote))
+}
+func (m *MsgGrantBridgeFeeAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantBridgeFeeAllowanceResponse.Merge(m, src)
+}
+func (m *MsgGrantBridgeFeeAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantBridgeFeeAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantBridgeFeeAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantBridgeFeeAllowanceResponse proto.InternalMessageInfo
+
+// MsgRevokeBridgeFeeAllowance removes the bridge fee allowance the granter
+// granted the grantee. Fees already paid stay with the sends they paid for.
+type MsgRevokeBridgeFeeAllowance struct {
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (m *MsgRevokeBridgeFeeAllowance) Reset()         { *m = MsgRevokeBridgeFeeAllowance{} }
+func (m *MsgRevokeBridgeFeeAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeBridgeFeeAllowance) ProtoMessage()    {}
+func (*MsgRevokeBridgeFeeAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{39}
+}
+func (m *MsgRevokeBridgeFeeAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeBridgeFeeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeBridgeFeeAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRevokeBridgeFeeAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeBridgeFeeAllowance.Merge(m, src)
+}
+func (m *MsgRevokeBridgeFeeAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeBridgeFeeAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeBridgeFeeAllowance.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "gravity.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SendToEthereum",
-			Handler:    _Msg_SendToEthereum_Handler,
-		},
-		{
-			MethodName: "CancelSendToEthereum",
-			Handler:    _Msg_CancelSendToEthereum_Handler,
-		},
-		{
-			MethodName: "RequestBatchTx",
-			Handler:    _Msg_RequestBatchTx_Handler,
-		},
-		{
-			MethodName: "SubmitEthereumTxConfirmation",
-			Handler:    _Msg_SubmitEthereumTxConfirmation_Handler,
-		},
-		{
-			MethodName: "SubmitEthereumEvent",
-			Handler:    _Msg_SubmitEthereumEvent_Handler,
-		},
-		{
-			MethodName: "SetDelegateKeys",
-			Handler:    _Msg_SetDelegateKeys_Handler,
-		},
-		{
-			MethodName: "SubmitEthereumHeightVote",
-			Handler:    _Msg_SubmitEthereumHeightVote_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "gravity/v1/msgs.proto",
+var xxx_messageInfo_MsgRevokeBridgeFeeAllowance proto.InternalMessageInfo
+
+func (m *MsgRevokeBridgeFeeAllowance) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
 }
 
-func (m *MsgSendToEthereum) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeBridgeFeeAllowance) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSendToEthereum) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRevokeBridgeFeeAllowanceResponse struct {
 }
 
-func (m *MsgSendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRevokeBridgeFeeAllowanceResponse) Reset()         { *m = MsgRevokeBridgeFeeAllowanceResponse{} }
+func (m *MsgRevokeBridgeFeeAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeBridgeFeeAllowanceResponse) ProtoMessage()    {}
+func (*MsgRevokeBridgeFeeAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{40}
+}
+func (m *MsgRevokeBridgeFeeAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeBridgeFeeAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeBridgeFeeAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.EthereumRecipient) > 0 {
-		i -= len(m.EthereumRecipient)
-		copy(dAtA[i:], m.EthereumRecipient)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumRecipient)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSendToEthereumResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokeBridgeFeeAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeBridgeFeeAllowanceResponse.Merge(m, src)
+}
+func (m *MsgRevokeBridgeFeeAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeBridgeFeeAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeBridgeFeeAllowanceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSendToEthereumResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevokeBridgeFeeAllowanceResponse proto.InternalMessageInfo
+
+// MsgSubmitBadEthereumSignatureEvidence submits the signature of a validator's
+// ethereum key over the checkpoint of an outgoing tx the chain never created.
+// Anyone can submit it, the validators signing with the ethereum signer are
+// slashed and jailed if the signature is over that checkpoint.
+type MsgSubmitBadEthereumSignatureEvidence struct {
+	Subject        *types1.Any `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Signature      []byte      `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	EthereumSigner string      `protobuf:"bytes,3,opt,name=ethereum_signer,json=ethereumSigner,proto3" json:"ethereum_signer,omitempty"`
+	Signer         string      `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgSendToEthereumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgSubmitBadEthereumSignatureEvidence) Reset()         { *m = MsgSubmitBadEthereumSignatureEvidence{} }
+func (m *MsgSubmitBadEthereumSignatureEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBadEthereumSignatureEvidence) ProtoMessage()    {}
+func (*MsgSubmitBadEthereumSignatureEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{41}
+}
+func (m *MsgSubmitBadEthereumSignatureEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBadEthereumSignatureEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBadEthereumSignatureEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSubmitBadEthereumSignatureEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBadEthereumSignatureEvidence.Merge(m, src)
+}
+func (m *MsgSubmitBadEthereumSignatureEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBadEthereumSignatureEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBadEthereumSignatureEvidence.DiscardUnknown(m)
 }
 
-func (m *MsgCancelSendToEthereum) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitBadEthereumSignatureEvidence proto.InternalMessageInfo
+
+type MsgSubmitBadEthereumSignatureEvidenceResponse struct {
+}
+
+func (m *MsgSubmitBadEthereumSignatureEvidenceResponse) Reset() {
+	*m = MsgSubmitBadEthereumSignatureEvidenceResponse{}
+}
+func (m *MsgSubmitBadEthereumSignatureEvidenceResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSubmitBadEthereumSignatureEvidenceResponse) ProtoMessage() {}
+func (*MsgSubmitBadEthereumSignatureEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{42}
+}
+func (m *MsgSubmitBadEthereumSignatureEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBadEthereumSignatureEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBadEthereumSignatureEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSubmitBadEthereumSignatureEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBadEthereumSignatureEvidenceResponse.Merge(m, src)
+}
+func (m *MsgSubmitBadEthereumSignatureEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBadEthereumSignatureEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBadEthereumSignatureEvidenceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelSendToEthereum) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSubmitBadEthereumSignatureEvidenceResponse proto.InternalMessageInfo
+
+// SendToCosmosEvent is submitted when the SendToCosmosEvent is emitted by they
+// gravity contract. ERC20 representation coins are minted to the cosmosreceiver
+// address.
+type SendToCosmosEvent struct {
+	EventNonce     uint64                                 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	TokenContract  string                                 `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	EthereumSender string                                 `protobuf:"bytes,4,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	CosmosReceiver string                                 `protobuf:"bytes,5,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	EthereumHeight uint64                                 `protobuf:"varint,6,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	// ibc_forward optionally routes the deposit on to another chain over IBC,
+	// encoded as "<source-channel>:<receiver>", e.g. "channel-3:cosmos1...". A
+	// malformed forward leaves the deposit with the cosmos receiver.
+	IbcForward string `protobuf:"bytes,7,opt,name=ibc_forward,json=ibcForward,proto3" json:"ibc_forward,omitempty"`
+	// memo optionally routes the credited deposit to the memo handler a module
+	// registered, as a JSON object with the handler route as its only key, e.g.
+	// {"swap": {...}}. It is emitted by the SendToCosmosWithMemoEvent of
+	// Gravity.sol. A deposit with both an ibc forward and a memo is left with the
+	// cosmos receiver.
+	Memo []byte `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+	// hash of the ethereum transaction that emitted the event, which indexes
+	// the event once observed
+	EthereumTxHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,9,opt,name=ethereum_tx_hash,json=ethereumTxHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"ethereum_tx_hash,omitempty"`
+	// index of the log of the event in the block of the ethereum transaction,
+	// which together with the tx hash identifies the event
+	EthereumLogIndex uint64 `protobuf:"varint,10,opt,name=ethereum_log_index,json=ethereumLogIndex,proto3" json:"ethereum_log_index,omitempty"`
+	// amount the balance of the gravity contract in the token grew by with the
+	// deposit, which is less than the amount for tokens taking a fee on
+	// transfer. Deposits of fee on transfer tokens credit this amount instead.
+	ReceivedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=received_amount,json=receivedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"received_amount"`
+	// whether the event carries its log index, which can be zero for the first
+	// log of a block. Only the events carrying it are guarded against being
+	// observed twice by their tx hash and log index.
+	HasEthereumLogIndex bool `protobuf:"varint,12,opt,name=has_ethereum_log_index,json=hasEthereumLogIndex,proto3" json:"has_ethereum_log_index,omitempty"`
+	// hash of the ethereum block the event was emitted in, checked against the
+	// agreed ethereum headers while ethereum header validation is enabled
+	EthereumBlockHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,13,opt,name=ethereum_block_hash,json=ethereumBlockHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"ethereum_block_hash,omitempty"`
 }
 
-func (m *MsgCancelSendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *SendToCosmosEvent) Reset()         { *m = SendToCosmosEvent{} }
+func (m *SendToCosmosEvent) String() string { return proto.CompactTextString(m) }
+func (*SendToCosmosEvent) ProtoMessage()    {}
+func (*SendToCosmosEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{43}
+}
+func (m *SendToCosmosEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendToCosmosEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendToCosmosEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SendToCosmosEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendToCosmosEvent.Merge(m, src)
+}
+func (m *SendToCosmosEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendToCosmosEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendToCosmosEvent.DiscardUnknown(m)
 }
 
-func (m *MsgCancelSendToEthereumResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SendToCosmosEvent proto.InternalMessageInfo
+
+func (m *SendToCosmosEvent) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCancelSendToEthereumResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SendToCosmosEvent) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
 }
 
-func (m *MsgCancelSendToEthereumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *SendToCosmosEvent) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
+	}
+	return ""
 }
 
-func (m *MsgRequestBatchTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SendToCosmosEvent) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRequestBatchTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SendToCosmosEvent) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
+	}
+	return 0
 }
 
-func (m *MsgRequestBatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *SendToCosmosEvent) GetIbcForward() string {
+	if m != nil {
+		return m.IbcForward
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRequestBatchTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SendToCosmosEvent) GetMemo() []byte {
+	if m != nil {
+		return m.Memo
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgRequestBatchTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SendToCosmosEvent) GetEthereumTxHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EthereumTxHash
+	}
+	return nil
 }
 
-func (m *MsgRequestBatchTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *SendToCosmosEvent) GetEthereumLogIndex() uint64 {
+	if m != nil {
+		return m.EthereumLogIndex
+	}
+	return 0
 }
 
-func (m *MsgSubmitEthereumTxConfirmation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SendToCosmosEvent) GetHasEthereumLogIndex() bool {
+	if m != nil {
+		return m.HasEthereumLogIndex
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgSubmitEthereumTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SendToCosmosEvent) GetEthereumBlockHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EthereumBlockHash
+	}
+	return nil
 }
 
-func (m *MsgSubmitEthereumTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Confirmation != nil {
-		{
-			size, err := m.Confirmation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgs(dAtA, i, uint64(size))
+// SendEtherToCosmosEvent is submitted when native ether is deposited through
+// the payable function of the gravity contract. There is no ERC20 contract,
+// vouchers of the native ether denom are minted to the cosmos receiver.
+type SendEtherToCosmosEvent struct {
+	EventNonce     uint64                                 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	EthereumSender string                                 `protobuf:"bytes,3,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	CosmosReceiver string                                 `protobuf:"bytes,4,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	EthereumHeight uint64                                 `protobuf:"varint,5,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	// hash of the ethereum transaction that emitted the event, which indexes
+	// the event once observed
+	EthereumTxHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,6,opt,name=ethereum_tx_hash,json=ethereumTxHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"ethereum_tx_hash,omitempty"`
+	// index of the log of the event in the block of the ethereum transaction,
+	// which together with the tx hash identifies the event
+	EthereumLogIndex uint64 `protobuf:"varint,7,opt,name=ethereum_log_index,json=ethereumLogIndex,proto3" json:"ethereum_log_index,omitempty"`
+	// whether the event carries its log index, which can be zero for the first
+	// log of a block. Only the events carrying it are guarded against being
+	// observed twice by their tx hash and log index.
+	HasEthereumLogIndex bool `protobuf:"varint,8,opt,name=has_ethereum_log_index,json=hasEthereumLogIndex,proto3" json:"has_ethereum_log_index,omitempty"`
+	// hash of the ethereum block the event was emitted in, checked against the
+	// agreed ethereum headers while ethereum header validation is enabled
+	EthereumBlockHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,9,opt,name=ethereum_block_hash,json=ethereumBlockHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"ethereum_block_hash,omitempty"`
+}
+
+func (m *SendEtherToCosmosEvent) Reset()         { *m = SendEtherToCosmosEvent{} }
+func (m *SendEtherToCosmosEvent) String() string { return proto.CompactTextString(m) }
+func (*SendEtherToCosmosEvent) ProtoMessage()    {}
+func (*SendEtherToCosmosEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{44}
+}
+func (m *SendEtherToCosmosEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendEtherToCosmosEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendEtherToCosmosEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SendEtherToCosmosEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendEtherToCosmosEvent.Merge(m, src)
+}
+func (m *SendEtherToCosmosEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendEtherToCosmosEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendEtherToCosmosEvent.DiscardUnknown(m)
 }
 
-func (m *ContractCallTxConfirmation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SendEtherToCosmosEvent proto.InternalMessageInfo
+
+func (m *SendEtherToCosmosEvent) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ContractCallTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SendEtherToCosmosEvent) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
+	}
+	return ""
 }
 
-func (m *ContractCallTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.EthereumSigner) > 0 {
-		i -= len(m.EthereumSigner)
-		copy(dAtA[i:], m.EthereumSigner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSigner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.InvalidationNonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.InvalidationScope) > 0 {
-		i -= len(m.InvalidationScope)
-		copy(dAtA[i:], m.InvalidationScope)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.InvalidationScope)))
-		i--
-		dAtA[i] = 0xa
+func (m *SendEtherToCosmosEvent) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *BatchTxConfirmation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SendEtherToCosmosEvent) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BatchTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SendEtherToCosmosEvent) GetEthereumTxHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EthereumTxHash
+	}
+	return nil
 }
 
-func (m *BatchTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.EthereumSigner) > 0 {
-		i -= len(m.EthereumSigner)
-		copy(dAtA[i:], m.EthereumSigner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSigner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.BatchNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.BatchNonce))
-		i--
-		dAtA[i] = 0x10
+func (m *SendEtherToCosmosEvent) GetEthereumLogIndex() uint64 {
+	if m != nil {
+		return m.EthereumLogIndex
 	}
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *SendEtherToCosmosEvent) GetHasEthereumLogIndex() bool {
+	if m != nil {
+		return m.HasEthereumLogIndex
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *SignerSetTxConfirmation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SendEtherToCosmosEvent) GetEthereumBlockHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EthereumBlockHash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SignerSetTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BatchExecutedEvent claims that a batch of BatchTxExecutedal operations on the
+// bridge contract was executed successfully on ETH
+type BatchExecutedEvent struct {
+	TokenContract  string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	EventNonce     uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	EthereumHeight uint64 `protobuf:"varint,3,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	BatchNonce     uint64 `protobuf:"varint,4,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	// ethereum address that submitted the batch, rewarded from the relayer
+	// reward pool through its orchestrator
+	Relayer string `protobuf:"bytes,5,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// hash of the ethereum transaction that emitted the event, which indexes
+	// the event once observed
+	EthereumTxHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,6,opt,name=ethereum_tx_hash,json=ethereumTxHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"ethereum_tx_hash,omitempty"`
+	// index of the log of the event in the block of the ethereum transaction,
+	// which together with the tx hash identifies the event
+	EthereumLogIndex uint64 `protobuf:"varint,7,opt,name=ethereum_log_index,json=ethereumLogIndex,proto3" json:"ethereum_log_index,omitempty"`
+	// whether the event carries its log index, which can be zero for the first
+	// log of a block. Only the events carrying it are guarded against being
+	// observed twice by their tx hash and log index.
+	HasEthereumLogIndex bool `protobuf:"varint,8,opt,name=has_ethereum_log_index,json=hasEthereumLogIndex,proto3" json:"has_ethereum_log_index,omitempty"`
+	// hash of the ethereum block the event was emitted in, checked against the
+	// agreed ethereum headers while ethereum header validation is enabled
+	EthereumBlockHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,9,opt,name=ethereum_block_hash,json=ethereumBlockHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"ethereum_block_hash,omitempty"`
 }
 
-func (m *SignerSetTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.EthereumSigner) > 0 {
-		i -= len(m.EthereumSigner)
-		copy(dAtA[i:], m.EthereumSigner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSigner)))
-		i--
-		dAtA[i] = 0x12
+func (m *BatchExecutedEvent) Reset()         { *m = BatchExecutedEvent{} }
+func (m *BatchExecutedEvent) String() string { return proto.CompactTextString(m) }
+func (*BatchExecutedEvent) ProtoMessage()    {}
+func (*BatchExecutedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{45}
+}
+func (m *BatchExecutedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchExecutedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.SignerSetNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SignerSetNonce))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *BatchExecutedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchExecutedEvent.Merge(m, src)
+}
+func (m *BatchExecutedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchExecutedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchExecutedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchExecutedEvent proto.InternalMessageInfo
+
+func (m *BatchExecutedEvent) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSubmitEthereumTxConfirmationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchExecutedEvent) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSubmitEthereumTxConfirmationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchExecutedEvent) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
+	}
+	return 0
 }
 
-func (m *MsgSubmitEthereumTxConfirmationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *BatchExecutedEvent) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
 }
 
-func (m *MsgSubmitEthereumEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchExecutedEvent) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSubmitEthereumEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchExecutedEvent) GetEthereumTxHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EthereumTxHash
+	}
+	return nil
 }
 
-func (m *MsgSubmitEthereumEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Event != nil {
-		{
-			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgs(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *BatchExecutedEvent) GetEthereumLogIndex() uint64 {
+	if m != nil {
+		return m.EthereumLogIndex
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgSubmitEthereumEventResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchExecutedEvent) GetHasEthereumLogIndex() bool {
+	if m != nil {
+		return m.HasEthereumLogIndex
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgSubmitEthereumEventResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchExecutedEvent) GetEthereumBlockHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EthereumBlockHash
+	}
+	return nil
 }
 
-func (m *MsgSubmitEthereumEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// NOTE: bytes.HexBytes is supposed to "help" with json encoding/decoding
+// investigate?
+type ContractCallExecutedEvent struct {
+	EventNonce        uint64                                               `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	InvalidationScope github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=invalidation_scope,json=invalidationScope,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"invalidation_scope,omitempty"`
+	InvalidationNonce uint64                                               `protobuf:"varint,3,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	EthereumHeight    uint64                                               `protobuf:"varint,4,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	// whether the call to the logic contract succeeded
+	Success bool `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// keccak256 hash of the data returned by the logic contract, if any
+	ReturnDataHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,6,opt,name=return_data_hash,json=returnDataHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"return_data_hash,omitempty"`
+	// hash of the ethereum transaction that emitted the event, which indexes
+	// the event once observed
+	EthereumTxHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,7,opt,name=ethereum_tx_hash,json=ethereumTxHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"ethereum_tx_hash,omitempty"`
+	// index of the log of the event in the block of the ethereum transaction,
+	// which together with the tx hash identifies the event
+	EthereumLogIndex uint64 `protobuf:"varint,8,opt,name=ethereum_log_index,json=ethereumLogIndex,proto3" json:"ethereum_log_index,omitempty"`
+	// whether the event carries its log index, which can be zero for the first
+	// log of a block. Only the events carrying it are guarded against being
+	// observed twice by their tx hash and log index.
+	HasEthereumLogIndex bool `protobuf:"varint,9,opt,name=has_ethereum_log_index,json=hasEthereumLogIndex,proto3" json:"has_ethereum_log_index,omitempty"`
+	// hash of the ethereum block the event was emitted in, checked against the
+	// agreed ethereum headers while ethereum header validation is enabled
+	EthereumBlockHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,10,opt,name=ethereum_block_hash,json=ethereumBlockHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"ethereum_block_hash,omitempty"`
 }
 
-func (m *MsgDelegateKeys) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ContractCallExecutedEvent) Reset()         { *m = ContractCallExecutedEvent{} }
+func (m *ContractCallExecutedEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractCallExecutedEvent) ProtoMessage()    {}
+func (*ContractCallExecutedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{46}
 }
-
-func (m *MsgDelegateKeys) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContractCallExecutedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgDelegateKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthSignature) > 0 {
-		i -= len(m.EthSignature)
-		copy(dAtA[i:], m.EthSignature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.EthereumAddress) > 0 {
-		i -= len(m.EthereumAddress)
-		copy(dAtA[i:], m.EthereumAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OrchestratorAddress) > 0 {
-		i -= len(m.OrchestratorAddress)
-		copy(dAtA[i:], m.OrchestratorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OrchestratorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *ContractCallExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCallExecutedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgDelegateKeysResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ContractCallExecutedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallExecutedEvent.Merge(m, src)
 }
-
-func (m *MsgDelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContractCallExecutedEvent) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgDelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ContractCallExecutedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallExecutedEvent.DiscardUnknown(m)
 }
 
-func (m *DelegateKeysSignMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ContractCallExecutedEvent proto.InternalMessageInfo
+
+func (m *ContractCallExecutedEvent) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DelegateKeysSignMsg) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContractCallExecutedEvent) GetInvalidationScope() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.InvalidationScope
+	}
+	return nil
 }
 
-func (m *DelegateKeysSignMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *ContractCallExecutedEvent) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgEthereumHeightVote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractCallExecutedEvent) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgEthereumHeightVote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContractCallExecutedEvent) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
 }
 
-func (m *MsgEthereumHeightVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.EthereumHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
-		i--
-		dAtA[i] = 0x8
+func (m *ContractCallExecutedEvent) GetReturnDataHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.ReturnDataHash
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgEthereumHeightVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractCallExecutedEvent) GetEthereumTxHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EthereumTxHash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgEthereumHeightVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContractCallExecutedEvent) GetEthereumLogIndex() uint64 {
+	if m != nil {
+		return m.EthereumLogIndex
+	}
+	return 0
 }
 
-func (m *MsgEthereumHeightVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ContractCallExecutedEvent) GetHasEthereumLogIndex() bool {
+	if m != nil {
+		return m.HasEthereumLogIndex
+	}
+	return false
 }
 
-func (m *SendToCosmosEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractCallExecutedEvent) GetEthereumBlockHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EthereumBlockHash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SendToCosmosEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ERC20DeployedEvent is submitted when an ERC20 contract
+// for a Cosmos SDK coin has been deployed on Ethereum.
+type ERC20DeployedEvent struct {
+	EventNonce     uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	CosmosDenom    string `protobuf:"bytes,2,opt,name=cosmos_denom,json=cosmosDenom,proto3" json:"cosmos_denom,omitempty"`
+	TokenContract  string `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Erc20Name      string `protobuf:"bytes,4,opt,name=erc20_name,json=erc20Name,proto3" json:"erc20_name,omitempty"`
+	Erc20Symbol    string `protobuf:"bytes,5,opt,name=erc20_symbol,json=erc20Symbol,proto3" json:"erc20_symbol,omitempty"`
+	Erc20Decimals  uint64 `protobuf:"varint,6,opt,name=erc20_decimals,json=erc20Decimals,proto3" json:"erc20_decimals,omitempty"`
+	EthereumHeight uint64 `protobuf:"varint,7,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	// hash of the ethereum transaction that emitted the event, which indexes
+	// the event once observed
+	EthereumTxHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,8,opt,name=ethereum_tx_hash,json=ethereumTxHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"ethereum_tx_hash,omitempty"`
+	// index of the log of the event in the block of the ethereum transaction,
+	// which together with the tx hash identifies the event
+	EthereumLogIndex uint64 `protobuf:"varint,9,opt,name=ethereum_log_index,json=ethereumLogIndex,proto3" json:"ethereum_log_index,omitempty"`
+	// whether the event carries its log index, which can be zero for the first
+	// log of a block. Only the events carrying it are guarded against being
+	// observed twice by their tx hash and log index.
+	HasEthereumLogIndex bool `protobuf:"varint,10,opt,name=has_ethereum_log_index,json=hasEthereumLogIndex,proto3" json:"has_ethereum_log_index,omitempty"`
+	// hash of the ethereum block the event was emitted in, checked against the
+	// agreed ethereum headers while ethereum header validation is enabled
+	EthereumBlockHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,11,opt,name=ethereum_block_hash,json=ethereumBlockHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"ethereum_block_hash,omitempty"`
 }
 
-func (m *SendToCosmosEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EthereumHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.CosmosReceiver) > 0 {
-		i -= len(m.CosmosReceiver)
-		copy(dAtA[i:], m.CosmosReceiver)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosReceiver)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.EthereumSender) > 0 {
-		i -= len(m.EthereumSender)
-		copy(dAtA[i:], m.EthereumSender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSender)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ERC20DeployedEvent) Reset()         { *m = ERC20DeployedEvent{} }
+func (m *ERC20DeployedEvent) String() string { return proto.CompactTextString(m) }
+func (*ERC20DeployedEvent) ProtoMessage()    {}
+func (*ERC20DeployedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{47}
+}
+func (m *ERC20DeployedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20DeployedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20DeployedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ERC20DeployedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20DeployedEvent.Merge(m, src)
+}
+func (m *ERC20DeployedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20DeployedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20DeployedEvent.DiscardUnknown(m)
 }
 
-func (m *BatchExecutedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ERC20DeployedEvent proto.InternalMessageInfo
+
+func (m *ERC20DeployedEvent) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BatchExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ERC20DeployedEvent) GetCosmosDenom() string {
+	if m != nil {
+		return m.CosmosDenom
+	}
+	return ""
 }
 
-func (m *BatchExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BatchNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.BatchNonce))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.EthereumHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
-		i--
-		dAtA[i] = 0x10
+func (m *ERC20DeployedEvent) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ERC20DeployedEvent) GetErc20Name() string {
+	if m != nil {
+		return m.Erc20Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ContractCallExecutedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ERC20DeployedEvent) GetErc20Symbol() string {
+	if m != nil {
+		return m.Erc20Symbol
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ContractCallExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ERC20DeployedEvent) GetErc20Decimals() uint64 {
+	if m != nil {
+		return m.Erc20Decimals
+	}
+	return 0
 }
 
-func (m *ContractCallExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EthereumHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
-		i--
-		dAtA[i] = 0x20
+func (m *ERC20DeployedEvent) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
 	}
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.InvalidationNonce))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *ERC20DeployedEvent) GetEthereumTxHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EthereumTxHash
 	}
-	if len(m.InvalidationScope) > 0 {
-		i -= len(m.InvalidationScope)
-		copy(dAtA[i:], m.InvalidationScope)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.InvalidationScope)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *ERC20DeployedEvent) GetEthereumLogIndex() uint64 {
+	if m != nil {
+		return m.EthereumLogIndex
 	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ERC20DeployedEvent) GetHasEthereumLogIndex() bool {
+	if m != nil {
+		return m.HasEthereumLogIndex
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *ERC20DeployedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ERC20DeployedEvent) GetEthereumBlockHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EthereumBlockHash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ERC20DeployedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// This informs the Cosmos module that a validator
+// set has been updated.
+type SignerSetTxExecutedEvent struct {
+	EventNonce       uint64            `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	SignerSetTxNonce uint64            `protobuf:"varint,2,opt,name=signer_set_tx_nonce,json=signerSetTxNonce,proto3" json:"signer_set_tx_nonce,omitempty"`
+	EthereumHeight   uint64            `protobuf:"varint,3,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	Members          []*EthereumSigner `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	// hash of the ethereum transaction that emitted the event, which indexes
+	// the event once observed
+	EthereumTxHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,5,opt,name=ethereum_tx_hash,json=ethereumTxHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"ethereum_tx_hash,omitempty"`
+	// index of the log of the event in the block of the ethereum transaction,
+	// which together with the tx hash identifies the event
+	EthereumLogIndex uint64 `protobuf:"varint,6,opt,name=ethereum_log_index,json=ethereumLogIndex,proto3" json:"ethereum_log_index,omitempty"`
+	// whether the event carries its log index, which can be zero for the first
+	// log of a block. Only the events carrying it are guarded against being
+	// observed twice by their tx hash and log index.
+	HasEthereumLogIndex bool `protobuf:"varint,7,opt,name=has_ethereum_log_index,json=hasEthereumLogIndex,proto3" json:"has_ethereum_log_index,omitempty"`
+	// hash of the ethereum block the event was emitted in, checked against the
+	// agreed ethereum headers while ethereum header validation is enabled
+	EthereumBlockHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,8,opt,name=ethereum_block_hash,json=ethereumBlockHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"ethereum_block_hash,omitempty"`
 }
 
-func (m *ERC20DeployedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EthereumHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Erc20Decimals != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Erc20Decimals))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Erc20Symbol) > 0 {
-		i -= len(m.Erc20Symbol)
-		copy(dAtA[i:], m.Erc20Symbol)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Erc20Symbol)))
-		i--
-		dAtA[i] = 0x2a
+func (m *SignerSetTxExecutedEvent) Reset()         { *m = SignerSetTxExecutedEvent{} }
+func (m *SignerSetTxExecutedEvent) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxExecutedEvent) ProtoMessage()    {}
+func (*SignerSetTxExecutedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{48}
+}
+func (m *SignerSetTxExecutedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSetTxExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetTxExecutedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Erc20Name) > 0 {
-		i -= len(m.Erc20Name)
-		copy(dAtA[i:], m.Erc20Name)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Erc20Name)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CosmosDenom) > 0 {
-		i -= len(m.CosmosDenom)
-		copy(dAtA[i:], m.CosmosDenom)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *SignerSetTxExecutedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxExecutedEvent.Merge(m, src)
+}
+func (m *SignerSetTxExecutedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSetTxExecutedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxExecutedEvent.DiscardUnknown(m)
 }
 
-func (m *SignerSetTxExecutedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SignerSetTxExecutedEvent proto.InternalMessageInfo
+
+func (m *SignerSetTxExecutedEvent) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SignerSetTxExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SignerSetTxExecutedEvent) GetSignerSetTxNonce() uint64 {
+	if m != nil {
+		return m.SignerSetTxNonce
+	}
+	return 0
 }
 
-func (m *SignerSetTxExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMsgs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.EthereumHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SignerSetTxNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.SignerSetTxNonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
-		i--
-		dAtA[i] = 0x8
+func (m *SignerSetTxExecutedEvent) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SignerSetTxExecutedEvent) GetMembers() []*EthereumSigner {
+	if m != nil {
+		return m.Members
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgSendToEthereum) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.EthereumRecipient)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+
+func (m *SignerSetTxExecutedEvent) GetEthereumTxHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EthereumTxHash
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = m.BridgeFee.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	return n
+	return nil
 }
 
-func (m *MsgSendToEthereumResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMsgs(uint64(m.Id))
+func (m *SignerSetTxExecutedEvent) GetEthereumLogIndex() uint64 {
+	if m != nil {
+		return m.EthereumLogIndex
 	}
-	return n
+	return 0
 }
 
-func (m *MsgCancelSendToEthereum) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMsgs(uint64(m.Id))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+func (m *SignerSetTxExecutedEvent) GetHasEthereumLogIndex() bool {
+	if m != nil {
+		return m.HasEthereumLogIndex
 	}
-	return n
+	return false
 }
 
-func (m *MsgCancelSendToEthereumResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignerSetTxExecutedEvent) GetEthereumBlockHash() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.EthereumBlockHash
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *MsgRequestBatchTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+// MsgOptOutOfBridge opts a validator out of the bridge duties. Its power is
+// left out of the signer sets and it is not slashed for missing signatures,
+// but the orchestrator of its ethereum address is not paid relayer rewards.
+type MsgOptOutOfBridge struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *MsgRequestBatchTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgOptOutOfBridge) Reset()         { *m = MsgOptOutOfBridge{} }
+func (m *MsgOptOutOfBridge) String() string { return proto.CompactTextString(m) }
+func (*MsgOptOutOfBridge) ProtoMessage()    {}
+func (*MsgOptOutOfBridge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{49}
+}
+func (m *MsgOptOutOfBridge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOptOutOfBridge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOptOutOfBridge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgOptOutOfBridge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOptOutOfBridge.Merge(m, src)
+}
+func (m *MsgOptOutOfBridge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOptOutOfBridge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOptOutOfBridge.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitEthereumTxConfirmation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Confirmation != nil {
-		l = m.Confirmation.Size()
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+var xxx_messageInfo_MsgOptOutOfBridge proto.InternalMessageInfo
+
+func (m *MsgOptOutOfBridge) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return n
+	return ""
 }
 
-func (m *ContractCallTxConfirmation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.InvalidationScope)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
-	}
-	l = len(m.EthereumSigner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+type MsgOptOutOfBridgeResponse struct {
 }
 
-func (m *BatchTxConfirmation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgOptOutOfBridgeResponse) Reset()         { *m = MsgOptOutOfBridgeResponse{} }
+func (m *MsgOptOutOfBridgeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOptOutOfBridgeResponse) ProtoMessage()    {}
+func (*MsgOptOutOfBridgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{50}
+}
+func (m *MsgOptOutOfBridgeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOptOutOfBridgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOptOutOfBridgeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.BatchNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.BatchNonce))
-	}
-	l = len(m.EthereumSigner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
 }
-
-func (m *SignerSetTxConfirmation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignerSetNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.SignerSetNonce))
-	}
-	l = len(m.EthereumSigner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+func (m *MsgOptOutOfBridgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOptOutOfBridgeResponse.Merge(m, src)
+}
+func (m *MsgOptOutOfBridgeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOptOutOfBridgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOptOutOfBridgeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitEthereumTxConfirmationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgOptOutOfBridgeResponse proto.InternalMessageInfo
+
+// MsgFreezeOrchestrator freezes the delegate keys of a validator whose
+// orchestrator or ethereum key is compromised. Its orchestrator and ethereum
+// address are removed at once, so the signatures and events of the keys are
+// rejected, without unbonding the validator. New delegate keys can only be set
+// once the orchestrator freeze cooldown has passed. It must be signed by the
+// validator operator.
+type MsgFreezeOrchestrator struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *MsgSubmitEthereumEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+func (m *MsgFreezeOrchestrator) Reset()         { *m = MsgFreezeOrchestrator{} }
+func (m *MsgFreezeOrchestrator) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeOrchestrator) ProtoMessage()    {}
+func (*MsgFreezeOrchestrator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{51}
+}
+func (m *MsgFreezeOrchestrator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeOrchestrator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeOrchestrator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgFreezeOrchestrator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeOrchestrator.Merge(m, src)
+}
+func (m *MsgFreezeOrchestrator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeOrchestrator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeOrchestrator.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitEthereumEventResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgFreezeOrchestrator proto.InternalMessageInfo
+
+func (m *MsgFreezeOrchestrator) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgDelegateKeys) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.OrchestratorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.EthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.EthSignature)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+type MsgFreezeOrchestratorResponse struct {
 }
 
-func (m *MsgDelegateKeysResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFreezeOrchestratorResponse) Reset()         { *m = MsgFreezeOrchestratorResponse{} }
+func (m *MsgFreezeOrchestratorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeOrchestratorResponse) ProtoMessage()    {}
+func (*MsgFreezeOrchestratorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{52}
+}
+func (m *MsgFreezeOrchestratorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeOrchestratorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeOrchestratorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgFreezeOrchestratorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeOrchestratorResponse.Merge(m, src)
+}
+func (m *MsgFreezeOrchestratorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeOrchestratorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeOrchestratorResponse.DiscardUnknown(m)
 }
 
-func (m *DelegateKeysSignMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovMsgs(uint64(m.Nonce))
-	}
-	return n
+var xxx_messageInfo_MsgFreezeOrchestratorResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSendToEthereum)(nil), "gravity.v1.MsgSendToEthereum")
+	proto.RegisterType((*MsgSendToEthereumResponse)(nil), "gravity.v1.MsgSendToEthereumResponse")
+	proto.RegisterType((*MsgCancelSendToEthereum)(nil), "gravity.v1.MsgCancelSendToEthereum")
+	proto.RegisterType((*MsgCancelSendToEthereumResponse)(nil), "gravity.v1.MsgCancelSendToEthereumResponse")
+	proto.RegisterType((*MsgRequestBatchTx)(nil), "gravity.v1.MsgRequestBatchTx")
+	proto.RegisterType((*MsgRequestBatchTxResponse)(nil), "gravity.v1.MsgRequestBatchTxResponse")
+	proto.RegisterType((*MsgSubmitEthereumTxConfirmation)(nil), "gravity.v1.MsgSubmitEthereumTxConfirmation")
+	proto.RegisterType((*ContractCallTxConfirmation)(nil), "gravity.v1.ContractCallTxConfirmation")
+	proto.RegisterType((*BatchTxConfirmation)(nil), "gravity.v1.BatchTxConfirmation")
+	proto.RegisterType((*SignerSetTxConfirmation)(nil), "gravity.v1.SignerSetTxConfirmation")
+	proto.RegisterType((*MsgSubmitEthereumTxConfirmationResponse)(nil), "gravity.v1.MsgSubmitEthereumTxConfirmationResponse")
+	proto.RegisterType((*MsgSubmitEthereumEvent)(nil), "gravity.v1.MsgSubmitEthereumEvent")
+	proto.RegisterType((*MsgSubmitEthereumEventResponse)(nil), "gravity.v1.MsgSubmitEthereumEventResponse")
+	proto.RegisterType((*MsgSubmitAggregatedEthereumEvent)(nil), "gravity.v1.MsgSubmitAggregatedEthereumEvent")
+	proto.RegisterType((*EthereumEventCosignature)(nil), "gravity.v1.EthereumEventCosignature")
+	proto.RegisterType((*MsgSubmitAggregatedEthereumEventResponse)(nil), "gravity.v1.MsgSubmitAggregatedEthereumEventResponse")
+	proto.RegisterType((*MsgDelegateKeys)(nil), "gravity.v1.MsgDelegateKeys")
+	proto.RegisterType((*MsgDelegateKeysResponse)(nil), "gravity.v1.MsgDelegateKeysResponse")
+	proto.RegisterType((*DelegateKeysSignMsg)(nil), "gravity.v1.DelegateKeysSignMsg")
+	proto.RegisterType((*MsgUpdateDelegateKeys)(nil), "gravity.v1.MsgUpdateDelegateKeys")
+	proto.RegisterType((*MsgUpdateDelegateKeysResponse)(nil), "gravity.v1.MsgUpdateDelegateKeysResponse")
+	proto.RegisterType((*MsgRevokeOrchestratorKey)(nil), "gravity.v1.MsgRevokeOrchestratorKey")
+	proto.RegisterType((*MsgRevokeOrchestratorKeyResponse)(nil), "gravity.v1.MsgRevokeOrchestratorKeyResponse")
+	proto.RegisterType((*MsgEthereumHeightVote)(nil), "gravity.v1.MsgEthereumHeightVote")
+	proto.RegisterType((*MsgEthereumHeightVoteResponse)(nil), "gravity.v1.MsgEthereumHeightVoteResponse")
+	proto.RegisterType((*MsgEthereumHeaderVote)(nil), "gravity.v1.MsgEthereumHeaderVote")
+	proto.RegisterType((*MsgEthereumHeaderVoteResponse)(nil), "gravity.v1.MsgEthereumHeaderVoteResponse")
+	proto.RegisterType((*MsgERC20DeployedConfirm)(nil), "gravity.v1.MsgERC20DeployedConfirm")
+	proto.RegisterType((*MsgERC20DeployedConfirmResponse)(nil), "gravity.v1.MsgERC20DeployedConfirmResponse")
+	proto.RegisterType((*MsgEthereumAnomalyReport)(nil), "gravity.v1.MsgEthereumAnomalyReport")
+	proto.RegisterType((*MsgEthereumAnomalyReportResponse)(nil), "gravity.v1.MsgEthereumAnomalyReportResponse")
+	proto.RegisterType((*MsgRegisterOrchestratorQueryIdentity)(nil), "gravity.v1.MsgRegisterOrchestratorQueryIdentity")
+	proto.RegisterType((*MsgRegisterOrchestratorQueryIdentityResponse)(nil), "gravity.v1.MsgRegisterOrchestratorQueryIdentityResponse")
+	proto.RegisterType((*MsgExecuteAtomic)(nil), "gravity.v1.MsgExecuteAtomic")
+	proto.RegisterType((*MsgExecuteAtomicResponse)(nil), "gravity.v1.MsgExecuteAtomicResponse")
+	proto.RegisterType((*MsgVetoDelayedSendToEthereum)(nil), "gravity.v1.MsgVetoDelayedSendToEthereum")
+	proto.RegisterType((*MsgVetoDelayedSendToEthereumResponse)(nil), "gravity.v1.MsgVetoDelayedSendToEthereumResponse")
+	proto.RegisterType((*MsgGrantBridgeFeeAllowance)(nil), "gravity.v1.MsgGrantBridgeFeeAllowance")
+	proto.RegisterType((*MsgGrantBridgeFeeAllowanceResponse)(nil), "gravity.v1.MsgGrantBridgeFeeAllowanceResponse")
+	proto.RegisterType((*MsgRevokeBridgeFeeAllowance)(nil), "gravity.v1.MsgRevokeBridgeFeeAllowance")
+	proto.RegisterType((*MsgRevokeBridgeFeeAllowanceResponse)(nil), "gravity.v1.MsgRevokeBridgeFeeAllowanceResponse")
+	proto.RegisterType((*MsgSubmitBadEthereumSignatureEvidence)(nil), "gravity.v1.MsgSubmitBadEthereumSignatureEvidence")
+	proto.RegisterType((*MsgSubmitBadEthereumSignatureEvidenceResponse)(nil), "gravity.v1.MsgSubmitBadEthereumSignatureEvidenceResponse")
+	proto.RegisterType((*SendToCosmosEvent)(nil), "gravity.v1.SendToCosmosEvent")
+	proto.RegisterType((*SendEtherToCosmosEvent)(nil), "gravity.v1.SendEtherToCosmosEvent")
+	proto.RegisterType((*BatchExecutedEvent)(nil), "gravity.v1.BatchExecutedEvent")
+	proto.RegisterType((*ContractCallExecutedEvent)(nil), "gravity.v1.ContractCallExecutedEvent")
+	proto.RegisterType((*ERC20DeployedEvent)(nil), "gravity.v1.ERC20DeployedEvent")
+	proto.RegisterType((*SignerSetTxExecutedEvent)(nil), "gravity.v1.SignerSetTxExecutedEvent")
+	proto.RegisterType((*MsgOptOutOfBridge)(nil), "gravity.v1.MsgOptOutOfBridge")
+	proto.RegisterType((*MsgOptOutOfBridgeResponse)(nil), "gravity.v1.MsgOptOutOfBridgeResponse")
+	proto.RegisterType((*MsgFreezeOrchestrator)(nil), "gravity.v1.MsgFreezeOrchestrator")
+	proto.RegisterType((*MsgFreezeOrchestratorResponse)(nil), "gravity.v1.MsgFreezeOrchestratorResponse")
 }
 
-func (m *MsgEthereumHeightVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EthereumHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.EthereumHeight))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+func init() { proto.RegisterFile("gravity/v1/msgs.proto", fileDescriptor_2f8523f2f6feb451) }
+
+var fileDescriptor_2f8523f2f6feb451 = []byte{
+	// 2550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4d, 0x6c, 0xdb, 0xc8,
+	0xf5, 0x37, 0x25, 0xd9, 0xb2, 0x9f, 0x6c, 0xc7, 0xa6, 0xbd, 0x89, 0xcc, 0x24, 0xb6, 0xa3, 0xd8,
+	0xbb, 0xf6, 0x26, 0x96, 0x6c, 0x67, 0x17, 0xff, 0xfd, 0x6f, 0xd1, 0x45, 0xfd, 0x99, 0x04, 0xbb,
+	0x4e, 0xb0, 0xb4, 0xb3, 0x4d, 0x7b, 0x11, 0x28, 0x72, 0x4c, 0x31, 0x16, 0x49, 0x95, 0x33, 0xd2,
+	0x4a, 0xed, 0xad, 0x40, 0x81, 0xf6, 0xb6, 0x05, 0xda, 0xfb, 0x9e, 0x7a, 0x58, 0xb4, 0xa7, 0xe6,
+	0xdc, 0x02, 0xbd, 0x74, 0x11, 0x14, 0xe8, 0x1e, 0xdb, 0x1e, 0xd2, 0x22, 0xb9, 0xf4, 0xda, 0x4b,
+	0x0f, 0x7b, 0x2a, 0x38, 0x33, 0xa4, 0x49, 0x8a, 0x94, 0xa8, 0x5d, 0x37, 0x45, 0x81, 0x9e, 0x2c,
+	0xce, 0xfb, 0xcd, 0xfb, 0x98, 0xf7, 0x9b, 0xaf, 0x37, 0x86, 0xd7, 0x74, 0x47, 0x69, 0x1b, 0xa4,
+	0x5b, 0x69, 0x6f, 0x55, 0x4c, 0xac, 0xe3, 0x72, 0xd3, 0xb1, 0x89, 0x2d, 0x02, 0x6f, 0x2e, 0xb7,
+	0xb7, 0xa4, 0x45, 0xd5, 0xc6, 0xa6, 0x8d, 0x2b, 0x35, 0x05, 0xa3, 0x4a, 0x7b, 0xab, 0x86, 0x88,
+	0xb2, 0x55, 0x51, 0x6d, 0xc3, 0x62, 0x58, 0x69, 0x81, 0xc9, 0xab, 0xf4, 0xab, 0xc2, 0x3e, 0xb8,
+	0xa8, 0x18, 0xd0, 0xee, 0x69, 0x64, 0x92, 0x79, 0xdd, 0xd6, 0x6d, 0xd6, 0xc3, 0xfd, 0xc5, 0x5b,
+	0xaf, 0xe9, 0xb6, 0xad, 0x37, 0x50, 0x45, 0x69, 0x1a, 0x15, 0xc5, 0xb2, 0x6c, 0xa2, 0x10, 0xc3,
+	0xb6, 0x3c, 0x6d, 0x0b, 0x5c, 0x4a, 0xbf, 0x6a, 0xad, 0xd3, 0x8a, 0x62, 0x71, 0x75, 0xa5, 0x5f,
+	0x64, 0x60, 0xf6, 0x08, 0xeb, 0xc7, 0xc8, 0xd2, 0x4e, 0xec, 0x03, 0x52, 0x47, 0x0e, 0x6a, 0x99,
+	0xe2, 0x65, 0x18, 0xc3, 0xc8, 0xd2, 0x90, 0x53, 0x14, 0x96, 0x85, 0xb5, 0x09, 0x99, 0x7f, 0x89,
+	0x1b, 0x20, 0x22, 0x8e, 0xa9, 0x3a, 0x48, 0x35, 0x9a, 0x06, 0xb2, 0x48, 0x31, 0x43, 0x31, 0xb3,
+	0x9e, 0x44, 0xf6, 0x04, 0xe2, 0xff, 0xc1, 0x98, 0x62, 0xda, 0x2d, 0x8b, 0x14, 0xb3, 0xcb, 0xc2,
+	0x5a, 0x61, 0x7b, 0xa1, 0xcc, 0x83, 0x74, 0x47, 0xa4, 0xcc, 0x47, 0xa4, 0xbc, 0x67, 0x1b, 0xd6,
+	0x6e, 0xee, 0xf3, 0xe7, 0x4b, 0x23, 0x32, 0x87, 0x8b, 0xef, 0x01, 0xd4, 0x1c, 0x43, 0xd3, 0x51,
+	0xf5, 0x14, 0xa1, 0x62, 0x2e, 0x5d, 0xe7, 0x09, 0xd6, 0xe5, 0x10, 0x21, 0x71, 0x09, 0x0a, 0xa7,
+	0x08, 0x55, 0x75, 0x47, 0xb1, 0x08, 0x72, 0x8a, 0xa3, 0xd4, 0x41, 0x38, 0x45, 0xe8, 0x2e, 0x6b,
+	0x11, 0x37, 0x61, 0x1e, 0x75, 0x90, 0xda, 0x22, 0xa8, 0xaa, 0x9c, 0x12, 0xe4, 0x54, 0xeb, 0xc8,
+	0xd0, 0xeb, 0xa4, 0x38, 0xb6, 0x2c, 0xac, 0xe5, 0x64, 0x91, 0xcb, 0x76, 0x5c, 0xd1, 0x3d, 0x2a,
+	0x29, 0xdd, 0x82, 0x85, 0x9e, 0x71, 0x92, 0x11, 0x6e, 0xda, 0x16, 0x46, 0xe2, 0x34, 0x64, 0x0c,
+	0x8d, 0x8e, 0x55, 0x4e, 0xce, 0x18, 0x5a, 0x69, 0x07, 0xae, 0x1c, 0x61, 0x7d, 0x4f, 0xb1, 0x54,
+	0xd4, 0x88, 0x0c, 0x6d, 0x04, 0x1a, 0x18, 0xea, 0x4c, 0x70, 0xa8, 0x4b, 0x37, 0x60, 0x29, 0x41,
+	0x85, 0x67, 0xb5, 0xf4, 0x2b, 0x81, 0xe6, 0x4e, 0x46, 0xdf, 0x6b, 0x21, 0x4c, 0x76, 0x15, 0xa2,
+	0xd6, 0x4f, 0x3a, 0xe2, 0x3c, 0x8c, 0x6a, 0xc8, 0xb2, 0x4d, 0x9e, 0x3a, 0xf6, 0x41, 0xcd, 0x18,
+	0xba, 0x15, 0x30, 0x43, 0xbf, 0xc4, 0x1b, 0x30, 0x69, 0x2a, 0x9d, 0x2a, 0x6a, 0x20, 0x13, 0x59,
+	0x04, 0xd3, 0x44, 0xe5, 0xe4, 0x82, 0xa9, 0x74, 0x0e, 0x78, 0x93, 0x78, 0x17, 0xf2, 0xa6, 0x61,
+	0xf9, 0x99, 0x98, 0xd8, 0x2d, 0xbb, 0xc3, 0xfd, 0x97, 0xe7, 0x4b, 0xaf, 0xeb, 0x06, 0xa9, 0xb7,
+	0x6a, 0x65, 0xd5, 0x36, 0x39, 0x7b, 0xf9, 0x9f, 0x0d, 0xac, 0x9d, 0x55, 0x48, 0xb7, 0x89, 0x70,
+	0xf9, 0xbe, 0x45, 0xe4, 0x31, 0xd3, 0xb0, 0x0e, 0x11, 0x2a, 0x5d, 0x85, 0x85, 0x1e, 0x77, 0xfd,
+	0x60, 0x7e, 0x2e, 0xd0, 0x80, 0x8f, 0x5b, 0x35, 0xd3, 0x20, 0x5e, 0xa8, 0x27, 0x9d, 0x3d, 0xdb,
+	0x3a, 0x35, 0x1c, 0x93, 0xd2, 0x59, 0x3c, 0x81, 0x49, 0x35, 0xf0, 0x4d, 0x23, 0x2c, 0x6c, 0xcf,
+	0x97, 0x19, 0xbd, 0xcb, 0x1e, 0xbd, 0xcb, 0x3b, 0x56, 0x77, 0x57, 0x7a, 0xf6, 0x74, 0xe3, 0x72,
+	0xbc, 0x1e, 0x39, 0xa4, 0x25, 0x69, 0x68, 0xde, 0xcd, 0xfd, 0xf8, 0xd3, 0xa5, 0x91, 0xd2, 0x3f,
+	0x05, 0x90, 0xf6, 0x6c, 0x8b, 0x38, 0x8a, 0x4a, 0xf6, 0x94, 0x46, 0x23, 0xe2, 0xd2, 0x06, 0x88,
+	0x86, 0xd5, 0x56, 0x1a, 0x86, 0x46, 0xbf, 0xab, 0x58, 0xb5, 0x9b, 0x88, 0x3a, 0x36, 0x29, 0xcf,
+	0x06, 0x25, 0xc7, 0xae, 0xa0, 0x07, 0x6e, 0xd9, 0x96, 0x8a, 0xa8, 0xdd, 0x5c, 0x18, 0xfe, 0xc0,
+	0x15, 0x88, 0x6f, 0xc0, 0x25, 0x7f, 0xbe, 0x71, 0x1f, 0xb3, 0xd4, 0xc7, 0x69, 0xaf, 0xf9, 0x98,
+	0xa5, 0xf1, 0x1a, 0x4c, 0xb8, 0x72, 0x85, 0xb4, 0x1c, 0x96, 0xa5, 0x49, 0xf9, 0xbc, 0x41, 0xbc,
+	0x03, 0x63, 0x58, 0xad, 0x23, 0x13, 0xd1, 0x99, 0x30, 0xbd, 0x7d, 0xb5, 0x7c, 0xbe, 0x4a, 0x95,
+	0x8f, 0x3d, 0xd8, 0x31, 0x85, 0xc8, 0x1c, 0x5a, 0xfa, 0xb3, 0x00, 0x73, 0x3c, 0x49, 0xa1, 0x88,
+	0x57, 0x61, 0x9a, 0xd8, 0x67, 0xc8, 0xaa, 0xaa, 0x7c, 0x54, 0x38, 0xd1, 0xa6, 0x68, 0xab, 0x37,
+	0x54, 0xee, 0x14, 0xac, 0xb9, 0xbd, 0x43, 0x21, 0x02, 0x6d, 0xfa, 0xcf, 0xc7, 0xf6, 0x5b, 0x01,
+	0xae, 0x30, 0xed, 0xc7, 0x88, 0x44, 0xe2, 0x5b, 0x83, 0x19, 0xe6, 0x4e, 0x15, 0x23, 0xc2, 0xbd,
+	0x67, 0xd3, 0x75, 0x1a, 0x7b, 0x5d, 0x12, 0x23, 0xc8, 0x0c, 0x8e, 0x20, 0x9b, 0x1c, 0x41, 0x2e,
+	0x7d, 0x04, 0xeb, 0xf0, 0xc6, 0x80, 0xd9, 0xe2, 0xcf, 0xac, 0x16, 0x5c, 0xee, 0x81, 0x1e, 0xb4,
+	0xdd, 0xf5, 0xf9, 0x9b, 0x30, 0x8a, 0xdc, 0x1f, 0x7d, 0x27, 0xd2, 0xec, 0xb3, 0xa7, 0x1b, 0x53,
+	0xa1, 0x7e, 0x32, 0xeb, 0x35, 0x60, 0xe2, 0x2c, 0xc3, 0x62, 0xbc, 0x59, 0xdf, 0xb1, 0x3f, 0x0a,
+	0xb0, 0xec, 0x43, 0x76, 0x74, 0xdd, 0x41, 0xba, 0x42, 0x90, 0xf6, 0x2a, 0x7c, 0x14, 0x1f, 0xb8,
+	0x4b, 0x89, 0x9f, 0x03, 0x77, 0xdd, 0xcb, 0xae, 0x15, 0xb6, 0x57, 0x82, 0x43, 0x1f, 0xd2, 0xb7,
+	0x77, 0x0e, 0xe6, 0xdb, 0x4d, 0xa8, 0x3f, 0x8f, 0x19, 0x41, 0x31, 0xa9, 0x97, 0x78, 0x0b, 0x66,
+	0xf9, 0xf4, 0xb6, 0x9d, 0xaa, 0xa2, 0x69, 0x0e, 0xc2, 0x98, 0x4f, 0x9d, 0x19, 0x5f, 0xb0, 0xc3,
+	0xda, 0xc3, 0x8c, 0xc9, 0x44, 0x18, 0x53, 0x7a, 0x13, 0xd6, 0x06, 0x8d, 0x9b, 0x3f, 0xc8, 0x3f,
+	0xc9, 0xc0, 0xa5, 0x23, 0xac, 0xef, 0xa3, 0x06, 0x45, 0xbd, 0x8f, 0xba, 0x78, 0x38, 0x57, 0xb6,
+	0x60, 0xde, 0x76, 0xd4, 0x3a, 0xc2, 0xc4, 0x09, 0xe1, 0xd9, 0x78, 0xce, 0x05, 0x65, 0x5e, 0x97,
+	0x75, 0x98, 0xf1, 0x27, 0x86, 0x07, 0x67, 0x73, 0xdb, 0x9f, 0x30, 0x1e, 0xf4, 0x26, 0x4c, 0x21,
+	0x52, 0xaf, 0x46, 0x27, 0xf8, 0x24, 0x22, 0x75, 0x9f, 0xfa, 0xe2, 0x21, 0x9b, 0x92, 0xf4, 0xa3,
+	0x9a, 0x7e, 0xb6, 0x5f, 0xc2, 0xe1, 0x86, 0xd2, 0x02, 0x5c, 0x89, 0x0c, 0x85, 0x3f, 0x4c, 0x8f,
+	0x61, 0x2e, 0xd8, 0xee, 0xaa, 0x3a, 0xc2, 0xfa, 0x70, 0x23, 0x35, 0x0f, 0xa3, 0xc1, 0xc5, 0x8e,
+	0x7d, 0x94, 0x7e, 0x2f, 0xc0, 0x6b, 0x47, 0x58, 0x7f, 0xd4, 0xd4, 0x14, 0x82, 0xfe, 0x9b, 0xd3,
+	0x50, 0x5a, 0x82, 0xeb, 0xb1, 0x81, 0xf8, 0x83, 0x78, 0x17, 0x8a, 0x74, 0x83, 0x6f, 0xdb, 0x67,
+	0xe8, 0x61, 0xc0, 0xa1, 0xf7, 0x51, 0x77, 0xa8, 0x60, 0x4b, 0x25, 0x58, 0x4e, 0x52, 0x14, 0xc8,
+	0x98, 0x3b, 0xac, 0x1e, 0xe9, 0xd9, 0x29, 0xed, 0x23, 0x9b, 0x84, 0x97, 0x65, 0x7e, 0xac, 0xe3,
+	0xeb, 0x37, 0x0a, 0x81, 0x93, 0xd6, 0x06, 0x1e, 0x67, 0xaf, 0x66, 0xdf, 0xb4, 0x11, 0x31, 0xad,
+	0x68, 0xc8, 0xa1, 0xa6, 0xdf, 0x81, 0xb1, 0x3a, 0xfd, 0xe2, 0xab, 0x95, 0x14, 0xb7, 0x9e, 0x30,
+	0xbc, 0x77, 0xe2, 0x65, 0xf8, 0xd4, 0xbe, 0x78, 0xa6, 0x7c, 0x5f, 0x7e, 0x40, 0x39, 0x7d, 0x20,
+	0xef, 0x6d, 0x6f, 0xee, 0xa3, 0x66, 0xc3, 0xee, 0x22, 0x8d, 0xef, 0x02, 0xee, 0xd9, 0x8e, 0xdf,
+	0x30, 0x82, 0x07, 0xc2, 0x02, 0x6b, 0xdb, 0x77, 0x9b, 0x62, 0x36, 0xf3, 0x4c, 0xdc, 0x66, 0x7e,
+	0xee, 0x5d, 0x36, 0xe4, 0x1d, 0x3b, 0xa4, 0xc6, 0x19, 0xf7, 0xfd, 0xfb, 0x44, 0x80, 0x62, 0x20,
+	0x82, 0x1d, 0xcb, 0x36, 0x95, 0x46, 0x57, 0x46, 0x4d, 0xdb, 0x21, 0x69, 0xcf, 0x12, 0x6f, 0x43,
+	0x5e, 0x61, 0xfd, 0x8a, 0x99, 0xde, 0x69, 0x1f, 0x55, 0xed, 0x61, 0x13, 0xbd, 0x66, 0xec, 0x8a,
+	0xf5, 0xc8, 0x77, 0xfb, 0x3b, 0xb0, 0x42, 0x19, 0xa8, 0x1b, 0x98, 0x20, 0x27, 0xc8, 0xc1, 0x0f,
+	0x5b, 0xc8, 0xe9, 0xde, 0xd7, 0x90, 0x45, 0x0c, 0xd2, 0x15, 0x17, 0x60, 0xfc, 0x0c, 0x75, 0xab,
+	0x75, 0x05, 0xd7, 0xf9, 0xa9, 0x2f, 0x7f, 0x86, 0xba, 0xf7, 0x14, 0x5c, 0x4f, 0x4c, 0xe9, 0x31,
+	0xdc, 0x4e, 0xa3, 0xda, 0xbf, 0x5c, 0xb8, 0x73, 0xb3, 0xd3, 0x34, 0x9c, 0x6e, 0x98, 0xcd, 0x93,
+	0xac, 0x91, 0x5f, 0x4f, 0x74, 0x98, 0x71, 0x63, 0xe2, 0xf7, 0x16, 0x62, 0x9b, 0x86, 0x2a, 0xbe,
+	0x0d, 0x39, 0xf7, 0x66, 0x5a, 0x14, 0x96, 0xb3, 0x89, 0x3b, 0x67, 0xe1, 0xd9, 0xd3, 0x8d, 0x3c,
+	0xd6, 0xce, 0xca, 0xae, 0x4b, 0x14, 0x3e, 0x60, 0x5b, 0x7f, 0x00, 0xc5, 0xa8, 0x21, 0xdf, 0xd3,
+	0x6d, 0x98, 0x70, 0xf8, 0xef, 0xbe, 0x56, 0xe5, 0x73, 0x58, 0xe9, 0x1e, 0x5c, 0x3b, 0xc2, 0xfa,
+	0x47, 0x88, 0xd8, 0xfb, 0xa8, 0xa1, 0x74, 0x91, 0x16, 0xb9, 0x2f, 0xcd, 0x40, 0xd6, 0xd0, 0x98,
+	0xb6, 0x9c, 0xec, 0xfe, 0x4c, 0x1c, 0xd7, 0xd7, 0x61, 0xa5, 0x9f, 0x26, 0x3f, 0xb5, 0xbf, 0x11,
+	0x40, 0x3a, 0xc2, 0x3a, 0xbd, 0x0a, 0xee, 0x7a, 0x57, 0xc6, 0x9d, 0x46, 0xc3, 0xfe, 0xd8, 0xbd,
+	0x6c, 0x89, 0x45, 0xc8, 0x7b, 0xf7, 0x46, 0x46, 0x46, 0xef, 0xf3, 0x5c, 0x82, 0xb8, 0x65, 0xef,
+	0x53, 0x6c, 0x40, 0x01, 0x37, 0x91, 0xa5, 0x55, 0x1b, 0x86, 0x69, 0x10, 0x7e, 0x98, 0xe8, 0x73,
+	0x61, 0xdd, 0x74, 0xe7, 0xfe, 0x67, 0x7f, 0x5d, 0x5a, 0x4b, 0x71, 0x83, 0x72, 0x3b, 0x60, 0x19,
+	0xa8, 0xfe, 0x0f, 0x5c, 0xf5, 0xa5, 0x15, 0x28, 0x25, 0xfb, 0xef, 0x87, 0xf9, 0x21, 0x5c, 0xf5,
+	0xd7, 0xd0, 0x8b, 0x09, 0xb3, 0xb4, 0x0a, 0x37, 0xfb, 0xa8, 0xf4, 0x2d, 0xff, 0x41, 0x80, 0x55,
+	0xff, 0x7c, 0xb2, 0xab, 0xf8, 0x07, 0x13, 0x7f, 0x27, 0x39, 0x68, 0x1b, 0x1a, 0x72, 0x9d, 0x78,
+	0x0f, 0xf2, 0xb8, 0x55, 0x7b, 0x82, 0xd4, 0xfe, 0xc7, 0xbb, 0xe9, 0x67, 0x4f, 0x37, 0xe0, 0x61,
+	0x8b, 0xe8, 0xb6, 0x61, 0xe9, 0x27, 0x1d, 0xd9, 0xeb, 0xd4, 0xff, 0x98, 0x94, 0xfe, 0x86, 0x71,
+	0xce, 0xa8, 0x5c, 0x0c, 0xe3, 0x2b, 0xb0, 0x91, 0x2a, 0x1a, 0x3f, 0xfe, 0x97, 0xa3, 0x30, 0xcb,
+	0xb8, 0xb7, 0x47, 0x93, 0xc9, 0x0e, 0xb2, 0x4b, 0x50, 0xa0, 0x47, 0xd2, 0xd0, 0x95, 0x02, 0x68,
+	0x13, 0xbb, 0x4e, 0xa4, 0x5c, 0x8b, 0x0f, 0x43, 0x45, 0x95, 0xaf, 0x70, 0x1b, 0x67, 0xbd, 0xc3,
+	0xa3, 0xc3, 0x2a, 0x10, 0xb9, 0xc8, 0xe8, 0xd0, 0x56, 0x17, 0xc8, 0xb7, 0x11, 0x07, 0xa9, 0xc8,
+	0x68, 0xfb, 0x05, 0x95, 0x69, 0xd6, 0x2c, 0xf3, 0xd6, 0xb8, 0x8d, 0x77, 0x2c, 0x76, 0xe3, 0x5d,
+	0x82, 0x82, 0x51, 0x53, 0xab, 0xa7, 0xb6, 0xf3, 0xb1, 0xe2, 0x68, 0xc5, 0x3c, 0xd5, 0x06, 0x46,
+	0x4d, 0x3d, 0x64, 0x2d, 0xa2, 0x08, 0x39, 0x13, 0x99, 0x76, 0x71, 0x9c, 0xa6, 0x94, 0xfe, 0x16,
+	0x6b, 0x81, 0xd3, 0x0c, 0xe9, 0xb0, 0x15, 0x77, 0xc2, 0x95, 0xef, 0xbe, 0xf3, 0xe5, 0xf3, 0xa5,
+	0xb7, 0x02, 0xd1, 0x13, 0xea, 0xb7, 0x69, 0x58, 0x24, 0xf8, 0xb3, 0x61, 0xd4, 0x70, 0xa5, 0xd6,
+	0x25, 0x08, 0x97, 0xef, 0xa1, 0xce, 0xae, 0xfb, 0xe3, 0xdc, 0xb1, 0x93, 0x0e, 0x5d, 0xb2, 0x6f,
+	0x07, 0xea, 0x5b, 0x0d, 0x5b, 0xaf, 0x1a, 0x96, 0x86, 0x3a, 0x45, 0xa0, 0x41, 0xf8, 0xd6, 0x3f,
+	0xb0, 0xf5, 0xfb, 0x6e, 0xbb, 0xf8, 0x6d, 0xb8, 0xc4, 0x47, 0x44, 0xab, 0xf2, 0x94, 0x14, 0xbe,
+	0x52, 0x4a, 0xa6, 0x3d, 0x35, 0x3b, 0x2c, 0x35, 0x77, 0xe0, 0x72, 0x5d, 0xc1, 0xd5, 0x18, 0x57,
+	0x26, 0x97, 0x85, 0xb5, 0x71, 0x79, 0xae, 0xae, 0xe0, 0x83, 0xa8, 0x37, 0x75, 0x98, 0xf3, 0x3b,
+	0xd4, 0x1a, 0xb6, 0x7a, 0xc6, 0x86, 0x68, 0xea, 0x6b, 0x0e, 0x91, 0x5f, 0xd6, 0xdb, 0x75, 0x75,
+	0xba, 0xa3, 0xf4, 0x6e, 0xee, 0xef, 0x9f, 0x2e, 0x09, 0xa5, 0x5f, 0xe7, 0xe0, 0xb2, 0xcb, 0x10,
+	0xea, 0xc8, 0x90, 0x54, 0x3f, 0xe7, 0x70, 0xe6, 0xa2, 0x39, 0x9c, 0x4d, 0xcb, 0xe1, 0x5c, 0x5a,
+	0x0e, 0x8f, 0xc6, 0x72, 0x38, 0x8e, 0x8e, 0x63, 0xaf, 0x84, 0x8e, 0xf9, 0x04, 0x3a, 0x26, 0xb3,
+	0x66, 0x7c, 0x68, 0xd6, 0x4c, 0xfc, 0xbb, 0x58, 0xf3, 0x8f, 0x2c, 0x88, 0xb4, 0xaa, 0xc4, 0x4f,
+	0x10, 0x1a, 0x63, 0x4c, 0xfa, 0xa2, 0x52, 0x90, 0x58, 0x99, 0x1e, 0x62, 0xc5, 0xa4, 0x2f, 0x9b,
+	0xb4, 0x04, 0x05, 0xcb, 0x53, 0xb9, 0x9e, 0xf2, 0x54, 0x11, 0xf2, 0x0e, 0x3d, 0x46, 0x78, 0xab,
+	0x9d, 0xf7, 0xf9, 0xbf, 0xcc, 0x0f, 0x99, 0xf9, 0xd2, 0x2f, 0x47, 0x61, 0x21, 0x58, 0x42, 0x0d,
+	0xa7, 0x7e, 0xe0, 0x62, 0xa1, 0xc7, 0x96, 0x58, 0x33, 0x5f, 0xd7, 0xcf, 0xb4, 0xc5, 0xd9, 0x6c,
+	0x9a, 0xe2, 0x2c, 0xe7, 0x5a, 0x2e, 0x96, 0x6b, 0x45, 0xf7, 0x94, 0xa3, 0xaa, 0x08, 0x63, 0x4a,
+	0xa5, 0x71, 0xd9, 0xfb, 0x74, 0xa9, 0xe4, 0x20, 0xd2, 0x72, 0xac, 0xaa, 0xa6, 0x10, 0xe5, 0x82,
+	0xa8, 0xc4, 0x34, 0xee, 0x2b, 0x44, 0xa1, 0x54, 0x8a, 0xa3, 0x6b, 0xfe, 0x95, 0xd0, 0x75, 0x7c,
+	0x68, 0xba, 0x4e, 0x0c, 0x4d, 0x57, 0xb8, 0x78, 0xba, 0xfe, 0x2e, 0x07, 0x62, 0xe8, 0x4a, 0x9b,
+	0x92, 0xa7, 0xd1, 0xeb, 0x76, 0x26, 0xcd, 0x75, 0x3b, 0x1b, 0xb7, 0xcc, 0x5d, 0x07, 0x40, 0x8e,
+	0xba, 0xbd, 0x59, 0xb5, 0x14, 0x5e, 0x15, 0x9e, 0x90, 0x27, 0x68, 0xcb, 0x03, 0xc5, 0xa4, 0x86,
+	0x98, 0x18, 0x77, 0xcd, 0x9a, 0xdd, 0xe0, 0xeb, 0x53, 0x81, 0xb6, 0x1d, 0xd3, 0x26, 0xd7, 0x10,
+	0x83, 0x68, 0x48, 0x35, 0x4c, 0xa5, 0x81, 0xf9, 0x49, 0x6c, 0x8a, 0xb6, 0xee, 0xf3, 0xc6, 0x38,
+	0x0a, 0xe7, 0x53, 0xef, 0x76, 0xe3, 0xaf, 0x84, 0x44, 0x13, 0x43, 0x93, 0x08, 0x86, 0x26, 0x51,
+	0xe1, 0xe2, 0x49, 0xf4, 0x65, 0x16, 0x8a, 0x81, 0x17, 0x86, 0x21, 0x97, 0xbc, 0x0d, 0x98, 0x0b,
+	0xbc, 0x41, 0x90, 0x4e, 0x68, 0xbf, 0x9b, 0xc1, 0xe7, 0x7a, 0x87, 0xdc, 0xf5, 0xde, 0x82, 0xbc,
+	0x89, 0xcc, 0x1a, 0x72, 0x70, 0x31, 0xb7, 0x9c, 0x4d, 0x2a, 0x50, 0x31, 0xbf, 0x65, 0x0f, 0x1a,
+	0x9b, 0xfc, 0xd1, 0x57, 0x92, 0xfc, 0xb1, 0xa1, 0x93, 0x9f, 0x1f, 0x3a, 0xf9, 0xe3, 0x17, 0x9f,
+	0xfc, 0x6f, 0xd1, 0x77, 0xd9, 0x87, 0x4d, 0xf2, 0xb0, 0x45, 0x1e, 0x9e, 0xb2, 0x9b, 0xf2, 0x70,
+	0x05, 0x50, 0xf6, 0x54, 0x1a, 0xd6, 0xe0, 0xdf, 0x2f, 0xf7, 0x69, 0xf9, 0xf1, 0xd0, 0x41, 0xe8,
+	0xfb, 0xa1, 0xea, 0xe8, 0x70, 0x26, 0x58, 0x65, 0xb1, 0x57, 0x8b, 0x67, 0x66, 0xfb, 0x33, 0x11,
+	0xb2, 0x6e, 0x0d, 0xfc, 0x31, 0x4c, 0x47, 0x6a, 0x32, 0xd7, 0x83, 0xac, 0xe9, 0x79, 0x15, 0x97,
+	0x56, 0xfb, 0x8a, 0xfd, 0x30, 0x46, 0xc4, 0x27, 0x30, 0x1f, 0xfb, 0x46, 0x7e, 0x33, 0xa2, 0x20,
+	0x0e, 0x24, 0xdd, 0x4a, 0x01, 0x0a, 0xd8, 0x7a, 0x0c, 0xd3, 0x91, 0x87, 0xf2, 0x68, 0x14, 0x61,
+	0xb1, 0xb4, 0xda, 0x57, 0x1c, 0xd0, 0xfc, 0x43, 0x01, 0xae, 0xf5, 0x7d, 0xb6, 0x8e, 0x7a, 0xda,
+	0x0f, 0x2c, 0xdd, 0x19, 0x02, 0x1c, 0x70, 0x42, 0x87, 0xb9, 0xb8, 0x17, 0xbe, 0x52, 0x5f, 0x6d,
+	0x14, 0x23, 0xbd, 0x39, 0x18, 0x13, 0x30, 0xf4, 0x08, 0x2e, 0x1d, 0x23, 0x12, 0x7a, 0xc7, 0xb8,
+	0x1a, 0x51, 0x10, 0x14, 0x4a, 0x37, 0xfb, 0x08, 0x43, 0x54, 0x28, 0x86, 0xed, 0x06, 0x0a, 0xfa,
+	0x37, 0x22, 0x2a, 0x7a, 0x21, 0xd2, 0xfa, 0x40, 0x48, 0xc0, 0x96, 0x06, 0x62, 0xcc, 0x6b, 0x4c,
+	0xd4, 0x4a, 0x2f, 0x44, 0x5a, 0x1f, 0x08, 0x09, 0x58, 0x31, 0xe1, 0xb5, 0xf8, 0x97, 0x90, 0x95,
+	0x1e, 0x62, 0xc5, 0xa0, 0xa4, 0xdb, 0x69, 0x50, 0x01, 0x73, 0x3f, 0x12, 0xe0, 0x7a, 0xff, 0x97,
+	0xd4, 0xdb, 0xb1, 0x79, 0x4e, 0x40, 0x4b, 0x6f, 0x0d, 0x83, 0x0e, 0xcf, 0xe9, 0xd8, 0xc7, 0x88,
+	0x28, 0x0f, 0xe2, 0x40, 0xd2, 0xad, 0x14, 0xa0, 0x80, 0x2d, 0x0c, 0x57, 0xc3, 0xa4, 0x09, 0xbf,
+	0x2e, 0xac, 0x24, 0x90, 0x22, 0x84, 0x92, 0x6e, 0xa7, 0x41, 0x05, 0x8c, 0xfe, 0x54, 0x80, 0x1b,
+	0x83, 0xdf, 0x05, 0x36, 0x7b, 0xd2, 0x37, 0xa0, 0x87, 0xf4, 0xce, 0xb0, 0x3d, 0x42, 0x93, 0x72,
+	0x2a, 0x5c, 0xfa, 0xbf, 0x16, 0x0d, 0x2a, 0x28, 0x95, 0x56, 0xfa, 0x49, 0x03, 0x6a, 0xbb, 0xb0,
+	0x90, 0x5c, 0x98, 0x5f, 0x8b, 0x28, 0x49, 0x44, 0x4a, 0x9b, 0x69, 0x91, 0xa1, 0xd4, 0x5e, 0x49,
+	0x2a, 0xd0, 0xbf, 0x1e, 0x51, 0x97, 0x80, 0x93, 0xca, 0xe9, 0x70, 0x01, 0xa3, 0x6d, 0x28, 0x26,
+	0xd6, 0xcb, 0xdf, 0x88, 0x9d, 0x8f, 0x31, 0x66, 0x2b, 0x29, 0x81, 0x01, 0xbb, 0x3f, 0x13, 0xa0,
+	0x94, 0xa2, 0x5a, 0xbe, 0x15, 0x3b, 0x25, 0xfb, 0x75, 0x91, 0xfe, 0x7f, 0xe8, 0x2e, 0xe1, 0x2d,
+	0x33, 0x72, 0x86, 0x89, 0x6e, 0x99, 0x61, 0xb1, 0xb4, 0xda, 0x57, 0xdc, 0x7f, 0xb5, 0xf7, 0xdf,
+	0x50, 0x93, 0x57, 0x7b, 0x0f, 0x22, 0xad, 0x0f, 0x84, 0x84, 0x57, 0xfb, 0x98, 0xa3, 0x52, 0xd4,
+	0x4a, 0x2f, 0x44, 0x5a, 0x1f, 0x08, 0x39, 0xb7, 0xb2, 0xfb, 0xe8, 0xf3, 0x17, 0x8b, 0xc2, 0x17,
+	0x2f, 0x16, 0x85, 0xbf, 0xbd, 0x58, 0x14, 0x3e, 0x79, 0xb9, 0x38, 0xf2, 0xc5, 0xcb, 0xc5, 0x91,
+	0x3f, 0xbd, 0x5c, 0x1c, 0xf9, 0xee, 0x37, 0x02, 0xa7, 0xca, 0x26, 0xd2, 0xf5, 0xee, 0x93, 0xb6,
+	0xf7, 0x2f, 0x9d, 0x1b, 0xec, 0x3f, 0x16, 0x2b, 0xa6, 0xad, 0xb5, 0x1a, 0xa8, 0xd2, 0xde, 0xae,
+	0x74, 0x3c, 0x11, 0x2b, 0x76, 0xd6, 0xc6, 0xe8, 0x3b, 0xc8, 0x9d, 0x7f, 0x0d, 0x00, 0x9c, 0x84,
+	0xd1, 0x04, 0x6e, 0x2a, 0x00, 0x00,
 }
 
-func (m *MsgEthereumHeightVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *SendToCosmosEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *SendToCosmosEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*SendToCosmosEvent)
+	if !ok {
+		that2, ok := that.(SendToCosmosEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if this.EventNonce != that1.EventNonce {
+		return false
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = len(m.EthereumSender)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if this.TokenContract != that1.TokenContract {
+		return false
 	}
-	l = len(m.CosmosReceiver)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if !this.Amount.Equal(that1.Amount) {
+		return false
 	}
-	if m.EthereumHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	if this.EthereumSender != that1.EthereumSender {
+		return false
 	}
-	return n
-}
-
-func (m *BatchExecutedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.CosmosReceiver != that1.CosmosReceiver {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if this.EthereumHeight != that1.EthereumHeight {
+		return false
 	}
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
+	if this.IbcForward != that1.IbcForward {
+		return false
 	}
-	if m.EthereumHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	if !bytes.Equal(this.Memo, that1.Memo) {
+		return false
 	}
-	if m.BatchNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.BatchNonce))
+	if !bytes.Equal(this.EthereumTxHash, that1.EthereumTxHash) {
+		return false
 	}
-	return n
+	if this.EthereumLogIndex != that1.EthereumLogIndex {
+		return false
+	}
+	if !this.ReceivedAmount.Equal(that1.ReceivedAmount) {
+		return false
+	}
+	if this.HasEthereumLogIndex != that1.HasEthereumLogIndex {
+		return false
+	}
+	if !bytes.Equal(this.EthereumBlockHash, that1.EthereumBlockHash) {
+		return false
+	}
+	return true
 }
+func (this *SendEtherToCosmosEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ContractCallExecutedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*SendEtherToCosmosEvent)
+	if !ok {
+		that2, ok := that.(SendEtherToCosmosEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.InvalidationScope)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if this.EventNonce != that1.EventNonce {
+		return false
 	}
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
+	if !this.Amount.Equal(that1.Amount) {
+		return false
 	}
-	if m.EthereumHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	if this.EthereumSender != that1.EthereumSender {
+		return false
 	}
-	return n
+	if this.CosmosReceiver != that1.CosmosReceiver {
+		return false
+	}
+	if this.EthereumHeight != that1.EthereumHeight {
+		return false
+	}
+	if !bytes.Equal(this.EthereumTxHash, that1.EthereumTxHash) {
+		return false
+	}
+	if this.EthereumLogIndex != that1.EthereumLogIndex {
+		return false
+	}
+	if this.HasEthereumLogIndex != that1.HasEthereumLogIndex {
+		return false
+	}
+	if !bytes.Equal(this.EthereumBlockHash, that1.EthereumBlockHash) {
+		return false
+	}
+	return true
 }
 
-func (m *ERC20DeployedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SendToEthereum(ctx context.Context, in *MsgSendToEthereum, opts ...grpc.CallOption) (*MsgSendToEthereumResponse, error)
+	CancelSendToEthereum(ctx context.Context, in *MsgCancelSendToEthereum, opts ...grpc.CallOption) (*MsgCancelSendToEthereumResponse, error)
+	RequestBatchTx(ctx context.Context, in *MsgRequestBatchTx, opts ...grpc.CallOption) (*MsgRequestBatchTxResponse, error)
+	SubmitEthereumTxConfirmation(ctx context.Context, in *MsgSubmitEthereumTxConfirmation, opts ...grpc.CallOption) (*MsgSubmitEthereumTxConfirmationResponse, error)
+	SubmitEthereumEvent(ctx context.Context, in *MsgSubmitEthereumEvent, opts ...grpc.CallOption) (*MsgSubmitEthereumEventResponse, error)
+	SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, opts ...grpc.CallOption) (*MsgDelegateKeysResponse, error)
+	SubmitEthereumHeightVote(ctx context.Context, in *MsgEthereumHeightVote, opts ...grpc.CallOption) (*MsgEthereumHeightVoteResponse, error)
+	UpdateDelegateKeys(ctx context.Context, in *MsgUpdateDelegateKeys, opts ...grpc.CallOption) (*MsgUpdateDelegateKeysResponse, error)
+	RevokeOrchestratorKey(ctx context.Context, in *MsgRevokeOrchestratorKey, opts ...grpc.CallOption) (*MsgRevokeOrchestratorKeyResponse, error)
+	SubmitAggregatedEthereumEvent(ctx context.Context, in *MsgSubmitAggregatedEthereumEvent, opts ...grpc.CallOption) (*MsgSubmitAggregatedEthereumEventResponse, error)
+	ERC20DeployedConfirm(ctx context.Context, in *MsgERC20DeployedConfirm, opts ...grpc.CallOption) (*MsgERC20DeployedConfirmResponse, error)
+	SubmitEthereumAnomalyReport(ctx context.Context, in *MsgEthereumAnomalyReport, opts ...grpc.CallOption) (*MsgEthereumAnomalyReportResponse, error)
+	RegisterOrchestratorQueryIdentity(ctx context.Context, in *MsgRegisterOrchestratorQueryIdentity, opts ...grpc.CallOption) (*MsgRegisterOrchestratorQueryIdentityResponse, error)
+	ExecuteAtomic(ctx context.Context, in *MsgExecuteAtomic, opts ...grpc.CallOption) (*MsgExecuteAtomicResponse, error)
+	VetoDelayedSendToEthereum(ctx context.Context, in *MsgVetoDelayedSendToEthereum, opts ...grpc.CallOption) (*MsgVetoDelayedSendToEthereumResponse, error)
+	GrantBridgeFeeAllowance(ctx context.Context, in *MsgGrantBridgeFeeAllowance, opts ...grpc.CallOption) (*MsgGrantBridgeFeeAllowanceResponse, error)
+	RevokeBridgeFeeAllowance(ctx context.Context, in *MsgRevokeBridgeFeeAllowance, opts ...grpc.CallOption) (*MsgRevokeBridgeFeeAllowanceResponse, error)
+	SubmitBadEthereumSignatureEvidence(ctx context.Context, in *MsgSubmitBadEthereumSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadEthereumSignatureEvidenceResponse, error)
+	OptOutOfBridge(ctx context.Context, in *MsgOptOutOfBridge, opts ...grpc.CallOption) (*MsgOptOutOfBridgeResponse, error)
+	SubmitEthereumHeaderVote(ctx context.Context, in *MsgEthereumHeaderVote, opts ...grpc.CallOption) (*MsgEthereumHeaderVoteResponse, error)
+	FreezeOrchestrator(ctx context.Context, in *MsgFreezeOrchestrator, opts ...grpc.CallOption) (*MsgFreezeOrchestratorResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SendToEthereum(ctx context.Context, in *MsgSendToEthereum, opts ...grpc.CallOption) (*MsgSendToEthereumResponse, error) {
+	out := new(MsgSendToEthereumResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SendToEthereum", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
+	return out, nil
+}
+
+func (c *msgClient) CancelSendToEthereum(ctx context.Context, in *MsgCancelSendToEthereum, opts ...grpc.CallOption) (*MsgCancelSendToEthereumResponse, error) {
+	out := new(MsgCancelSendToEthereumResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/CancelSendToEthereum", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.CosmosDenom)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RequestBatchTx(ctx context.Context, in *MsgRequestBatchTx, opts ...grpc.CallOption) (*MsgRequestBatchTxResponse, error) {
+	out := new(MsgRequestBatchTxResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/RequestBatchTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SubmitEthereumTxConfirmation(ctx context.Context, in *MsgSubmitEthereumTxConfirmation, opts ...grpc.CallOption) (*MsgSubmitEthereumTxConfirmationResponse, error) {
+	out := new(MsgSubmitEthereumTxConfirmationResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitEthereumTxConfirmation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Erc20Name)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SubmitEthereumEvent(ctx context.Context, in *MsgSubmitEthereumEvent, opts ...grpc.CallOption) (*MsgSubmitEthereumEventResponse, error) {
+	out := new(MsgSubmitEthereumEventResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitEthereumEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Erc20Symbol)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetDelegateKeys(ctx context.Context, in *MsgDelegateKeys, opts ...grpc.CallOption) (*MsgDelegateKeysResponse, error) {
+	out := new(MsgDelegateKeysResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SetDelegateKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Erc20Decimals != 0 {
-		n += 1 + sovMsgs(uint64(m.Erc20Decimals))
+	return out, nil
+}
+
+func (c *msgClient) SubmitEthereumHeightVote(ctx context.Context, in *MsgEthereumHeightVote, opts ...grpc.CallOption) (*MsgEthereumHeightVoteResponse, error) {
+	out := new(MsgEthereumHeightVoteResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitEthereumHeightVote", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.EthereumHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	return out, nil
+}
+
+func (c *msgClient) UpdateDelegateKeys(ctx context.Context, in *MsgUpdateDelegateKeys, opts ...grpc.CallOption) (*MsgUpdateDelegateKeysResponse, error) {
+	out := new(MsgUpdateDelegateKeysResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/UpdateDelegateKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *SignerSetTxExecutedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RevokeOrchestratorKey(ctx context.Context, in *MsgRevokeOrchestratorKey, opts ...grpc.CallOption) (*MsgRevokeOrchestratorKeyResponse, error) {
+	out := new(MsgRevokeOrchestratorKeyResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/RevokeOrchestratorKey", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
+	return out, nil
+}
+
+func (c *msgClient) SubmitAggregatedEthereumEvent(ctx context.Context, in *MsgSubmitAggregatedEthereumEvent, opts ...grpc.CallOption) (*MsgSubmitAggregatedEthereumEventResponse, error) {
+	out := new(MsgSubmitAggregatedEthereumEventResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitAggregatedEthereumEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.SignerSetTxNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.SignerSetTxNonce))
+	return out, nil
+}
+
+func (c *msgClient) ERC20DeployedConfirm(ctx context.Context, in *MsgERC20DeployedConfirm, opts ...grpc.CallOption) (*MsgERC20DeployedConfirmResponse, error) {
+	out := new(MsgERC20DeployedConfirmResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/ERC20DeployedConfirm", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.EthereumHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	return out, nil
+}
+
+func (c *msgClient) SubmitEthereumAnomalyReport(ctx context.Context, in *MsgEthereumAnomalyReport, opts ...grpc.CallOption) (*MsgEthereumAnomalyReportResponse, error) {
+	out := new(MsgEthereumAnomalyReportResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitEthereumAnomalyReport", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovMsgs(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RegisterOrchestratorQueryIdentity(ctx context.Context, in *MsgRegisterOrchestratorQueryIdentity, opts ...grpc.CallOption) (*MsgRegisterOrchestratorQueryIdentityResponse, error) {
+	out := new(MsgRegisterOrchestratorQueryIdentityResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/RegisterOrchestratorQueryIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecuteAtomic(ctx context.Context, in *MsgExecuteAtomic, opts ...grpc.CallOption) (*MsgExecuteAtomicResponse, error) {
+	out := new(MsgExecuteAtomicResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/ExecuteAtomic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VetoDelayedSendToEthereum(ctx context.Context, in *MsgVetoDelayedSendToEthereum, opts ...grpc.CallOption) (*MsgVetoDelayedSendToEthereumResponse, error) {
+	out := new(MsgVetoDelayedSendToEthereumResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/VetoDelayedSendToEthereum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantBridgeFeeAllowance(ctx context.Context, in *MsgGrantBridgeFeeAllowance, opts ...grpc.CallOption) (*MsgGrantBridgeFeeAllowanceResponse, error) {
+	out := new(MsgGrantBridgeFeeAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/GrantBridgeFeeAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeBridgeFeeAllowance(ctx context.Context, in *MsgRevokeBridgeFeeAllowance, opts ...grpc.CallOption) (*MsgRevokeBridgeFeeAllowanceResponse, error) {
+	out := new(MsgRevokeBridgeFeeAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/RevokeBridgeFeeAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitBadEthereumSignatureEvidence(ctx context.Context, in *MsgSubmitBadEthereumSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadEthereumSignatureEvidenceResponse, error) {
+	out := new(MsgSubmitBadEthereumSignatureEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitBadEthereumSignatureEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OptOutOfBridge(ctx context.Context, in *MsgOptOutOfBridge, opts ...grpc.CallOption) (*MsgOptOutOfBridgeResponse, error) {
+	out := new(MsgOptOutOfBridgeResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/OptOutOfBridge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitEthereumHeaderVote(ctx context.Context, in *MsgEthereumHeaderVote, opts ...grpc.CallOption) (*MsgEthereumHeaderVoteResponse, error) {
+	out := new(MsgEthereumHeaderVoteResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitEthereumHeaderVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeOrchestrator(ctx context.Context, in *MsgFreezeOrchestrator, opts ...grpc.CallOption) (*MsgFreezeOrchestratorResponse, error) {
+	out := new(MsgFreezeOrchestratorResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/FreezeOrchestrator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SendToEthereum(context.Context, *MsgSendToEthereum) (*MsgSendToEthereumResponse, error)
+	CancelSendToEthereum(context.Context, *MsgCancelSendToEthereum) (*MsgCancelSendToEthereumResponse, error)
+	RequestBatchTx(context.Context, *MsgRequestBatchTx) (*MsgRequestBatchTxResponse, error)
+	SubmitEthereumTxConfirmation(context.Context, *MsgSubmitEthereumTxConfirmation) (*MsgSubmitEthereumTxConfirmationResponse, error)
+	SubmitEthereumEvent(context.Context, *MsgSubmitEthereumEvent) (*MsgSubmitEthereumEventResponse, error)
+	SetDelegateKeys(context.Context, *MsgDelegateKeys) (*MsgDelegateKeysResponse, error)
+	SubmitEthereumHeightVote(context.Context, *MsgEthereumHeightVote) (*MsgEthereumHeightVoteResponse, error)
+	UpdateDelegateKeys(context.Context, *MsgUpdateDelegateKeys) (*MsgUpdateDelegateKeysResponse, error)
+	RevokeOrchestratorKey(context.Context, *MsgRevokeOrchestratorKey) (*MsgRevokeOrchestratorKeyResponse, error)
+	SubmitAggregatedEthereumEvent(context.Context, *MsgSubmitAggregatedEthereumEvent) (*MsgSubmitAggregatedEthereumEventResponse, error)
+	ERC20DeployedConfirm(context.Context, *MsgERC20DeployedConfirm) (*MsgERC20DeployedConfirmResponse, error)
+	SubmitEthereumAnomalyReport(context.Context, *MsgEthereumAnomalyReport) (*MsgEthereumAnomalyReportResponse, error)
+	RegisterOrchestratorQueryIdentity(context.Context, *MsgRegisterOrchestratorQueryIdentity) (*MsgRegisterOrchestratorQueryIdentityResponse, error)
+	ExecuteAtomic(context.Context, *MsgExecuteAtomic) (*MsgExecuteAtomicResponse, error)
+	VetoDelayedSendToEthereum(context.Context, *MsgVetoDelayedSendToEthereum) (*MsgVetoDelayedSendToEthereumResponse, error)
+	GrantBridgeFeeAllowance(context.Context, *MsgGrantBridgeFeeAllowance) (*MsgGrantBridgeFeeAllowanceResponse, error)
+	RevokeBridgeFeeAllowance(context.Context, *MsgRevokeBridgeFeeAllowance) (*MsgRevokeBridgeFeeAllowanceResponse, error)
+	SubmitBadEthereumSignatureEvidence(context.Context, *MsgSubmitBadEthereumSignatureEvidence) (*MsgSubmitBadEthereumSignatureEvidenceResponse, error)
+	OptOutOfBridge(context.Context, *MsgOptOutOfBridge) (*MsgOptOutOfBridgeResponse, error)
+	SubmitEthereumHeaderVote(context.Context, *MsgEthereumHeaderVote) (*MsgEthereumHeaderVoteResponse, error)
+	FreezeOrchestrator(context.Context, *MsgFreezeOrchestrator) (*MsgFreezeOrchestratorResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SendToEthereum(ctx context.Context, req *MsgSendToEthereum) (*MsgSendToEthereumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendToEthereum not implemented")
+}
+func (*UnimplementedMsgServer) CancelSendToEthereum(ctx context.Context, req *MsgCancelSendToEthereum) (*MsgCancelSendToEthereumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSendToEthereum not implemented")
+}
+func (*UnimplementedMsgServer) RequestBatchTx(ctx context.Context, req *MsgRequestBatchTx) (*MsgRequestBatchTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestBatchTx not implemented")
+}
+func (*UnimplementedMsgServer) SubmitEthereumTxConfirmation(ctx context.Context, req *MsgSubmitEthereumTxConfirmation) (*MsgSubmitEthereumTxConfirmationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumTxConfirmation not implemented")
+}
+func (*UnimplementedMsgServer) SubmitEthereumEvent(ctx context.Context, req *MsgSubmitEthereumEvent) (*MsgSubmitEthereumEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumEvent not implemented")
+}
+func (*UnimplementedMsgServer) SetDelegateKeys(ctx context.Context, req *MsgDelegateKeys) (*MsgDelegateKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegateKeys not implemented")
+}
+func (*UnimplementedMsgServer) SubmitEthereumHeightVote(ctx context.Context, req *MsgEthereumHeightVote) (*MsgEthereumHeightVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumHeightVote not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDelegateKeys(ctx context.Context, req *MsgUpdateDelegateKeys) (*MsgUpdateDelegateKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDelegateKeys not implemented")
+}
+func (*UnimplementedMsgServer) RevokeOrchestratorKey(ctx context.Context, req *MsgRevokeOrchestratorKey) (*MsgRevokeOrchestratorKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOrchestratorKey not implemented")
+}
+func (*UnimplementedMsgServer) SubmitAggregatedEthereumEvent(ctx context.Context, req *MsgSubmitAggregatedEthereumEvent) (*MsgSubmitAggregatedEthereumEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAggregatedEthereumEvent not implemented")
+}
+func (*UnimplementedMsgServer) ERC20DeployedConfirm(ctx context.Context, req *MsgERC20DeployedConfirm) (*MsgERC20DeployedConfirmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20DeployedConfirm not implemented")
+}
+func (*UnimplementedMsgServer) SubmitEthereumAnomalyReport(ctx context.Context, req *MsgEthereumAnomalyReport) (*MsgEthereumAnomalyReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumAnomalyReport not implemented")
+}
+func (*UnimplementedMsgServer) RegisterOrchestratorQueryIdentity(ctx context.Context, req *MsgRegisterOrchestratorQueryIdentity) (*MsgRegisterOrchestratorQueryIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterOrchestratorQueryIdentity not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteAtomic(ctx context.Context, req *MsgExecuteAtomic) (*MsgExecuteAtomicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteAtomic not implemented")
+}
+func (*UnimplementedMsgServer) VetoDelayedSendToEthereum(ctx context.Context, req *MsgVetoDelayedSendToEthereum) (*MsgVetoDelayedSendToEthereumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoDelayedSendToEthereum not implemented")
+}
+func (*UnimplementedMsgServer) GrantBridgeFeeAllowance(ctx context.Context, req *MsgGrantBridgeFeeAllowance) (*MsgGrantBridgeFeeAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantBridgeFeeAllowance not implemented")
+}
+func (*UnimplementedMsgServer) RevokeBridgeFeeAllowance(ctx context.Context, req *MsgRevokeBridgeFeeAllowance) (*MsgRevokeBridgeFeeAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeBridgeFeeAllowance not implemented")
+}
+func (*UnimplementedMsgServer) SubmitBadEthereumSignatureEvidence(ctx context.Context, req *MsgSubmitBadEthereumSignatureEvidence) (*MsgSubmitBadEthereumSignatureEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBadEthereumSignatureEvidence not implemented")
+}
+func (*UnimplementedMsgServer) OptOutOfBridge(ctx context.Context, req *MsgOptOutOfBridge) (*MsgOptOutOfBridgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptOutOfBridge not implemented")
+}
+func (*UnimplementedMsgServer) SubmitEthereumHeaderVote(ctx context.Context, req *MsgEthereumHeaderVote) (*MsgEthereumHeaderVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthereumHeaderVote not implemented")
+}
+func (*UnimplementedMsgServer) FreezeOrchestrator(ctx context.Context, req *MsgFreezeOrchestrator) (*MsgFreezeOrchestratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeOrchestrator not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SendToEthereum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendToEthereum)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendToEthereum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SendToEthereum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendToEthereum(ctx, req.(*MsgSendToEthereum))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSendToEthereum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSendToEthereum)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSendToEthereum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/CancelSendToEthereum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSendToEthereum(ctx, req.(*MsgCancelSendToEthereum))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestBatchTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestBatchTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestBatchTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/RequestBatchTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestBatchTx(ctx, req.(*MsgRequestBatchTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitEthereumTxConfirmation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitEthereumTxConfirmation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEthereumTxConfirmation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitEthereumTxConfirmation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEthereumTxConfirmation(ctx, req.(*MsgSubmitEthereumTxConfirmation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitEthereumEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitEthereumEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEthereumEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitEthereumEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEthereumEvent(ctx, req.(*MsgSubmitEthereumEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateKeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDelegateKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SetDelegateKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDelegateKeys(ctx, req.(*MsgDelegateKeys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitEthereumHeightVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEthereumHeightVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEthereumHeightVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitEthereumHeightVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEthereumHeightVote(ctx, req.(*MsgEthereumHeightVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDelegateKeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDelegateKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/UpdateDelegateKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDelegateKeys(ctx, req.(*MsgUpdateDelegateKeys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeOrchestratorKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeOrchestratorKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeOrchestratorKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/RevokeOrchestratorKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeOrchestratorKey(ctx, req.(*MsgRevokeOrchestratorKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitAggregatedEthereumEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitAggregatedEthereumEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitAggregatedEthereumEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitAggregatedEthereumEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitAggregatedEthereumEvent(ctx, req.(*MsgSubmitAggregatedEthereumEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ERC20DeployedConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgERC20DeployedConfirm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ERC20DeployedConfirm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/ERC20DeployedConfirm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ERC20DeployedConfirm(ctx, req.(*MsgERC20DeployedConfirm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitEthereumAnomalyReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEthereumAnomalyReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEthereumAnomalyReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitEthereumAnomalyReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEthereumAnomalyReport(ctx, req.(*MsgEthereumAnomalyReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterOrchestratorQueryIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterOrchestratorQueryIdentity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterOrchestratorQueryIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/RegisterOrchestratorQueryIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterOrchestratorQueryIdentity(ctx, req.(*MsgRegisterOrchestratorQueryIdentity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecuteAtomic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteAtomic)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteAtomic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/ExecuteAtomic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteAtomic(ctx, req.(*MsgExecuteAtomic))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VetoDelayedSendToEthereum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoDelayedSendToEthereum)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoDelayedSendToEthereum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/VetoDelayedSendToEthereum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoDelayedSendToEthereum(ctx, req.(*MsgVetoDelayedSendToEthereum))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantBridgeFeeAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantBridgeFeeAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantBridgeFeeAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/GrantBridgeFeeAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantBridgeFeeAllowance(ctx, req.(*MsgGrantBridgeFeeAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeBridgeFeeAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeBridgeFeeAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeBridgeFeeAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/RevokeBridgeFeeAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeBridgeFeeAllowance(ctx, req.(*MsgRevokeBridgeFeeAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitBadEthereumSignatureEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBadEthereumSignatureEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBadEthereumSignatureEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitBadEthereumSignatureEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBadEthereumSignatureEvidence(ctx, req.(*MsgSubmitBadEthereumSignatureEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OptOutOfBridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOptOutOfBridge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OptOutOfBridge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/OptOutOfBridge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OptOutOfBridge(ctx, req.(*MsgOptOutOfBridge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitEthereumHeaderVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEthereumHeaderVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEthereumHeaderVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitEthereumHeaderVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEthereumHeaderVote(ctx, req.(*MsgEthereumHeaderVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeOrchestrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeOrchestrator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeOrchestrator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/FreezeOrchestrator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeOrchestrator(ctx, req.(*MsgFreezeOrchestrator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gravity.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendToEthereum",
+			Handler:    _Msg_SendToEthereum_Handler,
+		},
+		{
+			MethodName: "CancelSendToEthereum",
+			Handler:    _Msg_CancelSendToEthereum_Handler,
+		},
+		{
+			MethodName: "RequestBatchTx",
+			Handler:    _Msg_RequestBatchTx_Handler,
+		},
+		{
+			MethodName: "SubmitEthereumTxConfirmation",
+			Handler:    _Msg_SubmitEthereumTxConfirmation_Handler,
+		},
+		{
+			MethodName: "SubmitEthereumEvent",
+			Handler:    _Msg_SubmitEthereumEvent_Handler,
+		},
+		{
+			MethodName: "SetDelegateKeys",
+			Handler:    _Msg_SetDelegateKeys_Handler,
+		},
+		{
+			MethodName: "SubmitEthereumHeightVote",
+			Handler:    _Msg_SubmitEthereumHeightVote_Handler,
+		},
+		{
+			MethodName: "UpdateDelegateKeys",
+			Handler:    _Msg_UpdateDelegateKeys_Handler,
+		},
+		{
+			MethodName: "RevokeOrchestratorKey",
+			Handler:    _Msg_RevokeOrchestratorKey_Handler,
+		},
+		{
+			MethodName: "SubmitAggregatedEthereumEvent",
+			Handler:    _Msg_SubmitAggregatedEthereumEvent_Handler,
+		},
+		{
+			MethodName: "ERC20DeployedConfirm",
+			Handler:    _Msg_ERC20DeployedConfirm_Handler,
+		},
+		{
+			MethodName: "SubmitEthereumAnomalyReport",
+			Handler:    _Msg_SubmitEthereumAnomalyReport_Handler,
+		},
+		{
+			MethodName: "RegisterOrchestratorQueryIdentity",
+			Handler:    _Msg_RegisterOrchestratorQueryIdentity_Handler,
+		},
+		{
+			MethodName: "ExecuteAtomic",
+			Handler:    _Msg_ExecuteAtomic_Handler,
+		},
+		{
+			MethodName: "VetoDelayedSendToEthereum",
+			Handler:    _Msg_VetoDelayedSendToEthereum_Handler,
+		},
+		{
+			MethodName: "GrantBridgeFeeAllowance",
+			Handler:    _Msg_GrantBridgeFeeAllowance_Handler,
+		},
+		{
+			MethodName: "RevokeBridgeFeeAllowance",
+			Handler:    _Msg_RevokeBridgeFeeAllowance_Handler,
+		},
+		{
+			MethodName: "SubmitBadEthereumSignatureEvidence",
+			Handler:    _Msg_SubmitBadEthereumSignatureEvidence_Handler,
+		},
+		{
+			MethodName: "OptOutOfBridge",
+			Handler:    _Msg_OptOutOfBridge_Handler,
+		},
+		{
+			MethodName: "SubmitEthereumHeaderVote",
+			Handler:    _Msg_SubmitEthereumHeaderVote_Handler,
+		},
+		{
+			MethodName: "FreezeOrchestrator",
+			Handler:    _Msg_FreezeOrchestrator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gravity/v1/msgs.proto",
+}
+
+func (m *MsgSendToEthereum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendToEthereum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecuteAfterHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ExecuteAfterHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.FeeGranter) > 0 {
+		i -= len(m.FeeGranter)
+		copy(dAtA[i:], m.FeeGranter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.FeeGranter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EthereumRecipient) > 0 {
+		i -= len(m.EthereumRecipient)
+		copy(dAtA[i:], m.EthereumRecipient)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumRecipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendToEthereumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendToEthereumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendToEthereumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSendToEthereum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSendToEthereum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSendToEthereumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSendToEthereumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSendToEthereumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestBatchTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestBatchTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxElements != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.MaxElements))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestBatchTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestBatchTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBatchTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitEthereumTxConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthereumTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthereumTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Confirmation != nil {
+		{
+			size, err := m.Confirmation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Scheme != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Scheme))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EthereumSigner) > 0 {
+		i -= len(m.EthereumSigner)
+		copy(dAtA[i:], m.EthereumSigner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSigner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Scheme != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Scheme))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EthereumSigner) > 0 {
+		i -= len(m.EthereumSigner)
+		copy(dAtA[i:], m.EthereumSigner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSigner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Scheme != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Scheme))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EthereumSigner) > 0 {
+		i -= len(m.EthereumSigner)
+		copy(dAtA[i:], m.EthereumSigner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSigner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitEthereumTxConfirmationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthereumTxConfirmationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthereumTxConfirmationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitEthereumEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthereumEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthereumEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitEthereumEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthereumEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthereumEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAggregatedEthereumEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAggregatedEthereumEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAggregatedEthereumEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cosignatures) > 0 {
+		for iNdEx := len(m.Cosignatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cosignatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumEventCosignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumEventCosignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumEventCosignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAggregatedEthereumEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAggregatedEthereumEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAggregatedEthereumEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignatureScheme != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SignatureScheme))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EthSignature) > 0 {
+		i -= len(m.EthSignature)
+		copy(dAtA[i:], m.EthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysSignMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysSignMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysSignMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDelegateKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDelegateKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDelegateKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthSignature) > 0 {
+		i -= len(m.EthSignature)
+		copy(dAtA[i:], m.EthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDelegateKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeOrchestratorKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeOrchestratorKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeOrchestratorKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeOrchestratorKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeOrchestratorKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeOrchestratorKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEthereumHeightVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumHeightVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumHeightVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEthereumHeightVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumHeightVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumHeightVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEthereumHeaderVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumHeaderVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumHeaderVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEthereumHeaderVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumHeaderVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumHeaderVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgERC20DeployedConfirm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgERC20DeployedConfirm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgERC20DeployedConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosDenom) > 0 {
+		i -= len(m.CosmosDenom)
+		copy(dAtA[i:], m.CosmosDenom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgERC20DeployedConfirmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgERC20DeployedConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgERC20DeployedConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEthereumAnomalyReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumAnomalyReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumAnomalyReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Anomaly != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Anomaly))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEthereumAnomalyReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumAnomalyReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumAnomalyReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterOrchestratorQueryIdentity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterOrchestratorQueryIdentity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterOrchestratorQueryIdentity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeyHash) > 0 {
+		i -= len(m.KeyHash)
+		copy(dAtA[i:], m.KeyHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.KeyHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterOrchestratorQueryIdentityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterOrchestratorQueryIdentityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterOrchestratorQueryIdentityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteAtomic) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteAtomic) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteAtomic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteAtomicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteAtomicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteAtomicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoDelayedSendToEthereum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoDelayedSendToEthereum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoDelayedSendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ids) > 0 {
+		dAtA8 := make([]byte, len(m.Ids)*10)
+		var j7 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintMsgs(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoDelayedSendToEthereumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoDelayedSendToEthereumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoDelayedSendToEthereumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantBridgeFeeAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantBridgeFeeAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantBridgeFeeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantBridgeFeeAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantBridgeFeeAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantBridgeFeeAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeBridgeFeeAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeBridgeFeeAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeBridgeFeeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeBridgeFeeAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeBridgeFeeAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeBridgeFeeAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBadEthereumSignatureEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBadEthereumSignatureEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBadEthereumSignatureEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EthereumSigner) > 0 {
+		i -= len(m.EthereumSigner)
+		copy(dAtA[i:], m.EthereumSigner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSigner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Subject != nil {
+		{
+			size, err := m.Subject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBadEthereumSignatureEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBadEthereumSignatureEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBadEthereumSignatureEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SendToCosmosEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendToCosmosEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendToCosmosEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumBlockHash) > 0 {
+		i -= len(m.EthereumBlockHash)
+		copy(dAtA[i:], m.EthereumBlockHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumBlockHash)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.HasEthereumLogIndex {
+		i--
+		if m.HasEthereumLogIndex {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.ReceivedAmount.Size()
+		i -= size
+		if _, err := m.ReceivedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.EthereumLogIndex != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumLogIndex))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.EthereumTxHash) > 0 {
+		i -= len(m.EthereumTxHash)
+		copy(dAtA[i:], m.EthereumTxHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumTxHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.IbcForward) > 0 {
+		i -= len(m.IbcForward)
+		copy(dAtA[i:], m.IbcForward)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.IbcForward)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendEtherToCosmosEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendEtherToCosmosEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendEtherToCosmosEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumBlockHash) > 0 {
+		i -= len(m.EthereumBlockHash)
+		copy(dAtA[i:], m.EthereumBlockHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumBlockHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.HasEthereumLogIndex {
+		i--
+		if m.HasEthereumLogIndex {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EthereumLogIndex != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumLogIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.EthereumTxHash) > 0 {
+		i -= len(m.EthereumTxHash)
+		copy(dAtA[i:], m.EthereumTxHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumTxHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchExecutedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumBlockHash) > 0 {
+		i -= len(m.EthereumBlockHash)
+		copy(dAtA[i:], m.EthereumBlockHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumBlockHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.HasEthereumLogIndex {
+		i--
+		if m.HasEthereumLogIndex {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EthereumLogIndex != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumLogIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.EthereumTxHash) > 0 {
+		i -= len(m.EthereumTxHash)
+		copy(dAtA[i:], m.EthereumTxHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumTxHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallExecutedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumBlockHash) > 0 {
+		i -= len(m.EthereumBlockHash)
+		copy(dAtA[i:], m.EthereumBlockHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumBlockHash)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.HasEthereumLogIndex {
+		i--
+		if m.HasEthereumLogIndex {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EthereumLogIndex != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumLogIndex))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.EthereumTxHash) > 0 {
+		i -= len(m.EthereumTxHash)
+		copy(dAtA[i:], m.EthereumTxHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumTxHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ReturnDataHash) > 0 {
+		i -= len(m.ReturnDataHash)
+		copy(dAtA[i:], m.ReturnDataHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ReturnDataHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20DeployedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20DeployedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20DeployedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumBlockHash) > 0 {
+		i -= len(m.EthereumBlockHash)
+		copy(dAtA[i:], m.EthereumBlockHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumBlockHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.HasEthereumLogIndex {
+		i--
+		if m.HasEthereumLogIndex {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EthereumLogIndex != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumLogIndex))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.EthereumTxHash) > 0 {
+		i -= len(m.EthereumTxHash)
+		copy(dAtA[i:], m.EthereumTxHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumTxHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Erc20Decimals != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Erc20Decimals))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Erc20Symbol) > 0 {
+		i -= len(m.Erc20Symbol)
+		copy(dAtA[i:], m.Erc20Symbol)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Erc20Symbol)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Erc20Name) > 0 {
+		i -= len(m.Erc20Name)
+		copy(dAtA[i:], m.Erc20Name)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Erc20Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CosmosDenom) > 0 {
+		i -= len(m.CosmosDenom)
+		copy(dAtA[i:], m.CosmosDenom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxExecutedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumBlockHash) > 0 {
+		i -= len(m.EthereumBlockHash)
+		copy(dAtA[i:], m.EthereumBlockHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumBlockHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.HasEthereumLogIndex {
+		i--
+		if m.HasEthereumLogIndex {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EthereumLogIndex != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumLogIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.EthereumTxHash) > 0 {
+		i -= len(m.EthereumTxHash)
+		copy(dAtA[i:], m.EthereumTxHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumTxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignerSetTxNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.SignerSetTxNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOptOutOfBridge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOptOutOfBridge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOptOutOfBridge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOptOutOfBridgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOptOutOfBridgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOptOutOfBridgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeOrchestrator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeOrchestrator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeOrchestrator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeOrchestratorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeOrchestratorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeOrchestratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSendToEthereum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthereumRecipient)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.FeeGranter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ExecuteAfterHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.ExecuteAfterHeight))
+	}
+	return n
+}
+
+func (m *MsgSendToEthereumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMsgs(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelSendToEthereum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMsgs(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelSendToEthereumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestBatchTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.MaxElements != 0 {
+		n += 1 + sovMsgs(uint64(m.MaxElements))
+	}
+	l = m.MinFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgRequestBatchTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitEthereumTxConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Confirmation != nil {
+		l = m.Confirmation.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallTxConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
+	}
+	l = len(m.EthereumSigner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Scheme != 0 {
+		n += 1 + sovMsgs(uint64(m.Scheme))
+	}
+	return n
+}
+
+func (m *BatchTxConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.BatchNonce))
+	}
+	l = len(m.EthereumSigner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Scheme != 0 {
+		n += 1 + sovMsgs(uint64(m.Scheme))
+	}
+	return n
+}
+
+func (m *SignerSetTxConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.SignerSetNonce))
+	}
+	l = len(m.EthereumSigner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Scheme != 0 {
+		n += 1 + sovMsgs(uint64(m.Scheme))
+	}
+	return n
+}
+
+func (m *MsgSubmitEthereumTxConfirmationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitEthereumEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitEthereumEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitAggregatedEthereumEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Cosignatures) > 0 {
+		for _, e := range m.Cosignatures {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EthereumEventCosignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitAggregatedEthereumEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.SignatureScheme != 0 {
+		n += 1 + sovMsgs(uint64(m.SignatureScheme))
+	}
+	return n
+}
+
+func (m *MsgDelegateKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DelegateKeysSignMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovMsgs(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgUpdateDelegateKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDelegateKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeOrchestratorKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeOrchestratorKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEthereumHeightVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthereumHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEthereumHeightVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEthereumHeaderVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEthereumHeaderVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgERC20DeployedConfirm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosDenom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgERC20DeployedConfirmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEthereumAnomalyReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Anomaly != 0 {
+		n += 1 + sovMsgs(uint64(m.Anomaly))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEthereumAnomalyReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterOrchestratorQueryIdentity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterOrchestratorQueryIdentityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *MsgExecuteAtomic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecuteAtomicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgVetoDelayedSendToEthereum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovMsgs(uint64(e))
+		}
+		n += 1 + sovMsgs(uint64(l)) + l
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVetoDelayedSendToEthereumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantBridgeFeeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGrantBridgeFeeAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeBridgeFeeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeBridgeFeeAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitBadEthereumSignatureEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Subject != nil {
+		l = m.Subject.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthereumSigner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitBadEthereumSignatureEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SendToCosmosEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	}
+	l = len(m.IbcForward)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthereumTxHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EthereumLogIndex != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumLogIndex))
+	}
+	l = m.ReceivedAmount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	if m.HasEthereumLogIndex {
+		n += 2
+	}
+	l = len(m.EthereumBlockHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *SendEtherToCosmosEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	}
+	l = len(m.EthereumTxHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EthereumLogIndex != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumLogIndex))
+	}
+	if m.HasEthereumLogIndex {
+		n += 2
+	}
+	l = len(m.EthereumBlockHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchExecutedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.BatchNonce))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthereumTxHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EthereumLogIndex != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumLogIndex))
+	}
+	if m.HasEthereumLogIndex {
+		n += 2
+	}
+	l = len(m.EthereumBlockHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallExecutedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.ReturnDataHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthereumTxHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EthereumLogIndex != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumLogIndex))
+	}
+	if m.HasEthereumLogIndex {
+		n += 2
+	}
+	l = len(m.EthereumBlockHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20DeployedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	l = len(m.CosmosDenom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Erc20Name)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Erc20Symbol)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Erc20Decimals != 0 {
+		n += 1 + sovMsgs(uint64(m.Erc20Decimals))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	}
+	l = len(m.EthereumTxHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EthereumLogIndex != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumLogIndex))
+	}
+	if m.HasEthereumLogIndex {
+		n += 2
+	}
+	l = len(m.EthereumBlockHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTxExecutedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	if m.SignerSetTxNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.SignerSetTxNonce))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumHeight))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.EthereumTxHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.EthereumLogIndex != 0 {
+		n += 1 + sovMsgs(uint64(m.EthereumLogIndex))
+	}
+	if m.HasEthereumLogIndex {
+		n += 2
+	}
+	l = len(m.EthereumBlockHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOptOutOfBridge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOptOutOfBridgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFreezeOrchestrator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeOrchestratorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendToEthereum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGranter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGranter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfterHeight", wireType)
+			}
+			m.ExecuteAfterHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAfterHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendToEthereumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendToEthereumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendToEthereumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSendToEthereum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSendToEthereumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSendToEthereumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSendToEthereumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestBatchTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestBatchTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestBatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxElements", wireType)
+			}
+			m.MaxElements = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxElements |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestBatchTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestBatchTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestBatchTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEthereumTxConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Confirmation == nil {
+				m.Confirmation = &types1.Any{}
+			}
+			if err := m.Confirmation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallTxConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+			}
+			m.Scheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scheme |= SignatureScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+			}
+			m.Scheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scheme |= SignatureScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			}
+			m.SignerSetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+			}
+			m.Scheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scheme |= SignatureScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEthereumTxConfirmationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &types1.Any{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEthereumEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEthereumEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitAggregatedEthereumEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitAggregatedEthereumEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitAggregatedEthereumEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &types1.Any{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cosignatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cosignatures = append(m.Cosignatures, EthereumEventCosignature{})
+			if err := m.Cosignatures[len(m.Cosignatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumEventCosignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumEventCosignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumEventCosignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitAggregatedEthereumEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitAggregatedEthereumEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitAggregatedEthereumEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthSignature = append(m.EthSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthSignature == nil {
+				m.EthSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureScheme", wireType)
+			}
+			m.SignatureScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureScheme |= SignatureScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysSignMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysSignMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDelegateKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDelegateKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDelegateKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthSignature = append(m.EthSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthSignature == nil {
+				m.EthSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDelegateKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDelegateKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeOrchestratorKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeOrchestratorKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeOrchestratorKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeOrchestratorKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeOrchestratorKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeOrchestratorKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEthereumHeightVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumHeightVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumHeightVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEthereumHeightVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumHeightVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumHeightVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEthereumHeaderVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumHeaderVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumHeaderVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEthereumHeaderVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumHeaderVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumHeaderVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgERC20DeployedConfirm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgERC20DeployedConfirm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgERC20DeployedConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgERC20DeployedConfirmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgERC20DeployedConfirmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgERC20DeployedConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEthereumAnomalyReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumAnomalyReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumAnomalyReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Anomaly", wireType)
+			}
+			m.Anomaly = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Anomaly |= EthereumAnomaly(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEthereumAnomalyReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumAnomalyReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumAnomalyReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterOrchestratorQueryIdentity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3138,15 +10734,49 @@ func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendToEthereum: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterOrchestratorQueryIdentity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterOrchestratorQueryIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyHash = append(m.KeyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyHash == nil {
+				m.KeyHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3174,11 +10804,164 @@ func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterOrchestratorQueryIdentityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterOrchestratorQueryIdentityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterOrchestratorQueryIdentityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteAtomic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteAtomic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteAtomic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types1.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumRecipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3206,11 +10989,61 @@ func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteAtomicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteAtomicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteAtomicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3237,15 +11070,142 @@ func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Responses = append(m.Responses, &types1.Any{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVetoDelayedSendToEthereum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoDelayedSendToEthereum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoDelayedSendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMsgs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3255,24 +11215,23 @@ func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3295,7 +11254,7 @@ func (m *MsgSendToEthereum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendToEthereumResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVetoDelayedSendToEthereumResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3318,31 +11277,12 @@ func (m *MsgSendToEthereumResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendToEthereumResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVetoDelayedSendToEthereumResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendToEthereumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVetoDelayedSendToEthereumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -3364,7 +11304,7 @@ func (m *MsgSendToEthereumResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantBridgeFeeAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3387,17 +11327,17 @@ func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSendToEthereum: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantBridgeFeeAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantBridgeFeeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3407,14 +11347,27 @@ func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3442,7 +11395,41 @@ func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3465,7 +11452,7 @@ func (m *MsgCancelSendToEthereum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSendToEthereumResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantBridgeFeeAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3488,10 +11475,10 @@ func (m *MsgCancelSendToEthereumResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSendToEthereumResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantBridgeFeeAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSendToEthereumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantBridgeFeeAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3515,7 +11502,7 @@ func (m *MsgCancelSendToEthereumResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBatchTx) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeBridgeFeeAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3538,15 +11525,15 @@ func (m *MsgRequestBatchTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBatchTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeBridgeFeeAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeBridgeFeeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3574,11 +11561,11 @@ func (m *MsgRequestBatchTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3606,7 +11593,7 @@ func (m *MsgRequestBatchTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3629,7 +11616,7 @@ func (m *MsgRequestBatchTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBatchTxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeBridgeFeeAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3652,10 +11639,10 @@ func (m *MsgRequestBatchTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBatchTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeBridgeFeeAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBatchTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeBridgeFeeAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3679,7 +11666,7 @@ func (m *MsgRequestBatchTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitEthereumTxConfirmation) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitBadEthereumSignatureEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3702,15 +11689,15 @@ func (m *MsgSubmitEthereumTxConfirmation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitBadEthereumSignatureEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitBadEthereumSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirmation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3737,14 +11724,80 @@ func (m *MsgSubmitEthereumTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Confirmation == nil {
-				m.Confirmation = &types1.Any{}
+			if m.Subject == nil {
+				m.Subject = &types1.Any{}
 			}
-			if err := m.Confirmation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -3763,19 +11816,69 @@ func (m *MsgSubmitEthereumTxConfirmation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBadEthereumSignatureEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBadEthereumSignatureEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBadEthereumSignatureEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -3797,7 +11900,7 @@ func (m *MsgSubmitEthereumTxConfirmation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
+func (m *SendToCosmosEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3820,17 +11923,17 @@ func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTxConfirmation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SendToCosmosEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SendToCosmosEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			var byteLen int
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3840,31 +11943,16 @@ func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
-			if m.InvalidationScope == nil {
-				m.InvalidationScope = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			m.InvalidationNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3874,14 +11962,27 @@ func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3909,13 +12010,15 @@ func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3925,79 +12028,27 @@ func (m *ContractCallTxConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxConfirmation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4025,13 +12076,13 @@ func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
 			}
-			m.BatchNonce = 0
+			m.EthereumHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4041,14 +12092,14 @@ func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
+				m.EthereumHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcForward", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4076,11 +12127,11 @@ func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			m.IbcForward = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4107,66 +12158,50 @@ func (m *BatchTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
+			m.Memo = append(m.Memo[:0], dAtA[iNdEx:postIndex]...)
+			if m.Memo == nil {
+				m.Memo = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumTxHash", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTxConfirmation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTxConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumTxHash = append(m.EthereumTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthereumTxHash == nil {
+				m.EthereumTxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumLogIndex", wireType)
 			}
-			m.SignerSetNonce = 0
+			m.EthereumLogIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4176,14 +12211,14 @@ func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				m.EthereumLogIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4211,11 +12246,33 @@ func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			if err := m.ReceivedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasEthereumLogIndex", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasEthereumLogIndex = bool(v != 0)
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4242,9 +12299,9 @@ func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
+			m.EthereumBlockHash = append(m.EthereumBlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthereumBlockHash == nil {
+				m.EthereumBlockHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4268,7 +12325,7 @@ func (m *SignerSetTxConfirmation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitEthereumTxConfirmationResponse) Unmarshal(dAtA []byte) error {
+func (m *SendEtherToCosmosEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4291,67 +12348,36 @@ func (m *MsgSubmitEthereumTxConfirmationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SendEtherToCosmosEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEthereumTxConfirmationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SendEtherToCosmosEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEthereumEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEthereumEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4361,31 +12387,29 @@ func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Event == nil {
-				m.Event = &types1.Any{}
-			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4413,113 +12437,64 @@ func (m *MsgSubmitEthereumEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSubmitEthereumEventResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEthereumEventResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEthereumEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateKeys: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateKeys: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumTxHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4529,29 +12504,31 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.EthereumTxHash = append(m.EthereumTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthereumTxHash == nil {
+				m.EthereumTxHash = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumLogIndex", wireType)
 			}
-			var stringLen uint64
+			m.EthereumLogIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4561,29 +12538,16 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EthereumLogIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasEthereumLogIndex", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4593,27 +12557,15 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.HasEthereumLogIndex = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4640,9 +12592,9 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthSignature = append(m.EthSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.EthSignature == nil {
-				m.EthSignature = []byte{}
+			m.EthereumBlockHash = append(m.EthereumBlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthereumBlockHash == nil {
+				m.EthereumBlockHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4666,7 +12618,7 @@ func (m *MsgDelegateKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateKeysResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchExecutedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4689,65 +12641,104 @@ func (m *MsgDelegateKeysResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateKeysResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchExecutedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchExecutedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysSignMsg: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysSignMsg: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4775,13 +12766,13 @@ func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumTxHash", wireType)
 			}
-			m.Nonce = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4791,66 +12782,50 @@ func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgEthereumHeightVote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
+			m.EthereumTxHash = append(m.EthereumTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthereumTxHash == nil {
+				m.EthereumTxHash = []byte{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumLogIndex", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EthereumLogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumLogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEthereumHeightVote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEthereumHeightVote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasEthereumLogIndex", wireType)
 			}
-			m.EthereumHeight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4860,16 +12835,17 @@ func (m *MsgEthereumHeightVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumHeight |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.HasEthereumLogIndex = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4879,74 +12855,26 @@ func (m *MsgEthereumHeightVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgEthereumHeightVoteResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EthereumBlockHash = append(m.EthereumBlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthereumBlockHash == nil {
+				m.EthereumBlockHash = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEthereumHeightVoteResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEthereumHeightVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -4968,7 +12896,7 @@ func (m *MsgEthereumHeightVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendToCosmosEvent) Unmarshal(dAtA []byte) error {
+func (m *ContractCallExecutedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4991,10 +12919,10 @@ func (m *SendToCosmosEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendToCosmosEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCallExecutedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendToCosmosEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCallExecutedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5018,9 +12946,9 @@ func (m *SendToCosmosEvent) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5030,29 +12958,31 @@ func (m *SendToCosmosEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
 			}
-			var stringLen uint64
+			m.InvalidationNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5062,31 +12992,55 @@ func (m *SendToCosmosEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+			m.Success = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnDataHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5096,29 +13050,31 @@ func (m *SendToCosmosEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			m.ReturnDataHash = append(m.ReturnDataHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnDataHash == nil {
+				m.ReturnDataHash = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumTxHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5128,29 +13084,31 @@ func (m *SendToCosmosEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			m.EthereumTxHash = append(m.EthereumTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthereumTxHash == nil {
+				m.EthereumTxHash = []byte{}
+			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumLogIndex", wireType)
 			}
-			m.EthereumHeight = 0
+			m.EthereumLogIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5160,11 +13118,65 @@ func (m *SendToCosmosEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumHeight |= uint64(b&0x7F) << shift
+				m.EthereumLogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasEthereumLogIndex", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasEthereumLogIndex = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumBlockHash = append(m.EthereumBlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthereumBlockHash == nil {
+				m.EthereumBlockHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -5186,7 +13198,7 @@ func (m *SendToCosmosEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchExecutedEvent) Unmarshal(dAtA []byte) error {
+func (m *ERC20DeployedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5205,19 +13217,38 @@ func (m *BatchExecutedEvent) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchExecutedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchExecutedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20DeployedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20DeployedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5245,13 +13276,13 @@ func (m *BatchExecutedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			m.EventNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5261,16 +13292,29 @@ func (m *BatchExecutedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			m.EthereumHeight = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5280,16 +13324,29 @@ func (m *BatchExecutedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			m.BatchNonce = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Symbol", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5299,66 +13356,48 @@ func (m *BatchExecutedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContractCallExecutedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Erc20Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Decimals", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Erc20Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Erc20Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallExecutedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallExecutedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
 			}
-			m.EventNonce = 0
+			m.EthereumHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5368,14 +13407,14 @@ func (m *ContractCallExecutedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				m.EthereumHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumTxHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5402,16 +13441,16 @@ func (m *ContractCallExecutedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
-			if m.InvalidationScope == nil {
-				m.InvalidationScope = []byte{}
+			m.EthereumTxHash = append(m.EthereumTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthereumTxHash == nil {
+				m.EthereumTxHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumLogIndex", wireType)
 			}
-			m.InvalidationNonce = 0
+			m.EthereumLogIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5421,16 +13460,16 @@ func (m *ContractCallExecutedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				m.EthereumLogIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasEthereumLogIndex", wireType)
 			}
-			m.EthereumHeight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5440,11 +13479,46 @@ func (m *ContractCallExecutedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumHeight |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasEthereumLogIndex = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumBlockHash = append(m.EthereumBlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthereumBlockHash == nil {
+				m.EthereumBlockHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -5466,7 +13540,7 @@ func (m *ContractCallExecutedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20DeployedEvent) Unmarshal(dAtA []byte) error {
+func (m *SignerSetTxExecutedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5489,10 +13563,10 @@ func (m *ERC20DeployedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20DeployedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignerSetTxExecutedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20DeployedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignerSetTxExecutedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5515,10 +13589,10 @@ func (m *ERC20DeployedEvent) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetTxNonce", wireType)
 			}
-			var stringLen uint64
+			m.SignerSetTxNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5528,29 +13602,16 @@ func (m *ERC20DeployedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SignerSetTxNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
 			}
-			var stringLen uint64
+			m.EthereumHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5560,29 +13621,16 @@ func (m *ERC20DeployedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EthereumHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5592,29 +13640,31 @@ func (m *ERC20DeployedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Name = string(dAtA[iNdEx:postIndex])
+			m.Members = append(m.Members, &EthereumSigner{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumTxHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5624,29 +13674,31 @@ func (m *ERC20DeployedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Symbol = string(dAtA[iNdEx:postIndex])
+			m.EthereumTxHash = append(m.EthereumTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthereumTxHash == nil {
+				m.EthereumTxHash = []byte{}
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Decimals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumLogIndex", wireType)
 			}
-			m.Erc20Decimals = 0
+			m.EthereumLogIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5656,16 +13708,16 @@ func (m *ERC20DeployedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Erc20Decimals |= uint64(b&0x7F) << shift
+				m.EthereumLogIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasEthereumLogIndex", wireType)
 			}
-			m.EthereumHeight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5675,11 +13727,46 @@ func (m *ERC20DeployedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumHeight |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasEthereumLogIndex = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumBlockHash = append(m.EthereumBlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthereumBlockHash == nil {
+				m.EthereumBlockHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -5701,7 +13788,7 @@ func (m *ERC20DeployedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignerSetTxExecutedEvent) Unmarshal(dAtA []byte) error {
+func (m *MsgOptOutOfBridge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5724,17 +13811,17 @@ func (m *SignerSetTxExecutedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTxExecutedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOptOutOfBridge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTxExecutedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOptOutOfBridge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.EventNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5744,54 +13831,129 @@ func (m *SignerSetTxExecutedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetTxNonce", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			m.SignerSetTxNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SignerSetTxNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.EthereumHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EthereumHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOptOutOfBridgeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOptOutOfBridgeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOptOutOfBridgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeOrchestrator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeOrchestrator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeOrchestrator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5801,26 +13963,74 @@ func (m *SignerSetTxExecutedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &EthereumSigner{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeOrchestratorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeOrchestratorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeOrchestratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -78,3 +78,33 @@ func TestValidateMsgDelegateKeys(t *testing.T) {
 	}
 
 }
+
+func TestNewMsgSubmitEthereumEventAndConfirmation(t *testing.T) {
+	signer := sdk.AccAddress(bytes.Repeat([]byte{0x1}, app.MaxAddrLen))
+
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		Amount:         sdk.NewInt(100),
+		EthereumSender: "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255",
+		CosmosReceiver: signer.String(),
+		EthereumHeight: 10,
+	}
+	eventMsg, err := types.NewMsgSubmitEthereumEvent(event, signer)
+	assert.NoError(t, err)
+	assert.Equal(t, signer.String(), eventMsg.Signer)
+	unpackedEvent, err := types.UnpackEvent(eventMsg.Event)
+	assert.NoError(t, err)
+	assert.Equal(t, event, unpackedEvent)
+
+	confirmation := &types.SignerSetTxConfirmation{
+		SignerSetNonce: 1,
+		EthereumSigner: "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255",
+		Signature:      []byte("signature"),
+	}
+	confirmationMsg, err := types.NewMsgSubmitEthereumTxConfirmation(confirmation, signer)
+	assert.NoError(t, err)
+	unpackedConfirmation, err := types.UnpackConfirmation(confirmationMsg.Confirmation)
+	assert.NoError(t, err)
+	assert.Equal(t, confirmation, unpackedConfirmation)
+}
@@ -0,0 +1,43 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// Oracle decides when an ethereum event vote record is observed, that is when
+// the event it holds is applied to state. Orchestrators submit events, vote
+// records are stored and observed events are applied in event nonce order the
+// same way whatever the oracle, only the acceptance rule is swapped, e.g. for
+// an optimistic or light client backed oracle.
+type Oracle interface {
+	IsObserved(ctx sdk.Context, event EthereumEvent, record *EthereumEventVoteRecord) bool
+}
+
+var _ Oracle = VotingOracle{}
+
+// VotingOracle observes an event once the validators that voted for it hold
+// the event vote record power threshold. It is the default oracle.
+type VotingOracle struct {
+	StakingKeeper StakingKeeper
+	// Threshold returns the fraction of the total power required, read from
+	// the event vote power threshold param by the gravity keeper
+	Threshold func(ctx sdk.Context) sdk.Dec
+}
+
+// NewVotingOracle returns a new VotingOracle
+func NewVotingOracle(stakingKeeper StakingKeeper, threshold func(ctx sdk.Context) sdk.Dec) VotingOracle {
+	return VotingOracle{StakingKeeper: stakingKeeper, Threshold: threshold}
+}
+
+// IsObserved sums the current powers of all validators who have voted and
+// checks it against the current threshold
+func (o VotingOracle) IsObserved(ctx sdk.Context, _ EthereumEvent, record *EthereumEventVoteRecord) bool {
+	requiredPower := EventVoteRecordPowerThreshold(o.StakingKeeper.GetLastTotalPower(ctx), o.Threshold(ctx))
+	eventVotePower := sdk.NewInt(0)
+	for _, validator := range record.Votes {
+		val, _ := sdk.ValAddressFromBech32(validator)
+		eventVotePower = eventVotePower.Add(sdk.NewInt(o.StakingKeeper.GetLastValidatorPower(ctx, val)))
+		if eventVotePower.GTE(requiredPower) {
+			return true
+		}
+	}
+	return false
+}
@@ -64,6 +64,35 @@ func (cctx *ContractCallTx) GetCosmosHeight() uint64 {
 	return cctx.Height
 }
 
+// OutgoingTxTimeout returns the ethereum height past which an outgoing tx
+// times out, signer sets never time out
+func OutgoingTxTimeout(otx OutgoingTx) (uint64, bool) {
+	switch tx := otx.(type) {
+	case *BatchTx:
+		return tx.Timeout, true
+	case *ContractCallTx:
+		return tx.Timeout, true
+	default:
+		return 0, false
+	}
+}
+
+// OutgoingTxNonce returns the nonce of an outgoing tx and the scope it
+// increases in on ethereum: the outgoing tx prefix byte, followed by the
+// invalidation scope for contract calls
+func OutgoingTxNonce(otx OutgoingTx) (scope []byte, nonce uint64) {
+	switch tx := otx.(type) {
+	case *SignerSetTx:
+		return []byte{SignerSetTxPrefixByte}, tx.Nonce
+	case *BatchTx:
+		return []byte{BatchTxPrefixByte}, tx.BatchNonce
+	case *ContractCallTx:
+		return append([]byte{ContractCallTxPrefixByte}, tx.InvalidationScope...), tx.InvalidationNonce
+	default:
+		return nil, 0
+	}
+}
+
 ///////////////////
 // GetCheckpoint //
 ///////////////////
@@ -182,11 +211,46 @@ func (c ContractCallTx) GetCheckpoint(gravityID []byte) []byte {
 		feeAmounts[i] = coin.Amount.BigInt()
 		feeTokenContracts[i] = gethcommon.HexToAddress(coin.Contract)
 	}
-	payload := make([]byte, len(c.Payload))
-	copy(payload, c.Payload)
 	var invalidationId [32]byte
 	copy(invalidationId[:], c.InvalidationScope[:])
 
+	// multi calls are signed under their own method name, so that their
+	// signatures can't be replayed as a single call
+	if len(c.Calls) > 0 {
+		var multiLogicCallMethodName [32]uint8
+		copy(multiLogicCallMethodName[:], []uint8("multiLogicCall"))
+
+		logicContractAddresses := make([]gethcommon.Address, len(c.Calls))
+		payloads := make([][]byte, len(c.Calls))
+		values := make([]*big.Int, len(c.Calls))
+		for i, call := range c.Calls {
+			logicContractAddresses[i] = gethcommon.HexToAddress(call.Address)
+			payloads[i] = append([]byte{}, call.Payload...)
+			values[i] = big.NewInt(0)
+			if !call.Value.IsNil() {
+				values[i] = call.Value.BigInt()
+			}
+		}
+
+		return packCall(OutgoingMultiLogicCallABIJSON, "checkpoint", []interface{}{
+			gravityIDFixed,
+			multiLogicCallMethodName,
+			transferAmounts,
+			transferTokenContracts,
+			feeAmounts,
+			feeTokenContracts,
+			logicContractAddresses,
+			payloads,
+			values,
+			big.NewInt(int64(c.Timeout)),
+			invalidationId,
+			big.NewInt(int64(c.InvalidationNonce)),
+		})
+	}
+
+	payload := make([]byte, len(c.Payload))
+	copy(payload, c.Payload)
+
 	// the methodName needs to be the same as the 'name' above in the checkpointAbiJson
 	// but other than that it's a constant that has no impact on the output. This is because
 	// it gets encoded as a function name which we must then discard.
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -12,13 +13,44 @@ import (
 const (
 	// ProposalTypeCommunityPoolEthereumSpend defines the type for a CommunityPoolEthereumSpendProposal
 	ProposalTypeCommunityPoolEthereumSpend = "CommunityPoolEthereumSpend"
+	// ProposalTypeEthereumBlocklist defines the type for an EthereumBlocklistProposal
+	ProposalTypeEthereumBlocklist = "EthereumBlocklist"
+
+	// ProposalTypeBridgeReenable defines the type for a BridgeReenableProposal
+	ProposalTypeBridgeReenable = "BridgeReenable"
+
+	// ProposalTypeDelayedSendToEthereumVeto defines the type for a DelayedSendToEthereumVetoProposal
+	ProposalTypeDelayedSendToEthereumVeto = "DelayedSendToEthereumVeto"
+
+	// ProposalTypeHeldSendToCosmosRelease defines the type for a HeldSendToCosmosReleaseProposal
+	ProposalTypeHeldSendToCosmosRelease = "HeldSendToCosmosRelease"
+
+	// ProposalTypeEmergencySignerSetUpdate defines the type for an EmergencySignerSetUpdateProposal
+	ProposalTypeEmergencySignerSetUpdate = "EmergencySignerSetUpdate"
+
+	// ProposalTypeSendToEthereumPriority defines the type for a SendToEthereumPriorityProposal
+	ProposalTypeSendToEthereumPriority = "SendToEthereumPriority"
 )
 
-// Assert CommunityPoolEthereumSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = &CommunityPoolEthereumSpendProposal{}
+// Assert proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &CommunityPoolEthereumSpendProposal{}
+	_ govtypes.Content = &EthereumBlocklistProposal{}
+	_ govtypes.Content = &BridgeReenableProposal{}
+	_ govtypes.Content = &DelayedSendToEthereumVetoProposal{}
+	_ govtypes.Content = &HeldSendToCosmosReleaseProposal{}
+	_ govtypes.Content = &EmergencySignerSetUpdateProposal{}
+	_ govtypes.Content = &SendToEthereumPriorityProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolEthereumSpend)
+	govtypes.RegisterProposalType(ProposalTypeEthereumBlocklist)
+	govtypes.RegisterProposalType(ProposalTypeBridgeReenable)
+	govtypes.RegisterProposalType(ProposalTypeDelayedSendToEthereumVeto)
+	govtypes.RegisterProposalType(ProposalTypeHeldSendToCosmosRelease)
+	govtypes.RegisterProposalType(ProposalTypeEmergencySignerSetUpdate)
+	govtypes.RegisterProposalType(ProposalTypeSendToEthereumPriority)
 }
 
 // NewCommunityPoolEthereumSpendProposal creates a new community pool spend proposal.
@@ -79,3 +111,280 @@ func (csp CommunityPoolEthereumSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount, csp.BridgeFee))
 	return b.String()
 }
+
+// NewEthereumBlocklistProposal creates a new Ethereum blocklist proposal.
+func NewEthereumBlocklistProposal(title, description string, addAddresses, removeAddresses []string) *EthereumBlocklistProposal {
+	return &EthereumBlocklistProposal{title, description, addAddresses, removeAddresses}
+}
+
+// GetTitle returns the title of an Ethereum blocklist proposal.
+func (ebp *EthereumBlocklistProposal) GetTitle() string { return ebp.Title }
+
+// GetDescription returns the description of an Ethereum blocklist proposal.
+func (ebp *EthereumBlocklistProposal) GetDescription() string { return ebp.Description }
+
+// ProposalRoute returns the routing key of an Ethereum blocklist proposal.
+func (ebp *EthereumBlocklistProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an Ethereum blocklist proposal.
+func (ebp *EthereumBlocklistProposal) ProposalType() string {
+	return ProposalTypeEthereumBlocklist
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (ebp *EthereumBlocklistProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(ebp)
+	if err != nil {
+		return err
+	}
+
+	if len(ebp.AddAddresses) == 0 && len(ebp.RemoveAddresses) == 0 {
+		return sdkerrors.Wrap(ErrInvalidEthereumBlocklistProposal, "no addresses to add or remove")
+	}
+
+	seen := make(map[string]bool)
+	for _, addr := range append(append([]string{}, ebp.AddAddresses...), ebp.RemoveAddresses...) {
+		if !common.IsHexAddress(addr) {
+			return sdkerrors.Wrapf(ErrInvalidEthereumBlocklistProposal, "invalid ethereum address %s", addr)
+		}
+		normalized := common.HexToAddress(addr).Hex()
+		if seen[normalized] {
+			return sdkerrors.Wrapf(ErrInvalidEthereumBlocklistProposal, "duplicate ethereum address %s", addr)
+		}
+		seen[normalized] = true
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (ebp EthereumBlocklistProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Ethereum Blocklist Proposal:
+  Title:            %s
+  Description:      %s
+  Add Addresses:    %s
+  Remove Addresses: %s
+`, ebp.Title, ebp.Description, strings.Join(ebp.AddAddresses, ", "), strings.Join(ebp.RemoveAddresses, ", ")))
+	return b.String()
+}
+
+// NewBridgeReenableProposal creates a new bridge re-enable proposal.
+func NewBridgeReenableProposal(title, description string) *BridgeReenableProposal {
+	return &BridgeReenableProposal{title, description}
+}
+
+// GetTitle returns the title of a bridge re-enable proposal.
+func (brp *BridgeReenableProposal) GetTitle() string { return brp.Title }
+
+// GetDescription returns the description of a bridge re-enable proposal.
+func (brp *BridgeReenableProposal) GetDescription() string { return brp.Description }
+
+// ProposalRoute returns the routing key of a bridge re-enable proposal.
+func (brp *BridgeReenableProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a bridge re-enable proposal.
+func (brp *BridgeReenableProposal) ProposalType() string {
+	return ProposalTypeBridgeReenable
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (brp *BridgeReenableProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(brp)
+}
+
+// String implements the Stringer interface.
+func (brp BridgeReenableProposal) String() string {
+	return fmt.Sprintf(`Bridge Reenable Proposal:
+  Title:       %s
+  Description: %s
+`, brp.Title, brp.Description)
+}
+
+// NewDelayedSendToEthereumVetoProposal creates a new delayed send to Ethereum veto proposal.
+func NewDelayedSendToEthereumVetoProposal(title, description string, ids []uint64) *DelayedSendToEthereumVetoProposal {
+	return &DelayedSendToEthereumVetoProposal{title, description, ids}
+}
+
+// GetTitle returns the title of a delayed send to Ethereum veto proposal.
+func (vp *DelayedSendToEthereumVetoProposal) GetTitle() string { return vp.Title }
+
+// GetDescription returns the description of a delayed send to Ethereum veto proposal.
+func (vp *DelayedSendToEthereumVetoProposal) GetDescription() string { return vp.Description }
+
+// ProposalRoute returns the routing key of a delayed send to Ethereum veto proposal.
+func (vp *DelayedSendToEthereumVetoProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a delayed send to Ethereum veto proposal.
+func (vp *DelayedSendToEthereumVetoProposal) ProposalType() string {
+	return ProposalTypeDelayedSendToEthereumVeto
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (vp *DelayedSendToEthereumVetoProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(vp); err != nil {
+		return err
+	}
+	return validateDelayedSendToEthereumIDs(vp.Ids)
+}
+
+// String implements the Stringer interface.
+func (vp DelayedSendToEthereumVetoProposal) String() string {
+	return fmt.Sprintf(`Delayed Send To Ethereum Veto Proposal:
+  Title:       %s
+  Description: %s
+  IDs:         %v
+`, vp.Title, vp.Description, vp.Ids)
+}
+
+// NewHeldSendToCosmosReleaseProposal creates a new held send to Cosmos release proposal.
+func NewHeldSendToCosmosReleaseProposal(title, description, tokenContract string, eventNonces []uint64) *HeldSendToCosmosReleaseProposal {
+	return &HeldSendToCosmosReleaseProposal{title, description, tokenContract, eventNonces}
+}
+
+// GetTitle returns the title of a held send to Cosmos release proposal.
+func (rp *HeldSendToCosmosReleaseProposal) GetTitle() string { return rp.Title }
+
+// GetDescription returns the description of a held send to Cosmos release proposal.
+func (rp *HeldSendToCosmosReleaseProposal) GetDescription() string { return rp.Description }
+
+// ProposalRoute returns the routing key of a held send to Cosmos release proposal.
+func (rp *HeldSendToCosmosReleaseProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a held send to Cosmos release proposal.
+func (rp *HeldSendToCosmosReleaseProposal) ProposalType() string {
+	return ProposalTypeHeldSendToCosmosRelease
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (rp *HeldSendToCosmosReleaseProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(rp); err != nil {
+		return err
+	}
+	if err := ValidateEthAddress(rp.TokenContract); err != nil {
+		return sdkerrors.Wrap(err, "token contract")
+	}
+	if len(rp.EventNonces) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "no event nonces")
+	}
+
+	seen := make(map[uint64]bool, len(rp.EventNonces))
+	for _, nonce := range rp.EventNonces {
+		if nonce == 0 {
+			return sdkerrors.Wrap(ErrInvalid, "event nonce cannot be zero")
+		}
+		if seen[nonce] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate event nonce %d", nonce)
+		}
+		seen[nonce] = true
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (rp HeldSendToCosmosReleaseProposal) String() string {
+	return fmt.Sprintf(`Held Send To Cosmos Release Proposal:
+  Title:          %s
+  Description:    %s
+  Token Contract: %s
+  Event Nonces:   %v
+`, rp.Title, rp.Description, rp.TokenContract, rp.EventNonces)
+}
+
+// NewEmergencySignerSetUpdateProposal creates a new emergency signer set update proposal.
+func NewEmergencySignerSetUpdateProposal(title, description string, excludedEthereumAddresses []string) *EmergencySignerSetUpdateProposal {
+	return &EmergencySignerSetUpdateProposal{title, description, excludedEthereumAddresses}
+}
+
+// GetTitle returns the title of an emergency signer set update proposal.
+func (sp *EmergencySignerSetUpdateProposal) GetTitle() string { return sp.Title }
+
+// GetDescription returns the description of an emergency signer set update proposal.
+func (sp *EmergencySignerSetUpdateProposal) GetDescription() string { return sp.Description }
+
+// ProposalRoute returns the routing key of an emergency signer set update proposal.
+func (sp *EmergencySignerSetUpdateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an emergency signer set update proposal.
+func (sp *EmergencySignerSetUpdateProposal) ProposalType() string {
+	return ProposalTypeEmergencySignerSetUpdate
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (sp *EmergencySignerSetUpdateProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(sp); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(sp.ExcludedEthereumAddresses))
+	for _, addr := range sp.ExcludedEthereumAddresses {
+		if !common.IsHexAddress(addr) {
+			return sdkerrors.Wrapf(ErrInvalid, "invalid ethereum address %s", addr)
+		}
+		normalized := common.HexToAddress(addr).Hex()
+		if seen[normalized] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate ethereum address %s", addr)
+		}
+		seen[normalized] = true
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (sp EmergencySignerSetUpdateProposal) String() string {
+	return fmt.Sprintf(`Emergency Signer Set Update Proposal:
+  Title:              %s
+  Description:        %s
+  Excluded Addresses: %s
+`, sp.Title, sp.Description, strings.Join(sp.ExcludedEthereumAddresses, ", "))
+}
+
+// NewSendToEthereumPriorityProposal creates a new send to Ethereum priority proposal.
+func NewSendToEthereumPriorityProposal(title, description string, ids []uint64) *SendToEthereumPriorityProposal {
+	return &SendToEthereumPriorityProposal{title, description, ids}
+}
+
+// GetTitle returns the title of a send to Ethereum priority proposal.
+func (pp *SendToEthereumPriorityProposal) GetTitle() string { return pp.Title }
+
+// GetDescription returns the description of a send to Ethereum priority proposal.
+func (pp *SendToEthereumPriorityProposal) GetDescription() string { return pp.Description }
+
+// ProposalRoute returns the routing key of a send to Ethereum priority proposal.
+func (pp *SendToEthereumPriorityProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a send to Ethereum priority proposal.
+func (pp *SendToEthereumPriorityProposal) ProposalType() string {
+	return ProposalTypeSendToEthereumPriority
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (pp *SendToEthereumPriorityProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(pp); err != nil {
+		return err
+	}
+
+	if len(pp.Ids) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "no send to ethereum ids")
+	}
+	seen := make(map[uint64]bool, len(pp.Ids))
+	for _, id := range pp.Ids {
+		if id == 0 {
+			return sdkerrors.Wrap(ErrInvalid, "send to ethereum id cannot be zero")
+		}
+		if seen[id] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate send to ethereum id %d", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (pp SendToEthereumPriorityProposal) String() string {
+	return fmt.Sprintf(`Send To Ethereum Priority Proposal:
+  Title:       %s
+  Description: %s
+  IDs:         %v
+`, pp.Title, pp.Description, pp.Ids)
+}
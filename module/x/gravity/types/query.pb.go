@@ -12,6 +12,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	crypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -32,7 +33,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-//  rpc Params
+// BatchTxSignatureStatus filters batches on whether validators holding the
+// vote power threshold of the last observed signer set have signed them
+type BatchTxSignatureStatus int32
+
+const (
+	BATCH_TX_SIGNATURE_STATUS_UNSPECIFIED BatchTxSignatureStatus = 0
+	BATCH_TX_SIGNATURE_STATUS_SIGNED      BatchTxSignatureStatus = 1
+	BATCH_TX_SIGNATURE_STATUS_UNSIGNED    BatchTxSignatureStatus = 2
+)
+
+var BatchTxSignatureStatus_name = map[int32]string{
+	0: "BATCH_TX_SIGNATURE_STATUS_UNSPECIFIED",
+	1: "BATCH_TX_SIGNATURE_STATUS_SIGNED",
+	2: "BATCH_TX_SIGNATURE_STATUS_UNSIGNED",
+}
+
+var BatchTxSignatureStatus_value = map[string]int32{
+	"BATCH_TX_SIGNATURE_STATUS_UNSPECIFIED": 0,
+	"BATCH_TX_SIGNATURE_STATUS_SIGNED":      1,
+	"BATCH_TX_SIGNATURE_STATUS_UNSIGNED":    2,
+}
+
+func (x BatchTxSignatureStatus) String() string {
+	return proto.EnumName(BatchTxSignatureStatus_name, int32(x))
+}
+
+func (BatchTxSignatureStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{0}
+}
+
+// rpc Params
 type ParamsRequest struct {
 }
 
@@ -113,7 +144,7 @@ func (m *ParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-//  rpc SignerSetTx
+// rpc SignerSetTx
 type SignerSetTxRequest struct {
 	SignerSetNonce uint64 `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
 }
@@ -238,7 +269,96 @@ func (m *SignerSetTxResponse) GetSignerSet() *SignerSetTx {
 	return nil
 }
 
-//  rpc BatchTx
+// rpc SignerSetTxDelta
+type SignerSetTxDeltaRequest struct {
+	SignerSetNonce uint64 `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
+}
+
+func (m *SignerSetTxDeltaRequest) Reset()         { *m = SignerSetTxDeltaRequest{} }
+func (m *SignerSetTxDeltaRequest) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxDeltaRequest) ProtoMessage()    {}
+func (*SignerSetTxDeltaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{5}
+}
+func (m *SignerSetTxDeltaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSetTxDeltaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetTxDeltaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerSetTxDeltaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxDeltaRequest.Merge(m, src)
+}
+func (m *SignerSetTxDeltaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSetTxDeltaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxDeltaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerSetTxDeltaRequest proto.InternalMessageInfo
+
+func (m *SignerSetTxDeltaRequest) GetSignerSetNonce() uint64 {
+	if m != nil {
+		return m.SignerSetNonce
+	}
+	return 0
+}
+
+type SignerSetTxDeltaResponse struct {
+	Delta *SignerSetTxDelta `protobuf:"bytes,1,opt,name=delta,proto3" json:"delta,omitempty"`
+}
+
+func (m *SignerSetTxDeltaResponse) Reset()         { *m = SignerSetTxDeltaResponse{} }
+func (m *SignerSetTxDeltaResponse) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxDeltaResponse) ProtoMessage()    {}
+func (*SignerSetTxDeltaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{6}
+}
+func (m *SignerSetTxDeltaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSetTxDeltaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetTxDeltaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerSetTxDeltaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxDeltaResponse.Merge(m, src)
+}
+func (m *SignerSetTxDeltaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSetTxDeltaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxDeltaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerSetTxDeltaResponse proto.InternalMessageInfo
+
+func (m *SignerSetTxDeltaResponse) GetDelta() *SignerSetTxDelta {
+	if m != nil {
+		return m.Delta
+	}
+	return nil
+}
+
+// rpc BatchTx
 type BatchTxRequest struct {
 	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
 	BatchNonce    uint64 `protobuf:"varint,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
@@ -248,7 +368,7 @@ func (m *BatchTxRequest) Reset()         { *m = BatchTxRequest{} }
 func (m *BatchTxRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchTxRequest) ProtoMessage()    {}
 func (*BatchTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{5}
+	return fileDescriptor_29a9d4192703013c, []int{7}
 }
 func (m *BatchTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,7 +419,7 @@ func (m *BatchTxResponse) Reset()         { *m = BatchTxResponse{} }
 func (m *BatchTxResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchTxResponse) ProtoMessage()    {}
 func (*BatchTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{6}
+	return fileDescriptor_29a9d4192703013c, []int{8}
 }
 func (m *BatchTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -335,7 +455,7 @@ func (m *BatchTxResponse) GetBatch() *BatchTx {
 	return nil
 }
 
-//  rpc ContractCallTx
+// rpc ContractCallTx
 type ContractCallTxRequest struct {
 	InvalidationScope []byte `protobuf:"bytes,1,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
 	InvalidationNonce uint64 `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
@@ -345,7 +465,7 @@ func (m *ContractCallTxRequest) Reset()         { *m = ContractCallTxRequest{} }
 func (m *ContractCallTxRequest) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTxRequest) ProtoMessage()    {}
 func (*ContractCallTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{7}
+	return fileDescriptor_29a9d4192703013c, []int{9}
 }
 func (m *ContractCallTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,7 +516,7 @@ func (m *ContractCallTxResponse) Reset()         { *m = ContractCallTxResponse{}
 func (m *ContractCallTxResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTxResponse) ProtoMessage()    {}
 func (*ContractCallTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{8}
+	return fileDescriptor_29a9d4192703013c, []int{10}
 }
 func (m *ContractCallTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,7 +561,7 @@ func (m *SignerSetTxConfirmationsRequest) Reset()         { *m = SignerSetTxConf
 func (m *SignerSetTxConfirmationsRequest) String() string { return proto.CompactTextString(m) }
 func (*SignerSetTxConfirmationsRequest) ProtoMessage()    {}
 func (*SignerSetTxConfirmationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{9}
+	return fileDescriptor_29a9d4192703013c, []int{11}
 }
 func (m *SignerSetTxConfirmationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,7 +605,7 @@ func (m *SignerSetTxConfirmationsResponse) Reset()         { *m = SignerSetTxCon
 func (m *SignerSetTxConfirmationsResponse) String() string { return proto.CompactTextString(m) }
 func (*SignerSetTxConfirmationsResponse) ProtoMessage()    {}
 func (*SignerSetTxConfirmationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{10}
+	return fileDescriptor_29a9d4192703013c, []int{12}
 }
 func (m *SignerSetTxConfirmationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,7 +641,7 @@ func (m *SignerSetTxConfirmationsResponse) GetSignatures() []*SignerSetTxConfirm
 	return nil
 }
 
-//  rpc SignerSetTxs
+// rpc SignerSetTxs
 type SignerSetTxsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -530,7 +650,7 @@ func (m *SignerSetTxsRequest) Reset()         { *m = SignerSetTxsRequest{} }
 func (m *SignerSetTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*SignerSetTxsRequest) ProtoMessage()    {}
 func (*SignerSetTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{11}
+	return fileDescriptor_29a9d4192703013c, []int{13}
 }
 func (m *SignerSetTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,7 +695,7 @@ func (m *SignerSetTxsResponse) Reset()         { *m = SignerSetTxsResponse{} }
 func (m *SignerSetTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*SignerSetTxsResponse) ProtoMessage()    {}
 func (*SignerSetTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{12}
+	return fileDescriptor_29a9d4192703013c, []int{14}
 }
 func (m *SignerSetTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,16 +738,23 @@ func (m *SignerSetTxsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-//  rpc BatchTxs
+// rpc BatchTxs
 type BatchTxsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only return batches of this token contract
+	TokenContract string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	// only return batches whose total fee is at least this amount
+	MinTotalFee string `protobuf:"bytes,3,opt,name=min_total_fee,json=minTotalFee,proto3" json:"min_total_fee,omitempty"`
+	// only return batches timing out before this ethereum height
+	TimeoutBeforeHeight uint64                 `protobuf:"varint,4,opt,name=timeout_before_height,json=timeoutBeforeHeight,proto3" json:"timeout_before_height,omitempty"`
+	SignatureStatus     BatchTxSignatureStatus `protobuf:"varint,5,opt,name=signature_status,json=signatureStatus,proto3,enum=gravity.v1.BatchTxSignatureStatus" json:"signature_status,omitempty"`
 }
 
 func (m *BatchTxsRequest) Reset()         { *m = BatchTxsRequest{} }
 func (m *BatchTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchTxsRequest) ProtoMessage()    {}
 func (*BatchTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{13}
+	return fileDescriptor_29a9d4192703013c, []int{15}
 }
 func (m *BatchTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -663,6 +790,34 @@ func (m *BatchTxsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *BatchTxsRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *BatchTxsRequest) GetMinTotalFee() string {
+	if m != nil {
+		return m.MinTotalFee
+	}
+	return ""
+}
+
+func (m *BatchTxsRequest) GetTimeoutBeforeHeight() uint64 {
+	if m != nil {
+		return m.TimeoutBeforeHeight
+	}
+	return 0
+}
+
+func (m *BatchTxsRequest) GetSignatureStatus() BatchTxSignatureStatus {
+	if m != nil {
+		return m.SignatureStatus
+	}
+	return BATCH_TX_SIGNATURE_STATUS_UNSPECIFIED
+}
+
 type BatchTxsResponse struct {
 	Batches    []*BatchTx          `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -672,7 +827,7 @@ func (m *BatchTxsResponse) Reset()         { *m = BatchTxsResponse{} }
 func (m *BatchTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchTxsResponse) ProtoMessage()    {}
 func (*BatchTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{14}
+	return fileDescriptor_29a9d4192703013c, []int{16}
 }
 func (m *BatchTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -715,7 +870,7 @@ func (m *BatchTxsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-//  rpc ContractCallTxs
+// rpc ContractCallTxs
 type ContractCallTxsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -724,7 +879,7 @@ func (m *ContractCallTxsRequest) Reset()         { *m = ContractCallTxsRequest{}
 func (m *ContractCallTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTxsRequest) ProtoMessage()    {}
 func (*ContractCallTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{15}
+	return fileDescriptor_29a9d4192703013c, []int{17}
 }
 func (m *ContractCallTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -769,7 +924,7 @@ func (m *ContractCallTxsResponse) Reset()         { *m = ContractCallTxsResponse
 func (m *ContractCallTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTxsResponse) ProtoMessage()    {}
 func (*ContractCallTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{16}
+	return fileDescriptor_29a9d4192703013c, []int{18}
 }
 func (m *ContractCallTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +978,7 @@ func (m *UnsignedSignerSetTxsRequest) Reset()         { *m = UnsignedSignerSetTx
 func (m *UnsignedSignerSetTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsignedSignerSetTxsRequest) ProtoMessage()    {}
 func (*UnsignedSignerSetTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{17}
+	return fileDescriptor_29a9d4192703013c, []int{19}
 }
 func (m *UnsignedSignerSetTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -867,7 +1022,7 @@ func (m *UnsignedSignerSetTxsResponse) Reset()         { *m = UnsignedSignerSetT
 func (m *UnsignedSignerSetTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*UnsignedSignerSetTxsResponse) ProtoMessage()    {}
 func (*UnsignedSignerSetTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{18}
+	return fileDescriptor_29a9d4192703013c, []int{20}
 }
 func (m *UnsignedSignerSetTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -913,7 +1068,7 @@ func (m *UnsignedBatchTxsRequest) Reset()         { *m = UnsignedBatchTxsRequest
 func (m *UnsignedBatchTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsignedBatchTxsRequest) ProtoMessage()    {}
 func (*UnsignedBatchTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{19}
+	return fileDescriptor_29a9d4192703013c, []int{21}
 }
 func (m *UnsignedBatchTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -958,7 +1113,7 @@ func (m *UnsignedBatchTxsResponse) Reset()         { *m = UnsignedBatchTxsRespon
 func (m *UnsignedBatchTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*UnsignedBatchTxsResponse) ProtoMessage()    {}
 func (*UnsignedBatchTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{20}
+	return fileDescriptor_29a9d4192703013c, []int{22}
 }
 func (m *UnsignedBatchTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -994,7 +1149,7 @@ func (m *UnsignedBatchTxsResponse) GetBatches() []*BatchTx {
 	return nil
 }
 
-//  rpc UnsignedContractCallTxs
+// rpc UnsignedContractCallTxs
 type UnsignedContractCallTxsRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
@@ -1003,7 +1158,7 @@ func (m *UnsignedContractCallTxsRequest) Reset()         { *m = UnsignedContract
 func (m *UnsignedContractCallTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsignedContractCallTxsRequest) ProtoMessage()    {}
 func (*UnsignedContractCallTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{21}
+	return fileDescriptor_29a9d4192703013c, []int{23}
 }
 func (m *UnsignedContractCallTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1202,7 @@ func (m *UnsignedContractCallTxsResponse) Reset()         { *m = UnsignedContrac
 func (m *UnsignedContractCallTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*UnsignedContractCallTxsResponse) ProtoMessage()    {}
 func (*UnsignedContractCallTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{22}
+	return fileDescriptor_29a9d4192703013c, []int{24}
 }
 func (m *UnsignedContractCallTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1090,7 +1245,7 @@ func (m *BatchTxFeesRequest) Reset()         { *m = BatchTxFeesRequest{} }
 func (m *BatchTxFeesRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchTxFeesRequest) ProtoMessage()    {}
 func (*BatchTxFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{23}
+	return fileDescriptor_29a9d4192703013c, []int{25}
 }
 func (m *BatchTxFeesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1127,7 +1282,7 @@ func (m *BatchTxFeesResponse) Reset()         { *m = BatchTxFeesResponse{} }
 func (m *BatchTxFeesResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchTxFeesResponse) ProtoMessage()    {}
 func (*BatchTxFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{24}
+	return fileDescriptor_29a9d4192703013c, []int{26}
 }
 func (m *BatchTxFeesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1163,6 +1318,178 @@ func (m *BatchTxFeesResponse) GetFees() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+type BatchFeesRequest struct {
+	// number of txs the fees of the next batch are summed over, the batch max
+	// element param when zero
+	MaxElements uint64             `protobuf:"varint,1,opt,name=max_elements,json=maxElements,proto3" json:"max_elements,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *BatchFeesRequest) Reset()         { *m = BatchFeesRequest{} }
+func (m *BatchFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchFeesRequest) ProtoMessage()    {}
+func (*BatchFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{27}
+}
+func (m *BatchFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchFeesRequest.Merge(m, src)
+}
+func (m *BatchFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchFeesRequest proto.InternalMessageInfo
+
+func (m *BatchFeesRequest) GetMaxElements() uint64 {
+	if m != nil {
+		return m.MaxElements
+	}
+	return 0
+}
+
+func (m *BatchFeesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type BatchFeesResponse struct {
+	Tokens     []TokenBatchFees    `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *BatchFeesResponse) Reset()         { *m = BatchFeesResponse{} }
+func (m *BatchFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchFeesResponse) ProtoMessage()    {}
+func (*BatchFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{28}
+}
+func (m *BatchFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchFeesResponse.Merge(m, src)
+}
+func (m *BatchFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchFeesResponse proto.InternalMessageInfo
+
+func (m *BatchFeesResponse) GetTokens() []TokenBatchFees {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *BatchFeesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// TokenBatchFees are the fees of the unbatched txs of a token, in ERC20 units
+type TokenBatchFees struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	// fees of all the unbatched txs of the token
+	TotalFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_fee,json=totalFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_fee"`
+	// fees of the txs the next batch of the token would hold
+	TopFee  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=top_fee,json=topFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"top_fee"`
+	TxCount uint64                                 `protobuf:"varint,4,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// cosmos blocks since the oldest unbatched tx of the token was created
+	OldestTxAge uint64 `protobuf:"varint,5,opt,name=oldest_tx_age,json=oldestTxAge,proto3" json:"oldest_tx_age,omitempty"`
+}
+
+func (m *TokenBatchFees) Reset()         { *m = TokenBatchFees{} }
+func (m *TokenBatchFees) String() string { return proto.CompactTextString(m) }
+func (*TokenBatchFees) ProtoMessage()    {}
+func (*TokenBatchFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{29}
+}
+func (m *TokenBatchFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenBatchFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenBatchFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenBatchFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenBatchFees.Merge(m, src)
+}
+func (m *TokenBatchFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenBatchFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenBatchFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenBatchFees proto.InternalMessageInfo
+
+func (m *TokenBatchFees) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *TokenBatchFees) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *TokenBatchFees) GetOldestTxAge() uint64 {
+	if m != nil {
+		return m.OldestTxAge
+	}
+	return 0
+}
+
 type ContractCallTxConfirmationsRequest struct {
 	InvalidationScope []byte `protobuf:"bytes,1,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
 	InvalidationNonce uint64 `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
@@ -1172,7 +1499,7 @@ func (m *ContractCallTxConfirmationsRequest) Reset()         { *m = ContractCall
 func (m *ContractCallTxConfirmationsRequest) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTxConfirmationsRequest) ProtoMessage()    {}
 func (*ContractCallTxConfirmationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{25}
+	return fileDescriptor_29a9d4192703013c, []int{30}
 }
 func (m *ContractCallTxConfirmationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1223,7 +1550,7 @@ func (m *ContractCallTxConfirmationsResponse) Reset()         { *m = ContractCal
 func (m *ContractCallTxConfirmationsResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTxConfirmationsResponse) ProtoMessage()    {}
 func (*ContractCallTxConfirmationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{26}
+	return fileDescriptor_29a9d4192703013c, []int{31}
 }
 func (m *ContractCallTxConfirmationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1268,7 +1595,7 @@ func (m *BatchTxConfirmationsRequest) Reset()         { *m = BatchTxConfirmation
 func (m *BatchTxConfirmationsRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchTxConfirmationsRequest) ProtoMessage()    {}
 func (*BatchTxConfirmationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{27}
+	return fileDescriptor_29a9d4192703013c, []int{32}
 }
 func (m *BatchTxConfirmationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1319,7 +1646,7 @@ func (m *BatchTxConfirmationsResponse) Reset()         { *m = BatchTxConfirmatio
 func (m *BatchTxConfirmationsResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchTxConfirmationsResponse) ProtoMessage()    {}
 func (*BatchTxConfirmationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{28}
+	return fileDescriptor_29a9d4192703013c, []int{33}
 }
 func (m *BatchTxConfirmationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1355,22 +1682,23 @@ func (m *BatchTxConfirmationsResponse) GetSignatures() []*BatchTxConfirmation {
 	return nil
 }
 
-type LastSubmittedEthereumEventRequest struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+type BatchTxConfirmationProgressRequest struct {
+	BatchNonce    uint64 `protobuf:"varint,1,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	TokenContract string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
 }
 
-func (m *LastSubmittedEthereumEventRequest) Reset()         { *m = LastSubmittedEthereumEventRequest{} }
-func (m *LastSubmittedEthereumEventRequest) String() string { return proto.CompactTextString(m) }
-func (*LastSubmittedEthereumEventRequest) ProtoMessage()    {}
-func (*LastSubmittedEthereumEventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{29}
+func (m *BatchTxConfirmationProgressRequest) Reset()         { *m = BatchTxConfirmationProgressRequest{} }
+func (m *BatchTxConfirmationProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchTxConfirmationProgressRequest) ProtoMessage()    {}
+func (*BatchTxConfirmationProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{34}
 }
-func (m *LastSubmittedEthereumEventRequest) XXX_Unmarshal(b []byte) error {
+func (m *BatchTxConfirmationProgressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LastSubmittedEthereumEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchTxConfirmationProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LastSubmittedEthereumEventRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchTxConfirmationProgressRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1380,8 +1708,145 @@ func (m *LastSubmittedEthereumEventRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *LastSubmittedEthereumEventRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LastSubmittedEthereumEventRequest.Merge(m, src)
+func (m *BatchTxConfirmationProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxConfirmationProgressRequest.Merge(m, src)
+}
+func (m *BatchTxConfirmationProgressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchTxConfirmationProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxConfirmationProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchTxConfirmationProgressRequest proto.InternalMessageInfo
+
+func (m *BatchTxConfirmationProgressRequest) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *BatchTxConfirmationProgressRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+type BatchTxConfirmationProgressResponse struct {
+	SignerSetNonce uint64            `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
+	SignedPower    uint64            `protobuf:"varint,2,opt,name=signed_power,json=signedPower,proto3" json:"signed_power,omitempty"`
+	TotalPower     uint64            `protobuf:"varint,3,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	ThresholdPower uint64            `protobuf:"varint,4,opt,name=threshold_power,json=thresholdPower,proto3" json:"threshold_power,omitempty"`
+	Signed         []*EthereumSigner `protobuf:"bytes,5,rep,name=signed,proto3" json:"signed,omitempty"`
+	Unsigned       []*EthereumSigner `protobuf:"bytes,6,rep,name=unsigned,proto3" json:"unsigned,omitempty"`
+}
+
+func (m *BatchTxConfirmationProgressResponse) Reset()         { *m = BatchTxConfirmationProgressResponse{} }
+func (m *BatchTxConfirmationProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchTxConfirmationProgressResponse) ProtoMessage()    {}
+func (*BatchTxConfirmationProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{35}
+}
+func (m *BatchTxConfirmationProgressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchTxConfirmationProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchTxConfirmationProgressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchTxConfirmationProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxConfirmationProgressResponse.Merge(m, src)
+}
+func (m *BatchTxConfirmationProgressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchTxConfirmationProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxConfirmationProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchTxConfirmationProgressResponse proto.InternalMessageInfo
+
+func (m *BatchTxConfirmationProgressResponse) GetSignerSetNonce() uint64 {
+	if m != nil {
+		return m.SignerSetNonce
+	}
+	return 0
+}
+
+func (m *BatchTxConfirmationProgressResponse) GetSignedPower() uint64 {
+	if m != nil {
+		return m.SignedPower
+	}
+	return 0
+}
+
+func (m *BatchTxConfirmationProgressResponse) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+func (m *BatchTxConfirmationProgressResponse) GetThresholdPower() uint64 {
+	if m != nil {
+		return m.ThresholdPower
+	}
+	return 0
+}
+
+func (m *BatchTxConfirmationProgressResponse) GetSigned() []*EthereumSigner {
+	if m != nil {
+		return m.Signed
+	}
+	return nil
+}
+
+func (m *BatchTxConfirmationProgressResponse) GetUnsigned() []*EthereumSigner {
+	if m != nil {
+		return m.Unsigned
+	}
+	return nil
+}
+
+// address is the validator operator address, or the account address of the
+// validator or its orchestrator
+type LastSubmittedEthereumEventRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *LastSubmittedEthereumEventRequest) Reset()         { *m = LastSubmittedEthereumEventRequest{} }
+func (m *LastSubmittedEthereumEventRequest) String() string { return proto.CompactTextString(m) }
+func (*LastSubmittedEthereumEventRequest) ProtoMessage()    {}
+func (*LastSubmittedEthereumEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{36}
+}
+func (m *LastSubmittedEthereumEventRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastSubmittedEthereumEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastSubmittedEthereumEventRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LastSubmittedEthereumEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastSubmittedEthereumEventRequest.Merge(m, src)
 }
 func (m *LastSubmittedEthereumEventRequest) XXX_Size() int {
 	return m.Size()
@@ -1399,15 +1864,23 @@ func (m *LastSubmittedEthereumEventRequest) GetAddress() string {
 	return ""
 }
 
+// LastSubmittedEthereumEventResponse is the progress of a validator's
+// orchestrator, lagging behind while event_nonce is below
+// last_observed_event_nonce or its height vote below the observed height
 type LastSubmittedEthereumEventResponse struct {
-	EventNonce uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	EventNonce         uint64                    `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	EthereumHeightVote LatestEthereumBlockHeight `protobuf:"bytes,2,opt,name=ethereum_height_vote,json=ethereumHeightVote,proto3" json:"ethereum_height_vote"`
+	// cosmos height of the last event vote of the validator
+	LastEventVoteHeight        uint64                    `protobuf:"varint,3,opt,name=last_event_vote_height,json=lastEventVoteHeight,proto3" json:"last_event_vote_height,omitempty"`
+	LastObservedEventNonce     uint64                    `protobuf:"varint,4,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
+	LastObservedEthereumHeight LatestEthereumBlockHeight `protobuf:"bytes,5,opt,name=last_observed_ethereum_height,json=lastObservedEthereumHeight,proto3" json:"last_observed_ethereum_height"`
 }
 
 func (m *LastSubmittedEthereumEventResponse) Reset()         { *m = LastSubmittedEthereumEventResponse{} }
 func (m *LastSubmittedEthereumEventResponse) String() string { return proto.CompactTextString(m) }
 func (*LastSubmittedEthereumEventResponse) ProtoMessage()    {}
 func (*LastSubmittedEthereumEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{30}
+	return fileDescriptor_29a9d4192703013c, []int{37}
 }
 func (m *LastSubmittedEthereumEventResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1443,6 +1916,34 @@ func (m *LastSubmittedEthereumEventResponse) GetEventNonce() uint64 {
 	return 0
 }
 
+func (m *LastSubmittedEthereumEventResponse) GetEthereumHeightVote() LatestEthereumBlockHeight {
+	if m != nil {
+		return m.EthereumHeightVote
+	}
+	return LatestEthereumBlockHeight{}
+}
+
+func (m *LastSubmittedEthereumEventResponse) GetLastEventVoteHeight() uint64 {
+	if m != nil {
+		return m.LastEventVoteHeight
+	}
+	return 0
+}
+
+func (m *LastSubmittedEthereumEventResponse) GetLastObservedEventNonce() uint64 {
+	if m != nil {
+		return m.LastObservedEventNonce
+	}
+	return 0
+}
+
+func (m *LastSubmittedEthereumEventResponse) GetLastObservedEthereumHeight() LatestEthereumBlockHeight {
+	if m != nil {
+		return m.LastObservedEthereumHeight
+	}
+	return LatestEthereumBlockHeight{}
+}
+
 type ERC20ToDenomRequest struct {
 	Erc20 string `protobuf:"bytes,1,opt,name=erc20,proto3" json:"erc20,omitempty"`
 }
@@ -1451,7 +1952,7 @@ func (m *ERC20ToDenomRequest) Reset()         { *m = ERC20ToDenomRequest{} }
 func (m *ERC20ToDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*ERC20ToDenomRequest) ProtoMessage()    {}
 func (*ERC20ToDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{31}
+	return fileDescriptor_29a9d4192703013c, []int{38}
 }
 func (m *ERC20ToDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1496,7 +1997,7 @@ func (m *ERC20ToDenomResponse) Reset()         { *m = ERC20ToDenomResponse{} }
 func (m *ERC20ToDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*ERC20ToDenomResponse) ProtoMessage()    {}
 func (*ERC20ToDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{32}
+	return fileDescriptor_29a9d4192703013c, []int{39}
 }
 func (m *ERC20ToDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1547,7 +2048,7 @@ func (m *DenomToERC20ParamsRequest) Reset()         { *m = DenomToERC20ParamsReq
 func (m *DenomToERC20ParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*DenomToERC20ParamsRequest) ProtoMessage()    {}
 func (*DenomToERC20ParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{33}
+	return fileDescriptor_29a9d4192703013c, []int{40}
 }
 func (m *DenomToERC20ParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1594,7 +2095,7 @@ func (m *DenomToERC20ParamsResponse) Reset()         { *m = DenomToERC20ParamsRe
 func (m *DenomToERC20ParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*DenomToERC20ParamsResponse) ProtoMessage()    {}
 func (*DenomToERC20ParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{34}
+	return fileDescriptor_29a9d4192703013c, []int{41}
 }
 func (m *DenomToERC20ParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1659,7 +2160,7 @@ func (m *DenomToERC20Request) Reset()         { *m = DenomToERC20Request{} }
 func (m *DenomToERC20Request) String() string { return proto.CompactTextString(m) }
 func (*DenomToERC20Request) ProtoMessage()    {}
 func (*DenomToERC20Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{35}
+	return fileDescriptor_29a9d4192703013c, []int{42}
 }
 func (m *DenomToERC20Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1704,7 +2205,7 @@ func (m *DenomToERC20Response) Reset()         { *m = DenomToERC20Response{} }
 func (m *DenomToERC20Response) String() string { return proto.CompactTextString(m) }
 func (*DenomToERC20Response) ProtoMessage()    {}
 func (*DenomToERC20Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{36}
+	return fileDescriptor_29a9d4192703013c, []int{43}
 }
 func (m *DenomToERC20Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1755,7 +2256,7 @@ func (m *DelegateKeysByValidatorRequest) Reset()         { *m = DelegateKeysByVa
 func (m *DelegateKeysByValidatorRequest) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysByValidatorRequest) ProtoMessage()    {}
 func (*DelegateKeysByValidatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{37}
+	return fileDescriptor_29a9d4192703013c, []int{44}
 }
 func (m *DelegateKeysByValidatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1800,7 +2301,7 @@ func (m *DelegateKeysByValidatorResponse) Reset()         { *m = DelegateKeysByV
 func (m *DelegateKeysByValidatorResponse) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysByValidatorResponse) ProtoMessage()    {}
 func (*DelegateKeysByValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{38}
+	return fileDescriptor_29a9d4192703013c, []int{45}
 }
 func (m *DelegateKeysByValidatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1851,7 +2352,7 @@ func (m *DelegateKeysByEthereumSignerRequest) Reset()         { *m = DelegateKey
 func (m *DelegateKeysByEthereumSignerRequest) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysByEthereumSignerRequest) ProtoMessage()    {}
 func (*DelegateKeysByEthereumSignerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{39}
+	return fileDescriptor_29a9d4192703013c, []int{46}
 }
 func (m *DelegateKeysByEthereumSignerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1896,7 +2397,7 @@ func (m *DelegateKeysByEthereumSignerResponse) Reset()         { *m = DelegateKe
 func (m *DelegateKeysByEthereumSignerResponse) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysByEthereumSignerResponse) ProtoMessage()    {}
 func (*DelegateKeysByEthereumSignerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{40}
+	return fileDescriptor_29a9d4192703013c, []int{47}
 }
 func (m *DelegateKeysByEthereumSignerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1947,7 +2448,7 @@ func (m *DelegateKeysByOrchestratorRequest) Reset()         { *m = DelegateKeysB
 func (m *DelegateKeysByOrchestratorRequest) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysByOrchestratorRequest) ProtoMessage()    {}
 func (*DelegateKeysByOrchestratorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{41}
+	return fileDescriptor_29a9d4192703013c, []int{48}
 }
 func (m *DelegateKeysByOrchestratorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1992,7 +2493,7 @@ func (m *DelegateKeysByOrchestratorResponse) Reset()         { *m = DelegateKeys
 func (m *DelegateKeysByOrchestratorResponse) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysByOrchestratorResponse) ProtoMessage()    {}
 func (*DelegateKeysByOrchestratorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{42}
+	return fileDescriptor_29a9d4192703013c, []int{49}
 }
 func (m *DelegateKeysByOrchestratorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2042,7 +2543,7 @@ func (m *DelegateKeysRequest) Reset()         { *m = DelegateKeysRequest{} }
 func (m *DelegateKeysRequest) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysRequest) ProtoMessage()    {}
 func (*DelegateKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{43}
+	return fileDescriptor_29a9d4192703013c, []int{50}
 }
 func (m *DelegateKeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2079,7 +2580,7 @@ func (m *DelegateKeysResponse) Reset()         { *m = DelegateKeysResponse{} }
 func (m *DelegateKeysResponse) String() string { return proto.CompactTextString(m) }
 func (*DelegateKeysResponse) ProtoMessage()    {}
 func (*DelegateKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{44}
+	return fileDescriptor_29a9d4192703013c, []int{51}
 }
 func (m *DelegateKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2124,7 +2625,7 @@ func (m *BatchedSendToEthereumsRequest) Reset()         { *m = BatchedSendToEthe
 func (m *BatchedSendToEthereumsRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchedSendToEthereumsRequest) ProtoMessage()    {}
 func (*BatchedSendToEthereumsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{45}
+	return fileDescriptor_29a9d4192703013c, []int{52}
 }
 func (m *BatchedSendToEthereumsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2168,7 +2669,7 @@ func (m *BatchedSendToEthereumsResponse) Reset()         { *m = BatchedSendToEth
 func (m *BatchedSendToEthereumsResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchedSendToEthereumsResponse) ProtoMessage()    {}
 func (*BatchedSendToEthereumsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{46}
+	return fileDescriptor_29a9d4192703013c, []int{53}
 }
 func (m *BatchedSendToEthereumsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2213,7 +2714,7 @@ func (m *UnbatchedSendToEthereumsRequest) Reset()         { *m = UnbatchedSendTo
 func (m *UnbatchedSendToEthereumsRequest) String() string { return proto.CompactTextString(m) }
 func (*UnbatchedSendToEthereumsRequest) ProtoMessage()    {}
 func (*UnbatchedSendToEthereumsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{47}
+	return fileDescriptor_29a9d4192703013c, []int{54}
 }
 func (m *UnbatchedSendToEthereumsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2265,7 +2766,7 @@ func (m *UnbatchedSendToEthereumsResponse) Reset()         { *m = UnbatchedSendT
 func (m *UnbatchedSendToEthereumsResponse) String() string { return proto.CompactTextString(m) }
 func (*UnbatchedSendToEthereumsResponse) ProtoMessage()    {}
 func (*UnbatchedSendToEthereumsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{48}
+	return fileDescriptor_29a9d4192703013c, []int{55}
 }
 func (m *UnbatchedSendToEthereumsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2315,7 +2816,7 @@ func (m *LastObservedEthereumHeightRequest) Reset()         { *m = LastObservedE
 func (m *LastObservedEthereumHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*LastObservedEthereumHeightRequest) ProtoMessage()    {}
 func (*LastObservedEthereumHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{49}
+	return fileDescriptor_29a9d4192703013c, []int{56}
 }
 func (m *LastObservedEthereumHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2352,7 +2853,7 @@ func (m *LastObservedEthereumHeightResponse) Reset()         { *m = LastObserved
 func (m *LastObservedEthereumHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*LastObservedEthereumHeightResponse) ProtoMessage()    {}
 func (*LastObservedEthereumHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29a9d4192703013c, []int{50}
+	return fileDescriptor_29a9d4192703013c, []int{57}
 }
 func (m *LastObservedEthereumHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2388,3695 +2889,20729 @@ func (m *LastObservedEthereumHeightResponse) GetLastObservedEthereumHeight() *La
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ParamsRequest)(nil), "gravity.v1.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "gravity.v1.ParamsResponse")
-	proto.RegisterType((*SignerSetTxRequest)(nil), "gravity.v1.SignerSetTxRequest")
-	proto.RegisterType((*LatestSignerSetTxRequest)(nil), "gravity.v1.LatestSignerSetTxRequest")
-	proto.RegisterType((*SignerSetTxResponse)(nil), "gravity.v1.SignerSetTxResponse")
-	proto.RegisterType((*BatchTxRequest)(nil), "gravity.v1.BatchTxRequest")
-	proto.RegisterType((*BatchTxResponse)(nil), "gravity.v1.BatchTxResponse")
-	proto.RegisterType((*ContractCallTxRequest)(nil), "gravity.v1.ContractCallTxRequest")
-	proto.RegisterType((*ContractCallTxResponse)(nil), "gravity.v1.ContractCallTxResponse")
-	proto.RegisterType((*SignerSetTxConfirmationsRequest)(nil), "gravity.v1.SignerSetTxConfirmationsRequest")
-	proto.RegisterType((*SignerSetTxConfirmationsResponse)(nil), "gravity.v1.SignerSetTxConfirmationsResponse")
-	proto.RegisterType((*SignerSetTxsRequest)(nil), "gravity.v1.SignerSetTxsRequest")
-	proto.RegisterType((*SignerSetTxsResponse)(nil), "gravity.v1.SignerSetTxsResponse")
-	proto.RegisterType((*BatchTxsRequest)(nil), "gravity.v1.BatchTxsRequest")
-	proto.RegisterType((*BatchTxsResponse)(nil), "gravity.v1.BatchTxsResponse")
-	proto.RegisterType((*ContractCallTxsRequest)(nil), "gravity.v1.ContractCallTxsRequest")
-	proto.RegisterType((*ContractCallTxsResponse)(nil), "gravity.v1.ContractCallTxsResponse")
-	proto.RegisterType((*UnsignedSignerSetTxsRequest)(nil), "gravity.v1.UnsignedSignerSetTxsRequest")
-	proto.RegisterType((*UnsignedSignerSetTxsResponse)(nil), "gravity.v1.UnsignedSignerSetTxsResponse")
-	proto.RegisterType((*UnsignedBatchTxsRequest)(nil), "gravity.v1.UnsignedBatchTxsRequest")
-	proto.RegisterType((*UnsignedBatchTxsResponse)(nil), "gravity.v1.UnsignedBatchTxsResponse")
-	proto.RegisterType((*UnsignedContractCallTxsRequest)(nil), "gravity.v1.UnsignedContractCallTxsRequest")
-	proto.RegisterType((*UnsignedContractCallTxsResponse)(nil), "gravity.v1.UnsignedContractCallTxsResponse")
-	proto.RegisterType((*BatchTxFeesRequest)(nil), "gravity.v1.BatchTxFeesRequest")
-	proto.RegisterType((*BatchTxFeesResponse)(nil), "gravity.v1.BatchTxFeesResponse")
-	proto.RegisterType((*ContractCallTxConfirmationsRequest)(nil), "gravity.v1.ContractCallTxConfirmationsRequest")
-	proto.RegisterType((*ContractCallTxConfirmationsResponse)(nil), "gravity.v1.ContractCallTxConfirmationsResponse")
-	proto.RegisterType((*BatchTxConfirmationsRequest)(nil), "gravity.v1.BatchTxConfirmationsRequest")
-	proto.RegisterType((*BatchTxConfirmationsResponse)(nil), "gravity.v1.BatchTxConfirmationsResponse")
-	proto.RegisterType((*LastSubmittedEthereumEventRequest)(nil), "gravity.v1.LastSubmittedEthereumEventRequest")
-	proto.RegisterType((*LastSubmittedEthereumEventResponse)(nil), "gravity.v1.LastSubmittedEthereumEventResponse")
-	proto.RegisterType((*ERC20ToDenomRequest)(nil), "gravity.v1.ERC20ToDenomRequest")
-	proto.RegisterType((*ERC20ToDenomResponse)(nil), "gravity.v1.ERC20ToDenomResponse")
-	proto.RegisterType((*DenomToERC20ParamsRequest)(nil), "gravity.v1.DenomToERC20ParamsRequest")
-	proto.RegisterType((*DenomToERC20ParamsResponse)(nil), "gravity.v1.DenomToERC20ParamsResponse")
-	proto.RegisterType((*DenomToERC20Request)(nil), "gravity.v1.DenomToERC20Request")
-	proto.RegisterType((*DenomToERC20Response)(nil), "gravity.v1.DenomToERC20Response")
-	proto.RegisterType((*DelegateKeysByValidatorRequest)(nil), "gravity.v1.DelegateKeysByValidatorRequest")
-	proto.RegisterType((*DelegateKeysByValidatorResponse)(nil), "gravity.v1.DelegateKeysByValidatorResponse")
-	proto.RegisterType((*DelegateKeysByEthereumSignerRequest)(nil), "gravity.v1.DelegateKeysByEthereumSignerRequest")
-	proto.RegisterType((*DelegateKeysByEthereumSignerResponse)(nil), "gravity.v1.DelegateKeysByEthereumSignerResponse")
-	proto.RegisterType((*DelegateKeysByOrchestratorRequest)(nil), "gravity.v1.DelegateKeysByOrchestratorRequest")
-	proto.RegisterType((*DelegateKeysByOrchestratorResponse)(nil), "gravity.v1.DelegateKeysByOrchestratorResponse")
-	proto.RegisterType((*DelegateKeysRequest)(nil), "gravity.v1.DelegateKeysRequest")
-	proto.RegisterType((*DelegateKeysResponse)(nil), "gravity.v1.DelegateKeysResponse")
-	proto.RegisterType((*BatchedSendToEthereumsRequest)(nil), "gravity.v1.BatchedSendToEthereumsRequest")
-	proto.RegisterType((*BatchedSendToEthereumsResponse)(nil), "gravity.v1.BatchedSendToEthereumsResponse")
-	proto.RegisterType((*UnbatchedSendToEthereumsRequest)(nil), "gravity.v1.UnbatchedSendToEthereumsRequest")
-	proto.RegisterType((*UnbatchedSendToEthereumsResponse)(nil), "gravity.v1.UnbatchedSendToEthereumsResponse")
-	proto.RegisterType((*LastObservedEthereumHeightRequest)(nil), "gravity.v1.LastObservedEthereumHeightRequest")
-	proto.RegisterType((*LastObservedEthereumHeightResponse)(nil), "gravity.v1.LastObservedEthereumHeightResponse")
+type ProjectedEthereumHeightRequest struct {
 }
 
-func init() { proto.RegisterFile("gravity/v1/query.proto", fileDescriptor_29a9d4192703013c) }
+func (m *ProjectedEthereumHeightRequest) Reset()         { *m = ProjectedEthereumHeightRequest{} }
+func (m *ProjectedEthereumHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*ProjectedEthereumHeightRequest) ProtoMessage()    {}
+func (*ProjectedEthereumHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{58}
+}
+func (m *ProjectedEthereumHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectedEthereumHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectedEthereumHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectedEthereumHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectedEthereumHeightRequest.Merge(m, src)
+}
+func (m *ProjectedEthereumHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectedEthereumHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectedEthereumHeightRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_29a9d4192703013c = []byte{
-	// 1841 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x49, 0x73, 0xdb, 0xc8,
-	0x15, 0x26, 0x34, 0x96, 0x3d, 0x7a, 0xb2, 0xb6, 0x16, 0x6d, 0xcb, 0x94, 0x4c, 0xda, 0x2d, 0x8f,
-	0xad, 0xb1, 0x22, 0x52, 0xd2, 0x54, 0x65, 0xdf, 0x46, 0xb2, 0x3d, 0x49, 0xcd, 0x78, 0x09, 0xe9,
-	0x99, 0xb2, 0x53, 0x49, 0x21, 0x20, 0xd1, 0x03, 0x22, 0x22, 0xd1, 0x32, 0x00, 0x32, 0xc3, 0x54,
-	0xa5, 0x2a, 0x95, 0x54, 0xe5, 0x90, 0x43, 0x6a, 0x0e, 0xb9, 0xe4, 0x9e, 0x53, 0xae, 0xf9, 0x13,
-	0x73, 0xf4, 0x31, 0xa7, 0x2c, 0xf6, 0x1f, 0x49, 0xa1, 0x17, 0xb0, 0x1b, 0x6c, 0x80, 0xb4, 0xa2,
-	0x9c, 0x6c, 0xbe, 0xe5, 0x7b, 0x0b, 0x5e, 0xbf, 0x7e, 0xaf, 0x05, 0x57, 0xbd, 0xd0, 0x19, 0xfa,
-	0xf1, 0xa8, 0x31, 0x3c, 0x68, 0xbc, 0x1c, 0x90, 0x70, 0x54, 0x3f, 0x0d, 0x69, 0x4c, 0x11, 0x08,
-	0x7a, 0x7d, 0x78, 0x50, 0xb9, 0xd7, 0xa1, 0x51, 0x9f, 0x46, 0x8d, 0xb6, 0x13, 0x11, 0x2e, 0xd4,
-	0x18, 0x1e, 0xb4, 0x49, 0xec, 0x1c, 0x34, 0x4e, 0x1d, 0xcf, 0x0f, 0x9c, 0xd8, 0xa7, 0x01, 0xd7,
-	0xab, 0x54, 0x55, 0x59, 0x29, 0xd5, 0xa1, 0xbe, 0xe4, 0x97, 0x3d, 0xea, 0x51, 0xf6, 0xdf, 0x46,
-	0xf2, 0x3f, 0x41, 0xdd, 0xf2, 0x28, 0xf5, 0x7a, 0xa4, 0xe1, 0x9c, 0xfa, 0x0d, 0x27, 0x08, 0x68,
-	0xcc, 0x20, 0x23, 0xc1, 0xdd, 0x50, 0x7c, 0xf4, 0x48, 0x40, 0x22, 0xdf, 0xc8, 0x11, 0x0e, 0x73,
-	0xce, 0x15, 0x85, 0xd3, 0x8f, 0x3c, 0xa1, 0x80, 0x57, 0x60, 0xe9, 0xa9, 0x13, 0x3a, 0xfd, 0xa8,
-	0x49, 0x5e, 0x0e, 0x48, 0x14, 0xe3, 0x23, 0x58, 0x96, 0x84, 0xe8, 0x94, 0x06, 0x11, 0x41, 0xfb,
-	0x70, 0xf1, 0x94, 0x51, 0x36, 0xac, 0x9b, 0xd6, 0xce, 0xe2, 0x21, 0xaa, 0x8f, 0x53, 0x51, 0xe7,
-	0xb2, 0x47, 0x17, 0xbe, 0xfa, 0x67, 0xad, 0xd4, 0x14, 0x72, 0xf8, 0xfb, 0x80, 0x5a, 0xbe, 0x17,
-	0x90, 0xb0, 0x45, 0xe2, 0x67, 0x5f, 0x08, 0x64, 0xb4, 0x03, 0xab, 0x11, 0xa3, 0xda, 0x11, 0x89,
-	0xed, 0x80, 0x06, 0x1d, 0xc2, 0x10, 0x2f, 0x34, 0x97, 0x23, 0x29, 0xfd, 0x38, 0xa1, 0xe2, 0x0a,
-	0x6c, 0x7c, 0xe2, 0xc4, 0x24, 0x8a, 0x27, 0x51, 0xf0, 0x23, 0x58, 0xd7, 0xa8, 0xc2, 0xc9, 0xaf,
-	0x03, 0x8c, 0xc1, 0x85, 0xa3, 0xd7, 0x54, 0x47, 0x55, 0xa5, 0x85, 0xd4, 0x1e, 0x7e, 0x0e, 0xcb,
-	0x47, 0x4e, 0xdc, 0xe9, 0x8e, 0xdd, 0x7c, 0x0f, 0x96, 0x63, 0x7a, 0x42, 0x02, 0xbb, 0x43, 0x83,
-	0x38, 0x74, 0x3a, 0x1c, 0x6d, 0xa1, 0xb9, 0xc4, 0xa8, 0xc7, 0x82, 0x88, 0x6a, 0xb0, 0xd8, 0x4e,
-	0x14, 0x45, 0x20, 0x73, 0x2c, 0x10, 0x60, 0x24, 0x1e, 0xc4, 0x77, 0x61, 0x25, 0x45, 0x16, 0x4e,
-	0xbe, 0x0f, 0xf3, 0x4c, 0x40, 0xf8, 0xb7, 0xae, 0xfa, 0x27, 0x65, 0xb9, 0x04, 0x1e, 0xc0, 0x15,
-	0x69, 0xea, 0xd8, 0xe9, 0xf5, 0xc6, 0xee, 0xed, 0x01, 0xf2, 0x83, 0xa1, 0xd3, 0xf3, 0x5d, 0x56,
-	0x12, 0x76, 0xd4, 0xa1, 0xa7, 0x3c, 0x8f, 0x97, 0x9b, 0x6b, 0x2a, 0xa7, 0x95, 0x30, 0x26, 0xc4,
-	0x55, 0x6f, 0x35, 0x71, 0xee, 0x74, 0x0b, 0xae, 0x66, 0xcd, 0x0a, 0xdf, 0xbf, 0x05, 0xd0, 0xa3,
-	0x9e, 0xdf, 0xb1, 0x3b, 0x4e, 0xaf, 0x27, 0x02, 0xa8, 0xa8, 0x01, 0x64, 0xf4, 0x16, 0x98, 0x74,
-	0xf2, 0x03, 0x7f, 0x0c, 0x35, 0x25, 0xfb, 0xc7, 0x34, 0xf8, 0xdc, 0x0f, 0xfb, 0xbc, 0xa0, 0xdf,
-	0xbe, 0x36, 0x3c, 0xb8, 0x99, 0x0f, 0x26, 0x7c, 0x3d, 0xe6, 0xc5, 0xe0, 0xc4, 0x83, 0x90, 0x24,
-	0x55, 0xfb, 0xce, 0xce, 0xe2, 0xe1, 0x76, 0x4e, 0x31, 0xa8, 0x08, 0x4d, 0x45, 0x0d, 0xff, 0x5c,
-	0x2b, 0xb4, 0xd4, 0xd3, 0x87, 0x00, 0xe3, 0x33, 0x2e, 0xf2, 0x70, 0xa7, 0xce, 0x0f, 0x79, 0x3d,
-	0x39, 0xe4, 0x75, 0xde, 0x35, 0xc4, 0x51, 0xaf, 0x3f, 0x75, 0x3c, 0x22, 0x74, 0x9b, 0x8a, 0x26,
-	0xfe, 0x8b, 0x05, 0x65, 0x1d, 0x5f, 0x38, 0xff, 0x4d, 0x58, 0x1c, 0xa7, 0x42, 0x7a, 0x9f, 0x5b,
-	0xca, 0x90, 0xa6, 0x27, 0x42, 0x1f, 0x69, 0xae, 0xcd, 0x31, 0xd7, 0xee, 0x4e, 0x75, 0x8d, 0x9b,
-	0xd5, 0x7c, 0x7b, 0x91, 0x96, 0xee, 0xb9, 0x87, 0xfd, 0x47, 0x0b, 0x56, 0xc7, 0xd8, 0x22, 0xe4,
-	0x3d, 0xb8, 0xc4, 0xaa, 0x3e, 0xfd, 0x58, 0xc6, 0x93, 0x21, 0x65, 0xce, 0x2f, 0xce, 0x5f, 0x64,
-	0xab, 0xfd, 0xdc, 0xc3, 0xfd, 0xb3, 0x05, 0xd7, 0x26, 0x4c, 0xa4, 0x7d, 0x75, 0x3e, 0x39, 0x4b,
-	0x32, 0xe6, 0xa2, 0xc3, 0xc4, 0x05, 0xcf, 0x2f, 0xf0, 0x6f, 0xc0, 0xe6, 0xa7, 0x01, 0xab, 0x1c,
-	0xd7, 0x54, 0xe3, 0x1b, 0x70, 0xc9, 0x71, 0xdd, 0x90, 0x44, 0x91, 0xe8, 0x7d, 0xf2, 0x27, 0x7e,
-	0x0e, 0x5b, 0x66, 0xc5, 0xff, 0xb5, 0x78, 0xf1, 0x07, 0x70, 0x4d, 0x22, 0x67, 0x6b, 0x2f, 0xdf,
-	0x9d, 0x1f, 0xc3, 0xc6, 0xa4, 0xd2, 0x99, 0x8a, 0x0a, 0x7f, 0x1b, 0xaa, 0x12, 0x2a, 0xa7, 0x26,
-	0xf2, 0xdd, 0x68, 0x41, 0x2d, 0x57, 0xf7, 0xac, 0x1f, 0x1b, 0x97, 0x01, 0x09, 0x27, 0x1f, 0x12,
-	0x92, 0x5e, 0xcf, 0x43, 0x58, 0xd7, 0xa8, 0x02, 0xde, 0x86, 0x0b, 0x9f, 0x93, 0x34, 0xd2, 0xeb,
-	0x5a, 0x4d, 0xc8, 0x6a, 0x38, 0xa6, 0x7e, 0x70, 0xb4, 0x9f, 0x5c, 0xd4, 0x7f, 0xfb, 0x57, 0x6d,
-	0xc7, 0xf3, 0xe3, 0xee, 0xa0, 0x5d, 0xef, 0xd0, 0x7e, 0x43, 0x4c, 0x28, 0xfc, 0x9f, 0xbd, 0xc8,
-	0x3d, 0x69, 0xc4, 0xa3, 0x53, 0x12, 0x31, 0x85, 0xa8, 0xc9, 0x80, 0xf1, 0xef, 0x2c, 0xc0, 0xba,
-	0x9f, 0xc6, 0x3e, 0xfe, 0xff, 0xbd, 0x9d, 0xfa, 0xb0, 0x5d, 0xe8, 0x83, 0x48, 0xc6, 0x43, 0x43,
-	0xfb, 0xbf, 0x93, 0x9f, 0xf0, 0xdc, 0x1b, 0x80, 0xc0, 0xa6, 0xc8, 0xb5, 0x31, 0xd6, 0xcc, 0x04,
-	0x60, 0x65, 0x27, 0x00, 0xc3, 0x24, 0x31, 0x67, 0x98, 0x24, 0xb0, 0x0d, 0x5b, 0x66, 0x33, 0x22,
-	0x9c, 0x1f, 0x18, 0xc2, 0xa9, 0x19, 0x6a, 0x39, 0x37, 0x8e, 0xef, 0xc1, 0xad, 0x4f, 0x9c, 0x28,
-	0x6e, 0x0d, 0xda, 0x7d, 0x3f, 0x8e, 0x89, 0xfb, 0x20, 0xee, 0x92, 0x90, 0x0c, 0xfa, 0x0f, 0x86,
-	0x24, 0x88, 0xa7, 0x57, 0xf7, 0x03, 0xc0, 0x45, 0xea, 0xc2, 0xcb, 0x1a, 0x2c, 0x92, 0x84, 0xa0,
-	0x67, 0x83, 0x91, 0xf8, 0xc7, 0xdb, 0x85, 0xf5, 0x07, 0xcd, 0xe3, 0xc3, 0xfd, 0x67, 0xf4, 0x3e,
-	0x09, 0x68, 0x5f, 0xda, 0x2d, 0xc3, 0x3c, 0x09, 0x3b, 0x87, 0xfb, 0xc2, 0x2a, 0xff, 0x81, 0x5f,
-	0x40, 0x59, 0x17, 0x16, 0x56, 0xca, 0x30, 0xef, 0x26, 0x04, 0x29, 0xcd, 0x7e, 0xa0, 0x5d, 0x58,
-	0xe3, 0xc5, 0x6b, 0xd3, 0xd0, 0x67, 0x4d, 0x8e, 0xb8, 0x2c, 0xd7, 0xef, 0x36, 0x57, 0x39, 0xe3,
-	0x49, 0x4a, 0xc7, 0x07, 0x70, 0x9d, 0x61, 0x3e, 0xa3, 0xcc, 0x82, 0x36, 0xfd, 0x9a, 0xf1, 0xf1,
-	0x5f, 0x2d, 0xa8, 0x98, 0x74, 0x84, 0x53, 0x37, 0x00, 0x92, 0x83, 0x66, 0xab, 0x9a, 0x0b, 0x09,
-	0x85, 0xe9, 0x24, 0x6c, 0x16, 0x94, 0x1d, 0x38, 0x7d, 0x22, 0x4a, 0x60, 0x81, 0x51, 0x1e, 0x3b,
-	0x7d, 0x82, 0x6e, 0xc1, 0x65, 0xce, 0x8e, 0x46, 0xfd, 0x36, 0xed, 0x6d, 0xbc, 0xc3, 0x04, 0x16,
-	0x19, 0xad, 0xc5, 0x48, 0x49, 0x21, 0x71, 0x11, 0x97, 0x74, 0xfc, 0xbe, 0xd3, 0x8b, 0x36, 0x2e,
-	0xb0, 0xf4, 0x2e, 0x31, 0xea, 0x7d, 0x41, 0x4c, 0x32, 0xac, 0x7a, 0x59, 0x1c, 0xd3, 0x0b, 0x28,
-	0xeb, 0xc2, 0xe3, 0x0c, 0x4f, 0x7e, 0x8f, 0xb7, 0xcb, 0xf0, 0x23, 0xa8, 0xde, 0x27, 0x3d, 0xe2,
-	0x39, 0x31, 0xf9, 0x98, 0x8c, 0xa2, 0xa3, 0xd1, 0x67, 0xfc, 0x1c, 0xd3, 0x50, 0xba, 0xb4, 0x0b,
-	0x6b, 0x43, 0x49, 0xb3, 0xf5, 0xb2, 0x5b, 0x4d, 0x19, 0x1f, 0x8a, 0xfa, 0x1b, 0x40, 0x2d, 0x17,
-	0x4e, 0x29, 0xbe, 0xb8, 0x9b, 0x41, 0x02, 0x12, 0x77, 0x05, 0x06, 0x3a, 0x80, 0x32, 0x0d, 0x93,
-	0x3e, 0x1f, 0x87, 0x9a, 0x4d, 0xfe, 0x35, 0xd6, 0x55, 0x9e, 0x34, 0xfb, 0x18, 0xb6, 0x75, 0xb3,
-	0xb2, 0xee, 0xf9, 0x0d, 0x26, 0x43, 0xb9, 0x0b, 0x2b, 0x44, 0x30, 0x6c, 0x7e, 0x9d, 0x09, 0xf3,
-	0xcb, 0x44, 0x93, 0xc7, 0x7f, 0xb0, 0xe0, 0x76, 0x31, 0xa0, 0x08, 0xe6, 0x6d, 0x92, 0x73, 0x96,
-	0xc0, 0x3e, 0x83, 0x5b, 0xba, 0x1f, 0x4f, 0x14, 0x21, 0x19, 0x56, 0x1e, 0xae, 0x95, 0x8f, 0xfb,
-	0x6b, 0xc0, 0x45, 0xb8, 0x67, 0x89, 0xce, 0x90, 0xdc, 0x39, 0x63, 0x72, 0xaf, 0x24, 0xa5, 0x3f,
-	0xb6, 0x2d, 0x6f, 0xcb, 0xe7, 0x49, 0x91, 0xab, 0x64, 0xe1, 0xc4, 0x0f, 0x61, 0xc9, 0x15, 0x74,
-	0xfb, 0x84, 0x8c, 0x64, 0x57, 0xdd, 0x54, 0xbb, 0xea, 0xa3, 0xc8, 0xd3, 0x74, 0x2f, 0xbb, 0xca,
-	0x2f, 0xfc, 0x10, 0x6e, 0xb0, 0xb6, 0x4b, 0xdc, 0x16, 0x09, 0xdc, 0x67, 0x54, 0x7e, 0xcb, 0x48,
-	0x59, 0x23, 0x23, 0x12, 0xb8, 0x24, 0x1b, 0xe4, 0x12, 0xa7, 0xca, 0xa4, 0x75, 0xa1, 0x9a, 0x87,
-	0x93, 0xde, 0x66, 0x6b, 0x89, 0x8a, 0x1d, 0x53, 0x5b, 0x06, 0x6d, 0x9c, 0x22, 0x74, 0xfd, 0xe6,
-	0x4a, 0xa4, 0xe3, 0xe1, 0x2f, 0xad, 0x64, 0x4a, 0x69, 0x9f, 0x83, 0xd3, 0x99, 0xe9, 0x78, 0xee,
-	0xcc, 0xd3, 0xf1, 0xdf, 0x2d, 0xb8, 0x99, 0xef, 0xd2, 0xf9, 0xc6, 0x7f, 0x7e, 0xc3, 0xf3, 0x36,
-	0xbf, 0x4e, 0x9f, 0xb4, 0x23, 0x12, 0x0e, 0xc7, 0xd7, 0xe1, 0x8f, 0x88, 0xef, 0x75, 0xe5, 0x75,
-	0x8a, 0xff, 0x64, 0xf1, 0x5b, 0x33, 0x4f, 0x4a, 0x04, 0xd7, 0x85, 0x1b, 0x3d, 0x27, 0x8a, 0x6d,
-	0x2a, 0xc4, 0xd2, 0x10, 0xed, 0x2e, 0x13, 0x14, 0xab, 0xc7, 0x7b, 0x6a, 0xa0, 0xfc, 0x69, 0x44,
-	0x02, 0x1e, 0xf5, 0x68, 0xe7, 0x44, 0xa0, 0x56, 0x7a, 0xb9, 0x16, 0x0f, 0xff, 0x53, 0x86, 0xf9,
-	0x9f, 0x24, 0x01, 0xa2, 0x0f, 0xe1, 0x22, 0xbf, 0xc0, 0xd0, 0xf5, 0xc9, 0x97, 0x1c, 0xe1, 0x7f,
-	0xa5, 0x62, 0x62, 0x71, 0xa7, 0x71, 0x09, 0x3d, 0x85, 0x45, 0x65, 0x8e, 0x47, 0xd5, 0xbc, 0x01,
-	0x5f, 0x80, 0xd5, 0x72, 0xf9, 0x29, 0xe2, 0xcf, 0x60, 0x6d, 0xe2, 0xc9, 0x07, 0xdd, 0x9e, 0x0c,
-	0xfb, 0x6c, 0xe8, 0xf7, 0xe1, 0x92, 0x18, 0x92, 0x50, 0xc5, 0xb4, 0x05, 0x08, 0xa4, 0x4d, 0x23,
-	0x2f, 0x45, 0x79, 0x01, 0xcb, 0xfa, 0xe4, 0x88, 0x6e, 0x15, 0x8c, 0xf1, 0x02, 0x13, 0x17, 0x89,
-	0xa4, 0xd0, 0x2d, 0xb8, 0xac, 0xee, 0x53, 0x28, 0x2f, 0xa6, 0xf4, 0xfb, 0xdc, 0xcc, 0x17, 0x48,
-	0x41, 0x3f, 0x82, 0x77, 0xe5, 0x56, 0x84, 0x4c, 0xa1, 0xa5, 0x60, 0x5b, 0x66, 0xa6, 0xf2, 0x71,
-	0x56, 0x32, 0x7b, 0x0d, 0x2a, 0x08, 0x2b, 0x85, 0xdd, 0x2e, 0x94, 0x49, 0xd1, 0x7f, 0x05, 0x1b,
-	0x79, 0x2f, 0x3a, 0x68, 0x77, 0x86, 0x57, 0x9b, 0xd4, 0xde, 0xd7, 0x66, 0x13, 0x4e, 0x0d, 0x9f,
-	0x40, 0xd9, 0x34, 0x78, 0xa3, 0xbb, 0x53, 0x86, 0xeb, 0xd4, 0xe0, 0xce, 0x74, 0xc1, 0xd4, 0xd8,
-	0x6f, 0x2d, 0xd8, 0x2c, 0x58, 0x5e, 0x50, 0x7d, 0xb6, 0x05, 0x25, 0xb5, 0xdd, 0x98, 0x59, 0x5e,
-	0x8d, 0xd7, 0xb4, 0xbc, 0xeb, 0xf1, 0x16, 0xbc, 0x0b, 0xe8, 0xf1, 0x16, 0xbd, 0x03, 0xe0, 0x12,
-	0xb2, 0x61, 0x35, 0xbb, 0x9a, 0xa3, 0x6d, 0x93, 0x7e, 0xb6, 0x18, 0x6f, 0x17, 0x0b, 0xa5, 0x06,
-	0xe2, 0xf1, 0x83, 0x41, 0xb6, 0x38, 0xef, 0x99, 0x20, 0x72, 0x8a, 0x74, 0x77, 0x26, 0xd9, 0xd4,
-	0xea, 0x6f, 0xa0, 0x92, 0xbf, 0x0c, 0xa1, 0x3d, 0xbd, 0x61, 0x4d, 0xd9, 0xb9, 0x2a, 0xf5, 0x59,
-	0xc5, 0xd5, 0xc6, 0xab, 0xac, 0xff, 0x7a, 0xe3, 0x9d, 0x7c, 0x2d, 0xa8, 0xd4, 0x72, 0xf9, 0x6a,
-	0xe7, 0x51, 0x37, 0x2d, 0xbd, 0xf3, 0x18, 0x16, 0x36, 0xbd, 0xf3, 0x98, 0x96, 0x34, 0x5c, 0x42,
-	0x04, 0xd0, 0xe4, 0xbe, 0x84, 0xb4, 0x5b, 0x2c, 0x77, 0x07, 0xab, 0xdc, 0x99, 0x26, 0xa6, 0xfa,
-	0xae, 0xf2, 0x75, 0xdf, 0x0d, 0xab, 0x90, 0xee, 0xbb, 0x69, 0xfd, 0xc1, 0x25, 0xf4, 0x12, 0xae,
-	0x9a, 0x27, 0x32, 0xf4, 0xfe, 0x44, 0x36, 0xf3, 0x06, 0xa9, 0xca, 0xbd, 0x59, 0x44, 0xd5, 0x0e,
-	0x98, 0x37, 0x06, 0xa1, 0x4c, 0x7d, 0x16, 0xce, 0x6f, 0x7a, 0x07, 0x9c, 0x36, 0x59, 0xf1, 0x33,
-	0x94, 0xb3, 0x5a, 0xe9, 0x67, 0xa8, 0x78, 0x9d, 0xd3, 0xcf, 0xd0, 0x94, 0x5d, 0x0d, 0x97, 0xd0,
-	0xef, 0x2d, 0xd8, 0x2a, 0xda, 0x84, 0x50, 0x23, 0x1f, 0xcf, 0xb8, 0x84, 0x55, 0xf6, 0x67, 0x57,
-	0x50, 0x4f, 0x72, 0xfe, 0xba, 0xa2, 0x9f, 0xe4, 0xa9, 0xeb, 0x92, 0x7e, 0x92, 0xa7, 0x6f, 0x41,
-	0xb2, 0x76, 0xc7, 0x72, 0xd9, 0xda, 0x9d, 0xd8, 0x65, 0xb2, 0xb5, 0x3b, 0xb9, 0xd5, 0x8c, 0xbb,
-	0x93, 0x79, 0x04, 0x9c, 0xec, 0x4e, 0x85, 0x23, 0xec, 0x64, 0x77, 0x2a, 0x9e, 0x65, 0x71, 0xe9,
-	0xe8, 0xd3, 0xaf, 0x5e, 0x57, 0xad, 0x57, 0xaf, 0xab, 0xd6, 0xbf, 0x5f, 0x57, 0xad, 0x2f, 0xdf,
-	0x54, 0x4b, 0xaf, 0xde, 0x54, 0x4b, 0xff, 0x78, 0x53, 0x2d, 0xfd, 0xf4, 0x3b, 0xca, 0x73, 0xe3,
-	0x29, 0xf1, 0xbc, 0xd1, 0x2f, 0x87, 0xf2, 0xef, 0x93, 0x7b, 0xed, 0xd0, 0x77, 0x3d, 0xd2, 0xe8,
-	0x53, 0x77, 0xd0, 0x23, 0x8d, 0xe1, 0x61, 0xe3, 0x0b, 0xc9, 0xe2, 0xef, 0x90, 0xed, 0x8b, 0xec,
-	0x4f, 0x95, 0x1f, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0xbd, 0xaf, 0xe5, 0x30, 0x9b, 0x1d, 0x00,
-	0x00,
+var xxx_messageInfo_ProjectedEthereumHeightRequest proto.InternalMessageInfo
+
+// ProjectedEthereumHeightResponse details how the timeout of outgoing txs is
+// computed. The timeout is the median height vote plus the
+// TimeoutEthereumBlockMargin param when that margin is set and validators
+// voted within the TimeoutHeightVoteWindow, and otherwise the projected
+// ethereum height plus the TargetEthTxTimeout in ethereum blocks. Heights are
+// zero when they can't be computed.
+type ProjectedEthereumHeightResponse struct {
+	LastObservedEthereumHeight LatestEthereumBlockHeight `protobuf:"bytes,1,opt,name=last_observed_ethereum_height,json=lastObservedEthereumHeight,proto3" json:"last_observed_ethereum_height"`
+	// the ethereum height projected from the last observed heights and the
+	// average block times
+	ProjectedEthereumHeight uint64 `protobuf:"varint,2,opt,name=projected_ethereum_height,json=projectedEthereumHeight,proto3" json:"projected_ethereum_height,omitempty"`
+	// the power weighted median of the recent ethereum height votes
+	MedianEthereumHeightVote uint64 `protobuf:"varint,3,opt,name=median_ethereum_height_vote,json=medianEthereumHeightVote,proto3" json:"median_ethereum_height_vote,omitempty"`
+	// the timeout an outgoing tx created at this height gets
+	TimeoutHeight uint64 `protobuf:"varint,4,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ProjectedEthereumHeightResponse) Reset()         { *m = ProjectedEthereumHeightResponse{} }
+func (m *ProjectedEthereumHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*ProjectedEthereumHeightResponse) ProtoMessage()    {}
+func (*ProjectedEthereumHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{59}
+}
+func (m *ProjectedEthereumHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectedEthereumHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectedEthereumHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectedEthereumHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectedEthereumHeightResponse.Merge(m, src)
+}
+func (m *ProjectedEthereumHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectedEthereumHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectedEthereumHeightResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ProjectedEthereumHeightResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Module parameters query
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
-	// get info on individual outgoing data
-	SignerSetTx(ctx context.Context, in *SignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error)
-	LatestSignerSetTx(ctx context.Context, in *LatestSignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error)
-	BatchTx(ctx context.Context, in *BatchTxRequest, opts ...grpc.CallOption) (*BatchTxResponse, error)
-	ContractCallTx(ctx context.Context, in *ContractCallTxRequest, opts ...grpc.CallOption) (*ContractCallTxResponse, error)
-	// get collections of outgoing traffic from the bridge
-	SignerSetTxs(ctx context.Context, in *SignerSetTxsRequest, opts ...grpc.CallOption) (*SignerSetTxsResponse, error)
-	BatchTxs(ctx context.Context, in *BatchTxsRequest, opts ...grpc.CallOption) (*BatchTxsResponse, error)
-	ContractCallTxs(ctx context.Context, in *ContractCallTxsRequest, opts ...grpc.CallOption) (*ContractCallTxsResponse, error)
-	// TODO: can/should we group these into one endpoint?
-	SignerSetTxConfirmations(ctx context.Context, in *SignerSetTxConfirmationsRequest, opts ...grpc.CallOption) (*SignerSetTxConfirmationsResponse, error)
-	BatchTxConfirmations(ctx context.Context, in *BatchTxConfirmationsRequest, opts ...grpc.CallOption) (*BatchTxConfirmationsResponse, error)
-	ContractCallTxConfirmations(ctx context.Context, in *ContractCallTxConfirmationsRequest, opts ...grpc.CallOption) (*ContractCallTxConfirmationsResponse, error)
-	// pending ethereum signature queries for orchestrators to figure out which
-	// signatures they are missing
-	// TODO: can/should we group this into one endpoint?
-	UnsignedSignerSetTxs(ctx context.Context, in *UnsignedSignerSetTxsRequest, opts ...grpc.CallOption) (*UnsignedSignerSetTxsResponse, error)
-	UnsignedBatchTxs(ctx context.Context, in *UnsignedBatchTxsRequest, opts ...grpc.CallOption) (*UnsignedBatchTxsResponse, error)
-	UnsignedContractCallTxs(ctx context.Context, in *UnsignedContractCallTxsRequest, opts ...grpc.CallOption) (*UnsignedContractCallTxsResponse, error)
-	LastSubmittedEthereumEvent(ctx context.Context, in *LastSubmittedEthereumEventRequest, opts ...grpc.CallOption) (*LastSubmittedEthereumEventResponse, error)
-	// Queries the fees for all pending batches, results are returned in sdk.Coin
-	// (fee_amount_int)(contract_address) style
-	BatchTxFees(ctx context.Context, in *BatchTxFeesRequest, opts ...grpc.CallOption) (*BatchTxFeesResponse, error)
-	// Query for info about denoms tracked by gravity
-	ERC20ToDenom(ctx context.Context, in *ERC20ToDenomRequest, opts ...grpc.CallOption) (*ERC20ToDenomResponse, error)
-	// DenomToERC20Params implements a query that allows ERC-20 parameter
-	// information to be retrieved by a Cosmos base denomination.
-	DenomToERC20Params(ctx context.Context, in *DenomToERC20ParamsRequest, opts ...grpc.CallOption) (*DenomToERC20ParamsResponse, error)
-	// Query for info about denoms tracked by gravity
-	DenomToERC20(ctx context.Context, in *DenomToERC20Request, opts ...grpc.CallOption) (*DenomToERC20Response, error)
-	// Query for batch send to ethereums
-	BatchedSendToEthereums(ctx context.Context, in *BatchedSendToEthereumsRequest, opts ...grpc.CallOption) (*BatchedSendToEthereumsResponse, error)
-	// Query for unbatched send to ethereums
-	UnbatchedSendToEthereums(ctx context.Context, in *UnbatchedSendToEthereumsRequest, opts ...grpc.CallOption) (*UnbatchedSendToEthereumsResponse, error)
-	// delegate keys
-	DelegateKeysByValidator(ctx context.Context, in *DelegateKeysByValidatorRequest, opts ...grpc.CallOption) (*DelegateKeysByValidatorResponse, error)
-	DelegateKeysByEthereumSigner(ctx context.Context, in *DelegateKeysByEthereumSignerRequest, opts ...grpc.CallOption) (*DelegateKeysByEthereumSignerResponse, error)
-	DelegateKeysByOrchestrator(ctx context.Context, in *DelegateKeysByOrchestratorRequest, opts ...grpc.CallOption) (*DelegateKeysByOrchestratorResponse, error)
-	DelegateKeys(ctx context.Context, in *DelegateKeysRequest, opts ...grpc.CallOption) (*DelegateKeysResponse, error)
-	LastObservedEthereumHeight(ctx context.Context, in *LastObservedEthereumHeightRequest, opts ...grpc.CallOption) (*LastObservedEthereumHeightResponse, error)
+func (m *ProjectedEthereumHeightResponse) GetLastObservedEthereumHeight() LatestEthereumBlockHeight {
+	if m != nil {
+		return m.LastObservedEthereumHeight
+	}
+	return LatestEthereumBlockHeight{}
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *ProjectedEthereumHeightResponse) GetProjectedEthereumHeight() uint64 {
+	if m != nil {
+		return m.ProjectedEthereumHeight
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *ProjectedEthereumHeightResponse) GetMedianEthereumHeightVote() uint64 {
+	if m != nil {
+		return m.MedianEthereumHeightVote
+	}
+	return 0
 }
 
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ProjectedEthereumHeightResponse) GetTimeoutHeight() uint64 {
+	if m != nil {
+		return m.TimeoutHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) SignerSetTx(ctx context.Context, in *SignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error) {
-	out := new(SignerSetTxResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetTx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueuedSendToCosmosEventsRequest struct {
+	TokenContract string             `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) LatestSignerSetTx(ctx context.Context, in *LatestSignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error) {
-	out := new(SignerSetTxResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LatestSignerSetTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueuedSendToCosmosEventsRequest) Reset()         { *m = QueuedSendToCosmosEventsRequest{} }
+func (m *QueuedSendToCosmosEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueuedSendToCosmosEventsRequest) ProtoMessage()    {}
+func (*QueuedSendToCosmosEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{60}
+}
+func (m *QueuedSendToCosmosEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedSendToCosmosEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedSendToCosmosEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueuedSendToCosmosEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedSendToCosmosEventsRequest.Merge(m, src)
+}
+func (m *QueuedSendToCosmosEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedSendToCosmosEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedSendToCosmosEventsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) BatchTx(ctx context.Context, in *BatchTxRequest, opts ...grpc.CallOption) (*BatchTxResponse, error) {
-	out := new(BatchTxResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueuedSendToCosmosEventsRequest proto.InternalMessageInfo
+
+func (m *QueuedSendToCosmosEventsRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ContractCallTx(ctx context.Context, in *ContractCallTxRequest, opts ...grpc.CallOption) (*ContractCallTxResponse, error) {
-	out := new(ContractCallTxResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ContractCallTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueuedSendToCosmosEventsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SignerSetTxs(ctx context.Context, in *SignerSetTxsRequest, opts ...grpc.CallOption) (*SignerSetTxsResponse, error) {
-	out := new(SignerSetTxsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueuedSendToCosmosEventsResponse struct {
+	Events     []*SendToCosmosEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) BatchTxs(ctx context.Context, in *BatchTxsRequest, opts ...grpc.CallOption) (*BatchTxsResponse, error) {
-	out := new(BatchTxsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueuedSendToCosmosEventsResponse) Reset()         { *m = QueuedSendToCosmosEventsResponse{} }
+func (m *QueuedSendToCosmosEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueuedSendToCosmosEventsResponse) ProtoMessage()    {}
+func (*QueuedSendToCosmosEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{61}
+}
+func (m *QueuedSendToCosmosEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedSendToCosmosEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedSendToCosmosEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueuedSendToCosmosEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedSendToCosmosEventsResponse.Merge(m, src)
+}
+func (m *QueuedSendToCosmosEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedSendToCosmosEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedSendToCosmosEventsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ContractCallTxs(ctx context.Context, in *ContractCallTxsRequest, opts ...grpc.CallOption) (*ContractCallTxsResponse, error) {
-	out := new(ContractCallTxsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ContractCallTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueuedSendToCosmosEventsResponse proto.InternalMessageInfo
+
+func (m *QueuedSendToCosmosEventsResponse) GetEvents() []*SendToCosmosEvent {
+	if m != nil {
+		return m.Events
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SignerSetTxConfirmations(ctx context.Context, in *SignerSetTxConfirmationsRequest, opts ...grpc.CallOption) (*SignerSetTxConfirmationsResponse, error) {
-	out := new(SignerSetTxConfirmationsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetTxConfirmations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueuedSendToCosmosEventsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) BatchTxConfirmations(ctx context.Context, in *BatchTxConfirmationsRequest, opts ...grpc.CallOption) (*BatchTxConfirmationsResponse, error) {
-	out := new(BatchTxConfirmationsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTxConfirmations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type HeldSendToCosmosEventsRequest struct {
+	TokenContract string             `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) ContractCallTxConfirmations(ctx context.Context, in *ContractCallTxConfirmationsRequest, opts ...grpc.CallOption) (*ContractCallTxConfirmationsResponse, error) {
-	out := new(ContractCallTxConfirmationsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ContractCallTxConfirmations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *HeldSendToCosmosEventsRequest) Reset()         { *m = HeldSendToCosmosEventsRequest{} }
+func (m *HeldSendToCosmosEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*HeldSendToCosmosEventsRequest) ProtoMessage()    {}
+func (*HeldSendToCosmosEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{62}
+}
+func (m *HeldSendToCosmosEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeldSendToCosmosEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeldSendToCosmosEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *HeldSendToCosmosEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeldSendToCosmosEventsRequest.Merge(m, src)
+}
+func (m *HeldSendToCosmosEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeldSendToCosmosEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeldSendToCosmosEventsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) UnsignedSignerSetTxs(ctx context.Context, in *UnsignedSignerSetTxsRequest, opts ...grpc.CallOption) (*UnsignedSignerSetTxsResponse, error) {
-	out := new(UnsignedSignerSetTxsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/UnsignedSignerSetTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_HeldSendToCosmosEventsRequest proto.InternalMessageInfo
+
+func (m *HeldSendToCosmosEventsRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) UnsignedBatchTxs(ctx context.Context, in *UnsignedBatchTxsRequest, opts ...grpc.CallOption) (*UnsignedBatchTxsResponse, error) {
-	out := new(UnsignedBatchTxsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/UnsignedBatchTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *HeldSendToCosmosEventsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) UnsignedContractCallTxs(ctx context.Context, in *UnsignedContractCallTxsRequest, opts ...grpc.CallOption) (*UnsignedContractCallTxsResponse, error) {
-	out := new(UnsignedContractCallTxsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/UnsignedContractCallTxs", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type HeldSendToCosmosEventsResponse struct {
+	Events     []*SendToCosmosEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) LastSubmittedEthereumEvent(ctx context.Context, in *LastSubmittedEthereumEventRequest, opts ...grpc.CallOption) (*LastSubmittedEthereumEventResponse, error) {
-	out := new(LastSubmittedEthereumEventResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastSubmittedEthereumEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *HeldSendToCosmosEventsResponse) Reset()         { *m = HeldSendToCosmosEventsResponse{} }
+func (m *HeldSendToCosmosEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*HeldSendToCosmosEventsResponse) ProtoMessage()    {}
+func (*HeldSendToCosmosEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{63}
+}
+func (m *HeldSendToCosmosEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeldSendToCosmosEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeldSendToCosmosEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *HeldSendToCosmosEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeldSendToCosmosEventsResponse.Merge(m, src)
+}
+func (m *HeldSendToCosmosEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeldSendToCosmosEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeldSendToCosmosEventsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) BatchTxFees(ctx context.Context, in *BatchTxFeesRequest, opts ...grpc.CallOption) (*BatchTxFeesResponse, error) {
-	out := new(BatchTxFeesResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTxFees", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_HeldSendToCosmosEventsResponse proto.InternalMessageInfo
+
+func (m *HeldSendToCosmosEventsResponse) GetEvents() []*SendToCosmosEvent {
+	if m != nil {
+		return m.Events
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ERC20ToDenom(ctx context.Context, in *ERC20ToDenomRequest, opts ...grpc.CallOption) (*ERC20ToDenomResponse, error) {
-	out := new(ERC20ToDenomResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ERC20ToDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *HeldSendToCosmosEventsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) DenomToERC20Params(ctx context.Context, in *DenomToERC20ParamsRequest, opts ...grpc.CallOption) (*DenomToERC20ParamsResponse, error) {
-	out := new(DenomToERC20ParamsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DenomToERC20Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ExecutedBatchTxsRequest struct {
+	TokenContract string             `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) DenomToERC20(ctx context.Context, in *DenomToERC20Request, opts ...grpc.CallOption) (*DenomToERC20Response, error) {
-	out := new(DenomToERC20Response)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DenomToERC20", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ExecutedBatchTxsRequest) Reset()         { *m = ExecutedBatchTxsRequest{} }
+func (m *ExecutedBatchTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecutedBatchTxsRequest) ProtoMessage()    {}
+func (*ExecutedBatchTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{64}
 }
-
-func (c *queryClient) BatchedSendToEthereums(ctx context.Context, in *BatchedSendToEthereumsRequest, opts ...grpc.CallOption) (*BatchedSendToEthereumsResponse, error) {
-	out := new(BatchedSendToEthereumsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchedSendToEthereums", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ExecutedBatchTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) UnbatchedSendToEthereums(ctx context.Context, in *UnbatchedSendToEthereumsRequest, opts ...grpc.CallOption) (*UnbatchedSendToEthereumsResponse, error) {
-	out := new(UnbatchedSendToEthereumsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/UnbatchedSendToEthereums", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ExecutedBatchTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutedBatchTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ExecutedBatchTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutedBatchTxsRequest.Merge(m, src)
+}
+func (m *ExecutedBatchTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutedBatchTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutedBatchTxsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) DelegateKeysByValidator(ctx context.Context, in *DelegateKeysByValidatorRequest, opts ...grpc.CallOption) (*DelegateKeysByValidatorResponse, error) {
-	out := new(DelegateKeysByValidatorResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DelegateKeysByValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ExecutedBatchTxsRequest proto.InternalMessageInfo
+
+func (m *ExecutedBatchTxsRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) DelegateKeysByEthereumSigner(ctx context.Context, in *DelegateKeysByEthereumSignerRequest, opts ...grpc.CallOption) (*DelegateKeysByEthereumSignerResponse, error) {
-	out := new(DelegateKeysByEthereumSignerResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DelegateKeysByEthereumSigner", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ExecutedBatchTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) DelegateKeysByOrchestrator(ctx context.Context, in *DelegateKeysByOrchestratorRequest, opts ...grpc.CallOption) (*DelegateKeysByOrchestratorResponse, error) {
-	out := new(DelegateKeysByOrchestratorResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DelegateKeysByOrchestrator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ExecutedBatchTxsResponse struct {
+	Records    []BatchTxExecutionRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) DelegateKeys(ctx context.Context, in *DelegateKeysRequest, opts ...grpc.CallOption) (*DelegateKeysResponse, error) {
-	out := new(DelegateKeysResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DelegateKeys", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ExecutedBatchTxsResponse) Reset()         { *m = ExecutedBatchTxsResponse{} }
+func (m *ExecutedBatchTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*ExecutedBatchTxsResponse) ProtoMessage()    {}
+func (*ExecutedBatchTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{65}
+}
+func (m *ExecutedBatchTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutedBatchTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutedBatchTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ExecutedBatchTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutedBatchTxsResponse.Merge(m, src)
+}
+func (m *ExecutedBatchTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutedBatchTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutedBatchTxsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LastObservedEthereumHeight(ctx context.Context, in *LastObservedEthereumHeightRequest, opts ...grpc.CallOption) (*LastObservedEthereumHeightResponse, error) {
-	out := new(LastObservedEthereumHeightResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastObservedEthereumHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ExecutedBatchTxsResponse proto.InternalMessageInfo
+
+func (m *ExecutedBatchTxsResponse) GetRecords() []BatchTxExecutionRecord {
+	if m != nil {
+		return m.Records
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Module parameters query
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
-	// get info on individual outgoing data
-	SignerSetTx(context.Context, *SignerSetTxRequest) (*SignerSetTxResponse, error)
-	LatestSignerSetTx(context.Context, *LatestSignerSetTxRequest) (*SignerSetTxResponse, error)
-	BatchTx(context.Context, *BatchTxRequest) (*BatchTxResponse, error)
-	ContractCallTx(context.Context, *ContractCallTxRequest) (*ContractCallTxResponse, error)
-	// get collections of outgoing traffic from the bridge
-	SignerSetTxs(context.Context, *SignerSetTxsRequest) (*SignerSetTxsResponse, error)
-	BatchTxs(context.Context, *BatchTxsRequest) (*BatchTxsResponse, error)
-	ContractCallTxs(context.Context, *ContractCallTxsRequest) (*ContractCallTxsResponse, error)
-	// TODO: can/should we group these into one endpoint?
-	SignerSetTxConfirmations(context.Context, *SignerSetTxConfirmationsRequest) (*SignerSetTxConfirmationsResponse, error)
-	BatchTxConfirmations(context.Context, *BatchTxConfirmationsRequest) (*BatchTxConfirmationsResponse, error)
-	ContractCallTxConfirmations(context.Context, *ContractCallTxConfirmationsRequest) (*ContractCallTxConfirmationsResponse, error)
-	// pending ethereum signature queries for orchestrators to figure out which
-	// signatures they are missing
-	// TODO: can/should we group this into one endpoint?
-	UnsignedSignerSetTxs(context.Context, *UnsignedSignerSetTxsRequest) (*UnsignedSignerSetTxsResponse, error)
-	UnsignedBatchTxs(context.Context, *UnsignedBatchTxsRequest) (*UnsignedBatchTxsResponse, error)
-	UnsignedContractCallTxs(context.Context, *UnsignedContractCallTxsRequest) (*UnsignedContractCallTxsResponse, error)
-	LastSubmittedEthereumEvent(context.Context, *LastSubmittedEthereumEventRequest) (*LastSubmittedEthereumEventResponse, error)
-	// Queries the fees for all pending batches, results are returned in sdk.Coin
-	// (fee_amount_int)(contract_address) style
-	BatchTxFees(context.Context, *BatchTxFeesRequest) (*BatchTxFeesResponse, error)
-	// Query for info about denoms tracked by gravity
-	ERC20ToDenom(context.Context, *ERC20ToDenomRequest) (*ERC20ToDenomResponse, error)
-	// DenomToERC20Params implements a query that allows ERC-20 parameter
-	// information to be retrieved by a Cosmos base denomination.
-	DenomToERC20Params(context.Context, *DenomToERC20ParamsRequest) (*DenomToERC20ParamsResponse, error)
-	// Query for info about denoms tracked by gravity
-	DenomToERC20(context.Context, *DenomToERC20Request) (*DenomToERC20Response, error)
-	// Query for batch send to ethereums
-	BatchedSendToEthereums(context.Context, *BatchedSendToEthereumsRequest) (*BatchedSendToEthereumsResponse, error)
-	// Query for unbatched send to ethereums
-	UnbatchedSendToEthereums(context.Context, *UnbatchedSendToEthereumsRequest) (*UnbatchedSendToEthereumsResponse, error)
-	// delegate keys
-	DelegateKeysByValidator(context.Context, *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error)
-	DelegateKeysByEthereumSigner(context.Context, *DelegateKeysByEthereumSignerRequest) (*DelegateKeysByEthereumSignerResponse, error)
-	DelegateKeysByOrchestrator(context.Context, *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error)
-	DelegateKeys(context.Context, *DelegateKeysRequest) (*DelegateKeysResponse, error)
-	LastObservedEthereumHeight(context.Context, *LastObservedEthereumHeightRequest) (*LastObservedEthereumHeightResponse, error)
+func (m *ExecutedBatchTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type ExecutedContractCallTxsRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) SignerSetTx(ctx context.Context, req *SignerSetTxRequest) (*SignerSetTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTx not implemented")
+func (m *ExecutedContractCallTxsRequest) Reset()         { *m = ExecutedContractCallTxsRequest{} }
+func (m *ExecutedContractCallTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecutedContractCallTxsRequest) ProtoMessage()    {}
+func (*ExecutedContractCallTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{66}
 }
-func (*UnimplementedQueryServer) LatestSignerSetTx(ctx context.Context, req *LatestSignerSetTxRequest) (*SignerSetTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestSignerSetTx not implemented")
-}
-func (*UnimplementedQueryServer) BatchTx(ctx context.Context, req *BatchTxRequest) (*BatchTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchTx not implemented")
+func (m *ExecutedContractCallTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ContractCallTx(ctx context.Context, req *ContractCallTxRequest) (*ContractCallTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTx not implemented")
+func (m *ExecutedContractCallTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutedContractCallTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) SignerSetTxs(ctx context.Context, req *SignerSetTxsRequest) (*SignerSetTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTxs not implemented")
+func (m *ExecutedContractCallTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutedContractCallTxsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) BatchTxs(ctx context.Context, req *BatchTxsRequest) (*BatchTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchTxs not implemented")
+func (m *ExecutedContractCallTxsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ContractCallTxs(ctx context.Context, req *ContractCallTxsRequest) (*ContractCallTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTxs not implemented")
+func (m *ExecutedContractCallTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutedContractCallTxsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) SignerSetTxConfirmations(ctx context.Context, req *SignerSetTxConfirmationsRequest) (*SignerSetTxConfirmationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTxConfirmations not implemented")
+
+var xxx_messageInfo_ExecutedContractCallTxsRequest proto.InternalMessageInfo
+
+func (m *ExecutedContractCallTxsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) BatchTxConfirmations(ctx context.Context, req *BatchTxConfirmationsRequest) (*BatchTxConfirmationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchTxConfirmations not implemented")
+
+func (m *ExecutedContractCallTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) ContractCallTxConfirmations(ctx context.Context, req *ContractCallTxConfirmationsRequest) (*ContractCallTxConfirmationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTxConfirmations not implemented")
+
+type ExecutedContractCallTxsResponse struct {
+	Records    []ContractCallTxExecutionRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse             `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) UnsignedSignerSetTxs(ctx context.Context, req *UnsignedSignerSetTxsRequest) (*UnsignedSignerSetTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsignedSignerSetTxs not implemented")
+
+func (m *ExecutedContractCallTxsResponse) Reset()         { *m = ExecutedContractCallTxsResponse{} }
+func (m *ExecutedContractCallTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*ExecutedContractCallTxsResponse) ProtoMessage()    {}
+func (*ExecutedContractCallTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{67}
 }
-func (*UnimplementedQueryServer) UnsignedBatchTxs(ctx context.Context, req *UnsignedBatchTxsRequest) (*UnsignedBatchTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsignedBatchTxs not implemented")
+func (m *ExecutedContractCallTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) UnsignedContractCallTxs(ctx context.Context, req *UnsignedContractCallTxsRequest) (*UnsignedContractCallTxsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsignedContractCallTxs not implemented")
+func (m *ExecutedContractCallTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutedContractCallTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) LastSubmittedEthereumEvent(ctx context.Context, req *LastSubmittedEthereumEventRequest) (*LastSubmittedEthereumEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastSubmittedEthereumEvent not implemented")
+func (m *ExecutedContractCallTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutedContractCallTxsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) BatchTxFees(ctx context.Context, req *BatchTxFeesRequest) (*BatchTxFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchTxFees not implemented")
+func (m *ExecutedContractCallTxsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ERC20ToDenom(ctx context.Context, req *ERC20ToDenomRequest) (*ERC20ToDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ERC20ToDenom not implemented")
+func (m *ExecutedContractCallTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutedContractCallTxsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) DenomToERC20Params(ctx context.Context, req *DenomToERC20ParamsRequest) (*DenomToERC20ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomToERC20Params not implemented")
+
+var xxx_messageInfo_ExecutedContractCallTxsResponse proto.InternalMessageInfo
+
+func (m *ExecutedContractCallTxsResponse) GetRecords() []ContractCallTxExecutionRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) DenomToERC20(ctx context.Context, req *DenomToERC20Request) (*DenomToERC20Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomToERC20 not implemented")
+
+func (m *ExecutedContractCallTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) BatchedSendToEthereums(ctx context.Context, req *BatchedSendToEthereumsRequest) (*BatchedSendToEthereumsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchedSendToEthereums not implemented")
+
+type RefundedContractCallTxsRequest struct {
+	Refundee   string             `protobuf:"bytes,1,opt,name=refundee,proto3" json:"refundee,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) UnbatchedSendToEthereums(ctx context.Context, req *UnbatchedSendToEthereumsRequest) (*UnbatchedSendToEthereumsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnbatchedSendToEthereums not implemented")
+
+func (m *RefundedContractCallTxsRequest) Reset()         { *m = RefundedContractCallTxsRequest{} }
+func (m *RefundedContractCallTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*RefundedContractCallTxsRequest) ProtoMessage()    {}
+func (*RefundedContractCallTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{68}
 }
-func (*UnimplementedQueryServer) DelegateKeysByValidator(ctx context.Context, req *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByValidator not implemented")
+func (m *RefundedContractCallTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DelegateKeysByEthereumSigner(ctx context.Context, req *DelegateKeysByEthereumSignerRequest) (*DelegateKeysByEthereumSignerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByEthereumSigner not implemented")
+func (m *RefundedContractCallTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundedContractCallTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) DelegateKeysByOrchestrator(ctx context.Context, req *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByOrchestrator not implemented")
+func (m *RefundedContractCallTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundedContractCallTxsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) DelegateKeys(ctx context.Context, req *DelegateKeysRequest) (*DelegateKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeys not implemented")
+func (m *RefundedContractCallTxsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) LastObservedEthereumHeight(ctx context.Context, req *LastObservedEthereumHeightRequest) (*LastObservedEthereumHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastObservedEthereumHeight not implemented")
+func (m *RefundedContractCallTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundedContractCallTxsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_RefundedContractCallTxsRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+func (m *RefundedContractCallTxsRequest) GetRefundee() string {
+	if m != nil {
+		return m.Refundee
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_SignerSetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignerSetTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SignerSetTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/SignerSetTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SignerSetTx(ctx, req.(*SignerSetTxRequest))
+func (m *RefundedContractCallTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_LatestSignerSetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LatestSignerSetTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestSignerSetTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/LatestSignerSetTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestSignerSetTx(ctx, req.(*LatestSignerSetTxRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type RefundedContractCallTxsResponse struct {
+	Records    []ContractCallTxRefundRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse          `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_BatchTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/BatchTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchTx(ctx, req.(*BatchTxRequest))
+func (m *RefundedContractCallTxsResponse) Reset()         { *m = RefundedContractCallTxsResponse{} }
+func (m *RefundedContractCallTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*RefundedContractCallTxsResponse) ProtoMessage()    {}
+func (*RefundedContractCallTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{69}
+}
+func (m *RefundedContractCallTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundedContractCallTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundedContractCallTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RefundedContractCallTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundedContractCallTxsResponse.Merge(m, src)
+}
+func (m *RefundedContractCallTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundedContractCallTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundedContractCallTxsResponse.DiscardUnknown(m)
 }
 
-func _Query_ContractCallTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractCallTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractCallTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/ContractCallTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractCallTx(ctx, req.(*ContractCallTxRequest))
+var xxx_messageInfo_RefundedContractCallTxsResponse proto.InternalMessageInfo
+
+func (m *RefundedContractCallTxsResponse) GetRecords() []ContractCallTxRefundRecord {
+	if m != nil {
+		return m.Records
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_SignerSetTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignerSetTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SignerSetTxs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/SignerSetTxs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SignerSetTxs(ctx, req.(*SignerSetTxsRequest))
+func (m *RefundedContractCallTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BatchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchTxs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/BatchTxs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchTxs(ctx, req.(*BatchTxsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type BridgeFeeAllowancesRequest struct {
+	Granter    string             `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee    string             `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_ContractCallTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractCallTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractCallTxs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/ContractCallTxs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractCallTxs(ctx, req.(*ContractCallTxsRequest))
+func (m *BridgeFeeAllowancesRequest) Reset()         { *m = BridgeFeeAllowancesRequest{} }
+func (m *BridgeFeeAllowancesRequest) String() string { return proto.CompactTextString(m) }
+func (*BridgeFeeAllowancesRequest) ProtoMessage()    {}
+func (*BridgeFeeAllowancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{70}
+}
+func (m *BridgeFeeAllowancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeFeeAllowancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeFeeAllowancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *BridgeFeeAllowancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeFeeAllowancesRequest.Merge(m, src)
+}
+func (m *BridgeFeeAllowancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeFeeAllowancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeFeeAllowancesRequest.DiscardUnknown(m)
 }
 
-func _Query_SignerSetTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignerSetTxConfirmationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SignerSetTxConfirmations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/SignerSetTxConfirmations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SignerSetTxConfirmations(ctx, req.(*SignerSetTxConfirmationsRequest))
+var xxx_messageInfo_BridgeFeeAllowancesRequest proto.InternalMessageInfo
+
+func (m *BridgeFeeAllowancesRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_BatchTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchTxConfirmationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchTxConfirmations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/BatchTxConfirmations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchTxConfirmations(ctx, req.(*BatchTxConfirmationsRequest))
+func (m *BridgeFeeAllowancesRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ContractCallTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractCallTxConfirmationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractCallTxConfirmations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/ContractCallTxConfirmations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractCallTxConfirmations(ctx, req.(*ContractCallTxConfirmationsRequest))
+func (m *BridgeFeeAllowancesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_UnsignedSignerSetTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsignedSignerSetTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnsignedSignerSetTxs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/UnsignedSignerSetTxs",
+type BridgeFeeAllowancesResponse struct {
+	Allowances []BridgeFeeAllowance `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *BridgeFeeAllowancesResponse) Reset()         { *m = BridgeFeeAllowancesResponse{} }
+func (m *BridgeFeeAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*BridgeFeeAllowancesResponse) ProtoMessage()    {}
+func (*BridgeFeeAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{71}
+}
+func (m *BridgeFeeAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeFeeAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeFeeAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnsignedSignerSetTxs(ctx, req.(*UnsignedSignerSetTxsRequest))
+}
+func (m *BridgeFeeAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeFeeAllowancesResponse.Merge(m, src)
+}
+func (m *BridgeFeeAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeFeeAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeFeeAllowancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeFeeAllowancesResponse proto.InternalMessageInfo
+
+func (m *BridgeFeeAllowancesResponse) GetAllowances() []BridgeFeeAllowance {
+	if m != nil {
+		return m.Allowances
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_UnsignedBatchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsignedBatchTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BridgeFeeAllowancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnsignedBatchTxs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/UnsignedBatchTxs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnsignedBatchTxs(ctx, req.(*UnsignedBatchTxsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_UnsignedContractCallTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsignedContractCallTxsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnsignedContractCallTxs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/UnsignedContractCallTxs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnsignedContractCallTxs(ctx, req.(*UnsignedContractCallTxsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type EthereumBlocklistRequest struct {
 }
 
-func _Query_LastSubmittedEthereumEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LastSubmittedEthereumEventRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastSubmittedEthereumEvent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/LastSubmittedEthereumEvent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastSubmittedEthereumEvent(ctx, req.(*LastSubmittedEthereumEventRequest))
+func (m *EthereumBlocklistRequest) Reset()         { *m = EthereumBlocklistRequest{} }
+func (m *EthereumBlocklistRequest) String() string { return proto.CompactTextString(m) }
+func (*EthereumBlocklistRequest) ProtoMessage()    {}
+func (*EthereumBlocklistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{72}
+}
+func (m *EthereumBlocklistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumBlocklistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumBlocklistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *EthereumBlocklistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumBlocklistRequest.Merge(m, src)
+}
+func (m *EthereumBlocklistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumBlocklistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumBlocklistRequest.DiscardUnknown(m)
 }
 
-func _Query_BatchTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchTxFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchTxFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/BatchTxFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchTxFees(ctx, req.(*BatchTxFeesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_EthereumBlocklistRequest proto.InternalMessageInfo
+
+type EthereumBlocklistResponse struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func _Query_ERC20ToDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ERC20ToDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ERC20ToDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/ERC20ToDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ERC20ToDenom(ctx, req.(*ERC20ToDenomRequest))
+func (m *EthereumBlocklistResponse) Reset()         { *m = EthereumBlocklistResponse{} }
+func (m *EthereumBlocklistResponse) String() string { return proto.CompactTextString(m) }
+func (*EthereumBlocklistResponse) ProtoMessage()    {}
+func (*EthereumBlocklistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{73}
+}
+func (m *EthereumBlocklistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumBlocklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumBlocklistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *EthereumBlocklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumBlocklistResponse.Merge(m, src)
+}
+func (m *EthereumBlocklistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumBlocklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumBlocklistResponse.DiscardUnknown(m)
 }
 
-func _Query_DenomToERC20Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DenomToERC20ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomToERC20Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/DenomToERC20Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomToERC20Params(ctx, req.(*DenomToERC20ParamsRequest))
+var xxx_messageInfo_EthereumBlocklistResponse proto.InternalMessageInfo
+
+func (m *EthereumBlocklistResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DenomToERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DenomToERC20Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomToERC20(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/DenomToERC20",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomToERC20(ctx, req.(*DenomToERC20Request))
-	}
-	return interceptor(ctx, in, info, handler)
+type ModuleAccountsRequest struct {
 }
 
-func _Query_BatchedSendToEthereums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchedSendToEthereumsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchedSendToEthereums(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/BatchedSendToEthereums",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchedSendToEthereums(ctx, req.(*BatchedSendToEthereumsRequest))
+func (m *ModuleAccountsRequest) Reset()         { *m = ModuleAccountsRequest{} }
+func (m *ModuleAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*ModuleAccountsRequest) ProtoMessage()    {}
+func (*ModuleAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{74}
+}
+func (m *ModuleAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ModuleAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleAccountsRequest.Merge(m, src)
+}
+func (m *ModuleAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleAccountsRequest.DiscardUnknown(m)
 }
 
-func _Query_UnbatchedSendToEthereums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnbatchedSendToEthereumsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnbatchedSendToEthereums(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/UnbatchedSendToEthereums",
+var xxx_messageInfo_ModuleAccountsRequest proto.InternalMessageInfo
+
+type ModuleAccountsResponse struct {
+	Accounts []*BridgeModuleAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *ModuleAccountsResponse) Reset()         { *m = ModuleAccountsResponse{} }
+func (m *ModuleAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*ModuleAccountsResponse) ProtoMessage()    {}
+func (*ModuleAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{75}
+}
+func (m *ModuleAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnbatchedSendToEthereums(ctx, req.(*UnbatchedSendToEthereumsRequest))
+}
+func (m *ModuleAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleAccountsResponse.Merge(m, src)
+}
+func (m *ModuleAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleAccountsResponse proto.InternalMessageInfo
+
+func (m *ModuleAccountsResponse) GetAccounts() []*BridgeModuleAccount {
+	if m != nil {
+		return m.Accounts
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DelegateKeysByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelegateKeysByValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// BridgeModuleAccount describes a module account the bridge moves funds through
+type BridgeModuleAccount struct {
+	Name     string                                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address  string                                   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Purpose  string                                   `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+}
+
+func (m *BridgeModuleAccount) Reset()         { *m = BridgeModuleAccount{} }
+func (m *BridgeModuleAccount) String() string { return proto.CompactTextString(m) }
+func (*BridgeModuleAccount) ProtoMessage()    {}
+func (*BridgeModuleAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{76}
+}
+func (m *BridgeModuleAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeModuleAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeModuleAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegateKeysByValidator(ctx, in)
+}
+func (m *BridgeModuleAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeModuleAccount.Merge(m, src)
+}
+func (m *BridgeModuleAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeModuleAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeModuleAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeModuleAccount proto.InternalMessageInfo
+
+func (m *BridgeModuleAccount) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/DelegateKeysByValidator",
+	return ""
+}
+
+func (m *BridgeModuleAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegateKeysByValidator(ctx, req.(*DelegateKeysByValidatorRequest))
+	return ""
+}
+
+func (m *BridgeModuleAccount) GetPurpose() string {
+	if m != nil {
+		return m.Purpose
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_DelegateKeysByEthereumSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelegateKeysByEthereumSignerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BridgeModuleAccount) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegateKeysByEthereumSigner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/DelegateKeysByEthereumSigner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegateKeysByEthereumSigner(ctx, req.(*DelegateKeysByEthereumSignerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DelegateKeysByOrchestrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelegateKeysByOrchestratorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegateKeysByOrchestrator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/DelegateKeysByOrchestrator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegateKeysByOrchestrator(ctx, req.(*DelegateKeysByOrchestratorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// rpc EndBlockerActions
+type EndBlockerActionsRequest struct {
+	// maximum number of actions to return, all the recorded ones if zero
+	Limit uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func _Query_DelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelegateKeysRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegateKeys(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/DelegateKeys",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegateKeys(ctx, req.(*DelegateKeysRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *EndBlockerActionsRequest) Reset()         { *m = EndBlockerActionsRequest{} }
+func (m *EndBlockerActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*EndBlockerActionsRequest) ProtoMessage()    {}
+func (*EndBlockerActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{77}
 }
-
-func _Query_LastObservedEthereumHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LastObservedEthereumHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastObservedEthereumHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/LastObservedEthereumHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastObservedEthereumHeight(ctx, req.(*LastObservedEthereumHeightRequest))
+func (m *EndBlockerActionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndBlockerActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndBlockerActionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "gravity.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "SignerSetTx",
-			Handler:    _Query_SignerSetTx_Handler,
-		},
-		{
-			MethodName: "LatestSignerSetTx",
-			Handler:    _Query_LatestSignerSetTx_Handler,
-		},
-		{
-			MethodName: "BatchTx",
-			Handler:    _Query_BatchTx_Handler,
-		},
-		{
-			MethodName: "ContractCallTx",
-			Handler:    _Query_ContractCallTx_Handler,
-		},
-		{
-			MethodName: "SignerSetTxs",
-			Handler:    _Query_SignerSetTxs_Handler,
-		},
-		{
-			MethodName: "BatchTxs",
-			Handler:    _Query_BatchTxs_Handler,
-		},
-		{
-			MethodName: "ContractCallTxs",
-			Handler:    _Query_ContractCallTxs_Handler,
-		},
-		{
-			MethodName: "SignerSetTxConfirmations",
-			Handler:    _Query_SignerSetTxConfirmations_Handler,
-		},
-		{
-			MethodName: "BatchTxConfirmations",
-			Handler:    _Query_BatchTxConfirmations_Handler,
-		},
-		{
-			MethodName: "ContractCallTxConfirmations",
-			Handler:    _Query_ContractCallTxConfirmations_Handler,
-		},
-		{
-			MethodName: "UnsignedSignerSetTxs",
-			Handler:    _Query_UnsignedSignerSetTxs_Handler,
-		},
-		{
-			MethodName: "UnsignedBatchTxs",
-			Handler:    _Query_UnsignedBatchTxs_Handler,
-		},
-		{
-			MethodName: "UnsignedContractCallTxs",
-			Handler:    _Query_UnsignedContractCallTxs_Handler,
-		},
-		{
-			MethodName: "LastSubmittedEthereumEvent",
-			Handler:    _Query_LastSubmittedEthereumEvent_Handler,
-		},
-		{
-			MethodName: "BatchTxFees",
-			Handler:    _Query_BatchTxFees_Handler,
-		},
-		{
-			MethodName: "ERC20ToDenom",
-			Handler:    _Query_ERC20ToDenom_Handler,
-		},
-		{
-			MethodName: "DenomToERC20Params",
-			Handler:    _Query_DenomToERC20Params_Handler,
-		},
-		{
-			MethodName: "DenomToERC20",
-			Handler:    _Query_DenomToERC20_Handler,
-		},
-		{
-			MethodName: "BatchedSendToEthereums",
-			Handler:    _Query_BatchedSendToEthereums_Handler,
-		},
-		{
-			MethodName: "UnbatchedSendToEthereums",
-			Handler:    _Query_UnbatchedSendToEthereums_Handler,
-		},
-		{
-			MethodName: "DelegateKeysByValidator",
-			Handler:    _Query_DelegateKeysByValidator_Handler,
-		},
-		{
-			MethodName: "DelegateKeysByEthereumSigner",
-			Handler:    _Query_DelegateKeysByEthereumSigner_Handler,
-		},
-		{
-			MethodName: "DelegateKeysByOrchestrator",
-			Handler:    _Query_DelegateKeysByOrchestrator_Handler,
-		},
-		{
-			MethodName: "DelegateKeys",
-			Handler:    _Query_DelegateKeys_Handler,
-		},
-		{
-			MethodName: "LastObservedEthereumHeight",
-			Handler:    _Query_LastObservedEthereumHeight_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "gravity/v1/query.proto",
+func (m *EndBlockerActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndBlockerActionsRequest.Merge(m, src)
 }
-
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EndBlockerActionsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EndBlockerActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndBlockerActionsRequest.DiscardUnknown(m)
 }
 
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_EndBlockerActionsRequest proto.InternalMessageInfo
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EndBlockerActionsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EndBlockerActionsResponse struct {
+	Actions []*EndBlockerAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *EndBlockerActionsResponse) Reset()         { *m = EndBlockerActionsResponse{} }
+func (m *EndBlockerActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*EndBlockerActionsResponse) ProtoMessage()    {}
+func (*EndBlockerActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{78}
+}
+func (m *EndBlockerActionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndBlockerActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndBlockerActionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EndBlockerActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndBlockerActionsResponse.Merge(m, src)
+}
+func (m *EndBlockerActionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndBlockerActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndBlockerActionsResponse.DiscardUnknown(m)
 }
 
-func (m *SignerSetTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EndBlockerActionsResponse proto.InternalMessageInfo
+
+func (m *EndBlockerActionsResponse) GetActions() []*EndBlockerAction {
+	if m != nil {
+		return m.Actions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SignerSetTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// rpc SignerSetTxsByHeightRange
+type SignerSetTxsByHeightRangeRequest struct {
+	// first ethereum block height of the range, inclusive
+	StartHeight uint64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// last ethereum block height of the range, inclusive, unbounded if zero
+	EndHeight  uint64             `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *SignerSetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SignerSetNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *SignerSetTxsByHeightRangeRequest) Reset()         { *m = SignerSetTxsByHeightRangeRequest{} }
+func (m *SignerSetTxsByHeightRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxsByHeightRangeRequest) ProtoMessage()    {}
+func (*SignerSetTxsByHeightRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{79}
 }
-
-func (m *LatestSignerSetTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SignerSetTxsByHeightRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSetTxsByHeightRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetTxsByHeightRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *SignerSetTxsByHeightRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxsByHeightRangeRequest.Merge(m, src)
+}
+func (m *SignerSetTxsByHeightRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSetTxsByHeightRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxsByHeightRangeRequest.DiscardUnknown(m)
 }
 
-func (m *LatestSignerSetTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_SignerSetTxsByHeightRangeRequest proto.InternalMessageInfo
+
+func (m *SignerSetTxsByHeightRangeRequest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
 }
 
-func (m *LatestSignerSetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *SignerSetTxsByHeightRangeRequest) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
 }
 
-func (m *SignerSetTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SignerSetTxsByHeightRangeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SignerSetTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SignerSetTxsByHeightRangeResponse struct {
+	SignerSets []*ObservedSignerSetTx `protobuf:"bytes,1,rep,name=signer_sets,json=signerSets,proto3" json:"signer_sets,omitempty"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *SignerSetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SignerSet != nil {
-		{
-			size, err := m.SignerSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *SignerSetTxsByHeightRangeResponse) Reset()         { *m = SignerSetTxsByHeightRangeResponse{} }
+func (m *SignerSetTxsByHeightRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxsByHeightRangeResponse) ProtoMessage()    {}
+func (*SignerSetTxsByHeightRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{80}
+}
+func (m *SignerSetTxsByHeightRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSetTxsByHeightRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetTxsByHeightRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SignerSetTxsByHeightRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxsByHeightRangeResponse.Merge(m, src)
+}
+func (m *SignerSetTxsByHeightRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSetTxsByHeightRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxsByHeightRangeResponse.DiscardUnknown(m)
 }
 
-func (m *BatchTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SignerSetTxsByHeightRangeResponse proto.InternalMessageInfo
+
+func (m *SignerSetTxsByHeightRangeResponse) GetSignerSets() []*ObservedSignerSetTx {
+	if m != nil {
+		return m.SignerSets
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BatchTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SignerSetTxsByHeightRangeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *BatchTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BatchNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// rpc BridgeConfig
+type BridgeConfigRequest struct {
 }
 
-func (m *BatchTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BridgeConfigRequest) Reset()         { *m = BridgeConfigRequest{} }
+func (m *BridgeConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*BridgeConfigRequest) ProtoMessage()    {}
+func (*BridgeConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{81}
+}
+func (m *BridgeConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *BridgeConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeConfigRequest.Merge(m, src)
+}
+func (m *BridgeConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeConfigRequest.DiscardUnknown(m)
 }
 
-func (m *BatchTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_BridgeConfigRequest proto.InternalMessageInfo
+
+type BridgeConfigResponse struct {
+	Config GenesisState `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
 }
 
-func (m *BatchTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Batch != nil {
-		{
-			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *BridgeConfigResponse) Reset()         { *m = BridgeConfigResponse{} }
+func (m *BridgeConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*BridgeConfigResponse) ProtoMessage()    {}
+func (*BridgeConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{82}
+}
+func (m *BridgeConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BridgeConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeConfigResponse.Merge(m, src)
+}
+func (m *BridgeConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeConfigResponse.DiscardUnknown(m)
 }
 
-func (m *ContractCallTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BridgeConfigResponse proto.InternalMessageInfo
+
+func (m *BridgeConfigResponse) GetConfig() GenesisState {
+	if m != nil {
+		return m.Config
 	}
-	return dAtA[:n], nil
+	return GenesisState{}
 }
 
-func (m *ContractCallTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// rpc ReplayDiff
+type ReplayDiffRequest struct {
 }
 
-func (m *ContractCallTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.InvalidationNonce))
-		i--
-		dAtA[i] = 0x10
+func (m *ReplayDiffRequest) Reset()         { *m = ReplayDiffRequest{} }
+func (m *ReplayDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplayDiffRequest) ProtoMessage()    {}
+func (*ReplayDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{83}
+}
+func (m *ReplayDiffRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayDiffRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.InvalidationScope) > 0 {
-		i -= len(m.InvalidationScope)
-		copy(dAtA[i:], m.InvalidationScope)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationScope)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ReplayDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayDiffRequest.Merge(m, src)
+}
+func (m *ReplayDiffRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayDiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayDiffRequest proto.InternalMessageInfo
+
+type ReplayDiffResponse struct {
+	Discrepancies []ReplayDiscrepancy `protobuf:"bytes,1,rep,name=discrepancies,proto3" json:"discrepancies"`
+}
+
+func (m *ReplayDiffResponse) Reset()         { *m = ReplayDiffResponse{} }
+func (m *ReplayDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*ReplayDiffResponse) ProtoMessage()    {}
+func (*ReplayDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{84}
+}
+func (m *ReplayDiffResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayDiffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ReplayDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayDiffResponse.Merge(m, src)
+}
+func (m *ReplayDiffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayDiffResponse.DiscardUnknown(m)
 }
 
-func (m *ContractCallTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ReplayDiffResponse proto.InternalMessageInfo
+
+func (m *ReplayDiffResponse) GetDiscrepancies() []ReplayDiscrepancy {
+	if m != nil {
+		return m.Discrepancies
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ContractCallTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ReplayDiscrepancy is a field of the bridge state whose rebuilt value
+// disagrees with the live one
+type ReplayDiscrepancy struct {
+	Field   string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Rebuilt string `protobuf:"bytes,2,opt,name=rebuilt,proto3" json:"rebuilt,omitempty"`
+	Live    string `protobuf:"bytes,3,opt,name=live,proto3" json:"live,omitempty"`
 }
 
-func (m *ContractCallTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogicCall != nil {
-		{
-			size, err := m.LogicCall.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ReplayDiscrepancy) Reset()         { *m = ReplayDiscrepancy{} }
+func (m *ReplayDiscrepancy) String() string { return proto.CompactTextString(m) }
+func (*ReplayDiscrepancy) ProtoMessage()    {}
+func (*ReplayDiscrepancy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{85}
+}
+func (m *ReplayDiscrepancy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayDiscrepancy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayDiscrepancy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SignerSetTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ReplayDiscrepancy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayDiscrepancy.Merge(m, src)
+}
+func (m *ReplayDiscrepancy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayDiscrepancy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayDiscrepancy.DiscardUnknown(m)
 }
 
-func (m *SignerSetTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ReplayDiscrepancy proto.InternalMessageInfo
+
+func (m *ReplayDiscrepancy) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
 }
 
-func (m *SignerSetTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SignerSetNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
-		i--
-		dAtA[i] = 0x8
+func (m *ReplayDiscrepancy) GetRebuilt() string {
+	if m != nil {
+		return m.Rebuilt
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SignerSetTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReplayDiscrepancy) GetLive() string {
+	if m != nil {
+		return m.Live
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SignerSetTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// rpc PendingERC20Deployments
+type PendingERC20DeploymentsRequest struct {
 }
 
-func (m *SignerSetTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *PendingERC20DeploymentsRequest) Reset()         { *m = PendingERC20DeploymentsRequest{} }
+func (m *PendingERC20DeploymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingERC20DeploymentsRequest) ProtoMessage()    {}
+func (*PendingERC20DeploymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{86}
+}
+func (m *PendingERC20DeploymentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingERC20DeploymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingERC20DeploymentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SignerSetTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PendingERC20DeploymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingERC20DeploymentsRequest.Merge(m, src)
+}
+func (m *PendingERC20DeploymentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingERC20DeploymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingERC20DeploymentsRequest.DiscardUnknown(m)
 }
 
-func (m *SignerSetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PendingERC20DeploymentsRequest proto.InternalMessageInfo
+
+type PendingERC20DeploymentsResponse struct {
+	Deployments []ERC20DeployedEvent `protobuf:"bytes,1,rep,name=deployments,proto3" json:"deployments"`
 }
 
-func (m *SignerSetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *PendingERC20DeploymentsResponse) Reset()         { *m = PendingERC20DeploymentsResponse{} }
+func (m *PendingERC20DeploymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingERC20DeploymentsResponse) ProtoMessage()    {}
+func (*PendingERC20DeploymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{87}
+}
+func (m *PendingERC20DeploymentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingERC20DeploymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingERC20DeploymentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PendingERC20DeploymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingERC20DeploymentsResponse.Merge(m, src)
+}
+func (m *PendingERC20DeploymentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingERC20DeploymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingERC20DeploymentsResponse.DiscardUnknown(m)
 }
 
-func (m *SignerSetTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PendingERC20DeploymentsResponse proto.InternalMessageInfo
+
+func (m *PendingERC20DeploymentsResponse) GetDeployments() []ERC20DeployedEvent {
+	if m != nil {
+		return m.Deployments
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SignerSetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// rpc ERC20Conversion
+type ERC20ConversionRequest struct {
+	Erc20 string `protobuf:"bytes,1,opt,name=erc20,proto3" json:"erc20,omitempty"`
 }
 
-func (m *SignerSetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SignerSets) > 0 {
-		for iNdEx := len(m.SignerSets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ERC20ConversionRequest) Reset()         { *m = ERC20ConversionRequest{} }
+func (m *ERC20ConversionRequest) String() string { return proto.CompactTextString(m) }
+func (*ERC20ConversionRequest) ProtoMessage()    {}
+func (*ERC20ConversionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{88}
+}
+func (m *ERC20ConversionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20ConversionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20ConversionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ERC20ConversionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20ConversionRequest.Merge(m, src)
+}
+func (m *ERC20ConversionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20ConversionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20ConversionRequest.DiscardUnknown(m)
 }
 
-func (m *BatchTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ERC20ConversionRequest proto.InternalMessageInfo
+
+func (m *ERC20ConversionRequest) GetErc20() string {
+	if m != nil {
+		return m.Erc20
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BatchTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ERC20ConversionResponse struct {
+	Conversion ERC20Conversion `protobuf:"bytes,1,opt,name=conversion,proto3" json:"conversion"`
+	Denom      string          `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// number of ERC20 base units one base unit of the denom is worth
+	Factor github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=factor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"factor"`
 }
 
-func (m *BatchTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ERC20ConversionResponse) Reset()         { *m = ERC20ConversionResponse{} }
+func (m *ERC20ConversionResponse) String() string { return proto.CompactTextString(m) }
+func (*ERC20ConversionResponse) ProtoMessage()    {}
+func (*ERC20ConversionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{89}
+}
+func (m *ERC20ConversionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20ConversionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20ConversionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ERC20ConversionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20ConversionResponse.Merge(m, src)
+}
+func (m *ERC20ConversionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20ConversionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20ConversionResponse.DiscardUnknown(m)
 }
 
-func (m *BatchTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ERC20ConversionResponse proto.InternalMessageInfo
+
+func (m *ERC20ConversionResponse) GetConversion() ERC20Conversion {
+	if m != nil {
+		return m.Conversion
 	}
-	return dAtA[:n], nil
+	return ERC20Conversion{}
 }
 
-func (m *BatchTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ERC20ConversionResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *BatchTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Batches) > 0 {
-		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// rpc TokenPauses
+type TokenPausesRequest struct {
 }
 
-func (m *ContractCallTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TokenPausesRequest) Reset()         { *m = TokenPausesRequest{} }
+func (m *TokenPausesRequest) String() string { return proto.CompactTextString(m) }
+func (*TokenPausesRequest) ProtoMessage()    {}
+func (*TokenPausesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{90}
+}
+func (m *TokenPausesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPausesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPausesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *TokenPausesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPausesRequest.Merge(m, src)
+}
+func (m *TokenPausesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPausesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPausesRequest.DiscardUnknown(m)
 }
 
-func (m *ContractCallTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_TokenPausesRequest proto.InternalMessageInfo
+
+type TokenPausesResponse struct {
+	Pauses []TokenPause `protobuf:"bytes,1,rep,name=pauses,proto3" json:"pauses"`
 }
 
-func (m *ContractCallTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *TokenPausesResponse) Reset()         { *m = TokenPausesResponse{} }
+func (m *TokenPausesResponse) String() string { return proto.CompactTextString(m) }
+func (*TokenPausesResponse) ProtoMessage()    {}
+func (*TokenPausesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{91}
+}
+func (m *TokenPausesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPausesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPausesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TokenPausesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPausesResponse.Merge(m, src)
+}
+func (m *TokenPausesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPausesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPausesResponse.DiscardUnknown(m)
 }
 
-func (m *ContractCallTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TokenPausesResponse proto.InternalMessageInfo
+
+func (m *TokenPausesResponse) GetPauses() []TokenPause {
+	if m != nil {
+		return m.Pauses
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ContractCallTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// rpc OrchestratorQueryIdentity
+type OrchestratorQueryIdentityRequest struct {
+	KeyHash []byte `protobuf:"bytes,1,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`
 }
 
-func (m *ContractCallTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Calls) > 0 {
-		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *OrchestratorQueryIdentityRequest) Reset()         { *m = OrchestratorQueryIdentityRequest{} }
+func (m *OrchestratorQueryIdentityRequest) String() string { return proto.CompactTextString(m) }
+func (*OrchestratorQueryIdentityRequest) ProtoMessage()    {}
+func (*OrchestratorQueryIdentityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{92}
+}
+func (m *OrchestratorQueryIdentityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrchestratorQueryIdentityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrchestratorQueryIdentityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *OrchestratorQueryIdentityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrchestratorQueryIdentityRequest.Merge(m, src)
+}
+func (m *OrchestratorQueryIdentityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrchestratorQueryIdentityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrchestratorQueryIdentityRequest.DiscardUnknown(m)
 }
 
-func (m *UnsignedSignerSetTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OrchestratorQueryIdentityRequest proto.InternalMessageInfo
+
+func (m *OrchestratorQueryIdentityRequest) GetKeyHash() []byte {
+	if m != nil {
+		return m.KeyHash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UnsignedSignerSetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type OrchestratorQueryIdentityResponse struct {
+	Identity OrchestratorQueryIdentity `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity"`
 }
 
-func (m *UnsignedSignerSetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *OrchestratorQueryIdentityResponse) Reset()         { *m = OrchestratorQueryIdentityResponse{} }
+func (m *OrchestratorQueryIdentityResponse) String() string { return proto.CompactTextString(m) }
+func (*OrchestratorQueryIdentityResponse) ProtoMessage()    {}
+func (*OrchestratorQueryIdentityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{93}
+}
+func (m *OrchestratorQueryIdentityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrchestratorQueryIdentityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrchestratorQueryIdentityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *OrchestratorQueryIdentityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrchestratorQueryIdentityResponse.Merge(m, src)
+}
+func (m *OrchestratorQueryIdentityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrchestratorQueryIdentityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrchestratorQueryIdentityResponse.DiscardUnknown(m)
 }
 
-func (m *UnsignedSignerSetTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OrchestratorQueryIdentityResponse proto.InternalMessageInfo
+
+func (m *OrchestratorQueryIdentityResponse) GetIdentity() OrchestratorQueryIdentity {
+	if m != nil {
+		return m.Identity
 	}
-	return dAtA[:n], nil
+	return OrchestratorQueryIdentity{}
 }
 
-func (m *UnsignedSignerSetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// rpc SignerSetTxCheckpoint, BatchTxCheckpoint and ContractCallTxCheckpoint
+//
+// OutgoingTxCheckpointResponse returns the checkpoint of an outgoing tx, the
+// ABI encoded and keccak256 hashed tx the gravity contract checks, along with
+// the digest validators actually sign, which is the checkpoint prefixed with
+// "\x19Ethereum Signed Message:\n32" and hashed again. A signature is valid if
+// it recovers the validator's ethereum address from signed_hash.
+type OutgoingTxCheckpointResponse struct {
+	Checkpoint []byte `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	SignedHash []byte `protobuf:"bytes,2,opt,name=signed_hash,json=signedHash,proto3" json:"signed_hash,omitempty"`
+	GravityId  string `protobuf:"bytes,3,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+}
+
+func (m *OutgoingTxCheckpointResponse) Reset()         { *m = OutgoingTxCheckpointResponse{} }
+func (m *OutgoingTxCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*OutgoingTxCheckpointResponse) ProtoMessage()    {}
+func (*OutgoingTxCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{94}
+}
+func (m *OutgoingTxCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *UnsignedSignerSetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SignerSets) > 0 {
-		for iNdEx := len(m.SignerSets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *OutgoingTxCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutgoingTxCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *OutgoingTxCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutgoingTxCheckpointResponse.Merge(m, src)
+}
+func (m *OutgoingTxCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutgoingTxCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutgoingTxCheckpointResponse.DiscardUnknown(m)
 }
 
-func (m *UnsignedBatchTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OutgoingTxCheckpointResponse proto.InternalMessageInfo
+
+func (m *OutgoingTxCheckpointResponse) GetCheckpoint() []byte {
+	if m != nil {
+		return m.Checkpoint
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UnsignedBatchTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OutgoingTxCheckpointResponse) GetSignedHash() []byte {
+	if m != nil {
+		return m.SignedHash
+	}
+	return nil
 }
 
-func (m *UnsignedBatchTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *OutgoingTxCheckpointResponse) GetGravityId() string {
+	if m != nil {
+		return m.GravityId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UnsignedBatchTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// rpc DelayedSendToEthereums
+type DelayedSendToEthereumsRequest struct {
+	SenderAddress string             `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *UnsignedBatchTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DelayedSendToEthereumsRequest) Reset()         { *m = DelayedSendToEthereumsRequest{} }
+func (m *DelayedSendToEthereumsRequest) String() string { return proto.CompactTextString(m) }
+func (*DelayedSendToEthereumsRequest) ProtoMessage()    {}
+func (*DelayedSendToEthereumsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{95}
 }
-
-func (m *UnsignedBatchTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Batches) > 0 {
-		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *DelayedSendToEthereumsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedSendToEthereumsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedSendToEthereumsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *UnsignedContractCallTxsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DelayedSendToEthereumsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedSendToEthereumsRequest.Merge(m, src)
 }
-
-func (m *UnsignedContractCallTxsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DelayedSendToEthereumsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedSendToEthereumsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedSendToEthereumsRequest.DiscardUnknown(m)
 }
 
-func (m *UnsignedContractCallTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_DelayedSendToEthereumsRequest proto.InternalMessageInfo
+
+func (m *DelayedSendToEthereumsRequest) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UnsignedContractCallTxsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DelayedSendToEthereumsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UnsignedContractCallTxsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DelayedSendToEthereumsResponse struct {
+	DelayedSendToEthereums []DelayedSendToEthereum `protobuf:"bytes,1,rep,name=delayed_send_to_ethereums,json=delayedSendToEthereums,proto3" json:"delayed_send_to_ethereums"`
+	Pagination             *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *UnsignedContractCallTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Calls) > 0 {
-		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *DelayedSendToEthereumsResponse) Reset()         { *m = DelayedSendToEthereumsResponse{} }
+func (m *DelayedSendToEthereumsResponse) String() string { return proto.CompactTextString(m) }
+func (*DelayedSendToEthereumsResponse) ProtoMessage()    {}
+func (*DelayedSendToEthereumsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{96}
+}
+func (m *DelayedSendToEthereumsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedSendToEthereumsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedSendToEthereumsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *BatchTxFeesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DelayedSendToEthereumsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedSendToEthereumsResponse.Merge(m, src)
 }
-
-func (m *BatchTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DelayedSendToEthereumsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedSendToEthereumsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedSendToEthereumsResponse.DiscardUnknown(m)
 }
 
-func (m *BatchTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_DelayedSendToEthereumsResponse proto.InternalMessageInfo
+
+func (m *DelayedSendToEthereumsResponse) GetDelayedSendToEthereums() []DelayedSendToEthereum {
+	if m != nil {
+		return m.DelayedSendToEthereums
+	}
+	return nil
 }
 
-func (m *BatchTxFeesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DelayedSendToEthereumsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BatchTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// rpc PendingEventVoteRecords
+type PendingEventVoteRecordsRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *BatchTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fees) > 0 {
-		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *PendingEventVoteRecordsRequest) Reset()         { *m = PendingEventVoteRecordsRequest{} }
+func (m *PendingEventVoteRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingEventVoteRecordsRequest) ProtoMessage()    {}
+func (*PendingEventVoteRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{97}
+}
+func (m *PendingEventVoteRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingEventVoteRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingEventVoteRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PendingEventVoteRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingEventVoteRecordsRequest.Merge(m, src)
+}
+func (m *PendingEventVoteRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingEventVoteRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingEventVoteRecordsRequest.DiscardUnknown(m)
 }
 
-func (m *ContractCallTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PendingEventVoteRecordsRequest proto.InternalMessageInfo
+
+func (m *PendingEventVoteRecordsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ContractCallTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PendingEventVoteRecordsResponse struct {
+	// last event nonce the validator voted on
+	LastEventNonce uint64 `protobuf:"varint,1,opt,name=last_event_nonce,json=lastEventNonce,proto3" json:"last_event_nonce,omitempty"`
+	// last event nonce observed by the bridge
+	LastObservedEventNonce uint64                     `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
+	EventVoteRecords       []*EthereumEventVoteRecord `protobuf:"bytes,3,rep,name=event_vote_records,json=eventVoteRecords,proto3" json:"event_vote_records,omitempty"`
 }
 
-func (m *ContractCallTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.InvalidationNonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.InvalidationScope) > 0 {
-		i -= len(m.InvalidationScope)
-		copy(dAtA[i:], m.InvalidationScope)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationScope)))
-		i--
-		dAtA[i] = 0xa
+func (m *PendingEventVoteRecordsResponse) Reset()         { *m = PendingEventVoteRecordsResponse{} }
+func (m *PendingEventVoteRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingEventVoteRecordsResponse) ProtoMessage()    {}
+func (*PendingEventVoteRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{98}
+}
+func (m *PendingEventVoteRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingEventVoteRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingEventVoteRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PendingEventVoteRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingEventVoteRecordsResponse.Merge(m, src)
+}
+func (m *PendingEventVoteRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingEventVoteRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingEventVoteRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *ContractCallTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PendingEventVoteRecordsResponse proto.InternalMessageInfo
+
+func (m *PendingEventVoteRecordsResponse) GetLastEventNonce() uint64 {
+	if m != nil {
+		return m.LastEventNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ContractCallTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingEventVoteRecordsResponse) GetLastObservedEventNonce() uint64 {
+	if m != nil {
+		return m.LastObservedEventNonce
+	}
+	return 0
 }
 
-func (m *ContractCallTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *PendingEventVoteRecordsResponse) GetEventVoteRecords() []*EthereumEventVoteRecord {
+	if m != nil {
+		return m.EventVoteRecords
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BatchTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// rpc EventByEthereumTxHash
+type EventByEthereumTxHashRequest struct {
+	EthereumTxHash string `protobuf:"bytes,1,opt,name=ethereum_tx_hash,json=ethereumTxHash,proto3" json:"ethereum_tx_hash,omitempty"`
 }
 
-func (m *BatchTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventByEthereumTxHashRequest) Reset()         { *m = EventByEthereumTxHashRequest{} }
+func (m *EventByEthereumTxHashRequest) String() string { return proto.CompactTextString(m) }
+func (*EventByEthereumTxHashRequest) ProtoMessage()    {}
+func (*EventByEthereumTxHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{99}
 }
-
-func (m *BatchTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BatchNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
-		i--
-		dAtA[i] = 0x8
+func (m *EventByEthereumTxHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventByEthereumTxHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventByEthereumTxHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventByEthereumTxHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventByEthereumTxHashRequest.Merge(m, src)
+}
+func (m *EventByEthereumTxHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventByEthereumTxHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventByEthereumTxHashRequest.DiscardUnknown(m)
 }
 
-func (m *BatchTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventByEthereumTxHashRequest proto.InternalMessageInfo
+
+func (m *EventByEthereumTxHashRequest) GetEthereumTxHash() string {
+	if m != nil {
+		return m.EthereumTxHash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BatchTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventByEthereumTxHashResponse struct {
+	EventVoteRecords []*EthereumEventVoteRecord `protobuf:"bytes,1,rep,name=event_vote_records,json=eventVoteRecords,proto3" json:"event_vote_records,omitempty"`
 }
 
-func (m *BatchTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *EventByEthereumTxHashResponse) Reset()         { *m = EventByEthereumTxHashResponse{} }
+func (m *EventByEthereumTxHashResponse) String() string { return proto.CompactTextString(m) }
+func (*EventByEthereumTxHashResponse) ProtoMessage()    {}
+func (*EventByEthereumTxHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{100}
+}
+func (m *EventByEthereumTxHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventByEthereumTxHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventByEthereumTxHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventByEthereumTxHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventByEthereumTxHashResponse.Merge(m, src)
+}
+func (m *EventByEthereumTxHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventByEthereumTxHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventByEthereumTxHashResponse.DiscardUnknown(m)
 }
 
-func (m *LastSubmittedEthereumEventRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventByEthereumTxHashResponse proto.InternalMessageInfo
+
+func (m *EventByEthereumTxHashResponse) GetEventVoteRecords() []*EthereumEventVoteRecord {
+	if m != nil {
+		return m.EventVoteRecords
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LastSubmittedEthereumEventRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// rpc ConflictingEthereumEvents
+type ConflictingEthereumEventsRequest struct {
 }
 
-func (m *LastSubmittedEthereumEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *ConflictingEthereumEventsRequest) Reset()         { *m = ConflictingEthereumEventsRequest{} }
+func (m *ConflictingEthereumEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ConflictingEthereumEventsRequest) ProtoMessage()    {}
+func (*ConflictingEthereumEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{101}
 }
-
-func (m *LastSubmittedEthereumEventResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConflictingEthereumEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictingEthereumEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictingEthereumEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ConflictingEthereumEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictingEthereumEventsRequest.Merge(m, src)
+}
+func (m *ConflictingEthereumEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictingEthereumEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictingEthereumEventsRequest.DiscardUnknown(m)
 }
 
-func (m *LastSubmittedEthereumEventResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ConflictingEthereumEventsRequest proto.InternalMessageInfo
+
+type ConflictingEthereumEventsResponse struct {
+	Conflicts []ConflictingEthereumEvents `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts"`
 }
 
-func (m *LastSubmittedEthereumEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EventNonce))
-		i--
-		dAtA[i] = 0x8
+func (m *ConflictingEthereumEventsResponse) Reset()         { *m = ConflictingEthereumEventsResponse{} }
+func (m *ConflictingEthereumEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ConflictingEthereumEventsResponse) ProtoMessage()    {}
+func (*ConflictingEthereumEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{102}
+}
+func (m *ConflictingEthereumEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictingEthereumEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictingEthereumEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConflictingEthereumEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictingEthereumEventsResponse.Merge(m, src)
+}
+func (m *ConflictingEthereumEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictingEthereumEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictingEthereumEventsResponse.DiscardUnknown(m)
 }
 
-func (m *ERC20ToDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConflictingEthereumEventsResponse proto.InternalMessageInfo
+
+func (m *ConflictingEthereumEventsResponse) GetConflicts() []ConflictingEthereumEvents {
+	if m != nil {
+		return m.Conflicts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ERC20ToDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConflictingEthereumEvents are the vote records of the ethereum events voted
+// on at the same event nonce
+type ConflictingEthereumEvents struct {
+	EventNonce       uint64                     `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	EventVoteRecords []*EthereumEventVoteRecord `protobuf:"bytes,2,rep,name=event_vote_records,json=eventVoteRecords,proto3" json:"event_vote_records,omitempty"`
 }
 
-func (m *ERC20ToDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Erc20) > 0 {
-		i -= len(m.Erc20)
-		copy(dAtA[i:], m.Erc20)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20)))
-		i--
-		dAtA[i] = 0xa
+func (m *ConflictingEthereumEvents) Reset()         { *m = ConflictingEthereumEvents{} }
+func (m *ConflictingEthereumEvents) String() string { return proto.CompactTextString(m) }
+func (*ConflictingEthereumEvents) ProtoMessage()    {}
+func (*ConflictingEthereumEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{103}
+}
+func (m *ConflictingEthereumEvents) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictingEthereumEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictingEthereumEvents.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConflictingEthereumEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictingEthereumEvents.Merge(m, src)
+}
+func (m *ConflictingEthereumEvents) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictingEthereumEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictingEthereumEvents.DiscardUnknown(m)
 }
 
-func (m *ERC20ToDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConflictingEthereumEvents proto.InternalMessageInfo
+
+func (m *ConflictingEthereumEvents) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ERC20ToDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConflictingEthereumEvents) GetEventVoteRecords() []*EthereumEventVoteRecord {
+	if m != nil {
+		return m.EventVoteRecords
+	}
+	return nil
 }
 
-func (m *ERC20ToDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CosmosOriginated {
-		i--
-		if m.CosmosOriginated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+// rpc BridgeStats
+// the stats of all tokens are returned if no token contract is given
+type BridgeStatsRequest struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+}
+
+func (m *BridgeStatsRequest) Reset()         { *m = BridgeStatsRequest{} }
+func (m *BridgeStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*BridgeStatsRequest) ProtoMessage()    {}
+func (*BridgeStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{104}
+}
+func (m *BridgeStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BridgeStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeStatsRequest.Merge(m, src)
+}
+func (m *BridgeStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeStatsRequest.DiscardUnknown(m)
 }
 
-func (m *DenomToERC20ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BridgeStatsRequest proto.InternalMessageInfo
+
+func (m *BridgeStatsRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DenomToERC20ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BridgeStatsResponse struct {
+	Stats []BridgeTokenStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
 }
 
-func (m *DenomToERC20ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *BridgeStatsResponse) Reset()         { *m = BridgeStatsResponse{} }
+func (m *BridgeStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*BridgeStatsResponse) ProtoMessage()    {}
+func (*BridgeStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{105}
+}
+func (m *BridgeStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BridgeStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeStatsResponse.Merge(m, src)
+}
+func (m *BridgeStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeStatsResponse.DiscardUnknown(m)
 }
 
-func (m *DenomToERC20ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BridgeStatsResponse proto.InternalMessageInfo
+
+func (m *BridgeStatsResponse) GetStats() []BridgeTokenStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DenomToERC20ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BridgeTokenStats is the usage of the bridge by a token over the rolling
+// windows of the last 24 hours and 7 days of block time
+type BridgeTokenStats struct {
+	TokenContract string            `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	LastDay       BridgeStatsWindow `protobuf:"bytes,2,opt,name=last_day,json=lastDay,proto3" json:"last_day"`
+	LastWeek      BridgeStatsWindow `protobuf:"bytes,3,opt,name=last_week,json=lastWeek,proto3" json:"last_week"`
 }
 
-func (m *DenomToERC20ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Erc20Decimals != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Erc20Decimals))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Erc20Symbol) > 0 {
-		i -= len(m.Erc20Symbol)
-		copy(dAtA[i:], m.Erc20Symbol)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20Symbol)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Erc20Name) > 0 {
-		i -= len(m.Erc20Name)
-		copy(dAtA[i:], m.Erc20Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *BridgeTokenStats) Reset()         { *m = BridgeTokenStats{} }
+func (m *BridgeTokenStats) String() string { return proto.CompactTextString(m) }
+func (*BridgeTokenStats) ProtoMessage()    {}
+func (*BridgeTokenStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{106}
 }
-
-func (m *DenomToERC20Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BridgeTokenStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeTokenStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeTokenStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *BridgeTokenStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeTokenStats.Merge(m, src)
+}
+func (m *BridgeTokenStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeTokenStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeTokenStats.DiscardUnknown(m)
 }
 
-func (m *DenomToERC20Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_BridgeTokenStats proto.InternalMessageInfo
+
+func (m *BridgeTokenStats) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
 }
 
-func (m *DenomToERC20Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *BridgeTokenStats) GetLastDay() BridgeStatsWindow {
+	if m != nil {
+		return m.LastDay
 	}
-	return len(dAtA) - i, nil
+	return BridgeStatsWindow{}
 }
 
-func (m *DenomToERC20Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BridgeTokenStats) GetLastWeek() BridgeStatsWindow {
+	if m != nil {
+		return m.LastWeek
 	}
-	return dAtA[:n], nil
+	return BridgeStatsWindow{}
 }
 
-func (m *DenomToERC20Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BridgeStatsWindow is the usage of the bridge by a token over a window, in
+// the units of the ERC20. The average fee is the fee in the token per send to
+// ethereum, a send paying its fee in the bridge fee denom counts as zero.
+type BridgeStatsWindow struct {
+	Inflow       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=inflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflow"`
+	Outflow      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=outflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow"`
+	InflowCount  uint64                                 `protobuf:"varint,3,opt,name=inflow_count,json=inflowCount,proto3" json:"inflow_count,omitempty"`
+	OutflowCount uint64                                 `protobuf:"varint,4,opt,name=outflow_count,json=outflowCount,proto3" json:"outflow_count,omitempty"`
+	AverageFee   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=average_fee,json=averageFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"average_fee"`
 }
 
-func (m *DenomToERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CosmosOriginated {
-		i--
-		if m.CosmosOriginated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *BridgeStatsWindow) Reset()         { *m = BridgeStatsWindow{} }
+func (m *BridgeStatsWindow) String() string { return proto.CompactTextString(m) }
+func (*BridgeStatsWindow) ProtoMessage()    {}
+func (*BridgeStatsWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{107}
+}
+func (m *BridgeStatsWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeStatsWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeStatsWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.Erc20) > 0 {
-		i -= len(m.Erc20)
-		copy(dAtA[i:], m.Erc20)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *BridgeStatsWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeStatsWindow.Merge(m, src)
+}
+func (m *BridgeStatsWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeStatsWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeStatsWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeStatsWindow proto.InternalMessageInfo
+
+func (m *BridgeStatsWindow) GetInflowCount() uint64 {
+	if m != nil {
+		return m.InflowCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DelegateKeysByValidatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BridgeStatsWindow) GetOutflowCount() uint64 {
+	if m != nil {
+		return m.OutflowCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DelegateKeysByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// rpc SignerSetTxCalldata
+type SignerSetTxCalldataRequest struct {
+	SignerSetNonce uint64 `protobuf:"varint,1,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
 }
 
-func (m *DelegateKeysByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *SignerSetTxCalldataRequest) Reset()         { *m = SignerSetTxCalldataRequest{} }
+func (m *SignerSetTxCalldataRequest) String() string { return proto.CompactTextString(m) }
+func (*SignerSetTxCalldataRequest) ProtoMessage()    {}
+func (*SignerSetTxCalldataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{108}
+}
+func (m *SignerSetTxCalldataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSetTxCalldataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSetTxCalldataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SignerSetTxCalldataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSetTxCalldataRequest.Merge(m, src)
+}
+func (m *SignerSetTxCalldataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSetTxCalldataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSetTxCalldataRequest.DiscardUnknown(m)
 }
 
-func (m *DelegateKeysByValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SignerSetTxCalldataRequest proto.InternalMessageInfo
+
+func (m *SignerSetTxCalldataRequest) GetSignerSetNonce() uint64 {
+	if m != nil {
+		return m.SignerSetNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DelegateKeysByValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// rpc BatchTxCalldata
+type BatchTxCalldataRequest struct {
+	BatchNonce    uint64 `protobuf:"varint,1,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	TokenContract string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
 }
 
-func (m *DelegateKeysByValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OrchestratorAddress) > 0 {
-		i -= len(m.OrchestratorAddress)
-		copy(dAtA[i:], m.OrchestratorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.EthAddress) > 0 {
-		i -= len(m.EthAddress)
-		copy(dAtA[i:], m.EthAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *BatchTxCalldataRequest) Reset()         { *m = BatchTxCalldataRequest{} }
+func (m *BatchTxCalldataRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchTxCalldataRequest) ProtoMessage()    {}
+func (*BatchTxCalldataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{109}
 }
-
-func (m *DelegateKeysByEthereumSignerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchTxCalldataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchTxCalldataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchTxCalldataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *DelegateKeysByEthereumSignerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchTxCalldataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxCalldataRequest.Merge(m, src)
+}
+func (m *BatchTxCalldataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchTxCalldataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxCalldataRequest.DiscardUnknown(m)
 }
 
-func (m *DelegateKeysByEthereumSignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthereumSigner) > 0 {
-		i -= len(m.EthereumSigner)
-		copy(dAtA[i:], m.EthereumSigner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthereumSigner)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_BatchTxCalldataRequest proto.InternalMessageInfo
+
+func (m *BatchTxCalldataRequest) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DelegateKeysByEthereumSignerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchTxCalldataRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DelegateKeysByEthereumSignerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// rpc ContractCallTxCalldata
+type ContractCallTxCalldataRequest struct {
+	InvalidationScope []byte `protobuf:"bytes,1,opt,name=invalidation_scope,json=invalidationScope,proto3" json:"invalidation_scope,omitempty"`
+	InvalidationNonce uint64 `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
 }
 
-func (m *DelegateKeysByEthereumSignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OrchestratorAddress) > 0 {
-		i -= len(m.OrchestratorAddress)
-		copy(dAtA[i:], m.OrchestratorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *ContractCallTxCalldataRequest) Reset()         { *m = ContractCallTxCalldataRequest{} }
+func (m *ContractCallTxCalldataRequest) String() string { return proto.CompactTextString(m) }
+func (*ContractCallTxCalldataRequest) ProtoMessage()    {}
+func (*ContractCallTxCalldataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{110}
 }
-
-func (m *DelegateKeysByOrchestratorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractCallTxCalldataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCallTxCalldataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCallTxCalldataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *DelegateKeysByOrchestratorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContractCallTxCalldataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallTxCalldataRequest.Merge(m, src)
+}
+func (m *ContractCallTxCalldataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCallTxCalldataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallTxCalldataRequest.DiscardUnknown(m)
 }
 
-func (m *DelegateKeysByOrchestratorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OrchestratorAddress) > 0 {
-		i -= len(m.OrchestratorAddress)
-		copy(dAtA[i:], m.OrchestratorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_ContractCallTxCalldataRequest proto.InternalMessageInfo
+
+func (m *ContractCallTxCalldataRequest) GetInvalidationScope() []byte {
+	if m != nil {
+		return m.InvalidationScope
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DelegateKeysByOrchestratorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractCallTxCalldataRequest) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DelegateKeysByOrchestratorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// OutgoingTxCalldataResponse is the ABI encoded call of updateValset,
+// submitBatch or submitLogicCall on the gravity contract. The signatures are
+// those of the signer set at current_signer_set_nonce, split into v, r and s
+// in the order of the set, with zeros for the signers that did not sign.
+type OutgoingTxCalldataResponse struct {
+	Calldata              []byte `protobuf:"bytes,1,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	CurrentSignerSetNonce uint64 `protobuf:"varint,2,opt,name=current_signer_set_nonce,json=currentSignerSetNonce,proto3" json:"current_signer_set_nonce,omitempty"`
+	SignedPower           uint64 `protobuf:"varint,3,opt,name=signed_power,json=signedPower,proto3" json:"signed_power,omitempty"`
+	TotalPower            uint64 `protobuf:"varint,4,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
 }
 
-func (m *DelegateKeysByOrchestratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthereumSigner) > 0 {
-		i -= len(m.EthereumSigner)
-		copy(dAtA[i:], m.EthereumSigner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthereumSigner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *OutgoingTxCalldataResponse) Reset()         { *m = OutgoingTxCalldataResponse{} }
+func (m *OutgoingTxCalldataResponse) String() string { return proto.CompactTextString(m) }
+func (*OutgoingTxCalldataResponse) ProtoMessage()    {}
+func (*OutgoingTxCalldataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{111}
 }
-
-func (m *DelegateKeysRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OutgoingTxCalldataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutgoingTxCalldataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutgoingTxCalldataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *DelegateKeysRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OutgoingTxCalldataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutgoingTxCalldataResponse.Merge(m, src)
 }
-
-func (m *DelegateKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *OutgoingTxCalldataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutgoingTxCalldataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutgoingTxCalldataResponse.DiscardUnknown(m)
 }
 
-func (m *DelegateKeysResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OutgoingTxCalldataResponse proto.InternalMessageInfo
+
+func (m *OutgoingTxCalldataResponse) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OutgoingTxCalldataResponse) GetCurrentSignerSetNonce() uint64 {
+	if m != nil {
+		return m.CurrentSignerSetNonce
+	}
+	return 0
 }
 
-func (m *DelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegateKeys) > 0 {
-		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *OutgoingTxCalldataResponse) GetSignedPower() uint64 {
+	if m != nil {
+		return m.SignedPower
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *BatchedSendToEthereumsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OutgoingTxCalldataResponse) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BatchedSendToEthereumsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// rpc AgreedEthereumHeader
+type AgreedEthereumHeaderRequest struct {
 }
 
-func (m *BatchedSendToEthereumsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SenderAddress) > 0 {
-		i -= len(m.SenderAddress)
-		copy(dAtA[i:], m.SenderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *AgreedEthereumHeaderRequest) Reset()         { *m = AgreedEthereumHeaderRequest{} }
+func (m *AgreedEthereumHeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*AgreedEthereumHeaderRequest) ProtoMessage()    {}
+func (*AgreedEthereumHeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{112}
 }
-
-func (m *BatchedSendToEthereumsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AgreedEthereumHeaderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AgreedEthereumHeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AgreedEthereumHeaderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *AgreedEthereumHeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgreedEthereumHeaderRequest.Merge(m, src)
+}
+func (m *AgreedEthereumHeaderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AgreedEthereumHeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgreedEthereumHeaderRequest.DiscardUnknown(m)
 }
 
-func (m *BatchedSendToEthereumsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_AgreedEthereumHeaderRequest proto.InternalMessageInfo
+
+type AgreedEthereumHeaderResponse struct {
+	// unset until a header is agreed on
+	Header *EthereumHeader      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Votes  []EthereumHeaderVote `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
 }
 
-func (m *BatchedSendToEthereumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SendToEthereums) > 0 {
-		for iNdEx := len(m.SendToEthereums) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SendToEthereums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *AgreedEthereumHeaderResponse) Reset()         { *m = AgreedEthereumHeaderResponse{} }
+func (m *AgreedEthereumHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*AgreedEthereumHeaderResponse) ProtoMessage()    {}
+func (*AgreedEthereumHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{113}
+}
+func (m *AgreedEthereumHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AgreedEthereumHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AgreedEthereumHeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AgreedEthereumHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgreedEthereumHeaderResponse.Merge(m, src)
+}
+func (m *AgreedEthereumHeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AgreedEthereumHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgreedEthereumHeaderResponse.DiscardUnknown(m)
 }
 
-func (m *UnbatchedSendToEthereumsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AgreedEthereumHeaderResponse proto.InternalMessageInfo
+
+func (m *AgreedEthereumHeaderResponse) GetHeader() *EthereumHeader {
+	if m != nil {
+		return m.Header
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UnbatchedSendToEthereumsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AgreedEthereumHeaderResponse) GetVotes() []EthereumHeaderVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
 }
 
-func (m *UnbatchedSendToEthereumsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SenderAddress) > 0 {
-		i -= len(m.SenderAddress)
-		copy(dAtA[i:], m.SenderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// rpc BridgeValidatorLiveness
+type BridgeValidatorLivenessRequest struct {
 }
 
-func (m *UnbatchedSendToEthereumsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BridgeValidatorLivenessRequest) Reset()         { *m = BridgeValidatorLivenessRequest{} }
+func (m *BridgeValidatorLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*BridgeValidatorLivenessRequest) ProtoMessage()    {}
+func (*BridgeValidatorLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{114}
+}
+func (m *BridgeValidatorLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeValidatorLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeValidatorLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *BridgeValidatorLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeValidatorLivenessRequest.Merge(m, src)
+}
+func (m *BridgeValidatorLivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeValidatorLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeValidatorLivenessRequest.DiscardUnknown(m)
 }
 
-func (m *UnbatchedSendToEthereumsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_BridgeValidatorLivenessRequest proto.InternalMessageInfo
+
+type BridgeValidatorLivenessResponse struct {
+	Validators []BridgeValidatorLiveness `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
 }
 
-func (m *UnbatchedSendToEthereumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *BridgeValidatorLivenessResponse) Reset()         { *m = BridgeValidatorLivenessResponse{} }
+func (m *BridgeValidatorLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*BridgeValidatorLivenessResponse) ProtoMessage()    {}
+func (*BridgeValidatorLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{115}
+}
+func (m *BridgeValidatorLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeValidatorLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeValidatorLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.SendToEthereums) > 0 {
-		for iNdEx := len(m.SendToEthereums) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SendToEthereums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *BridgeValidatorLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeValidatorLivenessResponse.Merge(m, src)
+}
+func (m *BridgeValidatorLivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeValidatorLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeValidatorLivenessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeValidatorLivenessResponse proto.InternalMessageInfo
+
+func (m *BridgeValidatorLivenessResponse) GetValidators() []BridgeValidatorLiveness {
+	if m != nil {
+		return m.Validators
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *LastObservedEthereumHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// BridgeValidatorLiveness is the participation of a bonded validator in the
+// bridge. The heights are zero if the validator never signed or voted, in
+// which case the blocks since count from the start of the chain.
+type BridgeValidatorLiveness struct {
+	ValidatorAddress         string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	LastSignatureHeight      uint64 `protobuf:"varint,2,opt,name=last_signature_height,json=lastSignatureHeight,proto3" json:"last_signature_height,omitempty"`
+	LastEventVoteHeight      uint64 `protobuf:"varint,3,opt,name=last_event_vote_height,json=lastEventVoteHeight,proto3" json:"last_event_vote_height,omitempty"`
+	BlocksSinceLastSignature uint64 `protobuf:"varint,4,opt,name=blocks_since_last_signature,json=blocksSinceLastSignature,proto3" json:"blocks_since_last_signature,omitempty"`
+	BlocksSinceLastEventVote uint64 `protobuf:"varint,5,opt,name=blocks_since_last_event_vote,json=blocksSinceLastEventVote,proto3" json:"blocks_since_last_event_vote,omitempty"`
+	LastEventNonce           uint64 `protobuf:"varint,6,opt,name=last_event_nonce,json=lastEventNonce,proto3" json:"last_event_nonce,omitempty"`
+	// the outgoing txs the validator left unsigned past their signing window
+	// since it last signed one
+	MissedSignatures uint64 `protobuf:"varint,7,opt,name=missed_signatures,json=missedSignatures,proto3" json:"missed_signatures,omitempty"`
+}
+
+func (m *BridgeValidatorLiveness) Reset()         { *m = BridgeValidatorLiveness{} }
+func (m *BridgeValidatorLiveness) String() string { return proto.CompactTextString(m) }
+func (*BridgeValidatorLiveness) ProtoMessage()    {}
+func (*BridgeValidatorLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{116}
+}
+func (m *BridgeValidatorLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeValidatorLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeValidatorLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *BridgeValidatorLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeValidatorLiveness.Merge(m, src)
+}
+func (m *BridgeValidatorLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeValidatorLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeValidatorLiveness.DiscardUnknown(m)
 }
 
-func (m *LastObservedEthereumHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_BridgeValidatorLiveness proto.InternalMessageInfo
+
+func (m *BridgeValidatorLiveness) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
 }
 
-func (m *LastObservedEthereumHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *BridgeValidatorLiveness) GetLastSignatureHeight() uint64 {
+	if m != nil {
+		return m.LastSignatureHeight
+	}
+	return 0
 }
 
-func (m *LastObservedEthereumHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BridgeValidatorLiveness) GetLastEventVoteHeight() uint64 {
+	if m != nil {
+		return m.LastEventVoteHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LastObservedEthereumHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BridgeValidatorLiveness) GetBlocksSinceLastSignature() uint64 {
+	if m != nil {
+		return m.BlocksSinceLastSignature
+	}
+	return 0
 }
 
-func (m *LastObservedEthereumHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastObservedEthereumHeight != nil {
-		{
-			size, err := m.LastObservedEthereumHeight.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *BridgeValidatorLiveness) GetBlocksSinceLastEventVote() uint64 {
+	if m != nil {
+		return m.BlocksSinceLastEventVote
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BridgeValidatorLiveness) GetLastEventNonce() uint64 {
+	if m != nil {
+		return m.LastEventNonce
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *BridgeValidatorLiveness) GetMissedSignatures() uint64 {
+	if m != nil {
+		return m.MissedSignatures
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// rpc BatchTxInclusionProof
+type BatchTxInclusionProofRequest struct {
+	TokenContract    string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	BatchNonce       uint64 `protobuf:"varint,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	SendToEthereumId uint64 `protobuf:"varint,3,opt,name=send_to_ethereum_id,json=sendToEthereumId,proto3" json:"send_to_ethereum_id,omitempty"`
 }
 
-func (m *SignerSetTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignerSetNonce != 0 {
-		n += 1 + sovQuery(uint64(m.SignerSetNonce))
+func (m *BatchTxInclusionProofRequest) Reset()         { *m = BatchTxInclusionProofRequest{} }
+func (m *BatchTxInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchTxInclusionProofRequest) ProtoMessage()    {}
+func (*BatchTxInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{117}
+}
+func (m *BatchTxInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchTxInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchTxInclusionProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *BatchTxInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxInclusionProofRequest.Merge(m, src)
+}
+func (m *BatchTxInclusionProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchTxInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxInclusionProofRequest.DiscardUnknown(m)
 }
 
-func (m *LatestSignerSetTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_BatchTxInclusionProofRequest proto.InternalMessageInfo
+
+func (m *BatchTxInclusionProofRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *SignerSetTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignerSet != nil {
-		l = m.SignerSet.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *BatchTxInclusionProofRequest) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
 	}
-	return n
+	return 0
 }
 
-func (m *BatchTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BatchNonce != 0 {
-		n += 1 + sovQuery(uint64(m.BatchNonce))
+func (m *BatchTxInclusionProofRequest) GetSendToEthereumId() uint64 {
+	if m != nil {
+		return m.SendToEthereumId
 	}
-	return n
+	return 0
 }
 
-func (m *BatchTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Batch != nil {
-		l = m.Batch.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// BatchTxInclusionProofResponse proves that a send to ethereum is one of the
+// transactions of a batch. The leaves of the merkle tree are the protobuf
+// encoded transactions of the batch, in order, hashed as in tendermint's
+// merkle package. The batch itself can be proven against the app hash with an
+// ABCI store query of store_key, and against the gravity contract with its
+// checkpoint.
+type BatchTxInclusionProofResponse struct {
+	SendToEthereum *SendToEthereum `protobuf:"bytes,1,opt,name=send_to_ethereum,json=sendToEthereum,proto3" json:"send_to_ethereum,omitempty"`
+	Root           []byte          `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Proof          *crypto.Proof   `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	Checkpoint     []byte          `protobuf:"bytes,4,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	StoreKey       []byte          `protobuf:"bytes,5,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
 }
 
-func (m *ContractCallTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.InvalidationScope)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovQuery(uint64(m.InvalidationNonce))
+func (m *BatchTxInclusionProofResponse) Reset()         { *m = BatchTxInclusionProofResponse{} }
+func (m *BatchTxInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchTxInclusionProofResponse) ProtoMessage()    {}
+func (*BatchTxInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{118}
+}
+func (m *BatchTxInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchTxInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchTxInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *BatchTxInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTxInclusionProofResponse.Merge(m, src)
+}
+func (m *BatchTxInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchTxInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTxInclusionProofResponse.DiscardUnknown(m)
 }
 
-func (m *ContractCallTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LogicCall != nil {
-		l = m.LogicCall.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_BatchTxInclusionProofResponse proto.InternalMessageInfo
+
+func (m *BatchTxInclusionProofResponse) GetSendToEthereum() *SendToEthereum {
+	if m != nil {
+		return m.SendToEthereum
 	}
-	return n
+	return nil
 }
 
-func (m *SignerSetTxConfirmationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignerSetNonce != 0 {
-		n += 1 + sovQuery(uint64(m.SignerSetNonce))
+func (m *BatchTxInclusionProofResponse) GetRoot() []byte {
+	if m != nil {
+		return m.Root
 	}
-	return n
+	return nil
 }
 
-func (m *SignerSetTxConfirmationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *BatchTxInclusionProofResponse) GetProof() *crypto.Proof {
+	if m != nil {
+		return m.Proof
 	}
-	return n
+	return nil
 }
 
-func (m *SignerSetTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *BatchTxInclusionProofResponse) GetCheckpoint() []byte {
+	if m != nil {
+		return m.Checkpoint
 	}
-	return n
+	return nil
 }
 
-func (m *SignerSetTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SignerSets) > 0 {
-		for _, e := range m.SignerSets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *BatchTxInclusionProofResponse) GetStoreKey() []byte {
+	if m != nil {
+		return m.StoreKey
 	}
-	return n
+	return nil
 }
 
-func (m *BatchTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// rpc BridgeValidatorInfo
+type BridgeValidatorInfoRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *BatchTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Batches) > 0 {
-		for _, e := range m.Batches {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *BridgeValidatorInfoRequest) Reset()         { *m = BridgeValidatorInfoRequest{} }
+func (m *BridgeValidatorInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*BridgeValidatorInfoRequest) ProtoMessage()    {}
+func (*BridgeValidatorInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{119}
+}
+func (m *BridgeValidatorInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeValidatorInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeValidatorInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *BridgeValidatorInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeValidatorInfoRequest.Merge(m, src)
+}
+func (m *BridgeValidatorInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeValidatorInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeValidatorInfoRequest.DiscardUnknown(m)
 }
 
-func (m *ContractCallTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_BridgeValidatorInfoRequest proto.InternalMessageInfo
+
+func (m *BridgeValidatorInfoRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return n
+	return ""
 }
 
-func (m *ContractCallTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Calls) > 0 {
-		for _, e := range m.Calls {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type BridgeValidatorInfoResponse struct {
+	Validator BridgeValidatorInfo `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator"`
 }
 
-func (m *UnsignedSignerSetTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *BridgeValidatorInfoResponse) Reset()         { *m = BridgeValidatorInfoResponse{} }
+func (m *BridgeValidatorInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*BridgeValidatorInfoResponse) ProtoMessage()    {}
+func (*BridgeValidatorInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{120}
 }
-
-func (m *UnsignedSignerSetTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SignerSets) > 0 {
-		for _, e := range m.SignerSets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *BridgeValidatorInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeValidatorInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeValidatorInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *UnsignedBatchTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *BridgeValidatorInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeValidatorInfoResponse.Merge(m, src)
 }
-
-func (m *UnsignedBatchTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Batches) > 0 {
-		for _, e := range m.Batches {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *BridgeValidatorInfoResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *UnsignedContractCallTxsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *BridgeValidatorInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeValidatorInfoResponse.DiscardUnknown(m)
 }
 
-func (m *UnsignedContractCallTxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Calls) > 0 {
-		for _, e := range m.Calls {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+var xxx_messageInfo_BridgeValidatorInfoResponse proto.InternalMessageInfo
+
+func (m *BridgeValidatorInfoResponse) GetValidator() BridgeValidatorInfo {
+	if m != nil {
+		return m.Validator
 	}
-	return n
+	return BridgeValidatorInfo{}
 }
 
-func (m *BatchTxFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// BridgeValidatorInfo is the bridge identity and participation of a
+// validator. The addresses are empty if the validator has not set its
+// delegate keys.
+type BridgeValidatorInfo struct {
+	ValidatorAddress    string                  `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	OrchestratorAddress string                  `protobuf:"bytes,2,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
+	EthereumAddress     string                  `protobuf:"bytes,3,opt,name=ethereum_address,json=ethereumAddress,proto3" json:"ethereum_address,omitempty"`
+	SignatureScheme     SignatureScheme         `protobuf:"varint,4,opt,name=signature_scheme,json=signatureScheme,proto3,enum=gravity.v1.SignatureScheme" json:"signature_scheme,omitempty"`
+	Bonded              bool                    `protobuf:"varint,5,opt,name=bonded,proto3" json:"bonded,omitempty"`
+	Liveness            BridgeValidatorLiveness `protobuf:"bytes,6,opt,name=liveness,proto3" json:"liveness"`
+	OptedOut            bool                    `protobuf:"varint,7,opt,name=opted_out,json=optedOut,proto3" json:"opted_out,omitempty"`
+	// set while the delegate keys of the validator are frozen
+	Freeze *OrchestratorFreeze `protobuf:"bytes,8,opt,name=freeze,proto3" json:"freeze,omitempty"`
 }
 
-func (m *BatchTxFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Fees) > 0 {
-		for _, e := range m.Fees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *BridgeValidatorInfo) Reset()         { *m = BridgeValidatorInfo{} }
+func (m *BridgeValidatorInfo) String() string { return proto.CompactTextString(m) }
+func (*BridgeValidatorInfo) ProtoMessage()    {}
+func (*BridgeValidatorInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{121}
+}
+func (m *BridgeValidatorInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeValidatorInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeValidatorInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *ContractCallTxConfirmationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.InvalidationScope)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovQuery(uint64(m.InvalidationNonce))
-	}
-	return n
+func (m *BridgeValidatorInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeValidatorInfo.Merge(m, src)
+}
+func (m *BridgeValidatorInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeValidatorInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeValidatorInfo.DiscardUnknown(m)
 }
 
-func (m *ContractCallTxConfirmationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+var xxx_messageInfo_BridgeValidatorInfo proto.InternalMessageInfo
+
+func (m *BridgeValidatorInfo) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return n
+	return ""
 }
 
-func (m *BatchTxConfirmationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BatchNonce != 0 {
-		n += 1 + sovQuery(uint64(m.BatchNonce))
-	}
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *BridgeValidatorInfo) GetOrchestratorAddress() string {
+	if m != nil {
+		return m.OrchestratorAddress
 	}
-	return n
+	return ""
 }
 
-func (m *BatchTxConfirmationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *BridgeValidatorInfo) GetEthereumAddress() string {
+	if m != nil {
+		return m.EthereumAddress
 	}
-	return n
+	return ""
 }
 
-func (m *LastSubmittedEthereumEventRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *BridgeValidatorInfo) GetSignatureScheme() SignatureScheme {
+	if m != nil {
+		return m.SignatureScheme
 	}
-	return n
+	return SIGNATURE_SCHEME_ECDSA
 }
 
-func (m *LastSubmittedEthereumEventResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovQuery(uint64(m.EventNonce))
+func (m *BridgeValidatorInfo) GetBonded() bool {
+	if m != nil {
+		return m.Bonded
 	}
-	return n
+	return false
 }
 
-func (m *ERC20ToDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Erc20)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *BridgeValidatorInfo) GetLiveness() BridgeValidatorLiveness {
+	if m != nil {
+		return m.Liveness
 	}
-	return n
+	return BridgeValidatorLiveness{}
 }
 
-func (m *ERC20ToDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.CosmosOriginated {
-		n += 2
+func (m *BridgeValidatorInfo) GetOptedOut() bool {
+	if m != nil {
+		return m.OptedOut
 	}
-	return n
+	return false
 }
 
-func (m *DenomToERC20ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *BridgeValidatorInfo) GetFreeze() *OrchestratorFreeze {
+	if m != nil {
+		return m.Freeze
 	}
-	return n
+	return nil
 }
 
-func (m *DenomToERC20ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Erc20Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Erc20Symbol)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Erc20Decimals != 0 {
-		n += 1 + sovQuery(uint64(m.Erc20Decimals))
-	}
-	return n
+func init() {
+	proto.RegisterEnum("gravity.v1.BatchTxSignatureStatus", BatchTxSignatureStatus_name, BatchTxSignatureStatus_value)
+	proto.RegisterType((*ParamsRequest)(nil), "gravity.v1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "gravity.v1.ParamsResponse")
+	proto.RegisterType((*SignerSetTxRequest)(nil), "gravity.v1.SignerSetTxRequest")
+	proto.RegisterType((*LatestSignerSetTxRequest)(nil), "gravity.v1.LatestSignerSetTxRequest")
+	proto.RegisterType((*SignerSetTxResponse)(nil), "gravity.v1.SignerSetTxResponse")
+	proto.RegisterType((*SignerSetTxDeltaRequest)(nil), "gravity.v1.SignerSetTxDeltaRequest")
+	proto.RegisterType((*SignerSetTxDeltaResponse)(nil), "gravity.v1.SignerSetTxDeltaResponse")
+	proto.RegisterType((*BatchTxRequest)(nil), "gravity.v1.BatchTxRequest")
+	proto.RegisterType((*BatchTxResponse)(nil), "gravity.v1.BatchTxResponse")
+	proto.RegisterType((*ContractCallTxRequest)(nil), "gravity.v1.ContractCallTxRequest")
+	proto.RegisterType((*ContractCallTxResponse)(nil), "gravity.v1.ContractCallTxResponse")
+	proto.RegisterType((*SignerSetTxConfirmationsRequest)(nil), "gravity.v1.SignerSetTxConfirmationsRequest")
+	proto.RegisterType((*SignerSetTxConfirmationsResponse)(nil), "gravity.v1.SignerSetTxConfirmationsResponse")
+	proto.RegisterType((*SignerSetTxsRequest)(nil), "gravity.v1.SignerSetTxsRequest")
+	proto.RegisterType((*SignerSetTxsResponse)(nil), "gravity.v1.SignerSetTxsResponse")
+	proto.RegisterType((*BatchTxsRequest)(nil), "gravity.v1.BatchTxsRequest")
+	proto.RegisterType((*BatchTxsResponse)(nil), "gravity.v1.BatchTxsResponse")
+	proto.RegisterType((*ContractCallTxsRequest)(nil), "gravity.v1.ContractCallTxsRequest")
+	proto.RegisterType((*ContractCallTxsResponse)(nil), "gravity.v1.ContractCallTxsResponse")
+	proto.RegisterType((*UnsignedSignerSetTxsRequest)(nil), "gravity.v1.UnsignedSignerSetTxsRequest")
+	proto.RegisterType((*UnsignedSignerSetTxsResponse)(nil), "gravity.v1.UnsignedSignerSetTxsResponse")
+	proto.RegisterType((*UnsignedBatchTxsRequest)(nil), "gravity.v1.UnsignedBatchTxsRequest")
+	proto.RegisterType((*UnsignedBatchTxsResponse)(nil), "gravity.v1.UnsignedBatchTxsResponse")
+	proto.RegisterType((*UnsignedContractCallTxsRequest)(nil), "gravity.v1.UnsignedContractCallTxsRequest")
+	proto.RegisterType((*UnsignedContractCallTxsResponse)(nil), "gravity.v1.UnsignedContractCallTxsResponse")
+	proto.RegisterType((*BatchTxFeesRequest)(nil), "gravity.v1.BatchTxFeesRequest")
+	proto.RegisterType((*BatchTxFeesResponse)(nil), "gravity.v1.BatchTxFeesResponse")
+	proto.RegisterType((*BatchFeesRequest)(nil), "gravity.v1.BatchFeesRequest")
+	proto.RegisterType((*BatchFeesResponse)(nil), "gravity.v1.BatchFeesResponse")
+	proto.RegisterType((*TokenBatchFees)(nil), "gravity.v1.TokenBatchFees")
+	proto.RegisterType((*ContractCallTxConfirmationsRequest)(nil), "gravity.v1.ContractCallTxConfirmationsRequest")
+	proto.RegisterType((*ContractCallTxConfirmationsResponse)(nil), "gravity.v1.ContractCallTxConfirmationsResponse")
+	proto.RegisterType((*BatchTxConfirmationsRequest)(nil), "gravity.v1.BatchTxConfirmationsRequest")
+	proto.RegisterType((*BatchTxConfirmationsResponse)(nil), "gravity.v1.BatchTxConfirmationsResponse")
+	proto.RegisterType((*BatchTxConfirmationProgressRequest)(nil), "gravity.v1.BatchTxConfirmationProgressRequest")
+	proto.RegisterType((*BatchTxConfirmationProgressResponse)(nil), "gravity.v1.BatchTxConfirmationProgressResponse")
+	proto.RegisterType((*LastSubmittedEthereumEventRequest)(nil), "gravity.v1.LastSubmittedEthereumEventRequest")
+	proto.RegisterType((*LastSubmittedEthereumEventResponse)(nil), "gravity.v1.LastSubmittedEthereumEventResponse")
+	proto.RegisterType((*ERC20ToDenomRequest)(nil), "gravity.v1.ERC20ToDenomRequest")
+	proto.RegisterType((*ERC20ToDenomResponse)(nil), "gravity.v1.ERC20ToDenomResponse")
+	proto.RegisterType((*DenomToERC20ParamsRequest)(nil), "gravity.v1.DenomToERC20ParamsRequest")
+	proto.RegisterType((*DenomToERC20ParamsResponse)(nil), "gravity.v1.DenomToERC20ParamsResponse")
+	proto.RegisterType((*DenomToERC20Request)(nil), "gravity.v1.DenomToERC20Request")
+	proto.RegisterType((*DenomToERC20Response)(nil), "gravity.v1.DenomToERC20Response")
+	proto.RegisterType((*DelegateKeysByValidatorRequest)(nil), "gravity.v1.DelegateKeysByValidatorRequest")
+	proto.RegisterType((*DelegateKeysByValidatorResponse)(nil), "gravity.v1.DelegateKeysByValidatorResponse")
+	proto.RegisterType((*DelegateKeysByEthereumSignerRequest)(nil), "gravity.v1.DelegateKeysByEthereumSignerRequest")
+	proto.RegisterType((*DelegateKeysByEthereumSignerResponse)(nil), "gravity.v1.DelegateKeysByEthereumSignerResponse")
+	proto.RegisterType((*DelegateKeysByOrchestratorRequest)(nil), "gravity.v1.DelegateKeysByOrchestratorRequest")
+	proto.RegisterType((*DelegateKeysByOrchestratorResponse)(nil), "gravity.v1.DelegateKeysByOrchestratorResponse")
+	proto.RegisterType((*DelegateKeysRequest)(nil), "gravity.v1.DelegateKeysRequest")
+	proto.RegisterType((*DelegateKeysResponse)(nil), "gravity.v1.DelegateKeysResponse")
+	proto.RegisterType((*BatchedSendToEthereumsRequest)(nil), "gravity.v1.BatchedSendToEthereumsRequest")
+	proto.RegisterType((*BatchedSendToEthereumsResponse)(nil), "gravity.v1.BatchedSendToEthereumsResponse")
+	proto.RegisterType((*UnbatchedSendToEthereumsRequest)(nil), "gravity.v1.UnbatchedSendToEthereumsRequest")
+	proto.RegisterType((*UnbatchedSendToEthereumsResponse)(nil), "gravity.v1.UnbatchedSendToEthereumsResponse")
+	proto.RegisterType((*LastObservedEthereumHeightRequest)(nil), "gravity.v1.LastObservedEthereumHeightRequest")
+	proto.RegisterType((*LastObservedEthereumHeightResponse)(nil), "gravity.v1.LastObservedEthereumHeightResponse")
+	proto.RegisterType((*ProjectedEthereumHeightRequest)(nil), "gravity.v1.ProjectedEthereumHeightRequest")
+	proto.RegisterType((*ProjectedEthereumHeightResponse)(nil), "gravity.v1.ProjectedEthereumHeightResponse")
+	proto.RegisterType((*QueuedSendToCosmosEventsRequest)(nil), "gravity.v1.QueuedSendToCosmosEventsRequest")
+	proto.RegisterType((*QueuedSendToCosmosEventsResponse)(nil), "gravity.v1.QueuedSendToCosmosEventsResponse")
+	proto.RegisterType((*HeldSendToCosmosEventsRequest)(nil), "gravity.v1.HeldSendToCosmosEventsRequest")
+	proto.RegisterType((*HeldSendToCosmosEventsResponse)(nil), "gravity.v1.HeldSendToCosmosEventsResponse")
+	proto.RegisterType((*ExecutedBatchTxsRequest)(nil), "gravity.v1.ExecutedBatchTxsRequest")
+	proto.RegisterType((*ExecutedBatchTxsResponse)(nil), "gravity.v1.ExecutedBatchTxsResponse")
+	proto.RegisterType((*ExecutedContractCallTxsRequest)(nil), "gravity.v1.ExecutedContractCallTxsRequest")
+	proto.RegisterType((*ExecutedContractCallTxsResponse)(nil), "gravity.v1.ExecutedContractCallTxsResponse")
+	proto.RegisterType((*RefundedContractCallTxsRequest)(nil), "gravity.v1.RefundedContractCallTxsRequest")
+	proto.RegisterType((*RefundedContractCallTxsResponse)(nil), "gravity.v1.RefundedContractCallTxsResponse")
+	proto.RegisterType((*BridgeFeeAllowancesRequest)(nil), "gravity.v1.BridgeFeeAllowancesRequest")
+	proto.RegisterType((*BridgeFeeAllowancesResponse)(nil), "gravity.v1.BridgeFeeAllowancesResponse")
+	proto.RegisterType((*EthereumBlocklistRequest)(nil), "gravity.v1.EthereumBlocklistRequest")
+	proto.RegisterType((*EthereumBlocklistResponse)(nil), "gravity.v1.EthereumBlocklistResponse")
+	proto.RegisterType((*ModuleAccountsRequest)(nil), "gravity.v1.ModuleAccountsRequest")
+	proto.RegisterType((*ModuleAccountsResponse)(nil), "gravity.v1.ModuleAccountsResponse")
+	proto.RegisterType((*BridgeModuleAccount)(nil), "gravity.v1.BridgeModuleAccount")
+	proto.RegisterType((*EndBlockerActionsRequest)(nil), "gravity.v1.EndBlockerActionsRequest")
+	proto.RegisterType((*EndBlockerActionsResponse)(nil), "gravity.v1.EndBlockerActionsResponse")
+	proto.RegisterType((*SignerSetTxsByHeightRangeRequest)(nil), "gravity.v1.SignerSetTxsByHeightRangeRequest")
+	proto.RegisterType((*SignerSetTxsByHeightRangeResponse)(nil), "gravity.v1.SignerSetTxsByHeightRangeResponse")
+	proto.RegisterType((*BridgeConfigRequest)(nil), "gravity.v1.BridgeConfigRequest")
+	proto.RegisterType((*BridgeConfigResponse)(nil), "gravity.v1.BridgeConfigResponse")
+	proto.RegisterType((*ReplayDiffRequest)(nil), "gravity.v1.ReplayDiffRequest")
+	proto.RegisterType((*ReplayDiffResponse)(nil), "gravity.v1.ReplayDiffResponse")
+	proto.RegisterType((*ReplayDiscrepancy)(nil), "gravity.v1.ReplayDiscrepancy")
+	proto.RegisterType((*PendingERC20DeploymentsRequest)(nil), "gravity.v1.PendingERC20DeploymentsRequest")
+	proto.RegisterType((*PendingERC20DeploymentsResponse)(nil), "gravity.v1.PendingERC20DeploymentsResponse")
+	proto.RegisterType((*ERC20ConversionRequest)(nil), "gravity.v1.ERC20ConversionRequest")
+	proto.RegisterType((*ERC20ConversionResponse)(nil), "gravity.v1.ERC20ConversionResponse")
+	proto.RegisterType((*TokenPausesRequest)(nil), "gravity.v1.TokenPausesRequest")
+	proto.RegisterType((*TokenPausesResponse)(nil), "gravity.v1.TokenPausesResponse")
+	proto.RegisterType((*OrchestratorQueryIdentityRequest)(nil), "gravity.v1.OrchestratorQueryIdentityRequest")
+	proto.RegisterType((*OrchestratorQueryIdentityResponse)(nil), "gravity.v1.OrchestratorQueryIdentityResponse")
+	proto.RegisterType((*OutgoingTxCheckpointResponse)(nil), "gravity.v1.OutgoingTxCheckpointResponse")
+	proto.RegisterType((*DelayedSendToEthereumsRequest)(nil), "gravity.v1.DelayedSendToEthereumsRequest")
+	proto.RegisterType((*DelayedSendToEthereumsResponse)(nil), "gravity.v1.DelayedSendToEthereumsResponse")
+	proto.RegisterType((*PendingEventVoteRecordsRequest)(nil), "gravity.v1.PendingEventVoteRecordsRequest")
+	proto.RegisterType((*PendingEventVoteRecordsResponse)(nil), "gravity.v1.PendingEventVoteRecordsResponse")
+	proto.RegisterType((*EventByEthereumTxHashRequest)(nil), "gravity.v1.EventByEthereumTxHashRequest")
+	proto.RegisterType((*EventByEthereumTxHashResponse)(nil), "gravity.v1.EventByEthereumTxHashResponse")
+	proto.RegisterType((*ConflictingEthereumEventsRequest)(nil), "gravity.v1.ConflictingEthereumEventsRequest")
+	proto.RegisterType((*ConflictingEthereumEventsResponse)(nil), "gravity.v1.ConflictingEthereumEventsResponse")
+	proto.RegisterType((*ConflictingEthereumEvents)(nil), "gravity.v1.ConflictingEthereumEvents")
+	proto.RegisterType((*BridgeStatsRequest)(nil), "gravity.v1.BridgeStatsRequest")
+	proto.RegisterType((*BridgeStatsResponse)(nil), "gravity.v1.BridgeStatsResponse")
+	proto.RegisterType((*BridgeTokenStats)(nil), "gravity.v1.BridgeTokenStats")
+	proto.RegisterType((*BridgeStatsWindow)(nil), "gravity.v1.BridgeStatsWindow")
+	proto.RegisterType((*SignerSetTxCalldataRequest)(nil), "gravity.v1.SignerSetTxCalldataRequest")
+	proto.RegisterType((*BatchTxCalldataRequest)(nil), "gravity.v1.BatchTxCalldataRequest")
+	proto.RegisterType((*ContractCallTxCalldataRequest)(nil), "gravity.v1.ContractCallTxCalldataRequest")
+	proto.RegisterType((*OutgoingTxCalldataResponse)(nil), "gravity.v1.OutgoingTxCalldataResponse")
+	proto.RegisterType((*AgreedEthereumHeaderRequest)(nil), "gravity.v1.AgreedEthereumHeaderRequest")
+	proto.RegisterType((*AgreedEthereumHeaderResponse)(nil), "gravity.v1.AgreedEthereumHeaderResponse")
+	proto.RegisterType((*BridgeValidatorLivenessRequest)(nil), "gravity.v1.BridgeValidatorLivenessRequest")
+	proto.RegisterType((*BridgeValidatorLivenessResponse)(nil), "gravity.v1.BridgeValidatorLivenessResponse")
+	proto.RegisterType((*BridgeValidatorLiveness)(nil), "gravity.v1.BridgeValidatorLiveness")
+	proto.RegisterType((*BatchTxInclusionProofRequest)(nil), "gravity.v1.BatchTxInclusionProofRequest")
+	proto.RegisterType((*BatchTxInclusionProofResponse)(nil), "gravity.v1.BatchTxInclusionProofResponse")
+	proto.RegisterType((*BridgeValidatorInfoRequest)(nil), "gravity.v1.BridgeValidatorInfoRequest")
+	proto.RegisterType((*BridgeValidatorInfoResponse)(nil), "gravity.v1.BridgeValidatorInfoResponse")
+	proto.RegisterType((*BridgeValidatorInfo)(nil), "gravity.v1.BridgeValidatorInfo")
 }
 
-func (m *DenomToERC20Request) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func init() { proto.RegisterFile("gravity/v1/query.proto", fileDescriptor_29a9d4192703013c) }
+
+var fileDescriptor_29a9d4192703013c = []byte{
+	// 5497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5c, 0xeb, 0x6f, 0x1c, 0xc9,
+	0x71, 0xd7, 0x50, 0x22, 0x45, 0x16, 0x25, 0x8a, 0x6a, 0x52, 0xd2, 0x72, 0x48, 0x2e, 0xa9, 0xa1,
+	0xde, 0x94, 0xb8, 0x92, 0xee, 0xe5, 0x7b, 0xe8, 0xee, 0x44, 0x8a, 0x94, 0x88, 0x3b, 0x3d, 0x6e,
+	0x49, 0xdd, 0xc3, 0x81, 0x31, 0x1e, 0xee, 0x36, 0x97, 0x73, 0xda, 0x9d, 0x59, 0xcf, 0xcc, 0xf2,
+	0xb8, 0x56, 0x18, 0x24, 0x17, 0x5f, 0x72, 0x09, 0x0c, 0xdb, 0x41, 0x62, 0xc7, 0x01, 0x9c, 0xc4,
+	0xb9, 0x3c, 0xec, 0x18, 0xc9, 0x7d, 0xc8, 0xc1, 0xf9, 0x90, 0x00, 0x09, 0x90, 0x00, 0x89, 0x11,
+	0x20, 0x80, 0x81, 0x7c, 0x49, 0xf2, 0xc1, 0x09, 0xee, 0x82, 0xfc, 0x1d, 0x46, 0x3f, 0x66, 0xa6,
+	0x7b, 0xa6, 0x67, 0x76, 0xc9, 0xdb, 0x33, 0xee, 0x13, 0x39, 0xdd, 0x55, 0xdd, 0xbf, 0xae, 0xee,
+	0xae, 0xae, 0xae, 0xae, 0x5a, 0x38, 0x59, 0xf3, 0xac, 0x6d, 0x3b, 0x68, 0x97, 0xb6, 0xaf, 0x95,
+	0xbe, 0xd2, 0xc2, 0x5e, 0x7b, 0xa1, 0xe9, 0xb9, 0x81, 0x8b, 0x80, 0x97, 0x2f, 0x6c, 0x5f, 0xd3,
+	0x2f, 0x55, 0x5c, 0xbf, 0xe1, 0xfa, 0xa5, 0x0d, 0xcb, 0xc7, 0x8c, 0xa8, 0xb4, 0x7d, 0x6d, 0x03,
+	0x07, 0xd6, 0xb5, 0x52, 0xd3, 0xaa, 0xd9, 0x8e, 0x15, 0xd8, 0xae, 0xc3, 0xf8, 0xf4, 0xa2, 0x48,
+	0x1b, 0x52, 0x55, 0x5c, 0x3b, 0xac, 0x1f, 0xaf, 0xb9, 0x35, 0x97, 0xfe, 0x5b, 0x22, 0xff, 0xf1,
+	0xd2, 0xa9, 0x9a, 0xeb, 0xd6, 0xea, 0xb8, 0x64, 0x35, 0xed, 0x92, 0xe5, 0x38, 0x6e, 0x40, 0x9b,
+	0xf4, 0x79, 0xed, 0x74, 0x80, 0x9d, 0x2a, 0xf6, 0x1a, 0xb6, 0x13, 0x94, 0x2a, 0x5e, 0xbb, 0x19,
+	0xb8, 0xa5, 0xa6, 0xe7, 0xba, 0x9b, 0xbc, 0xba, 0x20, 0x0c, 0xa1, 0x86, 0x1d, 0xec, 0xdb, 0xbe,
+	0xaa, 0x86, 0x8f, 0x87, 0xd5, 0x9c, 0x10, 0x6a, 0x1a, 0x7e, 0x8d, 0x33, 0x18, 0xc7, 0xe0, 0xe8,
+	0x03, 0xcb, 0xb3, 0x1a, 0x7e, 0x19, 0x7f, 0xa5, 0x85, 0xfd, 0xc0, 0x58, 0x84, 0x91, 0xb0, 0xc0,
+	0x6f, 0xba, 0x8e, 0x8f, 0xd1, 0x55, 0x18, 0x68, 0xd2, 0x92, 0x82, 0x36, 0xab, 0x5d, 0x18, 0xbe,
+	0x8e, 0x16, 0x62, 0x49, 0x2d, 0x30, 0xda, 0xc5, 0x43, 0x3f, 0xf9, 0xd9, 0xcc, 0x81, 0x32, 0xa7,
+	0x33, 0x5e, 0x04, 0xb4, 0x66, 0xd7, 0x1c, 0xec, 0xad, 0xe1, 0x60, 0x7d, 0x87, 0xb7, 0x8c, 0x2e,
+	0xc0, 0xa8, 0x4f, 0x4b, 0x4d, 0x1f, 0x07, 0xa6, 0xe3, 0x3a, 0x15, 0x4c, 0x5b, 0x3c, 0x54, 0x1e,
+	0xf1, 0x43, 0xea, 0x7b, 0xa4, 0xd4, 0xd0, 0xa1, 0xf0, 0xaa, 0x15, 0x60, 0x3f, 0x48, 0xb7, 0x62,
+	0xdc, 0x85, 0x31, 0xa9, 0x94, 0x83, 0x7c, 0x1a, 0x20, 0x6e, 0x9c, 0x03, 0x3d, 0x25, 0x02, 0x15,
+	0x99, 0x86, 0xa2, 0xfe, 0x8c, 0x25, 0x38, 0x25, 0xd4, 0xdc, 0xc2, 0xf5, 0xc0, 0xda, 0x3b, 0xde,
+	0x7b, 0x50, 0x48, 0x37, 0xc2, 0x81, 0x5d, 0x87, 0xfe, 0x2a, 0x29, 0xe0, 0x98, 0xa6, 0x32, 0x30,
+	0x31, 0x26, 0x46, 0x6a, 0xbc, 0x09, 0x23, 0x8b, 0x56, 0x50, 0xd9, 0x8a, 0x65, 0x77, 0x16, 0x46,
+	0x02, 0xf7, 0x11, 0x76, 0xcc, 0x8a, 0xeb, 0x04, 0x9e, 0x55, 0x61, 0x43, 0x1c, 0x2a, 0x1f, 0xa5,
+	0xa5, 0x4b, 0xbc, 0x10, 0xcd, 0xc0, 0xf0, 0x06, 0x61, 0xe4, 0x68, 0xfb, 0x28, 0x5a, 0xa0, 0x45,
+	0x0c, 0xe9, 0x0b, 0x70, 0x2c, 0x6a, 0x99, 0x03, 0xbc, 0x08, 0xfd, 0x94, 0x80, 0x03, 0x1c, 0x13,
+	0x01, 0x86, 0xb4, 0x8c, 0xc2, 0x68, 0xc1, 0x89, 0xb0, 0xab, 0x25, 0xab, 0x5e, 0x8f, 0xe1, 0x5d,
+	0x01, 0x64, 0x3b, 0xdb, 0x56, 0xdd, 0xae, 0xd2, 0x65, 0x6c, 0xfa, 0x15, 0xb7, 0xc9, 0x84, 0x75,
+	0xa4, 0x7c, 0x5c, 0xac, 0x59, 0x23, 0x15, 0x29, 0x72, 0x11, 0xad, 0x44, 0xce, 0x40, 0xaf, 0xc1,
+	0xc9, 0x64, 0xb7, 0x1c, 0xfb, 0xb3, 0x00, 0x75, 0xb7, 0x66, 0x57, 0xcc, 0x8a, 0x55, 0xaf, 0xf3,
+	0x01, 0xe8, 0xe2, 0x00, 0x12, 0x7c, 0x43, 0x94, 0x9a, 0x7c, 0x18, 0xaf, 0xc0, 0x8c, 0x20, 0xfe,
+	0x25, 0xd7, 0xd9, 0xb4, 0xbd, 0x06, 0xdb, 0x84, 0x7b, 0x5f, 0x00, 0x35, 0x98, 0xcd, 0x6e, 0x8c,
+	0x63, 0x5d, 0x62, 0x2b, 0xd4, 0x0a, 0x5a, 0x1e, 0x26, 0x5b, 0xe9, 0xe0, 0x85, 0xe1, 0xeb, 0x73,
+	0x19, 0xab, 0x41, 0x6c, 0xa1, 0x2c, 0xb0, 0x19, 0x5f, 0x92, 0x56, 0x7f, 0x84, 0x74, 0x05, 0x20,
+	0xd6, 0x4b, 0x5c, 0x0e, 0xe7, 0x16, 0x98, 0x62, 0x5a, 0x20, 0x8a, 0x69, 0x81, 0x69, 0x3a, 0xae,
+	0x9e, 0x16, 0x1e, 0x58, 0x35, 0xcc, 0x79, 0xcb, 0x02, 0xa7, 0xf1, 0x07, 0x1a, 0x8c, 0xcb, 0xed,
+	0x73, 0xf0, 0x5f, 0x80, 0xe1, 0x58, 0x14, 0x21, 0xfa, 0xcc, 0xfd, 0x05, 0x91, 0x78, 0x7c, 0x74,
+	0x5b, 0x82, 0xd6, 0x47, 0xa1, 0x9d, 0xef, 0x08, 0x8d, 0x75, 0x2b, 0x61, 0xfb, 0x41, 0x5f, 0xb4,
+	0x76, 0x7b, 0x3d, 0x6e, 0xc5, 0xf6, 0xea, 0x53, 0x6d, 0x2f, 0x03, 0x8e, 0x36, 0x6c, 0xc7, 0x0c,
+	0xdc, 0xc0, 0xaa, 0x9b, 0x9b, 0x18, 0x17, 0x0e, 0x52, 0xaa, 0xe1, 0x86, 0xed, 0xac, 0x93, 0xb2,
+	0x15, 0x4c, 0xf6, 0xfb, 0x89, 0xc0, 0x6e, 0x60, 0xb7, 0x15, 0x98, 0x1b, 0x78, 0xd3, 0xf5, 0xb0,
+	0xb9, 0x85, 0xed, 0xda, 0x56, 0x50, 0x38, 0x44, 0x57, 0xce, 0x18, 0xaf, 0x5c, 0xa4, 0x75, 0x77,
+	0x68, 0x15, 0xba, 0x0b, 0xa3, 0xd1, 0x1c, 0x9b, 0x7e, 0x60, 0x05, 0x2d, 0xbf, 0xd0, 0x3f, 0xab,
+	0x5d, 0x18, 0xb9, 0x6e, 0x28, 0x76, 0xe3, 0x5a, 0x48, 0xba, 0x46, 0x29, 0xcb, 0xc7, 0x7c, 0xb9,
+	0xc0, 0xf8, 0x6d, 0x0d, 0x46, 0x63, 0x49, 0xf1, 0x19, 0xbc, 0x02, 0x87, 0xe9, 0x26, 0x8e, 0xd6,
+	0x9e, 0x72, 0xa3, 0x87, 0x34, 0xbd, 0x9b, 0xb6, 0x2f, 0x27, 0x37, 0x6f, 0xcf, 0x17, 0xed, 0xef,
+	0x69, 0x70, 0x2a, 0xd5, 0x45, 0x74, 0x76, 0xf5, 0x13, 0xd5, 0x10, 0x8e, 0x39, 0x4f, 0x37, 0x30,
+	0xc2, 0xde, 0x0d, 0xfc, 0x19, 0x98, 0x7c, 0xe8, 0xd0, 0x8d, 0x50, 0x55, 0x6d, 0xd9, 0x02, 0x1c,
+	0xb6, 0xaa, 0x55, 0x0f, 0xfb, 0x3e, 0x57, 0xe5, 0xe1, 0xa7, 0xf1, 0x26, 0x4c, 0xa9, 0x19, 0x3f,
+	0xed, 0x5e, 0x34, 0x9e, 0x80, 0x53, 0x61, 0xcb, 0xc9, 0x9d, 0x94, 0x0d, 0x67, 0x15, 0x0a, 0x69,
+	0xa6, 0x7d, 0x2d, 0x2a, 0xe3, 0x39, 0x28, 0x86, 0x4d, 0x65, 0xac, 0x89, 0x6c, 0x18, 0x6b, 0x30,
+	0x93, 0xc9, 0xbb, 0xdf, 0xc9, 0x36, 0xc6, 0x01, 0x71, 0x90, 0x2b, 0x18, 0x47, 0x26, 0xd0, 0x36,
+	0x8c, 0x49, 0xa5, 0xbc, 0x79, 0x13, 0x0e, 0x6d, 0xe2, 0x68, 0xa4, 0x13, 0xd2, 0x9a, 0x08, 0x57,
+	0xc3, 0x92, 0x6b, 0x3b, 0x8b, 0x57, 0x89, 0x31, 0xf4, 0xa3, 0xff, 0x99, 0xb9, 0x50, 0xb3, 0x83,
+	0xad, 0xd6, 0xc6, 0x42, 0xc5, 0x6d, 0x94, 0x18, 0x31, 0xff, 0x73, 0xc5, 0xaf, 0x3e, 0x2a, 0x05,
+	0xed, 0x26, 0xf6, 0x29, 0x83, 0x5f, 0xa6, 0x0d, 0x1b, 0xbb, 0x7c, 0xdb, 0x0a, 0x58, 0xd0, 0x69,
+	0x38, 0xd2, 0xb0, 0x76, 0x4c, 0x5c, 0xc7, 0x0d, 0xec, 0x04, 0x3e, 0x3f, 0x7f, 0x86, 0x1b, 0xd6,
+	0xce, 0x32, 0x2f, 0x42, 0x2b, 0x8a, 0x15, 0xbb, 0x9f, 0x7d, 0xf4, 0x1d, 0x0d, 0x8e, 0x0b, 0xfd,
+	0x47, 0xab, 0x6d, 0x80, 0x2a, 0x41, 0xa5, 0x54, 0xd7, 0x49, 0x4d, 0xc4, 0x13, 0x5a, 0x81, 0x8c,
+	0xbe, 0x77, 0x3b, 0xe9, 0xeb, 0x7d, 0x30, 0x22, 0xf7, 0xd4, 0xad, 0x3d, 0xf4, 0x0a, 0x0c, 0xc5,
+	0xca, 0x9a, 0xaa, 0xf4, 0xc5, 0x05, 0x82, 0xf1, 0xbf, 0x7f, 0x36, 0x73, 0xae, 0x8b, 0xc9, 0x59,
+	0x75, 0x82, 0xf2, 0x60, 0x10, 0x6a, 0xf6, 0xdb, 0x70, 0x38, 0x70, 0x9b, 0xb1, 0xde, 0xdf, 0x73,
+	0x53, 0x03, 0x81, 0xdb, 0x24, 0x0d, 0x4d, 0xc0, 0x60, 0xb0, 0x63, 0x56, 0xdc, 0x96, 0x13, 0x9e,
+	0x0a, 0x87, 0x83, 0x9d, 0x25, 0xf2, 0x49, 0x4e, 0x18, 0xb7, 0x5e, 0xc5, 0x7e, 0x60, 0x06, 0x3b,
+	0xa6, 0x55, 0xc3, 0xf4, 0x18, 0x38, 0x54, 0x1e, 0x66, 0x85, 0xeb, 0x3b, 0x37, 0x6b, 0xd8, 0x78,
+	0x57, 0x03, 0x43, 0x5e, 0xce, 0x4a, 0xeb, 0xe5, 0xb3, 0xb5, 0xc9, 0x1a, 0x30, 0x97, 0x8b, 0x81,
+	0xaf, 0x9e, 0x15, 0x85, 0xd1, 0x73, 0x2e, 0x7b, 0x5f, 0x66, 0xda, 0x3d, 0x18, 0x26, 0xf9, 0x96,
+	0x54, 0x8e, 0x35, 0x61, 0xf7, 0x6a, 0x49, 0xbb, 0xb7, 0xcb, 0x03, 0xde, 0x30, 0x61, 0x4a, 0xdd,
+	0x0d, 0x1f, 0xce, 0x4b, 0x8a, 0xe1, 0xcc, 0x28, 0x54, 0x5e, 0xe6, 0x38, 0xea, 0x60, 0x28, 0x48,
+	0x1e, 0x78, 0x6e, 0xcd, 0xc3, 0x7e, 0xcf, 0x87, 0xf3, 0xc3, 0x3e, 0x98, 0xcb, 0xed, 0x8e, 0x0f,
+	0xab, 0x6b, 0x43, 0x97, 0xa8, 0x23, 0x5a, 0x52, 0x35, 0x9b, 0xee, 0x3b, 0xd8, 0xe3, 0xeb, 0x83,
+	0x9d, 0x47, 0xd5, 0x07, 0xa4, 0x88, 0x80, 0x67, 0x7b, 0x8e, 0x51, 0x1c, 0x64, 0xe0, 0x69, 0x11,
+	0x23, 0x38, 0x0f, 0xc7, 0x82, 0x2d, 0x0f, 0xfb, 0x5b, 0x6e, 0x3d, 0x6c, 0x86, 0xed, 0x82, 0x91,
+	0xa8, 0x98, 0x11, 0x5e, 0x87, 0x01, 0xd6, 0x70, 0xa1, 0x3f, 0xad, 0x7a, 0x96, 0x83, 0x2d, 0xec,
+	0xe1, 0x56, 0x83, 0x9d, 0x75, 0x65, 0x4e, 0x89, 0x9e, 0x86, 0xc1, 0x16, 0x3f, 0x26, 0x0a, 0x03,
+	0x1d, 0xb9, 0x22, 0x5a, 0xe3, 0x06, 0x9c, 0x7e, 0xd5, 0xf2, 0x83, 0xb5, 0xd6, 0x46, 0xc3, 0x0e,
+	0x02, 0x5c, 0x0d, 0x09, 0x97, 0xb7, 0xb1, 0x13, 0x74, 0x3e, 0x9d, 0xbe, 0x76, 0x10, 0x8c, 0x3c,
+	0x7e, 0x2e, 0xe8, 0x19, 0x18, 0xc6, 0xa4, 0x40, 0x9e, 0x58, 0x5a, 0xc4, 0xe4, 0xfb, 0x25, 0x18,
+	0xc7, 0x9c, 0x93, 0xdb, 0x8d, 0xe6, 0xb6, 0x1b, 0x60, 0xae, 0x3d, 0xcf, 0x8a, 0x43, 0x61, 0x37,
+	0xe4, 0xb0, 0x9f, 0xc5, 0xba, 0x5b, 0x79, 0xc4, 0xcc, 0x49, 0xae, 0x86, 0x51, 0xd8, 0x10, 0x2b,
+	0x7d, 0xdd, 0x0d, 0x30, 0x7a, 0x02, 0x4e, 0xd6, 0x2d, 0x3f, 0x30, 0x19, 0x08, 0xd2, 0x72, 0x68,
+	0x9d, 0xb2, 0x69, 0x1a, 0x23, 0xb5, 0x14, 0x32, 0x21, 0x67, 0x8c, 0xe8, 0x59, 0x98, 0xa0, 0x4c,
+	0xee, 0x86, 0x8f, 0xbd, 0x6d, 0x5c, 0x35, 0xc5, 0x21, 0xb0, 0x99, 0xa3, 0xad, 0xde, 0xe7, 0xf5,
+	0xcb, 0xf1, 0x70, 0x1c, 0x98, 0x4e, 0xb0, 0xca, 0x83, 0x2b, 0xf4, 0xef, 0x7d, 0x5c, 0xba, 0xd4,
+	0x97, 0x34, 0x46, 0x63, 0x1e, 0xc6, 0x96, 0xcb, 0x4b, 0xd7, 0xaf, 0xae, 0xbb, 0xb7, 0xb0, 0xe3,
+	0x36, 0xc2, 0x79, 0x1b, 0x87, 0x7e, 0xec, 0x55, 0xae, 0x5f, 0xe5, 0xb3, 0xc6, 0x3e, 0x8c, 0xb7,
+	0x60, 0x5c, 0x26, 0xe6, 0x93, 0x34, 0x4e, 0x6e, 0xec, 0x8e, 0xdb, 0x08, 0xa9, 0xe9, 0x07, 0x9a,
+	0x87, 0xe3, 0x4c, 0xa9, 0x9b, 0xae, 0x67, 0xd3, 0xa3, 0x09, 0x57, 0xe9, 0xb4, 0x0c, 0x96, 0x47,
+	0x59, 0xc5, 0xfd, 0xa8, 0xdc, 0xb8, 0x06, 0x13, 0xb4, 0xcd, 0x75, 0x97, 0xf6, 0x20, 0x79, 0x58,
+	0xd4, 0xed, 0x1b, 0x7f, 0xa6, 0x81, 0xae, 0xe2, 0xe1, 0xa0, 0xa6, 0x01, 0xc8, 0x91, 0x69, 0x8a,
+	0x9c, 0x43, 0xa4, 0x84, 0xf2, 0x90, 0x6a, 0x3a, 0x28, 0xd3, 0xb1, 0x1a, 0xfc, 0xa4, 0x2b, 0x0f,
+	0xd1, 0x92, 0x7b, 0x56, 0x83, 0x6e, 0x5b, 0x56, 0xed, 0xb7, 0x1b, 0x1b, 0x6e, 0x3d, 0xbc, 0xb7,
+	0xd0, 0xb2, 0x35, 0x5a, 0x44, 0x54, 0x0a, 0x23, 0xa9, 0xe2, 0x8a, 0xdd, 0xb0, 0xea, 0x3e, 0x9f,
+	0xda, 0xa3, 0xb4, 0xf4, 0x16, 0x2f, 0x24, 0x12, 0x16, 0x51, 0xe6, 0x8f, 0xe9, 0x2d, 0x18, 0x97,
+	0x89, 0x63, 0x09, 0xa7, 0xe7, 0x63, 0x6f, 0x12, 0xbe, 0x0b, 0xc5, 0x5b, 0xb8, 0x8e, 0x6b, 0x56,
+	0x80, 0x5f, 0xc1, 0x6d, 0x7f, 0xb1, 0xfd, 0x3a, 0x3b, 0xa0, 0x5c, 0x2f, 0x84, 0x34, 0x0f, 0xc7,
+	0xb7, 0xc3, 0x32, 0x53, 0xde, 0xb6, 0xa3, 0x51, 0xc5, 0x4d, 0xbe, 0x7f, 0x5b, 0x30, 0x93, 0xd9,
+	0x9c, 0xb0, 0x77, 0x83, 0xad, 0x44, 0x4b, 0x80, 0x83, 0x2d, 0xde, 0x06, 0xba, 0x06, 0xe3, 0xae,
+	0x47, 0xec, 0xdc, 0xc0, 0x93, 0xfa, 0x64, 0xb3, 0x31, 0x26, 0xd6, 0x85, 0xdd, 0xde, 0x83, 0x39,
+	0xb9, 0xdb, 0x84, 0x7e, 0xe2, 0x43, 0x39, 0x0f, 0xc7, 0xa2, 0x8d, 0xc3, 0x14, 0x32, 0xef, 0x7e,
+	0x04, 0x4b, 0xf4, 0xc6, 0x6f, 0x68, 0x70, 0x26, 0xbf, 0x41, 0x3e, 0x98, 0xbd, 0x08, 0x67, 0x3f,
+	0x03, 0x7b, 0x1d, 0x4e, 0xcb, 0x38, 0xee, 0x0b, 0x44, 0xe1, 0xb0, 0xb2, 0xda, 0xd5, 0xb2, 0xdb,
+	0xfd, 0x2a, 0x18, 0x79, 0xed, 0xee, 0x67, 0x74, 0x0a, 0xe1, 0xf6, 0x29, 0x85, 0x7b, 0x02, 0xc6,
+	0xc4, 0xbe, 0xc3, 0xdb, 0xc2, 0x9b, 0x30, 0x2e, 0x17, 0x73, 0x10, 0x2f, 0xc3, 0xd1, 0x2a, 0x2f,
+	0x37, 0x1f, 0xe1, 0x76, 0x68, 0x2e, 0x4c, 0x8a, 0xba, 0xee, 0xae, 0x5f, 0x93, 0x78, 0x8f, 0x54,
+	0x85, 0x2f, 0x63, 0x05, 0xa6, 0xe9, 0xe9, 0x8d, 0xab, 0x6b, 0xd8, 0xa9, 0xae, 0xbb, 0xe1, 0x5c,
+	0xfa, 0x82, 0x57, 0xd0, 0xa7, 0x8e, 0xe2, 0xc4, 0x20, 0x8f, 0xb2, 0xd2, 0x50, 0x68, 0x5b, 0x50,
+	0xcc, 0x6a, 0x27, 0x32, 0xd3, 0x8e, 0x13, 0x16, 0x33, 0x70, 0x23, 0x0d, 0xad, 0xb4, 0xf7, 0x65,
+	0xfe, 0xf2, 0x31, 0x5f, 0x6e, 0xcf, 0xf8, 0x96, 0x46, 0x6e, 0x69, 0x1b, 0x3d, 0x00, 0xdd, 0xb3,
+	0x5b, 0xcd, 0x47, 0x1a, 0xcc, 0x66, 0x43, 0xea, 0xed, 0xf8, 0x7b, 0x77, 0xe5, 0x99, 0x63, 0xe6,
+	0x88, 0xfa, 0x98, 0x0b, 0x57, 0xde, 0x37, 0x34, 0x30, 0xf2, 0xa8, 0xf8, 0xe0, 0xb6, 0x3a, 0x1d,
+	0xc2, 0xda, 0x1e, 0x0e, 0xe1, 0xdc, 0xe3, 0x77, 0x16, 0x8a, 0x0f, 0x3c, 0xf7, 0x6d, 0x5c, 0x09,
+	0xb2, 0x20, 0x7f, 0xd4, 0x07, 0x33, 0x99, 0x24, 0x1c, 0xaf, 0xd3, 0x4b, 0xbc, 0x9d, 0x8d, 0x06,
+	0xf4, 0x1c, 0x4c, 0x34, 0x43, 0x48, 0xa9, 0xbe, 0x98, 0x81, 0x7b, 0xaa, 0xa9, 0xc6, 0x8c, 0x6e,
+	0xc0, 0x64, 0x03, 0x57, 0x6d, 0xcb, 0x31, 0x95, 0x66, 0x1b, 0xb3, 0xaa, 0x0a, 0x8c, 0x64, 0x39,
+	0x6d, 0x8f, 0x11, 0x3b, 0x9e, 0x3b, 0x0b, 0x25, 0x2f, 0xe1, 0x51, 0x5e, 0xca, 0xe5, 0x4a, 0xb6,
+	0xd5, 0x6b, 0x2d, 0xdc, 0x0a, 0x17, 0xf0, 0x12, 0x5d, 0x50, 0xd4, 0xce, 0xf2, 0xf7, 0xf8, 0x42,
+	0xd0, 0xab, 0x6d, 0xf5, 0x81, 0x06, 0xb3, 0xd9, 0x90, 0xf8, 0x4c, 0x3e, 0x05, 0x03, 0xd4, 0x56,
+	0x0c, 0xf7, 0xd2, 0x74, 0x7a, 0x2f, 0x09, 0x7c, 0x65, 0x4e, 0xdc, 0xbb, 0x5d, 0xf4, 0x0d, 0x0d,
+	0xa6, 0xef, 0xe0, 0xfa, 0xe7, 0x47, 0x6a, 0xdf, 0xd7, 0xa0, 0x98, 0x05, 0xe8, 0x73, 0x22, 0xb3,
+	0xf7, 0x35, 0x38, 0xb5, 0xbc, 0x83, 0x2b, 0xad, 0x20, 0xed, 0x24, 0xfc, 0x05, 0x4b, 0xeb, 0x07,
+	0x1a, 0x14, 0xd2, 0x50, 0xb8, 0x9c, 0x16, 0xe1, 0xb0, 0x87, 0x2b, 0xae, 0x57, 0x0d, 0x05, 0xa5,
+	0x72, 0x95, 0x33, 0x6e, 0x72, 0x09, 0xa7, 0xa4, 0x5c, 0x19, 0x84, 0x8c, 0xbd, 0x13, 0xda, 0xbb,
+	0x1a, 0x14, 0x43, 0xa4, 0x7b, 0xf5, 0x6c, 0xf6, 0x4c, 0x5c, 0x3f, 0xd6, 0x60, 0x26, 0x13, 0x04,
+	0x97, 0xda, 0x6a, 0x52, 0x6a, 0x17, 0xb3, 0x9d, 0x31, 0xbf, 0x28, 0xe1, 0x7d, 0x4d, 0x83, 0x62,
+	0x19, 0x6f, 0xb6, 0x9c, 0x6a, 0xa6, 0xf0, 0x74, 0x18, 0xf4, 0x18, 0x05, 0xe6, 0xd2, 0x8b, 0xbe,
+	0x7b, 0x26, 0xbe, 0xbf, 0xd1, 0x60, 0x26, 0x13, 0x46, 0x64, 0x27, 0x24, 0xc4, 0x97, 0xe3, 0xcb,
+	0x62, 0x6d, 0x7d, 0xc6, 0xb2, 0xfb, 0xae, 0x06, 0xfa, 0xa2, 0x67, 0x57, 0x6b, 0x78, 0x05, 0xe3,
+	0x9b, 0xf5, 0xba, 0xfb, 0x8e, 0xe5, 0x54, 0xb0, 0xb8, 0xe8, 0x6a, 0x9e, 0xe5, 0x04, 0xd1, 0x85,
+	0x21, 0xfc, 0x8c, 0x6b, 0xc2, 0xdb, 0x62, 0xf8, 0x99, 0x90, 0xe7, 0xc1, 0x7d, 0xcb, 0xf3, 0xaf,
+	0x34, 0x98, 0x54, 0x42, 0xe3, 0xb2, 0xbc, 0x05, 0x60, 0x45, 0xa5, 0x5c, 0x9c, 0x45, 0x69, 0x0f,
+	0xa7, 0x98, 0xb9, 0x18, 0x05, 0xbe, 0xde, 0x49, 0x52, 0x87, 0x82, 0x64, 0x3e, 0xd4, 0x6d, 0x3f,
+	0xb2, 0x5a, 0x9e, 0x85, 0x09, 0x45, 0x1d, 0x1f, 0xc7, 0x14, 0x0c, 0xf1, 0x9d, 0xcc, 0x87, 0x31,
+	0x54, 0x8e, 0x0b, 0x8c, 0x53, 0x70, 0xe2, 0xae, 0x5b, 0x6d, 0xd5, 0xf1, 0xcd, 0x0a, 0x75, 0xf8,
+	0x46, 0xd7, 0x86, 0x87, 0x70, 0x32, 0x59, 0xc1, 0x1b, 0x7c, 0x1e, 0x06, 0x2d, 0x5e, 0xa6, 0x74,
+	0x31, 0x52, 0xb1, 0x48, 0xbc, 0xe5, 0x88, 0xc1, 0xf8, 0x17, 0x0d, 0xc6, 0x14, 0x14, 0x08, 0xc1,
+	0x21, 0xea, 0x1a, 0x60, 0xcb, 0x80, 0xfe, 0x2f, 0xaa, 0xa4, 0x3e, 0x59, 0x25, 0x15, 0xe0, 0x70,
+	0xb3, 0xe5, 0x35, 0x5d, 0x3f, 0x7c, 0xe2, 0x0c, 0x3f, 0x51, 0x0d, 0x06, 0x37, 0xac, 0x3a, 0x9b,
+	0xb3, 0x43, 0xbd, 0x7f, 0x08, 0x89, 0x1a, 0x37, 0xae, 0x42, 0x61, 0xd9, 0xa9, 0x52, 0x71, 0x63,
+	0xef, 0x66, 0x45, 0x72, 0xf7, 0x8e, 0x43, 0x7f, 0xdd, 0x6e, 0xd8, 0x01, 0x77, 0xa0, 0xb1, 0x0f,
+	0x63, 0x0d, 0x26, 0x14, 0x1c, 0x51, 0x7c, 0xc8, 0x61, 0x8b, 0x15, 0x71, 0x99, 0x4a, 0x81, 0x18,
+	0x49, 0xbe, 0x72, 0x48, 0x4c, 0x56, 0xb1, 0xf8, 0xb4, 0xef, 0x2f, 0xb6, 0xb9, 0xb5, 0x6a, 0x39,
+	0xd1, 0xb2, 0xa7, 0x5e, 0xd1, 0xc0, 0xf2, 0x02, 0xd1, 0x40, 0x25, 0x5e, 0x51, 0x52, 0xc6, 0xc8,
+	0xa9, 0x83, 0xc6, 0xa9, 0xca, 0x56, 0xe5, 0x10, 0x76, 0xaa, 0xbc, 0xba, 0x57, 0x9b, 0xee, 0x43,
+	0x0d, 0x4e, 0xe7, 0xc0, 0x8d, 0xae, 0xa6, 0x8a, 0x17, 0x44, 0x69, 0x91, 0x85, 0xa6, 0xf2, 0x67,
+	0xfe, 0xaa, 0x7f, 0x22, 0x5c, 0xae, 0xd4, 0x41, 0x5d, 0x0b, 0x77, 0xc7, 0x3d, 0x18, 0x97, 0x8b,
+	0xa3, 0x69, 0x1c, 0xa8, 0xd0, 0x12, 0x7e, 0x09, 0x28, 0x88, 0xa0, 0x6f, 0xb3, 0x50, 0x28, 0xf2,
+	0x0a, 0x1e, 0xaa, 0x0a, 0x4e, 0x6d, 0x8c, 0xc1, 0xf1, 0x32, 0x6e, 0xd6, 0xad, 0xf6, 0x2d, 0x7b,
+	0x73, 0x33, 0xec, 0xc4, 0x04, 0x24, 0x16, 0x46, 0x47, 0xe4, 0xd1, 0xaa, 0xed, 0x57, 0x3c, 0xdc,
+	0xb4, 0x9c, 0x8a, 0x8d, 0x95, 0x76, 0x58, 0xc8, 0x16, 0x92, 0xb5, 0x79, 0x77, 0x32, 0xa7, 0xf1,
+	0x46, 0xdc, 0x6b, 0x44, 0x49, 0x16, 0xef, 0xa6, 0x8d, 0xeb, 0xd5, 0xd0, 0xf9, 0x45, 0x3f, 0xc8,
+	0x8e, 0xf3, 0xf0, 0x46, 0xcb, 0xae, 0x87, 0xae, 0xfc, 0xf0, 0x93, 0xec, 0xdc, 0xba, 0xbd, 0x1d,
+	0x6e, 0x44, 0xfa, 0x3f, 0xbd, 0x68, 0x61, 0xa7, 0x6a, 0x3b, 0x35, 0xea, 0x58, 0xbb, 0x85, 0x9b,
+	0x75, 0xb7, 0xdd, 0x10, 0x0c, 0x5b, 0xc3, 0x86, 0x99, 0x4c, 0x8a, 0xe8, 0x30, 0x1b, 0xae, 0xc6,
+	0xc5, 0x2a, 0x0d, 0x2c, 0xb0, 0x72, 0xb7, 0x2e, 0x1f, 0xa7, 0xc8, 0x68, 0x2c, 0xc0, 0x49, 0x4a,
+	0xb8, 0xe4, 0x3a, 0xdb, 0xd8, 0xf3, 0xa9, 0xc1, 0x90, 0xe7, 0x77, 0xfd, 0x3b, 0x62, 0x61, 0x26,
+	0x19, 0x38, 0xa6, 0x9b, 0x00, 0x95, 0xa8, 0x94, 0xcf, 0xf1, 0x64, 0x0a, 0x52, 0xcc, 0x18, 0x9e,
+	0x08, 0x31, 0x53, 0xec, 0x8a, 0xec, 0x13, 0xdd, 0xb7, 0x2b, 0x30, 0xb0, 0x69, 0x55, 0x02, 0xd7,
+	0xdb, 0xef, 0xdb, 0x1d, 0xe3, 0x26, 0x2f, 0xc6, 0xf4, 0x29, 0xf2, 0x81, 0xd5, 0xf2, 0xe3, 0x17,
+	0xe3, 0x57, 0x60, 0x4c, 0x2a, 0xe5, 0xa3, 0x79, 0x92, 0x44, 0xce, 0xb5, 0xfc, 0x68, 0x0d, 0x9d,
+	0x4c, 0xbd, 0x9d, 0x52, 0x86, 0x38, 0x7a, 0x8e, 0xd0, 0x1a, 0x37, 0x60, 0x56, 0xf4, 0x6a, 0xbd,
+	0x46, 0x36, 0xd2, 0x6a, 0x15, 0x3b, 0x81, 0x1d, 0xb4, 0x43, 0xc9, 0x4e, 0xc0, 0xe0, 0x23, 0xdc,
+	0x36, 0xb7, 0x2c, 0x7f, 0x8b, 0x3f, 0xe9, 0x1d, 0x7e, 0x84, 0xdb, 0x77, 0x2c, 0x7f, 0xcb, 0xa8,
+	0xc3, 0xe9, 0x1c, 0x76, 0x8e, 0xec, 0x36, 0x0c, 0xda, 0xbc, 0x4c, 0x75, 0x9d, 0xce, 0x6c, 0x80,
+	0x43, 0x8d, 0x98, 0x8d, 0x5f, 0x81, 0xa9, 0xfb, 0xad, 0xa0, 0xe6, 0xda, 0x4e, 0x6d, 0x7d, 0x67,
+	0x69, 0x0b, 0x57, 0x1e, 0x35, 0x5d, 0x5b, 0x78, 0xf1, 0x28, 0x02, 0x54, 0xa2, 0x52, 0x0e, 0x55,
+	0x28, 0x21, 0x5e, 0x55, 0xfe, 0xa0, 0x44, 0xc7, 0xd2, 0xc7, 0x08, 0x58, 0x11, 0x19, 0x0e, 0x51,
+	0x9c, 0x1c, 0x98, 0x69, 0x57, 0xf9, 0x26, 0x18, 0xe2, 0x25, 0xab, 0x55, 0x7a, 0xc5, 0xbb, 0x85,
+	0xeb, 0x56, 0xfb, 0xf3, 0xe2, 0x6f, 0xfa, 0x77, 0x0d, 0x8a, 0x59, 0x80, 0xb8, 0x4c, 0x36, 0x60,
+	0xa2, 0xca, 0x28, 0xcc, 0x2c, 0xaf, 0xd3, 0x69, 0x71, 0x36, 0x94, 0xcd, 0xf1, 0x99, 0x38, 0x59,
+	0x55, 0xf6, 0xd5, 0x3b, 0x05, 0x7d, 0x37, 0x56, 0x35, 0xe1, 0xbb, 0x10, 0xb3, 0x69, 0xfd, 0x7d,
+	0x39, 0xda, 0xff, 0x4b, 0x83, 0x99, 0xcc, 0xf6, 0xe2, 0xe7, 0x48, 0xe1, 0x95, 0x4a, 0x7a, 0x8e,
+	0x8c, 0xde, 0xa7, 0xd8, 0xfb, 0x52, 0xee, 0xd3, 0x54, 0x5f, 0xee, 0xd3, 0xd4, 0x6b, 0x80, 0x84,
+	0x57, 0xb0, 0xd0, 0xaa, 0x3f, 0x98, 0x0e, 0xcb, 0x93, 0x5e, 0xf2, 0x62, 0xb8, 0xe5, 0x51, 0x9c,
+	0xc0, 0x6f, 0xdc, 0x81, 0x29, 0xa6, 0x24, 0x23, 0xaf, 0xfb, 0xfa, 0x0e, 0x59, 0xc3, 0x42, 0x3c,
+	0x61, 0xe4, 0x25, 0x0a, 0x76, 0xe2, 0xcd, 0x2b, 0xb8, 0x9a, 0x19, 0x83, 0xe1, 0xc1, 0x74, 0x46,
+	0x4b, 0x5c, 0x44, 0x6a, 0xf4, 0xda, 0xa7, 0x41, 0x6f, 0xc0, 0x2c, 0x39, 0x6c, 0xeb, 0x76, 0x25,
+	0x20, 0x93, 0x23, 0xf2, 0x45, 0x7a, 0xce, 0x81, 0xd3, 0x39, 0x34, 0xd1, 0x01, 0x3a, 0x54, 0xe1,
+	0x44, 0x21, 0xa4, 0xb3, 0x89, 0x6b, 0x92, 0xba, 0x05, 0xbe, 0xa4, 0x63, 0x6e, 0xe3, 0x9b, 0x1a,
+	0x4c, 0x64, 0x92, 0x77, 0x7e, 0x4d, 0x55, 0x4b, 0xa9, 0xef, 0xd3, 0x48, 0xe9, 0x79, 0x40, 0xcc,
+	0x30, 0x21, 0x56, 0xc6, 0x1e, 0x1d, 0x23, 0xc6, 0x7d, 0x18, 0x93, 0x98, 0xa3, 0x10, 0x9b, 0x7e,
+	0x3f, 0xb0, 0x22, 0x61, 0x4d, 0xa5, 0xad, 0x7d, 0x7a, 0x56, 0x50, 0x26, 0x2e, 0x23, 0xc6, 0x60,
+	0xfc, 0x3d, 0x89, 0xf4, 0x4b, 0x50, 0x74, 0xeb, 0xa5, 0x79, 0x11, 0x06, 0xe9, 0xde, 0xa9, 0x5a,
+	0x6d, 0xae, 0x1f, 0xa6, 0xd3, 0x1d, 0xd3, 0x16, 0xdf, 0xb0, 0x9d, 0xaa, 0xfb, 0x4e, 0x78, 0x87,
+	0x25, 0x4c, 0xb7, 0xac, 0x36, 0x7a, 0x19, 0x86, 0x28, 0xff, 0x3b, 0x18, 0x3f, 0x2a, 0x1c, 0xec,
+	0xbe, 0x01, 0xda, 0xeb, 0x1b, 0x18, 0x3f, 0x32, 0xfe, 0xa1, 0x0f, 0x8e, 0xa7, 0xa8, 0xc8, 0x49,
+	0x6d, 0x3b, 0x9b, 0x75, 0xf7, 0x9d, 0x82, 0xb6, 0xbf, 0x93, 0x9a, 0x71, 0xa3, 0x3b, 0x70, 0xd8,
+	0x6d, 0x05, 0xb4, 0xa1, 0xfd, 0x45, 0xfe, 0x84, 0xec, 0xc4, 0xbc, 0x67, 0x6d, 0xf2, 0x98, 0x1d,
+	0xe6, 0xd5, 0x1d, 0x66, 0x65, 0x2c, 0x6e, 0x67, 0x0e, 0x8e, 0x72, 0x6a, 0x29, 0xae, 0xe7, 0x08,
+	0x2f, 0x64, 0x44, 0xf7, 0x61, 0xd8, 0xda, 0xc6, 0x9e, 0x55, 0xc3, 0x34, 0x88, 0xa8, 0x7f, 0x5f,
+	0xa8, 0x80, 0x37, 0xb1, 0x82, 0xb1, 0xb1, 0x02, 0xba, 0x18, 0x34, 0x6c, 0xd5, 0xeb, 0x55, 0x6b,
+	0x3f, 0xf1, 0xeb, 0x5f, 0x86, 0x93, 0x61, 0x98, 0x48, 0xa2, 0x8d, 0x5e, 0x45, 0xa2, 0xec, 0xc2,
+	0x74, 0x22, 0xd2, 0x27, 0xd1, 0xd1, 0x67, 0x1b, 0xad, 0xf4, 0x63, 0x0d, 0x74, 0xc1, 0x4c, 0x89,
+	0xfa, 0xe6, 0x1b, 0x50, 0x87, 0xc1, 0x0a, 0x2f, 0xe3, 0x5d, 0x46, 0xdf, 0xe8, 0x19, 0x28, 0x54,
+	0x5a, 0x9e, 0x47, 0xb4, 0x48, 0x4a, 0x9a, 0xac, 0xbf, 0x13, 0xbc, 0x7e, 0x2d, 0x3f, 0x54, 0xe6,
+	0x60, 0xc7, 0x50, 0x99, 0x43, 0xc9, 0x50, 0x19, 0x63, 0x1a, 0x26, 0x6f, 0xd6, 0x3c, 0x2c, 0x3e,
+	0x3b, 0x58, 0xd5, 0xe8, 0x5d, 0x98, 0x18, 0x3f, 0x53, 0xea, 0xfa, 0x28, 0xf9, 0x60, 0x60, 0x8b,
+	0x96, 0xa8, 0x62, 0xe3, 0x13, 0x3c, 0x9c, 0x12, 0x3d, 0x07, 0xfd, 0x44, 0x5d, 0x86, 0x7a, 0xb2,
+	0x98, 0xcd, 0x42, 0xf4, 0x62, 0xa8, 0x8f, 0x28, 0x0b, 0xb9, 0x97, 0xb0, 0x0d, 0x1d, 0x3d, 0x9f,
+	0xbf, 0x6a, 0x6f, 0x63, 0x27, 0x0e, 0x6d, 0x32, 0xea, 0x30, 0x93, 0x49, 0x11, 0x9d, 0x1f, 0x10,
+	0x99, 0x0d, 0xca, 0x33, 0x2d, 0xa3, 0x81, 0xf0, 0x2e, 0x10, 0x33, 0x1b, 0xef, 0x1d, 0x84, 0x53,
+	0x19, 0xd4, 0x7b, 0x7b, 0x24, 0xbe, 0x0e, 0x27, 0xa8, 0xb2, 0x8b, 0xc3, 0xb4, 0xa5, 0x9b, 0x3c,
+	0x8d, 0x9b, 0x89, 0xe2, 0xb2, 0xf9, 0x9d, 0x7e, 0x5f, 0xc1, 0x36, 0x37, 0x60, 0x72, 0x83, 0x78,
+	0x22, 0x7c, 0xd3, 0xb7, 0x9d, 0x0a, 0x36, 0xe5, 0x5e, 0xf9, 0x12, 0x29, 0x30, 0x92, 0x35, 0x42,
+	0xf1, 0xaa, 0xd8, 0x33, 0x7a, 0x11, 0xa6, 0xd2, 0xec, 0x31, 0x80, 0x42, 0xbf, 0x92, 0x3f, 0x02,
+	0xa1, 0x34, 0xbd, 0x06, 0x94, 0xa6, 0xd7, 0x3c, 0x1c, 0x6f, 0xd8, 0xbe, 0x4f, 0x6c, 0xd8, 0x38,
+	0x22, 0xee, 0x30, 0x25, 0x1d, 0x65, 0x15, 0x11, 0x2a, 0xdf, 0xf8, 0xb6, 0x16, 0x05, 0xd6, 0xad,
+	0x3a, 0x95, 0x7a, 0xcb, 0x67, 0x51, 0x68, 0xee, 0x66, 0x8f, 0xf3, 0x5b, 0xd0, 0x15, 0x18, 0x4b,
+	0x9a, 0xd4, 0xe1, 0xb5, 0xe1, 0x50, 0x79, 0x54, 0x7e, 0xae, 0x5d, 0xad, 0x1a, 0xff, 0xaf, 0xc1,
+	0x74, 0x06, 0xae, 0xc8, 0x4b, 0x39, 0x9a, 0x6c, 0x50, 0xb5, 0x97, 0x12, 0x0f, 0xc3, 0x23, 0x72,
+	0x4f, 0xe4, 0x0e, 0xef, 0xb9, 0x6e, 0xc0, 0xaf, 0x37, 0xf4, 0x7f, 0xb4, 0x00, 0xfd, 0x34, 0xa7,
+	0x8b, 0x9f, 0x9d, 0x85, 0x85, 0x38, 0xe7, 0x6b, 0x81, 0xe5, 0x7c, 0x2d, 0x30, 0x28, 0x8c, 0x2c,
+	0x71, 0x93, 0x3a, 0x94, 0xba, 0x49, 0x4d, 0xc2, 0x90, 0x1f, 0xb8, 0x1e, 0x0d, 0x36, 0xa0, 0xf3,
+	0x7c, 0xa4, 0x3c, 0x48, 0x0b, 0x5e, 0xc1, 0x6d, 0x63, 0x15, 0xf4, 0xc4, 0x3e, 0x58, 0x75, 0x36,
+	0xdd, 0x7d, 0x59, 0xf0, 0x1b, 0x30, 0xa9, 0x6c, 0x2a, 0x4a, 0x73, 0x19, 0x8a, 0x58, 0xb8, 0xa4,
+	0x66, 0x72, 0x36, 0x2f, 0xe1, 0x0d, 0xed, 0xbe, 0x88, 0xcf, 0xf8, 0xde, 0x41, 0x18, 0x53, 0x10,
+	0x7e, 0xd6, 0x61, 0x2b, 0xe8, 0xa2, 0x60, 0xa1, 0x87, 0xe4, 0xec, 0xca, 0x19, 0xc5, 0x88, 0xc4,
+	0xf7, 0x45, 0x31, 0x67, 0xa3, 0xb2, 0x85, 0x1b, 0x6c, 0x77, 0x8e, 0xc8, 0xfe, 0x8a, 0x38, 0x59,
+	0x83, 0x92, 0x88, 0xc9, 0x1a, 0xb4, 0x00, 0x9d, 0x84, 0x81, 0x0d, 0x97, 0xbc, 0x39, 0xd0, 0x39,
+	0x1b, 0x2c, 0xf3, 0x2f, 0xb4, 0x0c, 0x83, 0x75, 0xae, 0xaa, 0xe8, 0x0e, 0xdc, 0x93, 0x0e, 0x8c,
+	0x58, 0xc9, 0xaa, 0x70, 0x9b, 0xe4, 0x61, 0xdb, 0x6d, 0x05, 0x74, 0x7b, 0x0e, 0x96, 0x07, 0x69,
+	0xc1, 0xfd, 0x56, 0x40, 0xbc, 0x69, 0x9b, 0x1e, 0xc6, 0x5f, 0xc5, 0x85, 0xc1, 0x59, 0x2d, 0xa9,
+	0xeb, 0x45, 0x1f, 0xc0, 0x0a, 0xa5, 0x2a, 0x73, 0xea, 0x4b, 0xbf, 0xaf, 0x45, 0x06, 0x43, 0x22,
+	0x19, 0x05, 0x5d, 0x84, 0xb3, 0x8b, 0x37, 0xd7, 0x97, 0xee, 0x98, 0xeb, 0x6f, 0x9a, 0x6b, 0xab,
+	0xb7, 0xef, 0xdd, 0x5c, 0x7f, 0x58, 0x5e, 0x36, 0xd7, 0xd6, 0x6f, 0xae, 0x3f, 0x5c, 0x33, 0x1f,
+	0xde, 0x5b, 0x7b, 0xb0, 0xbc, 0xb4, 0xba, 0xb2, 0xba, 0x7c, 0x6b, 0xf4, 0x00, 0x3a, 0x03, 0xb3,
+	0xd9, 0xa4, 0xa4, 0x60, 0xf9, 0xd6, 0xa8, 0x86, 0xce, 0x81, 0x91, 0xdb, 0x20, 0xa3, 0xeb, 0xd3,
+	0x0f, 0xbd, 0xff, 0xa7, 0xc5, 0x03, 0xd7, 0xff, 0xed, 0x0e, 0xf4, 0x53, 0x87, 0x05, 0xfa, 0x25,
+	0x18, 0x60, 0x21, 0x74, 0x68, 0x22, 0x9d, 0xaf, 0xc8, 0x17, 0xbe, 0xae, 0xab, 0xaa, 0xd8, 0x42,
+	0x36, 0xf4, 0x77, 0xff, 0xe3, 0xff, 0x7e, 0xb7, 0x6f, 0x1c, 0xa1, 0x92, 0x90, 0x39, 0xc9, 0x12,
+	0x1c, 0x91, 0x03, 0xc3, 0x82, 0xe1, 0x85, 0x8a, 0x59, 0xc9, 0x17, 0xbc, 0x9b, 0x99, 0xcc, 0x7a,
+	0xde, 0x57, 0x91, 0xf6, 0x55, 0x40, 0x27, 0xc5, 0xbe, 0x62, 0x73, 0x03, 0xfd, 0x9a, 0x06, 0xc7,
+	0x53, 0x19, 0x91, 0xe8, 0x4c, 0x3a, 0x02, 0x62, 0x3f, 0x9d, 0x9f, 0xa5, 0x9d, 0xcf, 0xa0, 0x69,
+	0x75, 0xe7, 0xa5, 0x3a, 0x6d, 0x19, 0xfd, 0xba, 0x06, 0xa3, 0xc9, 0x84, 0x45, 0x34, 0x97, 0x9b,
+	0xce, 0xc8, 0x11, 0x9c, 0xc9, 0x27, 0xe2, 0x30, 0xce, 0x50, 0x18, 0x45, 0x34, 0x95, 0x01, 0x83,
+	0xa6, 0x46, 0xa2, 0x5f, 0xd5, 0xe0, 0x30, 0x5f, 0x7a, 0x48, 0x57, 0x25, 0x9b, 0xf0, 0x3e, 0x27,
+	0x95, 0x75, 0xbc, 0xab, 0x17, 0x68, 0x57, 0x4f, 0xa3, 0x27, 0xc5, 0xae, 0xd8, 0xc1, 0x12, 0xec,
+	0xf8, 0xa5, 0xc7, 0xf2, 0x51, 0xb4, 0x5b, 0x7a, 0x2c, 0x1c, 0x3a, 0xbb, 0xe8, 0x87, 0x1a, 0x8c,
+	0xc8, 0xc6, 0x2c, 0x3a, 0x9d, 0xf7, 0x0c, 0xc8, 0x00, 0x19, 0x79, 0x24, 0x1c, 0xd7, 0x7d, 0x8a,
+	0x6b, 0x15, 0xdd, 0x16, 0x71, 0x85, 0x30, 0x68, 0x8e, 0x23, 0xc3, 0x97, 0xb6, 0x94, 0x77, 0x13,
+	0x85, 0x1c, 0xea, 0x1f, 0x6b, 0x70, 0x42, 0xbc, 0x21, 0xc4, 0xa7, 0x45, 0xa7, 0x25, 0x7b, 0x41,
+	0x52, 0x05, 0x39, 0x1e, 0x3e, 0xb5, 0x30, 0x85, 0x79, 0x7b, 0x9c, 0x34, 0x9b, 0x77, 0x4b, 0xc2,
+	0xa9, 0xf5, 0x41, 0x98, 0x74, 0x22, 0xa1, 0xcb, 0x9b, 0xd9, 0xee, 0x91, 0xdd, 0xa6, 0xc8, 0x6e,
+	0xa2, 0x97, 0xf6, 0x33, 0xcd, 0x22, 0xc8, 0x7f, 0xd6, 0xa0, 0x90, 0xb8, 0xbe, 0xc4, 0x95, 0x5d,
+	0xcc, 0x7d, 0xf7, 0x90, 0xbf, 0x48, 0x21, 0xaf, 0xa3, 0x72, 0x8f, 0x56, 0x80, 0x38, 0x0a, 0x0f,
+	0x8e, 0x08, 0x13, 0xed, 0xa3, 0x2c, 0xc5, 0x10, 0x69, 0xc7, 0xd9, 0x6c, 0x02, 0x0e, 0x77, 0x86,
+	0xc2, 0x9d, 0x40, 0xa7, 0xd4, 0x73, 0xef, 0xa3, 0xb7, 0x61, 0x90, 0x4f, 0x9f, 0x8f, 0x54, 0x5b,
+	0x32, 0xea, 0x6b, 0x4a, 0x5d, 0xc9, 0xfb, 0x99, 0xa3, 0xfd, 0x4c, 0xa3, 0xc9, 0xd4, 0x4c, 0xc6,
+	0xf3, 0x89, 0x7e, 0x53, 0x83, 0x63, 0xb2, 0xfc, 0x7d, 0x94, 0xb3, 0xeb, 0xa2, 0xae, 0xe7, 0x72,
+	0x69, 0x38, 0x82, 0x79, 0x8a, 0xe0, 0x2c, 0x9a, 0x4b, 0x23, 0x48, 0x4d, 0x0f, 0xfa, 0x91, 0x26,
+	0x25, 0x84, 0x4b, 0xb9, 0x24, 0x68, 0xbe, 0x8b, 0x9c, 0xdf, 0x08, 0xdb, 0xe5, 0xee, 0x88, 0x39,
+	0xc8, 0x27, 0x28, 0xc8, 0x2b, 0x68, 0x3e, 0x63, 0x3a, 0x4a, 0x52, 0xa0, 0x2b, 0xb3, 0xcd, 0xd1,
+	0xf7, 0x34, 0x18, 0x57, 0x25, 0xbd, 0xa0, 0xf3, 0x1d, 0x12, 0x5b, 0x7c, 0xe5, 0xf2, 0xce, 0xcb,
+	0x9f, 0x31, 0xae, 0x51, 0x80, 0xf3, 0xe8, 0xa2, 0x7a, 0x47, 0xaa, 0xe0, 0x7d, 0xa4, 0xc1, 0x64,
+	0x4e, 0x0e, 0x0b, 0x5a, 0xe8, 0xd0, 0x79, 0x22, 0xb7, 0x46, 0x2f, 0x75, 0x4d, 0x9f, 0x27, 0xd4,
+	0x18, 0x73, 0x45, 0xe0, 0x35, 0x9b, 0x21, 0x2a, 0x82, 0x3a, 0x27, 0x3f, 0x4a, 0x46, 0xdd, 0x39,
+	0x99, 0x4b, 0x2f, 0x75, 0x4d, 0x9f, 0x87, 0x3a, 0xce, 0x95, 0x57, 0xcb, 0xfa, 0x0f, 0x35, 0x18,
+	0x57, 0xa5, 0x9e, 0xca, 0x4b, 0x21, 0x27, 0xab, 0x55, 0xbf, 0xd0, 0x99, 0x90, 0x03, 0xbc, 0x4e,
+	0x01, 0x5e, 0x46, 0x97, 0x44, 0x80, 0x22, 0x65, 0xe9, 0x31, 0xb7, 0xc0, 0x77, 0x4b, 0x4d, 0xf6,
+	0x60, 0x80, 0xbe, 0xae, 0xc1, 0x68, 0x32, 0x17, 0x55, 0x36, 0x41, 0x32, 0xd2, 0x5b, 0xf5, 0x33,
+	0xf9, 0x44, 0x1c, 0xd3, 0x15, 0x8a, 0xe9, 0x3c, 0x3a, 0x9b, 0x9a, 0x6a, 0xac, 0x82, 0xf3, 0x97,
+	0x5a, 0x9c, 0x4f, 0x9b, 0x54, 0x3c, 0x97, 0x54, 0x1d, 0x66, 0x28, 0xa0, 0xf9, 0xae, 0x68, 0x39,
+	0xc6, 0xa7, 0x28, 0xc6, 0x12, 0xba, 0x22, 0x62, 0x4c, 0x10, 0x2b, 0xb0, 0xfe, 0xb5, 0x06, 0x7a,
+	0x76, 0x82, 0x12, 0xba, 0x22, 0x9b, 0x92, 0x1d, 0x12, 0xa1, 0xf4, 0x85, 0x6e, 0xc9, 0x39, 0xe8,
+	0xab, 0x14, 0xf4, 0x25, 0x74, 0x41, 0x04, 0xed, 0x7a, 0x56, 0xa5, 0x8e, 0x4b, 0x82, 0xab, 0x21,
+	0xc6, 0x8d, 0x9a, 0x30, 0x2c, 0xe4, 0xe0, 0xca, 0xe6, 0x4a, 0x3a, 0x65, 0x57, 0x9f, 0xc9, 0xac,
+	0xe7, 0x08, 0x66, 0x29, 0x02, 0x1d, 0x15, 0x54, 0x53, 0xbb, 0x49, 0xba, 0x70, 0x61, 0x28, 0xce,
+	0x2f, 0x4d, 0x1f, 0x47, 0x62, 0x6f, 0xd3, 0x19, 0xb5, 0x79, 0x06, 0x75, 0xd8, 0x57, 0xd3, 0x75,
+	0x69, 0x3a, 0x2a, 0x3d, 0xaf, 0x8e, 0x88, 0x09, 0x48, 0xf2, 0x81, 0xac, 0xc8, 0x63, 0xd2, 0x67,
+	0xb3, 0x09, 0x78, 0xd7, 0x4f, 0xd2, 0xae, 0x17, 0xd0, 0x65, 0xd9, 0x7e, 0x48, 0x64, 0xd5, 0x94,
+	0x58, 0xa6, 0x4f, 0xe0, 0xb2, 0x74, 0x22, 0xf4, 0x7d, 0x0d, 0x50, 0x3a, 0xf7, 0x08, 0x9d, 0x95,
+	0x1f, 0x21, 0x33, 0xf2, 0x99, 0xf4, 0x73, 0x9d, 0xc8, 0x38, 0xb6, 0xe7, 0x29, 0xb6, 0xa7, 0xd0,
+	0x13, 0xf9, 0xd8, 0x28, 0x24, 0x82, 0x8d, 0x81, 0xe4, 0x37, 0x2e, 0x22, 0x2c, 0xb1, 0x6d, 0x59,
+	0x58, 0x8a, 0x94, 0x24, 0x7d, 0x36, 0x9b, 0x60, 0x6f, 0xc2, 0x92, 0x01, 0x91, 0x1b, 0xc8, 0xb1,
+	0x44, 0x14, 0x82, 0x6c, 0x66, 0xa8, 0x83, 0x21, 0xf4, 0xb9, 0x5c, 0x9a, 0xbc, 0x4b, 0x10, 0x13,
+	0x84, 0x10, 0xe2, 0xf0, 0x47, 0xe1, 0xfd, 0x3b, 0xfd, 0xee, 0x7b, 0x31, 0xb5, 0x34, 0xb3, 0x1e,
+	0xc6, 0xf5, 0x4b, 0xdd, 0x90, 0xe6, 0x69, 0x46, 0xfa, 0x82, 0x6c, 0xf2, 0xd4, 0x0a, 0xf1, 0x29,
+	0x1b, 0xfd, 0x85, 0x46, 0x7e, 0x34, 0x40, 0x9d, 0x74, 0x81, 0x12, 0xea, 0x2e, 0x37, 0x5b, 0x44,
+	0xbf, 0xdc, 0x1d, 0x31, 0x87, 0x59, 0xa2, 0x30, 0x2f, 0xa2, 0xf3, 0x69, 0x98, 0x2d, 0x47, 0x05,
+	0xf4, 0x23, 0x0d, 0x4e, 0x65, 0x24, 0x7e, 0xc9, 0x2a, 0x3c, 0x3f, 0xd9, 0x4c, 0x9f, 0xef, 0x8a,
+	0x96, 0xa3, 0x7c, 0x89, 0xa2, 0x7c, 0x16, 0x3d, 0x23, 0xa2, 0x94, 0x72, 0x85, 0x4a, 0x91, 0x33,
+	0xab, 0xf4, 0x38, 0xe5, 0xf0, 0xda, 0x45, 0xff, 0xa8, 0xc1, 0x54, 0x5e, 0x9a, 0x17, 0x2a, 0x65,
+	0xc3, 0x51, 0x66, 0x98, 0xe9, 0x57, 0xbb, 0x67, 0xc8, 0xbb, 0xf6, 0xc9, 0x83, 0x08, 0x4d, 0x8c,
+	0xd2, 0xe3, 0x44, 0x82, 0xd5, 0x2e, 0xfa, 0x27, 0x9a, 0xed, 0x98, 0x95, 0xc8, 0x25, 0x1f, 0x47,
+	0x1d, 0x13, 0xc9, 0xf4, 0x85, 0x6e, 0xc9, 0x39, 0xf6, 0x65, 0x8a, 0xfd, 0x25, 0x74, 0x23, 0x1b,
+	0xbb, 0xe8, 0x1d, 0x2c, 0x3d, 0x56, 0xf9, 0x11, 0x77, 0x51, 0x40, 0x54, 0x52, 0xdc, 0x59, 0x52,
+	0x25, 0xa5, 0x52, 0xc5, 0xf4, 0xd9, 0x6c, 0x02, 0x8e, 0xec, 0x34, 0x45, 0x36, 0x89, 0x26, 0x32,
+	0x91, 0xa1, 0x0f, 0xf9, 0x49, 0x9e, 0x91, 0xcd, 0x92, 0x3a, 0xc9, 0x73, 0x73, 0x88, 0xf4, 0x85,
+	0x6e, 0xc9, 0xf3, 0x2c, 0xf8, 0xdc, 0x74, 0x1d, 0xf4, 0x27, 0x1a, 0x9c, 0xca, 0xc8, 0xf9, 0x91,
+	0xf7, 0x58, 0x7e, 0xee, 0x90, 0x3e, 0xdf, 0x15, 0x6d, 0x9e, 0xc2, 0xca, 0x4c, 0xf3, 0x21, 0x27,
+	0x60, 0x21, 0x2b, 0x9d, 0x45, 0x56, 0x58, 0x1d, 0xf2, 0x70, 0xf4, 0xcb, 0xdd, 0x11, 0x73, 0x98,
+	0x17, 0x29, 0xcc, 0x39, 0x74, 0x3a, 0xa1, 0xb0, 0x5a, 0x82, 0x9e, 0x62, 0x27, 0x12, 0xfa, 0xae,
+	0x06, 0x27, 0xd5, 0xb9, 0x23, 0xb2, 0xd2, 0xcf, 0x4d, 0x78, 0xd1, 0x2f, 0x75, 0x43, 0xca, 0xc1,
+	0x9d, 0xa7, 0xe0, 0x4e, 0xa3, 0x19, 0x11, 0xdc, 0x16, 0xae, 0xa7, 0xa0, 0xbd, 0xa7, 0xc1, 0x68,
+	0x32, 0x51, 0x43, 0xb6, 0xcb, 0x33, 0x32, 0x4a, 0xf4, 0x33, 0xf9, 0x44, 0x1c, 0xc8, 0x39, 0x0a,
+	0x64, 0x16, 0x15, 0x33, 0xae, 0x8d, 0x9c, 0x0f, 0x7d, 0x20, 0xe4, 0xae, 0xe4, 0x1a, 0xe4, 0xf9,
+	0xb9, 0x1a, 0xfa, 0x7c, 0x57, 0xb4, 0x1c, 0xdc, 0x02, 0x05, 0x77, 0x01, 0x9d, 0xcb, 0x77, 0xd9,
+	0x48, 0x20, 0x33, 0xf2, 0x0c, 0x64, 0x90, 0xf9, 0x39, 0x11, 0xfa, 0x7c, 0x57, 0xb4, 0x7b, 0x03,
+	0xc9, 0x93, 0x2a, 0xaa, 0xe8, 0x77, 0xa2, 0x30, 0x72, 0x29, 0x78, 0x1f, 0x9d, 0xcb, 0x0f, 0xd0,
+	0x8f, 0xc0, 0x9d, 0xef, 0x48, 0x97, 0xb7, 0x01, 0x36, 0x28, 0x03, 0xb1, 0x92, 0x4d, 0x21, 0xd4,
+	0xff, 0x7d, 0x0d, 0x8e, 0xa7, 0xc2, 0xf0, 0x65, 0x27, 0x78, 0x56, 0x04, 0xbf, 0x7e, 0xb6, 0x03,
+	0x55, 0xde, 0x42, 0x8b, 0x74, 0xc5, 0x46, 0xd4, 0xe9, 0x2f, 0xc3, 0x88, 0x1c, 0xbc, 0x2f, 0x7b,
+	0x01, 0x95, 0x11, 0xff, 0xba, 0x91, 0x47, 0x92, 0xe7, 0xe8, 0x6a, 0x50, 0x5a, 0x33, 0x8c, 0xf1,
+	0x47, 0xbf, 0x45, 0x04, 0x91, 0x8c, 0x74, 0x4f, 0x08, 0x22, 0x23, 0x74, 0x5e, 0x3f, 0xdb, 0x81,
+	0x2a, 0x6f, 0xeb, 0x93, 0x4d, 0xbf, 0xc1, 0xe8, 0x4d, 0x1e, 0x1f, 0x4f, 0xee, 0xc0, 0x13, 0x99,
+	0x01, 0xe7, 0x28, 0xcb, 0x7d, 0xa5, 0x0c, 0xa3, 0xd7, 0xaf, 0x74, 0x49, 0x9d, 0x67, 0xec, 0x89,
+	0xde, 0xae, 0x8d, 0x76, 0x98, 0x8c, 0xe9, 0x51, 0x34, 0x01, 0x1c, 0x11, 0x83, 0xca, 0x91, 0xe2,
+	0x5d, 0x52, 0x8a, 0x42, 0xd7, 0x67, 0xb3, 0x09, 0xf2, 0xce, 0x6b, 0xbe, 0x7c, 0x59, 0xe8, 0x39,
+	0xaa, 0x03, 0xc4, 0x51, 0xe6, 0x48, 0x19, 0x46, 0x1e, 0x85, 0xa4, 0xeb, 0xc5, 0xac, 0xea, 0x3c,
+	0x87, 0xab, 0x47, 0xe9, 0xcc, 0x2a, 0x69, 0x9f, 0x1e, 0xb6, 0xea, 0xc0, 0xef, 0xc4, 0x61, 0x9b,
+	0x1b, 0x3f, 0xae, 0xcf, 0x77, 0x45, 0x9b, 0x77, 0xd8, 0x86, 0xbf, 0x26, 0x11, 0x91, 0x47, 0xbe,
+	0x88, 0x26, 0x0c, 0x0b, 0xd1, 0xd2, 0xf2, 0xdd, 0x3e, 0x1d, 0x5c, 0xad, 0xcf, 0x64, 0xd6, 0xe7,
+	0xdd, 0xed, 0x99, 0x73, 0x9f, 0x85, 0x54, 0xd3, 0x55, 0x9a, 0x19, 0xd3, 0x2c, 0xaf, 0xd2, 0x4e,
+	0xa1, 0xd7, 0xfa, 0x95, 0x2e, 0xa9, 0xf3, 0x56, 0xa9, 0x64, 0x4f, 0xb2, 0xfb, 0x49, 0x18, 0x51,
+	0x4d, 0x2f, 0x77, 0xea, 0x00, 0x62, 0xf9, 0x9c, 0xcf, 0x8d, 0x7a, 0xd6, 0x2f, 0x75, 0x43, 0x9a,
+	0x37, 0x7d, 0x99, 0x11, 0xca, 0xe8, 0x6f, 0x85, 0x25, 0x96, 0x08, 0x8f, 0x54, 0x2f, 0x31, 0x75,
+	0xdc, 0xb0, 0x3e, 0xdf, 0x15, 0x2d, 0xc7, 0xb8, 0x48, 0x31, 0xbe, 0x80, 0x9e, 0x93, 0xec, 0x39,
+	0xc6, 0x64, 0xa6, 0x83, 0x3c, 0x95, 0xd7, 0xa6, 0x0f, 0x35, 0x38, 0xa1, 0x0c, 0xab, 0x45, 0x92,
+	0xdb, 0x32, 0x2f, 0x86, 0x57, 0xbf, 0xd8, 0x05, 0x25, 0x87, 0xfc, 0x32, 0x85, 0xfc, 0x1c, 0xfa,
+	0x82, 0xb4, 0x2b, 0x28, 0xd4, 0x8d, 0xb6, 0x99, 0x8c, 0x04, 0x2e, 0x3d, 0x4e, 0x96, 0xec, 0xd2,
+	0x65, 0x9b, 0x1d, 0xfe, 0x7a, 0xb9, 0xab, 0xa0, 0x5a, 0xe5, 0xb2, 0xed, 0x18, 0xc4, 0xab, 0x5e,
+	0xb6, 0x95, 0x98, 0x2d, 0xc6, 0xcf, 0x13, 0x90, 0x89, 0xc3, 0x2e, 0x8e, 0xe5, 0x44, 0xc5, 0x8c,
+	0x50, 0x50, 0xb5, 0xc3, 0x2e, 0x1d, 0x14, 0x9b, 0xe1, 0xb0, 0x63, 0x9a, 0x95, 0x06, 0xbf, 0xa2,
+	0x6f, 0x6a, 0xd2, 0x8f, 0xa1, 0x86, 0x51, 0x7d, 0xb2, 0x8d, 0x92, 0x1d, 0x1f, 0xa9, 0x9f, 0xcb,
+	0x78, 0x95, 0x4b, 0x44, 0x07, 0x1a, 0x17, 0x28, 0x12, 0x03, 0xcd, 0x66, 0x9d, 0x33, 0x51, 0xac,
+	0xe0, 0x7b, 0x5a, 0xf4, 0x13, 0xa5, 0x11, 0x1a, 0x55, 0x5a, 0xf2, 0x7e, 0x91, 0x74, 0xb0, 0x83,
+	0x23, 0x1c, 0xdf, 0xd6, 0x92, 0x3f, 0xba, 0x19, 0xc1, 0xc9, 0xc9, 0xf7, 0xed, 0xa9, 0x7c, 0xc4,
+	0xa7, 0x86, 0x08, 0xd7, 0x77, 0x34, 0x18, 0x57, 0xc5, 0x2b, 0xca, 0xef, 0x0b, 0x39, 0x11, 0x8f,
+	0xfa, 0x85, 0xce, 0x84, 0x79, 0x0f, 0x76, 0xc2, 0xb5, 0x8f, 0x10, 0xfb, 0x25, 0x8b, 0x36, 0x41,
+	0x4f, 0xcd, 0xac, 0x38, 0xc1, 0x4b, 0x5d, 0x84, 0xdd, 0x28, 0x55, 0x5a, 0x87, 0x38, 0xc7, 0x8c,
+	0xd7, 0x06, 0xb6, 0xc2, 0x63, 0xed, 0x15, 0x45, 0xf2, 0xfc, 0xab, 0x06, 0x27, 0x94, 0xb1, 0x6a,
+	0x48, 0xf5, 0xfe, 0xa6, 0x0c, 0xb3, 0xd3, 0x2f, 0x76, 0x41, 0xc9, 0xd1, 0xbd, 0x45, 0xd1, 0xad,
+	0xa1, 0xd7, 0xf6, 0xf5, 0x78, 0x4e, 0x43, 0xd6, 0xfc, 0xd2, 0x63, 0x45, 0x30, 0xde, 0x2e, 0xfa,
+	0x73, 0x4d, 0x1d, 0xdd, 0x75, 0xae, 0x43, 0x9c, 0x58, 0xce, 0xe5, 0x42, 0x19, 0x8b, 0x66, 0xdc,
+	0xa0, 0x63, 0x78, 0x06, 0x3d, 0x95, 0x2b, 0x61, 0xdb, 0xd9, 0x74, 0x55, 0xe7, 0xc5, 0xe2, 0xc3,
+	0x9f, 0x7c, 0x5c, 0xd4, 0x7e, 0xfa, 0x71, 0x51, 0xfb, 0xdf, 0x8f, 0x8b, 0xda, 0xb7, 0x3e, 0x29,
+	0x1e, 0xf8, 0xe9, 0x27, 0xc5, 0x03, 0xff, 0xf9, 0x49, 0xf1, 0xc0, 0x17, 0x9f, 0x17, 0x82, 0xb5,
+	0x9b, 0xb8, 0x56, 0x6b, 0xbf, 0xbd, 0x1d, 0x76, 0x71, 0x85, 0xb5, 0xcf, 0x8d, 0xf6, 0xd2, 0xf6,
+	0xf5, 0xd2, 0x4e, 0xd4, 0x3b, 0x8d, 0xe2, 0xde, 0x18, 0xa0, 0xbf, 0xbc, 0xfe, 0xc4, 0xcf, 0x07,
+	0x00, 0xfa, 0xec, 0x3c, 0x9a, 0x89, 0x5e, 0x00, 0x00,
 }
 
-func (m *DenomToERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Erc20)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.CosmosOriginated {
-		n += 2
-	}
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Module parameters query
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// get info on individual outgoing data
+	SignerSetTx(ctx context.Context, in *SignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error)
+	LatestSignerSetTx(ctx context.Context, in *LatestSignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error)
+	// the signers added, removed and changing power in a signer set compared to
+	// the previous one, the latest one with a zero nonce
+	SignerSetTxDelta(ctx context.Context, in *SignerSetTxDeltaRequest, opts ...grpc.CallOption) (*SignerSetTxDeltaResponse, error)
+	BatchTx(ctx context.Context, in *BatchTxRequest, opts ...grpc.CallOption) (*BatchTxResponse, error)
+	ContractCallTx(ctx context.Context, in *ContractCallTxRequest, opts ...grpc.CallOption) (*ContractCallTxResponse, error)
+	// get the checkpoints validators sign for outgoing txs
+	SignerSetTxCheckpoint(ctx context.Context, in *SignerSetTxRequest, opts ...grpc.CallOption) (*OutgoingTxCheckpointResponse, error)
+	BatchTxCheckpoint(ctx context.Context, in *BatchTxRequest, opts ...grpc.CallOption) (*OutgoingTxCheckpointResponse, error)
+	ContractCallTxCheckpoint(ctx context.Context, in *ContractCallTxRequest, opts ...grpc.CallOption) (*OutgoingTxCheckpointResponse, error)
+	// get collections of outgoing traffic from the bridge
+	SignerSetTxs(ctx context.Context, in *SignerSetTxsRequest, opts ...grpc.CallOption) (*SignerSetTxsResponse, error)
+	BatchTxs(ctx context.Context, in *BatchTxsRequest, opts ...grpc.CallOption) (*BatchTxsResponse, error)
+	ContractCallTxs(ctx context.Context, in *ContractCallTxsRequest, opts ...grpc.CallOption) (*ContractCallTxsResponse, error)
+	// TODO: can/should we group these into one endpoint?
+	SignerSetTxConfirmations(ctx context.Context, in *SignerSetTxConfirmationsRequest, opts ...grpc.CallOption) (*SignerSetTxConfirmationsResponse, error)
+	BatchTxConfirmations(ctx context.Context, in *BatchTxConfirmationsRequest, opts ...grpc.CallOption) (*BatchTxConfirmationsResponse, error)
+	// BatchTxConfirmationProgress returns the power that has signed a batch tx
+	// against the threshold required by the bridge contract, using the last
+	// observed signer set
+	BatchTxConfirmationProgress(ctx context.Context, in *BatchTxConfirmationProgressRequest, opts ...grpc.CallOption) (*BatchTxConfirmationProgressResponse, error)
+	ContractCallTxConfirmations(ctx context.Context, in *ContractCallTxConfirmationsRequest, opts ...grpc.CallOption) (*ContractCallTxConfirmationsResponse, error)
+	// pending ethereum signature queries for orchestrators to figure out which
+	// signatures they are missing
+	// TODO: can/should we group this into one endpoint?
+	UnsignedSignerSetTxs(ctx context.Context, in *UnsignedSignerSetTxsRequest, opts ...grpc.CallOption) (*UnsignedSignerSetTxsResponse, error)
+	UnsignedBatchTxs(ctx context.Context, in *UnsignedBatchTxsRequest, opts ...grpc.CallOption) (*UnsignedBatchTxsResponse, error)
+	UnsignedContractCallTxs(ctx context.Context, in *UnsignedContractCallTxsRequest, opts ...grpc.CallOption) (*UnsignedContractCallTxsResponse, error)
+	LastSubmittedEthereumEvent(ctx context.Context, in *LastSubmittedEthereumEventRequest, opts ...grpc.CallOption) (*LastSubmittedEthereumEventResponse, error)
+	// Queries the fees for all pending batches, results are returned in sdk.Coin
+	// (fee_amount_int)(contract_address) style
+	BatchTxFees(ctx context.Context, in *BatchTxFeesRequest, opts ...grpc.CallOption) (*BatchTxFeesResponse, error)
+	// Queries the fees of the unbatched txs of every token with unbatched txs,
+	// so that relayers can pick the token to request a batch for in one call
+	BatchFees(ctx context.Context, in *BatchFeesRequest, opts ...grpc.CallOption) (*BatchFeesResponse, error)
+	// Query for info about denoms tracked by gravity
+	ERC20ToDenom(ctx context.Context, in *ERC20ToDenomRequest, opts ...grpc.CallOption) (*ERC20ToDenomResponse, error)
+	// DenomToERC20Params implements a query that allows ERC-20 parameter
+	// information to be retrieved by a Cosmos base denomination.
+	DenomToERC20Params(ctx context.Context, in *DenomToERC20ParamsRequest, opts ...grpc.CallOption) (*DenomToERC20ParamsResponse, error)
+	// Query for info about denoms tracked by gravity
+	DenomToERC20(ctx context.Context, in *DenomToERC20Request, opts ...grpc.CallOption) (*DenomToERC20Response, error)
+	// Query for the factor amounts of an ERC20 are scaled by when converted to
+	// its Cosmos denom
+	ERC20Conversion(ctx context.Context, in *ERC20ConversionRequest, opts ...grpc.CallOption) (*ERC20ConversionResponse, error)
+	// Query for batch send to ethereums
+	BatchedSendToEthereums(ctx context.Context, in *BatchedSendToEthereumsRequest, opts ...grpc.CallOption) (*BatchedSendToEthereumsResponse, error)
+	// Query for unbatched send to ethereums
+	UnbatchedSendToEthereums(ctx context.Context, in *UnbatchedSendToEthereumsRequest, opts ...grpc.CallOption) (*UnbatchedSendToEthereumsResponse, error)
+	// delegate keys
+	DelegateKeysByValidator(ctx context.Context, in *DelegateKeysByValidatorRequest, opts ...grpc.CallOption) (*DelegateKeysByValidatorResponse, error)
+	DelegateKeysByEthereumSigner(ctx context.Context, in *DelegateKeysByEthereumSignerRequest, opts ...grpc.CallOption) (*DelegateKeysByEthereumSignerResponse, error)
+	DelegateKeysByOrchestrator(ctx context.Context, in *DelegateKeysByOrchestratorRequest, opts ...grpc.CallOption) (*DelegateKeysByOrchestratorResponse, error)
+	DelegateKeys(ctx context.Context, in *DelegateKeysRequest, opts ...grpc.CallOption) (*DelegateKeysResponse, error)
+	LastObservedEthereumHeight(ctx context.Context, in *LastObservedEthereumHeightRequest, opts ...grpc.CallOption) (*LastObservedEthereumHeightResponse, error)
+	// Query for the ethereum height projected from the last observed heights and
+	// the validators' height votes, and the timeout a batch created now would get
+	ProjectedEthereumHeight(ctx context.Context, in *ProjectedEthereumHeightRequest, opts ...grpc.CallOption) (*ProjectedEthereumHeightResponse, error)
+	// Query for deposits waiting on a mint rate limit, optionally filtered by
+	// token contract
+	QueuedSendToCosmosEvents(ctx context.Context, in *QueuedSendToCosmosEventsRequest, opts ...grpc.CallOption) (*QueuedSendToCosmosEventsResponse, error)
+	// Query for deposits of tokens off the allowlist held until governance
+	// releases them, optionally filtered by token contract
+	HeldSendToCosmosEvents(ctx context.Context, in *HeldSendToCosmosEventsRequest, opts ...grpc.CallOption) (*HeldSendToCosmosEventsResponse, error)
+	// Query for the archived records of executed batches in execution order,
+	// optionally filtered by token contract
+	ExecutedBatchTxs(ctx context.Context, in *ExecutedBatchTxsRequest, opts ...grpc.CallOption) (*ExecutedBatchTxsResponse, error)
+	// Query for the archived records of executed contract calls in execution
+	// order, optionally filtered by logic contract
+	ExecutedContractCallTxs(ctx context.Context, in *ExecutedContractCallTxsRequest, opts ...grpc.CallOption) (*ExecutedContractCallTxsResponse, error)
+	// Query for the archived records of the expired or invalidated contract
+	// calls whose escrow was refunded, optionally to a single refundee
+	RefundedContractCallTxs(ctx context.Context, in *RefundedContractCallTxsRequest, opts ...grpc.CallOption) (*RefundedContractCallTxsResponse, error)
+	// Query for the bridge fee allowances, optionally of a granter or to a
+	// grantee
+	BridgeFeeAllowances(ctx context.Context, in *BridgeFeeAllowancesRequest, opts ...grpc.CallOption) (*BridgeFeeAllowancesResponse, error)
+	// Query for the Ethereum addresses on the blocklist
+	EthereumBlocklist(ctx context.Context, in *EthereumBlocklistRequest, opts ...grpc.CallOption) (*EthereumBlocklistResponse, error)
+	// Query for the module accounts used by the bridge with their balances
+	ModuleAccounts(ctx context.Context, in *ModuleAccountsRequest, opts ...grpc.CallOption) (*ModuleAccountsResponse, error)
+	// Query for the most recent decisions taken by the module while processing
+	// blocks, newest first
+	EndBlockerActions(ctx context.Context, in *EndBlockerActionsRequest, opts ...grpc.CallOption) (*EndBlockerActionsResponse, error)
+	// Query for the signer sets active on Ethereum within a range of Ethereum
+	// block heights, oldest first, starting with the one active at the start
+	SignerSetTxsByHeightRange(ctx context.Context, in *SignerSetTxsByHeightRangeRequest, opts ...grpc.CallOption) (*SignerSetTxsByHeightRangeResponse, error)
+	// Query for the bridge configuration (params, delegate keys, token mappings
+	// and latest signer set) as a genesis state to bootstrap a new environment
+	BridgeConfig(ctx context.Context, in *BridgeConfigRequest, opts ...grpc.CallOption) (*BridgeConfigResponse, error)
+	// Query for the differences between the bridge state rebuilt from the log
+	// of observed ethereum events and the live bridge state
+	ReplayDiff(ctx context.Context, in *ReplayDiffRequest, opts ...grpc.CallOption) (*ReplayDiffResponse, error)
+	// Query for the observed ERC20 deployments awaiting a
+	// MsgERC20DeployedConfirm
+	PendingERC20Deployments(ctx context.Context, in *PendingERC20DeploymentsRequest, opts ...grpc.CallOption) (*PendingERC20DeploymentsResponse, error)
+	// Query for the tokens whose sends to Ethereum are paused on an attested
+	// Ethereum-side anomaly
+	TokenPauses(ctx context.Context, in *TokenPausesRequest, opts ...grpc.CallOption) (*TokenPausesResponse, error)
+	// Query for the unexpired orchestrator query identity of an API key hash
+	OrchestratorQueryIdentity(ctx context.Context, in *OrchestratorQueryIdentityRequest, opts ...grpc.CallOption) (*OrchestratorQueryIdentityResponse, error)
+	// Query for the sends to Ethereum held in the delayed send queue, optionally
+	// of a single sender
+	DelayedSendToEthereums(ctx context.Context, in *DelayedSendToEthereumsRequest, opts ...grpc.CallOption) (*DelayedSendToEthereumsResponse, error)
+	// Query for the ethereum event vote records above a validator's last event
+	// nonce that it has not voted on yet
+	PendingEventVoteRecords(ctx context.Context, in *PendingEventVoteRecordsRequest, opts ...grpc.CallOption) (*PendingEventVoteRecordsResponse, error)
+	// Query for the ethereum event vote records of the events emitted by an
+	// ethereum transaction, observed or still being voted on
+	EventByEthereumTxHash(ctx context.Context, in *EventByEthereumTxHashRequest, opts ...grpc.CallOption) (*EventByEthereumTxHashResponse, error)
+	// Query for the nonces with vote records of more than one ethereum event,
+	// which means the orchestrators disagree on the ethereum events
+	ConflictingEthereumEvents(ctx context.Context, in *ConflictingEthereumEventsRequest, opts ...grpc.CallOption) (*ConflictingEthereumEventsResponse, error)
+	// Query for the usage of the bridge by tokens over the last day and week
+	BridgeStats(ctx context.Context, in *BridgeStatsRequest, opts ...grpc.CallOption) (*BridgeStatsResponse, error)
+	// Query for the calldata of the gravity contract call submitting a signer
+	// set tx, a batch tx or a contract call tx signed by enough of the last
+	// signer set observed on ethereum
+	SignerSetTxCalldata(ctx context.Context, in *SignerSetTxCalldataRequest, opts ...grpc.CallOption) (*OutgoingTxCalldataResponse, error)
+	BatchTxCalldata(ctx context.Context, in *BatchTxCalldataRequest, opts ...grpc.CallOption) (*OutgoingTxCalldataResponse, error)
+	ContractCallTxCalldata(ctx context.Context, in *ContractCallTxCalldataRequest, opts ...grpc.CallOption) (*OutgoingTxCalldataResponse, error)
+	// Query for the ethereum header agreed on at the latest checkpoint, along
+	// with the latest header vote of each validator
+	AgreedEthereumHeader(ctx context.Context, in *AgreedEthereumHeaderRequest, opts ...grpc.CallOption) (*AgreedEthereumHeaderResponse, error)
+	// Query for how long ago each bonded validator last signed an outgoing tx
+	// and voted on an ethereum event
+	BridgeValidatorLiveness(ctx context.Context, in *BridgeValidatorLivenessRequest, opts ...grpc.CallOption) (*BridgeValidatorLivenessResponse, error)
+	// Query for a merkle proof that a send to ethereum is included in a batch
+	BatchTxInclusionProof(ctx context.Context, in *BatchTxInclusionProofRequest, opts ...grpc.CallOption) (*BatchTxInclusionProofResponse, error)
+	// Query for the delegate keys, liveness and missed signatures of a
+	// validator
+	BridgeValidatorInfo(ctx context.Context, in *BridgeValidatorInfoRequest, opts ...grpc.CallOption) (*BridgeValidatorInfoResponse, error)
 }
 
-func (m *DelegateKeysByValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *DelegateKeysByValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EthAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.OrchestratorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *DelegateKeysByEthereumSignerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EthereumSigner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *DelegateKeysByEthereumSignerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) SignerSetTx(ctx context.Context, in *SignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error) {
+	out := new(SignerSetTxResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) LatestSignerSetTx(ctx context.Context, in *LatestSignerSetTxRequest, opts ...grpc.CallOption) (*SignerSetTxResponse, error) {
+	out := new(SignerSetTxResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LatestSignerSetTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.OrchestratorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) SignerSetTxDelta(ctx context.Context, in *SignerSetTxDeltaRequest, opts ...grpc.CallOption) (*SignerSetTxDeltaResponse, error) {
+	out := new(SignerSetTxDeltaResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetTxDelta", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *DelegateKeysByOrchestratorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) BatchTx(ctx context.Context, in *BatchTxRequest, opts ...grpc.CallOption) (*BatchTxResponse, error) {
+	out := new(BatchTxResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.OrchestratorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ContractCallTx(ctx context.Context, in *ContractCallTxRequest, opts ...grpc.CallOption) (*ContractCallTxResponse, error) {
+	out := new(ContractCallTxResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ContractCallTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *DelegateKeysByOrchestratorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) SignerSetTxCheckpoint(ctx context.Context, in *SignerSetTxRequest, opts ...grpc.CallOption) (*OutgoingTxCheckpointResponse, error) {
+	out := new(OutgoingTxCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetTxCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) BatchTxCheckpoint(ctx context.Context, in *BatchTxRequest, opts ...grpc.CallOption) (*OutgoingTxCheckpointResponse, error) {
+	out := new(OutgoingTxCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTxCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.EthereumSigner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ContractCallTxCheckpoint(ctx context.Context, in *ContractCallTxRequest, opts ...grpc.CallOption) (*OutgoingTxCheckpointResponse, error) {
+	out := new(OutgoingTxCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ContractCallTxCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *DelegateKeysRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) SignerSetTxs(ctx context.Context, in *SignerSetTxsRequest, opts ...grpc.CallOption) (*SignerSetTxsResponse, error) {
+	out := new(SignerSetTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *DelegateKeysResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) BatchTxs(ctx context.Context, in *BatchTxsRequest, opts ...grpc.CallOption) (*BatchTxsResponse, error) {
+	out := new(BatchTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.DelegateKeys) > 0 {
-		for _, e := range m.DelegateKeys {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) ContractCallTxs(ctx context.Context, in *ContractCallTxsRequest, opts ...grpc.CallOption) (*ContractCallTxsResponse, error) {
+	out := new(ContractCallTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ContractCallTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *BatchedSendToEthereumsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) SignerSetTxConfirmations(ctx context.Context, in *SignerSetTxConfirmationsRequest, opts ...grpc.CallOption) (*SignerSetTxConfirmationsResponse, error) {
+	out := new(SignerSetTxConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetTxConfirmations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.SenderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) BatchTxConfirmations(ctx context.Context, in *BatchTxConfirmationsRequest, opts ...grpc.CallOption) (*BatchTxConfirmationsResponse, error) {
+	out := new(BatchTxConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTxConfirmations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *BatchedSendToEthereumsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) BatchTxConfirmationProgress(ctx context.Context, in *BatchTxConfirmationProgressRequest, opts ...grpc.CallOption) (*BatchTxConfirmationProgressResponse, error) {
+	out := new(BatchTxConfirmationProgressResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTxConfirmationProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.SendToEthereums) > 0 {
-		for _, e := range m.SendToEthereums {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ContractCallTxConfirmations(ctx context.Context, in *ContractCallTxConfirmationsRequest, opts ...grpc.CallOption) (*ContractCallTxConfirmationsResponse, error) {
+	out := new(ContractCallTxConfirmationsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ContractCallTxConfirmations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnsignedSignerSetTxs(ctx context.Context, in *UnsignedSignerSetTxsRequest, opts ...grpc.CallOption) (*UnsignedSignerSetTxsResponse, error) {
+	out := new(UnsignedSignerSetTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/UnsignedSignerSetTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnsignedBatchTxs(ctx context.Context, in *UnsignedBatchTxsRequest, opts ...grpc.CallOption) (*UnsignedBatchTxsResponse, error) {
+	out := new(UnsignedBatchTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/UnsignedBatchTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnsignedContractCallTxs(ctx context.Context, in *UnsignedContractCallTxsRequest, opts ...grpc.CallOption) (*UnsignedContractCallTxsResponse, error) {
+	out := new(UnsignedContractCallTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/UnsignedContractCallTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LastSubmittedEthereumEvent(ctx context.Context, in *LastSubmittedEthereumEventRequest, opts ...grpc.CallOption) (*LastSubmittedEthereumEventResponse, error) {
+	out := new(LastSubmittedEthereumEventResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastSubmittedEthereumEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BatchTxFees(ctx context.Context, in *BatchTxFeesRequest, opts ...grpc.CallOption) (*BatchTxFeesResponse, error) {
+	out := new(BatchTxFeesResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTxFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BatchFees(ctx context.Context, in *BatchFeesRequest, opts ...grpc.CallOption) (*BatchFeesResponse, error) {
+	out := new(BatchFeesResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ERC20ToDenom(ctx context.Context, in *ERC20ToDenomRequest, opts ...grpc.CallOption) (*ERC20ToDenomResponse, error) {
+	out := new(ERC20ToDenomResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ERC20ToDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomToERC20Params(ctx context.Context, in *DenomToERC20ParamsRequest, opts ...grpc.CallOption) (*DenomToERC20ParamsResponse, error) {
+	out := new(DenomToERC20ParamsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DenomToERC20Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomToERC20(ctx context.Context, in *DenomToERC20Request, opts ...grpc.CallOption) (*DenomToERC20Response, error) {
+	out := new(DenomToERC20Response)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DenomToERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ERC20Conversion(ctx context.Context, in *ERC20ConversionRequest, opts ...grpc.CallOption) (*ERC20ConversionResponse, error) {
+	out := new(ERC20ConversionResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ERC20Conversion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BatchedSendToEthereums(ctx context.Context, in *BatchedSendToEthereumsRequest, opts ...grpc.CallOption) (*BatchedSendToEthereumsResponse, error) {
+	out := new(BatchedSendToEthereumsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchedSendToEthereums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbatchedSendToEthereums(ctx context.Context, in *UnbatchedSendToEthereumsRequest, opts ...grpc.CallOption) (*UnbatchedSendToEthereumsResponse, error) {
+	out := new(UnbatchedSendToEthereumsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/UnbatchedSendToEthereums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegateKeysByValidator(ctx context.Context, in *DelegateKeysByValidatorRequest, opts ...grpc.CallOption) (*DelegateKeysByValidatorResponse, error) {
+	out := new(DelegateKeysByValidatorResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DelegateKeysByValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegateKeysByEthereumSigner(ctx context.Context, in *DelegateKeysByEthereumSignerRequest, opts ...grpc.CallOption) (*DelegateKeysByEthereumSignerResponse, error) {
+	out := new(DelegateKeysByEthereumSignerResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DelegateKeysByEthereumSigner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegateKeysByOrchestrator(ctx context.Context, in *DelegateKeysByOrchestratorRequest, opts ...grpc.CallOption) (*DelegateKeysByOrchestratorResponse, error) {
+	out := new(DelegateKeysByOrchestratorResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DelegateKeysByOrchestrator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegateKeys(ctx context.Context, in *DelegateKeysRequest, opts ...grpc.CallOption) (*DelegateKeysResponse, error) {
+	out := new(DelegateKeysResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DelegateKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LastObservedEthereumHeight(ctx context.Context, in *LastObservedEthereumHeightRequest, opts ...grpc.CallOption) (*LastObservedEthereumHeightResponse, error) {
+	out := new(LastObservedEthereumHeightResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastObservedEthereumHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedEthereumHeight(ctx context.Context, in *ProjectedEthereumHeightRequest, opts ...grpc.CallOption) (*ProjectedEthereumHeightResponse, error) {
+	out := new(ProjectedEthereumHeightResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ProjectedEthereumHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedSendToCosmosEvents(ctx context.Context, in *QueuedSendToCosmosEventsRequest, opts ...grpc.CallOption) (*QueuedSendToCosmosEventsResponse, error) {
+	out := new(QueuedSendToCosmosEventsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/QueuedSendToCosmosEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HeldSendToCosmosEvents(ctx context.Context, in *HeldSendToCosmosEventsRequest, opts ...grpc.CallOption) (*HeldSendToCosmosEventsResponse, error) {
+	out := new(HeldSendToCosmosEventsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/HeldSendToCosmosEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutedBatchTxs(ctx context.Context, in *ExecutedBatchTxsRequest, opts ...grpc.CallOption) (*ExecutedBatchTxsResponse, error) {
+	out := new(ExecutedBatchTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ExecutedBatchTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutedContractCallTxs(ctx context.Context, in *ExecutedContractCallTxsRequest, opts ...grpc.CallOption) (*ExecutedContractCallTxsResponse, error) {
+	out := new(ExecutedContractCallTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ExecutedContractCallTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RefundedContractCallTxs(ctx context.Context, in *RefundedContractCallTxsRequest, opts ...grpc.CallOption) (*RefundedContractCallTxsResponse, error) {
+	out := new(RefundedContractCallTxsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/RefundedContractCallTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeFeeAllowances(ctx context.Context, in *BridgeFeeAllowancesRequest, opts ...grpc.CallOption) (*BridgeFeeAllowancesResponse, error) {
+	out := new(BridgeFeeAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BridgeFeeAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EthereumBlocklist(ctx context.Context, in *EthereumBlocklistRequest, opts ...grpc.CallOption) (*EthereumBlocklistResponse, error) {
+	out := new(EthereumBlocklistResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/EthereumBlocklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleAccounts(ctx context.Context, in *ModuleAccountsRequest, opts ...grpc.CallOption) (*ModuleAccountsResponse, error) {
+	out := new(ModuleAccountsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ModuleAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EndBlockerActions(ctx context.Context, in *EndBlockerActionsRequest, opts ...grpc.CallOption) (*EndBlockerActionsResponse, error) {
+	out := new(EndBlockerActionsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/EndBlockerActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SignerSetTxsByHeightRange(ctx context.Context, in *SignerSetTxsByHeightRangeRequest, opts ...grpc.CallOption) (*SignerSetTxsByHeightRangeResponse, error) {
+	out := new(SignerSetTxsByHeightRangeResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetTxsByHeightRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeConfig(ctx context.Context, in *BridgeConfigRequest, opts ...grpc.CallOption) (*BridgeConfigResponse, error) {
+	out := new(BridgeConfigResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BridgeConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReplayDiff(ctx context.Context, in *ReplayDiffRequest, opts ...grpc.CallOption) (*ReplayDiffResponse, error) {
+	out := new(ReplayDiffResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ReplayDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingERC20Deployments(ctx context.Context, in *PendingERC20DeploymentsRequest, opts ...grpc.CallOption) (*PendingERC20DeploymentsResponse, error) {
+	out := new(PendingERC20DeploymentsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/PendingERC20Deployments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenPauses(ctx context.Context, in *TokenPausesRequest, opts ...grpc.CallOption) (*TokenPausesResponse, error) {
+	out := new(TokenPausesResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/TokenPauses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OrchestratorQueryIdentity(ctx context.Context, in *OrchestratorQueryIdentityRequest, opts ...grpc.CallOption) (*OrchestratorQueryIdentityResponse, error) {
+	out := new(OrchestratorQueryIdentityResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/OrchestratorQueryIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelayedSendToEthereums(ctx context.Context, in *DelayedSendToEthereumsRequest, opts ...grpc.CallOption) (*DelayedSendToEthereumsResponse, error) {
+	out := new(DelayedSendToEthereumsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DelayedSendToEthereums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingEventVoteRecords(ctx context.Context, in *PendingEventVoteRecordsRequest, opts ...grpc.CallOption) (*PendingEventVoteRecordsResponse, error) {
+	out := new(PendingEventVoteRecordsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/PendingEventVoteRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EventByEthereumTxHash(ctx context.Context, in *EventByEthereumTxHashRequest, opts ...grpc.CallOption) (*EventByEthereumTxHashResponse, error) {
+	out := new(EventByEthereumTxHashResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/EventByEthereumTxHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConflictingEthereumEvents(ctx context.Context, in *ConflictingEthereumEventsRequest, opts ...grpc.CallOption) (*ConflictingEthereumEventsResponse, error) {
+	out := new(ConflictingEthereumEventsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ConflictingEthereumEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeStats(ctx context.Context, in *BridgeStatsRequest, opts ...grpc.CallOption) (*BridgeStatsResponse, error) {
+	out := new(BridgeStatsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BridgeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SignerSetTxCalldata(ctx context.Context, in *SignerSetTxCalldataRequest, opts ...grpc.CallOption) (*OutgoingTxCalldataResponse, error) {
+	out := new(OutgoingTxCalldataResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/SignerSetTxCalldata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BatchTxCalldata(ctx context.Context, in *BatchTxCalldataRequest, opts ...grpc.CallOption) (*OutgoingTxCalldataResponse, error) {
+	out := new(OutgoingTxCalldataResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTxCalldata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractCallTxCalldata(ctx context.Context, in *ContractCallTxCalldataRequest, opts ...grpc.CallOption) (*OutgoingTxCalldataResponse, error) {
+	out := new(OutgoingTxCalldataResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ContractCallTxCalldata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AgreedEthereumHeader(ctx context.Context, in *AgreedEthereumHeaderRequest, opts ...grpc.CallOption) (*AgreedEthereumHeaderResponse, error) {
+	out := new(AgreedEthereumHeaderResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/AgreedEthereumHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeValidatorLiveness(ctx context.Context, in *BridgeValidatorLivenessRequest, opts ...grpc.CallOption) (*BridgeValidatorLivenessResponse, error) {
+	out := new(BridgeValidatorLivenessResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BridgeValidatorLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BatchTxInclusionProof(ctx context.Context, in *BatchTxInclusionProofRequest, opts ...grpc.CallOption) (*BatchTxInclusionProofResponse, error) {
+	out := new(BatchTxInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchTxInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeValidatorInfo(ctx context.Context, in *BridgeValidatorInfoRequest, opts ...grpc.CallOption) (*BridgeValidatorInfoResponse, error) {
+	out := new(BridgeValidatorInfoResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BridgeValidatorInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Module parameters query
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// get info on individual outgoing data
+	SignerSetTx(context.Context, *SignerSetTxRequest) (*SignerSetTxResponse, error)
+	LatestSignerSetTx(context.Context, *LatestSignerSetTxRequest) (*SignerSetTxResponse, error)
+	// the signers added, removed and changing power in a signer set compared to
+	// the previous one, the latest one with a zero nonce
+	SignerSetTxDelta(context.Context, *SignerSetTxDeltaRequest) (*SignerSetTxDeltaResponse, error)
+	BatchTx(context.Context, *BatchTxRequest) (*BatchTxResponse, error)
+	ContractCallTx(context.Context, *ContractCallTxRequest) (*ContractCallTxResponse, error)
+	// get the checkpoints validators sign for outgoing txs
+	SignerSetTxCheckpoint(context.Context, *SignerSetTxRequest) (*OutgoingTxCheckpointResponse, error)
+	BatchTxCheckpoint(context.Context, *BatchTxRequest) (*OutgoingTxCheckpointResponse, error)
+	ContractCallTxCheckpoint(context.Context, *ContractCallTxRequest) (*OutgoingTxCheckpointResponse, error)
+	// get collections of outgoing traffic from the bridge
+	SignerSetTxs(context.Context, *SignerSetTxsRequest) (*SignerSetTxsResponse, error)
+	BatchTxs(context.Context, *BatchTxsRequest) (*BatchTxsResponse, error)
+	ContractCallTxs(context.Context, *ContractCallTxsRequest) (*ContractCallTxsResponse, error)
+	// TODO: can/should we group these into one endpoint?
+	SignerSetTxConfirmations(context.Context, *SignerSetTxConfirmationsRequest) (*SignerSetTxConfirmationsResponse, error)
+	BatchTxConfirmations(context.Context, *BatchTxConfirmationsRequest) (*BatchTxConfirmationsResponse, error)
+	// BatchTxConfirmationProgress returns the power that has signed a batch tx
+	// against the threshold required by the bridge contract, using the last
+	// observed signer set
+	BatchTxConfirmationProgress(context.Context, *BatchTxConfirmationProgressRequest) (*BatchTxConfirmationProgressResponse, error)
+	ContractCallTxConfirmations(context.Context, *ContractCallTxConfirmationsRequest) (*ContractCallTxConfirmationsResponse, error)
+	// pending ethereum signature queries for orchestrators to figure out which
+	// signatures they are missing
+	// TODO: can/should we group this into one endpoint?
+	UnsignedSignerSetTxs(context.Context, *UnsignedSignerSetTxsRequest) (*UnsignedSignerSetTxsResponse, error)
+	UnsignedBatchTxs(context.Context, *UnsignedBatchTxsRequest) (*UnsignedBatchTxsResponse, error)
+	UnsignedContractCallTxs(context.Context, *UnsignedContractCallTxsRequest) (*UnsignedContractCallTxsResponse, error)
+	LastSubmittedEthereumEvent(context.Context, *LastSubmittedEthereumEventRequest) (*LastSubmittedEthereumEventResponse, error)
+	// Queries the fees for all pending batches, results are returned in sdk.Coin
+	// (fee_amount_int)(contract_address) style
+	BatchTxFees(context.Context, *BatchTxFeesRequest) (*BatchTxFeesResponse, error)
+	// Queries the fees of the unbatched txs of every token with unbatched txs,
+	// so that relayers can pick the token to request a batch for in one call
+	BatchFees(context.Context, *BatchFeesRequest) (*BatchFeesResponse, error)
+	// Query for info about denoms tracked by gravity
+	ERC20ToDenom(context.Context, *ERC20ToDenomRequest) (*ERC20ToDenomResponse, error)
+	// DenomToERC20Params implements a query that allows ERC-20 parameter
+	// information to be retrieved by a Cosmos base denomination.
+	DenomToERC20Params(context.Context, *DenomToERC20ParamsRequest) (*DenomToERC20ParamsResponse, error)
+	// Query for info about denoms tracked by gravity
+	DenomToERC20(context.Context, *DenomToERC20Request) (*DenomToERC20Response, error)
+	// Query for the factor amounts of an ERC20 are scaled by when converted to
+	// its Cosmos denom
+	ERC20Conversion(context.Context, *ERC20ConversionRequest) (*ERC20ConversionResponse, error)
+	// Query for batch send to ethereums
+	BatchedSendToEthereums(context.Context, *BatchedSendToEthereumsRequest) (*BatchedSendToEthereumsResponse, error)
+	// Query for unbatched send to ethereums
+	UnbatchedSendToEthereums(context.Context, *UnbatchedSendToEthereumsRequest) (*UnbatchedSendToEthereumsResponse, error)
+	// delegate keys
+	DelegateKeysByValidator(context.Context, *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error)
+	DelegateKeysByEthereumSigner(context.Context, *DelegateKeysByEthereumSignerRequest) (*DelegateKeysByEthereumSignerResponse, error)
+	DelegateKeysByOrchestrator(context.Context, *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error)
+	DelegateKeys(context.Context, *DelegateKeysRequest) (*DelegateKeysResponse, error)
+	LastObservedEthereumHeight(context.Context, *LastObservedEthereumHeightRequest) (*LastObservedEthereumHeightResponse, error)
+	// Query for the ethereum height projected from the last observed heights and
+	// the validators' height votes, and the timeout a batch created now would get
+	ProjectedEthereumHeight(context.Context, *ProjectedEthereumHeightRequest) (*ProjectedEthereumHeightResponse, error)
+	// Query for deposits waiting on a mint rate limit, optionally filtered by
+	// token contract
+	QueuedSendToCosmosEvents(context.Context, *QueuedSendToCosmosEventsRequest) (*QueuedSendToCosmosEventsResponse, error)
+	// Query for deposits of tokens off the allowlist held until governance
+	// releases them, optionally filtered by token contract
+	HeldSendToCosmosEvents(context.Context, *HeldSendToCosmosEventsRequest) (*HeldSendToCosmosEventsResponse, error)
+	// Query for the archived records of executed batches in execution order,
+	// optionally filtered by token contract
+	ExecutedBatchTxs(context.Context, *ExecutedBatchTxsRequest) (*ExecutedBatchTxsResponse, error)
+	// Query for the archived records of executed contract calls in execution
+	// order, optionally filtered by logic contract
+	ExecutedContractCallTxs(context.Context, *ExecutedContractCallTxsRequest) (*ExecutedContractCallTxsResponse, error)
+	// Query for the archived records of the expired or invalidated contract
+	// calls whose escrow was refunded, optionally to a single refundee
+	RefundedContractCallTxs(context.Context, *RefundedContractCallTxsRequest) (*RefundedContractCallTxsResponse, error)
+	// Query for the bridge fee allowances, optionally of a granter or to a
+	// grantee
+	BridgeFeeAllowances(context.Context, *BridgeFeeAllowancesRequest) (*BridgeFeeAllowancesResponse, error)
+	// Query for the Ethereum addresses on the blocklist
+	EthereumBlocklist(context.Context, *EthereumBlocklistRequest) (*EthereumBlocklistResponse, error)
+	// Query for the module accounts used by the bridge with their balances
+	ModuleAccounts(context.Context, *ModuleAccountsRequest) (*ModuleAccountsResponse, error)
+	// Query for the most recent decisions taken by the module while processing
+	// blocks, newest first
+	EndBlockerActions(context.Context, *EndBlockerActionsRequest) (*EndBlockerActionsResponse, error)
+	// Query for the signer sets active on Ethereum within a range of Ethereum
+	// block heights, oldest first, starting with the one active at the start
+	SignerSetTxsByHeightRange(context.Context, *SignerSetTxsByHeightRangeRequest) (*SignerSetTxsByHeightRangeResponse, error)
+	// Query for the bridge configuration (params, delegate keys, token mappings
+	// and latest signer set) as a genesis state to bootstrap a new environment
+	BridgeConfig(context.Context, *BridgeConfigRequest) (*BridgeConfigResponse, error)
+	// Query for the differences between the bridge state rebuilt from the log
+	// of observed ethereum events and the live bridge state
+	ReplayDiff(context.Context, *ReplayDiffRequest) (*ReplayDiffResponse, error)
+	// Query for the observed ERC20 deployments awaiting a
+	// MsgERC20DeployedConfirm
+	PendingERC20Deployments(context.Context, *PendingERC20DeploymentsRequest) (*PendingERC20DeploymentsResponse, error)
+	// Query for the tokens whose sends to Ethereum are paused on an attested
+	// Ethereum-side anomaly
+	TokenPauses(context.Context, *TokenPausesRequest) (*TokenPausesResponse, error)
+	// Query for the unexpired orchestrator query identity of an API key hash
+	OrchestratorQueryIdentity(context.Context, *OrchestratorQueryIdentityRequest) (*OrchestratorQueryIdentityResponse, error)
+	// Query for the sends to Ethereum held in the delayed send queue, optionally
+	// of a single sender
+	DelayedSendToEthereums(context.Context, *DelayedSendToEthereumsRequest) (*DelayedSendToEthereumsResponse, error)
+	// Query for the ethereum event vote records above a validator's last event
+	// nonce that it has not voted on yet
+	PendingEventVoteRecords(context.Context, *PendingEventVoteRecordsRequest) (*PendingEventVoteRecordsResponse, error)
+	// Query for the ethereum event vote records of the events emitted by an
+	// ethereum transaction, observed or still being voted on
+	EventByEthereumTxHash(context.Context, *EventByEthereumTxHashRequest) (*EventByEthereumTxHashResponse, error)
+	// Query for the nonces with vote records of more than one ethereum event,
+	// which means the orchestrators disagree on the ethereum events
+	ConflictingEthereumEvents(context.Context, *ConflictingEthereumEventsRequest) (*ConflictingEthereumEventsResponse, error)
+	// Query for the usage of the bridge by tokens over the last day and week
+	BridgeStats(context.Context, *BridgeStatsRequest) (*BridgeStatsResponse, error)
+	// Query for the calldata of the gravity contract call submitting a signer
+	// set tx, a batch tx or a contract call tx signed by enough of the last
+	// signer set observed on ethereum
+	SignerSetTxCalldata(context.Context, *SignerSetTxCalldataRequest) (*OutgoingTxCalldataResponse, error)
+	BatchTxCalldata(context.Context, *BatchTxCalldataRequest) (*OutgoingTxCalldataResponse, error)
+	ContractCallTxCalldata(context.Context, *ContractCallTxCalldataRequest) (*OutgoingTxCalldataResponse, error)
+	// Query for the ethereum header agreed on at the latest checkpoint, along
+	// with the latest header vote of each validator
+	AgreedEthereumHeader(context.Context, *AgreedEthereumHeaderRequest) (*AgreedEthereumHeaderResponse, error)
+	// Query for how long ago each bonded validator last signed an outgoing tx
+	// and voted on an ethereum event
+	BridgeValidatorLiveness(context.Context, *BridgeValidatorLivenessRequest) (*BridgeValidatorLivenessResponse, error)
+	// Query for a merkle proof that a send to ethereum is included in a batch
+	BatchTxInclusionProof(context.Context, *BatchTxInclusionProofRequest) (*BatchTxInclusionProofResponse, error)
+	// Query for the delegate keys, liveness and missed signatures of a
+	// validator
+	BridgeValidatorInfo(context.Context, *BridgeValidatorInfoRequest) (*BridgeValidatorInfoResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) SignerSetTx(ctx context.Context, req *SignerSetTxRequest) (*SignerSetTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTx not implemented")
+}
+func (*UnimplementedQueryServer) LatestSignerSetTx(ctx context.Context, req *LatestSignerSetTxRequest) (*SignerSetTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestSignerSetTx not implemented")
+}
+func (*UnimplementedQueryServer) SignerSetTxDelta(ctx context.Context, req *SignerSetTxDeltaRequest) (*SignerSetTxDeltaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTxDelta not implemented")
+}
+func (*UnimplementedQueryServer) BatchTx(ctx context.Context, req *BatchTxRequest) (*BatchTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTx not implemented")
+}
+func (*UnimplementedQueryServer) ContractCallTx(ctx context.Context, req *ContractCallTxRequest) (*ContractCallTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTx not implemented")
+}
+func (*UnimplementedQueryServer) SignerSetTxCheckpoint(ctx context.Context, req *SignerSetTxRequest) (*OutgoingTxCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTxCheckpoint not implemented")
+}
+func (*UnimplementedQueryServer) BatchTxCheckpoint(ctx context.Context, req *BatchTxRequest) (*OutgoingTxCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTxCheckpoint not implemented")
+}
+func (*UnimplementedQueryServer) ContractCallTxCheckpoint(ctx context.Context, req *ContractCallTxRequest) (*OutgoingTxCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTxCheckpoint not implemented")
+}
+func (*UnimplementedQueryServer) SignerSetTxs(ctx context.Context, req *SignerSetTxsRequest) (*SignerSetTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTxs not implemented")
+}
+func (*UnimplementedQueryServer) BatchTxs(ctx context.Context, req *BatchTxsRequest) (*BatchTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTxs not implemented")
+}
+func (*UnimplementedQueryServer) ContractCallTxs(ctx context.Context, req *ContractCallTxsRequest) (*ContractCallTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTxs not implemented")
+}
+func (*UnimplementedQueryServer) SignerSetTxConfirmations(ctx context.Context, req *SignerSetTxConfirmationsRequest) (*SignerSetTxConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTxConfirmations not implemented")
+}
+func (*UnimplementedQueryServer) BatchTxConfirmations(ctx context.Context, req *BatchTxConfirmationsRequest) (*BatchTxConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTxConfirmations not implemented")
+}
+func (*UnimplementedQueryServer) BatchTxConfirmationProgress(ctx context.Context, req *BatchTxConfirmationProgressRequest) (*BatchTxConfirmationProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTxConfirmationProgress not implemented")
+}
+func (*UnimplementedQueryServer) ContractCallTxConfirmations(ctx context.Context, req *ContractCallTxConfirmationsRequest) (*ContractCallTxConfirmationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTxConfirmations not implemented")
+}
+func (*UnimplementedQueryServer) UnsignedSignerSetTxs(ctx context.Context, req *UnsignedSignerSetTxsRequest) (*UnsignedSignerSetTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsignedSignerSetTxs not implemented")
+}
+func (*UnimplementedQueryServer) UnsignedBatchTxs(ctx context.Context, req *UnsignedBatchTxsRequest) (*UnsignedBatchTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsignedBatchTxs not implemented")
+}
+func (*UnimplementedQueryServer) UnsignedContractCallTxs(ctx context.Context, req *UnsignedContractCallTxsRequest) (*UnsignedContractCallTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsignedContractCallTxs not implemented")
+}
+func (*UnimplementedQueryServer) LastSubmittedEthereumEvent(ctx context.Context, req *LastSubmittedEthereumEventRequest) (*LastSubmittedEthereumEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastSubmittedEthereumEvent not implemented")
+}
+func (*UnimplementedQueryServer) BatchTxFees(ctx context.Context, req *BatchTxFeesRequest) (*BatchTxFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTxFees not implemented")
+}
+func (*UnimplementedQueryServer) BatchFees(ctx context.Context, req *BatchFeesRequest) (*BatchFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchFees not implemented")
+}
+func (*UnimplementedQueryServer) ERC20ToDenom(ctx context.Context, req *ERC20ToDenomRequest) (*ERC20ToDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20ToDenom not implemented")
+}
+func (*UnimplementedQueryServer) DenomToERC20Params(ctx context.Context, req *DenomToERC20ParamsRequest) (*DenomToERC20ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomToERC20Params not implemented")
+}
+func (*UnimplementedQueryServer) DenomToERC20(ctx context.Context, req *DenomToERC20Request) (*DenomToERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomToERC20 not implemented")
+}
+func (*UnimplementedQueryServer) ERC20Conversion(ctx context.Context, req *ERC20ConversionRequest) (*ERC20ConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20Conversion not implemented")
+}
+func (*UnimplementedQueryServer) BatchedSendToEthereums(ctx context.Context, req *BatchedSendToEthereumsRequest) (*BatchedSendToEthereumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchedSendToEthereums not implemented")
+}
+func (*UnimplementedQueryServer) UnbatchedSendToEthereums(ctx context.Context, req *UnbatchedSendToEthereumsRequest) (*UnbatchedSendToEthereumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbatchedSendToEthereums not implemented")
+}
+func (*UnimplementedQueryServer) DelegateKeysByValidator(ctx context.Context, req *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByValidator not implemented")
+}
+func (*UnimplementedQueryServer) DelegateKeysByEthereumSigner(ctx context.Context, req *DelegateKeysByEthereumSignerRequest) (*DelegateKeysByEthereumSignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByEthereumSigner not implemented")
+}
+func (*UnimplementedQueryServer) DelegateKeysByOrchestrator(ctx context.Context, req *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeysByOrchestrator not implemented")
+}
+func (*UnimplementedQueryServer) DelegateKeys(ctx context.Context, req *DelegateKeysRequest) (*DelegateKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateKeys not implemented")
+}
+func (*UnimplementedQueryServer) LastObservedEthereumHeight(ctx context.Context, req *LastObservedEthereumHeightRequest) (*LastObservedEthereumHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastObservedEthereumHeight not implemented")
+}
+func (*UnimplementedQueryServer) ProjectedEthereumHeight(ctx context.Context, req *ProjectedEthereumHeightRequest) (*ProjectedEthereumHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedEthereumHeight not implemented")
+}
+func (*UnimplementedQueryServer) QueuedSendToCosmosEvents(ctx context.Context, req *QueuedSendToCosmosEventsRequest) (*QueuedSendToCosmosEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedSendToCosmosEvents not implemented")
+}
+func (*UnimplementedQueryServer) HeldSendToCosmosEvents(ctx context.Context, req *HeldSendToCosmosEventsRequest) (*HeldSendToCosmosEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeldSendToCosmosEvents not implemented")
+}
+func (*UnimplementedQueryServer) ExecutedBatchTxs(ctx context.Context, req *ExecutedBatchTxsRequest) (*ExecutedBatchTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutedBatchTxs not implemented")
+}
+func (*UnimplementedQueryServer) ExecutedContractCallTxs(ctx context.Context, req *ExecutedContractCallTxsRequest) (*ExecutedContractCallTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutedContractCallTxs not implemented")
+}
+func (*UnimplementedQueryServer) RefundedContractCallTxs(ctx context.Context, req *RefundedContractCallTxsRequest) (*RefundedContractCallTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundedContractCallTxs not implemented")
+}
+func (*UnimplementedQueryServer) BridgeFeeAllowances(ctx context.Context, req *BridgeFeeAllowancesRequest) (*BridgeFeeAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeFeeAllowances not implemented")
+}
+func (*UnimplementedQueryServer) EthereumBlocklist(ctx context.Context, req *EthereumBlocklistRequest) (*EthereumBlocklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthereumBlocklist not implemented")
+}
+func (*UnimplementedQueryServer) ModuleAccounts(ctx context.Context, req *ModuleAccountsRequest) (*ModuleAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccounts not implemented")
+}
+func (*UnimplementedQueryServer) EndBlockerActions(ctx context.Context, req *EndBlockerActionsRequest) (*EndBlockerActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndBlockerActions not implemented")
+}
+func (*UnimplementedQueryServer) SignerSetTxsByHeightRange(ctx context.Context, req *SignerSetTxsByHeightRangeRequest) (*SignerSetTxsByHeightRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTxsByHeightRange not implemented")
+}
+func (*UnimplementedQueryServer) BridgeConfig(ctx context.Context, req *BridgeConfigRequest) (*BridgeConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeConfig not implemented")
+}
+func (*UnimplementedQueryServer) ReplayDiff(ctx context.Context, req *ReplayDiffRequest) (*ReplayDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDiff not implemented")
+}
+func (*UnimplementedQueryServer) PendingERC20Deployments(ctx context.Context, req *PendingERC20DeploymentsRequest) (*PendingERC20DeploymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingERC20Deployments not implemented")
+}
+func (*UnimplementedQueryServer) TokenPauses(ctx context.Context, req *TokenPausesRequest) (*TokenPausesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenPauses not implemented")
+}
+func (*UnimplementedQueryServer) OrchestratorQueryIdentity(ctx context.Context, req *OrchestratorQueryIdentityRequest) (*OrchestratorQueryIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrchestratorQueryIdentity not implemented")
+}
+func (*UnimplementedQueryServer) DelayedSendToEthereums(ctx context.Context, req *DelayedSendToEthereumsRequest) (*DelayedSendToEthereumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayedSendToEthereums not implemented")
+}
+func (*UnimplementedQueryServer) PendingEventVoteRecords(ctx context.Context, req *PendingEventVoteRecordsRequest) (*PendingEventVoteRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingEventVoteRecords not implemented")
+}
+func (*UnimplementedQueryServer) EventByEthereumTxHash(ctx context.Context, req *EventByEthereumTxHashRequest) (*EventByEthereumTxHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EventByEthereumTxHash not implemented")
+}
+func (*UnimplementedQueryServer) ConflictingEthereumEvents(ctx context.Context, req *ConflictingEthereumEventsRequest) (*ConflictingEthereumEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConflictingEthereumEvents not implemented")
+}
+func (*UnimplementedQueryServer) BridgeStats(ctx context.Context, req *BridgeStatsRequest) (*BridgeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeStats not implemented")
+}
+func (*UnimplementedQueryServer) SignerSetTxCalldata(ctx context.Context, req *SignerSetTxCalldataRequest) (*OutgoingTxCalldataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerSetTxCalldata not implemented")
+}
+func (*UnimplementedQueryServer) BatchTxCalldata(ctx context.Context, req *BatchTxCalldataRequest) (*OutgoingTxCalldataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTxCalldata not implemented")
+}
+func (*UnimplementedQueryServer) ContractCallTxCalldata(ctx context.Context, req *ContractCallTxCalldataRequest) (*OutgoingTxCalldataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCallTxCalldata not implemented")
+}
+func (*UnimplementedQueryServer) AgreedEthereumHeader(ctx context.Context, req *AgreedEthereumHeaderRequest) (*AgreedEthereumHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgreedEthereumHeader not implemented")
+}
+func (*UnimplementedQueryServer) BridgeValidatorLiveness(ctx context.Context, req *BridgeValidatorLivenessRequest) (*BridgeValidatorLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeValidatorLiveness not implemented")
+}
+func (*UnimplementedQueryServer) BatchTxInclusionProof(ctx context.Context, req *BatchTxInclusionProofRequest) (*BatchTxInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTxInclusionProof not implemented")
+}
+func (*UnimplementedQueryServer) BridgeValidatorInfo(ctx context.Context, req *BridgeValidatorInfoRequest) (*BridgeValidatorInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeValidatorInfo not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignerSetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSetTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSetTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/SignerSetTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSetTx(ctx, req.(*SignerSetTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestSignerSetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LatestSignerSetTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestSignerSetTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/LatestSignerSetTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestSignerSetTx(ctx, req.(*LatestSignerSetTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignerSetTxDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSetTxDeltaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSetTxDelta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/SignerSetTxDelta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSetTxDelta(ctx, req.(*SignerSetTxDeltaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTx(ctx, req.(*BatchTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractCallTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractCallTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractCallTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ContractCallTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractCallTx(ctx, req.(*ContractCallTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignerSetTxCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSetTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSetTxCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/SignerSetTxCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSetTxCheckpoint(ctx, req.(*SignerSetTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchTxCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTxCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchTxCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTxCheckpoint(ctx, req.(*BatchTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractCallTxCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractCallTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractCallTxCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ContractCallTxCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractCallTxCheckpoint(ctx, req.(*ContractCallTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignerSetTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSetTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSetTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/SignerSetTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSetTxs(ctx, req.(*SignerSetTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTxs(ctx, req.(*BatchTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractCallTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractCallTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractCallTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ContractCallTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractCallTxs(ctx, req.(*ContractCallTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignerSetTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSetTxConfirmationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSetTxConfirmations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/SignerSetTxConfirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSetTxConfirmations(ctx, req.(*SignerSetTxConfirmationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxConfirmationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTxConfirmations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchTxConfirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTxConfirmations(ctx, req.(*BatchTxConfirmationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchTxConfirmationProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxConfirmationProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTxConfirmationProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchTxConfirmationProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTxConfirmationProgress(ctx, req.(*BatchTxConfirmationProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractCallTxConfirmations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractCallTxConfirmationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractCallTxConfirmations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ContractCallTxConfirmations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractCallTxConfirmations(ctx, req.(*ContractCallTxConfirmationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnsignedSignerSetTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsignedSignerSetTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnsignedSignerSetTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/UnsignedSignerSetTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnsignedSignerSetTxs(ctx, req.(*UnsignedSignerSetTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnsignedBatchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsignedBatchTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnsignedBatchTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/UnsignedBatchTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnsignedBatchTxs(ctx, req.(*UnsignedBatchTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnsignedContractCallTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsignedContractCallTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnsignedContractCallTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/UnsignedContractCallTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnsignedContractCallTxs(ctx, req.(*UnsignedContractCallTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastSubmittedEthereumEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LastSubmittedEthereumEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastSubmittedEthereumEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/LastSubmittedEthereumEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastSubmittedEthereumEvent(ctx, req.(*LastSubmittedEthereumEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTxFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchTxFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTxFees(ctx, req.(*BatchTxFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchFees(ctx, req.(*BatchFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ERC20ToDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ERC20ToDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ERC20ToDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ERC20ToDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ERC20ToDenom(ctx, req.(*ERC20ToDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomToERC20Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenomToERC20ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomToERC20Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/DenomToERC20Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomToERC20Params(ctx, req.(*DenomToERC20ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomToERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenomToERC20Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomToERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/DenomToERC20",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomToERC20(ctx, req.(*DenomToERC20Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ERC20Conversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ERC20ConversionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ERC20Conversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ERC20Conversion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ERC20Conversion(ctx, req.(*ERC20ConversionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchedSendToEthereums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchedSendToEthereumsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchedSendToEthereums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchedSendToEthereums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchedSendToEthereums(ctx, req.(*BatchedSendToEthereumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbatchedSendToEthereums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbatchedSendToEthereumsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbatchedSendToEthereums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/UnbatchedSendToEthereums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbatchedSendToEthereums(ctx, req.(*UnbatchedSendToEthereumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegateKeysByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateKeysByValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegateKeysByValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/DelegateKeysByValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegateKeysByValidator(ctx, req.(*DelegateKeysByValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegateKeysByEthereumSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateKeysByEthereumSignerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegateKeysByEthereumSigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/DelegateKeysByEthereumSigner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegateKeysByEthereumSigner(ctx, req.(*DelegateKeysByEthereumSignerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegateKeysByOrchestrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateKeysByOrchestratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegateKeysByOrchestrator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/DelegateKeysByOrchestrator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegateKeysByOrchestrator(ctx, req.(*DelegateKeysByOrchestratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegateKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/DelegateKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegateKeys(ctx, req.(*DelegateKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastObservedEthereumHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LastObservedEthereumHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastObservedEthereumHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/LastObservedEthereumHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastObservedEthereumHeight(ctx, req.(*LastObservedEthereumHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedEthereumHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectedEthereumHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedEthereumHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ProjectedEthereumHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedEthereumHeight(ctx, req.(*ProjectedEthereumHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedSendToCosmosEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuedSendToCosmosEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedSendToCosmosEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/QueuedSendToCosmosEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedSendToCosmosEvents(ctx, req.(*QueuedSendToCosmosEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HeldSendToCosmosEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeldSendToCosmosEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeldSendToCosmosEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/HeldSendToCosmosEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeldSendToCosmosEvents(ctx, req.(*HeldSendToCosmosEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutedBatchTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutedBatchTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutedBatchTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ExecutedBatchTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutedBatchTxs(ctx, req.(*ExecutedBatchTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutedContractCallTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutedContractCallTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutedContractCallTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ExecutedContractCallTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutedContractCallTxs(ctx, req.(*ExecutedContractCallTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RefundedContractCallTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundedContractCallTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RefundedContractCallTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/RefundedContractCallTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RefundedContractCallTxs(ctx, req.(*RefundedContractCallTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeFeeAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgeFeeAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeFeeAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BridgeFeeAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeFeeAllowances(ctx, req.(*BridgeFeeAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EthereumBlocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthereumBlocklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EthereumBlocklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/EthereumBlocklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EthereumBlocklist(ctx, req.(*EthereumBlocklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModuleAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ModuleAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAccounts(ctx, req.(*ModuleAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EndBlockerActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndBlockerActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EndBlockerActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/EndBlockerActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EndBlockerActions(ctx, req.(*EndBlockerActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignerSetTxsByHeightRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSetTxsByHeightRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSetTxsByHeightRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/SignerSetTxsByHeightRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSetTxsByHeightRange(ctx, req.(*SignerSetTxsByHeightRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgeConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BridgeConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeConfig(ctx, req.(*BridgeConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReplayDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReplayDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ReplayDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReplayDiff(ctx, req.(*ReplayDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingERC20Deployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingERC20DeploymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingERC20Deployments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/PendingERC20Deployments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingERC20Deployments(ctx, req.(*PendingERC20DeploymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenPauses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenPausesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenPauses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/TokenPauses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenPauses(ctx, req.(*TokenPausesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrchestratorQueryIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrchestratorQueryIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrchestratorQueryIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/OrchestratorQueryIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrchestratorQueryIdentity(ctx, req.(*OrchestratorQueryIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelayedSendToEthereums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelayedSendToEthereumsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelayedSendToEthereums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/DelayedSendToEthereums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelayedSendToEthereums(ctx, req.(*DelayedSendToEthereumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingEventVoteRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingEventVoteRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingEventVoteRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/PendingEventVoteRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingEventVoteRecords(ctx, req.(*PendingEventVoteRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EventByEthereumTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventByEthereumTxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EventByEthereumTxHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/EventByEthereumTxHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EventByEthereumTxHash(ctx, req.(*EventByEthereumTxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConflictingEthereumEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConflictingEthereumEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConflictingEthereumEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ConflictingEthereumEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConflictingEthereumEvents(ctx, req.(*ConflictingEthereumEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BridgeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeStats(ctx, req.(*BridgeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignerSetTxCalldata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignerSetTxCalldataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignerSetTxCalldata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/SignerSetTxCalldata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerSetTxCalldata(ctx, req.(*SignerSetTxCalldataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchTxCalldata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxCalldataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTxCalldata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchTxCalldata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTxCalldata(ctx, req.(*BatchTxCalldataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractCallTxCalldata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractCallTxCalldataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractCallTxCalldata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ContractCallTxCalldata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractCallTxCalldata(ctx, req.(*ContractCallTxCalldataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AgreedEthereumHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgreedEthereumHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AgreedEthereumHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/AgreedEthereumHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AgreedEthereumHeader(ctx, req.(*AgreedEthereumHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeValidatorLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgeValidatorLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeValidatorLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BridgeValidatorLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeValidatorLiveness(ctx, req.(*BridgeValidatorLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchTxInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTxInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchTxInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTxInclusionProof(ctx, req.(*BatchTxInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeValidatorInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgeValidatorInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeValidatorInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BridgeValidatorInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeValidatorInfo(ctx, req.(*BridgeValidatorInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gravity.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "SignerSetTx",
+			Handler:    _Query_SignerSetTx_Handler,
+		},
+		{
+			MethodName: "LatestSignerSetTx",
+			Handler:    _Query_LatestSignerSetTx_Handler,
+		},
+		{
+			MethodName: "SignerSetTxDelta",
+			Handler:    _Query_SignerSetTxDelta_Handler,
+		},
+		{
+			MethodName: "BatchTx",
+			Handler:    _Query_BatchTx_Handler,
+		},
+		{
+			MethodName: "ContractCallTx",
+			Handler:    _Query_ContractCallTx_Handler,
+		},
+		{
+			MethodName: "SignerSetTxCheckpoint",
+			Handler:    _Query_SignerSetTxCheckpoint_Handler,
+		},
+		{
+			MethodName: "BatchTxCheckpoint",
+			Handler:    _Query_BatchTxCheckpoint_Handler,
+		},
+		{
+			MethodName: "ContractCallTxCheckpoint",
+			Handler:    _Query_ContractCallTxCheckpoint_Handler,
+		},
+		{
+			MethodName: "SignerSetTxs",
+			Handler:    _Query_SignerSetTxs_Handler,
+		},
+		{
+			MethodName: "BatchTxs",
+			Handler:    _Query_BatchTxs_Handler,
+		},
+		{
+			MethodName: "ContractCallTxs",
+			Handler:    _Query_ContractCallTxs_Handler,
+		},
+		{
+			MethodName: "SignerSetTxConfirmations",
+			Handler:    _Query_SignerSetTxConfirmations_Handler,
+		},
+		{
+			MethodName: "BatchTxConfirmations",
+			Handler:    _Query_BatchTxConfirmations_Handler,
+		},
+		{
+			MethodName: "BatchTxConfirmationProgress",
+			Handler:    _Query_BatchTxConfirmationProgress_Handler,
+		},
+		{
+			MethodName: "ContractCallTxConfirmations",
+			Handler:    _Query_ContractCallTxConfirmations_Handler,
+		},
+		{
+			MethodName: "UnsignedSignerSetTxs",
+			Handler:    _Query_UnsignedSignerSetTxs_Handler,
+		},
+		{
+			MethodName: "UnsignedBatchTxs",
+			Handler:    _Query_UnsignedBatchTxs_Handler,
+		},
+		{
+			MethodName: "UnsignedContractCallTxs",
+			Handler:    _Query_UnsignedContractCallTxs_Handler,
+		},
+		{
+			MethodName: "LastSubmittedEthereumEvent",
+			Handler:    _Query_LastSubmittedEthereumEvent_Handler,
+		},
+		{
+			MethodName: "BatchTxFees",
+			Handler:    _Query_BatchTxFees_Handler,
+		},
+		{
+			MethodName: "BatchFees",
+			Handler:    _Query_BatchFees_Handler,
+		},
+		{
+			MethodName: "ERC20ToDenom",
+			Handler:    _Query_ERC20ToDenom_Handler,
+		},
+		{
+			MethodName: "DenomToERC20Params",
+			Handler:    _Query_DenomToERC20Params_Handler,
+		},
+		{
+			MethodName: "DenomToERC20",
+			Handler:    _Query_DenomToERC20_Handler,
+		},
+		{
+			MethodName: "ERC20Conversion",
+			Handler:    _Query_ERC20Conversion_Handler,
+		},
+		{
+			MethodName: "BatchedSendToEthereums",
+			Handler:    _Query_BatchedSendToEthereums_Handler,
+		},
+		{
+			MethodName: "UnbatchedSendToEthereums",
+			Handler:    _Query_UnbatchedSendToEthereums_Handler,
+		},
+		{
+			MethodName: "DelegateKeysByValidator",
+			Handler:    _Query_DelegateKeysByValidator_Handler,
+		},
+		{
+			MethodName: "DelegateKeysByEthereumSigner",
+			Handler:    _Query_DelegateKeysByEthereumSigner_Handler,
+		},
+		{
+			MethodName: "DelegateKeysByOrchestrator",
+			Handler:    _Query_DelegateKeysByOrchestrator_Handler,
+		},
+		{
+			MethodName: "DelegateKeys",
+			Handler:    _Query_DelegateKeys_Handler,
+		},
+		{
+			MethodName: "LastObservedEthereumHeight",
+			Handler:    _Query_LastObservedEthereumHeight_Handler,
+		},
+		{
+			MethodName: "ProjectedEthereumHeight",
+			Handler:    _Query_ProjectedEthereumHeight_Handler,
+		},
+		{
+			MethodName: "QueuedSendToCosmosEvents",
+			Handler:    _Query_QueuedSendToCosmosEvents_Handler,
+		},
+		{
+			MethodName: "HeldSendToCosmosEvents",
+			Handler:    _Query_HeldSendToCosmosEvents_Handler,
+		},
+		{
+			MethodName: "ExecutedBatchTxs",
+			Handler:    _Query_ExecutedBatchTxs_Handler,
+		},
+		{
+			MethodName: "ExecutedContractCallTxs",
+			Handler:    _Query_ExecutedContractCallTxs_Handler,
+		},
+		{
+			MethodName: "RefundedContractCallTxs",
+			Handler:    _Query_RefundedContractCallTxs_Handler,
+		},
+		{
+			MethodName: "BridgeFeeAllowances",
+			Handler:    _Query_BridgeFeeAllowances_Handler,
+		},
+		{
+			MethodName: "EthereumBlocklist",
+			Handler:    _Query_EthereumBlocklist_Handler,
+		},
+		{
+			MethodName: "ModuleAccounts",
+			Handler:    _Query_ModuleAccounts_Handler,
+		},
+		{
+			MethodName: "EndBlockerActions",
+			Handler:    _Query_EndBlockerActions_Handler,
+		},
+		{
+			MethodName: "SignerSetTxsByHeightRange",
+			Handler:    _Query_SignerSetTxsByHeightRange_Handler,
+		},
+		{
+			MethodName: "BridgeConfig",
+			Handler:    _Query_BridgeConfig_Handler,
+		},
+		{
+			MethodName: "ReplayDiff",
+			Handler:    _Query_ReplayDiff_Handler,
+		},
+		{
+			MethodName: "PendingERC20Deployments",
+			Handler:    _Query_PendingERC20Deployments_Handler,
+		},
+		{
+			MethodName: "TokenPauses",
+			Handler:    _Query_TokenPauses_Handler,
+		},
+		{
+			MethodName: "OrchestratorQueryIdentity",
+			Handler:    _Query_OrchestratorQueryIdentity_Handler,
+		},
+		{
+			MethodName: "DelayedSendToEthereums",
+			Handler:    _Query_DelayedSendToEthereums_Handler,
+		},
+		{
+			MethodName: "PendingEventVoteRecords",
+			Handler:    _Query_PendingEventVoteRecords_Handler,
+		},
+		{
+			MethodName: "EventByEthereumTxHash",
+			Handler:    _Query_EventByEthereumTxHash_Handler,
+		},
+		{
+			MethodName: "ConflictingEthereumEvents",
+			Handler:    _Query_ConflictingEthereumEvents_Handler,
+		},
+		{
+			MethodName: "BridgeStats",
+			Handler:    _Query_BridgeStats_Handler,
+		},
+		{
+			MethodName: "SignerSetTxCalldata",
+			Handler:    _Query_SignerSetTxCalldata_Handler,
+		},
+		{
+			MethodName: "BatchTxCalldata",
+			Handler:    _Query_BatchTxCalldata_Handler,
+		},
+		{
+			MethodName: "ContractCallTxCalldata",
+			Handler:    _Query_ContractCallTxCalldata_Handler,
+		},
+		{
+			MethodName: "AgreedEthereumHeader",
+			Handler:    _Query_AgreedEthereumHeader_Handler,
+		},
+		{
+			MethodName: "BridgeValidatorLiveness",
+			Handler:    _Query_BridgeValidatorLiveness_Handler,
+		},
+		{
+			MethodName: "BatchTxInclusionProof",
+			Handler:    _Query_BatchTxInclusionProof_Handler,
+		},
+		{
+			MethodName: "BridgeValidatorInfo",
+			Handler:    _Query_BridgeValidatorInfo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gravity/v1/query.proto",
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LatestSignerSetTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LatestSignerSetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LatestSignerSetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignerSet != nil {
+		{
+			size, err := m.SignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxDeltaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxDeltaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxDeltaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxDeltaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxDeltaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxDeltaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Delta != nil {
+		{
+			size, err := m.Delta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Batch != nil {
+		{
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogicCall != nil {
+		{
+			size, err := m.LogicCall.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignerSets) > 0 {
+		for iNdEx := len(m.SignerSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignatureStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignatureStatus))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TimeoutBeforeHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeoutBeforeHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MinTotalFee) > 0 {
+		i -= len(m.MinTotalFee)
+		copy(dAtA[i:], m.MinTotalFee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinTotalFee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedSignerSetTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedSignerSetTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedSignerSetTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedSignerSetTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedSignerSetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedSignerSetTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignerSets) > 0 {
+		for iNdEx := len(m.SignerSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedBatchTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedBatchTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedBatchTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedBatchTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedBatchTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedBatchTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedContractCallTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedContractCallTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedContractCallTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedContractCallTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedContractCallTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedContractCallTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxElements != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxElements))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenBatchFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenBatchFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenBatchFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OldestTxAge != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OldestTxAge))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TopFee.Size()
+		i -= size
+		if _, err := m.TopFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalFee.Size()
+		i -= size
+		if _, err := m.TotalFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxConfirmationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxConfirmationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxConfirmationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxConfirmationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxConfirmationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxConfirmationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxConfirmationProgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxConfirmationProgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxConfirmationProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxConfirmationProgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxConfirmationProgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxConfirmationProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unsigned) > 0 {
+		for iNdEx := len(m.Unsigned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unsigned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Signed) > 0 {
+		for iNdEx := len(m.Signed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ThresholdPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ThresholdPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignedPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignedPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastSubmittedEthereumEventRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastSubmittedEthereumEventRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastSubmittedEthereumEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastSubmittedEthereumEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastSubmittedEthereumEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastSubmittedEthereumEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LastObservedEthereumHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.LastObservedEventNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastObservedEventNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastEventVoteHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastEventVoteHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.EthereumHeightVote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EventNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20ToDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20ToDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20ToDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20ToDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20ToDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20ToDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmosOriginated {
+		i--
+		if m.CosmosOriginated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomToERC20ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomToERC20ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomToERC20ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomToERC20ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomToERC20ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomToERC20ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Erc20Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Erc20Decimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Erc20Symbol) > 0 {
+		i -= len(m.Erc20Symbol)
+		copy(dAtA[i:], m.Erc20Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Erc20Name) > 0 {
+		i -= len(m.Erc20Name)
+		copy(dAtA[i:], m.Erc20Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomToERC20Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomToERC20Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomToERC20Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomToERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomToERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomToERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmosOriginated {
+		i--
+		if m.CosmosOriginated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByEthereumSignerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByEthereumSignerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByEthereumSignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumSigner) > 0 {
+		i -= len(m.EthereumSigner)
+		copy(dAtA[i:], m.EthereumSigner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthereumSigner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByEthereumSignerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByEthereumSignerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByEthereumSignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByOrchestratorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByOrchestratorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByOrchestratorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysByOrchestratorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysByOrchestratorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysByOrchestratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumSigner) > 0 {
+		i -= len(m.EthereumSigner)
+		copy(dAtA[i:], m.EthereumSigner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthereumSigner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegateKeys) > 0 {
+		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchedSendToEthereumsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchedSendToEthereumsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchedSendToEthereumsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchedSendToEthereumsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchedSendToEthereumsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchedSendToEthereumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SendToEthereums) > 0 {
+		for iNdEx := len(m.SendToEthereums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendToEthereums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbatchedSendToEthereumsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbatchedSendToEthereumsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbatchedSendToEthereumsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbatchedSendToEthereumsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbatchedSendToEthereumsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbatchedSendToEthereumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SendToEthereums) > 0 {
+		for iNdEx := len(m.SendToEthereums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendToEthereums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastObservedEthereumHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastObservedEthereumHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastObservedEthereumHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *LastObservedEthereumHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastObservedEthereumHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastObservedEthereumHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastObservedEthereumHeight != nil {
+		{
+			size, err := m.LastObservedEthereumHeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectedEthereumHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectedEthereumHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectedEthereumHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectedEthereumHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectedEthereumHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectedEthereumHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeoutHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MedianEthereumHeightVote != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MedianEthereumHeightVote))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProjectedEthereumHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProjectedEthereumHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.LastObservedEthereumHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedSendToCosmosEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedSendToCosmosEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedSendToCosmosEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedSendToCosmosEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedSendToCosmosEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedSendToCosmosEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeldSendToCosmosEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeldSendToCosmosEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeldSendToCosmosEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeldSendToCosmosEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeldSendToCosmosEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeldSendToCosmosEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutedBatchTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutedBatchTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutedBatchTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutedBatchTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutedBatchTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutedBatchTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutedContractCallTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutedContractCallTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutedContractCallTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutedContractCallTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutedContractCallTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutedContractCallTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefundedContractCallTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundedContractCallTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundedContractCallTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Refundee) > 0 {
+		i -= len(m.Refundee)
+		copy(dAtA[i:], m.Refundee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Refundee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefundedContractCallTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundedContractCallTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundedContractCallTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeFeeAllowancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeFeeAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeFeeAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeFeeAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeFeeAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeFeeAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumBlocklistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumBlocklistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumBlocklistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumBlocklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumBlocklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumBlocklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeModuleAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeModuleAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeModuleAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Purpose) > 0 {
+		i -= len(m.Purpose)
+		copy(dAtA[i:], m.Purpose)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Purpose)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EndBlockerActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndBlockerActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndBlockerActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EndBlockerActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndBlockerActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndBlockerActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxsByHeightRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxsByHeightRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxsByHeightRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxsByHeightRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxsByHeightRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxsByHeightRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignerSets) > 0 {
+		for iNdEx := len(m.SignerSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Discrepancies) > 0 {
+		for iNdEx := len(m.Discrepancies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Discrepancies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayDiscrepancy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayDiscrepancy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayDiscrepancy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Live) > 0 {
+		i -= len(m.Live)
+		copy(dAtA[i:], m.Live)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Live)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Rebuilt) > 0 {
+		i -= len(m.Rebuilt)
+		copy(dAtA[i:], m.Rebuilt)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Rebuilt)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingERC20DeploymentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingERC20DeploymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingERC20DeploymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingERC20DeploymentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingERC20DeploymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingERC20DeploymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deployments) > 0 {
+		for iNdEx := len(m.Deployments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deployments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20ConversionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20ConversionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20ConversionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20ConversionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20ConversionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20ConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Factor.Size()
+		i -= size
+		if _, err := m.Factor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Conversion.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenPausesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPausesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPausesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenPausesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPausesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPausesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pauses) > 0 {
+		for iNdEx := len(m.Pauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrchestratorQueryIdentityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrchestratorQueryIdentityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrchestratorQueryIdentityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyHash) > 0 {
+		i -= len(m.KeyHash)
+		copy(dAtA[i:], m.KeyHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrchestratorQueryIdentityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrchestratorQueryIdentityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrchestratorQueryIdentityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Identity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OutgoingTxCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutgoingTxCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutgoingTxCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SignedHash) > 0 {
+		i -= len(m.SignedHash)
+		copy(dAtA[i:], m.SignedHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignedHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Checkpoint) > 0 {
+		i -= len(m.Checkpoint)
+		copy(dAtA[i:], m.Checkpoint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checkpoint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedSendToEthereumsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedSendToEthereumsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedSendToEthereumsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedSendToEthereumsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedSendToEthereumsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedSendToEthereumsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelayedSendToEthereums) > 0 {
+		for iNdEx := len(m.DelayedSendToEthereums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelayedSendToEthereums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingEventVoteRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingEventVoteRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingEventVoteRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingEventVoteRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingEventVoteRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingEventVoteRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventVoteRecords) > 0 {
+		for iNdEx := len(m.EventVoteRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EventVoteRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LastObservedEventNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastObservedEventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastEventNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastEventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventByEthereumTxHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventByEthereumTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventByEthereumTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumTxHash) > 0 {
+		i -= len(m.EthereumTxHash)
+		copy(dAtA[i:], m.EthereumTxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthereumTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventByEthereumTxHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventByEthereumTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventByEthereumTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventVoteRecords) > 0 {
+		for iNdEx := len(m.EventVoteRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EventVoteRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConflictingEthereumEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConflictingEthereumEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConflictingEthereumEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ConflictingEthereumEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConflictingEthereumEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConflictingEthereumEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Conflicts) > 0 {
+		for iNdEx := len(m.Conflicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conflicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConflictingEthereumEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConflictingEthereumEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConflictingEthereumEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventVoteRecords) > 0 {
+		for iNdEx := len(m.EventVoteRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EventVoteRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeTokenStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeTokenStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeTokenStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LastWeek.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.LastDay.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeStatsWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeStatsWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeStatsWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AverageFee.Size()
+		i -= size
+		if _, err := m.AverageFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.OutflowCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutflowCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InflowCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InflowCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTxCalldataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTxCalldataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTxCalldataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxCalldataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxCalldataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxCalldataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTxCalldataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTxCalldataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTxCalldataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutgoingTxCalldataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutgoingTxCalldataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutgoingTxCalldataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SignedPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignedPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CurrentSignerSetNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentSignerSetNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AgreedEthereumHeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AgreedEthereumHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AgreedEthereumHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AgreedEthereumHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AgreedEthereumHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AgreedEthereumHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeValidatorLivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeValidatorLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeValidatorLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeValidatorLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeValidatorLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeValidatorLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeValidatorLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeValidatorLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeValidatorLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedSignatures != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedSignatures))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastEventNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastEventNonce))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlocksSinceLastEventVote != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksSinceLastEventVote))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlocksSinceLastSignature != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksSinceLastSignature))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastEventVoteHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastEventVoteHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastSignatureHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastSignatureHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxInclusionProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxInclusionProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxInclusionProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SendToEthereumId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SendToEthereumId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTxInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTxInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTxInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Checkpoint) > 0 {
+		i -= len(m.Checkpoint)
+		copy(dAtA[i:], m.Checkpoint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checkpoint)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SendToEthereum != nil {
+		{
+			size, err := m.SendToEthereum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeValidatorInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeValidatorInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeValidatorInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeValidatorInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeValidatorInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeValidatorInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeValidatorInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeValidatorInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeValidatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Freeze != nil {
+		{
+			size, err := m.Freeze.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.OptedOut {
+		i--
+		if m.OptedOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Liveness.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Bonded {
+		i--
+		if m.Bonded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SignatureScheme != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignatureScheme))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SignerSetTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SignerSetNonce))
+	}
+	return n
+}
+
+func (m *LatestSignerSetTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SignerSetTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSet != nil {
+		l = m.SignerSet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTxDeltaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SignerSetNonce))
+	}
+	return n
+}
+
+func (m *SignerSetTxDeltaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Delta != nil {
+		l = m.Delta.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNonce))
+	}
+	return n
+}
+
+func (m *BatchTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovQuery(uint64(m.InvalidationNonce))
+	}
+	return n
+}
+
+func (m *ContractCallTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogicCall != nil {
+		l = m.LogicCall.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTxConfirmationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SignerSetNonce))
+	}
+	return n
+}
+
+func (m *SignerSetTxConfirmationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignerSetTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignerSets) > 0 {
+		for _, e := range m.SignerSets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MinTotalFee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TimeoutBeforeHeight != 0 {
+		n += 1 + sovQuery(uint64(m.TimeoutBeforeHeight))
+	}
+	if m.SignatureStatus != 0 {
+		n += 1 + sovQuery(uint64(m.SignatureStatus))
+	}
+	return n
+}
+
+func (m *BatchTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnsignedSignerSetTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnsignedSignerSetTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignerSets) > 0 {
+		for _, e := range m.SignerSets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnsignedBatchTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnsignedBatchTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnsignedContractCallTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnsignedContractCallTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchTxFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BatchTxFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxElements != 0 {
+		n += 1 + sovQuery(uint64(m.MaxElements))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenBatchFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TopFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TxCount != 0 {
+		n += 1 + sovQuery(uint64(m.TxCount))
+	}
+	if m.OldestTxAge != 0 {
+		n += 1 + sovQuery(uint64(m.OldestTxAge))
+	}
+	return n
+}
+
+func (m *ContractCallTxConfirmationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovQuery(uint64(m.InvalidationNonce))
+	}
+	return n
+}
+
+func (m *ContractCallTxConfirmationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchTxConfirmationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxConfirmationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchTxConfirmationProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTxConfirmationProgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SignerSetNonce))
+	}
+	if m.SignedPower != 0 {
+		n += 1 + sovQuery(uint64(m.SignedPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	if m.ThresholdPower != 0 {
+		n += 1 + sovQuery(uint64(m.ThresholdPower))
+	}
+	if len(m.Signed) > 0 {
+		for _, e := range m.Signed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unsigned) > 0 {
+		for _, e := range m.Unsigned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LastSubmittedEthereumEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LastSubmittedEthereumEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovQuery(uint64(m.EventNonce))
+	}
+	l = m.EthereumHeightVote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastEventVoteHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastEventVoteHeight))
+	}
+	if m.LastObservedEventNonce != 0 {
+		n += 1 + sovQuery(uint64(m.LastObservedEventNonce))
+	}
+	l = m.LastObservedEthereumHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ERC20ToDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20ToDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CosmosOriginated {
+		n += 2
+	}
+	return n
+}
+
+func (m *DenomToERC20ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomToERC20ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Erc20Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Erc20Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Erc20Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Erc20Decimals))
+	}
+	return n
+}
+
+func (m *DenomToERC20Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomToERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CosmosOriginated {
+		n += 2
+	}
+	return n
+}
+
+func (m *DelegateKeysByValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysByValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysByEthereumSignerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EthereumSigner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysByEthereumSignerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysByOrchestratorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysByOrchestratorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EthereumSigner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DelegateKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DelegateKeys) > 0 {
+		for _, e := range m.DelegateKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchedSendToEthereumsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchedSendToEthereumsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SendToEthereums) > 0 {
+		for _, e := range m.SendToEthereums {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnbatchedSendToEthereumsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnbatchedSendToEthereumsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SendToEthereums) > 0 {
+		for _, e := range m.SendToEthereums {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LastObservedEthereumHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LastObservedEthereumHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastObservedEthereumHeight != nil {
+		l = m.LastObservedEthereumHeight.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ProjectedEthereumHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ProjectedEthereumHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LastObservedEthereumHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ProjectedEthereumHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ProjectedEthereumHeight))
+	}
+	if m.MedianEthereumHeightVote != 0 {
+		n += 1 + sovQuery(uint64(m.MedianEthereumHeightVote))
+	}
+	if m.TimeoutHeight != 0 {
+		n += 1 + sovQuery(uint64(m.TimeoutHeight))
+	}
+	return n
+}
+
+func (m *QueuedSendToCosmosEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuedSendToCosmosEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *HeldSendToCosmosEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *HeldSendToCosmosEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutedBatchTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutedBatchTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutedContractCallTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutedContractCallTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RefundedContractCallTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Refundee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RefundedContractCallTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeFeeAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeFeeAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EthereumBlocklistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EthereumBlocklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ModuleAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeModuleAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Purpose)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EndBlockerActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *EndBlockerActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignerSetTxsByHeightRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTxsByHeightRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignerSets) > 0 {
+		for _, e := range m.SignerSets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BridgeConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ReplayDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ReplayDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Discrepancies) > 0 {
+		for _, e := range m.Discrepancies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReplayDiscrepancy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Rebuilt)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Live)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingERC20DeploymentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PendingERC20DeploymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deployments) > 0 {
+		for _, e := range m.Deployments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ERC20ConversionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20ConversionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Conversion.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Factor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TokenPausesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TokenPausesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pauses) > 0 {
+		for _, e := range m.Pauses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OrchestratorQueryIdentityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OrchestratorQueryIdentityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Identity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *OutgoingTxCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checkpoint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SignedHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelayedSendToEthereumsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelayedSendToEthereumsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DelayedSendToEthereums) > 0 {
+		for _, e := range m.DelayedSendToEthereums {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingEventVoteRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingEventVoteRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastEventNonce != 0 {
+		n += 1 + sovQuery(uint64(m.LastEventNonce))
+	}
+	if m.LastObservedEventNonce != 0 {
+		n += 1 + sovQuery(uint64(m.LastObservedEventNonce))
+	}
+	if len(m.EventVoteRecords) > 0 {
+		for _, e := range m.EventVoteRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventByEthereumTxHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EthereumTxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EventByEthereumTxHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EventVoteRecords) > 0 {
+		for _, e := range m.EventVoteRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConflictingEthereumEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ConflictingEthereumEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Conflicts) > 0 {
+		for _, e := range m.Conflicts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConflictingEthereumEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovQuery(uint64(m.EventNonce))
+	}
+	if len(m.EventVoteRecords) > 0 {
+		for _, e := range m.EventVoteRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeTokenStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.LastDay.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LastWeek.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *BridgeStatsWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.InflowCount != 0 {
+		n += 1 + sovQuery(uint64(m.InflowCount))
+	}
+	if m.OutflowCount != 0 {
+		n += 1 + sovQuery(uint64(m.OutflowCount))
+	}
+	l = m.AverageFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SignerSetTxCalldataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovQuery(uint64(m.SignerSetNonce))
+	}
+	return n
+}
+
+func (m *BatchTxCalldataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallTxCalldataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovQuery(uint64(m.InvalidationNonce))
+	}
+	return n
+}
+
+func (m *OutgoingTxCalldataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CurrentSignerSetNonce != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentSignerSetNonce))
+	}
+	if m.SignedPower != 0 {
+		n += 1 + sovQuery(uint64(m.SignedPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	return n
+}
+
+func (m *AgreedEthereumHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AgreedEthereumHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeValidatorLivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BridgeValidatorLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeValidatorLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastSignatureHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastSignatureHeight))
+	}
+	if m.LastEventVoteHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastEventVoteHeight))
+	}
+	if m.BlocksSinceLastSignature != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksSinceLastSignature))
+	}
+	if m.BlocksSinceLastEventVote != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksSinceLastEventVote))
+	}
+	if m.LastEventNonce != 0 {
+		n += 1 + sovQuery(uint64(m.LastEventNonce))
+	}
+	if m.MissedSignatures != 0 {
+		n += 1 + sovQuery(uint64(m.MissedSignatures))
+	}
+	return n
+}
+
+func (m *BatchTxInclusionProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNonce))
+	}
+	if m.SendToEthereumId != 0 {
+		n += 1 + sovQuery(uint64(m.SendToEthereumId))
+	}
+	return n
+}
+
+func (m *BatchTxInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SendToEthereum != nil {
+		l = m.SendToEthereum.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Checkpoint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeValidatorInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeValidatorInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *BridgeValidatorInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SignatureScheme != 0 {
+		n += 1 + sovQuery(uint64(m.SignatureScheme))
+	}
+	if m.Bonded {
+		n += 2
+	}
+	l = m.Liveness.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.OptedOut {
+		n += 2
+	}
+	if m.Freeze != nil {
+		l = m.Freeze.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			}
+			m.SignerSetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestSignerSetTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestSignerSetTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestSignerSetTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignerSet == nil {
+				m.SignerSet = &SignerSetTx{}
+			}
+			if err := m.SignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxDeltaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxDeltaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxDeltaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			}
+			m.SignerSetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxDeltaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxDeltaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxDeltaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delta == nil {
+				m.Delta = &SignerSetTxDelta{}
+			}
+			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Batch == nil {
+				m.Batch = &BatchTx{}
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LogicCall == nil {
+				m.LogicCall = &ContractCallTx{}
+			}
+			if err := m.LogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxConfirmationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxConfirmationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			}
+			m.SignerSetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxConfirmationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &SignerSetTxConfirmation{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerSets = append(m.SignerSets, &SignerSetTx{})
+			if err := m.SignerSets[len(m.SignerSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTotalFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinTotalFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutBeforeHeight", wireType)
+			}
+			m.TimeoutBeforeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutBeforeHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureStatus", wireType)
+			}
+			m.SignatureStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureStatus |= BatchTxSignatureStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, &BatchTx{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calls = append(m.Calls, &ContractCallTx{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsignedSignerSetTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsignedSignerSetTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsignedSignerSetTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsignedSignerSetTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsignedSignerSetTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsignedSignerSetTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerSets = append(m.SignerSets, &SignerSetTx{})
+			if err := m.SignerSets[len(m.SignerSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsignedBatchTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsignedBatchTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsignedBatchTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsignedBatchTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsignedBatchTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsignedBatchTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, &BatchTx{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsignedContractCallTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsignedContractCallTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsignedContractCallTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsignedContractCallTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsignedContractCallTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsignedContractCallTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calls = append(m.Calls, &ContractCallTx{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxElements", wireType)
+			}
+			m.MaxElements = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxElements |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, TokenBatchFees{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenBatchFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenBatchFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenBatchFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TopFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestTxAge", wireType)
+			}
+			m.OldestTxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldestTxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallTxConfirmationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallTxConfirmationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallTxConfirmationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallTxConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &ContractCallTxConfirmation{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxConfirmationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxConfirmationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxConfirmationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &BatchTxConfirmation{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxConfirmationProgressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxConfirmationProgressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxConfirmationProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTxConfirmationProgressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTxConfirmationProgressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTxConfirmationProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			}
+			m.SignerSetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPower", wireType)
+			}
+			m.SignedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPower", wireType)
+			}
+			m.ThresholdPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signed = append(m.Signed, &EthereumSigner{})
+			if err := m.Signed[len(m.Signed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unsigned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unsigned = append(m.Unsigned, &EthereumSigner{})
+			if err := m.Unsigned[len(m.Unsigned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastSubmittedEthereumEventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastSubmittedEthereumEventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastSubmittedEthereumEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastSubmittedEthereumEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastSubmittedEthereumEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastSubmittedEthereumEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeightVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EthereumHeightVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventVoteHeight", wireType)
+			}
+			m.LastEventVoteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEventVoteHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+			}
+			m.LastObservedEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastObservedEthereumHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20ToDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20ToDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20ToDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20ToDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20ToDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20ToDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosOriginated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CosmosOriginated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomToERC20ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomToERC20ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomToERC20ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomToERC20ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomToERC20ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomToERC20ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Decimals", wireType)
+			}
+			m.Erc20Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Erc20Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomToERC20Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomToERC20Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomToERC20Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomToERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomToERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomToERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosOriginated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CosmosOriginated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysByValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysByValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysByValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysByValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysByValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysByEthereumSignerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysByEthereumSignerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysByEthereumSignerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysByEthereumSignerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysByEthereumSignerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysByEthereumSignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysByOrchestratorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysByOrchestratorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysByOrchestratorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysByOrchestratorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysByOrchestratorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysByOrchestratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchedSendToEthereumsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchedSendToEthereumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchedSendToEthereumsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchedSendToEthereumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendToEthereums = append(m.SendToEthereums, &SendToEthereum{})
+			if err := m.SendToEthereums[len(m.SendToEthereums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbatchedSendToEthereumsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbatchedSendToEthereumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbatchedSendToEthereumsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbatchedSendToEthereumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendToEthereums = append(m.SendToEthereums, &SendToEthereum{})
+			if err := m.SendToEthereums[len(m.SendToEthereums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastObservedEthereumHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastObservedEthereumHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastObservedEthereumHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastObservedEthereumHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastObservedEthereumHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastObservedEthereumHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastObservedEthereumHeight == nil {
+				m.LastObservedEthereumHeight = &LatestEthereumBlockHeight{}
+			}
+			if err := m.LastObservedEthereumHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectedEthereumHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectedEthereumHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectedEthereumHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectedEthereumHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectedEthereumHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectedEthereumHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastObservedEthereumHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedEthereumHeight", wireType)
+			}
+			m.ProjectedEthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectedEthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianEthereumHeightVote", wireType)
+			}
+			m.MedianEthereumHeightVote = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MedianEthereumHeightVote |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			m.TimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedSendToCosmosEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedSendToCosmosEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedSendToCosmosEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedSendToCosmosEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedSendToCosmosEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedSendToCosmosEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &SendToCosmosEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeldSendToCosmosEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeldSendToCosmosEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeldSendToCosmosEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeldSendToCosmosEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeldSendToCosmosEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeldSendToCosmosEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &SendToCosmosEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutedBatchTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutedBatchTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutedBatchTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutedBatchTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutedBatchTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutedBatchTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, BatchTxExecutionRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutedContractCallTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutedContractCallTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutedContractCallTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutedContractCallTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutedContractCallTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutedContractCallTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, ContractCallTxExecutionRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefundedContractCallTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefundedContractCallTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefundedContractCallTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refundee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refundee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefundedContractCallTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefundedContractCallTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefundedContractCallTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, ContractCallTxRefundRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeFeeAllowancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeFeeAllowancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeFeeAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeFeeAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeFeeAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeFeeAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, BridgeFeeAllowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumBlocklistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumBlocklistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumBlocklistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumBlocklistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumBlocklistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumBlocklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &BridgeModuleAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeModuleAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeModuleAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeModuleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purpose = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndBlockerActionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndBlockerActionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndBlockerActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndBlockerActionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndBlockerActionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndBlockerActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, &EndBlockerAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *UnbatchedSendToEthereumsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SenderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *UnbatchedSendToEthereumsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SendToEthereums) > 0 {
-		for _, e := range m.SendToEthereums {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *SignerSetTxsByHeightRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTxsByHeightRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTxsByHeightRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *LastObservedEthereumHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *LastObservedEthereumHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LastObservedEthereumHeight != nil {
-		l = m.LastObservedEthereumHeight.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *SignerSetTxsByHeightRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6099,12 +23634,82 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignerSetTxsByHeightRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignerSetTxsByHeightRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerSets = append(m.SignerSets, &ObservedSignerSetTx{})
+			if err := m.SignerSets[len(m.SignerSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6126,7 +23731,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *BridgeConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6149,45 +23754,12 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6209,7 +23781,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignerSetTxRequest) Unmarshal(dAtA []byte) error {
+func (m *BridgeConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6232,17 +23804,17 @@ func (m *SignerSetTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			m.SignerSetNonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6252,11 +23824,25 @@ func (m *SignerSetTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6278,7 +23864,7 @@ func (m *SignerSetTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LatestSignerSetTxRequest) Unmarshal(dAtA []byte) error {
+func (m *ReplayDiffRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6301,10 +23887,10 @@ func (m *LatestSignerSetTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LatestSignerSetTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplayDiffRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestSignerSetTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplayDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6328,7 +23914,7 @@ func (m *LatestSignerSetTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignerSetTxResponse) Unmarshal(dAtA []byte) error {
+func (m *ReplayDiffResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6351,15 +23937,15 @@ func (m *SignerSetTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplayDiffResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplayDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignerSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Discrepancies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6386,10 +23972,8 @@ func (m *SignerSetTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SignerSet == nil {
-				m.SignerSet = &SignerSetTx{}
-			}
-			if err := m.SignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Discrepancies = append(m.Discrepancies, ReplayDiscrepancy{})
+			if err := m.Discrepancies[len(m.Discrepancies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6414,7 +23998,7 @@ func (m *SignerSetTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTxRequest) Unmarshal(dAtA []byte) error {
+func (m *ReplayDiscrepancy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6437,15 +24021,15 @@ func (m *BatchTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplayDiscrepancy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplayDiscrepancy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6473,13 +24057,13 @@ func (m *BatchTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebuilt", wireType)
 			}
-			m.BatchNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6489,11 +24073,56 @@ func (m *BatchTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rebuilt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Live", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Live = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6515,7 +24144,7 @@ func (m *BatchTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTxResponse) Unmarshal(dAtA []byte) error {
+func (m *PendingERC20DeploymentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6538,15 +24167,65 @@ func (m *BatchTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingERC20DeploymentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingERC20DeploymentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingERC20DeploymentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingERC20DeploymentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingERC20DeploymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6573,10 +24252,8 @@ func (m *BatchTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Batch == nil {
-				m.Batch = &BatchTx{}
-			}
-			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deployments = append(m.Deployments, ERC20DeployedEvent{})
+			if err := m.Deployments[len(m.Deployments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6601,7 +24278,7 @@ func (m *BatchTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCallTxRequest) Unmarshal(dAtA []byte) error {
+func (m *ERC20ConversionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6624,17 +24301,17 @@ func (m *ContractCallTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ERC20ConversionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ERC20ConversionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6644,45 +24321,24 @@ func (m *ContractCallTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
-			if m.InvalidationScope == nil {
-				m.InvalidationScope = []byte{}
-			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
-			}
-			m.InvalidationNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6704,7 +24360,7 @@ func (m *ContractCallTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCallTxResponse) Unmarshal(dAtA []byte) error {
+func (m *ERC20ConversionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6727,15 +24383,15 @@ func (m *ContractCallTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ERC20ConversionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ERC20ConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conversion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6762,68 +24418,47 @@ func (m *ContractCallTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LogicCall == nil {
-				m.LogicCall = &ContractCallTx{}
-			}
-			if err := m.LogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Conversion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SignerSetTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTxConfirmationsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTxConfirmationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
 			}
-			m.SignerSetNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6833,11 +24468,26 @@ func (m *SignerSetTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6859,7 +24509,7 @@ func (m *SignerSetTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignerSetTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
+func (m *TokenPausesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6882,46 +24532,12 @@ func (m *SignerSetTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTxConfirmationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenPausesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTxConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenPausesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signatures = append(m.Signatures, &SignerSetTxConfirmation{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6943,7 +24559,7 @@ func (m *SignerSetTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignerSetTxsRequest) Unmarshal(dAtA []byte) error {
+func (m *TokenPausesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6966,15 +24582,15 @@ func (m *SignerSetTxsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTxsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenPausesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenPausesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7001,10 +24617,8 @@ func (m *SignerSetTxsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pauses = append(m.Pauses, TokenPause{})
+			if err := m.Pauses[len(m.Pauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7029,7 +24643,7 @@ func (m *SignerSetTxsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignerSetTxsResponse) Unmarshal(dAtA []byte) error {
+func (m *OrchestratorQueryIdentityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7052,51 +24666,17 @@ func (m *SignerSetTxsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTxsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrchestratorQueryIdentityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrchestratorQueryIdentityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignerSets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SignerSets = append(m.SignerSets, &SignerSetTx{})
-			if err := m.SignerSets[len(m.SignerSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7106,26 +24686,24 @@ func (m *SignerSetTxsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.KeyHash = append(m.KeyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyHash == nil {
+				m.KeyHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -7149,7 +24727,7 @@ func (m *SignerSetTxsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTxsRequest) Unmarshal(dAtA []byte) error {
+func (m *OrchestratorQueryIdentityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7172,15 +24750,15 @@ func (m *BatchTxsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrchestratorQueryIdentityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrchestratorQueryIdentityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7207,10 +24785,7 @@ func (m *BatchTxsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Identity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7235,7 +24810,7 @@ func (m *BatchTxsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTxsResponse) Unmarshal(dAtA []byte) error {
+func (m *OutgoingTxCheckpointResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7258,17 +24833,17 @@ func (m *BatchTxsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutgoingTxCheckpointResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutgoingTxCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7278,31 +24853,31 @@ func (m *BatchTxsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Batches = append(m.Batches, &BatchTx{})
-			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Checkpoint = append(m.Checkpoint[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checkpoint == nil {
+				m.Checkpoint = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7312,83 +24887,31 @@ func (m *BatchTxsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SignedHash = append(m.SignedHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignedHash == nil {
+				m.SignedHash = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContractCallTxsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTxsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7398,27 +24921,23 @@ func (m *ContractCallTxsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7441,7 +24960,7 @@ func (m *ContractCallTxsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCallTxsResponse) Unmarshal(dAtA []byte) error {
+func (m *DelayedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7464,17 +24983,17 @@ func (m *ContractCallTxsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTxsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelayedSendToEthereumsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelayedSendToEthereumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7484,25 +25003,23 @@ func (m *ContractCallTxsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Calls = append(m.Calls, &ContractCallTx{})
-			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -7534,7 +25051,7 @@ func (m *ContractCallTxsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -7561,7 +25078,7 @@ func (m *ContractCallTxsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsignedSignerSetTxsRequest) Unmarshal(dAtA []byte) error {
+func (m *DelayedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7584,17 +25101,17 @@ func (m *UnsignedSignerSetTxsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsignedSignerSetTxsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelayedSendToEthereumsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsignedSignerSetTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelayedSendToEthereumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedSendToEthereums", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7604,77 +25121,29 @@ func (m *UnsignedSignerSetTxsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.DelayedSendToEthereums = append(m.DelayedSendToEthereums, DelayedSendToEthereum{})
+			if err := m.DelayedSendToEthereums[len(m.DelayedSendToEthereums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnsignedSignerSetTxsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnsignedSignerSetTxsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsignedSignerSetTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignerSets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7701,8 +25170,10 @@ func (m *UnsignedSignerSetTxsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignerSets = append(m.SignerSets, &SignerSetTx{})
-			if err := m.SignerSets[len(m.SignerSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7727,7 +25198,7 @@ func (m *UnsignedSignerSetTxsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsignedBatchTxsRequest) Unmarshal(dAtA []byte) error {
+func (m *PendingEventVoteRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7750,15 +25221,15 @@ func (m *UnsignedBatchTxsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsignedBatchTxsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingEventVoteRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsignedBatchTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingEventVoteRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7786,7 +25257,7 @@ func (m *UnsignedBatchTxsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7809,7 +25280,7 @@ func (m *UnsignedBatchTxsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsignedBatchTxsResponse) Unmarshal(dAtA []byte) error {
+func (m *PendingEventVoteRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7832,15 +25303,53 @@ func (m *UnsignedBatchTxsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsignedBatchTxsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingEventVoteRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsignedBatchTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingEventVoteRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventNonce", wireType)
+			}
+			m.LastEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+			}
+			m.LastObservedEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventVoteRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7867,8 +25376,8 @@ func (m *UnsignedBatchTxsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Batches = append(m.Batches, &BatchTx{})
-			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EventVoteRecords = append(m.EventVoteRecords, &EthereumEventVoteRecord{})
+			if err := m.EventVoteRecords[len(m.EventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7893,7 +25402,7 @@ func (m *UnsignedBatchTxsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsignedContractCallTxsRequest) Unmarshal(dAtA []byte) error {
+func (m *EventByEthereumTxHashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7916,15 +25425,15 @@ func (m *UnsignedContractCallTxsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsignedContractCallTxsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventByEthereumTxHashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsignedContractCallTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventByEthereumTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7952,7 +25461,7 @@ func (m *UnsignedContractCallTxsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.EthereumTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7975,7 +25484,7 @@ func (m *UnsignedContractCallTxsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsignedContractCallTxsResponse) Unmarshal(dAtA []byte) error {
+func (m *EventByEthereumTxHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7998,15 +25507,15 @@ func (m *UnsignedContractCallTxsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsignedContractCallTxsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventByEthereumTxHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsignedContractCallTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventByEthereumTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventVoteRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8033,8 +25542,8 @@ func (m *UnsignedContractCallTxsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Calls = append(m.Calls, &ContractCallTx{})
-			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EventVoteRecords = append(m.EventVoteRecords, &EthereumEventVoteRecord{})
+			if err := m.EventVoteRecords[len(m.EventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8059,57 +25568,7 @@ func (m *UnsignedContractCallTxsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTxFeesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxFeesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BatchTxFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *ConflictingEthereumEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8132,46 +25591,12 @@ func (m *BatchTxFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConflictingEthereumEventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConflictingEthereumEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fees = append(m.Fees, types.Coin{})
-			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8193,7 +25618,7 @@ func (m *BatchTxFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCallTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
+func (m *ConflictingEthereumEventsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8216,17 +25641,17 @@ func (m *ContractCallTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTxConfirmationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConflictingEthereumEventsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTxConfirmationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConflictingEthereumEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8236,45 +25661,26 @@ func (m *ContractCallTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
-			if m.InvalidationScope == nil {
-				m.InvalidationScope = []byte{}
+			m.Conflicts = append(m.Conflicts, ConflictingEthereumEvents{})
+			if err := m.Conflicts[len(m.Conflicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
-			}
-			m.InvalidationNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8296,7 +25702,7 @@ func (m *ContractCallTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCallTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
+func (m *ConflictingEthereumEvents) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8319,15 +25725,34 @@ func (m *ContractCallTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTxConfirmationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConflictingEthereumEvents: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTxConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConflictingEthereumEvents: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventVoteRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8354,8 +25779,8 @@ func (m *ContractCallTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, &ContractCallTxConfirmation{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EventVoteRecords = append(m.EventVoteRecords, &EthereumEventVoteRecord{})
+			if err := m.EventVoteRecords[len(m.EventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8380,7 +25805,7 @@ func (m *ContractCallTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
+func (m *BridgeStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8403,32 +25828,13 @@ func (m *BatchTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxConfirmationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxConfirmationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
-			}
-			m.BatchNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
@@ -8481,7 +25887,7 @@ func (m *BatchTxConfirmationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
+func (m *BridgeStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8504,15 +25910,15 @@ func (m *BatchTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTxConfirmationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTxConfirmationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8539,8 +25945,8 @@ func (m *BatchTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, &BatchTxConfirmation{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Stats = append(m.Stats, BridgeTokenStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8565,7 +25971,7 @@ func (m *BatchTxConfirmationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastSubmittedEthereumEventRequest) Unmarshal(dAtA []byte) error {
+func (m *BridgeTokenStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8588,15 +25994,15 @@ func (m *LastSubmittedEthereumEventRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastSubmittedEthereumEventRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeTokenStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastSubmittedEthereumEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeTokenStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8624,7 +26030,73 @@ func (m *LastSubmittedEthereumEventRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastDay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWeek", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastWeek.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8647,7 +26119,7 @@ func (m *LastSubmittedEthereumEventRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastSubmittedEthereumEventResponse) Unmarshal(dAtA []byte) error {
+func (m *BridgeStatsWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8670,17 +26142,17 @@ func (m *LastSubmittedEthereumEventResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastSubmittedEthereumEventResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeStatsWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastSubmittedEthereumEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeStatsWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
 			}
-			m.EventNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8690,64 +26162,101 @@ func (m *LastSubmittedEthereumEventResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ERC20ToDenomRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflowCount", wireType)
+			}
+			m.InflowCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflowCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowCount", wireType)
+			}
+			m.OutflowCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutflowCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20ToDenomRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20ToDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8775,7 +26284,9 @@ func (m *ERC20ToDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			if err := m.AverageFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8798,7 +26309,7 @@ func (m *ERC20ToDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20ToDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *SignerSetTxCalldataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8821,49 +26332,17 @@ func (m *ERC20ToDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20ToDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignerSetTxCalldataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20ToDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignerSetTxCalldataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosOriginated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
 			}
-			var v int
+			m.SignerSetNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8873,12 +26352,11 @@ func (m *ERC20ToDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CosmosOriginated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8900,7 +26378,7 @@ func (m *ERC20ToDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomToERC20ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *BatchTxCalldataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8923,15 +26401,34 @@ func (m *DenomToERC20ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomToERC20ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchTxCalldataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomToERC20ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchTxCalldataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8959,7 +26456,7 @@ func (m *DenomToERC20ParamsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8982,7 +26479,7 @@ func (m *DenomToERC20ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomToERC20ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ContractCallTxCalldataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9005,49 +26502,17 @@ func (m *DenomToERC20ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomToERC20ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCallTxCalldataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomToERC20ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCallTxCalldataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9057,61 +26522,31 @@ func (m *DenomToERC20ParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Symbol", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
 			}
-			m.Erc20Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Decimals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
 			}
-			m.Erc20Decimals = 0
+			m.InvalidationNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9121,7 +26556,7 @@ func (m *DenomToERC20ParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Erc20Decimals |= uint64(b&0x7F) << shift
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9147,7 +26582,7 @@ func (m *DenomToERC20ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomToERC20Request) Unmarshal(dAtA []byte) error {
+func (m *OutgoingTxCalldataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9170,17 +26605,17 @@ func (m *DenomToERC20Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomToERC20Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutgoingTxCalldataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomToERC20Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutgoingTxCalldataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9190,79 +26625,31 @@ func (m *DenomToERC20Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DenomToERC20Response) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DenomToERC20Response: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomToERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSignerSetNonce", wireType)
 			}
-			var stringLen uint64
+			m.CurrentSignerSetNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9272,29 +26659,35 @@ func (m *DenomToERC20Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CurrentSignerSetNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPower", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SignedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Erc20 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosOriginated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
 			}
-			var v int
+			m.TotalPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9304,12 +26697,11 @@ func (m *DenomToERC20Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TotalPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CosmosOriginated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9331,7 +26723,7 @@ func (m *DenomToERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateKeysByValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *AgreedEthereumHeaderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9354,44 +26746,12 @@ func (m *DelegateKeysByValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysByValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AgreedEthereumHeaderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AgreedEthereumHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9413,7 +26773,7 @@ func (m *DelegateKeysByValidatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateKeysByValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *AgreedEthereumHeaderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9436,17 +26796,17 @@ func (m *DelegateKeysByValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysByValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AgreedEthereumHeaderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysByValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AgreedEthereumHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9456,29 +26816,33 @@ func (m *DelegateKeysByValidatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			if m.Header == nil {
+				m.Header = &EthereumHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9488,23 +26852,25 @@ func (m *DelegateKeysByValidatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			m.Votes = append(m.Votes, EthereumHeaderVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9527,7 +26893,7 @@ func (m *DelegateKeysByValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateKeysByEthereumSignerRequest) Unmarshal(dAtA []byte) error {
+func (m *BridgeValidatorLivenessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9550,17 +26916,67 @@ func (m *DelegateKeysByEthereumSignerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysByEthereumSignerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeValidatorLivenessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysByEthereumSignerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeValidatorLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeValidatorLivenessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeValidatorLivenessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeValidatorLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9570,23 +26986,25 @@ func (m *DelegateKeysByEthereumSignerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, BridgeValidatorLiveness{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9609,7 +27027,7 @@ func (m *DelegateKeysByEthereumSignerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateKeysByEthereumSignerResponse) Unmarshal(dAtA []byte) error {
+func (m *BridgeValidatorLiveness) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9632,10 +27050,10 @@ func (m *DelegateKeysByEthereumSignerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysByEthereumSignerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeValidatorLiveness: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysByEthereumSignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeValidatorLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9652,29 +27070,124 @@ func (m *DelegateKeysByEthereumSignerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSignatureHeight", wireType)
+			}
+			m.LastSignatureHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSignatureHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventVoteHeight", wireType)
+			}
+			m.LastEventVoteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEventVoteHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksSinceLastSignature", wireType)
+			}
+			m.BlocksSinceLastSignature = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksSinceLastSignature |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksSinceLastEventVote", wireType)
+			}
+			m.BlocksSinceLastEventVote = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksSinceLastEventVote |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventNonce", wireType)
+			}
+			m.LastEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedSignatures", wireType)
 			}
-			var stringLen uint64
+			m.MissedSignatures = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9684,24 +27197,11 @@ func (m *DelegateKeysByEthereumSignerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MissedSignatures |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9723,7 +27223,7 @@ func (m *DelegateKeysByEthereumSignerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateKeysByOrchestratorRequest) Unmarshal(dAtA []byte) error {
+func (m *BatchTxInclusionProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9746,15 +27246,15 @@ func (m *DelegateKeysByOrchestratorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysByOrchestratorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchTxInclusionProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysByOrchestratorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchTxInclusionProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9782,8 +27282,46 @@ func (m *DelegateKeysByOrchestratorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereumId", wireType)
+			}
+			m.SendToEthereumId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendToEthereumId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9805,7 +27343,7 @@ func (m *DelegateKeysByOrchestratorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateKeysByOrchestratorResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchTxInclusionProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9828,17 +27366,17 @@ func (m *DelegateKeysByOrchestratorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysByOrchestratorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchTxInclusionProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysByOrchestratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchTxInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereum", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9848,29 +27386,33 @@ func (m *DelegateKeysByOrchestratorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if m.SendToEthereum == nil {
+				m.SendToEthereum = &SendToEthereum{}
+			}
+			if err := m.SendToEthereum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSigner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9880,129 +27422,101 @@ func (m *DelegateKeysByOrchestratorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumSigner = string(dAtA[iNdEx:postIndex])
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegateKeysRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Proof == nil {
+				m.Proof = &crypto.Proof{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegateKeysResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Checkpoint = append(m.Checkpoint[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checkpoint == nil {
+				m.Checkpoint = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateKeysResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10012,24 +27526,24 @@ func (m *DelegateKeysResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StoreKey = append(m.StoreKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StoreKey == nil {
+				m.StoreKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -10053,7 +27567,7 @@ func (m *DelegateKeysResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
+func (m *BridgeValidatorInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10076,15 +27590,15 @@ func (m *BatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchedSendToEthereumsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeValidatorInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchedSendToEthereumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeValidatorInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10112,7 +27626,7 @@ func (m *BatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10135,7 +27649,7 @@ func (m *BatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
+func (m *BridgeValidatorInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10158,15 +27672,15 @@ func (m *BatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchedSendToEthereumsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeValidatorInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchedSendToEthereumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeValidatorInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10193,8 +27707,7 @@ func (m *BatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SendToEthereums = append(m.SendToEthereums, &SendToEthereum{})
-			if err := m.SendToEthereums[len(m.SendToEthereums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10219,7 +27732,7 @@ func (m *BatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
+func (m *BridgeValidatorInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10242,15 +27755,15 @@ func (m *UnbatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbatchedSendToEthereumsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeValidatorInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbatchedSendToEthereumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeValidatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10278,13 +27791,13 @@ func (m *UnbatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10294,83 +27807,29 @@ func (m *UnbatchedSendToEthereumsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnbatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnbatchedSendToEthereumsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbatchedSendToEthereumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendToEthereums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10380,29 +27839,66 @@ func (m *UnbatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SendToEthereums = append(m.SendToEthereums, &SendToEthereum{})
-			if err := m.SendToEthereums[len(m.SendToEthereums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureScheme", wireType)
+			}
+			m.SignatureScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureScheme |= SignatureScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Bonded = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liveness", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10429,116 +27925,33 @@ func (m *UnbatchedSendToEthereumsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Liveness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LastObservedEthereumHeightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LastObservedEthereumHeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastObservedEthereumHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LastObservedEthereumHeightResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedOut", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LastObservedEthereumHeightResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastObservedEthereumHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.OptedOut = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Freeze", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10565,10 +27978,10 @@ func (m *LastObservedEthereumHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastObservedEthereumHeight == nil {
-				m.LastObservedEthereumHeight = &LatestEthereumBlockHeight{}
+			if m.Freeze == nil {
+				m.Freeze = &OrchestratorFreeze{}
 			}
-			if err := m.LastObservedEthereumHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Freeze.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SignatureVerifier verifies the signatures of one scheme over the checkpoints
+// of outgoing txs. Verifiers are registered with the keeper by scheme, and a
+// validator can only select a scheme whose verifier is registered.
+type SignatureVerifier interface {
+	// VerifySignature returns an error if the signature is malformed, and
+	// false if it is well formed but doesn't sign the checkpoint for the
+	// ethereum address, which provably makes it a wrong signature
+	VerifySignature(ctx sdk.Context, checkpoint []byte, signature []byte, ethAddress common.Address) (bool, error)
+}
+
+var _ SignatureVerifier = ECDSASignatureVerifier{}
+
+// ECDSASignatureVerifier verifies ECDSA signatures by the ethereum key of a
+// validator, the SIGNATURE_SCHEME_ECDSA scheme
+type ECDSASignatureVerifier struct{}
+
+// VerifySignature recovers the signer of the signature over the checkpoint
+func (ECDSASignatureVerifier) VerifySignature(_ sdk.Context, checkpoint []byte, signature []byte, ethAddress common.Address) (bool, error) {
+	signer, err := EthereumSignatureSigner(checkpoint, signature)
+	if err != nil {
+		return false, err
+	}
+	return signer == ethAddress, nil
+}
+
+// ValidateSignatureScheme returns an error for values that aren't a
+// SignatureScheme
+func ValidateSignatureScheme(scheme SignatureScheme) error {
+	if _, ok := SignatureScheme_name[int32(scheme)]; !ok {
+		return sdkerrors.Wrapf(ErrUnsupportedSignatureScheme, "unknown signature scheme %d", scheme)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
 //////////////////////////////////////
@@ -122,6 +123,52 @@ func NewSignerSetTx(nonce, height uint64, members EthereumSigners) *SignerSetTx
 	return &SignerSetTx{Nonce: nonce, Height: height, Signers: mem}
 }
 
+// NewSignerSetTxDelta returns the signers added to, removed from and changing
+// power in the next signer set compared to the previous one, which is nil for
+// the first signer set
+func NewSignerSetTxDelta(previous, next *SignerSetTx) SignerSetTxDelta {
+	delta := SignerSetTxDelta{Nonce: next.Nonce, Height: next.Height}
+
+	previousPowers := map[string]uint64{}
+	if previous != nil {
+		delta.PreviousNonce = previous.Nonce
+		for _, signer := range previous.Signers {
+			previousPowers[signer.EthereumAddress] = signer.Power
+		}
+	}
+
+	nextPowers := map[string]uint64{}
+	for _, signer := range next.Signers {
+		nextPowers[signer.EthereumAddress] = signer.Power
+		power, ok := previousPowers[signer.EthereumAddress]
+		switch {
+		case !ok:
+			delta.Added = append(delta.Added, &EthereumSigner{Power: signer.Power, EthereumAddress: signer.EthereumAddress})
+		case power != signer.Power:
+			delta.PowerChanged = append(delta.PowerChanged, &EthereumSigner{Power: signer.Power, EthereumAddress: signer.EthereumAddress})
+		}
+	}
+	if previous != nil {
+		for _, signer := range previous.Signers {
+			if _, ok := nextPowers[signer.EthereumAddress]; !ok {
+				delta.Removed = append(delta.Removed, &EthereumSigner{Power: signer.Power, EthereumAddress: signer.EthereumAddress})
+			}
+		}
+	}
+
+	delta.Added.Sort()
+	delta.Removed.Sort()
+	delta.PowerChanged.Sort()
+	return delta
+}
+
+// BatchTxGasEstimate returns the ethereum gas a batch of transfers is
+// estimated to cost when relayed with the signatures of a signer set of the
+// given size
+func BatchTxGasEstimate(params Params, transfers, signatures int) uint64 {
+	return params.BatchGasBaseCost + uint64(transfers)*params.BatchGasPerTransfer + uint64(signatures)*params.BatchGasPerSignature
+}
+
 // GetFees returns the total fees contained within a given batch
 func (b BatchTx) GetFees() sdk.Int {
 	sum := sdk.ZeroInt()
@@ -130,3 +177,109 @@ func (b BatchTx) GetFees() sdk.Int {
 	}
 	return sum
 }
+
+// GetBridgeFeeCoins returns the fee paid in the bridge fee denom, empty if the
+// fee is paid in the token
+func (s SendToEthereum) GetBridgeFeeCoins() sdk.Coins {
+	if s.BridgeFee == nil || !s.BridgeFee.IsValid() || s.BridgeFee.IsZero() {
+		return sdk.Coins{}
+	}
+	return sdk.Coins{*s.BridgeFee}
+}
+
+// GetChainFeeCoins returns the chain fee escrowed for the tx, empty if it has
+// none
+func (s SendToEthereum) GetChainFeeCoins() sdk.Coins {
+	if s.ChainFee == nil || !s.ChainFee.IsValid() || s.ChainFee.IsZero() {
+		return sdk.Coins{}
+	}
+	return sdk.Coins{*s.ChainFee}
+}
+
+//////////////////////////////////////
+//   Orchestrator Query Identity    //
+//////////////////////////////////////
+
+// HashOrchestratorQueryKey returns the hash under which an orchestrator API key
+// is registered, so that the key itself never appears on chain
+func HashOrchestratorQueryKey(apiKey string) []byte {
+	hash := sha256.Sum256([]byte(apiKey))
+	return hash[:]
+}
+
+// ValidateBasic performs stateless checks on validity
+func (i OrchestratorQueryIdentity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(i.Orchestrator); err != nil {
+		return sdkerrors.Wrap(err, "orchestrator")
+	}
+	if len(i.KeyHash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalid, "key hash must be %d bytes, got %d", sha256.Size, len(i.KeyHash))
+	}
+	return nil
+}
+
+// IsExpired returns true if the identity is no longer valid at the height
+func (i OrchestratorQueryIdentity) IsExpired(height uint64) bool {
+	return height >= i.ExpiryHeight
+}
+
+//////////////////////////////////////
+//     Delayed Send To Ethereum     //
+//////////////////////////////////////
+
+// ValidateBasic performs stateless checks on validity
+func (d DelayedSendToEthereum) ValidateBasic() error {
+	ste := d.SendToEthereum
+	if _, err := sdk.AccAddressFromBech32(ste.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if err := ValidateEthAddress(ste.EthereumRecipient); err != nil {
+		return sdkerrors.Wrap(err, "ethereum recipient")
+	}
+	if err := ValidateEthAddress(ste.Erc20Token.Contract); err != nil {
+		return sdkerrors.Wrap(err, "token contract")
+	}
+	if ste.Erc20Token.Amount.IsNil() || !ste.Erc20Token.Amount.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalid, "amount must be positive")
+	}
+	return nil
+}
+
+//////////////////////////////////////
+//         Ethereum Header          //
+//////////////////////////////////////
+
+// ValidateBasic performs stateless checks on validity
+func (h EthereumHeader) ValidateBasic() error {
+	if h.Height == 0 {
+		return sdkerrors.Wrap(ErrInvalidEthereumHeader, "height cannot be 0")
+	}
+	if len(h.Hash) != common.HashLength {
+		return sdkerrors.Wrapf(ErrInvalidEthereumHeader, "hash must be %d bytes, got %x", common.HashLength, h.Hash)
+	}
+	if len(h.ParentCheckpointHash) != common.HashLength {
+		return sdkerrors.Wrapf(ErrInvalidEthereumHeader, "parent checkpoint hash must be %d bytes, got %x", common.HashLength, h.ParentCheckpointHash)
+	}
+	if uint64(len(h.BlockHashes)) > h.Height {
+		return sdkerrors.Wrapf(ErrInvalidEthereumHeader, "%d block hashes below height %d", len(h.BlockHashes), h.Height)
+	}
+	for _, blockHash := range h.BlockHashes {
+		if len(blockHash) != common.HashLength {
+			return sdkerrors.Wrapf(ErrInvalidEthereumHeader, "block hash must be %d bytes, got %x", common.HashLength, blockHash)
+		}
+	}
+	if len(h.BlockHashes) != 0 && !bytes.Equal(h.BlockHashes[len(h.BlockHashes)-1], h.Hash) {
+		return sdkerrors.Wrap(ErrInvalidEthereumHeader, "block hashes must end with the hash of the checkpoint block")
+	}
+	return nil
+}
+
+// BlockHash returns the agreed hash of the block at the height, and whether
+// the height is covered by the block hashes of the header
+func (h EthereumHeader) BlockHash(height uint64) (tmbytes.HexBytes, bool) {
+	first := h.Height - uint64(len(h.BlockHashes)) + 1
+	if height < first || height > h.Height {
+		return nil, false
+	}
+	return h.BlockHashes[height-first], true
+}
@@ -168,3 +168,77 @@ func shuffled(v EthereumSigners) EthereumSigners {
 	})
 	return v
 }
+
+func TestParseIBCForward(t *testing.T) {
+	_, _, err := ParseIBCForward("cosmos1qqqq")
+	assert.ErrorIs(t, err, ErrInvalidIBCForward)
+
+	_, _, err = ParseIBCForward("channel-3:")
+	assert.ErrorIs(t, err, ErrInvalidIBCForward)
+
+	channel, receiver, err := ParseIBCForward("channel-0:cosmos1qqqq")
+	assert.NoError(t, err)
+	assert.Equal(t, "channel-0", channel)
+	assert.Equal(t, "cosmos1qqqq", receiver)
+}
+
+func TestParseDepositMemo(t *testing.T) {
+	_, _, ok := ParseDepositMemo([]byte("not json"))
+	assert.False(t, ok)
+
+	_, _, ok = ParseDepositMemo([]byte(`{"swap": {}, "stake": {}}`))
+	assert.False(t, ok)
+
+	route, payload, ok := ParseDepositMemo([]byte(`{"swap": {"min_out": "10"}}`))
+	assert.True(t, ok)
+	assert.Equal(t, "swap", route)
+	assert.JSONEq(t, `{"min_out": "10"}`, string(payload))
+}
+
+func TestEthereumEventTxHash(t *testing.T) {
+	event := &BatchExecutedEvent{
+		TokenContract:  "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		EventNonce:     1,
+		EthereumHeight: 42,
+		BatchNonce:     3,
+	}
+	withoutTxHash := event.Hash()
+	assert.NoError(t, event.Validate())
+
+	event.EthereumTxHash = gethcommon.HexToHash("0x5e0a4d8ba2f9bde0b3a7d1c1e2c3b8a4f0e1d2c3b4a5968778695a4b3c2d1e0f").Bytes()
+	assert.NoError(t, event.Validate())
+	assert.NotEqual(t, withoutTxHash, event.Hash())
+
+	event.EthereumTxHash = []byte{1, 2, 3}
+	assert.ErrorIs(t, event.Validate(), ErrInvalid)
+}
+
+func TestNewSignerSetTxDelta(t *testing.T) {
+	var (
+		addr1 = "0xc783df8a850f42e7F7e57013759C285caa701eB6"
+		addr2 = "0xeAD9C93b79Ae7C1591b1FB5323BD777E86e150d4"
+		addr3 = "0xE5904695748fe4A84b40b3fc79De2277660BD1D3"
+	)
+	previous := NewSignerSetTx(1, 10, EthereumSigners{
+		{Power: 3333, EthereumAddress: addr1},
+		{Power: 3333, EthereumAddress: addr2},
+	})
+	next := NewSignerSetTx(2, 20, EthereumSigners{
+		{Power: 5000, EthereumAddress: addr1},
+		{Power: 1000, EthereumAddress: addr3},
+	})
+
+	delta := NewSignerSetTxDelta(previous, next)
+	assert.Equal(t, uint64(2), delta.Nonce)
+	assert.Equal(t, uint64(1), delta.PreviousNonce)
+	assert.Equal(t, EthereumSigners{{Power: 1000, EthereumAddress: addr3}}, delta.Added)
+	assert.Equal(t, EthereumSigners{{Power: 3333, EthereumAddress: addr2}}, delta.Removed)
+	assert.Equal(t, EthereumSigners{{Power: 5000, EthereumAddress: addr1}}, delta.PowerChanged)
+
+	// every signer of the first signer set is added
+	first := NewSignerSetTxDelta(nil, previous)
+	assert.Equal(t, uint64(0), first.PreviousNonce)
+	assert.Len(t, first.Added, 2)
+	assert.Empty(t, first.Removed)
+	assert.Empty(t, first.PowerChanged)
+}
@@ -171,6 +171,7 @@ pub fn ethereum_event_messages<CS: CosmosSigner>(
             batch_nonce: downcast_to_u64(batch.batch_nonce).unwrap(),
             ethereum_height: downcast_to_u64(batch.block_height).unwrap(),
             token_contract: format_eth_address(batch.erc20),
+            relayer: format_eth_address(batch.relayer),
         };
         let msg = proto::MsgSubmitEthereumEvent {
             signer: cosmos_address.to_string(),
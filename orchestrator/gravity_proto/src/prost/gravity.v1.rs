@@ -309,6 +309,10 @@ pub struct BatchExecutedEvent {
     pub ethereum_height: u64,
     #[prost(uint64, tag = "4")]
     pub batch_nonce: u64,
+    /// ethereum address that submitted the batch, rewarded from the relayer
+    /// reward pool through its orchestrator
+    #[prost(string, tag = "5")]
+    pub relayer: ::prost::alloc::string::String,
 }
 // ContractCallExecutedEvent describes a contract call that has been
 // successfully executed on Ethereum.
@@ -177,6 +177,9 @@ pub struct TransactionBatchExecutedEvent {
     /// of the Gravity solidity contract. Ensuring that these events can only be played
     /// back in order
     pub event_nonce: U256,
+    /// The Ethereum address that submitted the batch, paid a share of the batch
+    /// fees by the Cosmos module
+    pub relayer: EthAddress,
 }
 
 impl FromLog for TransactionBatchExecutedEvent {
@@ -188,6 +191,7 @@ impl FromLog for TransactionBatchExecutedEvent {
             block_height: block_height_from_log(input)?,
             erc20: event.token,
             event_nonce: event.event_nonce,
+            relayer: event.relayer,
         })
     }
 }
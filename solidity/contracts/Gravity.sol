@@ -24,6 +24,7 @@ error MalformedBatch();
 error InsufficientPower(uint256 cumulativePower, uint256 powerThreshold);
 error BatchTimedOut();
 error LogicCallTimedOut();
+error InvalidMultiLogicCalls();
 
 // This is being used purely to avoid stack too deep errors
 struct LogicCallArgs {
@@ -42,6 +43,26 @@ struct LogicCallArgs {
 	uint256 invalidationNonce;
 }
 
+// MultiLogicCallArgs is a LogicCallArgs executing several calls in order and
+// atomically. The transfers go to the logic contract of the first call, and the
+// values of the calls are paid from the ether sent along by the relayer.
+struct MultiLogicCallArgs {
+	// Transfers out to the logic contract of the first call
+	uint256[] transferAmounts;
+	address[] transferTokenContracts;
+	// The fees (transferred to msg.sender)
+	uint256[] feeAmounts;
+	address[] feeTokenContracts;
+	// The arbitrary logic calls
+	address[] logicContractAddresses;
+	bytes[] payloads;
+	uint256[] values;
+	// Invalidation metadata
+	uint256 timeOut;
+	bytes32 invalidationId;
+	uint256 invalidationNonce;
+}
+
 // This is used purely to avoid stack too deep errors
 // represents everything about a given validator set
 struct ValsetArgs {
@@ -76,6 +97,11 @@ contract Gravity is ReentrancyGuard, AccessControl, Pausable, Ownable {
 	bytes32 public constant RELAYER = keccak256("RELAYER");
 	bytes32 public constant RELAYER_ADMIN = keccak256("RELAYER_ADMIN");
 
+	// Pseudo token contract standing for native ether. Ether deposited with
+	// sendEtherToCosmos is credited on Cosmos as this token, and batches of it
+	// pay out ether.
+	address public constant NATIVE_ETHER = 0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE;
+
 	// These are updated often
 	bytes32 public state_lastValsetCheckpoint;
 	mapping(address => uint256) public state_lastBatchNonces;
@@ -123,10 +149,13 @@ contract Gravity is ReentrancyGuard, AccessControl, Pausable, Ownable {
 	//
 	// ValsetUpdatedEvent does not include the field _eventNonce because it is never submitted to the Cosmos
 	// module. It is purely for the use of relayers to allow them to successfully submit batches.
+	// TransactionBatchExecutedEvent carries the address that submitted the batch, which the
+	// Cosmos module rewards from its relayer reward pool.
 	event TransactionBatchExecutedEvent(
 		uint256 indexed _batchNonce,
 		address indexed _token,
-		uint256 _eventNonce
+		uint256 _eventNonce,
+		address _relayer
 	);
 	event SendToCosmosEvent(
 		address indexed _tokenContract,
@@ -135,6 +164,24 @@ contract Gravity is ReentrancyGuard, AccessControl, Pausable, Ownable {
 		uint256 _amount,
 		uint256 _eventNonce
 	);
+	// SendToCosmosWithMemoEvent is a SendToCosmosEvent carrying a memo, which the
+	// Cosmos module routes to the memo handler registered for it once credited.
+	event SendToCosmosWithMemoEvent(
+		address indexed _tokenContract,
+		address indexed _sender,
+		bytes32 indexed _destination,
+		uint256 _amount,
+		uint256 _eventNonce,
+		bytes _memo
+	);
+	// SendEtherToCosmosEvent is a SendToCosmosEvent of native ether, which the
+	// Cosmos module credits as the NATIVE_ETHER pseudo token.
+	event SendEtherToCosmosEvent(
+		address indexed _sender,
+		bytes32 indexed _destination,
+		uint256 _amount,
+		uint256 _eventNonce
+	);
 	event ERC20DeployedEvent(
 		// FYI: Can't index on a string without doing a bunch of weird stuff
 		string _cosmosDenom,
@@ -479,7 +526,12 @@ contract Gravity is ReentrancyGuard, AccessControl, Pausable, Ownable {
 		// LOGS scoped to reduce stack depth
 		{
 			state_lastEventNonce = state_lastEventNonce + 1;
-			emit TransactionBatchExecutedEvent(_batchNonce, _tokenContract, state_lastEventNonce);
+			emit TransactionBatchExecutedEvent(
+				_batchNonce,
+				_tokenContract,
+				state_lastEventNonce,
+				msg.sender
+			);
 		}
 	}
 
@@ -492,7 +544,17 @@ contract Gravity is ReentrancyGuard, AccessControl, Pausable, Ownable {
 	}
 
 	function safeTransferSelf(address token, address to, uint value) public onlySelf {
-		IERC20(token).safeTransfer(to, value);
+		_transferOut(token, to, value);
+	}
+
+	// _transferOut sends tokens held by the contract, unwrapping the
+	// NATIVE_ETHER pseudo token to ether
+	function _transferOut(address token, address to, uint value) private {
+		if (token == NATIVE_ETHER) {
+			Address.sendValue(payable(to), value);
+		} else {
+			IERC20(token).safeTransfer(to, value);
+		}
 	}
 
 	function redeemVoucher(
@@ -504,7 +566,7 @@ contract Gravity is ReentrancyGuard, AccessControl, Pausable, Ownable {
 		require(state_RevertedVouchers[_nonce].amount > 0, "Voucher has been redeemed already");
 		// redeemVoucher
 		state_RevertedVouchers[_nonce].amount = 0;
-		IERC20(voucher.tokenContract).safeTransfer(_newDestination, voucher.amount);
+		_transferOut(voucher.tokenContract, _newDestination, voucher.amount);
 	}
 
 	// This makes calls to contracts that execute arbitrary logic
@@ -619,6 +681,135 @@ contract Gravity is ReentrancyGuard, AccessControl, Pausable, Ownable {
 		}
 	}
 
+	// submitMultiLogicCall executes the calls of a multi logic call in order, reverting
+	// all of them if any fails. It is signed under the "multiLogicCall" method name so
+	// that its signatures can't be replayed as a single logic call.
+	function submitMultiLogicCall(
+		// The validators that approve the call
+		ValsetArgs calldata _currentValset,
+		// These are arrays of the parts of the validators signatures
+		ValSignature[] calldata _sigs,
+		MultiLogicCallArgs memory _args
+	) external payable nonReentrant whenNotPaused checkWhiteList {
+		// CHECKS scoped to reduce stack depth
+		{
+			// Check that the call has not timed out
+			if (block.number >= _args.timeOut) {
+				revert LogicCallTimedOut();
+			}
+
+			// Check that the invalidation nonce is higher than the last nonce for this invalidation Id
+			if (state_invalidationMapping[_args.invalidationId] >= _args.invalidationNonce) {
+				revert InvalidLogicCallNonce({
+					newNonce: _args.invalidationNonce,
+					currentNonce: state_invalidationMapping[_args.invalidationId]
+				});
+			}
+
+			// Check that current validators, powers, and signatures (v,r,s) set is well-formed
+			validateValset(_currentValset, _sigs);
+
+			// Check that the supplied current validator set matches the saved checkpoint
+			if (makeCheckpoint(_currentValset, state_gravityId) != state_lastValsetCheckpoint) {
+				revert IncorrectCheckpoint();
+			}
+
+			if (_args.transferAmounts.length != _args.transferTokenContracts.length) {
+				revert InvalidLogicCallTransfers();
+			}
+
+			if (_args.feeAmounts.length != _args.feeTokenContracts.length) {
+				revert InvalidLogicCallFees();
+			}
+
+			if (
+				_args.logicContractAddresses.length == 0 ||
+				_args.logicContractAddresses.length != _args.payloads.length ||
+				_args.logicContractAddresses.length != _args.values.length
+			) {
+				revert InvalidMultiLogicCalls();
+			}
+
+			// The relayer sends along exactly the ether the calls spend
+			uint256 totalValue = 0;
+			for (uint256 i = 0; i < _args.values.length; i++) {
+				totalValue = totalValue + _args.values[i];
+			}
+			if (msg.value != totalValue) {
+				revert InvalidMultiLogicCalls();
+			}
+		}
+
+		// Check that enough current validators have signed off on the calls and valset
+		checkValidatorSignatures(
+			_currentValset,
+			_sigs,
+			makeMultiLogicCallHash(_args),
+			state_powerThreshold
+		);
+
+		// ACTIONS
+
+		// Update invaldiation nonce
+		state_invalidationMapping[_args.invalidationId] = _args.invalidationNonce;
+
+		// Send tokens to the logic contract of the first call
+		for (uint256 i = 0; i < _args.transferAmounts.length; i++) {
+			IERC20(_args.transferTokenContracts[i]).safeTransfer(
+				_args.logicContractAddresses[0],
+				_args.transferAmounts[i]
+			);
+		}
+
+		// Make the calls to the logic contracts, any failing call reverts them all
+		bytes[] memory returnData = new bytes[](_args.logicContractAddresses.length);
+		for (uint256 i = 0; i < _args.logicContractAddresses.length; i++) {
+			returnData[i] = Address.functionCallWithValue(
+				_args.logicContractAddresses[i],
+				_args.payloads[i],
+				_args.values[i]
+			);
+		}
+
+		// Send fees to msg.sender
+		for (uint256 i = 0; i < _args.feeAmounts.length; i++) {
+			IERC20(_args.feeTokenContracts[i]).safeTransfer(msg.sender, _args.feeAmounts[i]);
+		}
+
+		// LOGS scoped to reduce stack depth
+		{
+			state_lastEventNonce = state_lastEventNonce + 1;
+			emit LogicCallEvent(
+				_args.invalidationId,
+				_args.invalidationNonce,
+				abi.encode(returnData),
+				state_lastEventNonce
+			);
+		}
+	}
+
+	// makeMultiLogicCallHash returns the hash the validators sign for a multi logic call
+	function makeMultiLogicCallHash(MultiLogicCallArgs memory _args) private view returns (bytes32) {
+		return
+			keccak256(
+				abi.encode(
+					state_gravityId,
+					// bytes32 encoding of "multiLogicCall"
+					0x6d756c74694c6f67696343616c6c000000000000000000000000000000000000,
+					_args.transferAmounts,
+					_args.transferTokenContracts,
+					_args.feeAmounts,
+					_args.feeTokenContracts,
+					_args.logicContractAddresses,
+					_args.payloads,
+					_args.values,
+					_args.timeOut,
+					_args.invalidationId,
+					_args.invalidationNonce
+				)
+			);
+	}
+
 	function sendToCronos(
 		address _tokenContract,
 		address _destination,
@@ -635,11 +826,67 @@ contract Gravity is ReentrancyGuard, AccessControl, Pausable, Ownable {
 		_sendToCosmos(_tokenContract, _destination, _amount);
 	}
 
+	function sendToCosmosWithMemo(
+		address _tokenContract,
+		bytes32 _destination,
+		uint256 _amount,
+		bytes calldata _memo
+	) public nonReentrant whenNotPaused {
+		uint256 received = _receiveDeposit(_tokenContract, _amount);
+
+		state_lastEventNonce = state_lastEventNonce + 1;
+
+		emit SendToCosmosWithMemoEvent(
+			_tokenContract,
+			msg.sender,
+			_destination,
+			received,
+			state_lastEventNonce,
+			_memo
+		);
+	}
+
+	function sendEtherToCosmos(
+		bytes32 _destination
+	) external payable nonReentrant whenNotPaused {
+		if (msg.value == 0) {
+			revert InvalidSendToCosmos();
+		}
+
+		state_lastEventNonce = state_lastEventNonce + 1;
+
+		emit SendEtherToCosmosEvent(
+			msg.sender,
+			_destination,
+			msg.value,
+			state_lastEventNonce
+		);
+	}
+
 	function _sendToCosmos(
 		address _tokenContract,
 		bytes32 _destination,
 		uint256 _amount
 	) private {
+		uint256 received = _receiveDeposit(_tokenContract, _amount);
+
+		state_lastEventNonce = state_lastEventNonce + 1;
+
+		// emit to Cosmos the actual amount our balance has changed, rather than the user
+		// provided amount. This protects against a small set of wonky ERC20 behavior, like
+		// burning on send but not tokens that for example change every users balance every day.
+		emit SendToCosmosEvent(
+			_tokenContract,
+			msg.sender,
+			_destination,
+			received,
+			state_lastEventNonce
+		);
+	}
+
+	// _receiveDeposit transfers the deposited tokens from the sender and returns the
+	// amount our balance actually grew by
+	function _receiveDeposit(address _tokenContract, uint256 _amount) private returns (uint256) {
 		// we snapshot our current balance of this token
 		uint256 ourStartingBalance = IERC20(_tokenContract).balanceOf(address(this));
 
@@ -656,18 +903,7 @@ contract Gravity is ReentrancyGuard, AccessControl, Pausable, Ownable {
 			revert InvalidSendToCosmos();
 		}
 
-		state_lastEventNonce = state_lastEventNonce + 1;
-
-		// emit to Cosmos the actual amount our balance has changed, rather than the user
-		// provided amount. This protects against a small set of wonky ERC20 behavior, like
-		// burning on send but not tokens that for example change every users balance every day.
-		emit SendToCosmosEvent(
-			_tokenContract,
-			msg.sender,
-			_destination,
-			ourEndingBalance - ourStartingBalance,
-			state_lastEventNonce
-		);
+		return ourEndingBalance - ourStartingBalance;
 	}
 
 	function deployERC20(
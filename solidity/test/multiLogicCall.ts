@@ -0,0 +1,189 @@
+import chai from "chai";
+import { ethers } from "hardhat";
+import { solidity } from "ethereum-waffle";
+import { TestLogicContract } from "../typechain/TestLogicContract";
+
+import { deployContracts } from "../test-utils";
+import {
+  getSignerAddresses,
+  signHash,
+  examplePowers,
+  ZeroAddress,
+} from "../test-utils/pure";
+
+chai.use(solidity);
+const { expect } = chai;
+
+
+async function runTest(opts: {
+  invalidationNonceNotHigher?: boolean;
+  signedAsSingleCall?: boolean;
+  failingCall?: boolean;
+  mismatchedValue?: boolean;
+  noCalls?: boolean;
+}) {
+  // Prep and deploy contract
+  // ========================
+  const signers = await ethers.getSigners();
+  const gravityId = ethers.utils.formatBytes32String("foo");
+  let powers = examplePowers();
+  let validators = signers.slice(0, powers.length);
+  const powerThreshold = 6666;
+  const {
+    gravity,
+    testERC20,
+  } = await deployContracts(gravityId, validators, powers, powerThreshold);
+
+  await gravity.grantRole(
+    await gravity.RELAYER(),
+    signers[0].address,
+  );
+
+  const TestLogicContract = await ethers.getContractFactory("TestLogicContract");
+  const logicContract = (await TestLogicContract.deploy(testERC20.address)) as TestLogicContract;
+  await logicContract.transferOwnership(gravity.address);
+
+  // Transfer out to Cosmos, locking coins
+  // =====================================
+  await testERC20.functions.approve(gravity.address, 1000);
+  await gravity.functions.sendToCronos(
+    testERC20.address,
+    "0xffffffffffffffffffffffffffffffffffffffff",
+    1000
+  );
+
+  // Prepare the calls
+  // =================
+  // 10 coins are transferred to the logic contract of the first call, then the
+  // first call sends 4 of them to signer 20 and the second 6 to signer 21
+  const secondAmount = opts.failingCall ? 100 : 3;
+  let logicContractAddresses = [logicContract.address, logicContract.address];
+  let payloads = [
+    logicContract.interface.encodeFunctionData("transferTokens", [await signers[20].getAddress(), 2, 2]),
+    logicContract.interface.encodeFunctionData("transferTokens", [await signers[21].getAddress(), secondAmount, 3]),
+  ];
+  let values = [0, 0];
+  if (opts.noCalls) {
+    logicContractAddresses = [];
+    payloads = [];
+    values = [];
+  }
+
+  const multiLogicCallArgs = {
+    transferAmounts: [10],
+    transferTokenContracts: [testERC20.address],
+    feeAmounts: [2],
+    feeTokenContracts: [testERC20.address],
+    logicContractAddresses,
+    payloads,
+    values,
+    timeOut: 4766922941000,
+    invalidationId: ethers.utils.hexZeroPad(testERC20.address, 32),
+    invalidationNonce: opts.invalidationNonceNotHigher ? 0 : 1,
+  }
+
+  const methodName = ethers.utils.formatBytes32String(
+    opts.signedAsSingleCall ? "logicCall" : "multiLogicCall"
+  );
+  const digest = ethers.utils.keccak256(ethers.utils.defaultAbiCoder.encode(
+    [
+      "bytes32", // gravityId
+      "bytes32", // methodName
+      "uint256[]", // transferAmounts
+      "address[]", // transferTokenContracts
+      "uint256[]", // feeAmounts
+      "address[]", // feeTokenContracts
+      "address[]", // logicContractAddresses
+      "bytes[]", // payloads
+      "uint256[]", // values
+      "uint256", // timeOut
+      "bytes32", // invalidationId
+      "uint256" // invalidationNonce
+    ],
+    [
+      gravityId,
+      methodName,
+      multiLogicCallArgs.transferAmounts,
+      multiLogicCallArgs.transferTokenContracts,
+      multiLogicCallArgs.feeAmounts,
+      multiLogicCallArgs.feeTokenContracts,
+      multiLogicCallArgs.logicContractAddresses,
+      multiLogicCallArgs.payloads,
+      multiLogicCallArgs.values,
+      multiLogicCallArgs.timeOut,
+      multiLogicCallArgs.invalidationId,
+      multiLogicCallArgs.invalidationNonce
+    ]
+  ));
+
+  const sigs = await signHash(validators, digest);
+
+  let valset = {
+    validators: await getSignerAddresses(validators),
+    powers,
+    valsetNonce: 0,
+    rewardAmount: 0,
+    rewardToken: ZeroAddress
+  }
+
+  await gravity.submitMultiLogicCall(
+    valset,
+    sigs,
+    multiLogicCallArgs,
+    { value: opts.mismatchedValue ? 1 : 0 }
+  );
+
+  expect(
+    (await testERC20.functions.balanceOf(await signers[20].getAddress()))[0].toNumber()
+  ).to.equal(4);
+
+  expect(
+    (await testERC20.functions.balanceOf(await signers[21].getAddress()))[0].toNumber()
+  ).to.equal(6);
+
+  expect(
+    (await testERC20.functions.balanceOf(gravity.address))[0].toNumber()
+  ).to.equal(988);
+
+  expect(
+    (await testERC20.functions.balanceOf(logicContract.address))[0].toNumber()
+  ).to.equal(0);
+
+  expect(
+    (await gravity.functions.lastLogicCallNonce(multiLogicCallArgs.invalidationId))[0].toNumber()
+  ).to.equal(1);
+}
+
+describe("submitMultiLogicCall tests", function () {
+  it("executes the calls in order", async function () {
+    await runTest({});
+  });
+
+  it("throws on invalidation nonce not incremented", async function () {
+    await expect(runTest({ invalidationNonceNotHigher: true })).to.be.revertedWith(
+      "InvalidLogicCallNonce(0, 0)"
+    );
+  });
+
+  it("throws on signatures of a single logic call", async function () {
+    await expect(runTest({ signedAsSingleCall: true })).to.be.revertedWith(
+      "InvalidSignature()"
+    );
+  });
+
+  it("reverts every call when one fails", async function () {
+    await expect(runTest({ failingCall: true })).to.be.reverted;
+  });
+
+  it("throws on ether not matching the call values", async function () {
+    await expect(runTest({ mismatchedValue: true })).to.be.revertedWith(
+      "InvalidMultiLogicCalls()"
+    );
+  });
+
+  it("throws on no calls", async function () {
+    await expect(runTest({ noCalls: true })).to.be.revertedWith(
+      "InvalidMultiLogicCalls()"
+    );
+  });
+});
@@ -0,0 +1,143 @@
+import chai from "chai";
+import { ethers } from "hardhat";
+import { solidity } from "ethereum-waffle";
+
+import { deployContracts } from "../test-utils";
+import {
+  getSignerAddresses,
+  signHash,
+  examplePowers,
+  ZeroAddress,
+} from "../test-utils/pure";
+
+chai.use(solidity);
+const { expect } = chai;
+
+const NATIVE_ETHER = "0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE";
+
+async function deploy() {
+  const signers = await ethers.getSigners();
+  const gravityId = ethers.utils.formatBytes32String("foo");
+  let powers = examplePowers();
+  let validators = signers.slice(0, powers.length);
+  const powerThreshold = 6666;
+  const {
+    gravity,
+    testERC20,
+  } = await deployContracts(gravityId, validators, powers, powerThreshold);
+
+  await gravity.grantRole(
+    await gravity.RELAYER(),
+    signers[0].address,
+  );
+
+  return { signers, gravityId, powers, validators, gravity, testERC20 };
+}
+
+describe("sendEtherToCosmos tests", function () {
+  it("locks the ether and emits the deposit", async function () {
+    const { signers, gravity } = await deploy();
+
+    const destination = ethers.utils.hexZeroPad("0xffffffffffffffffffffffffffffffffffffffff", 32);
+    await expect(gravity.functions.sendEtherToCosmos(
+      destination,
+      { value: 1000 }
+    )).to.emit(gravity, 'SendEtherToCosmosEvent').withArgs(
+        await signers[0].getAddress(),
+        destination,
+        1000,
+        2
+      );
+
+    expect(await ethers.provider.getBalance(gravity.address)).to.equal(1000);
+    expect((await gravity.functions.state_lastEventNonce())[0]).to.equal(2);
+  });
+
+  it("throws on no ether", async function () {
+    const { gravity } = await deploy();
+
+    const destination = ethers.utils.hexZeroPad("0xffffffffffffffffffffffffffffffffffffffff", 32);
+    await expect(gravity.functions.sendEtherToCosmos(destination)).to.be.revertedWith(
+      "InvalidSendToCosmos()"
+    );
+  });
+
+  it("unwraps batches of native ether to ether", async function () {
+    const { signers, gravityId, powers, validators, gravity, testERC20 } = await deploy();
+
+    const destination = ethers.utils.hexZeroPad("0xffffffffffffffffffffffffffffffffffffffff", 32);
+    await gravity.functions.sendEtherToCosmos(destination, { value: 1000 });
+
+    // the second destination can't receive ether, its transfer is kept as a
+    // voucher instead of failing the batch
+    const txAmounts = [100, 200, 300];
+    const txFees = [1, 2, 3];
+    const txDestinations = [
+      await signers[20].getAddress(),
+      await signers[21].getAddress(),
+      testERC20.address,
+    ];
+    const batchNonce = 1;
+    const batchTimeout = ethers.provider.blockNumber + 1000;
+
+    const methodName = ethers.utils.formatBytes32String("transactionBatch");
+    const digest = ethers.utils.keccak256(ethers.utils.defaultAbiCoder.encode(
+      [
+        "bytes32",
+        "bytes32",
+        "uint256[]",
+        "address[]",
+        "uint256[]",
+        "uint256",
+        "address",
+        "uint256",
+      ],
+      [
+        gravityId,
+        methodName,
+        txAmounts,
+        txDestinations,
+        txFees,
+        batchNonce,
+        NATIVE_ETHER,
+        batchTimeout,
+      ]
+    ));
+    const sigs = await signHash(validators, digest);
+
+    const valset = {
+      validators: await getSignerAddresses(validators),
+      powers,
+      valsetNonce: 0,
+      rewardAmount: 0,
+      rewardToken: ZeroAddress
+    }
+
+    const balance20 = await ethers.provider.getBalance(txDestinations[0]);
+    const balance21 = await ethers.provider.getBalance(txDestinations[1]);
+
+    await gravity.submitBatch(
+      valset,
+      sigs,
+      txAmounts,
+      txDestinations,
+      txFees,
+      batchNonce,
+      NATIVE_ETHER,
+      batchTimeout
+    );
+
+    expect((await ethers.provider.getBalance(txDestinations[0])).sub(balance20)).to.equal(100);
+    expect((await ethers.provider.getBalance(txDestinations[1])).sub(balance21)).to.equal(200);
+    expect(await ethers.provider.getBalance(testERC20.address)).to.equal(0);
+
+    // the fees went to the relayer, the failed transfer stays in the contract
+    expect(await ethers.provider.getBalance(gravity.address)).to.equal(1000 - 300 - 6);
+    expect((await gravity.functions.state_lastRevertedNonce())[0]).to.equal(2);
+    const voucher = await gravity.functions.state_RevertedVouchers(1);
+    expect(voucher.tokenContract).to.equal(NATIVE_ETHER);
+    expect(voucher.amount).to.equal(300);
+
+    expect((await gravity.functions.lastBatchNonce(NATIVE_ETHER))[0]).to.equal(1);
+  });
+});
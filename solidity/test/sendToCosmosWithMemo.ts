@@ -0,0 +1,56 @@
+import chai from "chai";
+import { ethers } from "hardhat";
+import { solidity } from "ethereum-waffle";
+
+import { deployContracts } from "../test-utils";
+import { examplePowers } from "../test-utils/pure";
+
+chai.use(solidity);
+const { expect } = chai;
+
+
+async function runTest(opts: {}) {
+
+
+  // Prep and deploy contract
+  // ========================
+  const signers = await ethers.getSigners();
+  const gravityId = ethers.utils.formatBytes32String("foo");
+  // This is the power distribution on the Cosmos hub as of 7/14/2020
+  let powers = examplePowers();
+  let validators = signers.slice(0, powers.length);
+  const powerThreshold = 6666;
+  const {
+    gravity,
+    testERC20,
+  } = await deployContracts(gravityId, validators, powers, powerThreshold);
+
+
+  // Transfer out to Cosmos with a memo, locking coins
+  // =================================================
+  const destination = ethers.utils.hexZeroPad("0xffffffffffffffffffffffffffffffffffffffff", 32);
+  const memo = ethers.utils.toUtf8Bytes('{"stake":{"validator":"cosmosvaloper1..."}}');
+  await testERC20.functions.approve(gravity.address, 1000);
+  await expect(gravity.functions.sendToCosmosWithMemo(
+    testERC20.address,
+    destination,
+    1000,
+    memo
+  )).to.emit(gravity, 'SendToCosmosWithMemoEvent').withArgs(
+      testERC20.address,
+      await signers[0].getAddress(),
+      destination,
+      1000,
+      2,
+      ethers.utils.hexlify(memo)
+    );
+
+  expect((await testERC20.functions.balanceOf(gravity.address))[0]).to.equal(1000);
+  expect((await gravity.functions.state_lastEventNonce())[0]).to.equal(2);
+}
+
+describe("sendToCosmosWithMemo tests", function () {
+  it("works right", async function () {
+    await runTest({})
+  });
+});
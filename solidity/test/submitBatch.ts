@@ -186,6 +186,8 @@ async function runTest(opts: {
     testERC20.address,
     batchTimeout
   );
+
+  return { batchSubmitTx, relayer: signers[0].address };
 }
 
 describe("submitBatch tests", function () {
@@ -252,6 +254,13 @@ describe("submitBatch tests", function () {
   it("does not throw on barely enough signatures", async function () {
     await runTest({ barelyEnoughPower: true });
   });
+
+  it("emits the relayer of the batch", async function () {
+    const { batchSubmitTx, relayer } = await runTest({});
+    const receipt = await batchSubmitTx.wait();
+    const event = receipt.events?.find((e) => e.event === "TransactionBatchExecutedEvent");
+    expect(event?.args?._relayer).to.equal(relayer);
+  });
 });
 
 // This test produces a hash for the contract which should match what is being used in the Go unit tests. It's here for